@@ -19,6 +19,7 @@ package v1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -36,6 +37,412 @@ type APIServerSpec struct {
 	// CalicoWebhooksDeployment configures the calico-webhooks Deployment.
 	// +optional
 	CalicoWebhooksDeployment *CalicoWebhooksDeployment `json:"calicoWebhooksDeployment,omitempty"`
+
+	// PrometheusMetrics configures whether the API server and query server metrics are exposed for scraping
+	// by Prometheus. When Enabled, a metrics Service and a ServiceMonitor are rendered in the calico-system
+	// namespace. The ServiceMonitor is only rendered if the Prometheus operator CRDs (monitoring.coreos.com/v1)
+	// are installed in the cluster.
+	// Default: Disabled
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	PrometheusMetrics *PrometheusMetricsOption `json:"prometheusMetrics,omitempty"`
+
+	// EtcdDatastore configures the API server to use an external etcdv3 datastore instead of the
+	// Kubernetes API. If the secret "calico-apiserver-etcd-tls" exists in the tigera-operator namespace,
+	// its ca.crt, tls.crt and tls.key fields are used to secure the connection to etcd.
+	// +optional
+	EtcdDatastore *APIServerEtcdDatastore `json:"etcdDatastore,omitempty"`
+
+	// RequestTimeout is the duration after which the API server times out a request. Passed to the API
+	// server as --request-timeout.
+	// Default: 1m0s
+	// +optional
+	RequestTimeout *metav1.Duration `json:"requestTimeout,omitempty"`
+
+	// MaxRequestsInflight is the maximum number of non-mutating requests in flight at a given time. Requests beyond
+	// this limit are rejected with 429 Too Many Requests. Zero means no limit. Passed to the API server as
+	// --max-requests-inflight.
+	// Default: 400
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRequestsInflight *int32 `json:"maxRequestsInflight,omitempty"`
+
+	// MaxMutatingRequestsInflight is the maximum number of mutating requests in flight at a given time. Requests
+	// beyond this limit are rejected with 429 Too Many Requests. Zero means no limit. Passed to the API server as
+	// --max-mutating-requests-inflight.
+	// Default: 200
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxMutatingRequestsInflight *int32 `json:"maxMutatingRequestsInflight,omitempty"`
+
+	// DefaultWatchCacheSize is the number of events the API server keeps in the watch cache of each resource that
+	// is not listed in WatchCacheSizes. Larger caches let clients resume watches on busy resources without a full
+	// relist, at the cost of memory. Zero disables the watch cache for those resources. Passed to the API server as
+	// --default-watch-cache-size.
+	// Default: 100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DefaultWatchCacheSize *int32 `json:"defaultWatchCacheSize,omitempty"`
+
+	// WatchCacheSizes overrides the watch cache size of individual resources, for example to give
+	// networkpolicies.projectcalico.org a larger cache in clusters with very large policy sets. Passed to the API
+	// server as --watch-cache-sizes.
+	// +listType=map
+	// +listMapKey=resource
+	// +optional
+	WatchCacheSizes []APIServerWatchCacheSize `json:"watchCacheSizes,omitempty"`
+
+	// EtcdCompactionInterval is the interval at which the API server requests a compaction of the etcd datastore.
+	// Zero disables compaction requests by the API server. Only applicable when EtcdDatastore is set. Passed to the
+	// API server as --etcd-compaction-interval.
+	// Default: 5m0s
+	// +optional
+	EtcdCompactionInterval *metav1.Duration `json:"etcdCompactionInterval,omitempty"`
+
+	// TLS configures the certificate served by the API server.
+	// +optional
+	TLS *APIServerTLS `json:"tls,omitempty"`
+
+	// QueryServer configures the query server that runs alongside the API server. Only applicable to Calico Enterprise.
+	// +optional
+	QueryServer *APIServerQueryServer `json:"queryServer,omitempty"`
+
+	// FlowControl configures API priority and fairness for requests to the projectcalico.org/v3 API served by the
+	// API server. The FlowSchemas and PriorityLevelConfigurations listed here are owned by the APIServer and are
+	// removed when no longer listed. To remove all of them, set FlowControl to an empty object.
+	// +optional
+	FlowControl *APIServerFlowControl `json:"flowControl,omitempty"`
+
+	// AuditLogs configures where the API server writes its audit logs and how they are rotated. Only applicable
+	// to Calico Enterprise.
+	// +optional
+	AuditLogs *APIServerAuditLogs `json:"auditLogs,omitempty"`
+
+	// APIServerService configures the calico-api Service, which serves the API server and, for Calico Enterprise,
+	// the query server.
+	// +optional
+	APIServerService *ServiceOverrides `json:"apiServerService,omitempty"`
+
+	// DelegatedAuth configures the RBAC that allows the API server to delegate authentication and authorization to
+	// the Kubernetes API server: the calico-apiserver-delegate-auth ClusterRoleBinding and the
+	// calico-apiserver-auth-reader RoleBinding, which grants read access to the extension-apiserver-authentication
+	// ConfigMap.
+	// +optional
+	DelegatedAuth *APIServerDelegatedAuth `json:"delegatedAuth,omitempty"`
+}
+
+// DelegatedAuthManagement is whether the operator manages the delegated auth RBAC of the API server.
+// +kubebuilder:validation:Enum=Managed;Unmanaged
+type DelegatedAuthManagement string
+
+const (
+	// DelegatedAuthManaged makes the operator create the delegated auth RBAC of the API server.
+	DelegatedAuthManaged DelegatedAuthManagement = "Managed"
+	// DelegatedAuthUnmanaged leaves the delegated auth RBAC of the API server to be provisioned separately.
+	DelegatedAuthUnmanaged DelegatedAuthManagement = "Unmanaged"
+)
+
+// APIServerDelegatedAuth configures the delegated auth RBAC of the API server.
+type APIServerDelegatedAuth struct {
+	// Namespace is a namespace that a calico-apiserver-auth-reader RoleBinding is created in, in addition to the one
+	// in kube-system that the API server reads the extension-apiserver-authentication ConfigMap from. It must hold
+	// the extension-apiserver-authentication-reader Role.
+	// Default: kube-system
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Management is whether the operator creates the delegated auth RBAC. With Unmanaged, the operator neither
+	// creates nor removes the calico-apiserver-delegate-auth ClusterRoleBinding and the calico-apiserver-auth-reader
+	// RoleBinding, and the APIServer reports the DelegatedAuthManaged condition as False until they are managed
+	// again. They must then be provisioned separately for the API server to authenticate requests.
+	// Default: Managed
+	// +optional
+	Management *DelegatedAuthManagement `json:"management,omitempty"`
+}
+
+// APIServerDelegatedAuthManagedCondition is the type of the APIServer status condition that is False while the
+// delegated auth RBAC of the API server is not managed by the operator.
+const APIServerDelegatedAuthManagedCondition = "DelegatedAuthManaged"
+
+// APIServerWatchCacheSize is the watch cache size of a single resource served by the API server.
+type APIServerWatchCacheSize struct {
+	// Resource is the lowercase plural name of the resource, qualified with its API group, for example
+	// networkpolicies.projectcalico.org.
+	// +kubebuilder:validation:MinLength=1
+	Resource string `json:"resource"`
+
+	// Size is the number of events kept in the watch cache of the resource. Zero disables the watch cache for
+	// the resource.
+	// +kubebuilder:validation:Minimum=0
+	Size int32 `json:"size"`
+}
+
+// APIServerAuditLogs defines the storage and rotation of the API server audit logs.
+type APIServerAuditLogs struct {
+	// Storage is the type of volume the audit logs are written to. HostPath writes to /var/log/calico/audit on
+	// the host, where they are collected by fluentd. EmptyDir and PersistentVolumeClaim are for clusters where
+	// hostPath volumes are not permitted; audit logs written to them are not collected by fluentd. Webhook does not
+	// write audit logs to a volume, but sends them to the audit webhook collector of WebhookConfigSecretName.
+	// Default: HostPath
+	// +kubebuilder:validation:Enum=HostPath;EmptyDir;PersistentVolumeClaim;Webhook
+	// +optional
+	Storage *APIServerAuditLogStorage `json:"storage,omitempty"`
+
+	// PersistentVolumeClaimName is the name of the PersistentVolumeClaim in the calico-system namespace that the
+	// audit logs are written to. Each API server pod writes its audit logs to its own <pod name> directory of the
+	// claim. Required when Storage is PersistentVolumeClaim.
+	// +optional
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName,omitempty"`
+
+	// WebhookConfigSecretName is the name of the secret in the tigera-operator namespace that holds, under the key
+	// "config", the kubeconfig of the audit webhook collector. It is passed to the API server as
+	// --audit-webhook-config-file. Only applicable when Storage is Webhook. Changes to the secret are rolled out to
+	// the API server.
+	// Default: calico-apiserver-audit-webhook
+	// +optional
+	WebhookConfigSecretName string `json:"webhookConfigSecretName,omitempty"`
+
+	// MaxAge is the maximum number of days to retain old audit log files. Passed to the API server as
+	// --audit-log-maxage.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxAge *int32 `json:"maxAge,omitempty"`
+
+	// MaxBackup is the maximum number of old audit log files to retain. Passed to the API server as
+	// --audit-log-maxbackup.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxBackup *int32 `json:"maxBackup,omitempty"`
+
+	// MaxSize is the maximum size in megabytes of an audit log file before it is rotated. Passed to the API
+	// server as --audit-log-maxsize.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxSize *int32 `json:"maxSize,omitempty"`
+}
+
+// APIServerAuditLogStorage specifies the type of volume the API server writes audit logs to.
+//
+// One of: HostPath, EmptyDir, PersistentVolumeClaim, Webhook
+type APIServerAuditLogStorage string
+
+const (
+	APIServerAuditLogStorageHostPath              APIServerAuditLogStorage = "HostPath"
+	APIServerAuditLogStorageEmptyDir              APIServerAuditLogStorage = "EmptyDir"
+	APIServerAuditLogStoragePersistentVolumeClaim APIServerAuditLogStorage = "PersistentVolumeClaim"
+	APIServerAuditLogStorageWebhook               APIServerAuditLogStorage = "Webhook"
+)
+
+// APIServerFlowControl defines API priority and fairness configuration for the API server.
+type APIServerFlowControl struct {
+	// PriorityAndFairness controls whether the API server classifies and queues requests using API priority and
+	// fairness. Passed to the API server as --enable-priority-and-fairness.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	PriorityAndFairness *PriorityAndFairnessOption `json:"priorityAndFairness,omitempty"`
+
+	// PriorityLevelConfigurations is a list of PriorityLevelConfigurations to create for the FlowSchemas below.
+	// +optional
+	PriorityLevelConfigurations []APIServerPriorityLevelConfiguration `json:"priorityLevelConfigurations,omitempty"`
+
+	// FlowSchemas is a list of FlowSchemas that classify requests to the projectcalico.org API group. Their rules
+	// may only match resources in the projectcalico.org API group.
+	// +optional
+	FlowSchemas []APIServerFlowSchema `json:"flowSchemas,omitempty"`
+}
+
+// APIServerPriorityLevelConfiguration is a PriorityLevelConfiguration rendered by the operator.
+type APIServerPriorityLevelConfiguration struct {
+	// Name of the PriorityLevelConfiguration.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Spec of the PriorityLevelConfiguration.
+	Spec flowcontrolv1.PriorityLevelConfigurationSpec `json:"spec"`
+}
+
+// APIServerFlowSchema is a FlowSchema rendered by the operator.
+type APIServerFlowSchema struct {
+	// Name of the FlowSchema.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Spec of the FlowSchema.
+	Spec flowcontrolv1.FlowSchemaSpec `json:"spec"`
+}
+
+// PriorityAndFairnessOption specifies whether API priority and fairness is enabled.
+//
+// One of: Enabled, Disabled
+type PriorityAndFairnessOption string
+
+const (
+	PriorityAndFairnessEnabled  PriorityAndFairnessOption = "Enabled"
+	PriorityAndFairnessDisabled PriorityAndFairnessOption = "Disabled"
+)
+
+// APIServerQueryServer defines configuration for the query server.
+type APIServerQueryServer struct {
+	// Enabled controls whether the query server container is run alongside the API server. Disabling the query
+	// server also removes its service port, network policy rules and TLS secrets. The Calico Enterprise web
+	// console relies on the query server, so only disable it if the web console is not used.
+	// Default: true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Gateway exposes the query server outside of the cluster through the Gateway API, as an alternative to
+	// port-forwarding or a NodePort Service. The operator renders a Gateway and a TLSRoute that pass TLS
+	// connections through to the calico-api Service, so clients see the API server certificate. Requires the
+	// Gateway API CRDs and an existing GatewayClass.
+	// +optional
+	Gateway *QueryServerGateway `json:"gateway,omitempty"`
+
+	// Route exposes the query server outside of an OpenShift cluster through a passthrough OpenShift Route to the
+	// calico-api Service, so clients see the API server certificate. Only applicable on OpenShift.
+	// +optional
+	Route *QueryServerRoute `json:"route,omitempty"`
+
+	// CacheRefreshInterval is how often the query server refreshes its cache of the endpoints and policies of the
+	// cluster. Larger clusters may need a longer interval to keep the refreshes from competing with queries.
+	// If omitted, the query server uses its default interval.
+	// +optional
+	CacheRefreshInterval *metav1.Duration `json:"cacheRefreshInterval,omitempty"`
+
+	// MaxPageSize is the largest number of items that the query server returns in a page of results.
+	// If omitted, the query server uses its default page size.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxPageSize *int32 `json:"maxPageSize,omitempty"`
+
+	// Workers is the number of queries that the query server processes concurrently.
+	// If omitted, the query server uses its default number of workers.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Workers *int32 `json:"workers,omitempty"`
+}
+
+// QueryServerRoute defines how the query server is exposed through an OpenShift Route.
+type QueryServerRoute struct {
+	// Hostname is the hostname that clients use to reach the query server. It is added to the API server
+	// certificate. If omitted, OpenShift generates a hostname from the ingress domain of the cluster, which is added
+	// to the certificate once the Route has been created.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+}
+
+// QueryServerGateway defines how the query server is exposed through the Gateway API.
+type QueryServerGateway struct {
+	// GatewayClassName is the name of the GatewayClass that implements the Gateway.
+	// +kubebuilder:validation:MinLength=1
+	GatewayClassName string `json:"gatewayClassName"`
+
+	// Hostname is the SNI hostname that clients use to reach the query server. It is added to the API server
+	// certificate. If omitted, all TLS connections to the Gateway port are passed through to the query server.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+
+	// Port is the port that the Gateway listens on.
+	// Default: 443
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+}
+
+// APIServerTLS defines additional subject alternative names for the operator-issued API server certificate.
+type APIServerTLS struct {
+	// ExtraDNSNames is a list of DNS names, in addition to the in-cluster service names, to include in the
+	// API server certificate. Use this when the API server is reached through an external load balancer or a
+	// custom FQDN.
+	// +optional
+	ExtraDNSNames []string `json:"extraDNSNames,omitempty"`
+
+	// ExtraIPAddresses is a list of IP addresses to include in the API server certificate.
+	// +optional
+	ExtraIPAddresses []string `json:"extraIPAddresses,omitempty"`
+}
+
+// APIServerEtcdDatastore defines the etcd cluster used by the API server.
+type APIServerEtcdDatastore struct {
+	// Endpoints is the list of etcd client URLs, e.g. https://10.0.0.1:2379.
+	// +kubebuilder:validation:MinItems=1
+	Endpoints []string `json:"endpoints"`
+}
+
+// PrometheusMetricsOption specifies whether Prometheus metrics scraping is enabled.
+//
+// One of: Enabled, Disabled
+type PrometheusMetricsOption string
+
+const (
+	PrometheusMetricsEnabled  PrometheusMetricsOption = "Enabled"
+	PrometheusMetricsDisabled PrometheusMetricsOption = "Disabled"
+)
+
+// IsPrometheusMetricsEnabled returns true if the API server and query server metrics should be exposed to Prometheus.
+func (s *APIServerSpec) IsPrometheusMetricsEnabled() bool {
+	return s != nil && s.PrometheusMetrics != nil && *s.PrometheusMetrics == PrometheusMetricsEnabled
+}
+
+// QueryServerGateway returns the Gateway API exposure of the query server, or nil if the query server is disabled or
+// not exposed.
+func (s *APIServerSpec) QueryServerGateway() *QueryServerGateway {
+	if !s.IsQueryServerEnabled() || s.QueryServer == nil {
+		return nil
+	}
+	return s.QueryServer.Gateway
+}
+
+// QueryServerRoute returns the OpenShift Route exposure of the query server, or nil if the query server is disabled
+// or not exposed.
+func (s *APIServerSpec) QueryServerRoute() *QueryServerRoute {
+	if !s.IsQueryServerEnabled() || s.QueryServer == nil {
+		return nil
+	}
+	return s.QueryServer.Route
+}
+
+// IsQueryServerEnabled returns true unless the query server has been explicitly disabled.
+func (s *APIServerSpec) IsQueryServerEnabled() bool {
+	return s == nil || s.QueryServer == nil || s.QueryServer.Enabled == nil || *s.QueryServer.Enabled
+}
+
+// GetAuditLogStorage returns the type of volume the API server writes audit logs to, defaulting to HostPath.
+func (s *APIServerSpec) GetAuditLogStorage() APIServerAuditLogStorage {
+	if s == nil || s.AuditLogs == nil || s.AuditLogs.Storage == nil {
+		return APIServerAuditLogStorageHostPath
+	}
+	return *s.AuditLogs.Storage
+}
+
+// GetAuditWebhookConfigSecretName returns the name of the secret that holds the kubeconfig of the audit webhook
+// collector, defaulting to calico-apiserver-audit-webhook.
+func (s *APIServerSpec) GetAuditWebhookConfigSecretName() string {
+	if s == nil || s.AuditLogs == nil || s.AuditLogs.WebhookConfigSecretName == "" {
+		return "calico-apiserver-audit-webhook"
+	}
+	return s.AuditLogs.WebhookConfigSecretName
+}
+
+// IsPriorityAndFairnessEnabled returns true if API priority and fairness has been explicitly enabled.
+func (s *APIServerSpec) IsPriorityAndFairnessEnabled() bool {
+	return s != nil && s.FlowControl != nil && s.FlowControl.PriorityAndFairness != nil &&
+		*s.FlowControl.PriorityAndFairness == PriorityAndFairnessEnabled
+}
+
+// GetDelegatedAuthNamespace returns the namespace of the additional calico-apiserver-auth-reader RoleBinding,
+// defaulting to kube-system.
+func (s *APIServerSpec) GetDelegatedAuthNamespace() string {
+	if s == nil || s.DelegatedAuth == nil || s.DelegatedAuth.Namespace == "" {
+		return "kube-system"
+	}
+	return s.DelegatedAuth.Namespace
+}
+
+// IsDelegatedAuthManaged returns true unless the delegated auth RBAC has been explicitly left unmanaged.
+func (s *APIServerSpec) IsDelegatedAuthManaged() bool {
+	return s == nil || s.DelegatedAuth == nil || s.DelegatedAuth.Management == nil ||
+		*s.DelegatedAuth.Management != DelegatedAuthUnmanaged
 }
 
 // APIServerStatus defines the observed state of Tigera API server.
@@ -56,6 +463,11 @@ type APIServerStatus struct {
 // of this resource is supported. It must be named "default" or "tigera-secure".
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Whether the component is running and stable."
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type=='Progressing')].status",description="Whether the component is processing changes."
+// +kubebuilder:printcolumn:name="Degraded",type="string",JSONPath=".status.conditions[?(@.type=='Degraded')].status",description="Whether the component is degraded."
+// +kubebuilder:printcolumn:name="Observed Generation",type="integer",JSONPath=".status.conditions[?(@.type=='Ready')].observedGeneration",description="The generation of the resource that the Available status reflects.",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default' || self.metadata.name == 'tigera-secure'",message="resource name must be 'default' or 'tigera-secure'"
 type APIServer struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -110,6 +522,22 @@ type APIServerDeploymentContainer struct {
 	// The probe handler is set by the operator and cannot be overridden.
 	// +optional
 	LivenessProbe *ProbeOverride `json:"livenessProbe,omitempty"`
+
+	// SeccompProfile overrides the seccomp profile in the container's security context.
+	// +optional
+	SeccompProfile *v1.SeccompProfile `json:"seccompProfile,omitempty"`
+
+	// AppArmorProfile is the AppArmor profile to run the container with. It must be one of runtime/default,
+	// unconfined, or localhost/<profile>, and is applied using the container.apparmor.security.beta.kubernetes.io
+	// pod annotation.
+	// +kubebuilder:validation:Pattern=`^(runtime/default|unconfined|localhost/.+)$`
+	// +optional
+	AppArmorProfile string `json:"appArmorProfile,omitempty"`
+
+	// ExtraVolumeMounts are added to the volume mounts of the container. Each must mount one of the ExtraVolumes
+	// of the pod, at a path that is not already used by the container.
+	// +optional
+	ExtraVolumeMounts []v1.VolumeMount `json:"extraVolumeMounts,omitempty"`
 }
 
 type APIServerDeploymentContainerPort struct {
@@ -184,6 +612,41 @@ type APIServerDeploymentPodSpec struct {
 	// PriorityClassName allows to specify a PriorityClass resource to be used.
 	// +optional
 	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// RuntimeClassName is the name of the RuntimeClass to run the API server pods with, for example to pin them to the
+	// standard runtime on clusters that run untrusted workloads with a sandboxed runtime. The RuntimeClass must exist.
+	// If omitted, the API server pods use the cluster's default runtime.
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+
+	// ImagePullPolicy is the pull policy applied to all containers of the API server pods.
+	// If specified, this takes precedence over the ImagePullPolicy of the Installation.
+	// If omitted, the API server pods use the ImagePullPolicy of the Installation.
+	// +optional
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	ImagePullPolicy *v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// TerminationGracePeriodSeconds is the duration in seconds the API server pods have to terminate gracefully
+	// before they are forcibly halted. It must be longer than twice PreStopSleepSeconds.
+	// If omitted, it defaults to 30 seconds plus twice PreStopSleepSeconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+
+	// PreStopSleepSeconds delays the shutdown of the API server pods so that load balancers stop sending them
+	// requests before they stop serving. The containers sleep for this long in a preStop hook before they are sent
+	// a termination signal, and the API server is started with a matching --shutdown-delay-duration so that it
+	// keeps serving in-flight and late requests for this long after the signal.
+	// If omitted, the API server pods shut down as soon as they are terminated.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	PreStopSleepSeconds *int32 `json:"preStopSleepSeconds,omitempty"`
+
+	// ExtraVolumes are added to the volumes of the API server pods, for example to provide a corporate CA bundle or
+	// an authentication configuration file to the containers through their ExtraVolumeMounts. Their names must not
+	// conflict with the volumes of the API server pods.
+	// +optional
+	ExtraVolumes []v1.Volume `json:"extraVolumes,omitempty"`
 }
 
 // APIServerDeploymentPodTemplateSpec is the API server Deployment's PodTemplateSpec
@@ -223,6 +686,11 @@ type APIServerDeploymentSpec struct {
 	// Template describes the API server Deployment pod that will be created.
 	// +optional
 	Template *APIServerDeploymentPodTemplateSpec `json:"template,omitempty"`
+
+	// PodDisruptionBudget configures the PodDisruptionBudget of the API server pods. If omitted, at most one API
+	// server pod may be unavailable during a voluntary disruption.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 }
 
 type APIServerPodLogging struct {
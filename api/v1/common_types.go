@@ -16,6 +16,11 @@ limitations under the License.
 
 package v1
 
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
 // Metadata contains the standard Kubernetes labels and annotations fields.
 type Metadata struct {
 	// Labels is a map of string keys and values that may match replicaset and
@@ -31,6 +36,54 @@ type Metadata struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+// PodDisruptionBudgetSpec configures the PodDisruptionBudget of a component. At most one of MinAvailable and
+// MaxUnavailable may be set.
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))",message="minAvailable and maxUnavailable may not both be set"
+type PodDisruptionBudgetSpec struct {
+	// MinAvailable is the number or percentage of pods that must remain available during a voluntary disruption.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number or percentage of pods that may be unavailable during a voluntary disruption.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// ServiceOverrides configures how a Service rendered by the operator routes traffic and how it is exposed.
+// +kubebuilder:validation:XValidation:rule="!has(self.externalTrafficPolicy) || (has(self.type) && self.type == 'LoadBalancer')",message="externalTrafficPolicy may only be set when type is LoadBalancer"
+type ServiceOverrides struct {
+	// Type is the type of the Service. LoadBalancer exposes the Service outside the cluster through the cloud
+	// provider's load balancer.
+	// Default: ClusterIP
+	// +kubebuilder:validation:Enum=ClusterIP;LoadBalancer
+	// +optional
+	Type *corev1.ServiceType `json:"type,omitempty"`
+
+	// Annotations are added to the Service, for example to configure the load balancer that the cloud provider
+	// provisions for a LoadBalancer Service.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// ExternalTrafficPolicy controls whether traffic from outside the cluster is routed to endpoints on any node
+	// (Cluster) or only to endpoints on the node that received it (Local). May only be set when Type is
+	// LoadBalancer.
+	// +kubebuilder:validation:Enum=Cluster;Local
+	// +optional
+	ExternalTrafficPolicy *corev1.ServiceExternalTrafficPolicy `json:"externalTrafficPolicy,omitempty"`
+
+	// InternalTrafficPolicy controls whether traffic from within the cluster is routed to endpoints on any node
+	// (Cluster) or only to endpoints on the same node as the client (Local).
+	// +kubebuilder:validation:Enum=Cluster;Local
+	// +optional
+	InternalTrafficPolicy *corev1.ServiceInternalTrafficPolicy `json:"internalTrafficPolicy,omitempty"`
+
+	// SessionAffinity routes the connections of a client to the same endpoint when set to ClientIP.
+	// Default: None
+	// +kubebuilder:validation:Enum=None;ClientIP
+	// +optional
+	SessionAffinity *corev1.ServiceAffinity `json:"sessionAffinity,omitempty"`
+}
+
 // +kubebuilder:validation:Enum=Error;Warning;Info;Debug
 type LogLevel string
 
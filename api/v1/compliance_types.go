@@ -57,6 +57,11 @@ type ComplianceStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Whether the component is running and stable."
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type=='Progressing')].status",description="Whether the component is processing changes."
+// +kubebuilder:printcolumn:name="Degraded",type="string",JSONPath=".status.conditions[?(@.type=='Degraded')].status",description="Whether the component is degraded."
+// +kubebuilder:printcolumn:name="Observed Generation",type="integer",JSONPath=".status.conditions[?(@.type=='Ready')].observedGeneration",description="The generation of the resource that the Available status reflects.",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Compliance installs the components required for Tigera compliance reporting. At most one instance
 // of this resource is supported. It must be named "tigera-secure".
@@ -22,6 +22,9 @@ import (
 
 // FluentdDaemonSet is the configuration for the Fluentd DaemonSet.
 type FluentdDaemonSet struct {
+	// Metadata is a subset of a Kubernetes object's metadata that is added to the DaemonSet.
+	// +optional
+	Metadata *Metadata `json:"metadata,omitempty"`
 
 	// Spec is the specification of the Fluentd DaemonSet.
 	// +optional
@@ -30,6 +33,14 @@ type FluentdDaemonSet struct {
 
 // FluentdDaemonSetSpec defines configuration for the Fluentd DaemonSet.
 type FluentdDaemonSetSpec struct {
+	// MinReadySeconds is the minimum number of seconds for which a newly created DaemonSet pod should
+	// be ready without any of its container crashing, for it to be considered available.
+	// If specified, this overrides any minReadySeconds value that may be set on the Fluentd DaemonSet.
+	// If omitted, the Fluentd DaemonSet will use its default value for minReadySeconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2147483647
+	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
 
 	// Template describes the Fluentd DaemonSet pod that will be created.
 	// +optional
@@ -38,6 +49,10 @@ type FluentdDaemonSetSpec struct {
 
 // FluentdDaemonSetPodTemplateSpec is the Fluentd DaemonSet's PodTemplateSpec
 type FluentdDaemonSetPodTemplateSpec struct {
+	// Metadata is a subset of a Kubernetes object's metadata that is added to
+	// the pod's metadata.
+	// +optional
+	Metadata *Metadata `json:"metadata,omitempty"`
 
 	// Spec is the Fluentd DaemonSet's PodSpec.
 	// +optional
@@ -57,6 +72,46 @@ type FluentdDaemonSetPodSpec struct {
 	// If omitted, the Fluentd DaemonSet will use its default values for its containers.
 	// +optional
 	Containers []FluentdDaemonSetContainer `json:"containers,omitempty"`
+
+	// Affinity is a group of affinity scheduling rules for the Fluentd pods.
+	// If specified, this overrides any affinity that may be set on the Fluentd DaemonSet.
+	// If omitted, the Fluentd DaemonSet will use its default value for affinity.
+	// WARNING: Please note that this field will override the default Fluentd DaemonSet affinity.
+	// +optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+
+	// NodeSelector is the Fluentd pod's scheduling constraints.
+	// If specified, each of the key/value pairs are added to the Fluentd DaemonSet nodeSelector provided
+	// the key does not already exist in the object's nodeSelector.
+	// If omitted, the Fluentd DaemonSet will use its default value for nodeSelector.
+	// WARNING: Please note that this field will modify the default Fluentd DaemonSet nodeSelector.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations is the Fluentd pod's tolerations.
+	// If specified, this overrides any tolerations that may be set on the Fluentd DaemonSet.
+	// If omitted, the Fluentd DaemonSet will use its default value for tolerations.
+	// WARNING: Please note that this field will override the default Fluentd DaemonSet tolerations.
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// PriorityClassName allows to specify a PriorityClass resource to be used.
+	// If omitted, the Fluentd DaemonSet uses the system-node-critical priority class.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// RuntimeClassName is the name of the RuntimeClass to run the Fluentd pods with, for example to pin them to the
+	// standard runtime on clusters that run untrusted workloads with a sandboxed runtime. The RuntimeClass must exist.
+	// If omitted, the Fluentd pods use the cluster's default runtime.
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+
+	// ImagePullPolicy is the pull policy applied to all containers of the Fluentd pods.
+	// If specified, this takes precedence over the ImagePullPolicy of the Installation.
+	// If omitted, the Fluentd pods use the ImagePullPolicy of the Installation.
+	// +optional
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	ImagePullPolicy *v1.PullPolicy `json:"imagePullPolicy,omitempty"`
 }
 
 // FluentdDaemonSetContainer is a Fluentd DaemonSet container.
@@ -81,6 +136,17 @@ type FluentdDaemonSetContainer struct {
 	// The probe handler is set by the operator and cannot be overridden.
 	// +optional
 	LivenessProbe *ProbeOverride `json:"livenessProbe,omitempty"`
+
+	// SeccompProfile overrides the seccomp profile in the container's security context.
+	// +optional
+	SeccompProfile *v1.SeccompProfile `json:"seccompProfile,omitempty"`
+
+	// AppArmorProfile is the AppArmor profile to run the container with. It must be one of runtime/default,
+	// unconfined, or localhost/<profile>, and is applied using the container.apparmor.security.beta.kubernetes.io
+	// pod annotation.
+	// +kubebuilder:validation:Pattern=`^(runtime/default|unconfined|localhost/.+)$`
+	// +optional
+	AppArmorProfile string `json:"appArmorProfile,omitempty"`
 }
 
 // FluentdDaemonSetInitContainer is a Fluentd DaemonSet init container.
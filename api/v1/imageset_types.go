@@ -40,6 +40,23 @@ type Image struct {
 	// Digest is the image identifier that will be used for the Image.
 	// The field should not include a leading `@` and must be prefixed with `sha256:`.
 	Digest string `json:"digest"`
+
+	// Architectures lists the architectures that the image identified by Digest is available for, along with
+	// the digest of the image for each architecture. When set, the pods that use the image are only scheduled
+	// on nodes of these architectures. Leave it empty for images that are available for every architecture
+	// of the nodes in the cluster.
+	// +optional
+	Architectures []ImageArchitecture `json:"architectures,omitempty"`
+}
+
+type ImageArchitecture struct {
+	// Architecture is the architecture as reported by the kubernetes.io/arch label of nodes, for example
+	// amd64 or arm64.
+	Architecture string `json:"architecture"`
+
+	// Digest is the identifier of the image for the architecture.
+	// The field should not include a leading `@` and must be prefixed with `sha256:`.
+	Digest string `json:"digest"`
 }
 
 // +kubebuilder:object:root=true
@@ -19,6 +19,7 @@ package v1
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -29,6 +30,11 @@ import (
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Whether the component is running and stable."
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type=='Progressing')].status",description="Whether the component is processing changes."
+// +kubebuilder:printcolumn:name="Degraded",type="string",JSONPath=".status.conditions[?(@.type=='Degraded')].status",description="Whether the component is degraded."
+// +kubebuilder:printcolumn:name="Observed Generation",type="integer",JSONPath=".status.conditions[?(@.type=='Ready')].observedGeneration",description="The generation of the resource that the Available status reflects.",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Installation configures an installation of Calico or Calico Enterprise. At most one instance
 // of this resource is supported. It must be named "default". The Installation API installs core networking
@@ -92,6 +98,13 @@ type InstallationSpec struct {
 	// +optional
 	ImagePrefix string `json:"imagePrefix,omitempty"`
 
+	// ComponentImages overrides the registry and image path of individual images, for example when only some of
+	// the images are mirrored to a private registry. Images that are not listed use Registry and ImagePath.
+	// +optional
+	// +listType=map
+	// +listMapKey=image
+	ComponentImages []ComponentImage `json:"componentImages,omitempty"`
+
 	// ImagePullSecrets is an array of references to container registry pull secrets to use. These are
 	// applied to all images to be pulled.
 	// +optional
@@ -105,6 +118,28 @@ type InstallationSpec struct {
 	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
 	ImagePullPolicy *v1.PullPolicy `json:"imagePullPolicy,omitempty"`
 
+	// ImageVerification configures the operator to render a sigstore ClusterImagePolicy that requires the images
+	// of the Calico components to be signed with the given cosign key. Enforcement is done by the sigstore
+	// policy-controller, which must be installed separately; the policy is only rendered once its CRD exists.
+	// Images are pinned by digest while verification is enabled, so either an ImageSet for the release or
+	// ImageResolution Digest is required.
+	// +optional
+	ImageVerification *ImageVerification `json:"imageVerification,omitempty"`
+
+	// ImageResolution controls how the images of the components are referenced. With Digest, the operator looks up
+	// the digest that the tag of each image points to in its registry, using ImagePullSecrets, and pins the
+	// components to it. Digests are looked up once and cached for the lifetime of the operator. An ImageSet, if
+	// one exists, takes precedence. Default: Tag
+	// +optional
+	ImageResolution *ImageResolution `json:"imageResolution,omitempty"`
+
+	// ComponentPolicyMode controls whether the network policies that the operator renders in the calico-system tier
+	// to secure the Calico components are enforced. With Staged, they are rendered as staged network policies
+	// instead, so that their effect can be reviewed in flow logs before they are enforced. Only supported by the
+	// CalicoEnterprise variant. Default: Enforced
+	// +optional
+	ComponentPolicyMode *ComponentPolicyMode `json:"componentPolicyMode,omitempty"`
+
 	// KubernetesProvider specifies a particular provider of the Kubernetes platform and enables provider-specific configuration.
 	// If the specified value is empty, the Operator will attempt to automatically determine the current provider.
 	// If the specified value is not empty, the Operator will still attempt auto-detection, but
@@ -151,6 +186,47 @@ type InstallationSpec struct {
 	// +optional
 	TyphaMetricsPort *int32 `json:"typhaMetricsPort,omitempty"`
 
+	// TyphaMetricsTLS specifies whether calico/typha serves prometheus metrics over TLS using a key pair issued by
+	// the operator. Requires TyphaMetricsPort to be set. Default: Disabled
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	TyphaMetricsTLS *TyphaMetricsTLSMode `json:"typhaMetricsTLS,omitempty"`
+
+	// TyphaMetricsAuth specifies whether clients of the calico/typha prometheus metrics must authenticate. TokenReview
+	// serves the metrics over TLS through a kube-rbac-proxy sidecar, which only allows clients with a bearer token
+	// that is authorized to get the /metrics non-resource URL. Requires TyphaMetricsPort to be set, and implies
+	// TyphaMetricsTLS. Default: None
+	// +kubebuilder:validation:Enum=None;TokenReview
+	// +optional
+	TyphaMetricsAuth *MetricsAuthMode `json:"typhaMetricsAuth,omitempty"`
+
+	// TyphaMetricsUpstreamPort is the loopback port that calico/typha serves prometheus metrics on for the
+	// kube-rbac-proxy sidecar when TyphaMetricsAuth is TokenReview. Typha runs on the host network, so the port must
+	// be free on every node. Default: 9096
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	TyphaMetricsUpstreamPort *int32 `json:"typhaMetricsUpstreamPort,omitempty"`
+
+	// MetricsRemoteWrite configures a Prometheus agent that scrapes the calico/node and calico/typha metrics enabled
+	// by NodeMetricsPort and TyphaMetricsPort and remote-writes them to an external endpoint, for clusters that do
+	// not run the Prometheus operator. Requires NodeMetricsPort or TyphaMetricsPort to be set.
+	// +optional
+	MetricsRemoteWrite *MetricsRemoteWrite `json:"metricsRemoteWrite,omitempty"`
+
+	// RBACMode controls which permissions the operator grants to calico/typha. Typha is always granted read access to
+	// every resource that its syncers watch. Full also grants the write access to IPAM resources and to the status of
+	// pods and nodes that calico/typha shares with calico/node. Minimal leaves that write access out. Default: Full
+	// +kubebuilder:validation:Enum=Minimal;Full
+	// +optional
+	RBACMode *RBACMode `json:"rbacMode,omitempty"`
+
+	// VerticalPodAutoscaling, if specified, renders VerticalPodAutoscalers for calico/typha and fluentd so that their
+	// resource requests track their actual usage. VerticalPodAutoscalers are only rendered once the
+	// VerticalPodAutoscaler CRDs are installed in the cluster.
+	// +optional
+	VerticalPodAutoscaling *VerticalPodAutoscaling `json:"verticalPodAutoscaling,omitempty"`
+
 	// FlexVolumePath optionally specifies a custom path for FlexVolume. If not specified, FlexVolume will be
 	// enabled by default. If set to 'None', FlexVolume will be disabled. The default is based on the
 	// kubernetesProvider.
@@ -180,10 +256,30 @@ type InstallationSpec struct {
 	// +optional
 	CertificateManagement *CertificateManagement `json:"certificateManagement,omitempty"`
 
+	// CertManager configures the operator to request the key pairs of the API server, typha and fluentd from
+	// cert-manager, by creating a cert-manager Certificate for each of them in the operator's namespace, instead of
+	// signing them with the operator's CA. Until cert-manager has issued a key pair, an operator signed key pair is
+	// used. The operator uses the secrets that cert-manager issues and rolls out renewed certificates.
+	// The Certificates and their secrets are left in place when this is removed; delete them to have the operator
+	// sign the key pairs again. This cannot be combined with CertificateManagement.
+	// +optional
+	CertManager *CertManager `json:"certManager,omitempty"`
+
+	// CertificateRotation configures the lifetime of certificates issued by the operator and when they are
+	// renewed. Certificates are rotated automatically before they expire, after which the components that use
+	// them are restarted to pick up the new key pair.
+	// +optional
+	CertificateRotation *CertificateRotation `json:"certificateRotation,omitempty"`
+
 	// TLSCipherSuites defines the cipher suite list that the TLS protocol should use during secure communication.
 	// +optional
 	TLSCipherSuites TLSCipherSuites `json:"tlsCipherSuites,omitempty"`
 
+	// TLSSecurityProfile configures the minimum TLS version and the cipher suites of the TLS servers of calico-apiserver,
+	// the query server, typha and fluentd. When set, it takes precedence over TLSCipherSuites for those servers.
+	// +optional
+	TLSSecurityProfile *TLSSecurityProfile `json:"tlsSecurityProfile,omitempty"`
+
 	// Deprecated. NonPrivileged is deprecated and will be removed from the API in a future release.
 	// Enabling this field is not supported and will cause errors.
 	// NonPrivileged configures Calico to be run in non-privileged containers as non-root users where possible.
@@ -209,6 +305,16 @@ type InstallationSpec struct {
 	// +optional
 	TyphaDeployment *TyphaDeployment `json:"typhaDeployment,omitempty"`
 
+	// TyphaConfiguration tunes how typha manages its client connections. Large clusters may need to raise
+	// connection limits or adjust how quickly connections are rebalanced across typha instances.
+	// +optional
+	TyphaConfiguration *TyphaConfiguration `json:"typhaConfiguration,omitempty"`
+
+	// TyphaService configures the calico-typha Service, for example to keep the connections of calico-node to
+	// the typha instances on its own node with an InternalTrafficPolicy of Local.
+	// +optional
+	TyphaService *ServiceOverrides `json:"typhaService,omitempty"`
+
 	// Deprecated. The CalicoWindowsUpgradeDaemonSet is deprecated and will be removed from the API in the future.
 	// CalicoWindowsUpgradeDaemonSet configures the calico-windows-upgrade DaemonSet.
 	CalicoWindowsUpgradeDaemonSet *CalicoWindowsUpgradeDaemonSet `json:"calicoWindowsUpgradeDaemonSet,omitempty"`
@@ -217,12 +323,30 @@ type InstallationSpec struct {
 	CalicoNodeWindowsDaemonSet *CalicoNodeWindowsDaemonSet `json:"calicoNodeWindowsDaemonSet,omitempty"`
 
 	// FIPSMode uses images and features only that are using FIPS 140-2 validated cryptographic modules and standards.
-	// Only supported for Variant=Calico.
+	// For Variant=TigeraSecureEnterprise, FIPS images are used for the components built from the combined calico image
+	// (such as typha, the API server and the query server) and for fluentd. The Windows components have no FIPS
+	// images, so FIPS mode is not supported when Calico for Windows is enabled.
 	// Default: Disabled
 	// +kubebuilder:validation:Enum=Enabled;Disabled
 	// +optional
 	FIPSMode *FIPSMode `json:"fipsMode,omitempty"`
 
+	// ManagedPriorityClasses configures the operator to create and own dedicated PriorityClasses, calico-data-plane
+	// and calico-control-plane, and to assign them to typha, the API server and fluentd instead of the built-in
+	// system-node-critical and system-cluster-critical PriorityClasses. This allows those components to be
+	// scheduled without a ResourceQuota for the system PriorityClasses in their namespaces.
+	// Default: Disabled
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	ManagedPriorityClasses *ManagedPriorityClassesType `json:"managedPriorityClasses,omitempty"`
+
+	// PodDisruptionBudgets configures whether the operator creates PodDisruptionBudgets for typha and the API server.
+	// On single-node clusters the budgets prevent the node from ever being drained, so they should be disabled there.
+	// Default: Enabled
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	PodDisruptionBudgets *PodDisruptionBudgetsType `json:"podDisruptionBudgets,omitempty"`
+
 	// Logging Configuration for Components
 	// +optional
 	Logging *Logging `json:"logging,omitempty"`
@@ -244,6 +368,101 @@ type InstallationSpec struct {
 	// the cluster (including the API server) are exempt from proxying.
 	// +optional
 	Proxy *Proxy `json:"proxy,omitempty"`
+
+	// NamespacePodSecurityStandards forces the pod security standard enforced on operator-managed namespaces. By
+	// default, each namespace is labeled with the least restrictive level its components need to run. If a level
+	// is forced that is more restrictive than the components in the namespace can run under, the operator reports
+	// them as degraded rather than deploying them.
+	// +optional
+	// +listType=map
+	// +listMapKey=namespace
+	NamespacePodSecurityStandards []NamespacePodSecurityStandard `json:"namespacePodSecurityStandards,omitempty"`
+}
+
+// ComponentImage overrides where an image is pulled from.
+type ComponentImage struct {
+	// Image is the original name of the image without registry, tag or digest, as it is specified in an ImageSet.
+	// For the image `docker.io/calico/node:v3.17.1` it should be `calico/node`.
+	Image string `json:"image"`
+
+	// Registry is the registry to pull the image from, in place of the Installation's registry.
+	// +optional
+	Registry string `json:"registry,omitempty"`
+
+	// ImagePath is the image path to use for the image, in place of the Installation's imagePath.
+	// +optional
+	ImagePath string `json:"imagePath,omitempty"`
+}
+
+// ImageResolution is how the images of the components are referenced.
+// +kubebuilder:validation:Enum=Tag;Digest
+type ImageResolution string
+
+const (
+	// ImageResolutionTag references images by the tag of the release.
+	ImageResolutionTag ImageResolution = "Tag"
+	// ImageResolutionDigest references images by the digest that the tag of the release points to.
+	ImageResolutionDigest ImageResolution = "Digest"
+)
+
+// ComponentPolicyMode is how the network policies of the Calico components are rendered.
+// +kubebuilder:validation:Enum=Enforced;Staged
+type ComponentPolicyMode string
+
+const (
+	// ComponentPolicyModeEnforced renders the policies as network policies that are enforced.
+	ComponentPolicyModeEnforced ComponentPolicyMode = "Enforced"
+	// ComponentPolicyModeStaged renders the policies as staged network policies that are only reported on.
+	ComponentPolicyModeStaged ComponentPolicyMode = "Staged"
+)
+
+// ImageVerificationMode is the action taken when an image fails signature verification.
+// +kubebuilder:validation:Enum=Enforce;Warn
+type ImageVerificationMode string
+
+const (
+	// ImageVerificationModeEnforce rejects pods with images that fail verification.
+	ImageVerificationModeEnforce ImageVerificationMode = "Enforce"
+	// ImageVerificationModeWarn admits pods with images that fail verification, with a warning.
+	ImageVerificationModeWarn ImageVerificationMode = "Warn"
+)
+
+// ImageVerification configures signature verification of the images of the Calico components.
+type ImageVerification struct {
+	// PublicKey is the PEM encoded cosign public key that the images are signed with.
+	// +kubebuilder:validation:MinLength=1
+	PublicKey string `json:"publicKey"`
+
+	// Mode is the action taken when an image fails verification. Default: Enforce
+	// +optional
+	Mode *ImageVerificationMode `json:"mode,omitempty"`
+}
+
+// VerificationMode returns the configured mode, defaulting to Enforce.
+func (v *ImageVerification) VerificationMode() ImageVerificationMode {
+	if v == nil || v.Mode == nil {
+		return ImageVerificationModeEnforce
+	}
+	return *v.Mode
+}
+
+// PodSecurityStandardLevel is a level of the Kubernetes pod security standards.
+// +kubebuilder:validation:Enum=Privileged;Baseline;Restricted
+type PodSecurityStandardLevel string
+
+const (
+	PodSecurityStandardPrivileged PodSecurityStandardLevel = "Privileged"
+	PodSecurityStandardBaseline   PodSecurityStandardLevel = "Baseline"
+	PodSecurityStandardRestricted PodSecurityStandardLevel = "Restricted"
+)
+
+// NamespacePodSecurityStandard is the pod security standard to enforce on an operator-managed namespace.
+type NamespacePodSecurityStandard struct {
+	// Namespace is the name of the operator-managed namespace.
+	Namespace string `json:"namespace"`
+
+	// Level is the pod security standard to enforce on the namespace.
+	Level PodSecurityStandardLevel `json:"level"`
 }
 
 // BPFNetworkBootstrapType defines how the initial networking configuration is executed.
@@ -309,6 +528,111 @@ func (s TLSCipherSuites) ToString() string {
 	return strings.Join(names, ",")
 }
 
+// TLSSecurityProfile selects the TLS settings of the rendered servers, modeled after the TLS security profiles of
+// OpenShift.
+// +kubebuilder:validation:XValidation:rule="self.type == 'Custom' ? has(self.custom) : !has(self.custom)",message="custom must be set if and only if type is Custom"
+type TLSSecurityProfile struct {
+	// Type is the profile to use. Intermediate requires TLS 1.2 and restricts the TLS 1.2 cipher suites to those with
+	// forward secrecy and AEAD encryption. Modern requires TLS 1.3. Custom uses the settings of the custom field.
+	// +kubebuilder:validation:Enum=Intermediate;Modern;Custom
+	Type TLSProfileType `json:"type"`
+
+	// Custom configures the TLS settings when Type is Custom.
+	// +optional
+	Custom *CustomTLSProfile `json:"custom,omitempty"`
+}
+
+type TLSProfileType string
+
+const (
+	TLSProfileIntermediateType TLSProfileType = "Intermediate"
+	TLSProfileModernType       TLSProfileType = "Modern"
+	TLSProfileCustomType       TLSProfileType = "Custom"
+)
+
+type CustomTLSProfile struct {
+	// Ciphers is the list of cipher suites the servers accept. Go does not allow the TLS 1.3 cipher suites to be
+	// configured, so they are always enabled when TLS 1.3 is negotiated.
+	// +optional
+	Ciphers []TLSCipher `json:"ciphers,omitempty"`
+
+	// MinTLSVersion is the minimum version of TLS the servers accept.
+	// +kubebuilder:validation:Enum=VersionTLS12;VersionTLS13
+	MinTLSVersion TLSProtocolVersion `json:"minTLSVersion"`
+}
+
+type TLSProtocolVersion string
+
+const (
+	VersionTLS12 TLSProtocolVersion = "VersionTLS12"
+	VersionTLS13 TLSProtocolVersion = "VersionTLS13"
+)
+
+// Number returns the version in the "1.2" form accepted by the TLS_MIN_VERSION environment variable of the
+// components.
+func (v TLSProtocolVersion) Number() string {
+	if v == VersionTLS13 {
+		return "1.3"
+	}
+	return "1.2"
+}
+
+// intermediateTLSCiphers are the cipher suites of the Intermediate profile.
+var intermediateTLSCiphers = []TLSCipher{
+	TLS_AES_128_GCM_SHA256,
+	TLS_AES_256_GCM_SHA384,
+	TLS_CHACHA20_POLY1305_SHA256,
+	TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+	TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+	TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+	TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
+}
+
+// modernTLSCiphers are the cipher suites of the Modern profile.
+var modernTLSCiphers = []TLSCipher{
+	TLS_AES_128_GCM_SHA256,
+	TLS_AES_256_GCM_SHA384,
+	TLS_CHACHA20_POLY1305_SHA256,
+}
+
+// MinTLSVersion returns the minimum TLS version of the profile.
+func (p *TLSSecurityProfile) MinTLSVersion() TLSProtocolVersion {
+	switch p.Type {
+	case TLSProfileModernType:
+		return VersionTLS13
+	case TLSProfileCustomType:
+		if p.Custom != nil && p.Custom.MinTLSVersion != "" {
+			return p.Custom.MinTLSVersion
+		}
+	}
+	return VersionTLS12
+}
+
+// Ciphers returns the cipher suites of the profile.
+func (p *TLSSecurityProfile) Ciphers() []TLSCipher {
+	switch p.Type {
+	case TLSProfileModernType:
+		return modernTLSCiphers
+	case TLSProfileCustomType:
+		if p.Custom != nil {
+			return p.Custom.Ciphers
+		}
+		return nil
+	}
+	return intermediateTLSCiphers
+}
+
+// CipherNames returns a comma-separated string of the names of the cipher suites of the profile.
+func (p *TLSSecurityProfile) CipherNames() string {
+	names := make([]string, 0, len(p.Ciphers()))
+	for _, c := range p.Ciphers() {
+		names = append(names, c.String())
+	}
+	return strings.Join(names, ",")
+}
+
 type Azure struct {
 	// PolicyMode determines whether the "control-plane" label is applied to namespaces. It offers two options: Default and Manual.
 	// The Default option adds the "control-plane" label to the required namespaces.
@@ -358,6 +682,176 @@ const (
 	FIPSModeDisabled FIPSMode = "Disabled"
 )
 
+// ManagedPriorityClassesType specifies whether the operator creates and assigns its own PriorityClasses.
+//
+// One of: Enabled, Disabled
+type ManagedPriorityClassesType string
+
+const (
+	ManagedPriorityClassesEnabled  ManagedPriorityClassesType = "Enabled"
+	ManagedPriorityClassesDisabled ManagedPriorityClassesType = "Disabled"
+)
+
+// PodDisruptionBudgetsType specifies whether the operator creates PodDisruptionBudgets for its components.
+//
+// One of: Enabled, Disabled
+type PodDisruptionBudgetsType string
+
+const (
+	PodDisruptionBudgetsEnabled  PodDisruptionBudgetsType = "Enabled"
+	PodDisruptionBudgetsDisabled PodDisruptionBudgetsType = "Disabled"
+)
+
+// TyphaMetricsTLSMode specifies whether typha serves prometheus metrics over TLS.
+//
+// One of: Enabled, Disabled
+type TyphaMetricsTLSMode string
+
+const (
+	TyphaMetricsTLSEnabled  TyphaMetricsTLSMode = "Enabled"
+	TyphaMetricsTLSDisabled TyphaMetricsTLSMode = "Disabled"
+)
+
+// MetricsAuthMode specifies whether clients of a prometheus metrics endpoint must authenticate.
+//
+// One of: None, TokenReview
+type MetricsAuthMode string
+
+const (
+	MetricsAuthNone        MetricsAuthMode = "None"
+	MetricsAuthTokenReview MetricsAuthMode = "TokenReview"
+)
+
+// RBACMode specifies how the operator generates the RBAC rules of calico/typha.
+//
+// One of: Minimal, Full
+type RBACMode string
+
+const (
+	RBACModeMinimal RBACMode = "Minimal"
+	RBACModeFull    RBACMode = "Full"
+)
+
+// VPAUpdateMode specifies how a VerticalPodAutoscaler applies its recommendations.
+//
+// One of: Off, Initial, Recreate, InPlaceOrRecreate
+type VPAUpdateMode string
+
+const (
+	VPAUpdateModeOff               VPAUpdateMode = "Off"
+	VPAUpdateModeInitial           VPAUpdateMode = "Initial"
+	VPAUpdateModeRecreate          VPAUpdateMode = "Recreate"
+	VPAUpdateModeInPlaceOrRecreate VPAUpdateMode = "InPlaceOrRecreate"
+)
+
+// VerticalPodAutoscaling configures the VerticalPodAutoscalers the operator renders for its components.
+type VerticalPodAutoscaling struct {
+	// UpdateMode controls how the recommended resource requests are applied. Off only computes recommendations,
+	// Initial applies them when pods are created, Recreate also evicts pods whose requests differ significantly from
+	// the recommendation, and InPlaceOrRecreate resizes pods in place where possible.
+	// Default: Initial
+	// +kubebuilder:validation:Enum=Off;Initial;Recreate;InPlaceOrRecreate
+	// +optional
+	UpdateMode *VPAUpdateMode `json:"updateMode,omitempty"`
+}
+
+// GetUpdateMode returns the configured update mode, or the default if it is not set.
+func (v *VerticalPodAutoscaling) GetUpdateMode() VPAUpdateMode {
+	if v == nil || v.UpdateMode == nil {
+		return VPAUpdateModeInitial
+	}
+	return *v.UpdateMode
+}
+
+// MetricsRemoteWrite configures the Prometheus agent that remote-writes the calico/node and calico/typha metrics.
+// The secrets it references must exist in the tigera-operator namespace; they are copied to the calico-system
+// namespace, where the agent runs.
+type MetricsRemoteWrite struct {
+	// URL is the remote-write endpoint that metrics are sent to.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// ScrapeInterval is how often the metrics are scraped. Default: 30s
+	// +optional
+	ScrapeInterval *metav1.Duration `json:"scrapeInterval,omitempty"`
+
+	// TLSSecretName is the name of a secret with the TLS configuration for the endpoint: the ca.crt key, to verify
+	// the certificate of the endpoint, and the tls.crt and tls.key keys, to authenticate with a client
+	// certificate. All keys are optional.
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+
+	// BasicAuthSecretName is the name of a secret with the username and password keys to authenticate to the
+	// endpoint with. Mutually exclusive with BearerTokenSecretName.
+	// +optional
+	BasicAuthSecretName string `json:"basicAuthSecretName,omitempty"`
+
+	// BearerTokenSecretName is the name of a secret with the token key to authenticate to the endpoint with.
+	// Mutually exclusive with BasicAuthSecretName.
+	// +optional
+	BearerTokenSecretName string `json:"bearerTokenSecretName,omitempty"`
+}
+
+// GetScrapeInterval returns the configured scrape interval, defaulting to 30s.
+func (m *MetricsRemoteWrite) GetScrapeInterval() time.Duration {
+	if m == nil || m.ScrapeInterval == nil {
+		return 30 * time.Second
+	}
+	return m.ScrapeInterval.Duration
+}
+
+// TyphaConfiguration configures typha's handling of client connections.
+type TyphaConfiguration struct {
+	// MaxConnectionsUpperLimit is the maximum number of client connections that a single typha will accept.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConnectionsUpperLimit *int32 `json:"maxConnectionsUpperLimit,omitempty"`
+
+	// ConnectionRebalancingMode controls whether typha sheds connections to rebalance load when the number
+	// of typha instances changes. When Kubernetes, typha uses the Kubernetes API to determine its fair share of
+	// connections; when None, connections are never rebalanced.
+	// Default: Kubernetes
+	// +kubebuilder:validation:Enum=Kubernetes;None
+	// +optional
+	ConnectionRebalancingMode *TyphaConnectionRebalancingMode `json:"connectionRebalancingMode,omitempty"`
+
+	// ConnectionRebalancingIntervalSeconds is how often typha polls the Kubernetes API to recalculate its fair
+	// share of connections. Only applies when ConnectionRebalancingMode is Kubernetes.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ConnectionRebalancingIntervalSeconds *int32 `json:"connectionRebalancingIntervalSeconds,omitempty"`
+
+	// ConnectionDropIntervalSeconds is the minimum interval between connections dropped by typha while it
+	// rebalances.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ConnectionDropIntervalSeconds *int32 `json:"connectionDropIntervalSeconds,omitempty"`
+
+	// ShutdownMaxDropIntervalSeconds is the maximum interval between connections dropped by typha while it
+	// shuts down. Typha drops connections faster than this if needed to finish within its shutdown timeout.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ShutdownMaxDropIntervalSeconds *int32 `json:"shutdownMaxDropIntervalSeconds,omitempty"`
+
+	// RolloutPauseThresholdPercent pauses a typha Deployment rollout while more than this percentage of the client
+	// connections accepted by up-level typha pods are dropped again, and resumes the rollout once the rate falls back
+	// below the threshold. Requires TyphaMetricsPort to be set. If omitted, typha rollouts are never paused.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	RolloutPauseThresholdPercent *int32 `json:"rolloutPauseThresholdPercent,omitempty"`
+}
+
+// TyphaConnectionRebalancingMode specifies how typha rebalances client connections.
+//
+// One of: Kubernetes, None
+type TyphaConnectionRebalancingMode string
+
+const (
+	TyphaConnectionRebalancingModeKubernetes TyphaConnectionRebalancingMode = "Kubernetes"
+	TyphaConnectionRebalancingModeNone       TyphaConnectionRebalancingMode = "None"
+)
+
 // Deprecated. Please use TyphaDeployment instead.
 // TyphaAffinity allows configuration of node affinity characteristics for Typha pods.
 type TyphaAffinity struct {
@@ -1061,6 +1555,48 @@ func (s *InstallationSpec) BPFEnabled() bool {
 		*s.CalicoNetwork.LinuxDataplane == LinuxDataplaneBPF
 }
 
+// TyphaMetricsTLSEnabled is an extension method that returns true if typha prometheus metrics are enabled and
+// served over TLS, either by typha itself or by the kube-rbac-proxy in front of it.
+func (s *InstallationSpec) TyphaMetricsTLSEnabled() bool {
+	if s.TyphaMetricsAuthEnabled() {
+		return true
+	}
+	return s.TyphaMetricsPort != nil && s.TyphaMetricsTLS != nil && *s.TyphaMetricsTLS == TyphaMetricsTLSEnabled
+}
+
+// TyphaMetricsAuthEnabled is an extension method that returns true if typha prometheus metrics are enabled and
+// served through a kube-rbac-proxy that authorizes each request with a TokenReview and SubjectAccessReview.
+func (s *InstallationSpec) TyphaMetricsAuthEnabled() bool {
+	return s.TyphaMetricsPort != nil && s.TyphaMetricsAuth != nil && *s.TyphaMetricsAuth == MetricsAuthTokenReview
+}
+
+// GetTyphaMetricsUpstreamPort returns the loopback port typha serves prometheus metrics on when they are served through
+// a kube-rbac-proxy, defaulting to 9096.
+func (s *InstallationSpec) GetTyphaMetricsUpstreamPort() int32 {
+	if s.TyphaMetricsUpstreamPort == nil {
+		return 9096
+	}
+	return *s.TyphaMetricsUpstreamPort
+}
+
+// MinimalRBACEnabled is an extension method that returns true if calico/typha is not granted the write access that
+// it does not use.
+func (s *InstallationSpec) MinimalRBACEnabled() bool {
+	return s.RBACMode != nil && *s.RBACMode == RBACModeMinimal
+}
+
+// ManagedPriorityClassesEnabled is an extension method that returns true if the operator creates and assigns its
+// own PriorityClasses.
+func (s *InstallationSpec) ManagedPriorityClassesEnabled() bool {
+	return s != nil && s.ManagedPriorityClasses != nil && *s.ManagedPriorityClasses == ManagedPriorityClassesEnabled
+}
+
+// PodDisruptionBudgetsEnabled is an extension method that returns true if the operator creates PodDisruptionBudgets
+// for typha and the API server.
+func (s *InstallationSpec) PodDisruptionBudgetsEnabled() bool {
+	return s == nil || s.PodDisruptionBudgets == nil || *s.PodDisruptionBudgets != PodDisruptionBudgetsDisabled
+}
+
 // IsNftables is an extension method that returns true if the Installation resource
 // has Calico Network Linux Dataplane set and equal to value "Nftables" or "BPF", otherwise false.
 //
@@ -1106,6 +1642,21 @@ func init() {
 	SchemeBuilder.Register(&Installation{}, &InstallationList{})
 }
 
+// CertificateRotation configures how the operator issues and renews the certificates that it signs.
+type CertificateRotation struct {
+	// CertificateDuration is the lifetime of certificates issued by the operator. Existing certificates with a
+	// longer remaining lifetime are reissued.
+	// Default: 19800h (825 days)
+	// +optional
+	CertificateDuration *metav1.Duration `json:"certificateDuration,omitempty"`
+
+	// RenewBefore is how long before expiry the operator replaces a certificate that it has issued. It must be
+	// shorter than CertificateDuration.
+	// Default: 720h (30 days)
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
 // CertificateManagement configures pods to submit a CertificateSigningRequest to the certificates.k8s.io/v1beta1 API in order
 // to obtain TLS certificates. This feature requires that you bring your own CSR signing and approval process, otherwise
 // pods will be stuck during initialization.
@@ -1131,6 +1682,30 @@ type CertificateManagement struct {
 	SignatureAlgorithm string `json:"signatureAlgorithm,omitempty"`
 }
 
+// CertManager configures how cert-manager issues the key pairs of the operator's components.
+type CertManager struct {
+	// IssuerRef references the cert-manager issuer that signs the certificates. An Issuer must be in the namespace
+	// of the operator.
+	IssuerRef CertManagerIssuerReference `json:"issuerRef"`
+}
+
+// CertManagerIssuerReference references a cert-manager issuer.
+type CertManagerIssuerReference struct {
+	// Name is the name of the issuer.
+	Name string `json:"name"`
+
+	// Kind is the kind of the issuer.
+	// Default: Issuer
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Group is the API group of the issuer, for issuers provided by an external cert-manager issuer plugin.
+	// Default: cert-manager.io
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
 // IsFIPSModeEnabled is a convenience function for turning a FIPSMode reference into a bool.
 func IsFIPSModeEnabled(mode *FIPSMode) bool {
 	return mode != nil && *mode == FIPSModeEnabled
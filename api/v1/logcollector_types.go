@@ -17,6 +17,10 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -48,6 +52,291 @@ type LogCollectorSpec struct {
 	// EKSLogForwarderDeployment configures the EKSLogForwarderDeployment Deployment.
 	// +optional
 	EKSLogForwarderDeployment *EKSLogForwarderDeployment `json:"eksLogForwarderDeployment,omitempty"`
+
+	// MetricsPort is the port fluentd serves Prometheus metrics on, such as buffer lengths and output retry
+	// counts. The metrics Service, the ServiceMonitor rendered by the Monitor, and the fluentd network policy
+	// all follow this port.
+	// Default: 9081
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	MetricsPort *int32 `json:"metricsPort,omitempty"`
+
+	// MetricsAuth specifies whether clients of the fluentd prometheus metrics must authenticate. TokenReview serves
+	// the metrics of the Linux fluentd pods through a kube-rbac-proxy sidecar, which only allows clients with a
+	// bearer token that is authorized to get the /metrics non-resource URL. Default: None
+	// +kubebuilder:validation:Enum=None;TokenReview
+	// +optional
+	MetricsAuth *MetricsAuthMode `json:"metricsAuth,omitempty"`
+
+	// CollectorType selects how fluentd is deployed. DaemonSet runs fluentd on every node and forwards flow, DNS
+	// and audit logs. AuditDeployment replaces the node DaemonSet with a small Deployment, co-located with the
+	// Calico API server pods, that only forwards the audit logs the API server writes to its hostPath. This is
+	// intended for clusters that do not collect per-node flow logs but still need to export audit logs. Aggregator
+	// also replaces the node DaemonSet, with a centralized fluentd Deployment that does not mount any host paths and
+	// only consumes logs forwarded to its TLS forward input service. The forwarders must present a client certificate
+	// signed by the operator CA, and their pods must have the logcollector.operator.tigera.io/forwarder label.
+	// Default: DaemonSet
+	// +kubebuilder:validation:Enum=DaemonSet;AuditDeployment;Aggregator
+	// +optional
+	CollectorType *LogCollectorType `json:"collectorType,omitempty"`
+
+	// OperatorLogs configures fluentd to also collect the logs of the tigera-operator pod and forward them, tagged
+	// as operator, to Linseed and the additional stores, so that they are available alongside the other logs when
+	// troubleshooting. The logs are collected by the fluentd DaemonSet on the Linux node that runs the operator, and
+	// are not collected with the AuditDeployment and Aggregator collector types.
+	// Default: Disabled
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	OperatorLogs *OperatorLogsOption `json:"operatorLogs,omitempty"`
+
+	// DeadLetterQueue configures fluentd to write the chunks of logs that it fails to flush to the additional stores,
+	// once their retries are exhausted, to a PersistentVolumeClaim instead of discarding them. The operator also
+	// renders the suspended fluentd-dead-letter-replay CronJob in the tigera-fluentd namespace; once the store
+	// recovers, replay the dead letter queue with kubectl create job --from=cronjob/fluentd-dead-letter-replay.
+	// Only applicable to fluentd on Linux nodes.
+	// +optional
+	DeadLetterQueue *FluentdDeadLetterQueue `json:"deadLetterQueue,omitempty"`
+
+	// FluentdState configures the volume that fluentd keeps its state on: the position files of the logs it tails
+	// and the buffers of the S3 destinations. By default, the state is kept in /var/log/calico/fluentd on the node.
+	// Use an EmptyDir or a PersistentVolumeClaim on nodes whose root filesystem is read-only, or a HostPath in
+	// another directory on nodes that restrict the host paths pods can write to. Only applicable to fluentd on
+	// Linux nodes.
+	// +optional
+	FluentdState *FluentdState `json:"fluentdState,omitempty"`
+
+	// Collection configures which types of logs fluentd collects. Disabling a type of log stops fluentd from reading
+	// it on the nodes, rather than filtering it out downstream, which saves the node CPU and egress it would use.
+	// All types of logs are collected by default.
+	// +optional
+	Collection *LogCollection `json:"collection,omitempty"`
+
+	// InputService configures the fluentd-http-input Service that receives the logs of non-cluster hosts. Only
+	// applicable when a NonClusterHost resource exists.
+	// +optional
+	InputService *ServiceOverrides `json:"inputService,omitempty"`
+
+	// PipelineCanary enables a periodic self-test of the log pipeline. On each run, a synthetic flow log record is
+	// injected through fluentd and read back from the configured stores, and the result is published as the
+	// PipelineHealthy condition in the LogCollector status. Only applicable to fluentd on Linux nodes.
+	// +optional
+	PipelineCanary *LogPipelineCanary `json:"pipelineCanary,omitempty"`
+
+	// Filters configures which flow logs fluentd drops before exporting them, without writing fluentd filter
+	// configuration. The filters apply in addition to those in the fluentd-filters ConfigMap.
+	// +optional
+	Filters *LogCollectorFilters `json:"filters,omitempty"`
+
+	// LinseedFailover configures fluentd on a managed cluster with a secondary Linseed endpoint, such as the Voltron
+	// endpoint of a standby management cluster in another region. Fluentd ships logs to the secondary endpoint while
+	// the management cluster that Guardian is connected to is unreachable. Only applicable to managed clusters.
+	// +optional
+	LinseedFailover *LinseedFailover `json:"linseedFailover,omitempty"`
+}
+
+// LinseedFailover defines the secondary Linseed endpoint of a managed cluster.
+type LinseedFailover struct {
+	// SecondaryEndpoint is the HTTPS URL of the secondary Linseed endpoint. Its certificate must be signed by a CA
+	// in the tigera-ca-bundle, and it must accept the Linseed tokens issued to the managed cluster.
+	// +kubebuilder:validation:Pattern=`^https://`
+	SecondaryEndpoint string `json:"secondaryEndpoint"`
+
+	// RetryPrimaryInterval is how long fluentd ships logs to the secondary endpoint before it tries the primary
+	// endpoint again. Default: 5m
+	// +optional
+	RetryPrimaryInterval *metav1.Duration `json:"retryPrimaryInterval,omitempty"`
+}
+
+// GetRetryPrimaryInterval returns the configured interval, or the default interval if unset.
+func (f *LinseedFailover) GetRetryPrimaryInterval() time.Duration {
+	if f.RetryPrimaryInterval == nil {
+		return 5 * time.Minute
+	}
+	return f.RetryPrimaryInterval.Duration
+}
+
+// LogCollectorFilters defines the flow logs that fluentd drops before exporting them.
+type LogCollectorFilters struct {
+	// ExcludedNamespaces drops the flow logs whose source or destination is in one of these namespaces.
+	// +listType=set
+	// +optional
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// ExcludedPodSelectors drops the flow logs whose source or destination pods match any of these label
+	// selectors. A selector may only use the In and Exists operators, and must not be empty.
+	// +optional
+	ExcludedPodSelectors []metav1.LabelSelector `json:"excludedPodSelectors,omitempty"`
+}
+
+// LogPipelineCanary configures the log pipeline self-test.
+type LogPipelineCanary struct {
+	// Schedule is the cron schedule that the self-test runs on.
+	// Default: */15 * * * *
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+}
+
+// GetSchedule returns the configured schedule of the self-test, or the default schedule if unset.
+func (c *LogPipelineCanary) GetSchedule() string {
+	if c.Schedule == "" {
+		return "*/15 * * * *"
+	}
+	return c.Schedule
+}
+
+// LogCollection enables or disables the collection of each type of log.
+type LogCollection struct {
+	// Flows enables the collection of flow logs.
+	// Default: true
+	// +optional
+	Flows *bool `json:"flows,omitempty"`
+
+	// DNS enables the collection of DNS logs.
+	// Default: true
+	// +optional
+	DNS *bool `json:"dns,omitempty"`
+
+	// L7 enables the collection of L7 logs.
+	// Default: true
+	// +optional
+	L7 *bool `json:"l7,omitempty"`
+
+	// Audit enables the collection of the Calico Enterprise and Kubernetes audit logs. It cannot be disabled with
+	// the AuditDeployment collector type.
+	// Default: true
+	// +optional
+	Audit *bool `json:"audit,omitempty"`
+
+	// BGP enables the collection of BGP logs.
+	// Default: true
+	// +optional
+	BGP *bool `json:"bgp,omitempty"`
+
+	// Runtime enables the collection of runtime security reports.
+	// Default: true
+	// +optional
+	Runtime *bool `json:"runtime,omitempty"`
+}
+
+// FlowsEnabled returns true unless the collection of flow logs is disabled.
+func (c *LogCollection) FlowsEnabled() bool {
+	return c == nil || c.Flows == nil || *c.Flows
+}
+
+// DNSEnabled returns true unless the collection of DNS logs is disabled.
+func (c *LogCollection) DNSEnabled() bool {
+	return c == nil || c.DNS == nil || *c.DNS
+}
+
+// L7Enabled returns true unless the collection of L7 logs is disabled.
+func (c *LogCollection) L7Enabled() bool {
+	return c == nil || c.L7 == nil || *c.L7
+}
+
+// AuditEnabled returns true unless the collection of audit logs is disabled.
+func (c *LogCollection) AuditEnabled() bool {
+	return c == nil || c.Audit == nil || *c.Audit
+}
+
+// BGPEnabled returns true unless the collection of BGP logs is disabled.
+func (c *LogCollection) BGPEnabled() bool {
+	return c == nil || c.BGP == nil || *c.BGP
+}
+
+// RuntimeEnabled returns true unless the collection of runtime security reports is disabled.
+func (c *LogCollection) RuntimeEnabled() bool {
+	return c == nil || c.Runtime == nil || *c.Runtime
+}
+
+// FluentdDeadLetterQueue defines where fluentd writes the chunks of logs it fails to flush.
+type FluentdDeadLetterQueue struct {
+	// PersistentVolumeClaimName is the name of the PersistentVolumeClaim in the tigera-fluentd namespace that the
+	// failed chunks are written to, in a directory per node. It is mounted by the fluentd pod of every node, so it
+	// must support the ReadWriteMany access mode.
+	// +kubebuilder:validation:MinLength=1
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName"`
+}
+
+// FluentdStateVolumeType is the type of volume that fluentd keeps its state on.
+//
+// One of: HostPath, EmptyDir, PersistentVolumeClaim
+// +kubebuilder:validation:Enum=HostPath;EmptyDir;PersistentVolumeClaim
+type FluentdStateVolumeType string
+
+const (
+	FluentdStateVolumeHostPath              FluentdStateVolumeType = "HostPath"
+	FluentdStateVolumeEmptyDir              FluentdStateVolumeType = "EmptyDir"
+	FluentdStateVolumePersistentVolumeClaim FluentdStateVolumeType = "PersistentVolumeClaim"
+)
+
+// FluentdState defines the volume that fluentd keeps its state on.
+// +kubebuilder:validation:XValidation:rule="self.type == 'HostPath' || !has(self.hostPath)",message="hostPath is only allowed with the HostPath type"
+// +kubebuilder:validation:XValidation:rule="(self.type == 'PersistentVolumeClaim') == has(self.persistentVolumeClaimName)",message="persistentVolumeClaimName is required with, and only allowed with, the PersistentVolumeClaim type"
+type FluentdState struct {
+	// Type is the type of volume that fluentd keeps its state on. With HostPath, fluentd resumes from where it left
+	// off when its pod is replaced. With EmptyDir, the state only lasts as long as the pod, so a new pod reads the
+	// logs that remain on the node again. With PersistentVolumeClaim, each node keeps its state in its own
+	// directory of the claim.
+	Type FluentdStateVolumeType `json:"type"`
+
+	// HostPath is the directory on the node that the state is kept in, when the type is HostPath.
+	// Default: /var/log/calico/fluentd
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/.*`
+	HostPath string `json:"hostPath,omitempty"`
+
+	// PersistentVolumeClaimName is the name of the PersistentVolumeClaim in the tigera-fluentd namespace that the
+	// state is kept on, when the type is PersistentVolumeClaim. It is mounted by the fluentd pod of every node, so
+	// it must support the ReadWriteMany access mode.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName,omitempty"`
+}
+
+// LogCollectorPipelineHealthy is the type of the LogCollector status condition that reports the result of the most
+// recent run of the log pipeline self-test.
+const LogCollectorPipelineHealthy = "PipelineHealthy"
+
+// LogCollectorType specifies how fluentd is deployed.
+//
+// One of: DaemonSet, AuditDeployment, Aggregator
+type LogCollectorType string
+
+const (
+	LogCollectorTypeDaemonSet       LogCollectorType = "DaemonSet"
+	LogCollectorTypeAuditDeployment LogCollectorType = "AuditDeployment"
+	LogCollectorTypeAggregator      LogCollectorType = "Aggregator"
+)
+
+// GetCollectorType returns the configured collector type, or DaemonSet if unset.
+func (s *LogCollectorSpec) GetCollectorType() LogCollectorType {
+	if s.CollectorType == nil {
+		return LogCollectorTypeDaemonSet
+	}
+	return *s.CollectorType
+}
+
+// OperatorLogsOption specifies whether fluentd collects the logs of the operator.
+//
+// One of: Enabled, Disabled
+type OperatorLogsOption string
+
+const (
+	OperatorLogsEnabled  OperatorLogsOption = "Enabled"
+	OperatorLogsDisabled OperatorLogsOption = "Disabled"
+)
+
+// OperatorLogsEnabled returns true if fluentd is configured to collect the logs of the operator.
+func (s *LogCollectorSpec) OperatorLogsEnabled() bool {
+	return s.OperatorLogs != nil && *s.OperatorLogs == OperatorLogsEnabled
+}
+
+// MetricsAuthEnabled returns true if the fluentd metrics are served through a kube-rbac-proxy that authorizes each
+// request with a TokenReview and SubjectAccessReview.
+func (s *LogCollectorSpec) MetricsAuthEnabled() bool {
+	return s.MetricsAuth != nil && *s.MetricsAuth == MetricsAuthTokenReview
 }
 
 type CollectProcessPathOption string
@@ -77,6 +366,21 @@ type AdditionalLogStoreSpec struct {
 	// If specified, enables exporting of flow, audit, and DNS logs to splunk.
 	// +optional
 	Splunk *SplunkStoreSpec `json:"splunk,omitempty"`
+	// If specified, enables exporting of flow, audit, and DNS logs to Grafana Loki.
+	// +optional
+	Loki *LokiStoreSpec `json:"loki,omitempty"`
+	// If specified, enables exporting of flow, audit, and DNS logs to Google Cloud Storage.
+	// +optional
+	GCS *GCSStoreSpec `json:"gcs,omitempty"`
+	// If specified, enables exporting of flow, audit, and DNS logs to Azure Blob Storage.
+	// +optional
+	AzureBlob *AzureBlobStoreSpec `json:"azureBlob,omitempty"`
+	// If specified, enables exporting of flow, audit, and DNS logs to an OpenTelemetry (OTLP) collector.
+	// +optional
+	OTLP *OTLPStoreSpec `json:"otlp,omitempty"`
+	// If specified, enables exporting of flow and audit logs to Amazon Security Lake.
+	// +optional
+	SecurityLake *SecurityLakeStoreSpec `json:"securityLake,omitempty"`
 }
 
 type AdditionalLogSourceSpec struct {
@@ -100,27 +404,178 @@ const (
 // S3StoreSpec defines configuration for exporting logs to Amazon S3.
 // +k8s:openapi-gen=true
 type S3StoreSpec struct {
-	// AWS Region of the S3 bucket
+	// AWS Region of the S3 bucket. Required unless Destinations is set.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Name of the S3 bucket to send logs. Required unless Destinations is set.
+	// +optional
+	BucketName string `json:"bucketName,omitempty"`
+
+	// Path in the S3 bucket where to send logs
+	// +optional
+	BucketPath string `json:"bucketPath,omitempty"`
+
+	// The set of hosts that will forward their logs to this store.
+	// +optional
+	HostScope *HostScope `json:"hostScope,omitempty"`
+
+	// Buffer tunes how fluentd buffers logs for this store.
+	// +optional
+	Buffer *FluentdBufferSpec `json:"buffer,omitempty"`
+
+	// Destinations are additional S3 buckets, each receiving only the log types it lists. This allows, for example,
+	// flow logs and audit logs to be archived in different buckets and regions, with independent credentials.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Destinations []S3DestinationSpec `json:"destinations,omitempty"`
+}
+
+// S3LogType represents the allowable log types for an S3 destination.
+// +kubebuilder:validation:Enum=Audit;DNS;Flows;L7
+type S3LogType string
+
+const (
+	S3LogAudit S3LogType = "Audit"
+	S3LogDNS   S3LogType = "DNS"
+	S3LogFlows S3LogType = "Flows"
+	S3LogL7    S3LogType = "L7"
+)
+
+// S3DestinationSpec defines an S3 bucket that a subset of the log types is exported to.
+type S3DestinationSpec struct {
+	// Name identifies the destination. It must be a valid DNS label.
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// AWS Region of the S3 bucket.
 	Region string `json:"region"`
 
-	// Name of the S3 bucket to send logs
+	// Name of the S3 bucket to send logs.
 	BucketName string `json:"bucketName"`
 
-	// Path in the S3 bucket where to send logs
+	// Path in the S3 bucket where to send logs.
+	// +optional
+	BucketPath string `json:"bucketPath,omitempty"`
+
+	// LogTypes are the log types sent to this destination.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	LogTypes []S3LogType `json:"logTypes"`
+
+	// CredentialsSecretName is the name of a secret in the tigera-operator namespace with the key-id and key-secret
+	// keys to write to the bucket with. If omitted, the log-collector-s3-credentials secret is used.
+	// +optional
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+}
+
+// SecurityLakeLogType represents the allowable log types for Amazon Security Lake.
+// Allowable values are Flows and Audit.
+// * Flows corresponds to flow logs generated by Calico node, written as OCSF Network Activity events.
+// * Audit corresponds to audit logs for both Kubernetes resources and Enterprise custom resources, written as OCSF API Activity events.
+// +kubebuilder:validation:Enum=Flows;Audit
+type SecurityLakeLogType string
+
+const (
+	SecurityLakeLogFlows SecurityLakeLogType = "Flows"
+	SecurityLakeLogAudit SecurityLakeLogType = "Audit"
+)
+
+// SecurityLakeStoreSpec defines configuration for exporting logs to Amazon Security Lake.
+// Logs are converted to the Open Cybersecurity Schema Framework (OCSF) and written to the Security Lake S3 bucket as a
+// custom source, partitioned as ext/<sourceName>/region=<region>/accountId=<accountID>/eventDay=<YYYYMMDD>/.
+// Unless RoleARN is set, the AWS credentials used to write to the bucket are read from the secret
+// log-collector-s3-credentials in the tigera-operator namespace.
+type SecurityLakeStoreSpec struct {
+	// AWS Region of the Security Lake
+	Region string `json:"region"`
+
+	// Name of the Security Lake S3 bucket, e.g. aws-security-data-lake-us-east-1-abcdefgh
+	BucketName string `json:"bucketName"`
+
+	// Name of the custom source registered in Security Lake for Calico logs
+	SourceName string `json:"sourceName"`
+
+	// ID of the AWS account that registered the custom source
+	// +kubebuilder:validation:Pattern=`^[0-9]{12}$`
+	AccountID string `json:"accountID"`
+
+	// RoleARN is the ARN of the IAM role used to write to Security Lake using IAM roles for service accounts (IRSA).
+	// When set, the role is added as the eks.amazonaws.com/role-arn annotation on the fluentd service account and
+	// no static credentials are needed.
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+
+	// If no values are provided, both flow and audit logs are exported.
+	// Default: Flows, Audit
+	// +optional
+	LogTypes []SecurityLakeLogType `json:"logTypes,omitempty"`
+
+	// The set of hosts that will forward their logs to this store.
+	// +optional
+	HostScope *HostScope `json:"hostScope,omitempty"`
+
+	// Buffer tunes how fluentd buffers logs for this store.
+	// +optional
+	Buffer *FluentdBufferSpec `json:"buffer,omitempty"`
+}
+
+// GCSStoreSpec defines configuration for exporting logs to Google Cloud Storage.
+// The service account key used to write to the bucket is read from the key.json field of the secret
+// log-collector-gcs-credentials in the tigera-operator namespace.
+type GCSStoreSpec struct {
+	// ID of the Google Cloud project that owns the bucket
+	ProjectID string `json:"projectID"`
+
+	// Name of the GCS bucket to send logs
+	BucketName string `json:"bucketName"`
+
+	// Path in the GCS bucket where to send logs
 	BucketPath string `json:"bucketPath"`
 
 	// The set of hosts that will forward their logs to this store.
 	// +optional
 	HostScope *HostScope `json:"hostScope,omitempty"`
+
+	// Buffer tunes how fluentd buffers logs for this store.
+	// +optional
+	Buffer *FluentdBufferSpec `json:"buffer,omitempty"`
+}
+
+// AzureBlobStoreSpec defines configuration for exporting logs to Azure Blob Storage.
+// The credentials used to write to the container are read from the secret log-collector-azure-blob-credentials
+// in the tigera-operator namespace, which must contain either a storage account access key in the access-key
+// field or a shared access signature in the sas-token field.
+type AzureBlobStoreSpec struct {
+	// Name of the Azure storage account
+	StorageAccount string `json:"storageAccount"`
+
+	// Name of the blob container to send logs
+	Container string `json:"container"`
+
+	// Path in the blob container where to send logs
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// The set of hosts that will forward their logs to this store.
+	// +optional
+	HostScope *HostScope `json:"hostScope,omitempty"`
+
+	// Buffer tunes how fluentd buffers logs for this store.
+	// +optional
+	Buffer *FluentdBufferSpec `json:"buffer,omitempty"`
 }
 
 // SyslogLogType represents the allowable log types for syslog.
-// Allowable values are Audit, DNS, Flows and IDSEvents.
+// Allowable values are Audit, DNS, Flows, IDSEvents and BGP.
 // * Audit corresponds to audit logs for both Kubernetes resources and Enterprise custom resources.
 // * DNS corresponds to DNS logs generated by Calico node.
 // * Flows corresponds to flow logs generated by Calico node.
 // * IDSEvents corresponds to event logs for the intrusion detection system (anomaly detection, suspicious IPs, suspicious domains and global alerts).
-// +kubebuilder:validation:Enum=Audit;DNS;Flows;IDSEvents
+// * BGP corresponds to the BGP logs of the BIRD daemons in Calico node. They are only exported when the collection
+// of BGP logs is enabled.
+// +kubebuilder:validation:Enum=Audit;DNS;Flows;IDSEvents;BGP
 type SyslogLogType string
 
 const (
@@ -129,6 +584,7 @@ const (
 	SyslogLogFlows     SyslogLogType = "Flows"
 	SyslogLogL7        SyslogLogType = "L7"
 	SyslogLogIDSEvents SyslogLogType = "IDSEvents"
+	SyslogLogBGP       SyslogLogType = "BGP"
 )
 
 var SyslogLogTypes []SyslogLogType = []SyslogLogType{
@@ -137,6 +593,7 @@ var SyslogLogTypes []SyslogLogType = []SyslogLogType{
 	SyslogLogFlows,
 	SyslogLogL7,
 	SyslogLogIDSEvents,
+	SyslogLogBGP,
 }
 
 var SyslogLogTypesString []string = []string{
@@ -145,6 +602,7 @@ var SyslogLogTypesString []string = []string{
 	SyslogLogFlows.String(),
 	SyslogLogL7.String(),
 	SyslogLogIDSEvents.String(),
+	SyslogLogBGP.String(),
 }
 
 func (cp SyslogLogType) String() string {
@@ -154,7 +612,9 @@ func (cp SyslogLogType) String() string {
 // SyslogStoreSpec defines configuration for exporting logs to syslog.
 type SyslogStoreSpec struct {
 	// Location of the syslog server. example: tcp://1.2.3.4:601
-	Endpoint string `json:"endpoint"`
+	// Required unless Destinations is set.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
 
 	// PacketSize defines the maximum size of packets to send to syslog.
 	// In general this is only needed if you notice long logs being truncated.
@@ -164,9 +624,12 @@ type SyslogStoreSpec struct {
 
 	// If no values are provided, the list will be updated to include log types Audit, DNS and Flows.
 	// Default: Audit, DNS, Flows
-	LogTypes []SyslogLogType `json:"logTypes"`
+	// +optional
+	LogTypes []SyslogLogType `json:"logTypes,omitempty"`
 
-	// Encryption configures traffic encryption to the Syslog server.
+	// Encryption configures traffic encryption to the Syslog server. When set to TLS and a
+	// secret named logcollector-syslog-client-tls with tls.crt and tls.key exists in the
+	// tigera-operator namespace, fluentd presents that client certificate to the Syslog server.
 	// Default: None
 	// +optional
 	// +kubebuilder:validation:Enum=None;TLS
@@ -175,6 +638,75 @@ type SyslogStoreSpec struct {
 	// The set of hosts that will forward their logs to this store.
 	// +optional
 	HostScope *HostScope `json:"hostScope,omitempty"`
+
+	// Buffer tunes how fluentd buffers logs for this store.
+	// +optional
+	Buffer *FluentdBufferSpec `json:"buffer,omitempty"`
+
+	// Destinations are additional Syslog servers, each with its own endpoint, encryption and log types. This
+	// allows, for example, flow logs and audit logs to be sent to different servers. If Endpoint is set, it is
+	// treated as a destination named default, so that name cannot be used.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Destinations []SyslogDestinationSpec `json:"destinations,omitempty"`
+}
+
+// SyslogDefaultDestinationName is the name of the destination that the Endpoint of a SyslogStoreSpec stands for.
+const SyslogDefaultDestinationName = "default"
+
+// GetDestinations returns every Syslog server that logs are exported to. If Endpoint is set, the Syslog store itself
+// is returned first, as a destination named default.
+func (s *SyslogStoreSpec) GetDestinations() []SyslogDestinationSpec {
+	if s == nil {
+		return nil
+	}
+	var destinations []SyslogDestinationSpec
+	if s.Endpoint != "" {
+		destinations = append(destinations, SyslogDestinationSpec{
+			Name:       SyslogDefaultDestinationName,
+			Endpoint:   s.Endpoint,
+			PacketSize: s.PacketSize,
+			LogTypes:   s.LogTypes,
+			Encryption: s.Encryption,
+		})
+	}
+	return append(destinations, s.Destinations...)
+}
+
+// SyslogDestinationSpec defines a Syslog server that logs are exported to.
+type SyslogDestinationSpec struct {
+	// Name identifies the destination. It must be a valid DNS label.
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Location of the syslog server. example: tcp://1.2.3.4:601
+	Endpoint string `json:"endpoint"`
+
+	// PacketSize defines the maximum size of packets to send to syslog.
+	// Default: 1024
+	// +optional
+	PacketSize *int32 `json:"packetSize,omitempty"`
+
+	// LogTypes are the log types sent to this destination. If no values are provided, the list will be updated to
+	// include log types Audit, DNS and Flows.
+	// Default: Audit, DNS, Flows
+	// +optional
+	// +listType=set
+	LogTypes []SyslogLogType `json:"logTypes,omitempty"`
+
+	// Encryption configures traffic encryption to the Syslog server. With TLS, fluentd presents the client
+	// certificate of the logcollector-syslog-client-tls secret, if it exists, like it does for the Syslog store.
+	// Default: None
+	// +optional
+	// +kubebuilder:validation:Enum=None;TLS
+	Encryption EncryptionOption `json:"encryption,omitempty"`
+
+	// CAConfigMapName is the name of a ConfigMap in the tigera-operator namespace with the CA certificate, in its
+	// tls.crt key, that the certificate of the Syslog server is verified with. Only used with TLS encryption. If
+	// omitted, the syslog-ca ConfigMap is used if it exists, and the publicly trusted CAs otherwise.
+	// +optional
+	CAConfigMapName string `json:"caConfigMapName,omitempty"`
 }
 
 // SplunkStoreSpec defines configuration for exporting logs to splunk.
@@ -185,6 +717,168 @@ type SplunkStoreSpec struct {
 	// The set of hosts that will forward their logs to this store
 	// +optional
 	HostScope *HostScope `json:"hostScope,omitempty"`
+
+	// Buffer tunes how fluentd buffers logs for this store.
+	// +optional
+	Buffer *FluentdBufferSpec `json:"buffer,omitempty"`
+
+	// Index is the Splunk index that logs are sent to. If omitted, logs are sent to the default index of the
+	// HEC token.
+	// +optional
+	Index string `json:"index,omitempty"`
+
+	// Source is the Splunk source that logs are tagged with. If omitted, the HEC token's default source is used.
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// SourceType is the Splunk sourcetype that logs are tagged with. If omitted, the HEC token's default
+	// sourcetype is used.
+	// +optional
+	SourceType string `json:"sourceType,omitempty"`
+
+	// Fields maps the names of Splunk index-time fields to the log record keys they are populated from.
+	// +optional
+	Fields map[string]string `json:"fields,omitempty"`
+
+	// LogTypes overrides the index, source, sourcetype and fields for individual log types. Fields are merged with
+	// those of the store, with the log type taking precedence.
+	// +optional
+	LogTypes []SplunkLogTypeSpec `json:"logTypes,omitempty"`
+}
+
+// SplunkLogType represents the log types that are forwarded to Splunk.
+// +kubebuilder:validation:Enum=Audit;DNS;Flows
+type SplunkLogType string
+
+const (
+	SplunkLogAudit SplunkLogType = "Audit"
+	SplunkLogDNS   SplunkLogType = "DNS"
+	SplunkLogFlows SplunkLogType = "Flows"
+)
+
+// SplunkLogTypeSpec customizes how a single log type is sent to Splunk.
+type SplunkLogTypeSpec struct {
+	// Type is the log type to customize.
+	Type SplunkLogType `json:"type"`
+
+	// Index is the Splunk index that logs of this type are sent to.
+	// +optional
+	Index string `json:"index,omitempty"`
+
+	// Source is the Splunk source that logs of this type are tagged with.
+	// +optional
+	Source string `json:"source,omitempty"`
+
+	// SourceType is the Splunk sourcetype that logs of this type are tagged with.
+	// +optional
+	SourceType string `json:"sourceType,omitempty"`
+
+	// Fields maps the names of Splunk index-time fields to the log record keys they are populated from.
+	// +optional
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// LokiStoreSpec defines configuration for exporting logs to Grafana Loki.
+// Credentials are read from the secret logcollector-loki-credentials in the tigera-operator namespace, if it
+// exists. It must contain either a username and password field for basic authentication, or a token field for
+// bearer token authentication. If Loki's certificate is not signed by a publicly trusted CA, place the CA
+// certificate in the tls.crt field of the ConfigMap loki-ca in the tigera-operator namespace.
+type LokiStoreSpec struct {
+	// URL of the Loki server. example: `https://loki.example.com:3100`
+	URL string `json:"url"`
+
+	// TenantID is sent as the X-Scope-OrgID header, for Loki servers running in multi-tenant mode.
+	// +optional
+	TenantID string `json:"tenantID,omitempty"`
+
+	// The set of hosts that will forward their logs to this store.
+	// +optional
+	HostScope *HostScope `json:"hostScope,omitempty"`
+
+	// Buffer tunes how fluentd buffers logs for this store.
+	// +optional
+	Buffer *FluentdBufferSpec `json:"buffer,omitempty"`
+}
+
+// OTLPProtocol is the transport used to send logs to an OpenTelemetry collector.
+//
+// One of: GRPC, HTTP
+// +kubebuilder:validation:Enum=GRPC;HTTP
+type OTLPProtocol string
+
+const (
+	OTLPProtocolGRPC OTLPProtocol = "GRPC"
+	OTLPProtocolHTTP OTLPProtocol = "HTTP"
+)
+
+// OTLPStoreSpec defines configuration for exporting logs to an OpenTelemetry collector using the OTLP protocol.
+// Additional headers, such as those used for authentication, are read from the secret logcollector-otlp-headers
+// in the tigera-operator namespace, if it exists. Each field of the secret is sent as a header, with the field
+// name as the header name. If the collector's certificate is not signed by a publicly trusted CA, place the CA
+// certificate in the tls.crt field of the ConfigMap otlp-ca in the tigera-operator namespace.
+type OTLPStoreSpec struct {
+	// Endpoint of the OpenTelemetry collector. example: `https://otel-collector.example.com:4317`
+	// +kubebuilder:validation:Pattern=`^https?://`
+	Endpoint string `json:"endpoint"`
+
+	// Protocol used to send logs to the collector.
+	// Default: GRPC
+	// +optional
+	Protocol OTLPProtocol `json:"protocol,omitempty"`
+
+	// The set of hosts that will forward their logs to this store.
+	// +optional
+	HostScope *HostScope `json:"hostScope,omitempty"`
+
+	// Buffer tunes how fluentd buffers logs for this store.
+	// +optional
+	Buffer *FluentdBufferSpec `json:"buffer,omitempty"`
+}
+
+// FluentdBufferOverflowAction is the action fluentd takes when the buffer of an output is full.
+// * ThrowException raises an error so that the logs are retried from the input.
+// * Block stops reading from the input until the buffer has space.
+// * DropOldestChunk discards the oldest buffered logs.
+// +kubebuilder:validation:Enum=ThrowException;Block;DropOldestChunk
+type FluentdBufferOverflowAction string
+
+const (
+	FluentdBufferOverflowThrowException  FluentdBufferOverflowAction = "ThrowException"
+	FluentdBufferOverflowBlock           FluentdBufferOverflowAction = "Block"
+	FluentdBufferOverflowDropOldestChunk FluentdBufferOverflowAction = "DropOldestChunk"
+)
+
+// FluentdBufferSpec tunes the buffer of a fluentd output. Bounding the buffer prevents fluentd from exhausting the
+// disk of a node while the store is unavailable. The defaults of its fields apply once the buffer of a store is
+// tuned; stores without a buffer keep the settings of the fluentd image.
+type FluentdBufferSpec struct {
+	// ChunkLimitSize is the maximum size of each buffered chunk of logs.
+	// Default: 8Mi, or the TotalLimitSize if it is smaller.
+	// +optional
+	ChunkLimitSize *resource.Quantity `json:"chunkLimitSize,omitempty"`
+
+	// TotalLimitSize is the maximum size of all buffered chunks of logs. It must not be smaller than the
+	// ChunkLimitSize.
+	// Default: 512Mi, or the ChunkLimitSize if it is larger.
+	// +optional
+	TotalLimitSize *resource.Quantity `json:"totalLimitSize,omitempty"`
+
+	// FlushInterval is how often buffered logs are flushed to the store. It is rounded down to whole seconds and
+	// must be at least one second.
+	// Default: 5s
+	// +optional
+	FlushInterval *metav1.Duration `json:"flushInterval,omitempty"`
+
+	// RetryMaxTimes is the maximum number of times a failed flush is retried before its logs are discarded.
+	// If omitted, failed flushes are retried until the retry timeout of fluentd expires.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RetryMaxTimes *int32 `json:"retryMaxTimes,omitempty"`
+
+	// OverflowAction is the action taken when the buffer is full.
+	// Default: ThrowException
+	// +optional
+	OverflowAction FluentdBufferOverflowAction `json:"overflowAction,omitempty"`
 }
 
 // EksConfigSpec defines configuration for fetching EKS audit logs.
@@ -204,6 +898,39 @@ type EksCloudwatchLogsSpec struct {
 	// Default: 60
 	// +optional
 	FetchInterval int32 `json:"fetchInterval,omitempty"`
+
+	// RoleARN is the ARN of the IAM role used to read the Cloudwatch logs using IAM roles for service accounts (IRSA).
+	// When set, the role is added as the eks.amazonaws.com/role-arn annotation on the eks-log-forwarder service
+	// account and the static credentials of the tigera-eks-log-forwarder-secret secret are not needed.
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+
+	// ServiceAccountAnnotations are added to the eks-log-forwarder service account, for example
+	// eks.amazonaws.com/sts-regional-endpoints to configure how IRSA obtains its credentials.
+	// +optional
+	ServiceAccountAnnotations map[string]string `json:"serviceAccountAnnotations,omitempty"`
+
+	// NodeSelector is the eks-log-forwarder pod's scheduling constraints, for example to run it on dedicated
+	// infrastructure nodes.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations is the eks-log-forwarder pod's tolerations. If specified, this overrides the control plane
+	// tolerations of the Installation that the pod uses by default.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Resources is the compute resources of the eks-log-forwarder container.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Replicas is the number of eks-log-forwarder replicas. Every replica reads the whole log group, so it can only
+	// be set to 0 to stop forwarding or 1.
+	// Default: 1
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
 }
 
 // LogCollectorStatus defines the observed state of Tigera flow and DNS log collection
@@ -220,6 +947,11 @@ type LogCollectorStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Whether the component is running and stable."
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type=='Progressing')].status",description="Whether the component is processing changes."
+// +kubebuilder:printcolumn:name="Degraded",type="string",JSONPath=".status.conditions[?(@.type=='Degraded')].status",description="Whether the component is degraded."
+// +kubebuilder:printcolumn:name="Observed Generation",type="integer",JSONPath=".status.conditions[?(@.type=='Ready')].observedGeneration",description="The generation of the resource that the Available status reflects.",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // LogCollector installs the components required for Tigera flow and DNS log collection. At most one instance
 // of this resource is supported. It must be named "tigera-secure". When created, this installs fluentd on all nodes
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LogExportLogType is the type of log that a LogExport exports.
+// +kubebuilder:validation:Enum=Audit;DNS;Flows;L7
+type LogExportLogType string
+
+const (
+	LogExportLogAudit LogExportLogType = "Audit"
+	LogExportLogDNS   LogExportLogType = "DNS"
+	LogExportLogFlows LogExportLogType = "Flows"
+	LogExportLogL7    LogExportLogType = "L7"
+)
+
+const (
+	// LogExportConditionSucceeded indicates whether the most recent run of the LogExport exported its logs.
+	LogExportConditionSucceeded = "Succeeded"
+
+	// LogExportReasonSucceeded is set on the Succeeded condition when the most recent run finished successfully.
+	LogExportReasonSucceeded = "Succeeded"
+
+	// LogExportReasonFailed is set on the Succeeded condition when the most recent run failed.
+	LogExportReasonFailed = "Failed"
+
+	// LogExportReasonPending is set on the Succeeded condition until the first run finishes.
+	LogExportReasonPending = "Pending"
+
+	// LogExportReasonInvalid is set on the Succeeded condition when the LogExport cannot be scheduled, for example
+	// because its credentials secret is missing.
+	LogExportReasonInvalid = "Invalid"
+)
+
+// LogExportSpec defines when logs are exported, which logs and where to.
+type LogExportSpec struct {
+	// Schedule is the cron schedule that the export runs on.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Query selects the logs that each run exports.
+	Query LogExportQuery `json:"query"`
+
+	// Destination is where each run writes the exported logs.
+	Destination LogExportDestination `json:"destination"`
+}
+
+// LogExportQuery selects the logs that are exported by each run of a LogExport.
+type LogExportQuery struct {
+	// LogType is the type of log to export.
+	LogType LogExportLogType `json:"logType"`
+
+	// TimeWindow is how far back from the start of each run logs are exported. To export each log exactly once,
+	// set it to the interval of the schedule.
+	// Default: 24h
+	// +optional
+	TimeWindow *metav1.Duration `json:"timeWindow,omitempty"`
+
+	// Selector is a Linseed selector that filters the exported logs, for example
+	// "source_namespace = 'default'". If not specified, all logs of the type in the time window are exported.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Selector string `json:"selector,omitempty"`
+}
+
+// GetTimeWindow returns the configured time window, or the default of 24 hours if unset.
+func (q *LogExportQuery) GetTimeWindow() time.Duration {
+	if q.TimeWindow == nil {
+		return 24 * time.Hour
+	}
+	return q.TimeWindow.Duration
+}
+
+// LogExportDestination defines where the exported logs are written.
+type LogExportDestination struct {
+	// S3 writes the exported logs to an S3 bucket, as a single gzipped newline delimited JSON object per run.
+	S3 *LogExportS3Destination `json:"s3"`
+}
+
+// LogExportS3Destination defines the S3 bucket that logs are exported to.
+type LogExportS3Destination struct {
+	// AWS Region of the S3 bucket.
+	Region string `json:"region"`
+
+	// Name of the S3 bucket to write the exported logs to.
+	BucketName string `json:"bucketName"`
+
+	// Path in the S3 bucket to write the exported logs under.
+	// +optional
+	BucketPath string `json:"bucketPath,omitempty"`
+
+	// CredentialsSecretName is the name of a secret in the tigera-operator namespace with the key-id and key-secret
+	// keys to write to the bucket with. If omitted, the log-collector-s3-credentials secret is used.
+	// +optional
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+}
+
+// LogExportStatus defines the observed state of a LogExport.
+type LogExportStatus struct {
+	// LastSuccessfulTime is the time the most recent successful run of the LogExport finished.
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+
+	// Conditions represents the latest observed state of the LogExport. The Succeeded condition reports the result
+	// of the most recent run.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 41",message="name must be no more than 41 characters"
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule",description="The cron schedule the export runs on."
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.query.logType",description="The type of log that is exported."
+// +kubebuilder:printcolumn:name="Succeeded",type="string",JSONPath=".status.conditions[?(@.type=='Succeeded')].status",description="Whether the most recent run exported its logs."
+// +kubebuilder:printcolumn:name="Last Success",type="date",JSONPath=".status.lastSuccessfulTime",description="When the most recent successful run finished."
+
+// LogExport periodically exports a batch of logs, for example a daily report of the flow logs, to an S3 bucket. Each
+// LogExport is run by a CronJob in the tigera-fluentd namespace.
+type LogExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogExportSpec   `json:"spec,omitempty"`
+	Status LogExportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LogExportList contains a list of LogExport
+type LogExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogExport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LogExport{}, &LogExportList{})
+}
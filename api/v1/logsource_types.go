@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LogParserType is the type of parser fluentd applies to the lines of a custom workload log.
+// +kubebuilder:validation:Enum=JSON;Regexp;None
+type LogParserType string
+
+const (
+	LogParserTypeJSON   LogParserType = "JSON"
+	LogParserTypeRegexp LogParserType = "Regexp"
+	LogParserTypeNone   LogParserType = "None"
+)
+
+const (
+	// LogSourceConditionAccepted indicates whether the LogSource has been validated and added to the fluentd
+	// configuration.
+	LogSourceConditionAccepted = "Accepted"
+
+	// LogSourceReasonValid is set on the Accepted condition when the LogSource has been added to the fluentd
+	// configuration.
+	LogSourceReasonValid = "Valid"
+
+	// LogSourceReasonInvalid is set on the Accepted condition when the LogSource failed validation.
+	LogSourceReasonInvalid = "Invalid"
+
+	// LogSourceReasonUnsupported is set on the Accepted condition when the LogCollector configuration does not
+	// tail container logs, for example when the collector type is AuditDeployment.
+	LogSourceReasonUnsupported = "Unsupported"
+)
+
+// LogSourceSpec defines the container logs to collect and how to parse them.
+type LogSourceSpec struct {
+	// ContainerName is the name of the container whose logs are collected. Only containers in the same namespace
+	// as the LogSource are matched.
+	ContainerName string `json:"containerName"`
+
+	// PodNamePrefix restricts collection to pods whose name starts with the given prefix. If not specified, the
+	// container is matched in all pods in the namespace.
+	// +optional
+	PodNamePrefix string `json:"podNamePrefix,omitempty"`
+
+	// Parser describes how each log line is parsed.
+	Parser LogParserSpec `json:"parser"`
+}
+
+// LogParserSpec defines how fluentd parses the lines of a custom workload log.
+type LogParserSpec struct {
+	// Type is the parser to apply to each log line. JSON parses each line as a JSON object, Regexp parses
+	// each line using Expression and None forwards each line unparsed in the "message" field.
+	Type LogParserType `json:"type"`
+
+	// Expression is the regular expression used to parse each log line. Named capture groups become fields of
+	// the parsed record. Required when Type is Regexp and must not be set otherwise.
+	// +optional
+	Expression string `json:"expression,omitempty"`
+
+	// TimeKey is the name of the parsed field that holds the time of the log entry.
+	// If not specified, the time at which the line was read is used.
+	// +optional
+	TimeKey string `json:"timeKey,omitempty"`
+
+	// TimeFormat is the strptime format of the TimeKey field. Requires TimeKey to be set.
+	// +optional
+	TimeFormat string `json:"timeFormat,omitempty"`
+}
+
+// LogSourceStatus defines the observed state of a LogSource.
+type LogSourceStatus struct {
+	// Conditions represents the latest observed state of the LogSource. The Accepted condition reports whether
+	// the LogSource has been added to the fluentd configuration.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Container",type="string",JSONPath=".spec.containerName",description="The container whose logs are collected."
+// +kubebuilder:printcolumn:name="Parser",type="string",JSONPath=".spec.parser.type",description="The parser applied to each log line."
+// +kubebuilder:printcolumn:name="Accepted",type="string",JSONPath=".status.conditions[?(@.type=='Accepted')].status",description="Whether the LogSource was added to the fluentd configuration."
+
+// LogSource registers the logs of a workload container with the log collector so that they are collected and
+// parsed by fluentd alongside the Calico Enterprise logs.
+type LogSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogSourceSpec   `json:"spec,omitempty"`
+	Status LogSourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LogSourceList contains a list of LogSource
+type LogSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogSource `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LogSource{}, &LogSourceList{})
+}
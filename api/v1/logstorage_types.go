@@ -18,6 +18,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -130,6 +131,24 @@ type Indices struct {
 	// Replicas defines how many replicas each index will have. See https://www.elastic.co/guide/en/elasticsearch/reference/current/scalability.html
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Lifecycle configures the index lifecycle management (ILM) policy that Linseed applies to the log indices it
+	// creates. When set, indices are rolled over according to this policy and deleted once they are older than the
+	// retention period configured for their log type. When not set, Linseed uses its built-in policy.
+	// +optional
+	Lifecycle *IndexLifecycle `json:"lifecycle,omitempty"`
+}
+
+// IndexLifecycle defines when log indices are rolled over to a new index.
+type IndexLifecycle struct {
+	// RolloverMaxAge is the maximum time since the creation of an index before it is rolled over. The value must
+	// be a whole number of seconds, for example 12h or 24h.
+	// +optional
+	RolloverMaxAge *metav1.Duration `json:"rolloverMaxAge,omitempty"`
+
+	// RolloverMaxSize is the maximum size of the primary shards of an index before it is rolled over, for example 50Gi.
+	// +optional
+	RolloverMaxSize *resource.Quantity `json:"rolloverMaxSize,omitempty"`
 }
 
 // Retention defines how long data is retained in an Elasticsearch cluster before it is cleared.
@@ -197,6 +216,11 @@ type LogStorageComponentResource struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Whether the component is running and stable."
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type=='Progressing')].status",description="Whether the component is processing changes."
+// +kubebuilder:printcolumn:name="Degraded",type="string",JSONPath=".status.conditions[?(@.type=='Degraded')].status",description="Whether the component is degraded."
+// +kubebuilder:printcolumn:name="Observed Generation",type="integer",JSONPath=".status.conditions[?(@.type=='Ready')].observedGeneration",description="The generation of the resource that the Available status reflects.",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // LogStorage installs the components required for Tigera flow and DNS log storage. At most one instance
 // of this resource is supported. It must be named "tigera-secure". When created, this installs an Elasticsearch cluster for use by
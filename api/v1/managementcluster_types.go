@@ -31,6 +31,20 @@ type ManagementClusterSpec struct {
 	// TLS provides options for configuring how Managed Clusters can establish an mTLS connection with the Management Cluster.
 	// +optional
 	TLS *TLS `json:"tls,omitempty"`
+
+	// TunnelCARotationInterval is how long the operator-generated tunnel CA in the calico-management-cluster-connection
+	// secret is used before it is rotated. When the interval has elapsed, a replacement CA is generated and served by
+	// Voltron alongside the current CA, and certificates for managed clusters are signed with the replacement CA.
+	// The certificates of existing managed clusters are reissued, and the installationManifest of each ManagedCluster
+	// is replaced with its new guardian secret, which trusts both CAs and must be applied to the managed cluster.
+	// Managed clusters are accepted with either their current or their reissued certificate for seven days, after
+	// which the replacement CA becomes the tunnel CA, the previous CA and the certificates it signed are discarded, and
+	// managed clusters whose new guardian secret has not been applied can no longer connect. The replacement CA
+	// becomes the tunnel CA earlier if no managed cluster holds a certificate signed by the previous CA.
+	// Rotation is not supported for multi-tenant management clusters or when tls.secretName is manager-tls.
+	// If omitted, the tunnel CA is not rotated.
+	// +optional
+	TunnelCARotationInterval *metav1.Duration `json:"tunnelCARotationInterval,omitempty"`
 }
 
 type TLS struct {
@@ -27,9 +27,69 @@ type NonClusterHostSpec struct {
 
 	// Location of the Typha endpoint for non-cluster host Felix and Typha communication. For example: 5.6.7.8:5473
 	TyphaEndpoint string `json:"typhaEndpoint,omitempty"`
+
+	// SourceCIDRs is the list of CIDRs that non-cluster hosts connect from. When set, a dedicated network policy allows
+	// these CIDRs to reach the fluentd input service directly. When empty, only log traffic proxied through the
+	// log ingestion endpoint is allowed.
+	// +optional
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty"`
+
+	// LogInputClientAuth controls how the fluentd input service authenticates non-cluster hosts. None relies on the
+	// network policy alone. ClientCertificate requires hosts to present a client certificate issued by the operator
+	// CA; the NonClusterHost status describes how hosts obtain one.
+	// Default: None
+	// +kubebuilder:validation:Enum=None;ClientCertificate
+	// +optional
+	LogInputClientAuth *LogInputClientAuthType `json:"logInputClientAuth,omitempty"`
+}
+
+// LogInputClientAuthType specifies how the fluentd input service authenticates non-cluster hosts.
+//
+// One of: None, ClientCertificate
+type LogInputClientAuthType string
+
+const (
+	LogInputClientAuthNone              LogInputClientAuthType = "None"
+	LogInputClientAuthClientCertificate LogInputClientAuthType = "ClientCertificate"
+)
+
+// NonClusterHostStatus defines the observed state of NonClusterHost.
+type NonClusterHostStatus struct {
+	// LogInputClientCertificate describes how non-cluster hosts obtain the client certificate the fluentd input
+	// service requires. Only set when LogInputClientAuth is ClientCertificate.
+	// +optional
+	LogInputClientCertificate *ClientCertificateDistribution `json:"logInputClientCertificate,omitempty"`
+}
+
+// ClientCertificateDistribution describes how a non-cluster host obtains a client certificate signed by the operator.
+// The host authenticates to the Kubernetes API server with the token in TokenSecret and creates a
+// CertificateSigningRequest named CSRName with the CSRLabels, the SignerName and a request for CommonName. The operator
+// approves and signs the request once it has verified that the host is allowed to request the common name. The host
+// verifies the certificate of the fluentd input service with the CA bundle in CABundleConfigMap.
+type ClientCertificateDistribution struct {
+	// TokenSecret is the namespace/name of the service account token secret non-cluster hosts authenticate with.
+	TokenSecret string `json:"tokenSecret"`
+
+	// SignerName is the signer name of the CertificateSigningRequest.
+	SignerName string `json:"signerName"`
+
+	// CSRName is the name of the CertificateSigningRequest, where <hostname> is the hostname of the non-cluster host.
+	CSRName string `json:"csrName"`
+
+	// CSRLabels are the labels the CertificateSigningRequest must have, where <hostname> is the hostname of the
+	// non-cluster host.
+	CSRLabels []string `json:"csrLabels"`
+
+	// CommonName is the common name the certificate must be requested for.
+	CommonName string `json:"commonName"`
+
+	// CABundleConfigMap is the namespace/name of the ConfigMap with the CA bundle that the fluentd input service
+	// certificate is signed by.
+	CABundleConfigMap string `json:"caBundleConfigMap"`
 }
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
 
 // NonClusterHost installs the components required for non-cluster host log collection.
@@ -42,6 +102,9 @@ type NonClusterHost struct {
 
 	// Specification of the desired state for non-cluster host log collection.
 	Spec NonClusterHostSpec `json:"spec,omitempty"`
+
+	// Most recently observed state for non-cluster host log collection.
+	Status NonClusterHostStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -54,6 +117,12 @@ type NonClusterHostList struct {
 	Items []NonClusterHost `json:"items"`
 }
 
+// LogInputClientCertificateRequired returns true if non-cluster hosts must present a client certificate to the
+// fluentd input service.
+func (n *NonClusterHost) LogInputClientCertificateRequired() bool {
+	return n != nil && n.Spec.LogInputClientAuth != nil && *n.Spec.LogInputClientAuth == LogInputClientAuthClientCertificate
+}
+
 func init() {
 	SchemeBuilder.Register(&NonClusterHost{}, &NonClusterHostList{})
 }
@@ -18,20 +18,24 @@ package v1
 // changing the probe handler itself (which the operator controls).
 type ProbeOverride struct {
 	// PeriodSeconds is how often (in seconds) to perform the probe.
+	// +kubebuilder:validation:Minimum=1
 	// +optional
 	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
 
 	// TimeoutSeconds is the number of seconds after which the probe times out.
+	// +kubebuilder:validation:Minimum=1
 	// +optional
 	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
 
 	// FailureThreshold is the minimum consecutive failures for the probe
 	// to be considered failed after having succeeded.
+	// +kubebuilder:validation:Minimum=1
 	// +optional
 	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
 
 	// InitialDelaySeconds is the number of seconds after the container
 	// starts before the probe is initiated.
+	// +kubebuilder:validation:Minimum=0
 	// +optional
 	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
 }
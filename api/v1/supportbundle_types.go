@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SupportBundlePhase is the progress of the collection of a SupportBundle.
+type SupportBundlePhase string
+
+const (
+	// SupportBundlePending is the phase of a SupportBundle until its collection Job has been created.
+	SupportBundlePending SupportBundlePhase = "Pending"
+
+	// SupportBundleCollecting is the phase of a SupportBundle while its collection Job runs.
+	SupportBundleCollecting SupportBundlePhase = "Collecting"
+
+	// SupportBundleCompleted is the phase of a SupportBundle that has been written to its destination.
+	SupportBundleCompleted SupportBundlePhase = "Completed"
+
+	// SupportBundleFailed is the phase of a SupportBundle that could not be collected or written.
+	SupportBundleFailed SupportBundlePhase = "Failed"
+)
+
+// SupportBundleSpec defines what a SupportBundle collects and where it is written.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable, create a new SupportBundle instead"
+type SupportBundleSpec struct {
+	// PodLogTailLines is the number of lines of the logs of each container of the operator and its components that
+	// are collected.
+	// Default: 1000
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PodLogTailLines *int64 `json:"podLogTailLines,omitempty"`
+
+	// Destination is where the bundle is written.
+	Destination SupportBundleDestination `json:"destination"`
+}
+
+// GetPodLogTailLines returns the configured number of log lines, or the default of 1000 if unset.
+func (s *SupportBundleSpec) GetPodLogTailLines() int64 {
+	if s.PodLogTailLines == nil {
+		return 1000
+	}
+	return *s.PodLogTailLines
+}
+
+// SupportBundleDestination defines where a support bundle is written. Exactly one destination must be specified.
+// +kubebuilder:validation:XValidation:rule="has(self.persistentVolumeClaim) != has(self.s3)",message="exactly one of persistentVolumeClaim and s3 must be specified"
+type SupportBundleDestination struct {
+	// PersistentVolumeClaim writes the bundle to a persistent volume claim.
+	// +optional
+	PersistentVolumeClaim *SupportBundlePersistentVolumeClaimDestination `json:"persistentVolumeClaim,omitempty"`
+
+	// S3 uploads the bundle to an S3 bucket.
+	// +optional
+	S3 *SupportBundleS3Destination `json:"s3,omitempty"`
+}
+
+// SupportBundlePersistentVolumeClaimDestination defines the persistent volume claim that a support bundle is written
+// to.
+type SupportBundlePersistentVolumeClaimDestination struct {
+	// ClaimName is the name of a persistent volume claim in the tigera-operator namespace. The bundle is written to
+	// the root of its volume as <SupportBundle name>.tar.gz.
+	// +kubebuilder:validation:MinLength=1
+	ClaimName string `json:"claimName"`
+}
+
+// SupportBundleS3Destination defines the S3 bucket that a support bundle is uploaded to.
+type SupportBundleS3Destination struct {
+	// AWS Region of the S3 bucket.
+	// +kubebuilder:validation:MinLength=1
+	Region string `json:"region"`
+
+	// Name of the S3 bucket to upload the bundle to.
+	// +kubebuilder:validation:MinLength=1
+	BucketName string `json:"bucketName"`
+
+	// Path in the S3 bucket to upload the bundle under. The bundle is uploaded as <SupportBundle name>.tar.gz.
+	// +optional
+	BucketPath string `json:"bucketPath,omitempty"`
+
+	// CredentialsSecretName is the name of a secret in the tigera-operator namespace with the key-id and key-secret
+	// keys to upload to the bucket with.
+	// +kubebuilder:validation:MinLength=1
+	CredentialsSecretName string `json:"credentialsSecretName"`
+}
+
+// SupportBundleStatus defines the observed state of a SupportBundle.
+type SupportBundleStatus struct {
+	// Phase is the progress of the collection of the bundle.
+	// +optional
+	Phase SupportBundlePhase `json:"phase,omitempty"`
+
+	// Location is where the bundle is written, either s3://<bucket>/<path>/<name>.tar.gz or
+	// pvc://<claim>/<name>.tar.gz.
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// Message describes why the collection of the bundle failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// CompletionTime is the time the bundle was written to its destination.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 48",message="name must be no more than 48 characters"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="The progress of the collection of the bundle."
+// +kubebuilder:printcolumn:name="Location",type="string",JSONPath=".status.location",description="Where the bundle is written."
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// SupportBundle collects the information that Tigera support needs to troubleshoot an installation into a single
+// tarball: the operator logs, the TigeraStatuses, the differences between the live objects and the objects the
+// operator renders, the logs of the component pods and the expiry of the certificates. The bundle is collected once,
+// by a Job in the tigera-operator namespace, when the SupportBundle is created. To collect another bundle, create a
+// new SupportBundle.
+type SupportBundle struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SupportBundleSpec   `json:"spec,omitempty"`
+	Status SupportBundleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SupportBundleList contains a list of SupportBundle
+type SupportBundleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SupportBundle `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SupportBundle{}, &SupportBundleList{})
+}
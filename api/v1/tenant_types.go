@@ -99,6 +99,27 @@ type TenantSpec struct {
 	// ManagedClusterVariant is the variant of the managed cluster.
 	// +optional
 	ManagedClusterVariant *ProductVariant `json:"managedClusterVariant,omitempty"`
+
+	// LogCollector configures how fluentd in the management cluster routes this tenant's logs.
+	// +optional
+	LogCollector *TenantLogCollectorSpec `json:"logCollector,omitempty"`
+
+	// APIServerDeployment configures the tenant's API server Deployment. When set, a query server is run in the
+	// Tenant's namespace with a certificate issued by the tenant's CA and RBAC bound to the tenant's service account.
+	// Only the tigera-queryserver container can be customized, and PodDisruptionBudget, PreStopSleepSeconds,
+	// ExtraVolumes and ExtraVolumeMounts are not supported. Requires multi-tenancy.
+	// +optional
+	APIServerDeployment *APIServerDeployment `json:"apiServerDeployment,omitempty"`
+}
+
+// TenantLogCollectorSpec defines per-tenant fluentd output settings. Per-tenant flow and DNS log filters
+// are read from a ConfigMap named fluentd-filters in the tenant's namespace, if it exists.
+type TenantLogCollectorSpec struct {
+	// LinseedEndpoint is the URL that fluentd sends this tenant's logs to. If omitted, the tigera-linseed
+	// service in the tenant's namespace is used.
+	// +kubebuilder:validation:Pattern=`^https://`
+	// +optional
+	LinseedEndpoint string `json:"linseedEndpoint,omitempty"`
 }
 
 // Index defines how to store a tenant's data
@@ -150,6 +171,11 @@ func (t *Tenant) SingleTenant() bool {
 	return t != nil && t.GetNamespace() == ""
 }
 
+// APIServerEnabled returns true if the tenant runs its own API server.
+func (t *Tenant) APIServerEnabled() bool {
+	return t.MultiTenant() && t.Spec.APIServerDeployment != nil
+}
+
 func (t *Tenant) ManagedClusterIsCalico() bool {
 	return t != nil && t.Spec.ManagedClusterVariant != nil && *t.Spec.ManagedClusterVariant == Calico
 }
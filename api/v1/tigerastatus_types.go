@@ -33,6 +33,59 @@ type TigeraStatusStatus struct {
 	// Conditions represents the latest observed set of conditions for this component. A component may be one or more of
 	// Available, Progressing, or Degraded.
 	Conditions []TigeraStatusCondition `json:"conditions"`
+
+	// RenderedResources lists the objects most recently rendered by the operator for this component, along with
+	// a hash of their desired state. External tools can use this to detect drift between the operator's desired
+	// state and the objects in the cluster.
+	// +optional
+	RenderedResources []RenderedResource `json:"renderedResources,omitempty"`
+
+	// TyphaRollout reports the progress of a typha Deployment rollout. It is only set on the calico TigeraStatus,
+	// and only while a rollout is in progress.
+	// +optional
+	TyphaRollout *TyphaRolloutStatus `json:"typhaRollout,omitempty"`
+}
+
+// TyphaRolloutStatus describes an in-progress typha Deployment rollout.
+type TyphaRolloutStatus struct {
+	// Paused is true if the operator has paused the rollout because too many client connections to up-level
+	// typha pods were dropped.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Revisions lists the typha Deployment revisions that still have pods.
+	// +optional
+	Revisions []TyphaRevisionStatus `json:"revisions,omitempty"`
+}
+
+// TyphaRevisionStatus describes the typha pods of a single Deployment revision.
+type TyphaRevisionStatus struct {
+	// Revision is the typha Deployment revision.
+	Revision string `json:"revision"`
+
+	// ReadyReplicas is the number of ready typha pods at this revision.
+	ReadyReplicas int32 `json:"readyReplicas"`
+
+	// ConnectedClients is the number of clients connected to the typha pods at this revision. It is only reported
+	// when typha metrics are enabled.
+	// +optional
+	ConnectedClients *int64 `json:"connectedClients,omitempty"`
+}
+
+// RenderedResource identifies an object rendered by the operator and the hash of its desired state.
+type RenderedResource struct {
+	// Kind is the kind of the object.
+	Kind string `json:"kind"`
+
+	// Name is the name of the object.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the object. It is empty for cluster-scoped objects.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Hash is a hash of the object as rendered by the operator.
+	Hash string `json:"hash"`
 }
 
 // +kubebuilder:object:root=true
@@ -78,6 +131,10 @@ const (
 
 	// Ready indicates that the component is healthy and ready.it is identical to Available and used in Status conditions for CRs.
 	ComponentReady StatusConditionType = "Ready"
+
+	// ReconcilePaused means that reconciliation of the component has been paused with the
+	// operator.tigera.io/reconcile=paused annotation on its CR, so changes to its resources are not reverted.
+	ComponentReconcilePaused StatusConditionType = "ReconcilePaused"
 )
 
 // TigeraStatusCondition represents a condition attached to a particular component.
@@ -139,6 +196,7 @@ const (
 	UpgradeError              TigeraStatusReason = "UpgradeError"
 	Unknown                   TigeraStatusReason = "Unknown"
 	ImageSetError             TigeraStatusReason = "ImageSetError"
+	PausedByAnnotation        TigeraStatusReason = "PausedByAnnotation"
 )
 
 func init() {
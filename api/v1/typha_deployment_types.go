@@ -44,6 +44,17 @@ type TyphaDeploymentContainer struct {
 	// The probe handler is set by the operator and cannot be overridden.
 	// +optional
 	LivenessProbe *ProbeOverride `json:"livenessProbe,omitempty"`
+
+	// SeccompProfile overrides the seccomp profile in the container's security context.
+	// +optional
+	SeccompProfile *v1.SeccompProfile `json:"seccompProfile,omitempty"`
+
+	// AppArmorProfile is the AppArmor profile to run the container with. It must be one of runtime/default,
+	// unconfined, or localhost/<profile>, and is applied using the container.apparmor.security.beta.kubernetes.io
+	// pod annotation.
+	// +kubebuilder:validation:Pattern=`^(runtime/default|unconfined|localhost/.+)$`
+	// +optional
+	AppArmorProfile string `json:"appArmorProfile,omitempty"`
 }
 
 // TyphaDeploymentInitContainer is a typha Deployment init container.
@@ -97,13 +108,19 @@ type TyphaDeploymentPodSpec struct {
 	// The grace period is the duration in seconds after the processes running in the pod are sent
 	// a termination signal and the time when the processes are forcibly halted with a kill signal.
 	// Set this value longer than the expected cleanup time for your process.
-	// Defaults to 30 seconds.
+	// Typha sheds its connections gradually over this period, and the typha Deployment's
+	// progressDeadlineSeconds is raised to at least 120% of it.
+	// Defaults to 300 seconds.
 	// +optional
+	// +kubebuilder:validation:Minimum=0
 	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty" protobuf:"varint,4,opt,name=terminationGracePeriodSeconds"`
 
 	// TopologySpreadConstraints describes how a group of pods ought to spread across topology
 	// domains. Scheduler will schedule pods in a way which abides by the constraints.
 	// All topologySpreadConstraints are ANDed.
+	// Constraints without a labelSelector default to selecting the typha pods. If a constraint uses the
+	// topology.kubernetes.io/zone topology key, it replaces the default typha zone anti-affinity; otherwise
+	// the constraints are applied in addition to it.
 	// +optional
 	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 
@@ -113,6 +130,19 @@ type TyphaDeploymentPodSpec struct {
 	// WARNING: Please note that this field will override the default calico-typha Deployment tolerations.
 	// +optional
 	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// RuntimeClassName is the name of the RuntimeClass to run the typha pods with, for example to pin them to the
+	// standard runtime on clusters that run untrusted workloads with a sandboxed runtime. The RuntimeClass must exist.
+	// If omitted, the typha pods use the cluster's default runtime.
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+
+	// ImagePullPolicy is the pull policy applied to all containers of the typha pods.
+	// If specified, this takes precedence over the ImagePullPolicy of the Installation.
+	// If omitted, the typha pods use the ImagePullPolicy of the Installation.
+	// +optional
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	ImagePullPolicy *v1.PullPolicy `json:"imagePullPolicy,omitempty"`
 }
 
 // TyphaDeploymentPodTemplateSpec is the typha Deployment's PodTemplateSpec
@@ -157,6 +187,59 @@ type TyphaDeploymentSpec struct {
 	// +optional
 	// +patchStrategy=retainKeys
 	Strategy *TyphaDeploymentStrategy `json:"strategy,omitempty" patchStrategy:"retainKeys" protobuf:"bytes,4,opt,name=strategy"`
+
+	// Pools is a list of additional typha Deployments, each with its own scheduling constraints. For example, one
+	// pool may be pinned to infrastructure nodes while the main typha Deployment serves the rest of the cluster.
+	// Each pool is rendered as a Deployment and Service named calico-typha-<name> that is otherwise configured
+	// like the main typha Deployment. Pool pods are selected by the calico-typha Service, so Felix discovers them
+	// in the same way as the main typha pods.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=10
+	Pools []TyphaDeploymentPool `json:"pools,omitempty"`
+
+	// PodDisruptionBudget configures the PodDisruptionBudget of the typha pods. If omitted, at most one typha pod
+	// may be unavailable during a voluntary disruption.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+}
+
+// TyphaDeploymentPool is an additional typha Deployment with its own scheduling constraints.
+type TyphaDeploymentPool struct {
+	// Name identifies the pool. It is appended to calico-typha to form the name of the pool's Deployment and Service.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=40
+	Name string `json:"name"`
+
+	// Replicas is the number of typha pods in the pool. Pools are not managed by the typha autoscaler.
+	// Default: 1
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// NodeSelector is the pool's pod scheduling constraints.
+	// If specified, this replaces the nodeSelector of the typha Deployment for the pool's pods.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations is the pool's pod tolerations.
+	// If specified, this replaces the tolerations of the typha Deployment for the pool's pods.
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// Affinity is a group of affinity scheduling rules for the pool's pods.
+	// If specified, this replaces the affinity of the typha Deployment for the pool's pods.
+	// +optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+}
+
+// GetReplicas returns the number of replicas for the pool, defaulting to 1.
+func (p TyphaDeploymentPool) GetReplicas() int32 {
+	if p.Replicas != nil {
+		return *p.Replicas
+	}
+	return 1
 }
 
 // TyphaDeploymentStrategy describes how to replace existing pods with new ones.  Only RollingUpdate is supported
@@ -164,7 +247,9 @@ type TyphaDeploymentSpec struct {
 type TyphaDeploymentStrategy struct {
 	// Rolling update config params. Present only if DeploymentStrategyType =
 	// RollingUpdate.
-	// to be.
+	// By default, typha rolls out with a maxSurge of 100% and a maxUnavailable of 1 so that a complete
+	// replacement set of typha pods can start before the back-level pods shed their connections.
+	// maxSurge and maxUnavailable must not both be zero.
 	// +optional
 	RollingUpdate *appsv1.RollingUpdateDeployment `json:"rollingUpdate,omitempty" protobuf:"bytes,2,opt,name=rollingUpdate"`
 }
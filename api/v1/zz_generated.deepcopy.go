@@ -28,6 +28,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -57,6 +58,61 @@ func (in *APIServer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerAuditLogs) DeepCopyInto(out *APIServerAuditLogs) {
+	*out = *in
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(APIServerAuditLogStorage)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBackup != nil {
+		in, out := &in.MaxBackup, &out.MaxBackup
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerAuditLogs.
+func (in *APIServerAuditLogs) DeepCopy() *APIServerAuditLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerAuditLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerDelegatedAuth) DeepCopyInto(out *APIServerDelegatedAuth) {
+	*out = *in
+	if in.Management != nil {
+		in, out := &in.Management, &out.Management
+		*out = new(DelegatedAuthManagement)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerDelegatedAuth.
+func (in *APIServerDelegatedAuth) DeepCopy() *APIServerDelegatedAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerDelegatedAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerDeployment) DeepCopyInto(out *APIServerDeployment) {
 	*out = *in
@@ -105,6 +161,18 @@ func (in *APIServerDeploymentContainer) DeepCopyInto(out *APIServerDeploymentCon
 		*out = new(ProbeOverride)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeccompProfile != nil {
+		in, out := &in.SeccompProfile, &out.SeccompProfile
+		*out = new(corev1.SeccompProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExtraVolumeMounts != nil {
+		in, out := &in.ExtraVolumeMounts, &out.ExtraVolumeMounts
+		*out = make([]corev1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerDeploymentContainer.
@@ -195,6 +263,33 @@ func (in *APIServerDeploymentPodSpec) DeepCopyInto(out *APIServerDeploymentPodSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullPolicy != nil {
+		in, out := &in.ImagePullPolicy, &out.ImagePullPolicy
+		*out = new(corev1.PullPolicy)
+		**out = **in
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PreStopSleepSeconds != nil {
+		in, out := &in.PreStopSleepSeconds, &out.PreStopSleepSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExtraVolumes != nil {
+		in, out := &in.ExtraVolumes, &out.ExtraVolumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerDeploymentPodSpec.
@@ -245,6 +340,11 @@ func (in *APIServerDeploymentSpec) DeepCopyInto(out *APIServerDeploymentSpec) {
 		*out = new(APIServerDeploymentPodTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerDeploymentSpec.
@@ -257,6 +357,76 @@ func (in *APIServerDeploymentSpec) DeepCopy() *APIServerDeploymentSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerEtcdDatastore) DeepCopyInto(out *APIServerEtcdDatastore) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerEtcdDatastore.
+func (in *APIServerEtcdDatastore) DeepCopy() *APIServerEtcdDatastore {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerEtcdDatastore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerFlowControl) DeepCopyInto(out *APIServerFlowControl) {
+	*out = *in
+	if in.PriorityAndFairness != nil {
+		in, out := &in.PriorityAndFairness, &out.PriorityAndFairness
+		*out = new(PriorityAndFairnessOption)
+		**out = **in
+	}
+	if in.PriorityLevelConfigurations != nil {
+		in, out := &in.PriorityLevelConfigurations, &out.PriorityLevelConfigurations
+		*out = make([]APIServerPriorityLevelConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FlowSchemas != nil {
+		in, out := &in.FlowSchemas, &out.FlowSchemas
+		*out = make([]APIServerFlowSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerFlowControl.
+func (in *APIServerFlowControl) DeepCopy() *APIServerFlowControl {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerFlowControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerFlowSchema) DeepCopyInto(out *APIServerFlowSchema) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerFlowSchema.
+func (in *APIServerFlowSchema) DeepCopy() *APIServerFlowSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerFlowSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerList) DeepCopyInto(out *APIServerList) {
 	*out = *in
@@ -334,6 +504,67 @@ func (in *APIServerPodLogging) DeepCopy() *APIServerPodLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerPriorityLevelConfiguration) DeepCopyInto(out *APIServerPriorityLevelConfiguration) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerPriorityLevelConfiguration.
+func (in *APIServerPriorityLevelConfiguration) DeepCopy() *APIServerPriorityLevelConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerPriorityLevelConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerQueryServer) DeepCopyInto(out *APIServerQueryServer) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(QueryServerGateway)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(QueryServerRoute)
+		**out = **in
+	}
+	if in.CacheRefreshInterval != nil {
+		in, out := &in.CacheRefreshInterval, &out.CacheRefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxPageSize != nil {
+		in, out := &in.MaxPageSize, &out.MaxPageSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerQueryServer.
+func (in *APIServerQueryServer) DeepCopy() *APIServerQueryServer {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerQueryServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerSpec) DeepCopyInto(out *APIServerSpec) {
 	*out = *in
@@ -352,6 +583,76 @@ func (in *APIServerSpec) DeepCopyInto(out *APIServerSpec) {
 		*out = new(CalicoWebhooksDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PrometheusMetrics != nil {
+		in, out := &in.PrometheusMetrics, &out.PrometheusMetrics
+		*out = new(PrometheusMetricsOption)
+		**out = **in
+	}
+	if in.EtcdDatastore != nil {
+		in, out := &in.EtcdDatastore, &out.EtcdDatastore
+		*out = new(APIServerEtcdDatastore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestTimeout != nil {
+		in, out := &in.RequestTimeout, &out.RequestTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRequestsInflight != nil {
+		in, out := &in.MaxRequestsInflight, &out.MaxRequestsInflight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxMutatingRequestsInflight != nil {
+		in, out := &in.MaxMutatingRequestsInflight, &out.MaxMutatingRequestsInflight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DefaultWatchCacheSize != nil {
+		in, out := &in.DefaultWatchCacheSize, &out.DefaultWatchCacheSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WatchCacheSizes != nil {
+		in, out := &in.WatchCacheSizes, &out.WatchCacheSizes
+		*out = make([]APIServerWatchCacheSize, len(*in))
+		copy(*out, *in)
+	}
+	if in.EtcdCompactionInterval != nil {
+		in, out := &in.EtcdCompactionInterval, &out.EtcdCompactionInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(APIServerTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueryServer != nil {
+		in, out := &in.QueryServer, &out.QueryServer
+		*out = new(APIServerQueryServer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowControl != nil {
+		in, out := &in.FlowControl, &out.FlowControl
+		*out = new(APIServerFlowControl)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuditLogs != nil {
+		in, out := &in.AuditLogs, &out.AuditLogs
+		*out = new(APIServerAuditLogs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIServerService != nil {
+		in, out := &in.APIServerService, &out.APIServerService
+		*out = new(ServiceOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DelegatedAuth != nil {
+		in, out := &in.DelegatedAuth, &out.DelegatedAuth
+		*out = new(APIServerDelegatedAuth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerSpec.
@@ -386,6 +687,46 @@ func (in *APIServerStatus) DeepCopy() *APIServerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerTLS) DeepCopyInto(out *APIServerTLS) {
+	*out = *in
+	if in.ExtraDNSNames != nil {
+		in, out := &in.ExtraDNSNames, &out.ExtraDNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraIPAddresses != nil {
+		in, out := &in.ExtraIPAddresses, &out.ExtraIPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerTLS.
+func (in *APIServerTLS) DeepCopy() *APIServerTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerWatchCacheSize) DeepCopyInto(out *APIServerWatchCacheSize) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerWatchCacheSize.
+func (in *APIServerWatchCacheSize) DeepCopy() *APIServerWatchCacheSize {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerWatchCacheSize)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSEgressGateway) DeepCopyInto(out *AWSEgressGateway) {
 	*out = *in
@@ -417,7 +758,7 @@ func (in *AdditionalLogSourceSpec) DeepCopyInto(out *AdditionalLogSourceSpec) {
 	if in.EksCloudwatchLog != nil {
 		in, out := &in.EksCloudwatchLog, &out.EksCloudwatchLog
 		*out = new(EksCloudwatchLogsSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -449,6 +790,31 @@ func (in *AdditionalLogStoreSpec) DeepCopyInto(out *AdditionalLogStoreSpec) {
 		*out = new(SplunkStoreSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(LokiStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCS != nil {
+		in, out := &in.GCS, &out.GCS
+		*out = new(GCSStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureBlob != nil {
+		in, out := &in.AzureBlob, &out.AzureBlob
+		*out = new(AzureBlobStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityLake != nil {
+		in, out := &in.SecurityLake, &out.SecurityLake
+		*out = new(SecurityLakeStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalLogStoreSpec.
@@ -859,6 +1225,31 @@ func (in *Azure) DeepCopy() *Azure {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlobStoreSpec) DeepCopyInto(out *AzureBlobStoreSpec) {
+	*out = *in
+	if in.HostScope != nil {
+		in, out := &in.HostScope, &out.HostScope
+		*out = new(HostScope)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(FluentdBufferSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobStoreSpec.
+func (in *AzureBlobStoreSpec) DeepCopy() *AzureBlobStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlobStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CNILogging) DeepCopyInto(out *CNILogging) {
 	*out = *in
@@ -1987,33 +2378,109 @@ func (in *CalicoWindowsUpgradeDaemonSetSpec) DeepCopy() *CalicoWindowsUpgradeDae
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateManagement) DeepCopyInto(out *CertificateManagement) {
+func (in *CertManager) DeepCopyInto(out *CertManager) {
 	*out = *in
-	if in.CACert != nil {
-		in, out := &in.CACert, &out.CACert
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
+	out.IssuerRef = in.IssuerRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateManagement.
-func (in *CertificateManagement) DeepCopy() *CertificateManagement {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManager.
+func (in *CertManager) DeepCopy() *CertManager {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateManagement)
+	out := new(CertManager)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CommonPrometheusFields) DeepCopyInto(out *CommonPrometheusFields) {
+func (in *CertManagerIssuerReference) DeepCopyInto(out *CertManagerIssuerReference) {
 	*out = *in
-	if in.Containers != nil {
-		in, out := &in.Containers, &out.Containers
-		*out = make([]PrometheusContainer, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerReference.
+func (in *CertManagerIssuerReference) DeepCopy() *CertManagerIssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateManagement) DeepCopyInto(out *CertificateManagement) {
+	*out = *in
+	if in.CACert != nil {
+		in, out := &in.CACert, &out.CACert
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateManagement.
+func (in *CertificateManagement) DeepCopy() *CertificateManagement {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateManagement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRotation) DeepCopyInto(out *CertificateRotation) {
+	*out = *in
+	if in.CertificateDuration != nil {
+		in, out := &in.CertificateDuration, &out.CertificateDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRotation.
+func (in *CertificateRotation) DeepCopy() *CertificateRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertificateDistribution) DeepCopyInto(out *ClientCertificateDistribution) {
+	*out = *in
+	if in.CSRLabels != nil {
+		in, out := &in.CSRLabels, &out.CSRLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertificateDistribution.
+func (in *ClientCertificateDistribution) DeepCopy() *ClientCertificateDistribution {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertificateDistribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonPrometheusFields) DeepCopyInto(out *CommonPrometheusFields) {
+	*out = *in
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]PrometheusContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
@@ -2825,6 +3292,21 @@ func (in *ComplianceStatus) DeepCopy() *ComplianceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentImage) DeepCopyInto(out *ComponentImage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentImage.
+func (in *ComponentImage) DeepCopy() *ComponentImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentResource) DeepCopyInto(out *ComponentResource) {
 	*out = *in
@@ -2845,6 +3327,26 @@ func (in *ComponentResource) DeepCopy() *ComponentResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomTLSProfile) DeepCopyInto(out *CustomTLSProfile) {
+	*out = *in
+	if in.Ciphers != nil {
+		in, out := &in.Ciphers, &out.Ciphers
+		*out = make([]TLSCipher, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTLSProfile.
+func (in *CustomTLSProfile) DeepCopy() *CustomTLSProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomTLSProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DPIDaemonsetInitContainer) DeepCopyInto(out *DPIDaemonsetInitContainer) {
 	*out = *in
@@ -3958,6 +4460,37 @@ func (in *EgressGatewayStatus) DeepCopy() *EgressGatewayStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EksCloudwatchLogsSpec) DeepCopyInto(out *EksCloudwatchLogsSpec) {
 	*out = *in
+	if in.ServiceAccountAnnotations != nil {
+		in, out := &in.ServiceAccountAnnotations, &out.ServiceAccountAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EksCloudwatchLogsSpec.
@@ -4195,9 +4728,49 @@ func (in *ExternalPrometheus) DeepCopy() *ExternalPrometheus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentdBufferSpec) DeepCopyInto(out *FluentdBufferSpec) {
+	*out = *in
+	if in.ChunkLimitSize != nil {
+		in, out := &in.ChunkLimitSize, &out.ChunkLimitSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TotalLimitSize != nil {
+		in, out := &in.TotalLimitSize, &out.TotalLimitSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.FlushInterval != nil {
+		in, out := &in.FlushInterval, &out.FlushInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetryMaxTimes != nil {
+		in, out := &in.RetryMaxTimes, &out.RetryMaxTimes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentdBufferSpec.
+func (in *FluentdBufferSpec) DeepCopy() *FluentdBufferSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentdBufferSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FluentdDaemonSet) DeepCopyInto(out *FluentdDaemonSet) {
 	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(Metadata)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
 		*out = new(FluentdDaemonSetSpec)
@@ -4233,6 +4806,11 @@ func (in *FluentdDaemonSetContainer) DeepCopyInto(out *FluentdDaemonSetContainer
 		*out = new(ProbeOverride)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeccompProfile != nil {
+		in, out := &in.SeccompProfile, &out.SeccompProfile
+		*out = new(corev1.SeccompProfile)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentdDaemonSetContainer.
@@ -4282,6 +4860,35 @@ func (in *FluentdDaemonSetPodSpec) DeepCopyInto(out *FluentdDaemonSetPodSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullPolicy != nil {
+		in, out := &in.ImagePullPolicy, &out.ImagePullPolicy
+		*out = new(corev1.PullPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentdDaemonSetPodSpec.
@@ -4297,6 +4904,11 @@ func (in *FluentdDaemonSetPodSpec) DeepCopy() *FluentdDaemonSetPodSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FluentdDaemonSetPodTemplateSpec) DeepCopyInto(out *FluentdDaemonSetPodTemplateSpec) {
 	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(Metadata)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
 		*out = new(FluentdDaemonSetPodSpec)
@@ -4317,6 +4929,11 @@ func (in *FluentdDaemonSetPodTemplateSpec) DeepCopy() *FluentdDaemonSetPodTempla
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FluentdDaemonSetSpec) DeepCopyInto(out *FluentdDaemonSetSpec) {
 	*out = *in
+	if in.MinReadySeconds != nil {
+		in, out := &in.MinReadySeconds, &out.MinReadySeconds
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Template != nil {
 		in, out := &in.Template, &out.Template
 		*out = new(FluentdDaemonSetPodTemplateSpec)
@@ -4334,6 +4951,61 @@ func (in *FluentdDaemonSetSpec) DeepCopy() *FluentdDaemonSetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentdDeadLetterQueue) DeepCopyInto(out *FluentdDeadLetterQueue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentdDeadLetterQueue.
+func (in *FluentdDeadLetterQueue) DeepCopy() *FluentdDeadLetterQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentdDeadLetterQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentdState) DeepCopyInto(out *FluentdState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentdState.
+func (in *FluentdState) DeepCopy() *FluentdState {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentdState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCSStoreSpec) DeepCopyInto(out *GCSStoreSpec) {
+	*out = *in
+	if in.HostScope != nil {
+		in, out := &in.HostScope, &out.HostScope
+		*out = new(HostScope)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(FluentdBufferSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCSStoreSpec.
+func (in *GCSStoreSpec) DeepCopy() *GCSStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GCSStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayAPI) DeepCopyInto(out *GatewayAPI) {
 	*out = *in
@@ -5715,6 +6387,11 @@ func (in *IPPool) DeepCopy() *IPPool {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]ImageArchitecture, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
@@ -5727,6 +6404,21 @@ func (in *Image) DeepCopy() *Image {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageArchitecture) DeepCopyInto(out *ImageArchitecture) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageArchitecture.
+func (in *ImageArchitecture) DeepCopy() *ImageArchitecture {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageArchitecture)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageSet) DeepCopyInto(out *ImageSet) {
 	*out = *in
@@ -5791,7 +6483,9 @@ func (in *ImageSetSpec) DeepCopyInto(out *ImageSetSpec) {
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
 		*out = make([]Image, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -5805,6 +6499,26 @@ func (in *ImageSetSpec) DeepCopy() *ImageSetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerification) DeepCopyInto(out *ImageVerification) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(ImageVerificationMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerification.
+func (in *ImageVerification) DeepCopy() *ImageVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Impersonation) DeepCopyInto(out *Impersonation) {
 	*out = *in
@@ -5851,13 +6565,43 @@ func (in *Index) DeepCopy() *Index {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Indices) DeepCopyInto(out *Indices) {
+func (in *IndexLifecycle) DeepCopyInto(out *IndexLifecycle) {
+	*out = *in
+	if in.RolloverMaxAge != nil {
+		in, out := &in.RolloverMaxAge, &out.RolloverMaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RolloverMaxSize != nil {
+		in, out := &in.RolloverMaxSize, &out.RolloverMaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexLifecycle.
+func (in *IndexLifecycle) DeepCopy() *IndexLifecycle {
+	if in == nil {
+		return nil
+	}
+	out := new(IndexLifecycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Indices) DeepCopyInto(out *Indices) {
 	*out = *in
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(IndexLifecycle)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Indices.
@@ -5932,6 +6676,11 @@ func (in *InstallationList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 	*out = *in
+	if in.ComponentImages != nil {
+		in, out := &in.ComponentImages, &out.ComponentImages
+		*out = make([]ComponentImage, len(*in))
+		copy(*out, *in)
+	}
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
 		*out = make([]corev1.LocalObjectReference, len(*in))
@@ -5942,6 +6691,21 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(corev1.PullPolicy)
 		**out = **in
 	}
+	if in.ImageVerification != nil {
+		in, out := &in.ImageVerification, &out.ImageVerification
+		*out = new(ImageVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageResolution != nil {
+		in, out := &in.ImageResolution, &out.ImageResolution
+		*out = new(ImageResolution)
+		**out = **in
+	}
+	if in.ComponentPolicyMode != nil {
+		in, out := &in.ComponentPolicyMode, &out.ComponentPolicyMode
+		*out = new(ComponentPolicyMode)
+		**out = **in
+	}
 	if in.CNI != nil {
 		in, out := &in.CNI, &out.CNI
 		*out = new(CNISpec)
@@ -5986,6 +6750,36 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.TyphaMetricsTLS != nil {
+		in, out := &in.TyphaMetricsTLS, &out.TyphaMetricsTLS
+		*out = new(TyphaMetricsTLSMode)
+		**out = **in
+	}
+	if in.TyphaMetricsAuth != nil {
+		in, out := &in.TyphaMetricsAuth, &out.TyphaMetricsAuth
+		*out = new(MetricsAuthMode)
+		**out = **in
+	}
+	if in.TyphaMetricsUpstreamPort != nil {
+		in, out := &in.TyphaMetricsUpstreamPort, &out.TyphaMetricsUpstreamPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MetricsRemoteWrite != nil {
+		in, out := &in.MetricsRemoteWrite, &out.MetricsRemoteWrite
+		*out = new(MetricsRemoteWrite)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RBACMode != nil {
+		in, out := &in.RBACMode, &out.RBACMode
+		*out = new(RBACMode)
+		**out = **in
+	}
+	if in.VerticalPodAutoscaling != nil {
+		in, out := &in.VerticalPodAutoscaling, &out.VerticalPodAutoscaling
+		*out = new(VerticalPodAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	in.NodeUpdateStrategy.DeepCopyInto(&out.NodeUpdateStrategy)
 	if in.ComponentResources != nil {
 		in, out := &in.ComponentResources, &out.ComponentResources
@@ -5999,6 +6793,16 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(CertificateManagement)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(CertManager)
+		**out = **in
+	}
+	if in.CertificateRotation != nil {
+		in, out := &in.CertificateRotation, &out.CertificateRotation
+		*out = new(CertificateRotation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TLSCipherSuites != nil {
 		in, out := &in.TLSCipherSuites, &out.TLSCipherSuites
 		*out = make(TLSCipherSuites, len(*in))
@@ -6006,6 +6810,11 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TLSSecurityProfile != nil {
+		in, out := &in.TLSSecurityProfile, &out.TLSSecurityProfile
+		*out = new(TLSSecurityProfile)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NonPrivileged != nil {
 		in, out := &in.NonPrivileged, &out.NonPrivileged
 		*out = new(NonPrivilegedType)
@@ -6031,6 +6840,16 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(TyphaDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TyphaConfiguration != nil {
+		in, out := &in.TyphaConfiguration, &out.TyphaConfiguration
+		*out = new(TyphaConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TyphaService != nil {
+		in, out := &in.TyphaService, &out.TyphaService
+		*out = new(ServiceOverrides)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CalicoWindowsUpgradeDaemonSet != nil {
 		in, out := &in.CalicoWindowsUpgradeDaemonSet, &out.CalicoWindowsUpgradeDaemonSet
 		*out = new(CalicoWindowsUpgradeDaemonSet)
@@ -6046,6 +6865,16 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(FIPSMode)
 		**out = **in
 	}
+	if in.ManagedPriorityClasses != nil {
+		in, out := &in.ManagedPriorityClasses, &out.ManagedPriorityClasses
+		*out = new(ManagedPriorityClassesType)
+		**out = **in
+	}
+	if in.PodDisruptionBudgets != nil {
+		in, out := &in.PodDisruptionBudgets, &out.PodDisruptionBudgets
+		*out = new(PodDisruptionBudgetsType)
+		**out = **in
+	}
 	if in.Logging != nil {
 		in, out := &in.Logging, &out.Logging
 		*out = new(Logging)
@@ -6071,6 +6900,11 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(Proxy)
 		**out = **in
 	}
+	if in.NamespacePodSecurityStandards != nil {
+		in, out := &in.NamespacePodSecurityStandards, &out.NamespacePodSecurityStandards
+		*out = make([]NamespacePodSecurityStandard, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSpec.
@@ -7119,6 +7953,71 @@ func (in *LinseedDeploymentSpec) DeepCopy() *LinseedDeploymentSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinseedFailover) DeepCopyInto(out *LinseedFailover) {
+	*out = *in
+	if in.RetryPrimaryInterval != nil {
+		in, out := &in.RetryPrimaryInterval, &out.RetryPrimaryInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinseedFailover.
+func (in *LinseedFailover) DeepCopy() *LinseedFailover {
+	if in == nil {
+		return nil
+	}
+	out := new(LinseedFailover)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogCollection) DeepCopyInto(out *LogCollection) {
+	*out = *in
+	if in.Flows != nil {
+		in, out := &in.Flows, &out.Flows
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.L7 != nil {
+		in, out := &in.L7, &out.L7
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BGP != nil {
+		in, out := &in.BGP, &out.BGP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Runtime != nil {
+		in, out := &in.Runtime, &out.Runtime
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollection.
+func (in *LogCollection) DeepCopy() *LogCollection {
+	if in == nil {
+		return nil
+	}
+	out := new(LogCollection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogCollectionSpec) DeepCopyInto(out *LogCollectionSpec) {
 	*out = *in
@@ -7176,6 +8075,33 @@ func (in *LogCollector) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogCollectorFilters) DeepCopyInto(out *LogCollectorFilters) {
+	*out = *in
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedPodSelectors != nil {
+		in, out := &in.ExcludedPodSelectors, &out.ExcludedPodSelectors
+		*out = make([]metav1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectorFilters.
+func (in *LogCollectorFilters) DeepCopy() *LogCollectorFilters {
+	if in == nil {
+		return nil
+	}
+	out := new(LogCollectorFilters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogCollectorList) DeepCopyInto(out *LogCollectorList) {
 	*out = *in
@@ -7236,6 +8162,61 @@ func (in *LogCollectorSpec) DeepCopyInto(out *LogCollectorSpec) {
 		*out = new(EKSLogForwarderDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MetricsPort != nil {
+		in, out := &in.MetricsPort, &out.MetricsPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MetricsAuth != nil {
+		in, out := &in.MetricsAuth, &out.MetricsAuth
+		*out = new(MetricsAuthMode)
+		**out = **in
+	}
+	if in.CollectorType != nil {
+		in, out := &in.CollectorType, &out.CollectorType
+		*out = new(LogCollectorType)
+		**out = **in
+	}
+	if in.OperatorLogs != nil {
+		in, out := &in.OperatorLogs, &out.OperatorLogs
+		*out = new(OperatorLogsOption)
+		**out = **in
+	}
+	if in.DeadLetterQueue != nil {
+		in, out := &in.DeadLetterQueue, &out.DeadLetterQueue
+		*out = new(FluentdDeadLetterQueue)
+		**out = **in
+	}
+	if in.FluentdState != nil {
+		in, out := &in.FluentdState, &out.FluentdState
+		*out = new(FluentdState)
+		**out = **in
+	}
+	if in.Collection != nil {
+		in, out := &in.Collection, &out.Collection
+		*out = new(LogCollection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InputService != nil {
+		in, out := &in.InputService, &out.InputService
+		*out = new(ServiceOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PipelineCanary != nil {
+		in, out := &in.PipelineCanary, &out.PipelineCanary
+		*out = new(LogPipelineCanary)
+		**out = **in
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = new(LogCollectorFilters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LinseedFailover != nil {
+		in, out := &in.LinseedFailover, &out.LinseedFailover
+		*out = new(LinseedFailover)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectorSpec.
@@ -7271,7 +8252,7 @@ func (in *LogCollectorStatus) DeepCopy() *LogCollectorStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogStorage) DeepCopyInto(out *LogStorage) {
+func (in *LogExport) DeepCopyInto(out *LogExport) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -7279,18 +8260,18 @@ func (in *LogStorage) DeepCopyInto(out *LogStorage) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStorage.
-func (in *LogStorage) DeepCopy() *LogStorage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogExport.
+func (in *LogExport) DeepCopy() *LogExport {
 	if in == nil {
 		return nil
 	}
-	out := new(LogStorage)
+	out := new(LogExport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LogStorage) DeepCopyObject() runtime.Object {
+func (in *LogExport) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -7298,51 +8279,51 @@ func (in *LogStorage) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogStorageComponentResource) DeepCopyInto(out *LogStorageComponentResource) {
+func (in *LogExportDestination) DeepCopyInto(out *LogExportDestination) {
 	*out = *in
-	if in.ResourceRequirements != nil {
-		in, out := &in.ResourceRequirements, &out.ResourceRequirements
-		*out = new(corev1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(LogExportS3Destination)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStorageComponentResource.
-func (in *LogStorageComponentResource) DeepCopy() *LogStorageComponentResource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogExportDestination.
+func (in *LogExportDestination) DeepCopy() *LogExportDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(LogStorageComponentResource)
+	out := new(LogExportDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogStorageList) DeepCopyInto(out *LogStorageList) {
+func (in *LogExportList) DeepCopyInto(out *LogExportList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]LogStorage, len(*in))
+		*out = make([]LogExport, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStorageList.
-func (in *LogStorageList) DeepCopy() *LogStorageList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogExportList.
+func (in *LogExportList) DeepCopy() *LogExportList {
 	if in == nil {
 		return nil
 	}
-	out := new(LogStorageList)
+	out := new(LogExportList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LogStorageList) DeepCopyObject() runtime.Object {
+func (in *LogExportList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -7350,77 +8331,64 @@ func (in *LogStorageList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogStorageSpec) DeepCopyInto(out *LogStorageSpec) {
+func (in *LogExportQuery) DeepCopyInto(out *LogExportQuery) {
 	*out = *in
-	if in.Nodes != nil {
-		in, out := &in.Nodes, &out.Nodes
-		*out = new(Nodes)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Indices != nil {
-		in, out := &in.Indices, &out.Indices
-		*out = new(Indices)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Retention != nil {
-		in, out := &in.Retention, &out.Retention
-		*out = new(Retention)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DataNodeSelector != nil {
-		in, out := &in.DataNodeSelector, &out.DataNodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ComponentResources != nil {
-		in, out := &in.ComponentResources, &out.ComponentResources
-		*out = make([]LogStorageComponentResource, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ECKOperatorStatefulSet != nil {
-		in, out := &in.ECKOperatorStatefulSet, &out.ECKOperatorStatefulSet
-		*out = new(ECKOperatorStatefulSet)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Kibana != nil {
-		in, out := &in.Kibana, &out.Kibana
-		*out = new(Kibana)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LinseedDeployment != nil {
-		in, out := &in.LinseedDeployment, &out.LinseedDeployment
-		*out = new(LinseedDeployment)
-		(*in).DeepCopyInto(*out)
+	if in.TimeWindow != nil {
+		in, out := &in.TimeWindow, &out.TimeWindow
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	if in.ElasticsearchMetricsDeployment != nil {
-		in, out := &in.ElasticsearchMetricsDeployment, &out.ElasticsearchMetricsDeployment
-		*out = new(ElasticsearchMetricsDeployment)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogExportQuery.
+func (in *LogExportQuery) DeepCopy() *LogExportQuery {
+	if in == nil {
+		return nil
 	}
-	if in.ESGatewayDeployment != nil {
-		in, out := &in.ESGatewayDeployment, &out.ESGatewayDeployment
-		*out = new(ESGatewayDeployment)
-		(*in).DeepCopyInto(*out)
+	out := new(LogExportQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogExportS3Destination) DeepCopyInto(out *LogExportS3Destination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogExportS3Destination.
+func (in *LogExportS3Destination) DeepCopy() *LogExportS3Destination {
+	if in == nil {
+		return nil
 	}
+	out := new(LogExportS3Destination)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStorageSpec.
-func (in *LogStorageSpec) DeepCopy() *LogStorageSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogExportSpec) DeepCopyInto(out *LogExportSpec) {
+	*out = *in
+	in.Query.DeepCopyInto(&out.Query)
+	in.Destination.DeepCopyInto(&out.Destination)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogExportSpec.
+func (in *LogExportSpec) DeepCopy() *LogExportSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LogStorageSpec)
+	out := new(LogExportSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogStorageStatus) DeepCopyInto(out *LogStorageStatus) {
+func (in *LogExportStatus) DeepCopyInto(out *LogExportStatus) {
 	*out = *in
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -7430,83 +8398,67 @@ func (in *LogStorageStatus) DeepCopyInto(out *LogStorageStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStorageStatus.
-func (in *LogStorageStatus) DeepCopy() *LogStorageStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogExportStatus.
+func (in *LogExportStatus) DeepCopy() *LogExportStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LogStorageStatus)
+	out := new(LogExportStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Logging) DeepCopyInto(out *Logging) {
+func (in *LogParserSpec) DeepCopyInto(out *LogParserSpec) {
 	*out = *in
-	if in.CNI != nil {
-		in, out := &in.CNI, &out.CNI
-		*out = new(CNILogging)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
-func (in *Logging) DeepCopy() *Logging {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogParserSpec.
+func (in *LogParserSpec) DeepCopy() *LogParserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Logging)
+	out := new(LogParserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementCluster) DeepCopyInto(out *ManagementCluster) {
+func (in *LogPipelineCanary) DeepCopyInto(out *LogPipelineCanary) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementCluster.
-func (in *ManagementCluster) DeepCopy() *ManagementCluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogPipelineCanary.
+func (in *LogPipelineCanary) DeepCopy() *LogPipelineCanary {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagementCluster)
+	out := new(LogPipelineCanary)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ManagementCluster) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementClusterConnection) DeepCopyInto(out *ManagementClusterConnection) {
+func (in *LogSource) DeepCopyInto(out *LogSource) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterConnection.
-func (in *ManagementClusterConnection) DeepCopy() *ManagementClusterConnection {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSource.
+func (in *LogSource) DeepCopy() *LogSource {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagementClusterConnection)
+	out := new(LogSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ManagementClusterConnection) DeepCopyObject() runtime.Object {
+func (in *LogSource) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -7514,31 +8466,31 @@ func (in *ManagementClusterConnection) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementClusterConnectionList) DeepCopyInto(out *ManagementClusterConnectionList) {
+func (in *LogSourceList) DeepCopyInto(out *LogSourceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ManagementClusterConnection, len(*in))
+		*out = make([]LogSource, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterConnectionList.
-func (in *ManagementClusterConnectionList) DeepCopy() *ManagementClusterConnectionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSourceList.
+func (in *LogSourceList) DeepCopy() *LogSourceList {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagementClusterConnectionList)
+	out := new(LogSourceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ManagementClusterConnectionList) DeepCopyObject() runtime.Object {
+func (in *LogSourceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -7546,37 +8498,23 @@ func (in *ManagementClusterConnectionList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementClusterConnectionSpec) DeepCopyInto(out *ManagementClusterConnectionSpec) {
+func (in *LogSourceSpec) DeepCopyInto(out *LogSourceSpec) {
 	*out = *in
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(ManagementClusterTLS)
-		**out = **in
-	}
-	if in.GuardianDeployment != nil {
-		in, out := &in.GuardianDeployment, &out.GuardianDeployment
-		*out = new(GuardianDeployment)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Impersonation != nil {
-		in, out := &in.Impersonation, &out.Impersonation
-		*out = new(Impersonation)
-		(*in).DeepCopyInto(*out)
-	}
+	out.Parser = in.Parser
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterConnectionSpec.
-func (in *ManagementClusterConnectionSpec) DeepCopy() *ManagementClusterConnectionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSourceSpec.
+func (in *LogSourceSpec) DeepCopy() *LogSourceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagementClusterConnectionSpec)
+	out := new(LogSourceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementClusterConnectionStatus) DeepCopyInto(out *ManagementClusterConnectionStatus) {
+func (in *LogSourceStatus) DeepCopyInto(out *LogSourceStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -7587,42 +8525,37 @@ func (in *ManagementClusterConnectionStatus) DeepCopyInto(out *ManagementCluster
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterConnectionStatus.
-func (in *ManagementClusterConnectionStatus) DeepCopy() *ManagementClusterConnectionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSourceStatus.
+func (in *LogSourceStatus) DeepCopy() *LogSourceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagementClusterConnectionStatus)
+	out := new(LogSourceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementClusterList) DeepCopyInto(out *ManagementClusterList) {
+func (in *LogStorage) DeepCopyInto(out *LogStorage) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ManagementCluster, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterList.
-func (in *ManagementClusterList) DeepCopy() *ManagementClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStorage.
+func (in *LogStorage) DeepCopy() *LogStorage {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagementClusterList)
+	out := new(LogStorage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ManagementClusterList) DeepCopyObject() runtime.Object {
+func (in *LogStorage) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -7630,61 +8563,51 @@ func (in *ManagementClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementClusterSpec) DeepCopyInto(out *ManagementClusterSpec) {
+func (in *LogStorageComponentResource) DeepCopyInto(out *LogStorageComponentResource) {
 	*out = *in
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(TLS)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterSpec.
-func (in *ManagementClusterSpec) DeepCopy() *ManagementClusterSpec {
-	if in == nil {
-		return nil
+	if in.ResourceRequirements != nil {
+		in, out := &in.ResourceRequirements, &out.ResourceRequirements
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ManagementClusterSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagementClusterTLS) DeepCopyInto(out *ManagementClusterTLS) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterTLS.
-func (in *ManagementClusterTLS) DeepCopy() *ManagementClusterTLS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStorageComponentResource.
+func (in *LogStorageComponentResource) DeepCopy() *LogStorageComponentResource {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagementClusterTLS)
+	out := new(LogStorageComponentResource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Manager) DeepCopyInto(out *Manager) {
+func (in *LogStorageList) DeepCopyInto(out *LogStorageList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogStorage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Manager.
-func (in *Manager) DeepCopy() *Manager {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStorageList.
+func (in *LogStorageList) DeepCopy() *LogStorageList {
 	if in == nil {
 		return nil
 	}
-	out := new(Manager)
+	out := new(LogStorageList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Manager) DeepCopyObject() runtime.Object {
+func (in *LogStorageList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -7692,170 +8615,161 @@ func (in *Manager) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagerDeployment) DeepCopyInto(out *ManagerDeployment) {
+func (in *LogStorageSpec) DeepCopyInto(out *LogStorageSpec) {
 	*out = *in
-	if in.Spec != nil {
-		in, out := &in.Spec, &out.Spec
-		*out = new(ManagerDeploymentSpec)
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = new(Nodes)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerDeployment.
-func (in *ManagerDeployment) DeepCopy() *ManagerDeployment {
-	if in == nil {
-		return nil
+	if in.Indices != nil {
+		in, out := &in.Indices, &out.Indices
+		*out = new(Indices)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ManagerDeployment)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagerDeploymentContainer) DeepCopyInto(out *ManagerDeploymentContainer) {
-	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(corev1.ResourceRequirements)
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(Retention)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ReadinessProbe != nil {
-		in, out := &in.ReadinessProbe, &out.ReadinessProbe
-		*out = new(ProbeOverride)
+	if in.DataNodeSelector != nil {
+		in, out := &in.DataNodeSelector, &out.DataNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ComponentResources != nil {
+		in, out := &in.ComponentResources, &out.ComponentResources
+		*out = make([]LogStorageComponentResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ECKOperatorStatefulSet != nil {
+		in, out := &in.ECKOperatorStatefulSet, &out.ECKOperatorStatefulSet
+		*out = new(ECKOperatorStatefulSet)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.LivenessProbe != nil {
-		in, out := &in.LivenessProbe, &out.LivenessProbe
-		*out = new(ProbeOverride)
+	if in.Kibana != nil {
+		in, out := &in.Kibana, &out.Kibana
+		*out = new(Kibana)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerDeploymentContainer.
-func (in *ManagerDeploymentContainer) DeepCopy() *ManagerDeploymentContainer {
-	if in == nil {
-		return nil
+	if in.LinseedDeployment != nil {
+		in, out := &in.LinseedDeployment, &out.LinseedDeployment
+		*out = new(LinseedDeployment)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(ManagerDeploymentContainer)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagerDeploymentInitContainer) DeepCopyInto(out *ManagerDeploymentInitContainer) {
-	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(corev1.ResourceRequirements)
+	if in.ElasticsearchMetricsDeployment != nil {
+		in, out := &in.ElasticsearchMetricsDeployment, &out.ElasticsearchMetricsDeployment
+		*out = new(ElasticsearchMetricsDeployment)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ESGatewayDeployment != nil {
+		in, out := &in.ESGatewayDeployment, &out.ESGatewayDeployment
+		*out = new(ESGatewayDeployment)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerDeploymentInitContainer.
-func (in *ManagerDeploymentInitContainer) DeepCopy() *ManagerDeploymentInitContainer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStorageSpec.
+func (in *LogStorageSpec) DeepCopy() *LogStorageSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagerDeploymentInitContainer)
+	out := new(LogStorageSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagerDeploymentPodSpec) DeepCopyInto(out *ManagerDeploymentPodSpec) {
+func (in *LogStorageStatus) DeepCopyInto(out *LogStorageStatus) {
 	*out = *in
-	if in.InitContainers != nil {
-		in, out := &in.InitContainers, &out.InitContainers
-		*out = make([]ManagerDeploymentInitContainer, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Containers != nil {
-		in, out := &in.Containers, &out.Containers
-		*out = make([]ManagerDeploymentContainer, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerDeploymentPodSpec.
-func (in *ManagerDeploymentPodSpec) DeepCopy() *ManagerDeploymentPodSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStorageStatus.
+func (in *LogStorageStatus) DeepCopy() *LogStorageStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagerDeploymentPodSpec)
+	out := new(LogStorageStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagerDeploymentPodTemplateSpec) DeepCopyInto(out *ManagerDeploymentPodTemplateSpec) {
+func (in *Logging) DeepCopyInto(out *Logging) {
 	*out = *in
-	if in.Spec != nil {
-		in, out := &in.Spec, &out.Spec
-		*out = new(ManagerDeploymentPodSpec)
+	if in.CNI != nil {
+		in, out := &in.CNI, &out.CNI
+		*out = new(CNILogging)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerDeploymentPodTemplateSpec.
-func (in *ManagerDeploymentPodTemplateSpec) DeepCopy() *ManagerDeploymentPodTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
+func (in *Logging) DeepCopy() *Logging {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagerDeploymentPodTemplateSpec)
+	out := new(Logging)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagerDeploymentSpec) DeepCopyInto(out *ManagerDeploymentSpec) {
+func (in *LokiStoreSpec) DeepCopyInto(out *LokiStoreSpec) {
 	*out = *in
-	if in.Template != nil {
-		in, out := &in.Template, &out.Template
-		*out = new(ManagerDeploymentPodTemplateSpec)
+	if in.HostScope != nil {
+		in, out := &in.HostScope, &out.HostScope
+		*out = new(HostScope)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(FluentdBufferSpec)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerDeploymentSpec.
-func (in *ManagerDeploymentSpec) DeepCopy() *ManagerDeploymentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStoreSpec.
+func (in *LokiStoreSpec) DeepCopy() *LokiStoreSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagerDeploymentSpec)
+	out := new(LokiStoreSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagerList) DeepCopyInto(out *ManagerList) {
+func (in *ManagementCluster) DeepCopyInto(out *ManagementCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Manager, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerList.
-func (in *ManagerList) DeepCopy() *ManagerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementCluster.
+func (in *ManagementCluster) DeepCopy() *ManagementCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagerList)
+	out := new(ManagementCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ManagerList) DeepCopyObject() runtime.Object {
+func (in *ManagementCluster) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -7863,78 +8777,7 @@ func (in *ManagerList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagerSpec) DeepCopyInto(out *ManagerSpec) {
-	*out = *in
-	if in.ManagerDeployment != nil {
-		in, out := &in.ManagerDeployment, &out.ManagerDeployment
-		*out = new(ManagerDeployment)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerSpec.
-func (in *ManagerSpec) DeepCopy() *ManagerSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(ManagerSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagerStatus) DeepCopyInto(out *ManagerStatus) {
-	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerStatus.
-func (in *ManagerStatus) DeepCopy() *ManagerStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(ManagerStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Metadata) DeepCopyInto(out *Metadata) {
-	*out = *in
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metadata.
-func (in *Metadata) DeepCopy() *Metadata {
-	if in == nil {
-		return nil
-	}
-	out := new(Metadata)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Monitor) DeepCopyInto(out *Monitor) {
+func (in *ManagementClusterConnection) DeepCopyInto(out *ManagementClusterConnection) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -7942,18 +8785,18 @@ func (in *Monitor) DeepCopyInto(out *Monitor) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitor.
-func (in *Monitor) DeepCopy() *Monitor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterConnection.
+func (in *ManagementClusterConnection) DeepCopy() *ManagementClusterConnection {
 	if in == nil {
 		return nil
 	}
-	out := new(Monitor)
+	out := new(ManagementClusterConnection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Monitor) DeepCopyObject() runtime.Object {
+func (in *ManagementClusterConnection) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -7961,31 +8804,31 @@ func (in *Monitor) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitorList) DeepCopyInto(out *MonitorList) {
+func (in *ManagementClusterConnectionList) DeepCopyInto(out *ManagementClusterConnectionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Monitor, len(*in))
+		*out = make([]ManagementClusterConnection, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorList.
-func (in *MonitorList) DeepCopy() *MonitorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterConnectionList.
+func (in *ManagementClusterConnectionList) DeepCopy() *ManagementClusterConnectionList {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitorList)
+	out := new(ManagementClusterConnectionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MonitorList) DeepCopyObject() runtime.Object {
+func (in *ManagementClusterConnectionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -7993,37 +8836,37 @@ func (in *MonitorList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitorSpec) DeepCopyInto(out *MonitorSpec) {
+func (in *ManagementClusterConnectionSpec) DeepCopyInto(out *ManagementClusterConnectionSpec) {
 	*out = *in
-	if in.ExternalPrometheus != nil {
-		in, out := &in.ExternalPrometheus, &out.ExternalPrometheus
-		*out = new(ExternalPrometheus)
-		(*in).DeepCopyInto(*out)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ManagementClusterTLS)
+		**out = **in
 	}
-	if in.Prometheus != nil {
-		in, out := &in.Prometheus, &out.Prometheus
-		*out = new(Prometheus)
+	if in.GuardianDeployment != nil {
+		in, out := &in.GuardianDeployment, &out.GuardianDeployment
+		*out = new(GuardianDeployment)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Alertmanager != nil {
-		in, out := &in.Alertmanager, &out.Alertmanager
-		*out = new(Alertmanager)
+	if in.Impersonation != nil {
+		in, out := &in.Impersonation, &out.Impersonation
+		*out = new(Impersonation)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorSpec.
-func (in *MonitorSpec) DeepCopy() *MonitorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterConnectionSpec.
+func (in *ManagementClusterConnectionSpec) DeepCopy() *ManagementClusterConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitorSpec)
+	out := new(ManagementClusterConnectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitorStatus) DeepCopyInto(out *MonitorStatus) {
+func (in *ManagementClusterConnectionStatus) DeepCopyInto(out *ManagementClusterConnectionStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -8034,272 +8877,137 @@ func (in *MonitorStatus) DeepCopyInto(out *MonitorStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorStatus.
-func (in *MonitorStatus) DeepCopy() *MonitorStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterConnectionStatus.
+func (in *ManagementClusterConnectionStatus) DeepCopy() *ManagementClusterConnectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitorStatus)
+	out := new(ManagementClusterConnectionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
+func (in *ManagementClusterList) DeepCopyInto(out *ManagementClusterList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagementCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedName.
-func (in *NamespacedName) DeepCopy() *NamespacedName {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterList.
+func (in *ManagementClusterList) DeepCopy() *ManagementClusterList {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespacedName)
+	out := new(ManagementClusterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagementClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeAddressAutodetection) DeepCopyInto(out *NodeAddressAutodetection) {
+func (in *ManagementClusterSpec) DeepCopyInto(out *ManagementClusterSpec) {
 	*out = *in
-	if in.FirstFound != nil {
-		in, out := &in.FirstFound, &out.FirstFound
-		*out = new(bool)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
 		**out = **in
 	}
-	if in.Kubernetes != nil {
-		in, out := &in.Kubernetes, &out.Kubernetes
-		*out = new(KubernetesAutodetectionMethod)
+	if in.TunnelCARotationInterval != nil {
+		in, out := &in.TunnelCARotationInterval, &out.TunnelCARotationInterval
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.CIDRS != nil {
-		in, out := &in.CIDRS, &out.CIDRS
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAddressAutodetection.
-func (in *NodeAddressAutodetection) DeepCopy() *NodeAddressAutodetection {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterSpec.
+func (in *ManagementClusterSpec) DeepCopy() *ManagementClusterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeAddressAutodetection)
+	out := new(ManagementClusterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeAffinity) DeepCopyInto(out *NodeAffinity) {
+func (in *ManagementClusterTLS) DeepCopyInto(out *ManagementClusterTLS) {
 	*out = *in
-	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
-		in, out := &in.PreferredDuringSchedulingIgnoredDuringExecution, &out.PreferredDuringSchedulingIgnoredDuringExecution
-		*out = make([]corev1.PreferredSchedulingTerm, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
-		in, out := &in.RequiredDuringSchedulingIgnoredDuringExecution, &out.RequiredDuringSchedulingIgnoredDuringExecution
-		*out = new(corev1.NodeSelector)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAffinity.
-func (in *NodeAffinity) DeepCopy() *NodeAffinity {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterTLS.
+func (in *ManagementClusterTLS) DeepCopy() *ManagementClusterTLS {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeAffinity)
+	out := new(ManagementClusterTLS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeSet) DeepCopyInto(out *NodeSet) {
+func (in *Manager) DeepCopyInto(out *Manager) {
 	*out = *in
-	if in.SelectionAttributes != nil {
-		in, out := &in.SelectionAttributes, &out.SelectionAttributes
-		*out = make([]NodeSetSelectionAttribute, len(*in))
-		copy(*out, *in)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSet.
-func (in *NodeSet) DeepCopy() *NodeSet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Manager.
+func (in *Manager) DeepCopy() *Manager {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeSet)
+	out := new(Manager)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeSetSelectionAttribute) DeepCopyInto(out *NodeSetSelectionAttribute) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSetSelectionAttribute.
-func (in *NodeSetSelectionAttribute) DeepCopy() *NodeSetSelectionAttribute {
-	if in == nil {
-		return nil
-	}
-	out := new(NodeSetSelectionAttribute)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Nodes) DeepCopyInto(out *Nodes) {
-	*out = *in
-	if in.NodeSets != nil {
-		in, out := &in.NodeSets, &out.NodeSets
-		*out = make([]NodeSet, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ResourceRequirements != nil {
-		in, out := &in.ResourceRequirements, &out.ResourceRequirements
-		*out = new(corev1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Nodes.
-func (in *Nodes) DeepCopy() *Nodes {
-	if in == nil {
-		return nil
-	}
-	out := new(Nodes)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonClusterHost) DeepCopyInto(out *NonClusterHost) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonClusterHost.
-func (in *NonClusterHost) DeepCopy() *NonClusterHost {
-	if in == nil {
-		return nil
-	}
-	out := new(NonClusterHost)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NonClusterHost) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonClusterHostList) DeepCopyInto(out *NonClusterHostList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]NonClusterHost, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonClusterHostList.
-func (in *NonClusterHostList) DeepCopy() *NonClusterHostList {
-	if in == nil {
-		return nil
-	}
-	out := new(NonClusterHostList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NonClusterHostList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonClusterHostSpec) DeepCopyInto(out *NonClusterHostSpec) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonClusterHostSpec.
-func (in *NonClusterHostSpec) DeepCopy() *NonClusterHostSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(NonClusterHostSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PacketCaptureAPI) DeepCopyInto(out *PacketCaptureAPI) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPI.
-func (in *PacketCaptureAPI) DeepCopy() *PacketCaptureAPI {
-	if in == nil {
-		return nil
-	}
-	out := new(PacketCaptureAPI)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PacketCaptureAPI) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Manager) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PacketCaptureAPIDeployment) DeepCopyInto(out *PacketCaptureAPIDeployment) {
+func (in *ManagerDeployment) DeepCopyInto(out *ManagerDeployment) {
 	*out = *in
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
-		*out = new(PacketCaptureAPIDeploymentSpec)
+		*out = new(ManagerDeploymentSpec)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIDeployment.
-func (in *PacketCaptureAPIDeployment) DeepCopy() *PacketCaptureAPIDeployment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerDeployment.
+func (in *ManagerDeployment) DeepCopy() *ManagerDeployment {
 	if in == nil {
 		return nil
 	}
-	out := new(PacketCaptureAPIDeployment)
+	out := new(ManagerDeployment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PacketCaptureAPIDeploymentContainer) DeepCopyInto(out *PacketCaptureAPIDeploymentContainer) {
+func (in *ManagerDeploymentContainer) DeepCopyInto(out *ManagerDeploymentContainer) {
 	*out = *in
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
@@ -8318,18 +9026,18 @@ func (in *PacketCaptureAPIDeploymentContainer) DeepCopyInto(out *PacketCaptureAP
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIDeploymentContainer.
-func (in *PacketCaptureAPIDeploymentContainer) DeepCopy() *PacketCaptureAPIDeploymentContainer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerDeploymentContainer.
+func (in *ManagerDeploymentContainer) DeepCopy() *ManagerDeploymentContainer {
 	if in == nil {
 		return nil
 	}
-	out := new(PacketCaptureAPIDeploymentContainer)
+	out := new(ManagerDeploymentContainer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PacketCaptureAPIDeploymentInitContainer) DeepCopyInto(out *PacketCaptureAPIDeploymentInitContainer) {
+func (in *ManagerDeploymentInitContainer) DeepCopyInto(out *ManagerDeploymentInitContainer) {
 	*out = *in
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
@@ -8338,111 +9046,111 @@ func (in *PacketCaptureAPIDeploymentInitContainer) DeepCopyInto(out *PacketCaptu
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIDeploymentInitContainer.
-func (in *PacketCaptureAPIDeploymentInitContainer) DeepCopy() *PacketCaptureAPIDeploymentInitContainer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerDeploymentInitContainer.
+func (in *ManagerDeploymentInitContainer) DeepCopy() *ManagerDeploymentInitContainer {
 	if in == nil {
 		return nil
 	}
-	out := new(PacketCaptureAPIDeploymentInitContainer)
+	out := new(ManagerDeploymentInitContainer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PacketCaptureAPIDeploymentPodSpec) DeepCopyInto(out *PacketCaptureAPIDeploymentPodSpec) {
+func (in *ManagerDeploymentPodSpec) DeepCopyInto(out *ManagerDeploymentPodSpec) {
 	*out = *in
 	if in.InitContainers != nil {
 		in, out := &in.InitContainers, &out.InitContainers
-		*out = make([]PacketCaptureAPIDeploymentInitContainer, len(*in))
+		*out = make([]ManagerDeploymentInitContainer, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.Containers != nil {
 		in, out := &in.Containers, &out.Containers
-		*out = make([]PacketCaptureAPIDeploymentContainer, len(*in))
+		*out = make([]ManagerDeploymentContainer, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIDeploymentPodSpec.
-func (in *PacketCaptureAPIDeploymentPodSpec) DeepCopy() *PacketCaptureAPIDeploymentPodSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerDeploymentPodSpec.
+func (in *ManagerDeploymentPodSpec) DeepCopy() *ManagerDeploymentPodSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PacketCaptureAPIDeploymentPodSpec)
+	out := new(ManagerDeploymentPodSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PacketCaptureAPIDeploymentPodTemplateSpec) DeepCopyInto(out *PacketCaptureAPIDeploymentPodTemplateSpec) {
+func (in *ManagerDeploymentPodTemplateSpec) DeepCopyInto(out *ManagerDeploymentPodTemplateSpec) {
 	*out = *in
 	if in.Spec != nil {
 		in, out := &in.Spec, &out.Spec
-		*out = new(PacketCaptureAPIDeploymentPodSpec)
+		*out = new(ManagerDeploymentPodSpec)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIDeploymentPodTemplateSpec.
-func (in *PacketCaptureAPIDeploymentPodTemplateSpec) DeepCopy() *PacketCaptureAPIDeploymentPodTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerDeploymentPodTemplateSpec.
+func (in *ManagerDeploymentPodTemplateSpec) DeepCopy() *ManagerDeploymentPodTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PacketCaptureAPIDeploymentPodTemplateSpec)
+	out := new(ManagerDeploymentPodTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PacketCaptureAPIDeploymentSpec) DeepCopyInto(out *PacketCaptureAPIDeploymentSpec) {
+func (in *ManagerDeploymentSpec) DeepCopyInto(out *ManagerDeploymentSpec) {
 	*out = *in
 	if in.Template != nil {
 		in, out := &in.Template, &out.Template
-		*out = new(PacketCaptureAPIDeploymentPodTemplateSpec)
+		*out = new(ManagerDeploymentPodTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIDeploymentSpec.
-func (in *PacketCaptureAPIDeploymentSpec) DeepCopy() *PacketCaptureAPIDeploymentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerDeploymentSpec.
+func (in *ManagerDeploymentSpec) DeepCopy() *ManagerDeploymentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PacketCaptureAPIDeploymentSpec)
+	out := new(ManagerDeploymentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PacketCaptureAPIList) DeepCopyInto(out *PacketCaptureAPIList) {
+func (in *ManagerList) DeepCopyInto(out *ManagerList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PacketCaptureAPI, len(*in))
+		*out = make([]Manager, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIList.
-func (in *PacketCaptureAPIList) DeepCopy() *PacketCaptureAPIList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerList.
+func (in *ManagerList) DeepCopy() *ManagerList {
 	if in == nil {
 		return nil
 	}
-	out := new(PacketCaptureAPIList)
+	out := new(ManagerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PacketCaptureAPIList) DeepCopyObject() runtime.Object {
+func (in *ManagerList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -8450,27 +9158,27 @@ func (in *PacketCaptureAPIList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PacketCaptureAPISpec) DeepCopyInto(out *PacketCaptureAPISpec) {
+func (in *ManagerSpec) DeepCopyInto(out *ManagerSpec) {
 	*out = *in
-	if in.PacketCaptureAPIDeployment != nil {
-		in, out := &in.PacketCaptureAPIDeployment, &out.PacketCaptureAPIDeployment
-		*out = new(PacketCaptureAPIDeployment)
+	if in.ManagerDeployment != nil {
+		in, out := &in.ManagerDeployment, &out.ManagerDeployment
+		*out = new(ManagerDeployment)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPISpec.
-func (in *PacketCaptureAPISpec) DeepCopy() *PacketCaptureAPISpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerSpec.
+func (in *ManagerSpec) DeepCopy() *ManagerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PacketCaptureAPISpec)
+	out := new(ManagerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PacketCaptureAPIStatus) DeepCopyInto(out *PacketCaptureAPIStatus) {
+func (in *ManagerStatus) DeepCopyInto(out *ManagerStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -8481,62 +9189,86 @@ func (in *PacketCaptureAPIStatus) DeepCopyInto(out *PacketCaptureAPIStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIStatus.
-func (in *PacketCaptureAPIStatus) DeepCopy() *PacketCaptureAPIStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerStatus.
+func (in *ManagerStatus) DeepCopy() *ManagerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PacketCaptureAPIStatus)
+	out := new(ManagerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PathMatch) DeepCopyInto(out *PathMatch) {
+func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
-	if in.PathRegexp != nil {
-		in, out := &in.PathRegexp, &out.PathRegexp
-		*out = new(string)
-		**out = **in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.PathReplace != nil {
-		in, out := &in.PathReplace, &out.PathReplace
-		*out = new(string)
-		**out = **in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PathMatch.
-func (in *PathMatch) DeepCopy() *PathMatch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metadata.
+func (in *Metadata) DeepCopy() *Metadata {
 	if in == nil {
 		return nil
 	}
-	out := new(PathMatch)
+	out := new(Metadata)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyRecommendation) DeepCopyInto(out *PolicyRecommendation) {
+func (in *MetricsRemoteWrite) DeepCopyInto(out *MetricsRemoteWrite) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.ScrapeInterval != nil {
+		in, out := &in.ScrapeInterval, &out.ScrapeInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendation.
-func (in *PolicyRecommendation) DeepCopy() *PolicyRecommendation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsRemoteWrite.
+func (in *MetricsRemoteWrite) DeepCopy() *MetricsRemoteWrite {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyRecommendation)
+	out := new(MetricsRemoteWrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitor) DeepCopyInto(out *Monitor) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitor.
+func (in *Monitor) DeepCopy() *Monitor {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PolicyRecommendation) DeepCopyObject() runtime.Object {
+func (in *Monitor) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -8544,268 +9276,416 @@ func (in *PolicyRecommendation) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyRecommendationDeployment) DeepCopyInto(out *PolicyRecommendationDeployment) {
+func (in *MonitorList) DeepCopyInto(out *MonitorList) {
 	*out = *in
-	if in.Spec != nil {
-		in, out := &in.Spec, &out.Spec
-		*out = new(PolicyRecommendationDeploymentSpec)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Monitor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationDeployment.
-func (in *PolicyRecommendationDeployment) DeepCopy() *PolicyRecommendationDeployment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorList.
+func (in *MonitorList) DeepCopy() *MonitorList {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyRecommendationDeployment)
+	out := new(MonitorList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MonitorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyRecommendationDeploymentContainer) DeepCopyInto(out *PolicyRecommendationDeploymentContainer) {
+func (in *MonitorSpec) DeepCopyInto(out *MonitorSpec) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(corev1.ResourceRequirements)
+	if in.ExternalPrometheus != nil {
+		in, out := &in.ExternalPrometheus, &out.ExternalPrometheus
+		*out = new(ExternalPrometheus)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ReadinessProbe != nil {
-		in, out := &in.ReadinessProbe, &out.ReadinessProbe
-		*out = new(ProbeOverride)
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(Prometheus)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.LivenessProbe != nil {
-		in, out := &in.LivenessProbe, &out.LivenessProbe
-		*out = new(ProbeOverride)
+	if in.Alertmanager != nil {
+		in, out := &in.Alertmanager, &out.Alertmanager
+		*out = new(Alertmanager)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationDeploymentContainer.
-func (in *PolicyRecommendationDeploymentContainer) DeepCopy() *PolicyRecommendationDeploymentContainer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorSpec.
+func (in *MonitorSpec) DeepCopy() *MonitorSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyRecommendationDeploymentContainer)
+	out := new(MonitorSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyRecommendationDeploymentInitContainer) DeepCopyInto(out *PolicyRecommendationDeploymentInitContainer) {
+func (in *MonitorStatus) DeepCopyInto(out *MonitorStatus) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(corev1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationDeploymentInitContainer.
-func (in *PolicyRecommendationDeploymentInitContainer) DeepCopy() *PolicyRecommendationDeploymentInitContainer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorStatus.
+func (in *MonitorStatus) DeepCopy() *MonitorStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyRecommendationDeploymentInitContainer)
+	out := new(MonitorStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyRecommendationDeploymentPodSpec) DeepCopyInto(out *PolicyRecommendationDeploymentPodSpec) {
+func (in *NamespacePodSecurityStandard) DeepCopyInto(out *NamespacePodSecurityStandard) {
 	*out = *in
-	if in.InitContainers != nil {
-		in, out := &in.InitContainers, &out.InitContainers
-		*out = make([]PolicyRecommendationDeploymentInitContainer, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Containers != nil {
-		in, out := &in.Containers, &out.Containers
-		*out = make([]PolicyRecommendationDeploymentContainer, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationDeploymentPodSpec.
-func (in *PolicyRecommendationDeploymentPodSpec) DeepCopy() *PolicyRecommendationDeploymentPodSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacePodSecurityStandard.
+func (in *NamespacePodSecurityStandard) DeepCopy() *NamespacePodSecurityStandard {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyRecommendationDeploymentPodSpec)
+	out := new(NamespacePodSecurityStandard)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyRecommendationDeploymentPodTemplateSpec) DeepCopyInto(out *PolicyRecommendationDeploymentPodTemplateSpec) {
+func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
 	*out = *in
-	if in.Spec != nil {
-		in, out := &in.Spec, &out.Spec
-		*out = new(PolicyRecommendationDeploymentPodSpec)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationDeploymentPodTemplateSpec.
-func (in *PolicyRecommendationDeploymentPodTemplateSpec) DeepCopy() *PolicyRecommendationDeploymentPodTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedName.
+func (in *NamespacedName) DeepCopy() *NamespacedName {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyRecommendationDeploymentPodTemplateSpec)
+	out := new(NamespacedName)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyRecommendationDeploymentSpec) DeepCopyInto(out *PolicyRecommendationDeploymentSpec) {
+func (in *NodeAddressAutodetection) DeepCopyInto(out *NodeAddressAutodetection) {
 	*out = *in
-	if in.Template != nil {
-		in, out := &in.Template, &out.Template
-		*out = new(PolicyRecommendationDeploymentPodTemplateSpec)
-		(*in).DeepCopyInto(*out)
+	if in.FirstFound != nil {
+		in, out := &in.FirstFound, &out.FirstFound
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(KubernetesAutodetectionMethod)
+		**out = **in
+	}
+	if in.CIDRS != nil {
+		in, out := &in.CIDRS, &out.CIDRS
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationDeploymentSpec.
-func (in *PolicyRecommendationDeploymentSpec) DeepCopy() *PolicyRecommendationDeploymentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAddressAutodetection.
+func (in *NodeAddressAutodetection) DeepCopy() *NodeAddressAutodetection {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyRecommendationDeploymentSpec)
+	out := new(NodeAddressAutodetection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyRecommendationList) DeepCopyInto(out *PolicyRecommendationList) {
+func (in *NodeAffinity) DeepCopyInto(out *NodeAffinity) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]PolicyRecommendation, len(*in))
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.PreferredDuringSchedulingIgnoredDuringExecution, &out.PreferredDuringSchedulingIgnoredDuringExecution
+		*out = make([]corev1.PreferredSchedulingTerm, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.RequiredDuringSchedulingIgnoredDuringExecution, &out.RequiredDuringSchedulingIgnoredDuringExecution
+		*out = new(corev1.NodeSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationList.
-func (in *PolicyRecommendationList) DeepCopy() *PolicyRecommendationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAffinity.
+func (in *NodeAffinity) DeepCopy() *NodeAffinity {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyRecommendationList)
+	out := new(NodeAffinity)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PolicyRecommendationList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyRecommendationSpec) DeepCopyInto(out *PolicyRecommendationSpec) {
+func (in *NodeSet) DeepCopyInto(out *NodeSet) {
 	*out = *in
-	if in.PolicyRecommendationDeployment != nil {
-		in, out := &in.PolicyRecommendationDeployment, &out.PolicyRecommendationDeployment
-		*out = new(PolicyRecommendationDeployment)
-		(*in).DeepCopyInto(*out)
+	if in.SelectionAttributes != nil {
+		in, out := &in.SelectionAttributes, &out.SelectionAttributes
+		*out = make([]NodeSetSelectionAttribute, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationSpec.
-func (in *PolicyRecommendationSpec) DeepCopy() *PolicyRecommendationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSet.
+func (in *NodeSet) DeepCopy() *NodeSet {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyRecommendationSpec)
+	out := new(NodeSet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyRecommendationStatus) DeepCopyInto(out *PolicyRecommendationStatus) {
+func (in *NodeSetSelectionAttribute) DeepCopyInto(out *NodeSetSelectionAttribute) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationStatus.
-func (in *PolicyRecommendationStatus) DeepCopy() *PolicyRecommendationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSetSelectionAttribute.
+func (in *NodeSetSelectionAttribute) DeepCopy() *NodeSetSelectionAttribute {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyRecommendationStatus)
+	out := new(NodeSetSelectionAttribute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProbeOverride) DeepCopyInto(out *ProbeOverride) {
+func (in *Nodes) DeepCopyInto(out *Nodes) {
 	*out = *in
-	if in.PeriodSeconds != nil {
-		in, out := &in.PeriodSeconds, &out.PeriodSeconds
-		*out = new(int32)
-		**out = **in
-	}
-	if in.TimeoutSeconds != nil {
-		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
-		*out = new(int32)
-		**out = **in
-	}
-	if in.FailureThreshold != nil {
-		in, out := &in.FailureThreshold, &out.FailureThreshold
-		*out = new(int32)
-		**out = **in
+	if in.NodeSets != nil {
+		in, out := &in.NodeSets, &out.NodeSets
+		*out = make([]NodeSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.InitialDelaySeconds != nil {
-		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
-		*out = new(int32)
-		**out = **in
+	if in.ResourceRequirements != nil {
+		in, out := &in.ResourceRequirements, &out.ResourceRequirements
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeOverride.
-func (in *ProbeOverride) DeepCopy() *ProbeOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Nodes.
+func (in *Nodes) DeepCopy() *Nodes {
 	if in == nil {
 		return nil
 	}
-	out := new(ProbeOverride)
+	out := new(Nodes)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Prometheus) DeepCopyInto(out *Prometheus) {
+func (in *NonClusterHost) DeepCopyInto(out *NonClusterHost) {
 	*out = *in
-	if in.PrometheusSpec != nil {
-		in, out := &in.PrometheusSpec, &out.PrometheusSpec
-		*out = new(PrometheusSpec)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Prometheus.
-func (in *Prometheus) DeepCopy() *Prometheus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonClusterHost.
+func (in *NonClusterHost) DeepCopy() *NonClusterHost {
 	if in == nil {
 		return nil
 	}
-	out := new(Prometheus)
+	out := new(NonClusterHost)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonClusterHost) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusContainer) DeepCopyInto(out *PrometheusContainer) {
+func (in *NonClusterHostList) DeepCopyInto(out *NonClusterHostList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonClusterHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonClusterHostList.
+func (in *NonClusterHostList) DeepCopy() *NonClusterHostList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonClusterHostList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonClusterHostList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonClusterHostSpec) DeepCopyInto(out *NonClusterHostSpec) {
+	*out = *in
+	if in.SourceCIDRs != nil {
+		in, out := &in.SourceCIDRs, &out.SourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LogInputClientAuth != nil {
+		in, out := &in.LogInputClientAuth, &out.LogInputClientAuth
+		*out = new(LogInputClientAuthType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonClusterHostSpec.
+func (in *NonClusterHostSpec) DeepCopy() *NonClusterHostSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonClusterHostSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonClusterHostStatus) DeepCopyInto(out *NonClusterHostStatus) {
+	*out = *in
+	if in.LogInputClientCertificate != nil {
+		in, out := &in.LogInputClientCertificate, &out.LogInputClientCertificate
+		*out = new(ClientCertificateDistribution)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonClusterHostStatus.
+func (in *NonClusterHostStatus) DeepCopy() *NonClusterHostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonClusterHostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPStoreSpec) DeepCopyInto(out *OTLPStoreSpec) {
+	*out = *in
+	if in.HostScope != nil {
+		in, out := &in.HostScope, &out.HostScope
+		*out = new(HostScope)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(FluentdBufferSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPStoreSpec.
+func (in *OTLPStoreSpec) DeepCopy() *OTLPStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureAPI) DeepCopyInto(out *PacketCaptureAPI) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPI.
+func (in *PacketCaptureAPI) DeepCopy() *PacketCaptureAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PacketCaptureAPI) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureAPIDeployment) DeepCopyInto(out *PacketCaptureAPIDeployment) {
+	*out = *in
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(PacketCaptureAPIDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIDeployment.
+func (in *PacketCaptureAPIDeployment) DeepCopy() *PacketCaptureAPIDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureAPIDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureAPIDeploymentContainer) DeepCopyInto(out *PacketCaptureAPIDeploymentContainer) {
 	*out = *in
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
@@ -8824,196 +9704,1076 @@ func (in *PrometheusContainer) DeepCopyInto(out *PrometheusContainer) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusContainer.
-func (in *PrometheusContainer) DeepCopy() *PrometheusContainer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIDeploymentContainer.
+func (in *PacketCaptureAPIDeploymentContainer) DeepCopy() *PacketCaptureAPIDeploymentContainer {
 	if in == nil {
 		return nil
 	}
-	out := new(PrometheusContainer)
+	out := new(PacketCaptureAPIDeploymentContainer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
+func (in *PacketCaptureAPIDeploymentInitContainer) DeepCopyInto(out *PacketCaptureAPIDeploymentInitContainer) {
 	*out = *in
-	if in.CommonPrometheusFields != nil {
-		in, out := &in.CommonPrometheusFields, &out.CommonPrometheusFields
-		*out = new(CommonPrometheusFields)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusSpec.
-func (in *PrometheusSpec) DeepCopy() *PrometheusSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIDeploymentInitContainer.
+func (in *PacketCaptureAPIDeploymentInitContainer) DeepCopy() *PacketCaptureAPIDeploymentInitContainer {
 	if in == nil {
 		return nil
 	}
-	out := new(PrometheusSpec)
+	out := new(PacketCaptureAPIDeploymentInitContainer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Proxy) DeepCopyInto(out *Proxy) {
+func (in *PacketCaptureAPIDeploymentPodSpec) DeepCopyInto(out *PacketCaptureAPIDeploymentPodSpec) {
 	*out = *in
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]PacketCaptureAPIDeploymentInitContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]PacketCaptureAPIDeploymentContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Proxy.
-func (in *Proxy) DeepCopy() *Proxy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIDeploymentPodSpec.
+func (in *PacketCaptureAPIDeploymentPodSpec) DeepCopy() *PacketCaptureAPIDeploymentPodSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Proxy)
+	out := new(PacketCaptureAPIDeploymentPodSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueryServerLogging) DeepCopyInto(out *QueryServerLogging) {
+func (in *PacketCaptureAPIDeploymentPodTemplateSpec) DeepCopyInto(out *PacketCaptureAPIDeploymentPodTemplateSpec) {
 	*out = *in
-	if in.LogSeverity != nil {
-		in, out := &in.LogSeverity, &out.LogSeverity
-		*out = new(LogSeverity)
-		**out = **in
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(PacketCaptureAPIDeploymentPodSpec)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryServerLogging.
-func (in *QueryServerLogging) DeepCopy() *QueryServerLogging {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIDeploymentPodTemplateSpec.
+func (in *PacketCaptureAPIDeploymentPodTemplateSpec) DeepCopy() *PacketCaptureAPIDeploymentPodTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QueryServerLogging)
+	out := new(PacketCaptureAPIDeploymentPodTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Retention) DeepCopyInto(out *Retention) {
+func (in *PacketCaptureAPIDeploymentSpec) DeepCopyInto(out *PacketCaptureAPIDeploymentSpec) {
 	*out = *in
-	if in.Flows != nil {
-		in, out := &in.Flows, &out.Flows
-		*out = new(int32)
-		**out = **in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(PacketCaptureAPIDeploymentPodTemplateSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.AuditReports != nil {
-		in, out := &in.AuditReports, &out.AuditReports
-		*out = new(int32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIDeploymentSpec.
+func (in *PacketCaptureAPIDeploymentSpec) DeepCopy() *PacketCaptureAPIDeploymentSpec {
+	if in == nil {
+		return nil
 	}
-	if in.Snapshots != nil {
-		in, out := &in.Snapshots, &out.Snapshots
-		*out = new(int32)
-		**out = **in
+	out := new(PacketCaptureAPIDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureAPIList) DeepCopyInto(out *PacketCaptureAPIList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PacketCaptureAPI, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.ComplianceReports != nil {
-		in, out := &in.ComplianceReports, &out.ComplianceReports
-		*out = new(int32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIList.
+func (in *PacketCaptureAPIList) DeepCopy() *PacketCaptureAPIList {
+	if in == nil {
+		return nil
 	}
-	if in.DNSLogs != nil {
-		in, out := &in.DNSLogs, &out.DNSLogs
+	out := new(PacketCaptureAPIList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PacketCaptureAPIList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureAPISpec) DeepCopyInto(out *PacketCaptureAPISpec) {
+	*out = *in
+	if in.PacketCaptureAPIDeployment != nil {
+		in, out := &in.PacketCaptureAPIDeployment, &out.PacketCaptureAPIDeployment
+		*out = new(PacketCaptureAPIDeployment)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPISpec.
+func (in *PacketCaptureAPISpec) DeepCopy() *PacketCaptureAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureAPIStatus) DeepCopyInto(out *PacketCaptureAPIStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureAPIStatus.
+func (in *PacketCaptureAPIStatus) DeepCopy() *PacketCaptureAPIStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureAPIStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PathMatch) DeepCopyInto(out *PathMatch) {
+	*out = *in
+	if in.PathRegexp != nil {
+		in, out := &in.PathRegexp, &out.PathRegexp
+		*out = new(string)
+		**out = **in
+	}
+	if in.PathReplace != nil {
+		in, out := &in.PathReplace, &out.PathReplace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PathMatch.
+func (in *PathMatch) DeepCopy() *PathMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(PathMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetSpec) DeepCopyInto(out *PodDisruptionBudgetSpec) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetSpec.
+func (in *PodDisruptionBudgetSpec) DeepCopy() *PodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendation) DeepCopyInto(out *PolicyRecommendation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendation.
+func (in *PolicyRecommendation) DeepCopy() *PolicyRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyRecommendation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendationDeployment) DeepCopyInto(out *PolicyRecommendationDeployment) {
+	*out = *in
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(PolicyRecommendationDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationDeployment.
+func (in *PolicyRecommendationDeployment) DeepCopy() *PolicyRecommendationDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendationDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendationDeploymentContainer) DeepCopyInto(out *PolicyRecommendationDeploymentContainer) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(ProbeOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(ProbeOverride)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationDeploymentContainer.
+func (in *PolicyRecommendationDeploymentContainer) DeepCopy() *PolicyRecommendationDeploymentContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendationDeploymentContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendationDeploymentInitContainer) DeepCopyInto(out *PolicyRecommendationDeploymentInitContainer) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationDeploymentInitContainer.
+func (in *PolicyRecommendationDeploymentInitContainer) DeepCopy() *PolicyRecommendationDeploymentInitContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendationDeploymentInitContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendationDeploymentPodSpec) DeepCopyInto(out *PolicyRecommendationDeploymentPodSpec) {
+	*out = *in
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]PolicyRecommendationDeploymentInitContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]PolicyRecommendationDeploymentContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationDeploymentPodSpec.
+func (in *PolicyRecommendationDeploymentPodSpec) DeepCopy() *PolicyRecommendationDeploymentPodSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendationDeploymentPodSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendationDeploymentPodTemplateSpec) DeepCopyInto(out *PolicyRecommendationDeploymentPodTemplateSpec) {
+	*out = *in
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(PolicyRecommendationDeploymentPodSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationDeploymentPodTemplateSpec.
+func (in *PolicyRecommendationDeploymentPodTemplateSpec) DeepCopy() *PolicyRecommendationDeploymentPodTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendationDeploymentPodTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendationDeploymentSpec) DeepCopyInto(out *PolicyRecommendationDeploymentSpec) {
+	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(PolicyRecommendationDeploymentPodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationDeploymentSpec.
+func (in *PolicyRecommendationDeploymentSpec) DeepCopy() *PolicyRecommendationDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendationDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendationList) DeepCopyInto(out *PolicyRecommendationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PolicyRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationList.
+func (in *PolicyRecommendationList) DeepCopy() *PolicyRecommendationList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyRecommendationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendationSpec) DeepCopyInto(out *PolicyRecommendationSpec) {
+	*out = *in
+	if in.PolicyRecommendationDeployment != nil {
+		in, out := &in.PolicyRecommendationDeployment, &out.PolicyRecommendationDeployment
+		*out = new(PolicyRecommendationDeployment)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationSpec.
+func (in *PolicyRecommendationSpec) DeepCopy() *PolicyRecommendationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecommendationStatus) DeepCopyInto(out *PolicyRecommendationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecommendationStatus.
+func (in *PolicyRecommendationStatus) DeepCopy() *PolicyRecommendationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecommendationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeOverride) DeepCopyInto(out *ProbeOverride) {
+	*out = *in
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InitialDelaySeconds != nil {
+		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeOverride.
+func (in *ProbeOverride) DeepCopy() *ProbeOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Prometheus) DeepCopyInto(out *Prometheus) {
+	*out = *in
+	if in.PrometheusSpec != nil {
+		in, out := &in.PrometheusSpec, &out.PrometheusSpec
+		*out = new(PrometheusSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Prometheus.
+func (in *Prometheus) DeepCopy() *Prometheus {
+	if in == nil {
+		return nil
+	}
+	out := new(Prometheus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusContainer) DeepCopyInto(out *PrometheusContainer) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(ProbeOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(ProbeOverride)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusContainer.
+func (in *PrometheusContainer) DeepCopy() *PrometheusContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
+	*out = *in
+	if in.CommonPrometheusFields != nil {
+		in, out := &in.CommonPrometheusFields, &out.CommonPrometheusFields
+		*out = new(CommonPrometheusFields)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusSpec.
+func (in *PrometheusSpec) DeepCopy() *PrometheusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Proxy) DeepCopyInto(out *Proxy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Proxy.
+func (in *Proxy) DeepCopy() *Proxy {
+	if in == nil {
+		return nil
+	}
+	out := new(Proxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryServerGateway) DeepCopyInto(out *QueryServerGateway) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryServerGateway.
+func (in *QueryServerGateway) DeepCopy() *QueryServerGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryServerGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryServerLogging) DeepCopyInto(out *QueryServerLogging) {
+	*out = *in
+	if in.LogSeverity != nil {
+		in, out := &in.LogSeverity, &out.LogSeverity
+		*out = new(LogSeverity)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryServerLogging.
+func (in *QueryServerLogging) DeepCopy() *QueryServerLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryServerLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryServerRoute) DeepCopyInto(out *QueryServerRoute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryServerRoute.
+func (in *QueryServerRoute) DeepCopy() *QueryServerRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryServerRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenderedResource) DeepCopyInto(out *RenderedResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderedResource.
+func (in *RenderedResource) DeepCopy() *RenderedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(RenderedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Retention) DeepCopyInto(out *Retention) {
+	*out = *in
+	if in.Flows != nil {
+		in, out := &in.Flows, &out.Flows
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AuditReports != nil {
+		in, out := &in.AuditReports, &out.AuditReports
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ComplianceReports != nil {
+		in, out := &in.ComplianceReports, &out.ComplianceReports
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DNSLogs != nil {
+		in, out := &in.DNSLogs, &out.DNSLogs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BGPLogs != nil {
+		in, out := &in.BGPLogs, &out.BGPLogs
 		*out = new(int32)
 		**out = **in
 	}
-	if in.BGPLogs != nil {
-		in, out := &in.BGPLogs, &out.BGPLogs
-		*out = new(int32)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retention.
+func (in *Retention) DeepCopy() *Retention {
+	if in == nil {
+		return nil
+	}
+	out := new(Retention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3DestinationSpec) DeepCopyInto(out *S3DestinationSpec) {
+	*out = *in
+	if in.LogTypes != nil {
+		in, out := &in.LogTypes, &out.LogTypes
+		*out = make([]S3LogType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3DestinationSpec.
+func (in *S3DestinationSpec) DeepCopy() *S3DestinationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(S3DestinationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3StoreSpec) DeepCopyInto(out *S3StoreSpec) {
+	*out = *in
+	if in.HostScope != nil {
+		in, out := &in.HostScope, &out.HostScope
+		*out = new(HostScope)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(FluentdBufferSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]S3DestinationSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3StoreSpec.
+func (in *S3StoreSpec) DeepCopy() *S3StoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(S3StoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNIMatch) DeepCopyInto(out *SNIMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNIMatch.
+func (in *SNIMatch) DeepCopy() *SNIMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(SNIMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityLakeStoreSpec) DeepCopyInto(out *SecurityLakeStoreSpec) {
+	*out = *in
+	if in.LogTypes != nil {
+		in, out := &in.LogTypes, &out.LogTypes
+		*out = make([]SecurityLakeLogType, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostScope != nil {
+		in, out := &in.HostScope, &out.HostScope
+		*out = new(HostScope)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(FluentdBufferSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityLakeStoreSpec.
+func (in *SecurityLakeStoreSpec) DeepCopy() *SecurityLakeStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityLakeStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceMonitor) DeepCopyInto(out *ServiceMonitor) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]Endpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMonitor.
+func (in *ServiceMonitor) DeepCopy() *ServiceMonitor {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceMonitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceOverrides) DeepCopyInto(out *ServiceOverrides) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(corev1.ServiceType)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExternalTrafficPolicy != nil {
+		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
+		*out = new(corev1.ServiceExternalTrafficPolicy)
+		**out = **in
+	}
+	if in.InternalTrafficPolicy != nil {
+		in, out := &in.InternalTrafficPolicy, &out.InternalTrafficPolicy
+		*out = new(corev1.ServiceInternalTrafficPolicy)
+		**out = **in
+	}
+	if in.SessionAffinity != nil {
+		in, out := &in.SessionAffinity, &out.SessionAffinity
+		*out = new(corev1.ServiceAffinity)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOverrides.
+func (in *ServiceOverrides) DeepCopy() *ServiceOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplunkLogTypeSpec) DeepCopyInto(out *SplunkLogTypeSpec) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkLogTypeSpec.
+func (in *SplunkLogTypeSpec) DeepCopy() *SplunkLogTypeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SplunkLogTypeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplunkStoreSpec) DeepCopyInto(out *SplunkStoreSpec) {
+	*out = *in
+	if in.HostScope != nil {
+		in, out := &in.HostScope, &out.HostScope
+		*out = new(HostScope)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(FluentdBufferSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LogTypes != nil {
+		in, out := &in.LogTypes, &out.LogTypes
+		*out = make([]SplunkLogTypeSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkStoreSpec.
+func (in *SplunkStoreSpec) DeepCopy() *SplunkStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SplunkStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportBundle) DeepCopyInto(out *SupportBundle) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundle.
+func (in *SupportBundle) DeepCopy() *SupportBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SupportBundle) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportBundleDestination) DeepCopyInto(out *SupportBundleDestination) {
+	*out = *in
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(SupportBundlePersistentVolumeClaimDestination)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(SupportBundleS3Destination)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retention.
-func (in *Retention) DeepCopy() *Retention {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleDestination.
+func (in *SupportBundleDestination) DeepCopy() *SupportBundleDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(Retention)
+	out := new(SupportBundleDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *S3StoreSpec) DeepCopyInto(out *S3StoreSpec) {
+func (in *SupportBundleList) DeepCopyInto(out *SupportBundleList) {
 	*out = *in
-	if in.HostScope != nil {
-		in, out := &in.HostScope, &out.HostScope
-		*out = new(HostScope)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SupportBundle, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3StoreSpec.
-func (in *S3StoreSpec) DeepCopy() *S3StoreSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleList.
+func (in *SupportBundleList) DeepCopy() *SupportBundleList {
 	if in == nil {
 		return nil
 	}
-	out := new(S3StoreSpec)
+	out := new(SupportBundleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SupportBundleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SNIMatch) DeepCopyInto(out *SNIMatch) {
+func (in *SupportBundlePersistentVolumeClaimDestination) DeepCopyInto(out *SupportBundlePersistentVolumeClaimDestination) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNIMatch.
-func (in *SNIMatch) DeepCopy() *SNIMatch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundlePersistentVolumeClaimDestination.
+func (in *SupportBundlePersistentVolumeClaimDestination) DeepCopy() *SupportBundlePersistentVolumeClaimDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(SNIMatch)
+	out := new(SupportBundlePersistentVolumeClaimDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceMonitor) DeepCopyInto(out *ServiceMonitor) {
+func (in *SupportBundleS3Destination) DeepCopyInto(out *SupportBundleS3Destination) {
 	*out = *in
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleS3Destination.
+func (in *SupportBundleS3Destination) DeepCopy() *SupportBundleS3Destination {
+	if in == nil {
+		return nil
 	}
-	if in.Endpoints != nil {
-		in, out := &in.Endpoints, &out.Endpoints
-		*out = make([]Endpoint, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out := new(SupportBundleS3Destination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportBundleSpec) DeepCopyInto(out *SupportBundleSpec) {
+	*out = *in
+	if in.PodLogTailLines != nil {
+		in, out := &in.PodLogTailLines, &out.PodLogTailLines
+		*out = new(int64)
+		**out = **in
 	}
+	in.Destination.DeepCopyInto(&out.Destination)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMonitor.
-func (in *ServiceMonitor) DeepCopy() *ServiceMonitor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleSpec.
+func (in *SupportBundleSpec) DeepCopy() *SupportBundleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceMonitor)
+	out := new(SupportBundleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SplunkStoreSpec) DeepCopyInto(out *SplunkStoreSpec) {
+func (in *SupportBundleStatus) DeepCopyInto(out *SupportBundleStatus) {
 	*out = *in
-	if in.HostScope != nil {
-		in, out := &in.HostScope, &out.HostScope
-		*out = new(HostScope)
-		**out = **in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkStoreSpec.
-func (in *SplunkStoreSpec) DeepCopy() *SplunkStoreSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportBundleStatus.
+func (in *SupportBundleStatus) DeepCopy() *SupportBundleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SplunkStoreSpec)
+	out := new(SupportBundleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -9033,6 +10793,31 @@ func (in *Sysctl) DeepCopy() *Sysctl {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyslogDestinationSpec) DeepCopyInto(out *SyslogDestinationSpec) {
+	*out = *in
+	if in.PacketSize != nil {
+		in, out := &in.PacketSize, &out.PacketSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LogTypes != nil {
+		in, out := &in.LogTypes, &out.LogTypes
+		*out = make([]SyslogLogType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogDestinationSpec.
+func (in *SyslogDestinationSpec) DeepCopy() *SyslogDestinationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SyslogDestinationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyslogStoreSpec) DeepCopyInto(out *SyslogStoreSpec) {
 	*out = *in
@@ -9051,6 +10836,18 @@ func (in *SyslogStoreSpec) DeepCopyInto(out *SyslogStoreSpec) {
 		*out = new(HostScope)
 		**out = **in
 	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(FluentdBufferSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]SyslogDestinationSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyslogStoreSpec.
@@ -9197,6 +10994,26 @@ func (in *TLSPassThroughRouteSpec) DeepCopy() *TLSPassThroughRouteSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSecurityProfile) DeepCopyInto(out *TLSSecurityProfile) {
+	*out = *in
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = new(CustomTLSProfile)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSecurityProfile.
+func (in *TLSSecurityProfile) DeepCopy() *TLSSecurityProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSecurityProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSTerminatedRoute) DeepCopyInto(out *TLSTerminatedRoute) {
 	*out = *in
@@ -9369,6 +11186,21 @@ func (in *TenantList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantLogCollectorSpec) DeepCopyInto(out *TenantLogCollectorSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantLogCollectorSpec.
+func (in *TenantLogCollectorSpec) DeepCopy() *TenantLogCollectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantLogCollectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TenantSpec) DeepCopyInto(out *TenantSpec) {
 	*out = *in
@@ -9407,6 +11239,16 @@ func (in *TenantSpec) DeepCopyInto(out *TenantSpec) {
 		*out = new(ProductVariant)
 		**out = **in
 	}
+	if in.LogCollector != nil {
+		in, out := &in.LogCollector, &out.LogCollector
+		*out = new(TenantLogCollectorSpec)
+		**out = **in
+	}
+	if in.APIServerDeployment != nil {
+		in, out := &in.APIServerDeployment, &out.APIServerDeployment
+		*out = new(APIServerDeployment)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantSpec.
@@ -9534,6 +11376,16 @@ func (in *TigeraStatusStatus) DeepCopyInto(out *TigeraStatusStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RenderedResources != nil {
+		in, out := &in.RenderedResources, &out.RenderedResources
+		*out = make([]RenderedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.TyphaRollout != nil {
+		in, out := &in.TyphaRollout, &out.TyphaRollout
+		*out = new(TyphaRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TigeraStatusStatus.
@@ -9566,6 +11418,51 @@ func (in *TyphaAffinity) DeepCopy() *TyphaAffinity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TyphaConfiguration) DeepCopyInto(out *TyphaConfiguration) {
+	*out = *in
+	if in.MaxConnectionsUpperLimit != nil {
+		in, out := &in.MaxConnectionsUpperLimit, &out.MaxConnectionsUpperLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConnectionRebalancingMode != nil {
+		in, out := &in.ConnectionRebalancingMode, &out.ConnectionRebalancingMode
+		*out = new(TyphaConnectionRebalancingMode)
+		**out = **in
+	}
+	if in.ConnectionRebalancingIntervalSeconds != nil {
+		in, out := &in.ConnectionRebalancingIntervalSeconds, &out.ConnectionRebalancingIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConnectionDropIntervalSeconds != nil {
+		in, out := &in.ConnectionDropIntervalSeconds, &out.ConnectionDropIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ShutdownMaxDropIntervalSeconds != nil {
+		in, out := &in.ShutdownMaxDropIntervalSeconds, &out.ShutdownMaxDropIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RolloutPauseThresholdPercent != nil {
+		in, out := &in.RolloutPauseThresholdPercent, &out.RolloutPauseThresholdPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TyphaConfiguration.
+func (in *TyphaConfiguration) DeepCopy() *TyphaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TyphaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TyphaDeployment) DeepCopyInto(out *TyphaDeployment) {
 	*out = *in
@@ -9609,6 +11506,11 @@ func (in *TyphaDeploymentContainer) DeepCopyInto(out *TyphaDeploymentContainer)
 		*out = new(ProbeOverride)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeccompProfile != nil {
+		in, out := &in.SeccompProfile, &out.SeccompProfile
+		*out = new(corev1.SeccompProfile)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TyphaDeploymentContainer.
@@ -9689,6 +11591,16 @@ func (in *TyphaDeploymentPodSpec) DeepCopyInto(out *TyphaDeploymentPodSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImagePullPolicy != nil {
+		in, out := &in.ImagePullPolicy, &out.ImagePullPolicy
+		*out = new(corev1.PullPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TyphaDeploymentPodSpec.
@@ -9726,6 +11638,45 @@ func (in *TyphaDeploymentPodTemplateSpec) DeepCopy() *TyphaDeploymentPodTemplate
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TyphaDeploymentPool) DeepCopyInto(out *TyphaDeploymentPool) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TyphaDeploymentPool.
+func (in *TyphaDeploymentPool) DeepCopy() *TyphaDeploymentPool {
+	if in == nil {
+		return nil
+	}
+	out := new(TyphaDeploymentPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TyphaDeploymentSpec) DeepCopyInto(out *TyphaDeploymentSpec) {
 	*out = *in
@@ -9744,6 +11695,18 @@ func (in *TyphaDeploymentSpec) DeepCopyInto(out *TyphaDeploymentSpec) {
 		*out = new(TyphaDeploymentStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]TyphaDeploymentPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TyphaDeploymentSpec.
@@ -9776,6 +11739,48 @@ func (in *TyphaDeploymentStrategy) DeepCopy() *TyphaDeploymentStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TyphaRevisionStatus) DeepCopyInto(out *TyphaRevisionStatus) {
+	*out = *in
+	if in.ConnectedClients != nil {
+		in, out := &in.ConnectedClients, &out.ConnectedClients
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TyphaRevisionStatus.
+func (in *TyphaRevisionStatus) DeepCopy() *TyphaRevisionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TyphaRevisionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TyphaRolloutStatus) DeepCopyInto(out *TyphaRolloutStatus) {
+	*out = *in
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]TyphaRevisionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TyphaRolloutStatus.
+func (in *TyphaRolloutStatus) DeepCopy() *TyphaRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TyphaRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserMatch) DeepCopyInto(out *UserMatch) {
 	*out = *in
@@ -9806,6 +11811,26 @@ func (in *UserSearch) DeepCopy() *UserSearch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerticalPodAutoscaling) DeepCopyInto(out *VerticalPodAutoscaling) {
+	*out = *in
+	if in.UpdateMode != nil {
+		in, out := &in.UpdateMode, &out.UpdateMode
+		*out = new(VPAUpdateMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalPodAutoscaling.
+func (in *VerticalPodAutoscaling) DeepCopy() *VerticalPodAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalPodAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Whisker) DeepCopyInto(out *Whisker) {
 	*out = *in
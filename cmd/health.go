@@ -0,0 +1,36 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// healthAddr returns the bind address for the controller health endpoint. It defaults to 0.0.0.0:9485 and can be
+// overridden via HEALTH_HOST and HEALTH_PORT.
+func healthAddr() string {
+	healthHost := os.Getenv("HEALTH_HOST")
+	if healthHost == "" {
+		healthHost = "0.0.0.0"
+	}
+
+	healthPort := os.Getenv("HEALTH_PORT")
+	if healthPort == "" {
+		return fmt.Sprintf("%s:%d", healthHost, defaultHealthPort)
+	}
+
+	return fmt.Sprintf("%s:%s", healthHost, healthPort)
+}
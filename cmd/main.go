@@ -36,19 +36,25 @@ import (
 	"github.com/tigera/operator/pkg/awssgsetup"
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/components"
+	"github.com/tigera/operator/pkg/controller/health"
 	"github.com/tigera/operator/pkg/controller/metrics"
 	"github.com/tigera/operator/pkg/controller/migration/datastoremigration"
 	"github.com/tigera/operator/pkg/controller/options"
 	"github.com/tigera/operator/pkg/controller/utils"
 	"github.com/tigera/operator/pkg/dns"
+	"github.com/tigera/operator/pkg/dryrun"
 	"github.com/tigera/operator/pkg/imports/admission"
 	"github.com/tigera/operator/pkg/imports/crds"
+	"github.com/tigera/operator/pkg/namespacescope"
 	"github.com/tigera/operator/pkg/render"
 	"github.com/tigera/operator/pkg/render/intrusiondetection/dpi"
 	"github.com/tigera/operator/pkg/render/istio"
 	"github.com/tigera/operator/pkg/render/logstorage"
 	"github.com/tigera/operator/pkg/render/logstorage/eck"
+	"github.com/tigera/operator/pkg/render/operatorwebhook"
+	"github.com/tigera/operator/pkg/supportbundle"
 	operatortls "github.com/tigera/operator/pkg/tls"
+	opwebhook "github.com/tigera/operator/pkg/webhook"
 	"github.com/tigera/operator/version"
 
 	operatortigeraiov1 "github.com/tigera/operator/api/v1"
@@ -62,6 +68,7 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/tools/clientcmd"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
@@ -75,6 +82,7 @@ import (
 
 var (
 	defaultMetricsPort int32 = 9484
+	defaultHealthPort  int32 = 9485
 	scheme                   = runtime.NewScheme()
 	setupLog                 = ctrl.Log.WithName("setup")
 )
@@ -106,9 +114,11 @@ func main() {
 	var printImages string
 	var printCalicoCRDs string
 	var printEnterpriseCRDs string
+	var renderFile string
 	var sgSetup bool
 	var manageCRDs bool
 	var preDelete bool
+	var supportBundle string
 	var variant string
 
 	// bootstrapCRDs is a flag that can be used to install the CRDs and exit. This is useful for
@@ -128,6 +138,11 @@ If a value other than 'all' is specified, the first CRD with a prefix of the spe
 		&printEnterpriseCRDs, "print-enterprise-crds", "",
 		`Print the Enterprise CRDs the operator has bundled then exit. Possible values: all, <crd prefix>.
 If a value other than 'all' is specified, the first CRD with a prefix of the specified value will be printed.`,
+	)
+	flag.StringVar(
+		&renderFile, "render", "",
+		`Print the objects the operator would create for the Installation and optional APIServer in the given YAML file then exit.
+Use - to read from stdin. This does not connect to a cluster, and only the Calico variant is supported.`,
 	)
 	flag.StringVar(&urlOnlyKubeconfig, "url-only-kubeconfig", "", "Path to a kubeconfig, but only for the apiserver url.")
 	flag.BoolVar(&showVersion, "version", false, "Show version information")
@@ -135,6 +150,7 @@ If a value other than 'all' is specified, the first CRD with a prefix of the spe
 	flag.BoolVar(&sgSetup, "aws-sg-setup", false, "Setup Security Groups in AWS (should only be used on OpenShift).")
 	flag.BoolVar(&manageCRDs, "manage-crds", false, "Operator should manage the projectcalico.org and operator.tigera.io CRDs.")
 	flag.BoolVar(&preDelete, "pre-delete", false, "Run helm pre-deletion hook logic, then exit.")
+	flag.StringVar(&supportBundle, "collect-support-bundle", "", "Collect the SupportBundle with the given name and write it to its destination, then exit.")
 	flag.BoolVar(&bootstrapCRDs, "bootstrap-crds", false, "Install CRDs and exit")
 	flag.StringVar(&variant, "variant", string(operatortigeraiov1.Calico), "Default product variant to assume during boostrapping.")
 
@@ -189,6 +205,14 @@ If a value other than 'all' is specified, the first CRD with a prefix of the spe
 		os.Exit(0)
 	}
 
+	if renderFile != "" {
+		if err := renderOffline(renderFile); err != nil {
+			setupLog.Error(err, "Failed to render the components offline")
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	if urlOnlyKubeconfig != "" {
 		if err := setKubernetesServiceEnv(urlOnlyKubeconfig); err != nil {
 			setupLog.Error(err, "Terminating")
@@ -229,6 +253,17 @@ If a value other than 'all' is specified, the first CRD with a prefix of the spe
 		apigroup.Set(apigroup.V3)
 	}
 
+	// Restrict the operator to the configured namespaces, if any. In this mode the operator runs without
+	// cluster-wide RBAC and components that need cluster scope are reported as degraded.
+	namespacescope.SetFromEnv()
+	if namespacescope.Restricted() {
+		if manageCRDs || bootstrapCRDs {
+			setupLog.Error(fmt.Errorf("CRDs are cluster scoped and cannot be managed while the operator is restricted to namespaces"), "Invalid configuration")
+			os.Exit(1)
+		}
+		setupLog.WithValues("namespaces", namespacescope.Namespaces()).Info("Restricting the operator to namespaces")
+	}
+
 	// Add the Calico API to the scheme, now that we know which backing CRD version to use.
 	utilruntime.Must(apis.AddToScheme(scheme, v3CRDs))
 
@@ -246,6 +281,15 @@ If a value other than 'all' is specified, the first CRD with a prefix of the spe
 		os.Exit(0)
 	}
 
+	if supportBundle != "" {
+		log.Info("Collecting support bundle", "name", supportBundle)
+		if err := supportbundle.Run(ctx, c, cs, supportBundle); err != nil {
+			log.Error(err, "Failed to collect support bundle")
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	if preDelete {
 		// We've built a client - we can use it to clean up.
 		if err := executePreDeleteHook(ctx, c); err != nil {
@@ -302,12 +346,24 @@ If a value other than 'all' is specified, the first CRD with a prefix of the spe
 		}
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
-		Scheme:  scheme,
-		Metrics: metricsOpts,
-		WebhookServer: webhook.NewServer(webhook.Options{
-			Port: 9443,
-		}),
+	webhookOpts := webhook.Options{
+		Port: operatorwebhook.Port,
+	}
+	var mgr ctrl.Manager
+	if common.OperatorWebhookEnabled() {
+		// The webhook keypair is created by the installation controller, so it is read from the cache of the manager
+		// rather than from a mounted volume. The webhook server is only configured once the manager starts.
+		webhookOpts.TLSOpts = []func(*tls.Config){
+			func(cfg *tls.Config) {
+				cfg.GetCertificate = opwebhook.GetCertificate(mgr.GetClient())
+			},
+		}
+	}
+
+	mgr, err = ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		Scheme:           scheme,
+		Metrics:          metricsOpts,
+		WebhookServer:    webhook.NewServer(webhookOpts),
 		LeaderElection:   enableLeaderElection,
 		LeaderElectionID: "operator-lock",
 		// We should test this again in the future to see if the problem with LicenseKey updates
@@ -329,6 +385,12 @@ If a value other than 'all' is specified, the first CRD with a prefix of the spe
 		// not being this mapper (which has since been rectified). It was a tough issue to figure out when the default
 		// had changed out from under us, so better to continue to explicitly set it as we know this is the mapper we want.
 		MapperProvider: apiutil.NewDynamicRESTMapper,
+
+		// Only cache namespaced objects from the namespaces the operator is restricted to, since it cannot
+		// list or watch the rest.
+		Cache: cache.Options{
+			DefaultNamespaces: namespacescope.CacheNamespaces(),
+		},
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -506,6 +568,7 @@ If a value other than 'all' is specified, the first CRD with a prefix of the spe
 		ManageCRDs:          manageCRDs,
 		ShutdownContext:     ctx,
 		K8sClientset:        clientset,
+		EventRecorder:       mgr.GetEventRecorderFor("tigera-operator"),
 		MultiTenant:         multiTenant,
 		ElasticExternal:     utils.UseExternalElastic(bootConfig),
 		UseV3CRDs:           v3CRDs,
@@ -523,12 +586,25 @@ If a value other than 'all' is specified, the first CRD with a prefix of the spe
 		os.Exit(1)
 	}
 
+	// Validate the operator's own custom resources at admission time.
+	if common.OperatorWebhookEnabled() {
+		opwebhook.Register(mgr.GetWebhookServer(), mgr.GetScheme())
+	}
+
 	// Register custom Prometheus metrics collector.
 	if common.MetricsEnabled() {
 		collector := metrics.NewOperatorCollector(mgr.GetClient(), enterpriseCRDExists)
 		ctrlmetrics.Registry.MustRegister(collector)
 	}
 
+	// Serve the health of each controller.
+	if common.HealthEnabled() {
+		if err := mgr.Add(health.NewServer(healthAddr(), health.DefaultRegistry)); err != nil {
+			setupLog.Error(err, "unable to add the controller health server")
+			os.Exit(1)
+		}
+	}
+
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "problem running manager")
@@ -595,6 +671,29 @@ func showCRDs(variant operatortigeraiov1.ProductVariant, outputType string) erro
 	return nil
 }
 
+// renderOffline prints the objects rendered for the custom resources in the given file, without connecting to a cluster.
+func renderOffline(path string) error {
+	in := os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", path, err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	input, err := dryrun.Decode(in)
+	if err != nil {
+		return err
+	}
+	objs, err := dryrun.Render(input, dns.DefaultClusterDomain)
+	if err != nil {
+		return err
+	}
+	return dryrun.Write(os.Stdout, objs)
+}
+
 func executePreDeleteHook(ctx context.Context, c client.Client) error {
 	defer log.Info("preDelete hook exiting")
 
@@ -636,6 +735,10 @@ func executePreDeleteHook(ctx context.Context, c client.Client) error {
 
 // verifyConfiguration verifies that the final configuration of the operator is correct before starting any controllers.
 func verifyConfiguration(ctx context.Context, cs kubernetes.Interface, opts options.ControllerOptions) error {
+	if !namespacescope.Manages(render.ElasticsearchNamespace) {
+		// The operator cannot read the Elasticsearch secrets, nor install Elasticsearch.
+		return nil
+	}
 	if opts.ElasticExternal {
 		// There should not be an internal-es cert
 		if _, err := cs.CoreV1().Secrets(render.ElasticsearchNamespace).Get(ctx, render.TigeraElasticsearchInternalCertSecret, metav1.GetOptions{}); err != nil {
@@ -19,6 +19,9 @@ package components
 
 var (
 	CalicoRelease string = "{{ .Title }}"
+
+	CalicoMinKubernetesVersion string = "{{ .KubernetesVersions.Min }}"
+	CalicoMaxKubernetesVersion string = "{{ .KubernetesVersions.Max }}"
 {{ with index .Components "cni-windows" }}
 	ComponentCalicoCNIWindows = Component{
 		Version:   "{{ .Version }}",
@@ -126,6 +129,24 @@ var (
 		variant:   calicoVariant,
 	}
 {{- end }}
+{{ with index .Components "prometheus" }}
+	ComponentCalicoPrometheus = Component{
+		Version:   "{{ .Version }}",
+		Image:     "{{ .Image }}",
+		Registry:  "{{ .Registry }}",
+		imagePath: "{{ .ImagePath }}",
+		variant:   calicoVariant,
+	}
+{{- end }}
+{{ with index .Components "kube-rbac-proxy" }}
+	ComponentCalicoKubeRBACProxy = Component{
+		Version:   "{{ .Version }}",
+		Image:     "{{ .Image }}",
+		Registry:  "{{ .Registry }}",
+		imagePath: "{{ .ImagePath }}",
+		variant:   calicoVariant,
+	}
+{{- end }}
 {{ with index .Components.calico }}
 	ComponentCalico = Component{
 		Version:   "{{ .Version }}",
@@ -157,6 +178,8 @@ var (
 		ComponentCalicoIstioInstallCNI,
 		ComponentCalicoIstioZTunnel,
 		ComponentCalicoIstioProxyv2,
+		ComponentCalicoPrometheus,
+		ComponentCalicoKubeRBACProxy,
 		ComponentCalico,
 		ComponentCalicoFIPS,
 	}
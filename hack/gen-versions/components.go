@@ -62,6 +62,8 @@ var (
 		"istio-install-cni":           "istio-install-cni",
 		"istio-ztunnel":               "istio-ztunnel",
 		"istio-proxyv2":               "istio-proxyv2",
+		"prometheus":                  "prometheus",
+		"kube-rbac-proxy":             "kube-rbac-proxy",
 		"webhooks":                    "webhooks",
 		"calico":                      "calico",
 	}
@@ -81,6 +83,13 @@ type Release struct {
 	// Calico or Enterprise version included in the operator.
 	Title      string     `yaml:"title"`
 	Components Components `yaml:"components"`
+	// KubernetesVersions is the range of Kubernetes versions, as major.minor, that the release supports.
+	KubernetesVersions KubernetesVersions `yaml:"kubernetesVersions"`
+}
+
+type KubernetesVersions struct {
+	Min string `yaml:"min"`
+	Max string `yaml:"max"`
 }
 
 type Components map[string]*Component
@@ -103,6 +112,7 @@ func GetComponents(versionsPath string) (Release, error) {
 
 	cv.Components = make(Components)
 	cv.Title = v.Title
+	cv.KubernetesVersions = v.KubernetesVersions
 
 	// parse through the components listed in versions.yml to:
 	// - add known default images to any components that are missing them.
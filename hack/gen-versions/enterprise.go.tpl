@@ -19,6 +19,9 @@ package components
 
 var (
 	EnterpriseRelease string = "{{ .Title }}"
+
+	EnterpriseMinKubernetesVersion string = "{{ .KubernetesVersions.Min }}"
+	EnterpriseMaxKubernetesVersion string = "{{ .KubernetesVersions.Max }}"
 {{ with index .Components "calico" }}
 	ComponentTigeraCalico = Component{
 		Version:   "{{ .Version }}",
@@ -27,6 +30,14 @@ var (
 		imagePath: "{{ .ImagePath }}",
 		variant:   enterpriseVariant,
 	}
+
+	ComponentTigeraCalicoFIPS = Component{
+		Version:   "{{ .Version }}-fips",
+		Image:     "{{ .Image }}",
+		Registry:  "{{ .Registry }}",
+		imagePath: "{{ .ImagePath }}",
+		variant:   enterpriseVariant,
+	}
 {{- end }}
 {{ with index .Components "compliance-benchmarker" }}
 	ComponentComplianceBenchmarker = Component{
@@ -99,6 +110,14 @@ var (
 		imagePath: "{{ .ImagePath }}",
 		variant:   enterpriseVariant,
 	}
+
+	ComponentFluentdFIPS = Component{
+		Version:   "{{ .Version }}-fips",
+		Image:     "{{ .Image }}",
+		Registry:  "{{ .Registry }}",
+		imagePath: "{{ .ImagePath }}",
+		variant:   enterpriseVariant,
+	}
 {{- end }}
 {{ with index .Components "fluentd-windows" }}
 	ComponentFluentdWindows = Component{
@@ -109,6 +128,15 @@ var (
 		variant:   enterpriseVariant,
 	}
 {{- end }}
+{{ with index .Components "log-exporter" }}
+	ComponentLogExporter = Component{
+		Version:   "{{ .Version }}",
+		Image:     "{{ .Image }}",
+		Registry:  "{{ .Registry }}",
+		imagePath: "{{ .ImagePath }}",
+		variant:   enterpriseVariant,
+	}
+{{- end }}
 {{ with index .Components "intrusion-detection-controller" }}
 	ComponentIntrusionDetectionController = Component{
 		Version:   "{{ .Version }}",
@@ -210,6 +238,15 @@ var (
 		variant:   enterpriseVariant,
 	}
 {{- end }}
+{{ with index .Components "kube-rbac-proxy" }}
+	ComponentKubeRBACProxy = Component{
+		Version:   "{{ .Version }}",
+		Image:     "{{ .Image }}",
+		Registry:  "{{ .Registry }}",
+		imagePath: "{{ .ImagePath }}",
+		variant:   enterpriseVariant,
+	}
+{{- end }}
 {{ with index .Components "node" }}
 	ComponentTigeraNode = Component{
 		Version:   "{{ .Version }}",
@@ -301,13 +338,16 @@ var (
 	// Components that are only for providing a version should be left out of this list.
 	EnterpriseImages = []Component{
 		ComponentTigeraCalico,
+		ComponentTigeraCalicoFIPS,
 		ComponentComplianceBenchmarker,
 		ComponentDeepPacketInspection,
 		ComponentElasticTseeInstaller,
 		ComponentElasticsearch,
 		ComponentElasticsearchOperator,
 		ComponentFluentd,
+		ComponentFluentdFIPS,
 		ComponentFluentdWindows,
+		ComponentLogExporter,
 		ComponentIntrusionDetectionController,
 		ComponentKibana,
 		ComponentManager,
@@ -318,6 +358,7 @@ var (
 		ComponentDikastes,
 		ComponentPrometheus,
 		ComponentPrometheusAlertmanager,
+		ComponentKubeRBACProxy,
 		ComponentTigeraNode,
 		ComponentTigeraNodeWindows,
 		ComponentTigeraCNIWindows,
@@ -35,6 +35,10 @@ type LogCollectorReconciler struct {
 
 // +kubebuilder:rbac:groups=operator.tigera.io,resources=logcollectors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.tigera.io,resources=logcollectors/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=operator.tigera.io,resources=logsources,verbs=get;list;watch
+// +kubebuilder:rbac:groups=operator.tigera.io,resources=logsources/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=operator.tigera.io,resources=logexports,verbs=get;list;watch
+// +kubebuilder:rbac:groups=operator.tigera.io,resources=logexports/status,verbs=get;update;patch
 
 func (r *LogCollectorReconciler) SetupWithManager(mgr ctrl.Manager, opts options.ControllerOptions) error {
 	return logcollector.Add(mgr, opts)
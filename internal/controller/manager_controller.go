@@ -35,6 +35,7 @@ type ManagerReconciler struct {
 
 // +kubebuilder:rbac:groups=operator.tigera.io,resources=managers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.tigera.io,resources=managers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=projectcalico.org,resources=managedclusters,verbs=get;list;watch;update
 
 func (r *ManagerReconciler) SetupWithManager(mgr ctrl.Manager, opts options.ControllerOptions) error {
 	return manager.Add(mgr, opts)
@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/tigera/operator/pkg/controller/options"
+	"github.com/tigera/operator/pkg/controller/supportbundle"
+)
+
+// SupportBundleReconciler reconciles a SupportBundle object
+type SupportBundleReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=operator.tigera.io,resources=supportbundles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=operator.tigera.io,resources=supportbundles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=pods/log,verbs=get
+
+func (r *SupportBundleReconciler) SetupWithManager(mgr ctrl.Manager, opts options.ControllerOptions) error {
+	return supportbundle.Add(mgr, opts)
+}
@@ -18,20 +18,25 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	esv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/elasticsearch/v1"
 	kbv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/kibana/v1"
 	envoy "github.com/envoyproxy/gateway/api/v1alpha1"
 	netattachv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	configv1 "github.com/openshift/api/config/v1"
+	routev1 "github.com/openshift/api/route/v1"
 	ocsv1 "github.com/openshift/api/security/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 	operatorv1 "github.com/tigera/operator/api/v1"
+	sigstorev1beta1 "github.com/tigera/operator/pkg/apis/sigstore/v1beta1"
+	vpav1 "github.com/tigera/operator/pkg/apis/vpa/v1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
@@ -41,6 +46,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	aggregator "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset/scheme"
 	gateway "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	csisecret "sigs.k8s.io/secrets-store-csi-driver/apis/v1"
 )
 
@@ -62,11 +68,13 @@ func init() {
 	AddToSchemes = append(AddToSchemes, aggregator.AddToScheme)
 	AddToSchemes = append(AddToSchemes, apiextensions.AddToScheme)
 	AddToSchemes = append(AddToSchemes, ocsv1.AddToScheme)
+	AddToSchemes = append(AddToSchemes, routev1.Install)
 	AddToSchemes = append(AddToSchemes, esv1.SchemeBuilder.AddToScheme)
 	AddToSchemes = append(AddToSchemes, kbv1.SchemeBuilder.AddToScheme)
 	AddToSchemes = append(AddToSchemes, policyv1.SchemeBuilder.AddToScheme)
 	AddToSchemes = append(AddToSchemes, policyv1beta1.SchemeBuilder.AddToScheme)
 	AddToSchemes = append(AddToSchemes, gateway.Install)
+	AddToSchemes = append(AddToSchemes, gatewayv1alpha2.Install)
 	AddToSchemes = append(AddToSchemes, envoy.AddToScheme)
 	AddToSchemes = append(AddToSchemes, csisecret.AddToScheme)
 	AddToSchemes = append(AddToSchemes, operatorv1.AddToScheme)
@@ -80,6 +88,10 @@ func init() {
 	AddToSchemes = append(AddToSchemes, certificatesv1.AddToScheme)
 	AddToSchemes = append(AddToSchemes, networkingv1.AddToScheme)
 	AddToSchemes = append(AddToSchemes, netattachv1.AddToScheme)
+	AddToSchemes = append(AddToSchemes, flowcontrolv1.AddToScheme)
+	AddToSchemes = append(AddToSchemes, certmanagerv1.AddToScheme)
+	AddToSchemes = append(AddToSchemes, sigstorev1beta1.AddToScheme)
+	AddToSchemes = append(AddToSchemes, vpav1.AddToScheme)
 }
 
 func calicoSchemeBuilder(useV3 bool) func(*runtime.Scheme) error {
@@ -105,12 +117,18 @@ func calicoSchemeBuilder(useV3 bool) func(*runtime.Scheme) error {
 			&v3.IPAMConfigurationList{},
 			&v3.LicenseKey{},
 			&v3.LicenseKeyList{},
+			&v3.ManagedCluster{},
+			&v3.ManagedClusterList{},
 			&v3.NetworkPolicy{},
 			&v3.NetworkPolicyList{},
 			&v3.NetworkSet{},
 			&v3.NetworkSetList{},
 			&v3.PolicyRecommendationScope{},
 			&v3.PolicyRecommendationScopeList{},
+			&v3.StagedGlobalNetworkPolicy{},
+			&v3.StagedGlobalNetworkPolicyList{},
+			&v3.StagedNetworkPolicy{},
+			&v3.StagedNetworkPolicyList{},
 			&v3.Tier{},
 			&v3.TierList{},
 			&v3.UISettings{},
@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	SchemeGroupVersion = schema.GroupVersion{Group: "policy.sigstore.dev", Version: "v1beta1"}
+	SchemeBuilder      = runtime.NewSchemeBuilder(addKnownTypes)
+	AddToScheme        = SchemeBuilder.AddToScheme
+)
+
+const (
+	ClusterImagePolicyKind = "ClusterImagePolicy"
+
+	ModeEnforce = "enforce"
+	ModeWarn    = "warn"
+)
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&ClusterImagePolicy{},
+		&ClusterImagePolicyList{},
+	)
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
+
+// ClusterImagePolicy is a minimal stub for the policy.sigstore.dev/v1beta1 ClusterImagePolicy CR of the sigstore
+// policy-controller. It contains only the fields the operator sets when it requires the signatures of its images to
+// be verified, so that the operator does not depend on the policy-controller module.
+type ClusterImagePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ClusterImagePolicySpec `json:"spec"`
+}
+
+type ClusterImagePolicySpec struct {
+	// Images are the glob patterns of the images the policy applies to.
+	Images      []ImagePattern `json:"images"`
+	Authorities []Authority    `json:"authorities"`
+	// Mode is either enforce, to reject pods with images that fail verification, or warn.
+	Mode string `json:"mode,omitempty"`
+}
+
+type ImagePattern struct {
+	Glob string `json:"glob"`
+}
+
+type Authority struct {
+	Name string        `json:"name,omitempty"`
+	Key  *KeyReference `json:"key,omitempty"`
+}
+
+type KeyReference struct {
+	// Data is the PEM encoded public key.
+	Data          string `json:"data,omitempty"`
+	HashAlgorithm string `json:"hashAlgorithm,omitempty"`
+}
+
+func (in *ClusterImagePolicy) DeepCopyObject() runtime.Object {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterImagePolicy)
+	in.DeepCopyInto(&out.ObjectMeta)
+	out.TypeMeta = in.TypeMeta
+	out.Spec.Mode = in.Spec.Mode
+	if in.Spec.Images != nil {
+		out.Spec.Images = append([]ImagePattern{}, in.Spec.Images...)
+	}
+	if in.Spec.Authorities != nil {
+		out.Spec.Authorities = make([]Authority, len(in.Spec.Authorities))
+		for i, a := range in.Spec.Authorities {
+			out.Spec.Authorities[i] = a
+			if a.Key != nil {
+				key := *a.Key
+				out.Spec.Authorities[i].Key = &key
+			}
+		}
+	}
+	return out
+}
+
+// ClusterImagePolicyList is a list of ClusterImagePolicy resources.
+type ClusterImagePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterImagePolicy `json:"items"`
+}
+
+func (in *ClusterImagePolicyList) DeepCopyObject() runtime.Object {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterImagePolicyList)
+	out.TypeMeta = in.TypeMeta
+	in.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		out.Items = make([]ClusterImagePolicy, len(in.Items))
+		for i := range in.Items {
+			item := in.Items[i].DeepCopyObject().(*ClusterImagePolicy)
+			out.Items[i] = *item
+		}
+	}
+	return out
+}
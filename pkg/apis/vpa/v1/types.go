@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	SchemeGroupVersion = schema.GroupVersion{Group: "autoscaling.k8s.io", Version: "v1"}
+	SchemeBuilder      = runtime.NewSchemeBuilder(addKnownTypes)
+	AddToScheme        = SchemeBuilder.AddToScheme
+)
+
+const (
+	VerticalPodAutoscalerKind = "VerticalPodAutoscaler"
+
+	// ContainerPolicyAllContainers is the container name of a policy that applies to all containers.
+	ContainerPolicyAllContainers = "*"
+
+	// ControlledValuesRequestsOnly limits the autoscaler to setting resource requests, leaving limits alone.
+	ControlledValuesRequestsOnly = "RequestsOnly"
+)
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&VerticalPodAutoscaler{},
+		&VerticalPodAutoscalerList{},
+	)
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
+
+// VerticalPodAutoscaler is a minimal stub for the autoscaling.k8s.io/v1 VerticalPodAutoscaler CR. It contains only
+// the fields the operator sets for its components, so that the operator does not depend on the autoscaler module.
+type VerticalPodAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VerticalPodAutoscalerSpec `json:"spec"`
+}
+
+type VerticalPodAutoscalerSpec struct {
+	// TargetRef is the workload whose pods are autoscaled.
+	TargetRef      *autoscalingv1.CrossVersionObjectReference `json:"targetRef"`
+	UpdatePolicy   *PodUpdatePolicy                           `json:"updatePolicy,omitempty"`
+	ResourcePolicy *PodResourcePolicy                         `json:"resourcePolicy,omitempty"`
+}
+
+type PodUpdatePolicy struct {
+	// UpdateMode is one of Off, Initial, Recreate or InPlaceOrRecreate.
+	UpdateMode *string `json:"updateMode,omitempty"`
+}
+
+type PodResourcePolicy struct {
+	ContainerPolicies []ContainerResourcePolicy `json:"containerPolicies,omitempty"`
+}
+
+type ContainerResourcePolicy struct {
+	ContainerName string `json:"containerName,omitempty"`
+	// ControlledValues is either RequestsAndLimits or RequestsOnly.
+	ControlledValues *string `json:"controlledValues,omitempty"`
+}
+
+func (in *VerticalPodAutoscaler) DeepCopyObject() runtime.Object {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalPodAutoscaler)
+	in.DeepCopyInto(&out.ObjectMeta)
+	out.TypeMeta = in.TypeMeta
+	if in.Spec.TargetRef != nil {
+		targetRef := *in.Spec.TargetRef
+		out.Spec.TargetRef = &targetRef
+	}
+	if in.Spec.UpdatePolicy != nil {
+		out.Spec.UpdatePolicy = &PodUpdatePolicy{}
+		if in.Spec.UpdatePolicy.UpdateMode != nil {
+			mode := *in.Spec.UpdatePolicy.UpdateMode
+			out.Spec.UpdatePolicy.UpdateMode = &mode
+		}
+	}
+	if in.Spec.ResourcePolicy != nil {
+		out.Spec.ResourcePolicy = &PodResourcePolicy{}
+		for _, p := range in.Spec.ResourcePolicy.ContainerPolicies {
+			if p.ControlledValues != nil {
+				values := *p.ControlledValues
+				p.ControlledValues = &values
+			}
+			out.Spec.ResourcePolicy.ContainerPolicies = append(out.Spec.ResourcePolicy.ContainerPolicies, p)
+		}
+	}
+	return out
+}
+
+// VerticalPodAutoscalerList is a list of VerticalPodAutoscaler resources.
+type VerticalPodAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VerticalPodAutoscaler `json:"items"`
+}
+
+func (in *VerticalPodAutoscalerList) DeepCopyObject() runtime.Object {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalPodAutoscalerList)
+	out.TypeMeta = in.TypeMeta
+	in.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		out.Items = make([]VerticalPodAutoscaler, len(in.Items))
+		for i := range in.Items {
+			item := in.Items[i].DeepCopyObject().(*VerticalPodAutoscaler)
+			out.Items[i] = *item
+		}
+	}
+	return out
+}
@@ -515,3 +515,66 @@ func validateMinDomains(fldPath *field.Path, minDomains *int32, action core.Unsa
 	}
 	return allErrs
 }
+
+// ValidateSeccompProfileField validates the given seccomp profile.
+func ValidateSeccompProfileField(sp *core.SeccompProfile, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if sp == nil {
+		return allErrs
+	}
+
+	if err := validateSeccompProfileType(fldPath.Child("type"), sp.Type); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if sp.Type == core.SeccompProfileTypeLocalhost {
+		if sp.LocalhostProfile == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("localhostProfile"), "must be set when seccomp type is Localhost"))
+		} else {
+			allErrs = append(allErrs, validateLocalDescendingPath(*sp.LocalhostProfile, fldPath.Child("localhostProfile"))...)
+		}
+	} else if sp.LocalhostProfile != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("localhostProfile"), sp, "can only be set when seccomp type is Localhost"))
+	}
+
+	return allErrs
+}
+
+func validateSeccompProfileType(fldPath *field.Path, seccompProfileType core.SeccompProfileType) *field.Error {
+	switch seccompProfileType {
+	case core.SeccompProfileTypeLocalhost, core.SeccompProfileTypeRuntimeDefault, core.SeccompProfileTypeUnconfined:
+		return nil
+	case "":
+		return field.Required(fldPath, "type is required when seccompProfile is set")
+	default:
+		return field.NotSupported(fldPath, seccompProfileType, []string{string(core.SeccompProfileTypeLocalhost), string(core.SeccompProfileTypeRuntimeDefault), string(core.SeccompProfileTypeUnconfined)})
+	}
+}
+
+// validateLocalDescendingPath validates that the given path is relative and does not contain '..'.
+func validateLocalDescendingPath(targetPath string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if strings.HasPrefix(targetPath, "/") {
+		allErrs = append(allErrs, field.Invalid(fldPath, targetPath, "must be a relative path"))
+	}
+	for _, item := range strings.Split(targetPath, "/") {
+		if item == ".." {
+			allErrs = append(allErrs, field.Invalid(fldPath, targetPath, "must not contain '..'"))
+			break
+		}
+	}
+	return allErrs
+}
+
+// ValidateAppArmorProfileFormat validates the given AppArmor profile name as used in the
+// container.apparmor.security.beta.kubernetes.io annotation.
+func ValidateAppArmorProfileFormat(profile string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if profile == "" || profile == core.DeprecatedAppArmorBetaProfileRuntimeDefault || profile == core.DeprecatedAppArmorBetaProfileNameUnconfined {
+		return allErrs
+	}
+	if !strings.HasPrefix(profile, core.DeprecatedAppArmorBetaProfileNamePrefix) || profile == core.DeprecatedAppArmorBetaProfileNamePrefix {
+		allErrs = append(allErrs, field.Invalid(fldPath, profile, "must be runtime/default, unconfined or localhost/<profile>"))
+	}
+	return allErrs
+}
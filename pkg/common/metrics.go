@@ -28,3 +28,8 @@ func MetricsEnabled() bool {
 func MetricsTLSEnabled() bool {
 	return strings.EqualFold(os.Getenv("METRICS_SCHEME"), "https")
 }
+
+// HealthEnabled returns true when the operator controller health endpoint is enabled via HEALTH_ENABLED=true.
+func HealthEnabled() bool {
+	return strings.EqualFold(os.Getenv("HEALTH_ENABLED"), "true")
+}
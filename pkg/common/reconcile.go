@@ -0,0 +1,32 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ReconcileAnnotation may be set to ReconcilePausedValue on the APIServer, LogCollector or Installation CR to stop
+	// the corresponding controller from reconciling, so that manual changes to the resources it manages are not
+	// overwritten. This is intended for break-glass debugging only; remove the annotation to resume reconciliation.
+	ReconcileAnnotation  = "operator.tigera.io/reconcile"
+	ReconcilePausedValue = "paused"
+)
+
+// ReconcilePaused returns true if reconciliation of the given CR has been paused with the ReconcileAnnotation.
+func ReconcilePaused(obj metav1.Object) bool {
+	return obj != nil && obj.GetAnnotations()[ReconcileAnnotation] == ReconcilePausedValue
+}
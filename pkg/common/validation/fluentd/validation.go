@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/tigera/operator/pkg/common/k8svalidation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateFluentdDaemonSetContainer validates the given container is a valid Fluentd DaemonSet container.
+func ValidateFluentdDaemonSetContainer(container corev1.Container) error {
+	errs := k8svalidation.ValidateResourceRequirements(&container.Resources, field.NewPath("spec", "template", "spec", "containers"))
+	return errs.ToAggregate()
+}
+
+// ValidateFluentdDaemonSetInitContainer validates the given container is a valid Fluentd DaemonSet init container.
+func ValidateFluentdDaemonSetInitContainer(container corev1.Container) error {
+	errs := k8svalidation.ValidateResourceRequirements(&container.Resources, field.NewPath("spec", "template", "spec", "initContainers"))
+	return errs.ToAggregate()
+}
+
+// ValidateAdditionalOutput validates that the given fluentd configuration only contains <match> output sections, so
+// that user supplied outputs cannot add sources or filters, or include other files.
+func ValidateAdditionalOutput(config string) error {
+	var sections []string
+	matches := 0
+	for i, line := range strings.Split(config, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "@include") {
+			return fmt.Errorf("line %d: @include is not allowed", i+1)
+		}
+
+		switch {
+		case strings.HasPrefix(line, "</"):
+			name := strings.TrimSuffix(strings.TrimPrefix(line, "</"), ">")
+			if len(sections) == 0 || sections[len(sections)-1] != name {
+				return fmt.Errorf("line %d: unexpected %s", i+1, line)
+			}
+			sections = sections[:len(sections)-1]
+		case strings.HasPrefix(line, "<"):
+			if !strings.HasSuffix(line, ">") {
+				return fmt.Errorf("line %d: unterminated section %s", i+1, line)
+			}
+			name := strings.TrimSuffix(strings.TrimPrefix(line, "<"), ">")
+			name, _, _ = strings.Cut(name, " ")
+			if len(sections) == 0 {
+				if name != "match" {
+					return fmt.Errorf("line %d: only <match> sections are allowed, found <%s>", i+1, name)
+				}
+				matches++
+			}
+			sections = append(sections, name)
+		default:
+			if len(sections) == 0 {
+				return fmt.Errorf("line %d: only <match> sections are allowed, found %q", i+1, line)
+			}
+		}
+	}
+	if len(sections) > 0 {
+		return fmt.Errorf("section <%s> is not closed", sections[len(sections)-1])
+	}
+	if matches == 0 {
+		return fmt.Errorf("no <match> sections found")
+	}
+	return nil
+}
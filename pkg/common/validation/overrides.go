@@ -65,6 +65,14 @@ func ValidateReplicatedPodResourceOverrides(overrides any, validateContainerFn V
 			}
 		}
 	}
+	for _, co := range rcc.GetContainerOverrides(overrides) {
+		fldPath := field.NewPath("spec", "template", "spec", "containers")
+		errs := k8svalidation.ValidateSeccompProfileField(co.SeccompProfile, fldPath.Child("seccompProfile"))
+		errs = append(errs, k8svalidation.ValidateAppArmorProfileFormat(co.AppArmorProfile, fldPath.Child("appArmorProfile"))...)
+		if errs.ToAggregate() != nil {
+			return fmt.Errorf("spec.Template.Spec.Containers[%q] is invalid: %w", co.Name, errs.ToAggregate())
+		}
+	}
 	if affinity := rcc.GetAffinity(overrides); affinity != nil {
 		if errs := k8svalidation.ValidateAffinity(affinity, field.NewPath("spec", "template", "spec", "affinity")); errs.ToAggregate() != nil {
 			return fmt.Errorf("spec.Template.Spec.Affinity is invalid: %w", errs.ToAggregate())
@@ -101,6 +109,44 @@ func ValidateReplicatedPodResourceOverrides(overrides any, validateContainerFn V
 	return nil
 }
 
+// ValidatePodDisruptionBudget validates the given PodDisruptionBudget overrides.
+func ValidatePodDisruptionBudget(pdb *operatorv1.PodDisruptionBudgetSpec) error {
+	if pdb == nil {
+		return nil
+	}
+	if pdb.MinAvailable != nil && pdb.MaxUnavailable != nil {
+		return fmt.Errorf("spec.PodDisruptionBudget is invalid: minAvailable and maxUnavailable may not both be set")
+	}
+	errs := field.ErrorList{}
+	fldPath := field.NewPath("spec", "podDisruptionBudget")
+	if pdb.MinAvailable != nil {
+		errs = append(errs, k8svalidation.ValidatePositiveIntOrPercent(*pdb.MinAvailable, fldPath.Child("minAvailable"))...)
+		errs = append(errs, k8svalidation.IsNotMoreThan100Percent(*pdb.MinAvailable, fldPath.Child("minAvailable"))...)
+	}
+	if pdb.MaxUnavailable != nil {
+		errs = append(errs, k8svalidation.ValidatePositiveIntOrPercent(*pdb.MaxUnavailable, fldPath.Child("maxUnavailable"))...)
+		errs = append(errs, k8svalidation.IsNotMoreThan100Percent(*pdb.MaxUnavailable, fldPath.Child("maxUnavailable"))...)
+	}
+	if errs.ToAggregate() != nil {
+		return fmt.Errorf("spec.PodDisruptionBudget is invalid: %w", errs.ToAggregate())
+	}
+	return nil
+}
+
+// ValidateServiceOverrides validates the given Service overrides.
+func ValidateServiceOverrides(svc *operatorv1.ServiceOverrides) error {
+	if svc == nil {
+		return nil
+	}
+	if svc.ExternalTrafficPolicy != nil && (svc.Type == nil || *svc.Type != corev1.ServiceTypeLoadBalancer) {
+		return fmt.Errorf("externalTrafficPolicy may only be set when type is %s", corev1.ServiceTypeLoadBalancer)
+	}
+	if err := k8svalidation.ValidateAnnotations(svc.Annotations, field.NewPath("annotations")).ToAggregate(); err != nil {
+		return err
+	}
+	return nil
+}
+
 // validateMetadata validates the given Metadata.
 func validateMetadata(metadata *operatorv1.Metadata) error {
 	if metadata == nil {
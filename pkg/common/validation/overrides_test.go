@@ -25,6 +25,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	opv1 "github.com/tigera/operator/api/v1"
 	node "github.com/tigera/operator/pkg/common/validation/calico-node"
@@ -175,6 +176,36 @@ var _ = Describe("Test overrides validation (TyphaDeployment)", func() {
 		Expect(err.Error()).Should(HavePrefix("spec.Template.Spec.TerminationGracePeriodSeconds is invalid: cannot be negative"))
 	})
 
+	It("should accept valid seccomp and AppArmor profiles", func() {
+		overrides.Spec.Template.Spec.Containers = []opv1.TyphaDeploymentContainer{{
+			Name:            "calico-typha",
+			SeccompProfile:  &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost, LocalhostProfile: ptr.To("profiles/typha.json")},
+			AppArmorProfile: "localhost/typha",
+		}}
+		err := ValidateReplicatedPodResourceOverrides(overrides, typha.ValidateTyphaDeploymentContainer, typha.ValidateTyphaDeploymentInitContainer)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should reject a Localhost seccomp profile without a profile path", func() {
+		overrides.Spec.Template.Spec.Containers = []opv1.TyphaDeploymentContainer{{
+			Name:           "calico-typha",
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost},
+		}}
+		err := ValidateReplicatedPodResourceOverrides(overrides, typha.ValidateTyphaDeploymentContainer, typha.ValidateTyphaDeploymentInitContainer)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("localhostProfile"))
+	})
+
+	It("should reject an invalid AppArmor profile", func() {
+		overrides.Spec.Template.Spec.Containers = []opv1.TyphaDeploymentContainer{{
+			Name:            "calico-typha",
+			AppArmorProfile: "typha",
+		}}
+		err := ValidateReplicatedPodResourceOverrides(overrides, typha.ValidateTyphaDeploymentContainer, typha.ValidateTyphaDeploymentInitContainer)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("appArmorProfile"))
+	})
+
 	intOrStr := func(v string) *intstr.IntOrString {
 		if v == "" {
 			return nil
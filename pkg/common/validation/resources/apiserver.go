@@ -0,0 +1,224 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	operatorv1 "github.com/tigera/operator/api/v1"
+	overrides "github.com/tigera/operator/pkg/common/validation"
+	apiserver "github.com/tigera/operator/pkg/common/validation/apiserver"
+	webhooks "github.com/tigera/operator/pkg/common/validation/webhooks"
+)
+
+// ValidateAPIServer validates the given APIServer. It only inspects the spec, so the result does not depend on the
+// state of the cluster.
+func ValidateAPIServer(instance *operatorv1.APIServer) error {
+	// Verify the APIServerDeployment overrides, if specified, is valid.
+	if d := instance.Spec.APIServerDeployment; d != nil {
+		err := overrides.ValidateReplicatedPodResourceOverrides(d, apiserver.ValidateAPIServerDeploymentContainer, apiserver.ValidateAPIServerDeploymentInitContainer)
+		if err != nil {
+			return fmt.Errorf("APIServer spec.APIServerDeployment is not valid: %w", err)
+		}
+		if d.Spec != nil {
+			if err := overrides.ValidatePodDisruptionBudget(d.Spec.PodDisruptionBudget); err != nil {
+				return fmt.Errorf("APIServer spec.APIServerDeployment is not valid: %w", err)
+			}
+			// The pods must not be killed before the preStop sleep and the API server's shutdown delay are over.
+			if t := d.Spec.Template; t != nil && t.Spec != nil && t.Spec.PreStopSleepSeconds != nil && t.Spec.TerminationGracePeriodSeconds != nil &&
+				*t.Spec.TerminationGracePeriodSeconds <= 2*int64(*t.Spec.PreStopSleepSeconds) {
+				return fmt.Errorf("APIServer spec.APIServerDeployment.Spec.Template.Spec.TerminationGracePeriodSeconds must be longer than twice PreStopSleepSeconds")
+			}
+			if t := d.Spec.Template; t != nil && t.Spec != nil {
+				if err := validateExtraVolumes(t.Spec); err != nil {
+					return fmt.Errorf("APIServer spec.APIServerDeployment is not valid: %w", err)
+				}
+			}
+		}
+	}
+
+	// Verify the CalicoWebhooksDeployment overrides, if specified, is valid.
+	if d := instance.Spec.CalicoWebhooksDeployment; d != nil {
+		err := overrides.ValidateReplicatedPodResourceOverrides(d, webhooks.ValidateCalicoWebhooksDeploymentContainer, overrides.NoContainersDefined)
+		if err != nil {
+			return fmt.Errorf("APIServer spec.CalicoWebhooksDeployment is not valid: %w", err)
+		}
+	}
+
+	if err := overrides.ValidateServiceOverrides(instance.Spec.APIServerService); err != nil {
+		return fmt.Errorf("APIServer spec.APIServerService is not valid: %w", err)
+	}
+
+	if t := instance.Spec.RequestTimeout; t != nil && t.Duration <= 0 {
+		return fmt.Errorf("APIServer spec.RequestTimeout must be greater than zero")
+	}
+
+	// Verify the watch cache sizes, if specified, name each resource once.
+	seen := map[string]bool{}
+	for _, wcs := range instance.Spec.WatchCacheSizes {
+		if errs := utilvalidation.IsDNS1123Subdomain(wcs.Resource); len(errs) > 0 || !strings.Contains(wcs.Resource, ".") {
+			return fmt.Errorf("APIServer spec.WatchCacheSizes resource %q must be a lowercase resource name qualified with its API group", wcs.Resource)
+		}
+		if seen[wcs.Resource] {
+			return fmt.Errorf("APIServer spec.WatchCacheSizes contains resource %q more than once", wcs.Resource)
+		}
+		seen[wcs.Resource] = true
+	}
+
+	if t := instance.Spec.EtcdCompactionInterval; t != nil {
+		if instance.Spec.EtcdDatastore == nil {
+			return fmt.Errorf("APIServer spec.EtcdCompactionInterval may only be set when EtcdDatastore is set")
+		}
+		if t.Duration < 0 {
+			return fmt.Errorf("APIServer spec.EtcdCompactionInterval must not be negative")
+		}
+	}
+
+	// Verify the extra certificate SANs, if specified, are valid.
+	if t := instance.Spec.TLS; t != nil {
+		for _, name := range t.ExtraDNSNames {
+			if len(utilvalidation.IsDNS1123Subdomain(name)) > 0 && len(utilvalidation.IsWildcardDNS1123Subdomain(name)) > 0 {
+				return fmt.Errorf("APIServer spec.TLS.ExtraDNSNames contains an invalid DNS name %q", name)
+			}
+		}
+		for _, ip := range t.ExtraIPAddresses {
+			if net.ParseIP(ip) == nil {
+				return fmt.Errorf("APIServer spec.TLS.ExtraIPAddresses contains an invalid IP address %q", ip)
+			}
+		}
+	}
+
+	if qs := instance.Spec.QueryServer; qs != nil && qs.CacheRefreshInterval != nil && qs.CacheRefreshInterval.Duration <= 0 {
+		return fmt.Errorf("APIServer spec.QueryServer.CacheRefreshInterval must be greater than zero")
+	}
+
+	// Verify the query server Gateway hostname, if specified, is a valid DNS name.
+	if gw := instance.Spec.QueryServerGateway(); gw != nil && gw.Hostname != "" {
+		if len(utilvalidation.IsDNS1123Subdomain(gw.Hostname)) > 0 && len(utilvalidation.IsWildcardDNS1123Subdomain(gw.Hostname)) > 0 {
+			return fmt.Errorf("APIServer spec.QueryServer.Gateway.Hostname %q is not a valid DNS name", gw.Hostname)
+		}
+	}
+
+	// Verify the query server Route hostname, if specified, is a valid DNS name.
+	if route := instance.Spec.QueryServerRoute(); route != nil && route.Hostname != "" {
+		if len(utilvalidation.IsDNS1123Subdomain(route.Hostname)) > 0 {
+			return fmt.Errorf("APIServer spec.QueryServer.Route.Hostname %q is not a valid DNS name", route.Hostname)
+		}
+	}
+
+	// Verify the FlowSchemas, if specified, only match requests to the projectcalico.org API group.
+	if fc := instance.Spec.FlowControl; fc != nil {
+		for _, fs := range fc.FlowSchemas {
+			for _, rule := range fs.Spec.Rules {
+				if len(rule.NonResourceRules) > 0 {
+					return fmt.Errorf("APIServer spec.FlowControl.FlowSchemas %q must not contain non-resource rules", fs.Name)
+				}
+				for _, rr := range rule.ResourceRules {
+					for _, group := range rr.APIGroups {
+						if group != v3.GroupName {
+							return fmt.Errorf("APIServer spec.FlowControl.FlowSchemas %q may only match the %s API group", fs.Name, v3.GroupName)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// Verify the audit log volume, if specified, is complete.
+	if al := instance.Spec.AuditLogs; al != nil {
+		switch instance.Spec.GetAuditLogStorage() {
+		case operatorv1.APIServerAuditLogStoragePersistentVolumeClaim:
+			if al.PersistentVolumeClaimName == "" {
+				return fmt.Errorf("APIServer spec.AuditLogs.PersistentVolumeClaimName must be set when Storage is %s", operatorv1.APIServerAuditLogStoragePersistentVolumeClaim)
+			}
+			if errs := utilvalidation.IsDNS1123Subdomain(al.PersistentVolumeClaimName); len(errs) > 0 {
+				return fmt.Errorf("APIServer spec.AuditLogs.PersistentVolumeClaimName %q is not valid: %s", al.PersistentVolumeClaimName, strings.Join(errs, ", "))
+			}
+		default:
+			if al.PersistentVolumeClaimName != "" {
+				return fmt.Errorf("APIServer spec.AuditLogs.PersistentVolumeClaimName may only be set when Storage is %s", operatorv1.APIServerAuditLogStoragePersistentVolumeClaim)
+			}
+		}
+		if instance.Spec.GetAuditLogStorage() == operatorv1.APIServerAuditLogStorageWebhook {
+			if al.MaxAge != nil || al.MaxBackup != nil || al.MaxSize != nil {
+				return fmt.Errorf("APIServer spec.AuditLogs.MaxAge, MaxBackup and MaxSize may not be set when Storage is %s", operatorv1.APIServerAuditLogStorageWebhook)
+			}
+			if errs := utilvalidation.IsDNS1123Subdomain(instance.Spec.GetAuditWebhookConfigSecretName()); len(errs) > 0 {
+				return fmt.Errorf("APIServer spec.AuditLogs.WebhookConfigSecretName %q is not valid: %s", al.WebhookConfigSecretName, strings.Join(errs, ", "))
+			}
+		} else if al.WebhookConfigSecretName != "" {
+			return fmt.Errorf("APIServer spec.AuditLogs.WebhookConfigSecretName may only be set when Storage is %s", operatorv1.APIServerAuditLogStorageWebhook)
+		}
+	}
+
+	// Verify the delegated auth namespace, if specified, is a valid namespace name.
+	if da := instance.Spec.DelegatedAuth; da != nil && da.Namespace != "" {
+		if errs := utilvalidation.IsDNS1123Label(da.Namespace); len(errs) > 0 {
+			return fmt.Errorf("APIServer spec.DelegatedAuth.Namespace %q is not valid: %s", da.Namespace, strings.Join(errs, ", "))
+		}
+	}
+
+	// Verify the etcd endpoints, if specified, are valid URLs.
+	if etcd := instance.Spec.EtcdDatastore; etcd != nil {
+		if len(etcd.Endpoints) == 0 {
+			return fmt.Errorf("APIServer spec.EtcdDatastore.Endpoints must not be empty")
+		}
+		for _, e := range etcd.Endpoints {
+			u, err := url.Parse(e)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("APIServer spec.EtcdDatastore.Endpoints contains an invalid URL %q", e)
+			}
+		}
+	}
+	return nil
+}
+
+// validateExtraVolumes checks that the extra volumes of the API server pods are named uniquely, and that the extra
+// volume mounts of each container mount one of them at a distinct path. Conflicts with the volumes that the operator
+// renders are detected when the API server is rendered, since those depend on the rest of the configuration.
+func validateExtraVolumes(spec *operatorv1.APIServerDeploymentPodSpec) error {
+	volumes := map[string]bool{}
+	for _, v := range spec.ExtraVolumes {
+		if errs := utilvalidation.IsDNS1123Label(v.Name); len(errs) > 0 {
+			return fmt.Errorf("extra volume name %q is not valid: %s", v.Name, strings.Join(errs, ", "))
+		}
+		if volumes[v.Name] {
+			return fmt.Errorf("extra volume %s is specified more than once", v.Name)
+		}
+		volumes[v.Name] = true
+	}
+	for _, c := range spec.Containers {
+		paths := map[string]bool{}
+		for _, m := range c.ExtraVolumeMounts {
+			if !volumes[m.Name] {
+				return fmt.Errorf("extra volume mount %s of container %s does not mount one of the extra volumes", m.Name, c.Name)
+			}
+			if m.MountPath == "" {
+				return fmt.Errorf("extra volume mount %s of container %s has no mount path", m.Name, c.Name)
+			}
+			if paths[m.MountPath] {
+				return fmt.Errorf("extra volume mount path %s of container %s is specified more than once", m.MountPath, c.Name)
+			}
+			paths[m.MountPath] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+
+	v1 "k8s.io/api/core/v1"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	overrides "github.com/tigera/operator/pkg/common/validation"
+	node "github.com/tigera/operator/pkg/common/validation/calico-node"
+	csinodedriver "github.com/tigera/operator/pkg/common/validation/csi-node-driver"
+	kubecontrollers "github.com/tigera/operator/pkg/common/validation/kube-controllers"
+	typha "github.com/tigera/operator/pkg/common/validation/typha"
+	"github.com/tigera/operator/pkg/controller/certificatemanager"
+	"github.com/tigera/operator/pkg/render"
+	rcc "github.com/tigera/operator/pkg/render/common/components"
+	"github.com/tigera/operator/pkg/render/gatewayapi"
+	"github.com/tigera/operator/pkg/render/intrusiondetection/dpi"
+	"github.com/tigera/operator/pkg/render/logstorage/eck"
+	"github.com/tigera/operator/pkg/render/monitor"
+	"github.com/tigera/operator/pkg/tls"
+)
+
+// ValidateInstallation validates the parts of the given Installation that do not depend on defaulting or on the
+// state of the cluster. The installation controller performs further validation once defaults have been filled in.
+func ValidateInstallation(instance *operatorv1.Installation) error {
+	if instance.Spec.ControlPlaneNodeSelector != nil {
+		if v, ok := instance.Spec.ControlPlaneNodeSelector["beta.kubernetes.io/os"]; ok && v != "linux" {
+			return fmt.Errorf("installation spec.ControlPlaneNodeSelector 'beta.kubernetes.io/os=%s' is not supported", v)
+		}
+		if v, ok := instance.Spec.ControlPlaneNodeSelector["kubernetes.io/os"]; ok && v != "linux" {
+			return fmt.Errorf("installation spec.ControlPlaneNodeSelector 'kubernetes.io/os=%s' is not supported", v)
+		}
+	}
+
+	if instance.Spec.ControlPlaneReplicas != nil && *instance.Spec.ControlPlaneReplicas <= 0 {
+		return fmt.Errorf("installation spec.ControlPlaneReplicas should be greater than 0")
+	}
+
+	validComponentNames := map[operatorv1.ComponentName]struct{}{
+		operatorv1.ComponentNameKubeControllers: {},
+		operatorv1.ComponentNameNode:            {},
+		operatorv1.ComponentNameTypha:           {},
+	}
+
+	for _, resource := range instance.Spec.ComponentResources {
+		if _, ok := validComponentNames[resource.ComponentName]; !ok {
+			return fmt.Errorf("installation spec.ComponentResources.ComponentName %s is not supported", resource.ComponentName)
+		}
+	}
+
+	// Verify that non-privileged mode is not Enabled, since it's been deprecated.
+	if instance.Spec.NonPrivileged != nil && *instance.Spec.NonPrivileged == operatorv1.NonPrivilegedEnabled {
+		return fmt.Errorf("non-privileged Calico is deprecated and cannot be Enabled; please, remove this field from your installation spec")
+	}
+
+	// Verify the CalicoNodeDaemonSet overrides, if specified, is valid.
+	if ds := instance.Spec.CalicoNodeDaemonSet; ds != nil {
+		err := overrides.ValidateReplicatedPodResourceOverrides(ds, node.ValidateCalicoNodeDaemonSetContainer, node.ValidateCalicoNodeDaemonSetInitContainer)
+		err2 := validateExclusiveInitContainers(rcc.GetInitContainers(ds))
+		if err != nil || err2 != nil {
+			return fmt.Errorf("installation spec.CalicoNodeDaemonSet is not valid: %w", errors.Join(err, err2))
+		}
+	}
+
+	// Verify the CalicoNodeWindowsDaemonSet overrides, if specified, is valid.
+	if ds := instance.Spec.CalicoNodeWindowsDaemonSet; ds != nil {
+		err := overrides.ValidateReplicatedPodResourceOverrides(ds, node.ValidateCalicoNodeWindowsDaemonSetContainer, node.ValidateCalicoNodeWindowsDaemonSetInitContainer)
+		if err != nil {
+			return fmt.Errorf("installation spec.CalicoNodeWindowsDaemonSet is not valid: %w", err)
+		}
+	}
+
+	// Verify the CalicoKubeControllersDeployment overrides, if specified, is valid.
+	if deploy := instance.Spec.CalicoKubeControllersDeployment; deploy != nil {
+		err := overrides.ValidateReplicatedPodResourceOverrides(deploy, kubecontrollers.ValidateCalicoKubeControllersDeploymentContainer, overrides.NoContainersDefined)
+		if err != nil {
+			return fmt.Errorf("installation spec.CalicoKubeControllersDeployment is not valid: %w", err)
+		}
+	}
+
+	// Pausing typha rollouts relies on typha's connection metrics.
+	if tc := instance.Spec.TyphaConfiguration; tc != nil && tc.RolloutPauseThresholdPercent != nil && instance.Spec.TyphaMetricsPort == nil {
+		return fmt.Errorf("installation spec.TyphaConfiguration.RolloutPauseThresholdPercent requires spec.TyphaMetricsPort to be set")
+	}
+
+	if instance.Spec.TyphaMetricsTLS != nil && *instance.Spec.TyphaMetricsTLS == operatorv1.TyphaMetricsTLSEnabled && instance.Spec.TyphaMetricsPort == nil {
+		return fmt.Errorf("installation spec.TyphaMetricsTLS requires spec.TyphaMetricsPort to be set")
+	}
+
+	if a := instance.Spec.TyphaMetricsAuth; a != nil && *a == operatorv1.MetricsAuthTokenReview {
+		if instance.Spec.TyphaMetricsPort == nil {
+			return fmt.Errorf("installation spec.TyphaMetricsAuth requires spec.TyphaMetricsPort to be set")
+		}
+		if t := instance.Spec.TyphaMetricsTLS; t != nil && *t == operatorv1.TyphaMetricsTLSDisabled {
+			return fmt.Errorf("installation spec.TyphaMetricsAuth TokenReview serves metrics over TLS, so spec.TyphaMetricsTLS cannot be Disabled")
+		}
+		if operatorv1.IsFIPSModeEnabled(instance.Spec.FIPSMode) {
+			return fmt.Errorf("installation spec.TyphaMetricsAuth TokenReview is not supported in FIPS mode")
+		}
+		// Typha and calico/node run on the host network, so the upstream port must not clash with their other ports.
+		upstream := instance.Spec.GetTyphaMetricsUpstreamPort()
+		if upstream == *instance.Spec.TyphaMetricsPort {
+			return fmt.Errorf("installation spec.TyphaMetricsUpstreamPort %d must differ from spec.TyphaMetricsPort", upstream)
+		}
+		if instance.Spec.NodeMetricsPort != nil && upstream == *instance.Spec.NodeMetricsPort {
+			return fmt.Errorf("installation spec.TyphaMetricsUpstreamPort %d must differ from spec.NodeMetricsPort", upstream)
+		}
+		if upstream == render.TyphaPort {
+			return fmt.Errorf("installation spec.TyphaMetricsUpstreamPort %d must differ from the typha port", upstream)
+		}
+	} else if instance.Spec.TyphaMetricsUpstreamPort != nil {
+		return fmt.Errorf("installation spec.TyphaMetricsUpstreamPort requires spec.TyphaMetricsAuth to be TokenReview")
+	}
+
+	if rw := instance.Spec.MetricsRemoteWrite; rw != nil {
+		if err := validateMetricsRemoteWrite(instance, rw); err != nil {
+			return err
+		}
+	}
+
+	// Verify the TyphaDeployment overrides, if specified, is valid.
+	if deploy := instance.Spec.TyphaDeployment; deploy != nil {
+		err := overrides.ValidateReplicatedPodResourceOverrides(deploy, typha.ValidateTyphaDeploymentContainer, typha.ValidateTyphaDeploymentInitContainer)
+		if err != nil {
+			return fmt.Errorf("installation spec.TyphaDeployment is not valid: %w", err)
+		}
+		if err := typha.ValidateTyphaDeploymentRollout(deploy); err != nil {
+			return fmt.Errorf("installation spec.TyphaDeployment is not valid: %w", err)
+		}
+		if err := typha.ValidateTyphaDeploymentPools(deploy); err != nil {
+			return fmt.Errorf("installation spec.TyphaDeployment is not valid: %w", err)
+		}
+		if deploy.Spec != nil {
+			if err := overrides.ValidatePodDisruptionBudget(deploy.Spec.PodDisruptionBudget); err != nil {
+				return fmt.Errorf("installation spec.TyphaDeployment is not valid: %w", err)
+			}
+		}
+	}
+
+	if err := overrides.ValidateServiceOverrides(instance.Spec.TyphaService); err != nil {
+		return fmt.Errorf("installation spec.TyphaService is not valid: %w", err)
+	}
+
+	// Verify the CSINodeDriverDaemonSet overrides, if specified, is valid.
+	if ds := instance.Spec.CSINodeDriverDaemonSet; ds != nil {
+		err := overrides.ValidateReplicatedPodResourceOverrides(ds, csinodedriver.ValidateCSINodeDriverDaemonSetContainer, overrides.NoContainersDefined)
+		if err != nil {
+			return fmt.Errorf("installation spec.CSINodeDriverDaemonSet is not valid: %w", err)
+		}
+	}
+
+	if instance.Spec.CertManager != nil && instance.Spec.CertificateManagement != nil {
+		return fmt.Errorf("installation spec.CertManager cannot be combined with spec.CertificateManagement")
+	}
+
+	if rotation := instance.Spec.CertificateRotation; rotation != nil {
+		duration, renewBefore := tls.DefaultCertificateDuration, certificatemanager.DefaultRenewBefore
+		if rotation.CertificateDuration != nil {
+			duration = rotation.CertificateDuration.Duration
+		}
+		if rotation.RenewBefore != nil {
+			renewBefore = rotation.RenewBefore.Duration
+		}
+		if duration <= 0 || renewBefore <= 0 {
+			return fmt.Errorf("installation spec.CertificateRotation durations must be greater than 0")
+		}
+		if renewBefore >= duration {
+			return fmt.Errorf("installation spec.CertificateRotation.RenewBefore (%s) must be shorter than CertificateDuration (%s)", renewBefore, duration)
+		}
+	}
+
+	// The policy-controller only accepts PEM encoded public keys.
+	if iv := instance.Spec.ImageVerification; iv != nil {
+		block, _ := pem.Decode([]byte(iv.PublicKey))
+		if block == nil {
+			return fmt.Errorf("installation spec.ImageVerification.PublicKey is not PEM encoded")
+		}
+		if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			return fmt.Errorf("installation spec.ImageVerification.PublicKey is not a valid public key: %w", err)
+		}
+	}
+
+	for _, nss := range instance.Spec.NamespacePodSecurityStandards {
+		required, ok := requiredPodSecurityStandards[nss.Namespace]
+		if !ok {
+			continue
+		}
+		if _, err := render.ResolvePodSecurityStandard(&instance.Spec, nss.Namespace, required, required); err != nil {
+			return fmt.Errorf("installation spec.NamespacePodSecurityStandards is not valid: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// requiredPodSecurityStandards are the least privileged pod security standards that the components of the
+// operator-managed namespaces run under. The namespaces whose components depend on the configuration of other
+// resources are validated by the controllers that create them.
+var requiredPodSecurityStandards = map[string]render.PodSecurityStandard{
+	common.CalicoNamespace:            render.CalicoSystemPodSecurityStandard,
+	render.LegacyManagerNamespace:     render.LegacyManagerPodSecurityStandard,
+	render.DexNamespace:               render.DexPodSecurityStandard,
+	render.PacketCaptureNamespace:     render.PacketCapturePodSecurityStandard,
+	common.TigeraPrometheusNamespace:  monitor.PodSecurityStandard,
+	eck.OperatorNamespace:             eck.OperatorPodSecurityStandard,
+	dpi.DeepPacketInspectionNamespace: dpi.DeepPacketInspectionPodSecurityStandard,
+	gatewayapi.GatewayNamespace:       gatewayapi.GatewayPodSecurityStandard,
+}
+
+// validateExclusiveInitContainers checks that the init containers do not contain both mount-bpffs and ebpf-bootstrap.
+func validateExclusiveInitContainers(initContainers []v1.Container) error {
+	hasMountBpffs, hasEbpfBootstrap := false, false
+	for _, c := range initContainers {
+		switch c.Name {
+		case "mount-bpffs":
+			hasMountBpffs = true
+		case "ebpf-bootstrap":
+			hasEbpfBootstrap = true
+		}
+		if hasMountBpffs && hasEbpfBootstrap {
+			return fmt.Errorf("init container names mount-bpffs and ebpf-bootstrap are mutually exclusive, please remove one of them")
+		}
+	}
+	return nil
+}
+
+func validateMetricsRemoteWrite(instance *operatorv1.Installation, rw *operatorv1.MetricsRemoteWrite) error {
+	if instance.Spec.NodeMetricsPort == nil && instance.Spec.TyphaMetricsPort == nil {
+		return fmt.Errorf("installation spec.MetricsRemoteWrite requires spec.NodeMetricsPort or spec.TyphaMetricsPort to be set")
+	}
+	if u, err := url.Parse(rw.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("installation spec.MetricsRemoteWrite.URL %q is not a valid http or https URL", rw.URL)
+	}
+	if rw.ScrapeInterval != nil && rw.ScrapeInterval.Duration <= 0 {
+		return fmt.Errorf("installation spec.MetricsRemoteWrite.ScrapeInterval must be greater than 0")
+	}
+	if rw.BasicAuthSecretName != "" && rw.BearerTokenSecretName != "" {
+		return fmt.Errorf("installation spec.MetricsRemoteWrite.BasicAuthSecretName and spec.MetricsRemoteWrite.BearerTokenSecretName are mutually exclusive")
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	overrides "github.com/tigera/operator/pkg/common/validation"
+	fluentd "github.com/tigera/operator/pkg/common/validation/fluentd"
+	operatorurl "github.com/tigera/operator/pkg/url"
+)
+
+var (
+	awsAccountIDRegexp = regexp.MustCompile(`^[0-9]{12}$`)
+	awsRoleARNRegexp   = regexp.MustCompile(`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`)
+)
+
+// ValidateLogCollector validates the given LogCollector. It only inspects the spec, so the result does not depend on
+// the state of the cluster.
+func ValidateLogCollector(instance *operatorv1.LogCollector) error {
+	// Verify the FluentdDaemonSet overrides, if specified, are valid.
+	if ds := instance.Spec.FluentdDaemonSet; ds != nil {
+		if err := overrides.ValidateReplicatedPodResourceOverrides(ds, fluentd.ValidateFluentdDaemonSetContainer, fluentd.ValidateFluentdDaemonSetInitContainer); err != nil {
+			return fmt.Errorf("LogCollector spec.FluentdDaemonSet is not valid: %w", err)
+		}
+	}
+
+	// The operator logs are collected by the fluentd DaemonSet, which the AuditDeployment collector type replaces.
+	if instance.Spec.OperatorLogsEnabled() && instance.Spec.GetCollectorType() == operatorv1.LogCollectorTypeAuditDeployment {
+		return fmt.Errorf("LogCollector spec.OperatorLogs cannot be enabled with the %s collector type", operatorv1.LogCollectorTypeAuditDeployment)
+	}
+
+	// The AuditDeployment collector type only collects the audit logs.
+	if !instance.Spec.Collection.AuditEnabled() && instance.Spec.GetCollectorType() == operatorv1.LogCollectorTypeAuditDeployment {
+		return fmt.Errorf("LogCollector spec.Collection.Audit cannot be disabled with the %s collector type", operatorv1.LogCollectorTypeAuditDeployment)
+	}
+
+	// Verify the fluentd state host path, if specified, is an absolute path. It is mounted into the fluentd pods.
+	if state := instance.Spec.FluentdState; state != nil && state.HostPath != "" {
+		if !path.IsAbs(state.HostPath) || path.Clean(state.HostPath) != state.HostPath {
+			return fmt.Errorf("LogCollector spec.FluentdState.HostPath %q must be a clean absolute path", state.HostPath)
+		}
+	}
+
+	// Verify the dead letter queue PersistentVolumeClaim name, if specified, is valid.
+	if dlq := instance.Spec.DeadLetterQueue; dlq != nil {
+		if errs := utilvalidation.IsDNS1123Subdomain(dlq.PersistentVolumeClaimName); len(errs) > 0 {
+			return fmt.Errorf("LogCollector spec.DeadLetterQueue.PersistentVolumeClaimName %q is not valid: %s", dlq.PersistentVolumeClaimName, strings.Join(errs, ", "))
+		}
+	}
+
+	if err := validateLogCollectorFilters(instance.Spec.Filters); err != nil {
+		return err
+	}
+
+	if err := overrides.ValidateServiceOverrides(instance.Spec.InputService); err != nil {
+		return fmt.Errorf("LogCollector spec.InputService is not valid: %w", err)
+	}
+
+	// Verify the secondary Linseed endpoint, if specified, is a valid HTTPS URL.
+	if failover := instance.Spec.LinseedFailover; failover != nil {
+		if u, err := url.Parse(failover.SecondaryEndpoint); err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("LogCollector spec.LinseedFailover.SecondaryEndpoint %q is not a valid HTTPS URL", failover.SecondaryEndpoint)
+		}
+		if failover.GetRetryPrimaryInterval() < time.Second {
+			return fmt.Errorf("LogCollector spec.LinseedFailover.RetryPrimaryInterval must be at least one second")
+		}
+	}
+
+	// Verify the Security Lake partition and IAM role, if specified, are valid.
+	if stores := instance.Spec.AdditionalStores; stores != nil && stores.SecurityLake != nil {
+		if !awsAccountIDRegexp.MatchString(stores.SecurityLake.AccountID) {
+			return fmt.Errorf("LogCollector spec.AdditionalStores.SecurityLake.AccountID %q is not a valid AWS account ID", stores.SecurityLake.AccountID)
+		}
+		if arn := stores.SecurityLake.RoleARN; arn != "" && !awsRoleARNRegexp.MatchString(arn) {
+			return fmt.Errorf("LogCollector spec.AdditionalStores.SecurityLake.RoleARN %q is not a valid IAM role ARN", arn)
+		}
+	}
+
+	// Verify the IAM role of the EKS Cloudwatch log forwarder, if specified, is valid.
+	if sources := instance.Spec.AdditionalSources; sources != nil && sources.EksCloudwatchLog != nil {
+		if arn := sources.EksCloudwatchLog.RoleARN; arn != "" && !awsRoleARNRegexp.MatchString(arn) {
+			return fmt.Errorf("LogCollector spec.AdditionalSources.EksCloudwatchLog.RoleARN %q is not a valid IAM role ARN", arn)
+		}
+	}
+
+	// Verify the buffer tuning of each additional store, if specified, is valid.
+	if stores := instance.Spec.AdditionalStores; stores != nil {
+		if stores.S3 != nil {
+			if err := validateFluentdBuffer("S3", stores.S3.Buffer); err != nil {
+				return err
+			}
+			if err := validateS3Store(stores.S3); err != nil {
+				return err
+			}
+		}
+		if stores.Syslog != nil {
+			if err := validateFluentdBuffer("Syslog", stores.Syslog.Buffer); err != nil {
+				return err
+			}
+			if err := validateSyslogStore(stores.Syslog); err != nil {
+				return err
+			}
+		}
+		if stores.Splunk != nil {
+			if err := validateFluentdBuffer("Splunk", stores.Splunk.Buffer); err != nil {
+				return err
+			}
+			if err := validateSplunkLogTypes(stores.Splunk); err != nil {
+				return err
+			}
+		}
+		if stores.Loki != nil {
+			if err := validateFluentdBuffer("Loki", stores.Loki.Buffer); err != nil {
+				return err
+			}
+		}
+		if stores.GCS != nil {
+			if err := validateFluentdBuffer("GCS", stores.GCS.Buffer); err != nil {
+				return err
+			}
+		}
+		if stores.AzureBlob != nil {
+			if err := validateFluentdBuffer("AzureBlob", stores.AzureBlob.Buffer); err != nil {
+				return err
+			}
+		}
+		if stores.OTLP != nil {
+			if err := validateFluentdBuffer("OTLP", stores.OTLP.Buffer); err != nil {
+				return err
+			}
+		}
+		if stores.SecurityLake != nil {
+			if err := validateFluentdBuffer("SecurityLake", stores.SecurityLake.Buffer); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateLogCollectorFilters verifies that the filters can be rendered as fluentd grep filters. Selectors must
+// select something, since an empty selector would drop every flow log, and only the In and Exists operators are
+// supported since the flow logs only record the labels that pods have.
+func validateLogCollectorFilters(filters *operatorv1.LogCollectorFilters) error {
+	if filters == nil {
+		return nil
+	}
+	for _, ns := range filters.ExcludedNamespaces {
+		if errs := utilvalidation.IsDNS1123Label(ns); len(errs) > 0 {
+			return fmt.Errorf("LogCollector spec.Filters.ExcludedNamespaces %q is not valid: %s", ns, strings.Join(errs, ", "))
+		}
+	}
+	for i, selector := range filters.ExcludedPodSelectors {
+		if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+			return fmt.Errorf("LogCollector spec.Filters.ExcludedPodSelectors[%d] must not be empty", i)
+		}
+		if errs := metav1validation.ValidateLabelSelector(&selector, metav1validation.LabelSelectorValidationOptions{}, nil); len(errs) > 0 {
+			return fmt.Errorf("LogCollector spec.Filters.ExcludedPodSelectors[%d] is not valid: %w", i, errs.ToAggregate())
+		}
+		for _, req := range selector.MatchExpressions {
+			if req.Operator != metav1.LabelSelectorOpIn && req.Operator != metav1.LabelSelectorOpExists {
+				return fmt.Errorf("LogCollector spec.Filters.ExcludedPodSelectors[%d] uses the %s operator, only In and Exists are supported", i, req.Operator)
+			}
+		}
+	}
+	return nil
+}
+
+func validateS3Store(s3 *operatorv1.S3StoreSpec) error {
+	if len(s3.Destinations) == 0 && (s3.Region == "" || s3.BucketName == "") {
+		return fmt.Errorf("LogCollector spec.AdditionalStores.S3 requires Region and BucketName unless Destinations is set")
+	}
+	var names []string
+	for _, d := range s3.Destinations {
+		names = append(names, d.Name)
+	}
+	return validateStoreDestinations("S3", names, func(i int) error {
+		d := s3.Destinations[i]
+		if d.Region == "" || d.BucketName == "" {
+			return fmt.Errorf("requires Region and BucketName")
+		}
+		if len(d.LogTypes) == 0 {
+			return fmt.Errorf("requires at least one LogType")
+		}
+		return nil
+	})
+}
+
+func validateSyslogStore(syslog *operatorv1.SyslogStoreSpec) error {
+	if len(syslog.Destinations) == 0 && syslog.Endpoint == "" {
+		return fmt.Errorf("LogCollector spec.AdditionalStores.Syslog requires Endpoint unless Destinations is set")
+	}
+	var names []string
+	for _, d := range syslog.Destinations {
+		if syslog.Endpoint != "" && d.Name == operatorv1.SyslogDefaultDestinationName {
+			return fmt.Errorf("LogCollector spec.AdditionalStores.Syslog.Destinations name %q is reserved for spec.AdditionalStores.Syslog.Endpoint", d.Name)
+		}
+		names = append(names, d.Name)
+	}
+	return validateStoreDestinations("Syslog", names, func(i int) error {
+		if syslog.Destinations[i].Endpoint == "" {
+			return fmt.Errorf("requires Endpoint")
+		}
+		if _, _, _, err := operatorurl.ParseEndpoint(syslog.Destinations[i].Endpoint); err != nil {
+			return fmt.Errorf("has invalid Endpoint: %w", err)
+		}
+		return nil
+	})
+}
+
+// validateStoreDestinations verifies that the destinations of an additional store have unique names that are valid
+// DNS labels, and that each destination passes the checks of the store.
+func validateStoreDestinations(store string, names []string, validate func(i int) error) error {
+	seen := map[string]bool{}
+	for i, name := range names {
+		if errs := utilvalidation.IsDNS1123Label(name); len(errs) > 0 {
+			return fmt.Errorf("LogCollector spec.AdditionalStores.%s.Destinations name %q is not valid: %s", store, name, strings.Join(errs, ", "))
+		}
+		if seen[name] {
+			return fmt.Errorf("LogCollector spec.AdditionalStores.%s.Destinations contains destination %s more than once", store, name)
+		}
+		seen[name] = true
+		if err := validate(i); err != nil {
+			return fmt.Errorf("LogCollector spec.AdditionalStores.%s.Destinations[%s] %w", store, name, err)
+		}
+	}
+	return nil
+}
+
+func validateSplunkLogTypes(splunk *operatorv1.SplunkStoreSpec) error {
+	if err := validateSplunkFields(splunk.Fields); err != nil {
+		return fmt.Errorf("LogCollector spec.AdditionalStores.Splunk.Fields is not valid: %w", err)
+	}
+	seen := map[operatorv1.SplunkLogType]bool{}
+	for _, lt := range splunk.LogTypes {
+		if seen[lt.Type] {
+			return fmt.Errorf("LogCollector spec.AdditionalStores.Splunk.LogTypes contains log type %s more than once", lt.Type)
+		}
+		seen[lt.Type] = true
+		if err := validateSplunkFields(lt.Fields); err != nil {
+			return fmt.Errorf("LogCollector spec.AdditionalStores.Splunk.LogTypes[%s].Fields is not valid: %w", lt.Type, err)
+		}
+	}
+	return nil
+}
+
+func validateSplunkFields(fields map[string]string) error {
+	for name, key := range fields {
+		if name == "" || key == "" {
+			return fmt.Errorf("field names and record keys must not be empty")
+		}
+		if strings.ContainsAny(name+key, ":,") {
+			return fmt.Errorf("field %q must not contain ':' or ','", name)
+		}
+	}
+	return nil
+}
+
+func validateFluentdBuffer(store string, buffer *operatorv1.FluentdBufferSpec) error {
+	if buffer == nil {
+		return nil
+	}
+	if err := fluentdBufferError(buffer); err != nil {
+		return fmt.Errorf("LogCollector spec.AdditionalStores.%s.Buffer is not valid: %w", store, err)
+	}
+	return nil
+}
+
+func fluentdBufferError(buffer *operatorv1.FluentdBufferSpec) error {
+	if buffer.ChunkLimitSize != nil && buffer.ChunkLimitSize.Sign() <= 0 {
+		return fmt.Errorf("chunkLimitSize must be positive")
+	}
+	if buffer.TotalLimitSize != nil && buffer.TotalLimitSize.Sign() <= 0 {
+		return fmt.Errorf("totalLimitSize must be positive")
+	}
+	if buffer.ChunkLimitSize != nil && buffer.TotalLimitSize != nil && buffer.TotalLimitSize.Cmp(*buffer.ChunkLimitSize) < 0 {
+		return fmt.Errorf("totalLimitSize must not be smaller than chunkLimitSize")
+	}
+	if buffer.FlushInterval != nil && buffer.FlushInterval.Duration < time.Second {
+		return fmt.Errorf("flushInterval must be at least 1s")
+	}
+	return nil
+}
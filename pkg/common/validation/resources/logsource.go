@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+)
+
+var (
+	podNamePrefixRegexp = regexp.MustCompile(`^[a-z0-9][-a-z0-9.]*$`)
+
+	// fluentd uses Ruby regular expressions, so only check for a named group rather than compiling the expression.
+	namedGroupRegexp = regexp.MustCompile(`\(\?<[A-Za-z_][A-Za-z0-9_]*>`)
+)
+
+// ValidateLogSource validates the given LogSource. Each LogSource is rendered into the fluentd configuration, so
+// any value that could break out of its <source> block is rejected.
+func ValidateLogSource(instance *operatorv1.LogSource) error {
+	spec := instance.Spec
+	if errs := utilvalidation.IsDNS1123Label(spec.ContainerName); len(errs) > 0 {
+		return fmt.Errorf("LogSource spec.ContainerName %q is not valid: %s", spec.ContainerName, strings.Join(errs, ", "))
+	}
+	if spec.PodNamePrefix != "" && !podNamePrefixRegexp.MatchString(spec.PodNamePrefix) {
+		return fmt.Errorf("LogSource spec.PodNamePrefix %q is not valid: must consist of lower case alphanumeric characters, '-' or '.'", spec.PodNamePrefix)
+	}
+
+	parser := spec.Parser
+	switch parser.Type {
+	case operatorv1.LogParserTypeRegexp:
+		if parser.Expression == "" {
+			return fmt.Errorf("LogSource spec.Parser.Expression is required with the %s parser", parser.Type)
+		}
+		if strings.ContainsAny(parser.Expression, "\n\r") {
+			return fmt.Errorf("LogSource spec.Parser.Expression must be a single line")
+		}
+		if !namedGroupRegexp.MatchString(parser.Expression) {
+			return fmt.Errorf("LogSource spec.Parser.Expression must contain at least one named capture group")
+		}
+	case operatorv1.LogParserTypeJSON, operatorv1.LogParserTypeNone:
+		if parser.Expression != "" {
+			return fmt.Errorf("LogSource spec.Parser.Expression can only be set with the %s parser", operatorv1.LogParserTypeRegexp)
+		}
+	default:
+		return fmt.Errorf("LogSource spec.Parser.Type %q is not supported", parser.Type)
+	}
+
+	if strings.ContainsAny(parser.TimeKey+parser.TimeFormat, "\n\r") {
+		return fmt.Errorf("LogSource spec.Parser.TimeKey and spec.Parser.TimeFormat must be a single line")
+	}
+	if parser.TimeFormat != "" && parser.TimeKey == "" {
+		return fmt.Errorf("LogSource spec.Parser.TimeFormat requires spec.Parser.TimeKey")
+	}
+	return nil
+}
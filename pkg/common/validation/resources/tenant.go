@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"fmt"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	overrides "github.com/tigera/operator/pkg/common/validation"
+	apiserver "github.com/tigera/operator/pkg/common/validation/apiserver"
+	"github.com/tigera/operator/pkg/render"
+)
+
+// ValidateTenant validates the given Tenant. The API server of a tenant only runs the query server, so the
+// APIServerDeployment overrides that the tenant API server cannot apply are rejected rather than silently ignored.
+func ValidateTenant(instance *operatorv1.Tenant) error {
+	d := instance.Spec.APIServerDeployment
+	if d == nil {
+		return nil
+	}
+	if err := overrides.ValidateReplicatedPodResourceOverrides(d, apiserver.ValidateAPIServerDeploymentContainer, overrides.NoContainersDefined); err != nil {
+		return fmt.Errorf("Tenant spec.APIServerDeployment is not valid: %w", err)
+	}
+	if d.Spec == nil {
+		return nil
+	}
+	if d.Spec.PodDisruptionBudget != nil {
+		return fmt.Errorf("Tenant spec.APIServerDeployment.Spec.PodDisruptionBudget is not supported")
+	}
+	t := d.Spec.Template
+	if t == nil || t.Spec == nil {
+		return nil
+	}
+	if t.Spec.PreStopSleepSeconds != nil {
+		return fmt.Errorf("Tenant spec.APIServerDeployment.Spec.Template.Spec.PreStopSleepSeconds is not supported")
+	}
+	if len(t.Spec.ExtraVolumes) > 0 {
+		return fmt.Errorf("Tenant spec.APIServerDeployment.Spec.Template.Spec.ExtraVolumes is not supported")
+	}
+	for _, c := range t.Spec.Containers {
+		if c.Name != string(render.TigeraAPIServerQueryServerContainerName) {
+			return fmt.Errorf("Tenant spec.APIServerDeployment.Spec.Template.Spec.Containers may only contain %s, not %s", render.TigeraAPIServerQueryServerContainerName, c.Name)
+		}
+		if len(c.ExtraVolumeMounts) > 0 {
+			return fmt.Errorf("Tenant spec.APIServerDeployment.Spec.Template.Spec.Containers[%s].ExtraVolumeMounts is not supported", c.Name)
+		}
+	}
+	return nil
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2022-2024 Tigera, Inc. All rights reserved.
+// Copyright (c) 2022-2026 Tigera, Inc. All rights reserved.
 
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -15,8 +15,14 @@
 package validation
 
 import (
+	"fmt"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 
+	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common/k8svalidation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -32,3 +38,83 @@ func ValidateTyphaDeploymentInitContainer(container corev1.Container) error {
 	errs := k8svalidation.ValidateResourceRequirements(&container.Resources, field.NewPath("spec", "template", "spec", "initContainers"))
 	return errs.ToAggregate()
 }
+
+// ValidateTyphaDeploymentRollout validates the rolling update and termination grace period settings of the typha
+// Deployment overrides. These are not covered by the generic overrides validation but are used to derive typha's
+// shutdown timeout and the Deployment's progress deadline.
+func ValidateTyphaDeploymentRollout(deploy *operatorv1.TyphaDeployment) error {
+	if deploy.Spec == nil {
+		return nil
+	}
+
+	if deploy.Spec.Strategy != nil && deploy.Spec.Strategy.RollingUpdate != nil {
+		ru := deploy.Spec.Strategy.RollingUpdate
+		surge, err := rollingUpdateValue(ru.MaxSurge, "maxSurge")
+		if err != nil {
+			return err
+		}
+		unavailable, err := rollingUpdateValue(ru.MaxUnavailable, "maxUnavailable")
+		if err != nil {
+			return err
+		}
+		// Kubernetes defaults an unset value to 25%, so only reject the combination when both are given.
+		if ru.MaxSurge != nil && ru.MaxUnavailable != nil && surge == 0 && unavailable == 0 {
+			return fmt.Errorf("spec.strategy.rollingUpdate: maxSurge and maxUnavailable must not both be zero")
+		}
+	}
+
+	if t := deploy.Spec.Template; t != nil && t.Spec != nil && t.Spec.TerminationGracePeriodSeconds != nil {
+		if *t.Spec.TerminationGracePeriodSeconds < 0 {
+			return fmt.Errorf("spec.template.spec.terminationGracePeriodSeconds must not be negative")
+		}
+	}
+	return nil
+}
+
+// ValidateTyphaDeploymentPools validates the typha pools of the typha Deployment overrides. Each pool is rendered
+// as a Deployment and Service named after the pool, so the names must be unique and must not collide with the
+// other typha resources.
+func ValidateTyphaDeploymentPools(deploy *operatorv1.TyphaDeployment) error {
+	if deploy.Spec == nil {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	for i, pool := range deploy.Spec.Pools {
+		if errs := utilvalidation.IsDNS1123Label(pool.Name); len(errs) > 0 {
+			return fmt.Errorf("spec.pools[%d].name %q is not valid: %s", i, pool.Name, strings.Join(errs, ", "))
+		}
+		if seen[pool.Name] {
+			return fmt.Errorf("spec.pools[%d].name %q is not unique", i, pool.Name)
+		}
+		seen[pool.Name] = true
+		if reservedTyphaPoolNames[pool.Name] {
+			return fmt.Errorf("spec.pools[%d].name %q is reserved", i, pool.Name)
+		}
+		if pool.Replicas != nil && *pool.Replicas < 0 {
+			return fmt.Errorf("spec.pools[%d].replicas must not be negative", i)
+		}
+	}
+	return nil
+}
+
+// reservedTyphaPoolNames are the pool names that would produce the name of another typha resource.
+var reservedTyphaPoolNames = map[string]bool{
+	"metrics":         true,
+	"noncluster-host": true,
+}
+
+func rollingUpdateValue(v *intstr.IntOrString, name string) (int, error) {
+	if v == nil {
+		return 0, nil
+	}
+	// Scale against 100 replicas so that any non-zero percentage is distinguishable from zero.
+	n, err := intstr.GetScaledValueFromIntOrPercent(v, 100, true)
+	if err != nil {
+		return 0, fmt.Errorf("spec.strategy.rollingUpdate.%s is not valid: %w", name, err)
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("spec.strategy.rollingUpdate.%s must not be negative", name)
+	}
+	return n, nil
+}
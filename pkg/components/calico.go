@@ -20,6 +20,9 @@ package components
 var (
 	CalicoRelease string = "master"
 
+	CalicoMinKubernetesVersion string = "1.30"
+	CalicoMaxKubernetesVersion string = "1.35"
+
 	ComponentCalicoCNIWindows = Component{
 		Version:   "master",
 		Image:     "cni-windows",
@@ -116,6 +119,22 @@ var (
 		variant:   calicoVariant,
 	}
 
+	ComponentCalicoPrometheus = Component{
+		Version:   "v3.9.1",
+		Image:     "prometheus",
+		Registry:  "",
+		imagePath: "prometheus/",
+		variant:   calicoVariant,
+	}
+
+	ComponentCalicoKubeRBACProxy = Component{
+		Version:   "master",
+		Image:     "kube-rbac-proxy",
+		Registry:  "",
+		imagePath: "",
+		variant:   calicoVariant,
+	}
+
 	ComponentCalico = Component{
 		Version:   "master",
 		Image:     "calico",
@@ -145,6 +164,8 @@ var (
 		ComponentCalicoIstioInstallCNI,
 		ComponentCalicoIstioZTunnel,
 		ComponentCalicoIstioProxyv2,
+		ComponentCalicoPrometheus,
+		ComponentCalicoKubeRBACProxy,
 		ComponentCalico,
 		ComponentCalicoFIPS,
 	}
@@ -24,9 +24,12 @@ const CalicoBinaryPath = "/usr/bin/calico"
 
 // CombinedCalicoImage returns the combined calico/calico Component for the given installation.
 // The right Component is selected based on the installation variant (Calico OSS vs. Calico Enterprise)
-// and FIPS mode. FIPS + Enterprise is rejected at admission so it is not represented here.
+// and FIPS mode.
 func CombinedCalicoImage(installation *operatorv1.InstallationSpec) Component {
 	if installation.Variant.IsEnterprise() {
+		if operatorv1.IsFIPSModeEnabled(installation.FIPSMode) {
+			return ComponentTigeraCalicoFIPS
+		}
 		return ComponentTigeraCalico
 	}
 	if operatorv1.IsFIPSModeEnabled(installation.FIPSMode) {
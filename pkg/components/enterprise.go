@@ -20,6 +20,9 @@ package components
 var (
 	EnterpriseRelease string = "master"
 
+	EnterpriseMinKubernetesVersion string = "1.30"
+	EnterpriseMaxKubernetesVersion string = "1.35"
+
 	ComponentTigeraCalico = Component{
 		Version:   "master",
 		Image:     "calico",
@@ -28,6 +31,14 @@ var (
 		variant:   enterpriseVariant,
 	}
 
+	ComponentTigeraCalicoFIPS = Component{
+		Version:   "master-fips",
+		Image:     "calico",
+		Registry:  "",
+		imagePath: "",
+		variant:   enterpriseVariant,
+	}
+
 	ComponentComplianceBenchmarker = Component{
 		Version:   "master",
 		Image:     "compliance-benchmarker",
@@ -91,6 +102,14 @@ var (
 		variant:   enterpriseVariant,
 	}
 
+	ComponentFluentdFIPS = Component{
+		Version:   "master-fips",
+		Image:     "fluentd",
+		Registry:  "",
+		imagePath: "",
+		variant:   enterpriseVariant,
+	}
+
 	ComponentFluentdWindows = Component{
 		Version:   "master",
 		Image:     "fluentd-windows",
@@ -99,6 +118,14 @@ var (
 		variant:   enterpriseVariant,
 	}
 
+	ComponentLogExporter = Component{
+		Version:   "master",
+		Image:     "log-exporter",
+		Registry:  "",
+		imagePath: "",
+		variant:   enterpriseVariant,
+	}
+
 	ComponentIntrusionDetectionController = Component{
 		Version:   "master",
 		Image:     "intrusion-detection-controller",
@@ -188,6 +215,14 @@ var (
 		variant:   enterpriseVariant,
 	}
 
+	ComponentKubeRBACProxy = Component{
+		Version:   "master",
+		Image:     "kube-rbac-proxy",
+		Registry:  "",
+		imagePath: "",
+		variant:   enterpriseVariant,
+	}
+
 	ComponentTigeraNode = Component{
 		Version:   "master",
 		Image:     "node",
@@ -268,13 +303,16 @@ var (
 	// Components that are only for providing a version should be left out of this list.
 	EnterpriseImages = []Component{
 		ComponentTigeraCalico,
+		ComponentTigeraCalicoFIPS,
 		ComponentComplianceBenchmarker,
 		ComponentDeepPacketInspection,
 		ComponentElasticTseeInstaller,
 		ComponentElasticsearch,
 		ComponentElasticsearchOperator,
 		ComponentFluentd,
+		ComponentFluentdFIPS,
 		ComponentFluentdWindows,
+		ComponentLogExporter,
 		ComponentIntrusionDetectionController,
 		ComponentKibana,
 		ComponentManager,
@@ -285,6 +323,7 @@ var (
 		ComponentDikastes,
 		ComponentPrometheus,
 		ComponentPrometheusAlertmanager,
+		ComponentKubeRBACProxy,
 		ComponentTigeraNode,
 		ComponentTigeraNodeWindows,
 		ComponentTigeraCNIWindows,
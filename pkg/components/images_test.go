@@ -26,7 +26,12 @@ import (
 func calicoImageEntries() []TableEntry {
 	var entries []TableEntry
 	for _, c := range CalicoImages {
-		entries = append(entries, Entry(fmt.Sprintf("a %s image correctly", c.Image), c, CalicoRegistry, CalicoImagePath))
+		// Upstream images, such as Prometheus, are pulled from their own image path.
+		imagePath := CalicoImagePath
+		if c.imagePath != "" {
+			imagePath = c.imagePath
+		}
+		entries = append(entries, Entry(fmt.Sprintf("a %s image correctly", c.Image), c, CalicoRegistry, imagePath))
 	}
 	return entries
 }
@@ -143,6 +148,23 @@ var _ = Describe("test GetReference", func() {
 		)
 	})
 
+	Context("per-image override", func() {
+		overrides := []op.ComponentImage{
+			{Image: "calico/node", Registry: "mirror.io/"},
+			{Image: "tigera/node", Registry: "mirror.io/", ImagePath: "mirrorpath"},
+			{Image: "tigera/eck-operator", ImagePath: "mirrorpath"},
+		}
+		DescribeTable("should render",
+			func(c Component, expected string) {
+				Expect(GetReference(c, "quay.io/extra/", "userpath", "", nil, overrides...)).To(Equal(fmt.Sprintf("%s%s:%s", expected, c.Image, c.Version)))
+			},
+			Entry("a calico image with an overridden registry", ComponentCalicoNode, "mirror.io/userpath/"),
+			Entry("a tigera image with an overridden registry and imagepath", ComponentTigeraNode, "mirror.io/mirrorpath/"),
+			Entry("an ECK image with an overridden imagepath", ComponentElasticsearchOperator, "quay.io/extra/mirrorpath/"),
+			Entry("an image without an override", ComponentOperatorInit, "quay.io/extra/userpath/"),
+		)
+	})
+
 	Context("with an ImageSet", func() {
 		DescribeTable("should render",
 			func(c Component, hash string) {
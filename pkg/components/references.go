@@ -75,10 +75,24 @@ func getDefaults(c Component) (registry string, imagePath string) {
 	return
 }
 
-// GetReference returns the fully qualified image to use, including registry and version.
-func GetReference(c Component, registry, imagePath, imagePrefix string, is *operator.ImageSet) (string, error) {
+// GetReference returns the fully qualified image to use, including registry and version. If one of the given
+// overrides matches the component's image, its registry and image path take precedence over the given ones.
+func GetReference(c Component, registry, imagePath, imagePrefix string, is *operator.ImageSet, overrides ...operator.ComponentImage) (string, error) {
 	defaultRegistry, defaultImagePath := getDefaults(c)
 
+	for _, o := range overrides {
+		if o.Image != path.Join(defaultImagePath, c.Image) {
+			continue
+		}
+		if o.Registry != "" {
+			registry = o.Registry
+		}
+		if o.ImagePath != "" {
+			imagePath = o.ImagePath
+		}
+		break
+	}
+
 	// If a user did not supply a registry, use the default registry
 	if registry == "" || registry == UseDefault {
 		registry = defaultRegistry
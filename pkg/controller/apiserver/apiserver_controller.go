@@ -17,9 +17,13 @@ package apiserver
 import (
 	"context"
 	"fmt"
+	"net"
+	"slices"
+	"sync/atomic"
 
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,14 +36,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gapi "sigs.k8s.io/gateway-api/apis/v1"
+	gapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
+	routev1 "github.com/openshift/api/route/v1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
-	"github.com/tigera/operator/pkg/common/validation"
-	apiserver "github.com/tigera/operator/pkg/common/validation/apiserver"
-	webhooksvalidation "github.com/tigera/operator/pkg/common/validation/webhooks"
+	"github.com/tigera/operator/pkg/common/validation/resources"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
 	"github.com/tigera/operator/pkg/controller/k8sapi"
 	"github.com/tigera/operator/pkg/controller/migration/datastoremigration"
@@ -62,16 +68,22 @@ const ResourceName string = "apiserver"
 
 var log = logf.Log.WithName("controller_apiserver")
 
+// auditWebhookSecretName is the name of the audit webhook secret that the APIServer was last reconciled with, or empty
+// if the APIServer does not send its audit logs to a webhook.
+var auditWebhookSecretName atomic.Value
+
 // Add creates a new APIServer Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 	r := &ReconcileAPIServer{
-		client:              mgr.GetClient(),
-		scheme:              mgr.GetScheme(),
-		status:              status.New(mgr.GetClient(), "apiserver", opts.KubernetesVersion),
-		tierWatchReady:      &utils.ReadyFlag{},
-		migrationWatchReady: &utils.ReadyFlag{},
-		opts:                opts,
+		client:                   mgr.GetClient(),
+		scheme:                   mgr.GetScheme(),
+		status:                   status.New(mgr.GetClient(), "apiserver", opts.KubernetesVersion, opts.EventRecorder),
+		tierWatchReady:           &utils.ReadyFlag{},
+		migrationWatchReady:      &utils.ReadyFlag{},
+		serviceMonitorWatchReady: &utils.ReadyFlag{},
+		gatewayWatchReady:        &utils.ReadyFlag{},
+		opts:                     opts,
 	}
 	r.status.Run(opts.ShutdownContext)
 
@@ -79,6 +91,7 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 	if err != nil {
 		return fmt.Errorf("failed to create apiserver-controller: %w", err)
 	}
+	r.controller = c
 
 	// Established deferred watches against the v3 API that should succeed after the API Server becomes available.
 	// Watch for changes to Tier, as its status is used as input to determine whether network policy should be reconciled by this controller.
@@ -99,6 +112,19 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		return fmt.Errorf("apiserver-controller failed to watch Installation resource: %v", err)
 	}
 
+	if opts.MultiTenant {
+		// Each tenant may run its own API server in its namespace. Tenants are reconciled individually, and all of them
+		// whenever the cluster-wide configuration they depend on changes.
+		if err = c.WatchObject(&operatorv1.Tenant{}, &handler.EnqueueRequestForObject{}); err != nil {
+			return fmt.Errorf("apiserver-controller failed to watch Tenant resource: %w", err)
+		}
+		for _, obj := range []client.Object{&operatorv1.APIServer{}, &operatorv1.Installation{}} {
+			if err = c.WatchObject(obj, utils.EnqueueAllTenants(mgr.GetClient())); err != nil {
+				return fmt.Errorf("apiserver-controller failed to watch resource: %w", err)
+			}
+		}
+	}
+
 	if err = utils.AddConfigMapWatch(c, render.K8sSvcEndpointConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
 		return fmt.Errorf("apiserver-controller failed to watch ConfigMap %s: %w", render.K8sSvcEndpointConfigMapName, err)
 	}
@@ -123,7 +149,7 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		}
 
 		for _, namespace := range []string{common.OperatorNamespace(), render.APIServerNamespace} {
-			for _, secretName := range []string{render.VoltronTunnelSecretName, render.ManagerTLSSecretName} {
+			for _, secretName := range []string{render.VoltronTunnelSecretName, render.VoltronNextTunnelSecretName, render.ManagerTLSSecretName} {
 				if err = utils.AddSecretsWatch(c, secretName, namespace); err != nil {
 					return fmt.Errorf("apiserver-controller failed to watch the Secret resource: %v", err)
 				}
@@ -165,6 +191,9 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 	if err = utils.AddDeploymentWatch(c, "calico-apiserver", "calico-apiserver"); err != nil {
 		return fmt.Errorf("apiserver-controller failed to watch Deployment: %w", err)
 	}
+	if err = utils.AddDeploymentWatch(c, render.APIServerMigrationName, render.APIServerNamespace); err != nil {
+		return fmt.Errorf("apiserver-controller failed to watch the migration Deployment: %w", err)
+	}
 
 	if err = utils.AddDeploymentWatch(c, webhooks.WebhooksName, common.CalicoNamespace); err != nil {
 		return fmt.Errorf("apiserver-controller failed to watch webhooks Deployment: %w", err)
@@ -172,11 +201,33 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 	if err = utils.AddSecretsWatch(c, webhooks.WebhooksTLSSecretName, common.OperatorNamespace()); err != nil {
 		return fmt.Errorf("apiserver-controller failed to watch webhooks TLS secret: %w", err)
 	}
+	if err = utils.AddSecretsWatch(c, render.APIServerEtcdTLSSecretName, common.OperatorNamespace()); err != nil {
+		return fmt.Errorf("apiserver-controller failed to watch etcd TLS secret: %w", err)
+	}
+	if err = utils.AddSecretsWatch(c, render.APIServerAuditWebhookSecretName, common.OperatorNamespace()); err != nil {
+		return fmt.Errorf("apiserver-controller failed to watch audit webhook secret: %w", err)
+	}
+	// The audit webhook secret may be given another name in the APIServer. Match the name the APIServer was last
+	// reconciled with; a change of the name itself is picked up through the APIServer watch.
+	err = c.WatchObject(&corev1.Secret{}, &handler.EnqueueRequestForObject{}, predicate.NewPredicateFuncs(func(object client.Object) bool {
+		name, _ := auditWebhookSecretName.Load().(string)
+		return name != "" && object.GetNamespace() == common.OperatorNamespace() && object.GetName() == name
+	}))
+	if err != nil {
+		return fmt.Errorf("apiserver-controller failed to watch the configured audit webhook secret: %w", err)
+	}
 
 	if err = imageset.AddImageSetWatch(c); err != nil {
 		return fmt.Errorf("apiserver-controller failed to watch ImageSet: %w", err)
 	}
 
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("apiserver-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("apiserver-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("apiserver-controller failed to watch apiserver Tigerastatus: %w", err)
@@ -198,6 +249,28 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		},
 	}, predicate.ResourceVersionChangedPredicate{})
 
+	// Watch the API server ServiceMonitor. This watch can only be established once the Prometheus operator CRDs
+	// are installed, so its readiness also tells us whether the ServiceMonitor can be rendered.
+	go utils.WaitToAddResourceWatch(c, opts.K8sClientset, log, r.serviceMonitorWatchReady, []client.Object{
+		&monitoringv1.ServiceMonitor{
+			TypeMeta:   metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: monitor.MonitoringAPIVersion},
+			ObjectMeta: metav1.ObjectMeta{Name: render.APIServerMetricsServiceName, Namespace: render.APIServerNamespace},
+		},
+	})
+
+	// Watch the query server Gateway and TLSRoute. As above, readiness tells us whether the Gateway API CRDs
+	// are installed and so whether the query server can be exposed through a Gateway.
+	go utils.WaitToAddResourceWatch(c, opts.K8sClientset, log, r.gatewayWatchReady, []client.Object{
+		&gapi.Gateway{
+			TypeMeta:   metav1.TypeMeta{Kind: "Gateway", APIVersion: gapi.GroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: render.QueryserverServiceName, Namespace: render.APIServerNamespace},
+		},
+		&gapiv1alpha2.TLSRoute{
+			TypeMeta:   metav1.TypeMeta{Kind: "TLSRoute", APIVersion: gapiv1alpha2.GroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: render.QueryserverServiceName, Namespace: render.APIServerNamespace},
+		},
+	})
+
 	log.V(5).Info("Controller created and Watches setup")
 	return nil
 }
@@ -214,7 +287,19 @@ type ReconcileAPIServer struct {
 	status              status.StatusManager
 	tierWatchReady      *utils.ReadyFlag
 	migrationWatchReady *utils.ReadyFlag
-	opts                options.ControllerOptions
+	// serviceMonitorWatchReady is marked ready once the ServiceMonitor CRD exists and is being watched.
+	serviceMonitorWatchReady *utils.ReadyFlag
+	// gatewayWatchReady is marked ready once the Gateway API Gateway and TLSRoute CRDs exist and are being watched.
+	gatewayWatchReady *utils.ReadyFlag
+	opts              options.ControllerOptions
+
+	// controller is used to watch the query server Route once it is configured.
+	controller ctrlruntime.Controller
+	// queryServerRouteWatched is set once the query server Route is being watched.
+	queryServerRouteWatched bool
+	// queryServerRouteRemoved is set once a query server Route that is not configured has been deleted, so that
+	// it is not deleted again on every reconcile.
+	queryServerRouteRemoved bool
 }
 
 // Reconcile reads that state of the cluster for a APIServer object and makes changes based on the state read
@@ -248,6 +333,18 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 		return reconcile.Result{}, nil
 	}
 
+	// Requests for the namespace of a tenant are for the API server of that tenant.
+	if r.opts.MultiTenant && request.Namespace != "" {
+		tenant, _, err := utils.GetTenant(ctx, r.opts.MultiTenant, r.client, request.Namespace)
+		if err != nil && !errors.IsNotFound(err) {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "An error occurred while querying Tenant", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		if tenant != nil {
+			return r.reconcileTenant(ctx, tenant, reqLogger)
+		}
+	}
+
 	instance, msg, err := utils.GetAPIServer(ctx, r.client)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -264,11 +361,11 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 		r.status.SetDegraded(operatorv1.ResourceReadError, fmt.Sprintf("An error occurred when querying the APIServer resource: %s", msg), err, reqLogger)
 		return reconcile.Result{}, err
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(instance)
 	reqLogger.V(2).Info("Loaded config", "config", instance)
 
 	// Validate APIServer resource.
-	if err := validateAPIServerResource(instance); err != nil {
+	if err := resources.ValidateAPIServer(instance); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceValidationError, "APIServer is invalid", err, reqLogger)
 		return reconcile.Result{}, err
 	}
@@ -276,6 +373,20 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 	// SetMetaData in the TigeraStatus such as observedGenerations.
 	defer r.status.SetMetaData(&instance.ObjectMeta)
 
+	// Report when the delegated auth RBAC is left to be provisioned separately. The condition is persisted along
+	// with the rest of the CR status.
+	if instance.Spec.IsDelegatedAuthManaged() {
+		meta.RemoveStatusCondition(&instance.Status.Conditions, operatorv1.APIServerDelegatedAuthManagedCondition)
+	} else {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:               operatorv1.APIServerDelegatedAuthManagedCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             string(operatorv1.DelegatedAuthUnmanaged),
+			Message:            "The calico-apiserver-delegate-auth ClusterRoleBinding and calico-apiserver-auth-reader RoleBinding must be provisioned separately",
+			ObservedGeneration: instance.Generation,
+		})
+	}
+
 	// Changes for updating ApiServer status conditions.
 	if request.Name == ResourceName && request.Namespace == "" {
 		ts := &operatorv1.TigeraStatus{}
@@ -290,6 +401,13 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 		}
 	}
 
+	// Leave the managed resources alone while reconciliation is paused, so that manual changes made for
+	// debugging are not reverted.
+	if common.ReconcilePaused(instance) {
+		reqLogger.Info("Reconciliation is paused", "annotation", common.ReconcileAnnotation)
+		return reconcile.Result{}, nil
+	}
+
 	// Query for the installation object.
 	_, installationSpec, err := utils.GetInstallationSpec(context.Background(), r.client)
 	if err != nil {
@@ -300,6 +418,15 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying installation", err, reqLogger)
 		return reconcile.Result{}, err
 	}
+
+	// A tenant that is deleted is reconciled here, so this is where its API server is removed from the ClusterRoleBinding
+	// shared by the tenant API servers.
+	if r.opts.MultiTenant && installationSpec.Variant.IsEnterprise() {
+		if err = r.reconcileTenantAPIServerClusterRBAC(ctx, instance); err != nil {
+			r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error reconciling the tenant API server ClusterRoleBinding", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+	}
 	if installationSpec.Variant == "" {
 		r.status.SetDegraded(operatorv1.ResourceNotReady, "Waiting for Installation Variant to be set", nil, reqLogger)
 		return reconcile.Result{}, nil
@@ -311,8 +438,18 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 		return reconcile.Result{}, err
 	}
 
+	queryServerRouteHost, err := r.queryServerRouteHost(ctx, instance)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error reading the query server Route", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	apiServerDNSNames := append(dns.GetServiceDNSNames(render.APIServerServiceName, render.APIServerNamespace, r.opts.ClusterDomain), extraAPIServerSANs(instance)...)
+	if queryServerRouteHost != "" && !slices.Contains(apiServerDNSNames, queryServerRouteHost) {
+		apiServerDNSNames = append(apiServerDNSNames, queryServerRouteHost)
+	}
 	secretName := render.CalicoAPIServerTLSSecretName
-	tlsSecret, err := certificateManager.GetOrCreateKeyPair(r.client, secretName, common.OperatorNamespace(), dns.GetServiceDNSNames(render.APIServerServiceName, render.APIServerNamespace, r.opts.ClusterDomain))
+	tlsSecret, err := certificateManager.GetOrRequestKeyPair(r.client, secretName, common.OperatorNamespace(), apiServerDNSNames)
 	if err != nil {
 		r.status.SetDegraded(operatorv1.ResourceCreateError, "Unable to get or create tls key pair", err, reqLogger)
 		return reconcile.Result{}, err
@@ -320,8 +457,8 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 
 	// Since apiserver and queryserver may have different UID:GID at run-time, we need to produce this secret in separate volumes and with different permissions.
 	var queryServerTLSSecretCertificateManagementOnly certificatemanagement.KeyPairInterface
-	if installationSpec.CertificateManagement != nil {
-		queryServerTLSSecretCertificateManagementOnly, err = certificateManager.GetOrCreateKeyPair(r.client, "query-server-tls", common.OperatorNamespace(), dns.GetServiceDNSNames(render.APIServerServiceName, render.APIServerNamespace, r.opts.ClusterDomain))
+	if installationSpec.CertificateManagement != nil && instance.Spec.IsQueryServerEnabled() {
+		queryServerTLSSecretCertificateManagementOnly, err = certificateManager.GetOrCreateKeyPair(r.client, "query-server-tls", common.OperatorNamespace(), apiServerDNSNames)
 		if err != nil {
 			r.status.SetDegraded(operatorv1.ResourceCreateError, "Unable to get or create tls key pair", err, reqLogger)
 			return reconcile.Result{}, err
@@ -388,6 +525,20 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 				r.status.SetDegraded(operatorv1.ResourceReadError, "Unable to fetch the tunnel secret", err, reqLogger)
 				return reconcile.Result{}, err
 			}
+
+			// While the manager controller is rotating the tunnel CA, sign the certificates of managed clusters with
+			// the replacement CA so that they move to it as they reconnect.
+			if tunnelSecretName == render.VoltronTunnelSecretName {
+				next, err := utils.GetSecret(ctx, r.client, render.VoltronNextTunnelSecretName, common.OperatorNamespace())
+				if err != nil {
+					r.status.SetDegraded(operatorv1.ResourceReadError, "Unable to fetch the replacement tunnel secret", err, reqLogger)
+					return reconcile.Result{}, err
+				}
+				if next != nil {
+					managementCluster = managementCluster.DeepCopy()
+					managementCluster.Spec.TLS.SecretName = render.VoltronNextTunnelSecretName
+				}
+			}
 		}
 
 		prometheusCertificate, err := certificateManager.GetCertificate(r.client, monitor.PrometheusClientTLSSecretName, common.OperatorNamespace())
@@ -467,6 +618,42 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 		return reconcile.Result{}, err
 	}
 
+	var etcdEndpoints []string
+	var etcdTLSSecret *corev1.Secret
+	if etcd := instance.Spec.EtcdDatastore; etcd != nil {
+		etcdEndpoints = etcd.Endpoints
+		etcdTLSSecret, err = getEtcdTLSSecret(ctx, r.client)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving etcd TLS secret", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+	}
+
+	var auditWebhookSecret *corev1.Secret
+	auditWebhookSecretName.Store("")
+	if installationSpec.Variant.IsEnterprise() && instance.Spec.GetAuditLogStorage() == operatorv1.APIServerAuditLogStorageWebhook {
+		auditWebhookSecretName.Store(instance.Spec.GetAuditWebhookConfigSecretName())
+		auditWebhookSecret, err = getAuditWebhookSecret(ctx, r.client, instance.Spec.GetAuditWebhookConfigSecretName())
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving audit webhook secret", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		if auditWebhookSecret == nil {
+			r.status.SetDegraded(operatorv1.ResourceNotFound, fmt.Sprintf("Waiting for audit webhook secret %q", instance.Spec.GetAuditWebhookConfigSecretName()), nil, reqLogger)
+			return reconcile.Result{}, nil
+		}
+	}
+
+	serviceMonitorCRDExists := r.serviceMonitorWatchReady != nil && r.serviceMonitorWatchReady.IsReady()
+	if instance.Spec.IsPrometheusMetricsEnabled() && !serviceMonitorCRDExists {
+		reqLogger.Info("Prometheus metrics are enabled, but the ServiceMonitor CRD is not installed. Skipping the API server ServiceMonitor")
+	}
+
+	gatewayAPICRDExists := r.gatewayWatchReady != nil && r.gatewayWatchReady.IsReady()
+	if instance.Spec.QueryServerGateway() != nil && !gatewayAPICRDExists {
+		reqLogger.Info("A query server Gateway is configured, but the Gateway API CRDs are not installed. Skipping the query server Gateway")
+	}
+
 	// API server exists and configuration is valid - maintain a Finalizer on the installation.
 	if _, err := r.maintainFinalizer(ctx, instance); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Error setting finalizer on Installation", err, reqLogger)
@@ -498,6 +685,20 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 		ClusterDomain:                r.opts.ClusterDomain,
 		RequiresAggregationServer:    !r.opts.UseV3CRDs,
 		QueryServerTLSKeyPairCertificateManagementOnly: queryServerTLSSecretCertificateManagementOnly,
+		ServiceMonitorCRDExists:                        serviceMonitorCRDExists,
+		GatewayAPICRDExists:                            gatewayAPICRDExists,
+		QueryServerRouteHost:                           queryServerRouteHost,
+		DeleteQueryServerRoute:                         instance.Spec.QueryServerRoute() == nil && !r.queryServerRouteRemoved,
+		EtcdEndpoints:                                  etcdEndpoints,
+		EtcdTLSSecret:                                  etcdTLSSecret,
+		AuditWebhookSecret:                             auditWebhookSecret,
+	}
+
+	// Move the API server between the host and pod networks without an outage when its network mode changes.
+	apiServerCfg.HostNetworkMigration, err = hostNetworkMigration(ctx, r.client, apiServerCfg.HostNetwork())
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying the API server deployments", err, reqLogger)
+		return reconcile.Result{}, err
 	}
 
 	var components []render.Component
@@ -561,6 +762,14 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 		}),
 	)
 
+	// Remove any flow control resources that are no longer configured.
+	staleFlowControl, err := staleFlowControlObjects(ctx, r.client, instance)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error listing flow control resources", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	components = append(components, render.NewDeletionPassthrough(staleFlowControl...))
+
 	// If the projectcalico.org/v3 API group is being backed by our aggregated API server, then v3 NetworkPolicy will fail to reconcile until the Calico API server is healthy.
 	// Thus, we only render v3.NetworkPolicy after the aggregated API server becomes available to avoid a chicken-and-egg scenario.
 	//
@@ -584,6 +793,9 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 		}
 	}
 
+	// A query server Route that is not configured has been deleted by now. Do not delete it again until it is.
+	r.queryServerRouteRemoved = instance.Spec.QueryServerRoute() == nil
+
 	// Check BYO certificate expiry warnings.
 	certificatemanagement.CheckKeyPairWarnings(map[string]certificatemanagement.KeyPairInterface{
 		render.CalicoAPIServerTLSSecretName: tlsSecret,
@@ -603,26 +815,202 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 	if err = r.client.Status().Update(ctx, instance); err != nil {
 		return reconcile.Result{}, err
 	}
+	if apiServerCfg.HostNetworkMigration != nil {
+		// The deployment watches ignore status updates, so check on the progress of the migration periodically.
+		return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
-func validateAPIServerResource(instance *operatorv1.APIServer) error {
-	// Verify the APIServerDeployment overrides, if specified, is valid.
-	if d := instance.Spec.APIServerDeployment; d != nil {
-		err := validation.ValidateReplicatedPodResourceOverrides(d, apiserver.ValidateAPIServerDeploymentContainer, apiserver.ValidateAPIServerDeploymentInitContainer)
-		if err != nil {
-			return fmt.Errorf("APIServer spec.APIServerDeployment is not valid: %w", err)
+// hostNetworkMigration returns the progress of a move of the API server between the host and pod networks, or nil
+// if none is in progress. The calico-apiserver deployment keeps its current network mode until the migration
+// deployment, rendered in the new mode, has an available pod. The migration deployment is then kept until the
+// calico-apiserver deployment has been recreated in the new mode and has an available pod itself.
+func hostNetworkMigration(ctx context.Context, cli client.Client, hostNetwork bool) (*render.APIServerHostNetworkMigration, error) {
+	current := &v1.Deployment{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: render.APIServerName, Namespace: render.APIServerNamespace}, current); err != nil {
+		if errors.IsNotFound(err) {
+			// Nothing to migrate from.
+			return nil, nil
 		}
+		return nil, err
 	}
 
-	// Verify the CalicoWebhooksDeployment overrides, if specified, is valid.
-	if d := instance.Spec.CalicoWebhooksDeployment; d != nil {
-		err := validation.ValidateReplicatedPodResourceOverrides(d, webhooksvalidation.ValidateCalicoWebhooksDeploymentContainer, validation.NoContainersDefined)
-		if err != nil {
-			return fmt.Errorf("APIServer spec.CalicoWebhooksDeployment is not valid: %w", err)
+	migrationAvailable := false
+	migration := &v1.Deployment{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: render.APIServerMigrationName, Namespace: render.APIServerNamespace}, migration); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, err
 		}
+		migration = nil
+	} else {
+		migrationAvailable = migration.Spec.Template.Spec.HostNetwork == hostNetwork && deploymentAvailable(migration)
 	}
-	return nil
+
+	if current.Spec.Template.Spec.HostNetwork != hostNetwork {
+		if migrationAvailable {
+			return &render.APIServerHostNetworkMigration{}, nil
+		}
+		previous := current.Spec.Template.Spec.HostNetwork
+		return &render.APIServerHostNetworkMigration{PreviousHostNetwork: &previous}, nil
+	}
+	if migration != nil && !deploymentAvailable(current) {
+		return &render.APIServerHostNetworkMigration{}, nil
+	}
+	return nil, nil
+}
+
+// deploymentAvailable returns true if the latest spec of the deployment has been rolled out to at least one pod, and
+// the deployment has an available pod.
+func deploymentAvailable(d *v1.Deployment) bool {
+	return d.Status.ObservedGeneration >= d.Generation && d.Status.UpdatedReplicas > 0 && d.Status.AvailableReplicas > 0
+}
+
+// queryServerRouteHost returns the host of the query server OpenShift Route, if configured. OpenShift generates the
+// host when the Route is created without a hostname, and clients verify the API server certificate against it too.
+// The Route is only watched once it is configured, so that it is not watched on every OpenShift cluster.
+func (r *ReconcileAPIServer) queryServerRouteHost(ctx context.Context, instance *operatorv1.APIServer) (string, error) {
+	route := instance.Spec.QueryServerRoute()
+	if route == nil || !r.opts.DetectedProvider.IsOpenShift() {
+		return "", nil
+	}
+	if !r.queryServerRouteWatched {
+		if err := utils.AddNamespacedWatch(r.controller, &routev1.Route{
+			TypeMeta:   metav1.TypeMeta{Kind: "Route", APIVersion: routev1.GroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: render.QueryserverServiceName, Namespace: render.APIServerNamespace},
+		}, &handler.EnqueueRequestForObject{}); err != nil {
+			return "", fmt.Errorf("failed to watch the query server Route: %w", err)
+		}
+		r.queryServerRouteWatched = true
+	}
+	if route.Hostname != "" {
+		return route.Hostname, nil
+	}
+
+	existing := &routev1.Route{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: render.QueryserverServiceName, Namespace: render.APIServerNamespace}, existing)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return existing.Spec.Host, nil
+}
+
+// extraAPIServerSANs returns the user supplied DNS names and IP addresses to add to the API server certificate.
+func extraAPIServerSANs(instance *operatorv1.APIServer) []string {
+	var sans []string
+	// The query server Gateway passes TLS through, so clients verify the API server certificate against the
+	// Gateway hostname.
+	if gw := instance.Spec.QueryServerGateway(); gw != nil && gw.Hostname != "" {
+		sans = append(sans, gw.Hostname)
+	}
+	// The same applies to the hostname of the query server OpenShift Route.
+	if route := instance.Spec.QueryServerRoute(); route != nil && route.Hostname != "" {
+		sans = append(sans, route.Hostname)
+	}
+	t := instance.Spec.TLS
+	if t == nil {
+		return sans
+	}
+	sans = append(sans, t.ExtraDNSNames...)
+	for _, ip := range t.ExtraIPAddresses {
+		// Use the canonical form, since that is how the IP is recorded in the issued certificate.
+		if parsed := net.ParseIP(ip); parsed != nil {
+			ip = parsed.String()
+		}
+		sans = append(sans, ip)
+	}
+	return sans
+}
+
+// staleFlowControlObjects returns the FlowSchemas and PriorityLevelConfigurations previously rendered from the
+// APIServer flow control configuration that are no longer configured. All of them are stale once the flow control
+// configuration is removed.
+func staleFlowControlObjects(ctx context.Context, c client.Client, instance *operatorv1.APIServer) ([]client.Object, error) {
+	desiredPLCs := map[string]bool{}
+	desiredFlowSchemas := map[string]bool{}
+	if fc := instance.Spec.FlowControl; fc != nil {
+		for _, plc := range fc.PriorityLevelConfigurations {
+			desiredPLCs[plc.Name] = true
+		}
+		for _, fs := range fc.FlowSchemas {
+			desiredFlowSchemas[fs.Name] = true
+		}
+	}
+
+	labels := client.MatchingLabels{render.APIServerFlowControlLabel: render.APIServerFlowControlLabelValue}
+	var stale []client.Object
+
+	plcs := &flowcontrolv1.PriorityLevelConfigurationList{}
+	if err := c.List(ctx, plcs, labels); err != nil {
+		if meta.IsNoMatchError(err) {
+			// The cluster does not serve the flow control API, so nothing can have been rendered.
+			return nil, nil
+		}
+		if errors.IsForbidden(err) {
+			// The operator has not been granted access to the flow control API, so nothing can have been rendered.
+			log.V(1).Info("Not permitted to list flow control resources, skipping their cleanup", "error", err)
+			return nil, nil
+		}
+		return nil, err
+	}
+	for i := range plcs.Items {
+		if !desiredPLCs[plcs.Items[i].Name] {
+			stale = append(stale, &plcs.Items[i])
+		}
+	}
+
+	flowSchemas := &flowcontrolv1.FlowSchemaList{}
+	if err := c.List(ctx, flowSchemas, labels); err != nil {
+		if errors.IsForbidden(err) {
+			log.V(1).Info("Not permitted to list flow control resources, skipping their cleanup", "error", err)
+			return nil, nil
+		}
+		return nil, err
+	}
+	for i := range flowSchemas.Items {
+		if !desiredFlowSchemas[flowSchemas.Items[i].Name] {
+			stale = append(stale, &flowSchemas.Items[i])
+		}
+	}
+	return stale, nil
+}
+
+// getEtcdTLSSecret returns the secret holding the etcd client certificates, or nil if it doesn't exist.
+func getEtcdTLSSecret(ctx context.Context, c client.Client) (*corev1.Secret, error) {
+	s := &corev1.Secret{}
+	key := types.NamespacedName{Name: render.APIServerEtcdTLSSecretName, Namespace: common.OperatorNamespace()}
+	if err := c.Get(ctx, key, s); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read secret %q: %w", render.APIServerEtcdTLSSecretName, err)
+	}
+
+	for _, k := range []string{render.APIServerEtcdCACertKey, corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		if len(s.Data[k]) == 0 {
+			return nil, fmt.Errorf("expected secret %q to have a field named %q", render.APIServerEtcdTLSSecretName, k)
+		}
+	}
+	return s, nil
+}
+
+// getAuditWebhookSecret returns the secret holding the kubeconfig of the audit webhook collector, or nil if it doesn't exist.
+func getAuditWebhookSecret(ctx context.Context, c client.Client, name string) (*corev1.Secret, error) {
+	s := &corev1.Secret{}
+	key := types.NamespacedName{Name: name, Namespace: common.OperatorNamespace()}
+	if err := c.Get(ctx, key, s); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read secret %q: %w", name, err)
+	}
+	if len(s.Data[render.APIServerAuditWebhookConfigKey]) == 0 {
+		return nil, fmt.Errorf("expected secret %q to have a field named %q", name, render.APIServerAuditWebhookConfigKey)
+	}
+	return s, nil
 }
 
 // setAPIGroupEnvVar updates the operator's own Deployment to add the
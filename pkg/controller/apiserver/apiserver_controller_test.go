@@ -27,30 +27,41 @@ import (
 	admregv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/openshift/library-go/pkg/crypto"
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/apis"
 	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/common/validation/resources"
 	"github.com/tigera/operator/pkg/components"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
 	"github.com/tigera/operator/pkg/controller/options"
 	"github.com/tigera/operator/pkg/controller/status"
 	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/pkg/ctrlruntime"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
 	"github.com/tigera/operator/pkg/dns"
 	"github.com/tigera/operator/pkg/render"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 	"github.com/tigera/operator/pkg/render/common/secret"
 	"github.com/tigera/operator/pkg/tls"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
 	"github.com/tigera/operator/test"
 )
 
@@ -145,7 +156,7 @@ var _ = Describe("apiserver controller tests", func() {
 		mockStatus.On("AddStatefulSets", mock.Anything).Return()
 		mockStatus.On("AddCronJobs", mock.Anything)
 		mockStatus.On("IsAvailable").Return(true)
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("ClearDegraded")
 		mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 		mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -209,6 +220,43 @@ var _ = Describe("apiserver controller tests", func() {
 					components.ComponentTigeraCalico.Image,
 					components.ComponentTigeraCalico.Version)))
 		})
+		It("should not render anything while reconciliation is paused", func() {
+			Expect(cli.Create(ctx, installation)).To(BeNil())
+
+			apiServer := &operatorv1.APIServer{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, apiServer)).NotTo(HaveOccurred())
+			apiServer.Annotations = map[string]string{"operator.tigera.io/reconcile": "paused"}
+			Expect(cli.Update(ctx, apiServer)).NotTo(HaveOccurred())
+
+			r := ReconcileAPIServer{
+				client:              cli,
+				scheme:              scheme,
+				status:              mockStatus,
+				tierWatchReady:      ready,
+				migrationWatchReady: &utils.ReadyFlag{},
+				opts: options.ControllerOptions{
+					EnterpriseCRDExists: true,
+					DetectedProvider:    operatorv1.ProviderNone,
+				},
+			}
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			d := appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver", Namespace: "calico-system"},
+			}
+			Expect(kerror.IsNotFound(test.GetResource(cli, &d))).To(BeTrue())
+
+			By("resuming reconciliation once the annotation is removed")
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, apiServer)).NotTo(HaveOccurred())
+			apiServer.Annotations = nil
+			Expect(cli.Update(ctx, apiServer)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(test.GetResource(cli, &d)).To(BeNil())
+		})
+
 		It("should use images from imageset", func() {
 			installation.Spec.CertificateManagement = certificateManagement
 			Expect(cli.Create(ctx, installation)).To(BeNil())
@@ -566,6 +614,37 @@ var _ = Describe("apiserver controller tests", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(instance.Status.Conditions).To(HaveLen(0))
 		})
+		It("should report the delegated auth RBAC as unmanaged", func() {
+			apiserver, _, err := utils.GetAPIServer(ctx, cli)
+			Expect(err).ShouldNot(HaveOccurred())
+			apiserver.Spec.DelegatedAuth = &operatorv1.APIServerDelegatedAuth{Management: ptr.To(operatorv1.DelegatedAuthUnmanaged)}
+			Expect(cli.Update(ctx, apiserver)).NotTo(HaveOccurred())
+			Expect(cli.Create(ctx, &operatorv1.TigeraStatus{ObjectMeta: metav1.ObjectMeta{Name: "apiserver"}})).NotTo(HaveOccurred())
+
+			r := ReconcileAPIServer{
+				client:              cli,
+				scheme:              scheme,
+				status:              mockStatus,
+				tierWatchReady:      ready,
+				migrationWatchReady: &utils.ReadyFlag{},
+				opts: options.ControllerOptions{
+					EnterpriseCRDExists: true,
+					DetectedProvider:    operatorv1.ProviderNone,
+				},
+			}
+			_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "apiserver"}})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			instance, _, err := utils.GetAPIServer(ctx, r.client)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(instance.Status.Conditions).To(HaveLen(1))
+			Expect(instance.Status.Conditions[0].Type).To(Equal(operatorv1.APIServerDelegatedAuthManagedCondition))
+			Expect(instance.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+			Expect(instance.Status.Conditions[0].Reason).To(Equal("Unmanaged"))
+
+			// The RBAC is not rendered.
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "calico-apiserver-delegate-auth"}, &rbacv1.ClusterRoleBinding{})).NotTo(Succeed())
+		})
 		It("should reconcile with creating new status condition  with multiple conditions as true", func() {
 			ts := &operatorv1.TigeraStatus{
 				ObjectMeta: metav1.ObjectMeta{Name: "apiserver"},
@@ -828,6 +907,36 @@ var _ = Describe("apiserver controller tests", func() {
 				Expect(kerror.IsNotFound(err)).Should(BeFalse())
 			})
 
+			It("Should sign managed cluster certificates with the replacement CA while the tunnel CA is rotated", func() {
+				for _, name := range []string{render.VoltronTunnelSecretName, render.VoltronNextTunnelSecretName} {
+					Expect(cli.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.OperatorNamespace()},
+					})).NotTo(HaveOccurred())
+				}
+
+				r := ReconcileAPIServer{
+					client:              cli,
+					scheme:              scheme,
+					status:              mockStatus,
+					tierWatchReady:      ready,
+					migrationWatchReady: &utils.ReadyFlag{},
+					opts: options.ControllerOptions{
+						EnterpriseCRDExists: true,
+						DetectedProvider:    operatorv1.ProviderNone,
+					},
+				}
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				deployment := appsv1.Deployment{}
+				Expect(cli.Get(ctx, types.NamespacedName{Name: "calico-apiserver", Namespace: "calico-system"}, &deployment)).NotTo(HaveOccurred())
+				var args []string
+				for _, c := range deployment.Spec.Template.Spec.Containers {
+					args = append(args, c.Args...)
+				}
+				Expect(args).To(ContainElement("--tunnelSecretName=" + render.VoltronNextTunnelSecretName))
+			})
+
 			It("Should reconcile multi-cluster setup for a management cluster for a multiple tenant", func() {
 				r := ReconcileAPIServer{
 					client:              cli,
@@ -984,9 +1093,739 @@ var _ = Describe("apiserver controller tests", func() {
 					},
 				},
 			}
-			err := validateAPIServerResource(instance)
+			err := resources.ValidateAPIServer(instance)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("CalicoWebhooksDeployment"))
 		})
 	})
+
+	Context("host network migration", func() {
+		deployment := func(name string, hostNetwork, available bool) *appsv1.Deployment {
+			d := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: render.APIServerNamespace},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{HostNetwork: hostNetwork}},
+				},
+			}
+			if available {
+				d.Status = appsv1.DeploymentStatus{UpdatedReplicas: 1, AvailableReplicas: 1}
+			}
+			return d
+		}
+
+		// update replaces the deployment, including its status, as observed by the deployment controller.
+		update := func(d *appsv1.Deployment) {
+			status := d.Status
+			Expect(cli.Update(ctx, d)).NotTo(HaveOccurred())
+			d.Status = status
+			d.Status.ObservedGeneration = d.Generation
+			Expect(cli.Status().Update(ctx, d)).NotTo(HaveOccurred())
+		}
+
+		It("should not migrate a new API server", func() {
+			Expect(hostNetworkMigration(ctx, cli, true)).To(BeNil())
+		})
+
+		It("should not migrate when the network mode is unchanged", func() {
+			Expect(cli.Create(ctx, deployment(render.APIServerName, false, true))).NotTo(HaveOccurred())
+			Expect(hostNetworkMigration(ctx, cli, false)).To(BeNil())
+		})
+
+		It("should move the API server to the new network mode once the migration deployment is available", func() {
+			Expect(cli.Create(ctx, deployment(render.APIServerName, false, true))).NotTo(HaveOccurred())
+
+			By("keeping the current network mode until the migration deployment exists")
+			migration, err := hostNetworkMigration(ctx, cli, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migration.PreviousHostNetwork).To(Equal(ptr.To(false)))
+
+			By("keeping the current network mode until the migration deployment is available")
+			Expect(cli.Create(ctx, deployment(render.APIServerMigrationName, true, false))).NotTo(HaveOccurred())
+			migration, err = hostNetworkMigration(ctx, cli, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migration.PreviousHostNetwork).To(Equal(ptr.To(false)))
+
+			By("moving the current deployment once the migration deployment is available")
+			update(deployment(render.APIServerMigrationName, true, true))
+			migration, err = hostNetworkMigration(ctx, cli, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migration).NotTo(BeNil())
+			Expect(migration.PreviousHostNetwork).To(BeNil())
+
+			By("keeping the migration deployment until the recreated deployment is available")
+			update(deployment(render.APIServerName, true, false))
+			migration, err = hostNetworkMigration(ctx, cli, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migration).NotTo(BeNil())
+			Expect(migration.PreviousHostNetwork).To(BeNil())
+
+			By("finishing the migration once the recreated deployment is available")
+			update(deployment(render.APIServerName, true, true))
+			Expect(hostNetworkMigration(ctx, cli, true)).To(BeNil())
+		})
+	})
+
+	Context("request tuning", func() {
+		It("should reject a non-positive request timeout", func() {
+			instance := &operatorv1.APIServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				Spec: operatorv1.APIServerSpec{
+					RequestTimeout: &metav1.Duration{},
+				},
+			}
+			err := resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("RequestTimeout"))
+		})
+
+		It("should reject invalid watch cache sizes", func() {
+			instance := &operatorv1.APIServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				Spec: operatorv1.APIServerSpec{
+					WatchCacheSizes: []operatorv1.APIServerWatchCacheSize{{Resource: "NetworkPolicies", Size: 100}},
+				},
+			}
+			err := resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("WatchCacheSizes"))
+
+			instance.Spec.WatchCacheSizes = []operatorv1.APIServerWatchCacheSize{
+				{Resource: "networkpolicies.projectcalico.org", Size: 100},
+				{Resource: "networkpolicies.projectcalico.org", Size: 200},
+			}
+			err = resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("more than once"))
+
+			instance.Spec.WatchCacheSizes = instance.Spec.WatchCacheSizes[:1]
+			Expect(resources.ValidateAPIServer(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should only accept an etcd compaction interval with an etcd datastore", func() {
+			instance := &operatorv1.APIServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				Spec: operatorv1.APIServerSpec{
+					EtcdCompactionInterval: &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			}
+			err := resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("EtcdCompactionInterval"))
+
+			instance.Spec.EtcdDatastore = &operatorv1.APIServerEtcdDatastore{Endpoints: []string{"https://etcd:2379"}}
+			Expect(resources.ValidateAPIServer(instance)).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("certificate SANs", func() {
+		It("should add the extra DNS names and IP addresses to the API server certificate", func() {
+			Expect(cli.Create(ctx, installation)).To(BeNil())
+
+			apiServer := &operatorv1.APIServer{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, apiServer)).NotTo(HaveOccurred())
+			apiServer.Spec.TLS = &operatorv1.APIServerTLS{
+				ExtraDNSNames:    []string{"apiserver.example.com"},
+				ExtraIPAddresses: []string{"203.0.113.10", "2001:DB8::1"},
+			}
+			Expect(cli.Update(ctx, apiServer)).NotTo(HaveOccurred())
+
+			r := ReconcileAPIServer{
+				client:              cli,
+				scheme:              scheme,
+				status:              mockStatus,
+				tierWatchReady:      ready,
+				migrationWatchReady: &utils.ReadyFlag{},
+				opts: options.ControllerOptions{
+					EnterpriseCRDExists: true,
+					DetectedProvider:    operatorv1.ProviderNone,
+					ClusterDomain:       dns.DefaultClusterDomain,
+				},
+			}
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			s := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Namespace: common.OperatorNamespace(), Name: render.CalicoAPIServerTLSSecretName}, s)).ShouldNot(HaveOccurred())
+			cert, err := certificatemanagement.ParseCertificate(s.Data[corev1.TLSCertKey])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cert.DNSNames).To(ContainElements("calico-api.calico-system.svc", "apiserver.example.com"))
+			var ips []string
+			for _, ip := range cert.IPAddresses {
+				ips = append(ips, ip.String())
+			}
+			Expect(ips).To(ConsistOf("203.0.113.10", "2001:db8::1"))
+
+			// Reconciling again should not reissue the certificate.
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			s2 := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Namespace: common.OperatorNamespace(), Name: render.CalicoAPIServerTLSSecretName}, s2)).ShouldNot(HaveOccurred())
+			Expect(s2.Data[corev1.TLSCertKey]).To(Equal(s.Data[corev1.TLSCertKey]))
+		})
+
+		It("should add the query server Gateway hostname to the API server certificate", func() {
+			Expect(cli.Create(ctx, installation)).To(BeNil())
+
+			apiServer := &operatorv1.APIServer{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, apiServer)).NotTo(HaveOccurred())
+			apiServer.Spec.QueryServer = &operatorv1.APIServerQueryServer{
+				Gateway: &operatorv1.QueryServerGateway{GatewayClassName: "example", Hostname: "calico-api.example.com"},
+			}
+			Expect(cli.Update(ctx, apiServer)).NotTo(HaveOccurred())
+
+			r := ReconcileAPIServer{
+				client:              cli,
+				scheme:              scheme,
+				status:              mockStatus,
+				tierWatchReady:      ready,
+				migrationWatchReady: &utils.ReadyFlag{},
+				opts: options.ControllerOptions{
+					EnterpriseCRDExists: true,
+					DetectedProvider:    operatorv1.ProviderNone,
+					ClusterDomain:       dns.DefaultClusterDomain,
+				},
+			}
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			s := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Namespace: common.OperatorNamespace(), Name: render.CalicoAPIServerTLSSecretName}, s)).ShouldNot(HaveOccurred())
+			cert, err := certificatemanagement.ParseCertificate(s.Data[corev1.TLSCertKey])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cert.DNSNames).To(ContainElements("calico-api.calico-system.svc", "calico-api.example.com"))
+		})
+
+		It("should watch the query server Route and add its generated host to the API server certificate", func() {
+			Expect(cli.Create(ctx, installation)).To(BeNil())
+
+			apiServer := &operatorv1.APIServer{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, apiServer)).NotTo(HaveOccurred())
+			apiServer.Spec.QueryServer = &operatorv1.APIServerQueryServer{Route: &operatorv1.QueryServerRoute{}}
+			Expect(cli.Update(ctx, apiServer)).NotTo(HaveOccurred())
+			Expect(cli.Create(ctx, &routev1.Route{
+				ObjectMeta: metav1.ObjectMeta{Name: render.QueryserverServiceName, Namespace: render.APIServerNamespace},
+				Spec:       routev1.RouteSpec{Host: "calico-api-calico-system.apps.example.com"},
+			})).NotTo(HaveOccurred())
+
+			watches := &watchRecorder{}
+			r := ReconcileAPIServer{
+				client:              cli,
+				scheme:              scheme,
+				status:              mockStatus,
+				tierWatchReady:      ready,
+				migrationWatchReady: &utils.ReadyFlag{},
+				controller:          watches,
+				opts: options.ControllerOptions{
+					EnterpriseCRDExists: true,
+					DetectedProvider:    operatorv1.ProviderOpenShift,
+					ClusterDomain:       dns.DefaultClusterDomain,
+				},
+			}
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(watches.objects).To(HaveLen(1))
+
+			s := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Namespace: common.OperatorNamespace(), Name: render.CalicoAPIServerTLSSecretName}, s)).ShouldNot(HaveOccurred())
+			cert, err := certificatemanagement.ParseCertificate(s.Data[corev1.TLSCertKey])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cert.DNSNames).To(ContainElements("calico-api.calico-system.svc", "calico-api-calico-system.apps.example.com"))
+
+			route := &routev1.Route{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: render.QueryserverServiceName, Namespace: render.APIServerNamespace}, route)).NotTo(HaveOccurred())
+			Expect(route.Spec.Host).To(Equal("calico-api-calico-system.apps.example.com"))
+		})
+
+		It("should request the API server certificate from cert-manager", func() {
+			installation.Spec.CertManager = &operatorv1.CertManager{IssuerRef: operatorv1.CertManagerIssuerReference{Name: "my-issuer"}}
+			Expect(cli.Create(ctx, installation)).To(BeNil())
+
+			r := ReconcileAPIServer{
+				client:              cli,
+				scheme:              scheme,
+				status:              mockStatus,
+				tierWatchReady:      ready,
+				migrationWatchReady: &utils.ReadyFlag{},
+				opts: options.ControllerOptions{
+					EnterpriseCRDExists: true,
+					DetectedProvider:    operatorv1.ProviderNone,
+					ClusterDomain:       dns.DefaultClusterDomain,
+				},
+			}
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			certificate := &certmanagerv1.Certificate{}
+			Expect(cli.Get(ctx, client.ObjectKey{Namespace: common.OperatorNamespace(), Name: render.CalicoAPIServerTLSSecretName}, certificate)).ShouldNot(HaveOccurred())
+			Expect(certificate.Spec.SecretName).To(Equal(render.CalicoAPIServerTLSSecretName))
+			Expect(certificate.Spec.DNSNames).To(ContainElement("calico-api.calico-system.svc"))
+			Expect(certificate.Spec.IssuerRef).To(Equal(cmmeta.IssuerReference{Name: "my-issuer", Kind: "Issuer", Group: "cert-manager.io"}))
+
+			By("using an operator signed certificate until cert-manager has issued one")
+			s := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Namespace: common.OperatorNamespace(), Name: render.CalicoAPIServerTLSSecretName}, s)).ShouldNot(HaveOccurred())
+			cert, err := certificatemanagement.ParseCertificate(s.Data[corev1.TLSCertKey])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cert.Issuer.CommonName).To(HavePrefix(rmeta.TigeraOperatorCAIssuerPrefix))
+
+			By("issuing the certificate with cert-manager")
+			ca, err := tls.MakeCA("cert-manager-ca")
+			Expect(err).NotTo(HaveOccurred())
+			issued, err := secret.CreateTLSSecret(ca, render.CalicoAPIServerTLSSecretName, common.OperatorNamespace(), corev1.TLSPrivateKeyKey, corev1.TLSCertKey,
+				time.Hour*24*90, []crypto.CertificateExtensionFunc{tls.SetServerAuth, tls.SetClientAuth}, certificate.Spec.DNSNames...)
+			Expect(err).NotTo(HaveOccurred())
+			s.Data = issued.Data
+			s.Annotations = map[string]string{certmanagerv1.CertificateNameKey: certificate.Name}
+			Expect(cli.Update(ctx, s)).NotTo(HaveOccurred())
+
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			By("leaving the issued secret to cert-manager and copying it to the API server namespace")
+			Expect(cli.Get(ctx, client.ObjectKey{Namespace: common.OperatorNamespace(), Name: render.CalicoAPIServerTLSSecretName}, s)).ShouldNot(HaveOccurred())
+			Expect(s.Data[corev1.TLSCertKey]).To(Equal(issued.Data[corev1.TLSCertKey]))
+			copied := &corev1.Secret{}
+			Expect(cli.Get(ctx, client.ObjectKey{Namespace: render.APIServerNamespace, Name: render.CalicoAPIServerTLSSecretName}, copied)).ShouldNot(HaveOccurred())
+			Expect(copied.Data[corev1.TLSCertKey]).To(Equal(issued.Data[corev1.TLSCertKey]))
+		})
+
+		It("should reject invalid extra DNS names and IP addresses", func() {
+			instance := &operatorv1.APIServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				Spec: operatorv1.APIServerSpec{
+					TLS: &operatorv1.APIServerTLS{ExtraDNSNames: []string{"not a dns name"}},
+				},
+			}
+			err := resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ExtraDNSNames"))
+
+			instance.Spec.TLS = &operatorv1.APIServerTLS{ExtraDNSNames: []string{"*.example.com"}, ExtraIPAddresses: []string{"10.0.0.300"}}
+			err = resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ExtraIPAddresses"))
+		})
+
+		It("should reject an invalid query server Gateway hostname", func() {
+			instance := &operatorv1.APIServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				Spec: operatorv1.APIServerSpec{
+					QueryServer: &operatorv1.APIServerQueryServer{
+						Gateway: &operatorv1.QueryServerGateway{GatewayClassName: "example", Hostname: "not a dns name"},
+					},
+				},
+			}
+			err := resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Gateway.Hostname"))
+
+			instance.Spec.QueryServer.Gateway.Hostname = "calico-api.example.com"
+			Expect(resources.ValidateAPIServer(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should reject an invalid query server Route hostname", func() {
+			instance := &operatorv1.APIServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				Spec: operatorv1.APIServerSpec{
+					QueryServer: &operatorv1.APIServerQueryServer{
+						Route: &operatorv1.QueryServerRoute{Hostname: "*.example.com"},
+					},
+				},
+			}
+			err := resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Route.Hostname"))
+
+			instance.Spec.QueryServer.Route.Hostname = "calico-api.apps.example.com"
+			Expect(resources.ValidateAPIServer(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should reject an incomplete audit log volume", func() {
+			instance := &operatorv1.APIServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: operatorv1.APIServerSpec{
+					AuditLogs: &operatorv1.APIServerAuditLogs{
+						Storage: ptr.To(operatorv1.APIServerAuditLogStoragePersistentVolumeClaim),
+					},
+				},
+			}
+			err := resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("PersistentVolumeClaimName must be set"))
+
+			instance.Spec.AuditLogs = &operatorv1.APIServerAuditLogs{
+				Storage:                   ptr.To(operatorv1.APIServerAuditLogStorageEmptyDir),
+				PersistentVolumeClaimName: "audit-logs",
+			}
+			err = resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("may only be set"))
+
+			instance.Spec.AuditLogs.Storage = ptr.To(operatorv1.APIServerAuditLogStoragePersistentVolumeClaim)
+			Expect(resources.ValidateAPIServer(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should reject audit log rotation for the audit webhook", func() {
+			instance := &operatorv1.APIServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: operatorv1.APIServerSpec{
+					AuditLogs: &operatorv1.APIServerAuditLogs{
+						Storage: ptr.To(operatorv1.APIServerAuditLogStorageWebhook),
+						MaxAge:  ptr.To(int32(7)),
+					},
+				},
+			}
+			err := resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("may not be set when Storage is Webhook"))
+
+			instance.Spec.AuditLogs = &operatorv1.APIServerAuditLogs{
+				Storage:                 ptr.To(operatorv1.APIServerAuditLogStorageEmptyDir),
+				WebhookConfigSecretName: "audit-webhook",
+			}
+			err = resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("may only be set"))
+
+			instance.Spec.AuditLogs.Storage = ptr.To(operatorv1.APIServerAuditLogStorageWebhook)
+			Expect(resources.ValidateAPIServer(instance)).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("flow control", func() {
+		It("should create the configured flow control resources and remove those no longer configured", func() {
+			Expect(cli.Create(ctx, installation)).To(BeNil())
+
+			// A FlowSchema left behind by a previous configuration.
+			Expect(cli.Create(ctx, &flowcontrolv1.FlowSchema{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "calico-old",
+					Labels: map[string]string{render.APIServerFlowControlLabel: render.APIServerFlowControlLabelValue},
+				},
+			})).NotTo(HaveOccurred())
+
+			apiServer := &operatorv1.APIServer{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, apiServer)).NotTo(HaveOccurred())
+			apiServer.Spec.FlowControl = &operatorv1.APIServerFlowControl{
+				PriorityAndFairness: ptr.To(operatorv1.PriorityAndFairnessEnabled),
+				PriorityLevelConfigurations: []operatorv1.APIServerPriorityLevelConfiguration{
+					{Name: "calico-workload", Spec: flowcontrolv1.PriorityLevelConfigurationSpec{Type: flowcontrolv1.PriorityLevelEnablementLimited}},
+				},
+				FlowSchemas: []operatorv1.APIServerFlowSchema{
+					{
+						Name: "calico-policies",
+						Spec: flowcontrolv1.FlowSchemaSpec{
+							PriorityLevelConfiguration: flowcontrolv1.PriorityLevelConfigurationReference{Name: "calico-workload"},
+							Rules: []flowcontrolv1.PolicyRulesWithSubjects{{
+								ResourceRules: []flowcontrolv1.ResourcePolicyRule{{
+									Verbs:      []string{"*"},
+									APIGroups:  []string{"projectcalico.org"},
+									Resources:  []string{"networkpolicies"},
+									Namespaces: []string{"*"},
+								}},
+							}},
+						},
+					},
+				},
+			}
+			Expect(cli.Update(ctx, apiServer)).NotTo(HaveOccurred())
+
+			r := ReconcileAPIServer{
+				client:              cli,
+				scheme:              scheme,
+				status:              mockStatus,
+				tierWatchReady:      ready,
+				migrationWatchReady: &utils.ReadyFlag{},
+				opts: options.ControllerOptions{
+					EnterpriseCRDExists: true,
+					DetectedProvider:    operatorv1.ProviderNone,
+				},
+			}
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(test.GetResource(cli, &flowcontrolv1.PriorityLevelConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "calico-workload"},
+			})).To(BeNil())
+			fs := &flowcontrolv1.FlowSchema{ObjectMeta: metav1.ObjectMeta{Name: "calico-policies"}}
+			Expect(test.GetResource(cli, fs)).To(BeNil())
+			Expect(fs.Labels).To(HaveKeyWithValue(render.APIServerFlowControlLabel, render.APIServerFlowControlLabelValue))
+			Expect(fs.Spec.PriorityLevelConfiguration.Name).To(Equal("calico-workload"))
+
+			err = cli.Get(ctx, client.ObjectKey{Name: "calico-old"}, &flowcontrolv1.FlowSchema{})
+			Expect(kerror.IsNotFound(err)).To(BeTrue())
+
+			d := appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver", Namespace: "calico-system"},
+			}
+			Expect(test.GetResource(cli, &d)).To(BeNil())
+			apiserver := test.GetContainer(d.Spec.Template.Spec.Containers, "calico-apiserver")
+			Expect(apiserver).ToNot(BeNil())
+			Expect(apiserver.Args).To(ContainElement("--enable-priority-and-fairness=true"))
+		})
+
+		It("should remove the flow control resources once flow control is no longer configured", func() {
+			Expect(cli.Create(ctx, installation)).To(BeNil())
+
+			labels := map[string]string{render.APIServerFlowControlLabel: render.APIServerFlowControlLabelValue}
+			Expect(cli.Create(ctx, &flowcontrolv1.FlowSchema{
+				ObjectMeta: metav1.ObjectMeta{Name: "calico-policies", Labels: labels},
+			})).NotTo(HaveOccurred())
+			Expect(cli.Create(ctx, &flowcontrolv1.PriorityLevelConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "calico-workload", Labels: labels},
+			})).NotTo(HaveOccurred())
+			// Flow control resources not rendered by the operator are left alone.
+			Expect(cli.Create(ctx, &flowcontrolv1.FlowSchema{
+				ObjectMeta: metav1.ObjectMeta{Name: "global-default"},
+			})).NotTo(HaveOccurred())
+
+			r := ReconcileAPIServer{
+				client:              cli,
+				scheme:              scheme,
+				status:              mockStatus,
+				tierWatchReady:      ready,
+				migrationWatchReady: &utils.ReadyFlag{},
+				opts: options.ControllerOptions{
+					EnterpriseCRDExists: true,
+					DetectedProvider:    operatorv1.ProviderNone,
+				},
+			}
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = cli.Get(ctx, client.ObjectKey{Name: "calico-policies"}, &flowcontrolv1.FlowSchema{})
+			Expect(kerror.IsNotFound(err)).To(BeTrue())
+			err = cli.Get(ctx, client.ObjectKey{Name: "calico-workload"}, &flowcontrolv1.PriorityLevelConfiguration{})
+			Expect(kerror.IsNotFound(err)).To(BeTrue())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "global-default"}, &flowcontrolv1.FlowSchema{})).NotTo(HaveOccurred())
+		})
+
+		It("should skip the cleanup when not permitted to list flow control resources", func() {
+			stale, err := staleFlowControlObjects(ctx, forbiddenListClient{cli}, &operatorv1.APIServer{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stale).To(BeEmpty())
+		})
+
+		It("should reject FlowSchemas that match other API groups", func() {
+			instance := &operatorv1.APIServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				Spec: operatorv1.APIServerSpec{
+					FlowControl: &operatorv1.APIServerFlowControl{
+						FlowSchemas: []operatorv1.APIServerFlowSchema{{
+							Name: "pods",
+							Spec: flowcontrolv1.FlowSchemaSpec{
+								Rules: []flowcontrolv1.PolicyRulesWithSubjects{{
+									ResourceRules: []flowcontrolv1.ResourcePolicyRule{{
+										Verbs:     []string{"*"},
+										APIGroups: []string{""},
+										Resources: []string{"pods"},
+									}},
+								}},
+							},
+						}},
+					},
+				},
+			}
+			err := resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("projectcalico.org"))
+
+			instance.Spec.FlowControl.FlowSchemas[0].Spec.Rules[0] = flowcontrolv1.PolicyRulesWithSubjects{
+				NonResourceRules: []flowcontrolv1.NonResourcePolicyRule{{Verbs: []string{"*"}, NonResourceURLs: []string{"/healthz"}}},
+			}
+			err = resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("non-resource rules"))
+		})
+	})
+
+	Context("etcd datastore", func() {
+		It("should configure the API server to use etcd with the client certificates", func() {
+			installation.Spec.CertificateManagement = certificateManagement
+			Expect(cli.Create(ctx, installation)).To(BeNil())
+
+			apiServer := &operatorv1.APIServer{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, apiServer)).NotTo(HaveOccurred())
+			apiServer.Spec.EtcdDatastore = &operatorv1.APIServerEtcdDatastore{Endpoints: []string{"https://10.0.0.1:2379"}}
+			Expect(cli.Update(ctx, apiServer)).NotTo(HaveOccurred())
+			Expect(cli.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: render.APIServerEtcdTLSSecretName, Namespace: common.OperatorNamespace()},
+				Data: map[string][]byte{
+					"ca.crt":  []byte("ca"),
+					"tls.crt": []byte("cert"),
+					"tls.key": []byte("key"),
+				},
+			})).NotTo(HaveOccurred())
+
+			r := ReconcileAPIServer{
+				client:              cli,
+				scheme:              scheme,
+				status:              mockStatus,
+				tierWatchReady:      ready,
+				migrationWatchReady: &utils.ReadyFlag{},
+				opts: options.ControllerOptions{
+					EnterpriseCRDExists: true,
+					DetectedProvider:    operatorv1.ProviderNone,
+				},
+			}
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(test.GetResource(cli, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: render.APIServerEtcdTLSSecretName, Namespace: "calico-system"},
+			})).To(BeNil())
+
+			d := appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver", Namespace: "calico-system"},
+			}
+			Expect(test.GetResource(cli, &d)).To(BeNil())
+			apiserver := test.GetContainer(d.Spec.Template.Spec.Containers, "calico-apiserver")
+			Expect(apiserver).ToNot(BeNil())
+			Expect(apiserver.Env).To(ContainElements(
+				corev1.EnvVar{Name: "DATASTORE_TYPE", Value: "etcdv3"},
+				corev1.EnvVar{Name: "ETCD_ENDPOINTS", Value: "https://10.0.0.1:2379"},
+			))
+		})
+
+		It("should reject an etcd secret missing the CA", func() {
+			Expect(cli.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: render.APIServerEtcdTLSSecretName, Namespace: common.OperatorNamespace()},
+				Data: map[string][]byte{
+					"tls.crt": []byte("cert"),
+					"tls.key": []byte("key"),
+				},
+			})).NotTo(HaveOccurred())
+
+			_, err := getEtcdTLSSecret(ctx, cli)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ca.crt"))
+		})
+
+		It("should reject invalid etcd endpoints", func() {
+			instance := &operatorv1.APIServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				Spec: operatorv1.APIServerSpec{
+					EtcdDatastore: &operatorv1.APIServerEtcdDatastore{Endpoints: []string{"10.0.0.1:2379"}},
+				},
+			}
+			err := resources.ValidateAPIServer(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("EtcdDatastore"))
+		})
+	})
+
+	Context("Tenant API servers", func() {
+		const tenantNamespace = "tenant-a"
+		var r ReconcileAPIServer
+		var tenant *operatorv1.Tenant
+
+		BeforeEach(func() {
+			Expect(cli.Create(ctx, installation)).To(BeNil())
+			tenant = &operatorv1.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: tenantNamespace},
+				Spec: operatorv1.TenantSpec{
+					ID:                  "tenant-a",
+					APIServerDeployment: &operatorv1.APIServerDeployment{},
+				},
+			}
+			Expect(cli.Create(ctx, tenant)).NotTo(HaveOccurred())
+
+			certificateManager, err := certificatemanager.Create(cli, nil, "", tenantNamespace, certificatemanager.AllowCACreation(), certificatemanager.WithTenant(tenant))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cli.Create(ctx, certificateManager.KeyPair().Secret(tenantNamespace))).NotTo(HaveOccurred())
+			Expect(cli.Create(ctx, certificateManager.CreateTrustedBundle().ConfigMap(tenantNamespace))).NotTo(HaveOccurred())
+
+			r = ReconcileAPIServer{
+				client:              cli,
+				scheme:              scheme,
+				status:              mockStatus,
+				tierWatchReady:      ready,
+				migrationWatchReady: &utils.ReadyFlag{},
+				opts: options.ControllerOptions{
+					EnterpriseCRDExists: true,
+					DetectedProvider:    operatorv1.ProviderNone,
+					MultiTenant:         true,
+				},
+			}
+		})
+
+		It("should render the API server of a tenant in its namespace", func() {
+			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "default", Namespace: tenantNamespace}})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			d := appsv1.Deployment{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: render.APIServerName, Namespace: tenantNamespace}, &d)).NotTo(HaveOccurred())
+			Expect(d.Spec.Template.Spec.Containers).To(HaveLen(1))
+			Expect(d.Spec.Template.Spec.Containers[0].Name).To(Equal("tigera-queryserver"))
+			Expect(d.OwnerReferences).To(HaveLen(1))
+			Expect(d.OwnerReferences[0].Kind).To(Equal("Tenant"))
+
+			keyPair := corev1.Secret{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: render.TenantAPIServerTLSSecretName, Namespace: tenantNamespace}, &keyPair)).NotTo(HaveOccurred())
+
+			policy := v3.NetworkPolicy{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: render.TenantAPIServerPolicyName, Namespace: tenantNamespace}, &policy)).NotTo(HaveOccurred())
+
+			// The cluster API server is left to cluster-wide requests.
+			Expect(cli.Get(ctx, types.NamespacedName{Name: render.APIServerName, Namespace: render.APIServerNamespace}, &appsv1.Deployment{})).To(HaveOccurred())
+		})
+
+		It("should remove the API server of a tenant once it is no longer configured", func() {
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "default", Namespace: tenantNamespace}}
+			_, err := r.Reconcile(ctx, request)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tenant.Spec.APIServerDeployment = nil
+			Expect(cli.Update(ctx, tenant)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, request)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = cli.Get(ctx, types.NamespacedName{Name: render.APIServerName, Namespace: tenantNamespace}, &appsv1.Deployment{})
+			Expect(kerror.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should only bind the shared ClusterRole to the API servers of existing tenants", func() {
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: "default", Namespace: tenantNamespace}}
+			_, err := r.Reconcile(ctx, request)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			crb := rbacv1.ClusterRoleBinding{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: render.TenantAPIServerClusterRoleName}, &crb)).NotTo(HaveOccurred())
+			Expect(crb.Subjects).To(ConsistOf(rbacv1.Subject{Kind: "ServiceAccount", Name: render.APIServerServiceAccountName, Namespace: tenantNamespace}))
+
+			// The request for a deleted tenant is reconciled as a cluster-wide request, which removes the tenant from
+			// the binding.
+			Expect(cli.Delete(ctx, tenant)).NotTo(HaveOccurred())
+			_, _ = r.Reconcile(ctx, request)
+
+			err = cli.Get(ctx, types.NamespacedName{Name: render.TenantAPIServerClusterRoleName}, &rbacv1.ClusterRoleBinding{})
+			Expect(kerror.IsNotFound(err)).To(BeTrue())
+			err = cli.Get(ctx, types.NamespacedName{Name: render.TenantAPIServerClusterRoleName}, &rbacv1.ClusterRole{})
+			Expect(kerror.IsNotFound(err)).To(BeTrue())
+		})
+	})
 })
+
+// watchRecorder records the objects the controller watches once it is running.
+type watchRecorder struct {
+	ctrlruntime.Controller
+	objects []client.Object
+}
+
+func (w *watchRecorder) WatchObject(object client.Object, _ handler.EventHandler, _ ...predicate.Predicate) error {
+	w.objects = append(w.objects, object)
+	return nil
+}
+
+// forbiddenListClient rejects every List, as the API server does for resources the operator has no access to.
+type forbiddenListClient struct {
+	client.Client
+}
+
+func (c forbiddenListClient) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	return kerror.NewForbidden(flowcontrolv1.Resource("prioritylevelconfigurations"), "", fmt.Errorf("not permitted"))
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiserver
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/common/validation/resources"
+	"github.com/tigera/operator/pkg/controller/certificatemanager"
+	"github.com/tigera/operator/pkg/controller/k8sapi"
+	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/pkg/controller/utils/imageset"
+	"github.com/tigera/operator/pkg/dns"
+	"github.com/tigera/operator/pkg/render"
+	rcertificatemanagement "github.com/tigera/operator/pkg/render/certificatemanagement"
+	"github.com/tigera/operator/pkg/render/common/authentication"
+	"github.com/tigera/operator/pkg/render/common/networkpolicy"
+)
+
+// reconcileTenant reconciles the API server of the given tenant. Tenants that do not configure an API server
+// deployment have any previously rendered API server removed.
+func (r *ReconcileAPIServer) reconcileTenant(ctx context.Context, tenant *operatorv1.Tenant, reqLogger logr.Logger) (reconcile.Result, error) {
+	instance, msg, err := utils.GetAPIServer(ctx, r.client)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			reqLogger.V(1).Info("APIServer not found")
+			return reconcile.Result{}, nil
+		}
+		r.status.SetDegraded(operatorv1.ResourceReadError, "An error occurred when querying the APIServer resource: "+msg, err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	if common.ReconcilePaused(instance) {
+		reqLogger.Info("Reconciliation is paused", "annotation", common.ReconcileAnnotation)
+		return reconcile.Result{}, nil
+	}
+
+	_, installationSpec, err := utils.GetInstallationSpec(ctx, r.client)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			r.status.SetDegraded(operatorv1.ResourceNotFound, "Installation not found", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying installation", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	if !installationSpec.Variant.IsEnterprise() {
+		reqLogger.V(1).Info("Tenant API servers require the enterprise variant, skip")
+		return reconcile.Result{}, nil
+	}
+
+	if err := resources.ValidateTenant(tenant); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Tenant is invalid", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	if err = r.reconcileTenantAPIServerClusterRBAC(ctx, instance); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error reconciling the tenant API server ClusterRoleBinding", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	cfg := &render.TenantAPIServerConfiguration{
+		Tenant:        tenant,
+		Installation:  installationSpec,
+		APIServer:     &instance.Spec,
+		ClusterDomain: r.opts.ClusterDomain,
+		OpenShift:     r.opts.DetectedProvider.IsOpenShift(),
+	}
+
+	var components []render.Component
+	if tenant.APIServerEnabled() {
+		certificateManager, err := certificatemanager.Create(r.client, installationSpec, r.opts.ClusterDomain, tenant.Namespace,
+			certificatemanager.WithTenant(tenant), certificatemanager.WithLogger(reqLogger))
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceCreateError, "Unable to create the tenant CA", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+
+		cfg.TLSKeyPair, err = certificateManager.GetOrCreateKeyPair(r.client, render.TenantAPIServerTLSSecretName, tenant.Namespace,
+			dns.GetServiceDNSNames(render.QueryserverServiceName, tenant.Namespace, r.opts.ClusterDomain))
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceCreateError, "Unable to get or create tls key pair", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+
+		// The bundle is created in the tenant namespace by the tenant secrets controller.
+		cfg.TrustedBundle, err = certificateManager.LoadTrustedBundle(ctx, r.client, tenant.Namespace)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error getting trusted bundle", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+
+		cfg.PullSecrets, err = utils.GetInstallationPullSecrets(installationSpec, r.client)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving pull secrets", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+
+		cfg.KeyValidatorConfig, err = r.tenantKeyValidatorConfig(ctx)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Failed to get KeyValidator Config", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+
+		components = append(components, rcertificatemanagement.CertificateManagement(&rcertificatemanagement.Config{
+			Namespace:       tenant.Namespace,
+			TruthNamespace:  tenant.Namespace,
+			ServiceAccounts: []string{render.APIServerServiceAccountName},
+			KeyPairOptions: []rcertificatemanagement.KeyPairOption{
+				rcertificatemanagement.NewKeyPairOption(cfg.TLSKeyPair, true, true),
+			},
+		}))
+	}
+
+	if err = utils.PopulateK8sServiceEndPoint(r.client); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error reading services endpoint configmap", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	cfg.K8SServiceEndpoint = k8sapi.PodNetworkEndpoint
+
+	components = append(components, render.TenantAPIServer(cfg))
+
+	// Only render the policy once the calico-system tier exists, as for the cluster API server.
+	if r.opts.UseV3CRDs || r.tierWatchReady.IsReady() {
+		if err := r.client.Get(ctx, client.ObjectKey{Name: networkpolicy.CalicoTierName}, &v3.Tier{}); err != nil {
+			if !errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+				r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying calico-system tier", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+		} else {
+			components = append(components, render.TenantAPIServerPolicy(cfg))
+		}
+	}
+
+	if err = imageset.ApplyImageSet(ctx, r.client, installationSpec.Variant, components...); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error with images from ImageSet", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	handler := utils.NewComponentHandler(log, r.client, r.scheme, tenant)
+	for _, component := range components {
+		if err := handler.CreateOrUpdateOrDelete(ctx, component, nil); err != nil {
+			r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating resource", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// reconcileTenantAPIServerClusterRBAC binds the ClusterRole shared by the tenant API servers to the tenants that
+// currently run their own API server, so that deleted tenants are removed from the binding. The ClusterRole and its
+// binding are deleted once no tenant runs its own API server.
+func (r *ReconcileAPIServer) reconcileTenantAPIServerClusterRBAC(ctx context.Context, instance *operatorv1.APIServer) error {
+	bindingNamespaces, err := utils.TenantNamespaces(ctx, r.client, func(t *operatorv1.Tenant) bool { return t.APIServerEnabled() })
+	if err != nil {
+		return err
+	}
+	handler := utils.NewComponentHandler(log, r.client, r.scheme, instance)
+	return handler.CreateOrUpdateOrDelete(ctx, render.TenantAPIServerClusterRBAC(bindingNamespaces), nil)
+}
+
+// tenantKeyValidatorConfig returns the configuration tenant API servers use to validate user tokens, or nil if
+// authentication is not configured.
+func (r *ReconcileAPIServer) tenantKeyValidatorConfig(ctx context.Context) (authentication.KeyValidatorConfig, error) {
+	authenticationCR, err := utils.GetAuthentication(ctx, r.client)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if authenticationCR == nil || authenticationCR.Status.State != operatorv1.TigeraStatusReady {
+		return nil, nil
+	}
+	return utils.GetKeyValidatorConfig(ctx, r.client, authenticationCR, r.opts.ClusterDomain)
+}
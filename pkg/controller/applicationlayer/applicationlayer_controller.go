@@ -81,7 +81,7 @@ func newReconciler(mgr manager.Manager, opts options.ControllerOptions, licenseA
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
 		provider:        opts.DetectedProvider,
-		status:          status.New(mgr.GetClient(), "applicationlayer", opts.KubernetesVersion),
+		status:          status.New(mgr.GetClient(), "applicationlayer", opts.KubernetesVersion, opts.EventRecorder),
 		clusterDomain:   opts.ClusterDomain,
 		licenseAPIReady: licenseAPIReady,
 	}
@@ -186,7 +186,7 @@ func (r *ReconcileApplicationLayer) Reconcile(ctx context.Context, request recon
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying for Application Layer", err, reqLogger)
 		return reconcile.Result{}, err
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(instance)
 	// SetMetaData in the TigeraStatus such as observedGenerations.
 	defer r.status.SetMetaData(&instance.ObjectMeta)
 
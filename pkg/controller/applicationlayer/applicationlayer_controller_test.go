@@ -82,7 +82,7 @@ var _ = Describe("Application layer controller tests", func() {
 				},
 			}
 			mockStatus = &status.MockStatus{}
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 
 			r = ReconcileApplicationLayer{
 				client:          c,
@@ -111,7 +111,7 @@ func newReconciler(mgr manager.Manager, opts options.ControllerOptions, tierWatc
 		client:         mgr.GetClient(),
 		scheme:         mgr.GetScheme(),
 		provider:       opts.DetectedProvider,
-		status:         status.New(mgr.GetClient(), "authentication", opts.KubernetesVersion),
+		status:         status.New(mgr.GetClient(), "authentication", opts.KubernetesVersion, opts.EventRecorder),
 		clusterDomain:  opts.ClusterDomain,
 		tierWatchReady: tierWatchReady,
 		multiTenant:    opts.MultiTenant,
@@ -151,6 +151,13 @@ func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 		return fmt.Errorf("%s failed to watch ImageSet: %w", controllerName, err)
 	}
 
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("authentication-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("authentication-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("authentication-controller failed to watch authentication Tigerastatus: %w", err)
@@ -192,7 +199,7 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 		}
 		return reconcile.Result{}, err
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(authentication)
 
 	// SetMetaData in the TigeraStatus such as observedGenerations.
 	defer r.status.SetMetaData(&authentication.ObjectMeta)
@@ -84,7 +84,7 @@ var _ = Describe("authentication controller tests", func() {
 		mockStatus.On("AddStatefulSets", mock.Anything).Return()
 		mockStatus.On("AddCronJobs", mock.Anything)
 		mockStatus.On("IsAvailable").Return(true)
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("ClearDegraded")
 		mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 		mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -490,7 +490,7 @@ var _ = Describe("authentication controller tests", func() {
 			})).ToNot(HaveOccurred())
 
 			mockStatus = &status.MockStatus{}
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return().Maybe()
 			mockStatus.On("ClearWarning", mock.Anything).Return().Maybe()
 			r = &ReconcileAuthentication{
@@ -20,10 +20,13 @@ import (
 	"crypto/rand"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/go-logr/logr"
 	"github.com/openshift/library-go/pkg/crypto"
 	corev1 "k8s.io/api/core/v1"
@@ -34,8 +37,10 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/controller/status"
 	"github.com/tigera/operator/pkg/controller/utils/imageset"
+	"github.com/tigera/operator/pkg/dns"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 	"github.com/tigera/operator/pkg/tls"
 	"github.com/tigera/operator/pkg/tls/certificatemanagement"
@@ -46,8 +51,12 @@ const (
 	// OperatorCSRSignerName when this value is set as a signer on a CSR, the CSR controller will handle
 	// the request.
 	OperatorCSRSignerName = "tigera.io/operator-signer"
-	// GRACE_PERIOD is when we start rolling out a new certificate, during which the current cert is still valid (30d).
-	gracePeriod = 30 * 24 * time.Hour
+	// DefaultRenewBefore is when we start rolling out a new certificate, during which the current cert is still valid (30d).
+	// It can be overridden with Installation.Spec.CertificateRotation.RenewBefore.
+	DefaultRenewBefore = 30 * 24 * time.Hour
+	// ServiceDNSAliasesConfigMapName is the ConfigMap in the operator namespace that lists additional DNS names for the
+	// certificates of services, keyed by <service>.<namespace>. See dns.ParseServiceDNSAliases for the format.
+	ServiceDNSAliasesConfigMapName = "tigera-service-dns-aliases"
 )
 
 var log = logf.Log.WithName("tls")
@@ -76,6 +85,27 @@ type certificateManager struct {
 	// create new CAs. Most instances should simply read the existing CA and use it to sign
 	// certificates.
 	allowCACreation bool
+
+	// certificateDuration is the lifetime of newly issued key pairs and renewBefore is how long before
+	// expiry an operator issued key pair is replaced.
+	certificateDuration time.Duration
+	renewBefore         time.Duration
+
+	// certManager is set when key pairs are requested from cert-manager.
+	certManager *operatorv1.CertManager
+
+	// serviceDNSAliases are added to the DNS names of the key pairs of the services they are listed for.
+	serviceDNSAliases dns.ServiceDNSAliases
+	// invalidServiceDNSAliases holds the reason the aliases of a service are not valid, so that only the key pairs of
+	// that service fail.
+	invalidServiceDNSAliases map[string]error
+
+	// userTrustedCertificates are loaded from the ConfigMaps in the operator namespace labeled with
+	// certificatemanagement.TrustedBundleAddLabel, and are added to the trusted bundles.
+	userTrustedCertificates []certificatemanagement.CertificateInterface
+	// invalidUserTrustedCertificates describes the labeled ConfigMaps that do not hold certificates. They are left
+	// out of the trusted bundles, and only fail the controllers that create one.
+	invalidUserTrustedCertificates error
 }
 
 // CertificateManager can sign new certificates and has methods to retrieve existing KeyPairs and Certificates. If a user
@@ -87,6 +117,10 @@ type CertificateManager interface {
 	GetKeyPair(cli client.Client, secretName, secretNamespace string, dnsNames []string) (certificatemanagement.KeyPairInterface, error)
 	// GetOrCreateKeyPair returns a KeyPair. If one exists, some checks are performed. Otherwise, a new KeyPair is created.
 	GetOrCreateKeyPair(cli client.Client, secretName, secretNamespace string, dnsNames []string) (certificatemanagement.KeyPairInterface, error)
+	// GetOrRequestKeyPair returns a KeyPair that is requested from cert-manager if Installation.CertManager is configured.
+	// Until cert-manager has issued the key pair, an operator signed key pair is returned. If cert-manager is not
+	// configured, it behaves like GetOrCreateKeyPair.
+	GetOrRequestKeyPair(cli client.Client, secretName, secretNamespace string, dnsNames []string) (certificatemanagement.KeyPairInterface, error)
 	// CreateCSRKeyPair returns a KeyPair that relies on issuing Certificate Signing Requests to the kubernetes api to be
 	// signed by OperatorCSRSignerName. This means that pkg/controller/csr/csr_controller.go will end up signing the CSR
 	// using the private key of the certificate manager.
@@ -160,7 +194,7 @@ func Create(cli client.Client, installation *operatorv1.InstallationSpec, cluste
 
 	// Create a certificatemanager instance and apply any user-provided options to
 	// initialize it.
-	cm := &certificateManager{log: log}
+	cm := &certificateManager{log: log, certificateDuration: tls.DefaultCertificateDuration, renewBefore: DefaultRenewBefore}
 	for _, opt := range opts {
 		if err := opt(cm); err != nil {
 			return nil, err
@@ -168,6 +202,24 @@ func Create(cli client.Client, installation *operatorv1.InstallationSpec, cluste
 	}
 	cm.log.V(2).Info("Creating CertificateManager in namespace", "ns", ns)
 
+	// Load the user supplied DNS aliases of services, if any.
+	aliases := &corev1.ConfigMap{}
+	if err := cli.Get(context.Background(), types.NamespacedName{Name: ServiceDNSAliasesConfigMapName, Namespace: common.OperatorNamespace()}, aliases); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return nil, err
+		}
+	} else {
+		cm.serviceDNSAliases, cm.invalidServiceDNSAliases = dns.ParseServiceDNSAliases(aliases.Data)
+		for service, err := range cm.invalidServiceDNSAliases {
+			cm.log.Error(err, "Ignoring the DNS aliases of a service", "service", service, "configMap", ServiceDNSAliasesConfigMapName)
+		}
+	}
+
+	// Load the user supplied certificates to trust, if any.
+	if cm.userTrustedCertificates, cm.invalidUserTrustedCertificates, err = loadUserTrustedCertificates(cli); err != nil {
+		return nil, err
+	}
+
 	// Determine the name of the CA secret to use. Default to the tigera CA name. For
 	// per-tenant CA secrets, we use a different name for differentiation.
 	caSecretName := certificatemanagement.CASecretName
@@ -187,6 +239,17 @@ func Create(cli client.Client, installation *operatorv1.InstallationSpec, cluste
 			return nil, err
 		}
 
+		if rotation := installation.CertificateRotation; rotation != nil {
+			if rotation.CertificateDuration != nil {
+				cm.certificateDuration = rotation.CertificateDuration.Duration
+			}
+			if rotation.RenewBefore != nil {
+				cm.renewBefore = rotation.RenewBefore.Duration
+			}
+		}
+
+		cm.certManager = installation.CertManager
+
 		if installation.CertificateManagement != nil {
 			// Configured to use certificate management. Get the CACert from
 			// the installation spec.
@@ -305,6 +368,10 @@ func (cm *certificateManager) CreateCSRKeyPair(secretName, namespace string, dns
 
 // GetOrCreateKeyPair returns a KeyPair. If one exists, some checks are performed. Otherwise, a new KeyPair is created.
 func (cm *certificateManager) GetOrCreateKeyPair(cli client.Client, secretName, secretNamespace string, dnsNames []string) (certificatemanagement.KeyPairInterface, error) {
+	dnsNames, err := cm.withServiceDNSAliases(dnsNames)
+	if err != nil {
+		return nil, err
+	}
 	keyPair, x509Cert, err := cm.getKeyPair(cli, secretName, secretNamespace, false, dnsNames)
 	if keyPair != nil && keyPair.UseCertificateManagement() {
 		return certificateManagementKeyPair(cm, secretName, secretNamespace, dnsNames), nil
@@ -318,13 +385,16 @@ func (cm *certificateManager) GetOrCreateKeyPair(cli client.Client, secretName,
 		} else if keyPair.BYO() {
 			cm.log.V(3).Info("secret %s has invalid DNS names, the expected names are: %v", secretName, dnsNames)
 			return keyPair, nil
+		} else if keyPair.UseCertManager() {
+			// cert-manager reissues the key pair once the DNS names of its Certificate are updated.
+			return keyPair, nil
 		}
 	} else {
 		cm.log.V(1).Info("Keypair wasn't found, create a new one", "namespace", secretNamespace, "name", secretName)
 	}
 
 	// If we reach here, it means we need to create a new KeyPair.
-	tlsCfg, err := cm.MakeServerCertForDuration(sets.New[string](dnsNames...), tls.DefaultCertificateDuration, tls.SetServerAuth, tls.SetClientAuth)
+	tlsCfg, err := cm.MakeServerCertForDuration(sets.New[string](dnsNames...), cm.certificateDuration, tls.SetServerAuth, tls.SetClientAuth)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create signed cert pair: %s", err)
 	}
@@ -343,6 +413,34 @@ func (cm *certificateManager) GetOrCreateKeyPair(cli client.Client, secretName,
 	}, nil
 }
 
+// GetOrRequestKeyPair returns a KeyPair that is requested from cert-manager if Installation.CertManager is configured.
+// Until cert-manager has issued the key pair, an operator signed key pair is returned, so that components that
+// cert-manager itself depends on, such as typha, do not have to wait for it.
+func (cm *certificateManager) GetOrRequestKeyPair(cli client.Client, secretName, secretNamespace string, dnsNames []string) (certificatemanagement.KeyPairInterface, error) {
+	keyPairInterface, err := cm.GetOrCreateKeyPair(cli, secretName, secretNamespace, dnsNames)
+	if err != nil || cm.certManager == nil {
+		return keyPairInterface, err
+	}
+	keyPair, ok := keyPairInterface.(*certificatemanagement.KeyPair)
+	if !ok || keyPair.UseCertificateManagement() {
+		return keyPairInterface, nil
+	}
+	keyPair.CertManager = cm.certManager
+	keyPair.DNSNames = cm.serviceDNSAliases.WithAliases(dnsNames)
+	return keyPair, nil
+}
+
+// withServiceDNSAliases returns the DNS names extended with the aliases of their service. It returns an error if the
+// aliases listed for the service are not valid, rather than issuing a certificate without them.
+func (cm *certificateManager) withServiceDNSAliases(dnsNames []string) ([]string, error) {
+	for _, name := range dnsNames {
+		if err, ok := cm.invalidServiceDNSAliases[name]; ok {
+			return nil, fmt.Errorf("ConfigMap %s/%s is not valid: %w", common.OperatorNamespace(), ServiceDNSAliasesConfigMapName, err)
+		}
+	}
+	return cm.serviceDNSAliases.WithAliases(dnsNames), nil
+}
+
 // SignCertificate signs a certificate using the certificate manager's private key. The function is assuming that the
 // public key of the requestor is already set in the certificate template.
 func (cm *certificateManager) SignCertificate(certificateTemplate *x509.Certificate) ([]byte, error) {
@@ -467,10 +565,10 @@ func (cm *certificateManager) getKeyPair(cli client.Client, secretName, secretNa
 		return nil, nil, newCertExtKeyUsageError(secretName, secretNamespace, requiredKeyUsages)
 	}
 
-	if !readCertOnly && x509Cert.NotAfter.Before(time.Now().Add(gracePeriod)) {
-		// The certificate is not valid one month from now. Let's start the rotation process, so there will be plenty of time
-		// to roll out the changes without disruption. All components that need to trust this certificate are already
-		// trusting the issuer, so there will be no disruption.
+	if !readCertOnly && x509Cert.NotAfter.Before(time.Now().Add(cm.renewBefore)) {
+		// The certificate is about to enter its renewal window (one month by default). Let's start the rotation process, so there
+		// will be plenty of time to roll out the changes without disruption. All components that need to trust this certificate
+		// are already trusting the issuer, so there will be no disruption.
 		if !strings.HasPrefix(x509Cert.Issuer.CommonName, rmeta.TigeraOperatorCAIssuerPrefix) {
 			cm.log.V(2).Info("Warning: this certificate will soon expire and is not managed by the operator, user action required!", "name", secretName)
 		} else {
@@ -491,6 +589,11 @@ func (cm *certificateManager) getKeyPair(cli client.Client, secretName, secretNa
 			return certificateManagementKeyPair(cm, secretName, secretNamespace, dnsNames), nil, nil
 		}
 		if string(x509Cert.AuthorityKeyId) == string(cm.AuthorityKeyId) {
+			if !readCertOnly && x509Cert.NotAfter.After(time.Now().Add(cm.certificateDuration)) {
+				// The certificate outlives the configured certificate duration, which happens when the duration is lowered.
+				cm.log.Info("KeyPair is valid for longer than the certificate duration, will create a new one", "name", secretName)
+				return nil, nil, nil
+			}
 			issuer = cm.keyPair
 		} else {
 			if !readCertOnly {
@@ -503,14 +606,19 @@ func (cm *certificateManager) getKeyPair(cli client.Client, secretName, secretNa
 			issuer = nil
 		}
 	}
-	return &certificatemanagement.KeyPair{
+	keyPair := &certificatemanagement.KeyPair{
 		Issuer:         issuer,
 		Name:           secretName,
 		Namespace:      secretNamespace,
 		PrivateKeyPEM:  keyPEM,
 		CertificatePEM: certPEM,
 		OriginalSecret: secret,
-	}, x509Cert, nil
+	}
+	if issuer == nil && cm.certManager != nil && secret.Annotations[certmanagerv1.CertificateNameKey] != "" {
+		// The secret was issued by cert-manager, rather than provided by the user.
+		keyPair.CertManager = cm.certManager
+	}
+	return keyPair, x509Cert, nil
 }
 
 // HasRequiredKeyUsage returns true if the given certificate is valid
@@ -540,6 +648,10 @@ func (cm *certificateManager) GetCertificate(cli client.Client, secretName, secr
 
 // GetKeyPair returns an existing KeyPair. If the KeyPair is not found, nil is returned.
 func (cm *certificateManager) GetKeyPair(cli client.Client, secretName, secretNamespace string, dnsNames []string) (certificatemanagement.KeyPairInterface, error) {
+	dnsNames, err := cm.withServiceDNSAliases(dnsNames)
+	if err != nil {
+		return nil, err
+	}
 	keyPair, _, err := cm.getKeyPair(cli, secretName, secretNamespace, false, dnsNames)
 	return keyPair, err
 }
@@ -596,7 +708,10 @@ func (cm *certificateManager) CreateNamedTrustedBundleFromSecrets(prefix string,
 // It will include:
 // - A bundle with Calico's root certificates + any user supplied certificates in /etc/pki/tls/certs/tigera-ca-bundle.crt.
 func (cm *certificateManager) CreateTrustedBundle(certificates ...certificatemanagement.CertificateInterface) certificatemanagement.TrustedBundle {
-	return certificatemanagement.CreateTrustedBundle(cm.keyPair, certificates...)
+	if cm.invalidUserTrustedCertificates != nil {
+		cm.log.Error(cm.invalidUserTrustedCertificates, "Leaving invalid certificates out of the trusted bundle")
+	}
+	return certificatemanagement.CreateTrustedBundle(cm.keyPair, cm.withUserTrustedCertificates(certificates)...)
 }
 
 // CreateTrustedBundleWithSystemRootCertificates creates a TrustedBundle, which provides standardized methods for mounting a bundle of certificates to trust.
@@ -604,11 +719,83 @@ func (cm *certificateManager) CreateTrustedBundle(certificates ...certificateman
 // - A bundle with Calico's root certificates + any user supplied certificates in /etc/pki/tls/certs/tigera-ca-bundle.crt.
 // - A system root certificate bundle in /etc/pki/tls/certs/ca-bundle.crt.
 func (cm *certificateManager) CreateTrustedBundleWithSystemRootCertificates(certificates ...certificatemanagement.CertificateInterface) (certificatemanagement.TrustedBundle, error) {
-	return certificatemanagement.CreateTrustedBundleWithSystemRootCertificates(cm.keyPair, certificates...)
+	if cm.invalidUserTrustedCertificates != nil {
+		return nil, cm.invalidUserTrustedCertificates
+	}
+	return certificatemanagement.CreateTrustedBundleWithSystemRootCertificates(cm.keyPair, cm.withUserTrustedCertificates(certificates)...)
 }
 
 func (cm *certificateManager) CreateMultiTenantTrustedBundleWithSystemRootCertificates(certificates ...certificatemanagement.CertificateInterface) (certificatemanagement.TrustedBundle, error) {
-	return certificatemanagement.CreateMultiTenantTrustedBundleWithSystemRootCertificates(cm.keyPair, certificates...)
+	if cm.invalidUserTrustedCertificates != nil {
+		return nil, cm.invalidUserTrustedCertificates
+	}
+	return certificatemanagement.CreateMultiTenantTrustedBundleWithSystemRootCertificates(cm.keyPair, cm.withUserTrustedCertificates(certificates)...)
+}
+
+// withUserTrustedCertificates returns the given certificates followed by the user supplied certificates to trust.
+func (cm *certificateManager) withUserTrustedCertificates(certificates []certificatemanagement.CertificateInterface) []certificatemanagement.CertificateInterface {
+	return append(append([]certificatemanagement.CertificateInterface{}, certificates...), cm.userTrustedCertificates...)
+}
+
+// loadUserTrustedCertificates returns a certificate for each ConfigMap in the operator namespace that is labeled with
+// certificatemanagement.TrustedBundleAddLabel=true, holding the PEM encoded certificates of all of its keys. The
+// ConfigMaps that do not hold certificates are skipped, and described by the returned invalid error.
+func loadUserTrustedCertificates(cli client.Client) (certificates []certificatemanagement.CertificateInterface, invalid error, err error) {
+	configMaps := &corev1.ConfigMapList{}
+	if err := cli.List(context.Background(), configMaps,
+		client.InNamespace(common.OperatorNamespace()),
+		client.MatchingLabels{certificatemanagement.TrustedBundleAddLabel: "true"},
+	); err != nil {
+		return nil, nil, fmt.Errorf("failed to list the ConfigMaps of certificates to trust: %w", err)
+	}
+
+	var invalidConfigMaps []error
+configMaps:
+	for _, configMap := range configMaps.Items {
+		keys := make([]string, 0, len(configMap.Data))
+		for key := range configMap.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var pemBuf bytes.Buffer
+		for _, key := range keys {
+			data := []byte(configMap.Data[key])
+			if err := validateCertificatesPEM(data); err != nil {
+				invalidConfigMaps = append(invalidConfigMaps, fmt.Errorf("ConfigMap %s/%s key %s is not valid: %w", configMap.Namespace, configMap.Name, key, err))
+				continue configMaps
+			}
+			pemBuf.Write(bytes.TrimSpace(data))
+			pemBuf.WriteString("\n")
+		}
+		if pemBuf.Len() > 0 {
+			certificates = append(certificates, certificatemanagement.NewCertificate(configMap.Name, configMap.Namespace, pemBuf.Bytes(), nil))
+		}
+	}
+	return certificates, errors.Join(invalidConfigMaps...), nil
+}
+
+// validateCertificatesPEM returns an error unless data holds one or more PEM encoded certificates and nothing else.
+func validateCertificatesPEM(data []byte) error {
+	var found bool
+	for rest := bytes.TrimSpace(data); len(rest) > 0; rest = bytes.TrimSpace(rest) {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return fmt.Errorf("it does not hold PEM encoded data")
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("it holds a PEM block of type %s rather than a certificate", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("failed to parse certificate: %w", err)
+		}
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("it does not hold any certificates")
+	}
+	return nil
 }
 
 func (cm *certificateManager) LoadNamedTrustedBundle(ctx context.Context, client client.Client, ns, name string) (certificatemanagement.TrustedBundleRO, error) {
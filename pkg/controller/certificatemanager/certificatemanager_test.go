@@ -30,6 +30,8 @@ import (
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/openshift/library-go/pkg/crypto"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
@@ -39,6 +41,7 @@ import (
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
 	"github.com/tigera/operator/pkg/controller/utils/imageset"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/dns"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 	"github.com/tigera/operator/pkg/render/common/secret"
 	"github.com/tigera/operator/pkg/tls"
@@ -393,6 +396,195 @@ var _ = Describe("Test CertificateManagement suite", func() {
 				Expect(certificate.NotAfter).NotTo(Equal(fetchedCertificate.NotAfter))
 			})
 		})
+
+		Describe("test certificate rotation configuration", func() {
+			BeforeEach(func() {
+				Expect(cli.Create(ctx, certificateManager.KeyPair().Secret(common.OperatorNamespace()))).NotTo(HaveOccurred())
+			})
+
+			createWithRotation := func(rotation *operatorv1.CertificateRotation) certificatemanager.CertificateManager {
+				cm, err := certificatemanager.Create(cli, &operatorv1.InstallationSpec{CertificateRotation: rotation}, clusterDomain, common.OperatorNamespace())
+				Expect(err).NotTo(HaveOccurred())
+				return cm
+			}
+
+			It("should issue key pairs with the configured certificate duration", func() {
+				cm := createWithRotation(&operatorv1.CertificateRotation{
+					CertificateDuration: &metav1.Duration{Duration: 48 * time.Hour},
+					RenewBefore:         &metav1.Duration{Duration: time.Hour},
+				})
+				keyPair, err := cm.GetOrCreateKeyPair(cli, appSecretName, appNs, appDNSNames)
+				Expect(err).NotTo(HaveOccurred())
+				cert, err := certificatemanagement.ParseCertificate(keyPair.GetCertificatePEM())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.NotAfter).To(BeTemporally("~", time.Now().Add(48*time.Hour), time.Minute))
+
+				By("fetching the key pair again, it is not rotated yet")
+				Expect(cli.Create(ctx, keyPair.Secret(appNs))).NotTo(HaveOccurred())
+				keyPair2, err := cm.GetOrCreateKeyPair(cli, appSecretName, appNs, appDNSNames)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyPair2.HashAnnotationValue()).To(Equal(keyPair.HashAnnotationValue()))
+			})
+
+			It("should rotate key pairs that are within the configured renewal window", func() {
+				cm := createWithRotation(&operatorv1.CertificateRotation{CertificateDuration: &metav1.Duration{Duration: 48 * time.Hour}})
+				keyPair, err := cm.GetOrCreateKeyPair(cli, appSecretName, appNs, appDNSNames)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cli.Create(ctx, keyPair.Secret(appNs))).NotTo(HaveOccurred())
+
+				cm = createWithRotation(&operatorv1.CertificateRotation{
+					CertificateDuration: &metav1.Duration{Duration: 48 * time.Hour},
+					RenewBefore:         &metav1.Duration{Duration: 72 * time.Hour},
+				})
+				keyPair2, err := cm.GetOrCreateKeyPair(cli, appSecretName, appNs, appDNSNames)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyPair2.HashAnnotationValue()).NotTo(Equal(keyPair.HashAnnotationValue()))
+			})
+
+			It("should rotate key pairs that outlive a lowered certificate duration", func() {
+				keyPair, err := createWithRotation(nil).GetOrCreateKeyPair(cli, appSecretName, appNs, appDNSNames)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cli.Create(ctx, keyPair.Secret(appNs))).NotTo(HaveOccurred())
+
+				cm := createWithRotation(&operatorv1.CertificateRotation{CertificateDuration: &metav1.Duration{Duration: 90 * 24 * time.Hour}})
+				keyPair2, err := cm.GetOrCreateKeyPair(cli, appSecretName, appNs, appDNSNames)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyPair2.HashAnnotationValue()).NotTo(Equal(keyPair.HashAnnotationValue()))
+				cert, err := certificatemanagement.ParseCertificate(keyPair2.GetCertificatePEM())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.NotAfter).To(BeTemporally("~", time.Now().Add(90*24*time.Hour), time.Minute))
+			})
+		})
+
+		Describe("test service DNS aliases", func() {
+			var serviceDNSNames []string
+
+			BeforeEach(func() {
+				Expect(cli.Create(ctx, certificateManager.KeyPair().Secret(common.OperatorNamespace()))).NotTo(HaveOccurred())
+				serviceDNSNames = dns.GetServiceDNSNames("calico-api", "calico-system", clusterDomain)
+			})
+
+			createAliases := func(data map[string]string) {
+				Expect(cli.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: certificatemanager.ServiceDNSAliasesConfigMapName, Namespace: common.OperatorNamespace()},
+					Data:       data,
+				})).NotTo(HaveOccurred())
+			}
+
+			It("should add the aliases of a service to its key pair", func() {
+				createAliases(map[string]string{"calico-api.calico-system": "calico-api.external.example.com, *.api.example.com"})
+				cm, err := certificatemanager.Create(cli, installation, clusterDomain, common.OperatorNamespace())
+				Expect(err).NotTo(HaveOccurred())
+
+				keyPair, err := cm.GetOrCreateKeyPair(cli, appSecretName, appNs, serviceDNSNames)
+				Expect(err).NotTo(HaveOccurred())
+				cert, err := certificatemanagement.ParseCertificate(keyPair.GetCertificatePEM())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.DNSNames).To(ContainElements("calico-api.calico-system.svc", "calico-api.external.example.com", "*.api.example.com"))
+
+				By("leaving the key pairs of other services alone")
+				keyPair, err = cm.GetOrCreateKeyPair(cli, "other", appNs, dns.GetServiceDNSNames("other", "calico-system", clusterDomain))
+				Expect(err).NotTo(HaveOccurred())
+				cert, err = certificatemanagement.ParseCertificate(keyPair.GetCertificatePEM())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.DNSNames).NotTo(ContainElement("calico-api.external.example.com"))
+			})
+
+			It("should reissue a key pair when an alias is added", func() {
+				keyPair, err := certificateManager.GetOrCreateKeyPair(cli, appSecretName, appNs, serviceDNSNames)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cli.Create(ctx, keyPair.Secret(appNs))).NotTo(HaveOccurred())
+
+				createAliases(map[string]string{"calico-api.calico-system": "calico-api.external.example.com"})
+				cm, err := certificatemanager.Create(cli, installation, clusterDomain, common.OperatorNamespace())
+				Expect(err).NotTo(HaveOccurred())
+				keyPair2, err := cm.GetOrCreateKeyPair(cli, appSecretName, appNs, serviceDNSNames)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyPair2.HashAnnotationValue()).NotTo(Equal(keyPair.HashAnnotationValue()))
+			})
+
+			It("should only fail the key pairs of services with invalid aliases", func() {
+				createAliases(map[string]string{"calico-api.calico-system": "not a dns name!"})
+				cm, err := certificatemanager.Create(cli, installation, clusterDomain, common.OperatorNamespace())
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = cm.GetOrCreateKeyPair(cli, appSecretName, appNs, serviceDNSNames)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(certificatemanager.ServiceDNSAliasesConfigMapName))
+				_, err = cm.GetKeyPair(cli, appSecretName, appNs, serviceDNSNames)
+				Expect(err).To(HaveOccurred())
+
+				By("issuing the key pairs of other services")
+				_, err = cm.GetOrCreateKeyPair(cli, "other", appNs, dns.GetServiceDNSNames("other", "calico-system", clusterDomain))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Describe("test cert-manager key pairs", func() {
+			var certManager *operatorv1.CertManager
+
+			BeforeEach(func() {
+				Expect(cli.Create(ctx, certificateManager.KeyPair().Secret(common.OperatorNamespace()))).NotTo(HaveOccurred())
+				certManager = &operatorv1.CertManager{IssuerRef: operatorv1.CertManagerIssuerReference{Name: "my-issuer", Kind: "ClusterIssuer"}}
+			})
+
+			issuedSecret := func() *corev1.Secret {
+				s := byoSecret.DeepCopy()
+				s.Annotations = map[string]string{certmanagerv1.CertificateNameKey: appSecretName}
+				return s
+			}
+
+			It("should use an operator signed key pair until cert-manager has issued one", func() {
+				cm, err := certificatemanager.Create(cli, &operatorv1.InstallationSpec{CertManager: certManager}, clusterDomain, common.OperatorNamespace())
+				Expect(err).NotTo(HaveOccurred())
+				keyPair, err := cm.GetOrRequestKeyPair(cli, appSecretName, appNs, append(appDNSNames, "10.0.0.1"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyPair.UseCertManager()).To(BeTrue())
+				Expect(keyPair.BYO()).To(BeFalse())
+				Expect(keyPair.GetIssuer().GetCertificatePEM()).To(Equal(cm.KeyPair().GetCertificatePEM()))
+
+				certificate := keyPair.Certificate(common.OperatorNamespace())
+				Expect(certificate.Namespace).To(Equal(common.OperatorNamespace()))
+				Expect(certificate.Spec.SecretName).To(Equal(appSecretName))
+				Expect(certificate.Spec.CommonName).To(Equal(appSecretName))
+				Expect(certificate.Spec.DNSNames).To(ConsistOf(appSecretName))
+				Expect(certificate.Spec.IPAddresses).To(ConsistOf("10.0.0.1"))
+				Expect(certificate.Spec.Usages).To(ContainElements(certmanagerv1.UsageServerAuth, certmanagerv1.UsageClientAuth))
+				Expect(certificate.Spec.IssuerRef).To(Equal(cmmeta.IssuerReference{Name: "my-issuer", Kind: "ClusterIssuer", Group: "cert-manager.io"}))
+			})
+
+			It("should use the key pair that cert-manager has issued", func() {
+				Expect(cli.Create(ctx, issuedSecret())).NotTo(HaveOccurred())
+				cm, err := certificatemanager.Create(cli, &operatorv1.InstallationSpec{CertManager: certManager}, clusterDomain, common.OperatorNamespace())
+				Expect(err).NotTo(HaveOccurred())
+				keyPair, err := cm.GetOrRequestKeyPair(cli, appSecretName, appNs, appDNSNames)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyPair.UseCertManager()).To(BeTrue())
+				Expect(keyPair.BYO()).To(BeFalse())
+				Expect(keyPair.GetIssuer()).To(BeNil())
+				_, certPEM := certificatemanagement.GetKeyCertPEM(byoSecret)
+				Expect(keyPair.GetCertificatePEM()).To(Equal(certPEM))
+
+				By("keeping the issued key pair while cert-manager reissues it for new DNS names")
+				keyPair, err = cm.GetOrRequestKeyPair(cli, appSecretName, appNs, []string{"new-name"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyPair.GetCertificatePEM()).To(Equal(certPEM))
+				Expect(keyPair.Certificate(common.OperatorNamespace()).Spec.DNSNames).To(ConsistOf("new-name"))
+
+				By("fetching the key pair as a cert-manager key pair")
+				keyPair, err = cm.GetKeyPair(cli, appSecretName, appNs, appDNSNames)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyPair.UseCertManager()).To(BeTrue())
+			})
+
+			It("should treat the issued key pair as user provided when cert-manager is not configured", func() {
+				Expect(cli.Create(ctx, issuedSecret())).NotTo(HaveOccurred())
+				keyPair, err := certificateManager.GetOrRequestKeyPair(cli, appSecretName, appNs, appDNSNames)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keyPair.UseCertManager()).To(BeFalse())
+				Expect(keyPair.BYO()).To(BeTrue())
+			})
+		})
 	})
 
 	Describe("test KeyPair interface", func() {
@@ -820,6 +1012,74 @@ var _ = Describe("Test CertificateManagement suite", func() {
 				Expect(trustedBundle.HashAnnotations()).To(HaveKey("tigera-operator.hash.operator.tigera.io/byo-secret"))
 			})
 		})
+
+		Describe("test user labeled ConfigMaps", func() {
+			var userCA []byte
+
+			BeforeEach(func() {
+				userCA = byoSecret.Data["cert.crt"]
+			})
+
+			newConfigMap := func(name, labelValue string, data map[string]string) *corev1.ConfigMap {
+				configMap := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.OperatorNamespace()},
+					Data:       data,
+				}
+				if labelValue != "" {
+					configMap.Labels = map[string]string{certificatemanagement.TrustedBundleAddLabel: labelValue}
+				}
+				return configMap
+			}
+
+			It("should add the certificates of labeled ConfigMaps to the trusted bundles", func() {
+				Expect(cli.Create(ctx, newConfigMap("user-ca", "true", map[string]string{"ca.pem": string(userCA)}))).NotTo(HaveOccurred())
+
+				certificateManager, err := certificatemanager.Create(cli, installation, clusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+				Expect(err).NotTo(HaveOccurred())
+
+				trustedBundle := certificateManager.CreateTrustedBundle()
+				bundle := trustedBundle.ConfigMap(appNs).Data[certificatemanagement.TrustedCertConfigMapKeyName]
+				Expect(bundle).To(ContainSubstring(strings.TrimSpace(string(userCA))))
+				Expect(strings.Count(bundle, "certificate name:")).To(Equal(2))
+				Expect(trustedBundle.HashAnnotations()).To(HaveKey("tigera-operator.hash.operator.tigera.io/user-ca"))
+
+				if runtime.GOOS == "linux" {
+					trustedBundle, err = certificateManager.CreateTrustedBundleWithSystemRootCertificates()
+					Expect(err).NotTo(HaveOccurred())
+					bundle = trustedBundle.ConfigMap(appNs).Data[certificatemanagement.TrustedCertConfigMapKeyName]
+					Expect(bundle).To(ContainSubstring(strings.TrimSpace(string(userCA))))
+				}
+			})
+
+			It("should ignore ConfigMaps that are not labeled to be added", func() {
+				Expect(cli.Create(ctx, newConfigMap("unlabeled", "", map[string]string{"ca.pem": string(userCA)}))).NotTo(HaveOccurred())
+				Expect(cli.Create(ctx, newConfigMap("disabled", "false", map[string]string{"ca.pem": string(userCA)}))).NotTo(HaveOccurred())
+
+				certificateManager, err := certificatemanager.Create(cli, installation, clusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+				Expect(err).NotTo(HaveOccurred())
+
+				bundle := certificateManager.CreateTrustedBundle().ConfigMap(appNs).Data[certificatemanagement.TrustedCertConfigMapKeyName]
+				Expect(bundle).NotTo(ContainSubstring(strings.TrimSpace(string(userCA))))
+				Expect(strings.Count(bundle, "certificate name:")).To(Equal(1))
+			})
+
+			It("should leave out labeled ConfigMaps that do not hold certificates", func() {
+				Expect(cli.Create(ctx, newConfigMap("user-ca", "true", map[string]string{"ca.pem": string(userCA)}))).NotTo(HaveOccurred())
+				Expect(cli.Create(ctx, newConfigMap("invalid-ca", "true", map[string]string{"ca.pem": "not a certificate"}))).NotTo(HaveOccurred())
+
+				// Only the controllers that create a trusted bundle are affected by the invalid ConfigMap.
+				certificateManager, err := certificatemanager.Create(cli, installation, clusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+				Expect(err).NotTo(HaveOccurred())
+
+				trustedBundle := certificateManager.CreateTrustedBundle()
+				Expect(trustedBundle.HashAnnotations()).To(HaveKey("tigera-operator.hash.operator.tigera.io/user-ca"))
+				Expect(trustedBundle.HashAnnotations()).NotTo(HaveKey("tigera-operator.hash.operator.tigera.io/invalid-ca"))
+
+				_, err = certificateManager.CreateTrustedBundleWithSystemRootCertificates()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid-ca"))
+			})
+		})
 	})
 })
 
@@ -67,7 +67,7 @@ var log = logf.Log.WithName(controllerName)
 // Add creates a new ManagementClusterConnection Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and start it when the Manager is started. This controller is meant only for enterprise users.
 func Add(mgr manager.Manager, opts options.ControllerOptions) error {
-	statusManager := status.New(mgr.GetClient(), "management-cluster-connection", opts.KubernetesVersion)
+	statusManager := status.New(mgr.GetClient(), "management-cluster-connection", opts.KubernetesVersion, opts.EventRecorder)
 
 	// Create the reconciler
 	tierWatchReady := &utils.ReadyFlag{}
@@ -126,6 +126,13 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		return fmt.Errorf("%s failed to watch Guardian deployment: %w", controllerName, err)
 	}
 
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("clusterconnection-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("clusterconnection-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("clusterconnection-controller failed to watch management-cluster-connection Tigerastatus: %w", err)
@@ -226,7 +233,7 @@ func (r *ReconcileConnection) Reconcile(ctx context.Context, request reconcile.R
 			return reconcile.Result{}, err
 		}
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(managementClusterConnection)
 	// SetMetaData in the TigeraStatus such as observedGenerations.
 	defer r.status.SetMetaData(&managementClusterConnection.ObjectMeta)
 
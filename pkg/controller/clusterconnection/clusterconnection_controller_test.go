@@ -90,7 +90,7 @@ var _ = Describe("ManagementClusterConnection controller tests", func() {
 		mockStatus.On("AddCronJobs", mock.Anything)
 		mockStatus.On("ClearDegraded", mock.Anything)
 		mockStatus.On("SetDegraded", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("ReadyToMonitor")
 		mockStatus.On("SetMetaData", mock.Anything).Return()
 		mockStatus.On("OnCRNotFound").Return()
@@ -327,7 +327,7 @@ var _ = Describe("ManagementClusterConnection controller tests", func() {
 			It("should degrade and wait when tier is ready, but tier watch is not ready", func() {
 				mockStatus = &status.MockStatus{}
 				mockStatus.On("Run").Return()
-				mockStatus.On("OnCRFound").Return()
+				mockStatus.On("OnCRFound", mock.Anything).Return()
 				mockStatus.On("SetMetaData", mock.Anything).Return()
 
 				r = clusterconnection.NewReconcilerWithShims(c, clientScheme, mockStatus, operatorv1.ProviderNone, notReady, ready)
@@ -378,7 +378,7 @@ var _ = Describe("ManagementClusterConnection controller tests", func() {
 			It("should degrade and wait when tier and license are ready, but tier watch is not ready", func() {
 				mockStatus = &status.MockStatus{}
 				mockStatus.On("Run").Return()
-				mockStatus.On("OnCRFound").Return()
+				mockStatus.On("OnCRFound", mock.Anything).Return()
 				mockStatus.On("SetMetaData", mock.Anything).Return()
 
 				r = clusterconnection.NewReconcilerWithShims(c, clientScheme, mockStatus, operatorv1.ProviderNone, notReady, ready)
@@ -136,6 +136,13 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		return fmt.Errorf("compliance-controller failed to watch resource: %w", err)
 	}
 
+	if err = utils.AddConfigMapWatchWithLabel(complianceController, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("compliance-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(complianceController, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("compliance-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(complianceController, ResourceName); err != nil {
 		return fmt.Errorf("compliance-controller failed to watch compliance Tigerastatus: %w", err)
@@ -149,7 +156,7 @@ func newReconciler(mgr manager.Manager, opts options.ControllerOptions, licenseA
 	r := &ReconcileCompliance{
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
-		status:          status.New(mgr.GetClient(), "compliance", opts.KubernetesVersion),
+		status:          status.New(mgr.GetClient(), "compliance", opts.KubernetesVersion, opts.EventRecorder),
 		licenseAPIReady: licenseAPIReady,
 		tierWatchReady:  tierWatchReady,
 		opts:            opts,
@@ -225,7 +232,7 @@ func (r *ReconcileCompliance) Reconcile(ctx context.Context, request reconcile.R
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying compliance", err, reqLogger)
 		return reconcile.Result{}, err
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(instance)
 	reqLogger.V(2).Info("Loaded config", "config", instance)
 
 	// SetMetaData in the TigeraStatus such as observedGenerations.
@@ -431,14 +438,19 @@ func (r *ReconcileCompliance) Reconcile(ctx context.Context, request reconcile.R
 
 	reqLogger.V(3).Info("rendering components")
 
-	setUp := render.NewSetup(&render.SetUpConfiguration{
+	setUpCfg := &render.SetUpConfiguration{
 		OpenShift:       r.opts.DetectedProvider.IsOpenShift(),
 		Installation:    installationSpec,
 		PullSecrets:     pullSecrets,
 		Namespace:       helper.InstallNamespace(),
-		PSS:             render.PSSPrivileged,
+		PSS:             render.CompliancePodSecurityStandard,
 		CreateNamespace: !tenant.MultiTenant(),
-	})
+	}
+	if _, err := setUpCfg.PodSecurityStandard(); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Failed to resolve the pod security standard of the namespace", err, reqLogger)
+		return reconcile.Result{}, nil
+	}
+	setUp := render.NewSetup(setUpCfg)
 
 	hasNoLicense := !utils.IsFeatureActive(license, common.ComplianceFeature)
 	openshift := r.opts.DetectedProvider.IsOpenShift()
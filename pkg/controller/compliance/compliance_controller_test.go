@@ -84,7 +84,7 @@ var _ = Describe("Compliance controller tests", func() {
 		mockStatus.On("RemoveCertificateSigningRequests", mock.Anything).Return()
 		mockStatus.On("AddCronJobs", mock.Anything)
 		mockStatus.On("IsAvailable").Return(true)
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("AddCertificateSigningRequests", mock.Anything).Return()
 		mockStatus.On("ClearDegraded")
 		mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
@@ -628,7 +628,7 @@ var _ = Describe("Compliance controller tests", func() {
 
 		BeforeEach(func() {
 			mockStatus = &status.MockStatus{}
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("SetMetaData", mock.Anything).Return()
 
 			readyFlag = &utils.ReadyFlag{}
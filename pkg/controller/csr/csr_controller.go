@@ -63,6 +63,8 @@ import (
 const (
 	controllerName = "csr-controller"
 	LabelName      = "operator.tigera.io/csr"
+	// HostnameLabelName is the label with the hostname of the non-cluster host that created a CSR.
+	HostnameLabelName = "nonclusterhost.tigera.io/hostname"
 )
 
 var (
@@ -119,6 +121,9 @@ type tlsAsset struct {
 	serviceaccountName      string
 	serviceaccountNamespace string
 	validDNSNames           []string
+	// accessReviewName is the common name a requestor without a fixed service account must be allowed to create
+	// CSRs for, see the SubjectAccessReview in validate.
+	accessReviewName string
 }
 
 func newReconciler(mgr manager.Manager, opts options.ControllerOptions) (reconcile.Reconciler, error) {
@@ -158,7 +163,15 @@ func allowedAssets(clusterDomain string) map[string]tlsAsset {
 		// To accommodate our customers' use of different non-cluster service accounts,
 		// we will perform a SubjectAccessReview to validate the requestor's permission.
 		render.NodeTLSSecretNameNonClusterHost: {
-			validDNSNames: []string{render.FelixCommonName + render.TyphaNonClusterHostSuffix},
+			validDNSNames:    []string{render.FelixCommonName + render.TyphaNonClusterHostSuffix},
+			accessReviewName: render.TyphaCommonName + render.TyphaNonClusterHostSuffix,
+		},
+		// Non-cluster hosts present this certificate to the fluentd input service when the NonClusterHost requires
+		// client certificates. Only hosts whose service account is allowed to create CSRs for the common name can
+		// obtain one, which the operator grants only while client certificates are required.
+		render.FluentdInputClientTLSSecretName: {
+			validDNSNames:    []string{render.FluentdInputClientCommonName},
+			accessReviewName: render.FluentdInputClientCommonName,
 		},
 	}
 }
@@ -253,7 +266,7 @@ func (r *reconcileCSR) Reconcile(ctx context.Context, request reconcile.Request)
 		reqLogger.V(5).Info("Inspecting CSR with name : %v.", csr.Name)
 		var certificateTemplate *x509.Certificate
 		var err error
-		if v, ok := csr.Labels["nonclusterhost.tigera.io/hostname"]; ok {
+		if v, ok := csr.Labels[HostnameLabelName]; ok {
 			var hep *v3.HostEndpoint
 			if hep, err = r.getHostEndpoint(ctx, v); err == nil {
 				certificateTemplate, err = validate(r.clientset, &csr, hep, r.allowedTLSAssets)
@@ -392,7 +405,7 @@ func validate[T PodOrHostEndpoint](
 					Resource:    "certificatesigningrequests",
 					Subresource: "common-name",
 					Verb:        "create",
-					Name:        render.TyphaCommonName + render.TyphaNonClusterHostSuffix,
+					Name:        asset.accessReviewName,
 				},
 			},
 		}
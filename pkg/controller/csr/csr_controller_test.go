@@ -27,6 +27,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
 
 	authv1 "k8s.io/api/authorization/v1"
 	certificatesv1 "k8s.io/api/certificates/v1"
@@ -96,7 +97,7 @@ var _ = Describe("CSR controller tests", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(cli.Create(ctx, certificateManager.KeyPair().Secret(common.OperatorNamespace()))).NotTo(HaveOccurred())
 		mockStatus = &status.MockStatus{}
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		r = reconcileCSR{
 			client:              cli,
 			clientset:           clientset,
@@ -235,6 +236,32 @@ var _ = Describe("CSR controller tests", func() {
 		Entry("irrelevant signer name", invalidNonClusterHostCSR(invalidX509CR(), validHostEndpoint(), invalidSignername), validHostEndpoint(), false, false, true),
 	)
 
+	It("should sign fluentd input client certificates for non-cluster hosts allowed to request the common name", func() {
+		var review *authv1.SubjectAccessReview
+		clientset.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+			review = action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+			return true, &authv1.SubjectAccessReview{Status: authv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+		})
+		cr := validNonClusterHostX509CR()
+		cr.Subject.CommonName = "fluentd-http-input-client"
+		cr.DNSNames = []string{"fluentd-http-input-client"}
+		csr := validNonClusterHostCSR(cr, validHostEndpoint())
+		csr.Name = "tigera-fluentd-http-input-client-tls:" + validHostEndpoint().Spec.Node
+
+		certificate, err := validate(clientset, csr, validHostEndpoint(), allowedAssets(dns.DefaultClusterDomain))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(certificate.Subject.CommonName).To(Equal("fluentd-http-input-client"))
+		Expect(review).NotTo(BeNil())
+		Expect(review.Spec.ResourceAttributes.Name).To(Equal("fluentd-http-input-client"))
+
+		// The typha client common name cannot be requested with the fluentd input client secret name.
+		cr = validNonClusterHostX509CR()
+		csr = validNonClusterHostCSR(cr, validHostEndpoint())
+		csr.Name = "tigera-fluentd-http-input-client-tls:" + validHostEndpoint().Spec.Node
+		_, err = validate(clientset, csr, validHostEndpoint(), allowedAssets(dns.DefaultClusterDomain))
+		Expect(err).To(HaveOccurred())
+	})
+
 	DescribeTable("getPod", func(csr *certificatesv1.CertificateSigningRequest, pod *corev1.Pod, expectPodNil bool) {
 		if pod != nil {
 			Expect(cli.Create(ctx, pod)).NotTo(HaveOccurred())
@@ -82,7 +82,7 @@ func newReconciler(mgr manager.Manager, opts options.ControllerOptions, licenseA
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
 		provider:        opts.DetectedProvider,
-		status:          status.New(mgr.GetClient(), "egressgateway", opts.KubernetesVersion),
+		status:          status.New(mgr.GetClient(), "egressgateway", opts.KubernetesVersion, opts.EventRecorder),
 		clusterDomain:   opts.ClusterDomain,
 		licenseAPIReady: licenseAPIReady,
 	}
@@ -231,7 +231,7 @@ func (r *ReconcileEgressGateway) Reconcile(ctx context.Context, request reconcil
 		egwsToReconcile = []operatorv1.EgressGateway{*requestedEGW}
 		egws = append(egws[:idx], egws[idx+1:]...)
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(nil)
 
 	// Get the unready EGW.
 	unreadyEGW := getUnreadyEgressGateway(egws)
@@ -87,7 +87,7 @@ var _ = Describe("Egress Gateway controller tests", func() {
 				},
 			}
 			mockStatus = &status.MockStatus{}
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 
 			r = ReconcileEgressGateway{
 				client:          c,
@@ -481,6 +481,65 @@ var _ = Describe("Egress Gateway controller tests", func() {
 			Expect(test.GetResource(c, &scc)).NotTo(BeNil())
 		})
 
+		It("should keep the owner references of every egress gateway on the objects they share", func() {
+			// Return the managed fields, so that the objects that already have the applied state are not applied again.
+			c = ctrlrfake.DefaultFakeClientBuilder(scheme).WithReturnManagedFields().WithObjects(installation).Build()
+			r.client = c
+			mockStatus.On("AddDaemonsets", mock.Anything).Return()
+			mockStatus.On("AddDeployments", mock.Anything).Return()
+			mockStatus.On("RemoveDeployments", mock.Anything).Return()
+			mockStatus.On("IsAvailable").Return(true)
+			mockStatus.On("AddStatefulSets", mock.Anything).Return()
+			mockStatus.On("AddCronJobs", mock.Anything)
+			mockStatus.On("OnCRNotFound").Return()
+			mockStatus.On("ClearDegraded")
+			mockStatus.On("ReadyToMonitor")
+			for _, obj := range []client.Object{
+				&v3.IPPool{ObjectMeta: metav1.ObjectMeta{Name: "ippool-1"}, Spec: v3.IPPoolSpec{CIDR: "1.2.3.0/24"}},
+				&v3.FelixConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+				&v3.ExternalNetwork{ObjectMeta: metav1.ObjectMeta{Name: "one"}},
+			} {
+				Expect(c.Create(ctx, obj)).NotTo(HaveOccurred())
+			}
+
+			logSeverity := operatorv1.LogSeverityInfo
+			for _, name := range []string{"calico-red", "calico-blue"} {
+				Expect(c.Create(ctx, &operatorv1.EgressGateway{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "calico-egress", UID: types.UID(name)},
+					Spec: operatorv1.EgressGatewaySpec{
+						LogSeverity:      &logSeverity,
+						IPPools:          []operatorv1.EgressGatewayIPPool{{Name: "ippool-1"}},
+						ExternalNetworks: []string{"one"},
+					},
+					Status: operatorv1.EgressGatewayStatus{State: operatorv1.TigeraStatusReady},
+				})).NotTo(HaveOccurred())
+			}
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rb := rbacv1.RoleBinding{
+				TypeMeta:   metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-operator-secrets", Namespace: "calico-egress"},
+			}
+			Expect(test.GetResource(c, &rb)).To(BeNil())
+			owners := func() []types.UID {
+				var uids []types.UID
+				for _, ref := range rb.OwnerReferences {
+					uids = append(uids, ref.UID)
+				}
+				return uids
+			}
+			Expect(owners()).To(ConsistOf(types.UID("calico-red"), types.UID("calico-blue")))
+
+			// Reconciling the egress gateways again neither drops an owner nor writes the shared objects.
+			resourceVersion := rb.ResourceVersion
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(test.GetResource(c, &rb)).To(BeNil())
+			Expect(owners()).To(ConsistOf(types.UID("calico-red"), types.UID("calico-blue")))
+			Expect(rb.ResourceVersion).To(Equal(resourceVersion))
+		})
+
 		It("Should throw an error when ippool is not present", func() {
 			mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Error validating egress gateway Name = calico-red, Namespace = calico-egress", "ippools.crd.projectcalico.org \"ippool-3\" not found", mock.Anything, mock.Anything).Return()
 			Expect(c.Create(ctx, installation)).NotTo(HaveOccurred())
@@ -49,6 +49,7 @@ import (
 	"github.com/tigera/operator/pkg/ctrlruntime"
 	"github.com/tigera/operator/pkg/render"
 	"github.com/tigera/operator/pkg/render/gatewayapi"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
 )
 
 const (
@@ -67,7 +68,7 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		client:              mgr.GetClient(),
 		scheme:              mgr.GetScheme(),
 		enterpriseCRDsExist: opts.EnterpriseCRDExists,
-		status:              status.New(mgr.GetClient(), "gatewayapi", opts.KubernetesVersion),
+		status:              status.New(mgr.GetClient(), "gatewayapi", opts.KubernetesVersion, opts.EventRecorder),
 		clusterDomain:       opts.ClusterDomain,
 		multiTenant:         opts.MultiTenant,
 		newComponentHandler: utils.NewComponentHandler,
@@ -91,6 +92,10 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		return fmt.Errorf("gatewayapi-controller failed to watch Installation resource: %w", err)
 	}
 
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("gatewayapi-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+
 	// Perform periodic reconciliation. This acts as a backstop to catch reconcile issues,
 	// and also makes sure we spot when things change that might not trigger a reconciliation.
 	if err = utils.AddPeriodicReconcile(c, utils.PeriodicReconcileTime, &handler.EnqueueRequestForObject{}); err != nil {
@@ -178,7 +183,7 @@ func (r *ReconcileGatewayAPI) Reconcile(ctx context.Context, request reconcile.R
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying for GatewayAPI CR: "+msg, err, reqLogger)
 		return reconcile.Result{}, err
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(gatewayAPI)
 
 	// SetMetaData in the TigeraStatus such as observedGenerations.
 	defer r.status.SetMetaData(&gatewayAPI.ObjectMeta)
@@ -94,7 +94,7 @@ var _ = Describe("Gateway API controller tests", func() {
 			},
 		}
 		mockStatus = &status.MockStatus{}
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("AddDaemonsets", mock.Anything).Return()
 		mockStatus.On("AddDeployments", mock.Anything).Return()
 		mockStatus.On("IsAvailable").Return(true)
@@ -21,6 +21,7 @@ import (
 	v1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -54,7 +55,7 @@ var log = logf.Log.WithName(controllerName)
 // Add creates a new Reconciler Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and start it when the Manager is started.
 func Add(mgr manager.Manager, opts options.ControllerOptions) error {
-	statusManager := status.New(mgr.GetClient(), "goldmane", opts.KubernetesVersion)
+	statusManager := status.New(mgr.GetClient(), "goldmane", opts.KubernetesVersion, opts.EventRecorder)
 	reconciler := newReconciler(mgr.GetClient(), mgr.GetScheme(), statusManager, opts.DetectedProvider, opts)
 
 	// Create a new controller
@@ -98,6 +99,13 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		return fmt.Errorf("%s failed to watch Whisker deployment: %w", controllerName, err)
 	}
 
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("goldmane-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("goldmane-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("goldmane-controller failed to watch Tigerastatus: %w", err)
 	}
@@ -164,10 +172,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 			return reconcile.Result{}, err
 		}
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(goldmaneCR)
 	// SetMetaData in the TigeraStatus such as observedGenerations.
 	defer r.status.SetMetaData(&goldmaneCR.ObjectMeta)
 
+	// Changes for updating Goldmane status conditions.
+	if request.Name == ResourceName && request.Namespace == "" {
+		ts := &operatorv1.TigeraStatus{}
+		err := r.cli.Get(ctx, types.NamespacedName{Name: ResourceName}, ts)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		goldmaneCR.Status.Conditions = status.UpdateStatusCondition(goldmaneCR.Status.Conditions, ts.Status.Conditions)
+		if err := r.cli.Status().Update(ctx, goldmaneCR); err != nil {
+			log.WithValues("reason", err).Info("Failed to create Goldmane status conditions.")
+			return reconcile.Result{}, err
+		}
+	}
+
 	variant, installationSpec, err := utils.GetInstallationSpec(ctx, r.cli)
 	if err != nil {
 		return reconcile.Result{}, err
@@ -0,0 +1,190 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The health package tracks the state of each of the operator's controllers and serves it over HTTP, so that
+// controllers that are stuck waiting for a watch or failing to reconcile can be detected and alerted on.
+package health
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	tierKind       = "Tier"
+	licenseKeyKind = "LicenseKey"
+)
+
+// DefaultRegistry is the Registry that the operator's controllers report to.
+var DefaultRegistry = NewRegistry()
+
+// Registry records, for each controller, whether the watches it depends on are established and the time and result
+// of its last reconcile. It is safe for concurrent use.
+type Registry struct {
+	mu          sync.RWMutex
+	controllers map[string]*controllerState
+}
+
+type controllerState struct {
+	// watches maps the watches the controller waits on, keyed by kind and name, to their kind and whether they
+	// are established.
+	watches            map[string]watchState
+	lastReconcileTime  time.Time
+	lastReconcileError string
+}
+
+type watchState struct {
+	kind        string
+	established bool
+}
+
+// ControllerStatus is the state of a single controller.
+type ControllerStatus struct {
+	Name string `json:"name"`
+
+	// Ready is true when all the watches of the controller are established and its last reconcile, if any,
+	// succeeded.
+	Ready bool `json:"ready"`
+
+	// WatchesEstablished is true when the controller is not waiting to watch any resources, such as resources whose
+	// CRDs or API server are not available yet.
+	WatchesEstablished bool     `json:"watchesEstablished"`
+	PendingWatches     []string `json:"pendingWatches,omitempty"`
+
+	// TierWatchReady and LicenseWatchReady are only set for controllers that watch Tiers and LicenseKeys.
+	TierWatchReady    *bool `json:"tierWatchReady,omitempty"`
+	LicenseWatchReady *bool `json:"licenseWatchReady,omitempty"`
+
+	LastReconcileTime  *time.Time `json:"lastReconcileTime,omitempty"`
+	LastReconcileError string     `json:"lastReconcileError,omitempty"`
+}
+
+func NewRegistry() *Registry {
+	return &Registry{controllers: map[string]*controllerState{}}
+}
+
+// AddController registers a controller, so that it is reported even before it has reconciled.
+func (r *Registry) AddController(controller string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.controller(controller)
+}
+
+// WatchPending records that the controller is waiting to watch the given kind of resource. The name is empty for
+// watches on all resources of the kind.
+func (r *Registry) WatchPending(controller, kind, name string) {
+	r.setWatch(controller, kind, name, false)
+}
+
+// WatchEstablished records that the controller now watches the given kind of resource.
+func (r *Registry) WatchEstablished(controller, kind, name string) {
+	r.setWatch(controller, kind, name, true)
+}
+
+func (r *Registry) setWatch(controller, kind, name string, established bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := kind
+	if name != "" {
+		key = kind + "/" + name
+	}
+	r.controller(controller).watches[key] = watchState{kind: kind, established: established}
+}
+
+// ReconcileCompleted records the result of a reconcile of the controller.
+func (r *Registry) ReconcileCompleted(controller string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := r.controller(controller)
+	c.lastReconcileTime = time.Now()
+	c.lastReconcileError = ""
+	if err != nil {
+		c.lastReconcileError = err.Error()
+	}
+}
+
+// controller returns the state of the controller, creating it if needed. The caller must hold the write lock.
+func (r *Registry) controller(name string) *controllerState {
+	c, ok := r.controllers[name]
+	if !ok {
+		c = &controllerState{watches: map[string]watchState{}}
+		r.controllers[name] = c
+	}
+	return c
+}
+
+// Status returns the state of each controller, sorted by name.
+func (r *Registry) Status() []ControllerStatus {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	statuses := []ControllerStatus{}
+	for name := range r.controllers {
+		statuses = append(statuses, r.status(name))
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// ControllerStatus returns the state of the named controller, and false if it is not registered.
+func (r *Registry) ControllerStatus(name string) (ControllerStatus, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if _, ok := r.controllers[name]; !ok {
+		return ControllerStatus{}, false
+	}
+	return r.status(name), true
+}
+
+// status returns the state of a registered controller. The caller must hold the read lock.
+func (r *Registry) status(name string) ControllerStatus {
+	c := r.controllers[name]
+	s := ControllerStatus{Name: name, LastReconcileError: c.lastReconcileError}
+	kindReady := map[string]bool{}
+	for key, w := range c.watches {
+		if _, ok := kindReady[w.kind]; !ok {
+			kindReady[w.kind] = true
+		}
+		if !w.established {
+			kindReady[w.kind] = false
+			s.PendingWatches = append(s.PendingWatches, key)
+		}
+	}
+	sort.Strings(s.PendingWatches)
+	if ready, ok := kindReady[tierKind]; ok {
+		s.TierWatchReady = &ready
+	}
+	if ready, ok := kindReady[licenseKeyKind]; ok {
+		s.LicenseWatchReady = &ready
+	}
+	if !c.lastReconcileTime.IsZero() {
+		t := c.lastReconcileTime
+		s.LastReconcileTime = &t
+	}
+	s.WatchesEstablished = len(s.PendingWatches) == 0
+	s.Ready = s.WatchesEstablished && s.LastReconcileError == ""
+	return s
+}
+
+// notReady returns the names of the controllers that are not ready.
+func notReady(statuses []ControllerStatus) string {
+	var names []string
+	for _, s := range statuses {
+		if !s.Ready {
+			names = append(names, s.Name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/utils/ptr"
+)
+
+func TestHealth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Health Suite")
+}
+
+var _ = Describe("Controller health", func() {
+	var registry *Registry
+	var handler http.Handler
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	BeforeEach(func() {
+		registry = NewRegistry()
+		handler = (&server{registry: registry}).handler()
+	})
+
+	It("should report registered controllers that have not reconciled as ready", func() {
+		registry.AddController("installation-controller")
+
+		Expect(registry.Status()).To(Equal([]ControllerStatus{{Name: "installation-controller", Ready: true, WatchesEstablished: true}}))
+		Expect(get("/healthz").Code).To(Equal(http.StatusOK))
+		Expect(get("/readyz").Code).To(Equal(http.StatusOK))
+	})
+
+	It("should report controllers with pending watches as not ready", func() {
+		registry.WatchPending("apiserver-controller", "Tier", "calico-system")
+		registry.WatchPending("apiserver-controller", "LicenseKey", "")
+		registry.WatchEstablished("apiserver-controller", "LicenseKey", "")
+
+		status, ok := registry.ControllerStatus("apiserver-controller")
+		Expect(ok).To(BeTrue())
+		Expect(status.Ready).To(BeFalse())
+		Expect(status.WatchesEstablished).To(BeFalse())
+		Expect(status.PendingWatches).To(ConsistOf("Tier/calico-system"))
+		Expect(status.TierWatchReady).To(Equal(ptr.To(false)))
+		Expect(status.LicenseWatchReady).To(Equal(ptr.To(true)))
+
+		rec := get("/readyz")
+		Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+		Expect(rec.Body.String()).To(ContainSubstring("apiserver-controller"))
+
+		registry.WatchEstablished("apiserver-controller", "Tier", "calico-system")
+		Expect(get("/readyz").Code).To(Equal(http.StatusOK))
+	})
+
+	It("should report the result of the last reconcile", func() {
+		registry.ReconcileCompleted("logcollector-controller", fmt.Errorf("boom"))
+		registry.ReconcileCompleted("monitor-controller", nil)
+
+		Expect(get("/readyz/logcollector-controller").Code).To(Equal(http.StatusServiceUnavailable))
+		Expect(get("/readyz/monitor-controller").Code).To(Equal(http.StatusOK))
+		Expect(get("/readyz/unknown-controller").Code).To(Equal(http.StatusNotFound))
+
+		rec := get("/controllers")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		var statuses []ControllerStatus
+		Expect(json.Unmarshal(rec.Body.Bytes(), &statuses)).NotTo(HaveOccurred())
+		Expect(statuses).To(HaveLen(2))
+		Expect(statuses[0].Name).To(Equal("logcollector-controller"))
+		Expect(statuses[0].LastReconcileError).To(Equal("boom"))
+		Expect(statuses[0].LastReconcileTime).NotTo(BeNil())
+		Expect(statuses[1].Ready).To(BeTrue())
+
+		By("clearing the error once a reconcile succeeds")
+		registry.ReconcileCompleted("logcollector-controller", nil)
+		Expect(get("/readyz").Code).To(Equal(http.StatusOK))
+	})
+
+	It("should expose the state of the controllers as metrics", func() {
+		registry.WatchPending("apiserver-controller", "Tier", "calico-system")
+		registry.ReconcileCompleted("monitor-controller", nil)
+
+		expected := `
+# HELP tigera_operator_controller_ready Whether the watches of the controller are established and its last reconcile succeeded. 1 = ready, 0 = not ready.
+# TYPE tigera_operator_controller_ready gauge
+tigera_operator_controller_ready{controller="apiserver-controller"} 0
+tigera_operator_controller_ready{controller="monitor-controller"} 1
+`
+		Expect(testutil.CollectAndCompare(&collector{registry: registry}, strings.NewReader(expected), "tigera_operator_controller_ready")).To(Succeed())
+		Expect(testutil.CollectAndCount(&collector{registry: registry}, "tigera_operator_controller_last_reconcile_timestamp_seconds")).To(Equal(1))
+		Expect(get("/metrics").Body.String()).To(ContainSubstring(`tigera_operator_controller_watches_established{controller="apiserver-controller"} 0`))
+	})
+})
@@ -0,0 +1,168 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var log = logf.Log.WithName("health")
+
+var (
+	controllerReadyDesc = prometheus.NewDesc(
+		"tigera_operator_controller_ready",
+		"Whether the watches of the controller are established and its last reconcile succeeded. 1 = ready, 0 = not ready.",
+		[]string{"controller"},
+		nil,
+	)
+
+	controllerWatchesEstablishedDesc = prometheus.NewDesc(
+		"tigera_operator_controller_watches_established",
+		"Whether the controller is not waiting to watch any resources. 1 = established, 0 = pending.",
+		[]string{"controller"},
+		nil,
+	)
+
+	controllerLastReconcileDesc = prometheus.NewDesc(
+		"tigera_operator_controller_last_reconcile_timestamp_seconds",
+		"Unix timestamp of the last reconcile of the controller.",
+		[]string{"controller"},
+		nil,
+	)
+)
+
+// server serves the state of the controllers in a Registry:
+//
+//	/healthz reports that the operator is running.
+//	/readyz reports whether all controllers are ready, and /readyz/<controller> whether a single controller is.
+//	/controllers returns the state of all controllers as JSON.
+//	/metrics exposes the state of all controllers as Prometheus metrics.
+type server struct {
+	addr     string
+	registry *Registry
+}
+
+// NewServer returns a manager.Runnable that serves the state of the registry's controllers on the given address.
+func NewServer(addr string, registry *Registry) manager.Runnable {
+	return &server{addr: addr, registry: registry}
+}
+
+// NeedLeaderElection returns false, so that the state of an operator that is not the leader can be queried too.
+func (s *server) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *server) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s for health requests: %w", s.addr, err)
+	}
+	srv := &http.Server{Handler: s.handler(), ReadHeaderTimeout: 10 * time.Second}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Error(err, "Failed to shut down the health server")
+		}
+	}()
+
+	log.Info("Serving controller health", "address", listener.Addr().String())
+	if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *server) handler() http.Handler {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(&collector{registry: s.registry})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("/readyz", s.readyz)
+	mux.HandleFunc("/readyz/", s.readyz)
+	mux.HandleFunc("/controllers", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(s.registry.Status()); err != nil {
+			log.Error(err, "Failed to write the controller health")
+		}
+	})
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	return mux
+}
+
+func (s *server) readyz(w http.ResponseWriter, req *http.Request) {
+	statuses := s.registry.Status()
+	if name := strings.TrimPrefix(req.URL.Path, "/readyz/"); name != req.URL.Path && name != "" {
+		status, ok := s.registry.ControllerStatus(name)
+		if !ok {
+			http.Error(w, fmt.Sprintf("unknown controller %s", name), http.StatusNotFound)
+			return
+		}
+		statuses = []ControllerStatus{status}
+	}
+
+	if names := notReady(statuses); names != "" {
+		http.Error(w, fmt.Sprintf("controllers not ready: %s", names), http.StatusServiceUnavailable)
+		return
+	}
+	_, _ = w.Write([]byte("ok"))
+}
+
+// collector implements prometheus.Collector and exposes the state of the registry's controllers.
+type collector struct {
+	registry *Registry
+}
+
+// Describe implements prometheus.Collector.
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- controllerReadyDesc
+	ch <- controllerWatchesEstablishedDesc
+	ch <- controllerLastReconcileDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	for _, s := range c.registry.Status() {
+		ch <- prometheus.MustNewConstMetric(controllerReadyDesc, prometheus.GaugeValue, boolValue(s.Ready), s.Name)
+		ch <- prometheus.MustNewConstMetric(controllerWatchesEstablishedDesc, prometheus.GaugeValue, boolValue(s.WatchesEstablished), s.Name)
+		if s.LastReconcileTime != nil {
+			ch <- prometheus.MustNewConstMetric(controllerLastReconcileDesc, prometheus.GaugeValue, float64(s.LastReconcileTime.Unix()), s.Name)
+		}
+	}
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -57,10 +57,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 	calicoclient "github.com/tigera/api/pkg/client/clientset_generated/clientset"
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/active"
+	sigstorev1beta1 "github.com/tigera/operator/pkg/apis/sigstore/v1beta1"
+	vpav1 "github.com/tigera/operator/pkg/apis/vpa/v1"
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/components"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
@@ -85,6 +88,8 @@ import (
 	"github.com/tigera/operator/pkg/render/goldmane"
 	"github.com/tigera/operator/pkg/render/kubecontrollers"
 	"github.com/tigera/operator/pkg/render/monitor"
+	"github.com/tigera/operator/pkg/render/operatorwebhook"
+	"github.com/tigera/operator/pkg/render/prometheusagent"
 	"github.com/tigera/operator/pkg/tls/certificatemanagement"
 )
 
@@ -160,6 +165,13 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		return fmt.Errorf("tigera-installation-controller failed to watch primary resource: %w", err)
 	}
 
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("tigera-installation-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("tigera-installation-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(c, InstallationName); err != nil {
 		return fmt.Errorf("tigera-installation-controller failed to watch calico Tigerastatus: %w", err)
@@ -290,6 +302,33 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		},
 	}, predicate.ResourceVersionChangedPredicate{})
 
+	// Watch the typha ServiceMonitor. This watch can only be established once the Prometheus operator CRDs
+	// are installed, so its readiness also tells us whether the ServiceMonitor can be rendered.
+	go utils.WaitToAddResourceWatch(c, opts.K8sClientset, log, ri.serviceMonitorWatchReady, []client.Object{
+		&monitoringv1.ServiceMonitor{
+			TypeMeta:   metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: monitor.MonitoringAPIVersion},
+			ObjectMeta: metav1.ObjectMeta{Name: render.TyphaMetricsName, Namespace: common.CalicoNamespace},
+		},
+	})
+
+	// Watch the image verification policy. This watch can only be established once the sigstore policy-controller
+	// CRDs are installed, so its readiness also tells us whether the policy can be rendered.
+	go utils.WaitToAddResourceWatch(c, opts.K8sClientset, log, ri.clusterImagePolicyWatchReady, []client.Object{
+		&sigstorev1beta1.ClusterImagePolicy{
+			TypeMeta:   metav1.TypeMeta{Kind: sigstorev1beta1.ClusterImagePolicyKind, APIVersion: sigstorev1beta1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: render.ImageVerificationPolicyName},
+		},
+	})
+
+	// Watch the typha VerticalPodAutoscaler. This watch can only be established once the VerticalPodAutoscaler CRDs
+	// are installed, so its readiness also tells us whether VerticalPodAutoscalers can be rendered.
+	go utils.WaitToAddResourceWatch(c, opts.K8sClientset, log, ri.vpaWatchReady, []client.Object{
+		&vpav1.VerticalPodAutoscaler{
+			TypeMeta:   metav1.TypeMeta{Kind: vpav1.VerticalPodAutoscalerKind, APIVersion: vpav1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: common.TyphaDeploymentName, Namespace: common.CalicoNamespace},
+		},
+	})
+
 	return nil
 }
 
@@ -315,7 +354,7 @@ func newReconciler(mgr manager.Manager, opts options.ControllerOptions) (*Reconc
 		return nil, fmt.Errorf("failed to initialize Namespace migration: %w", err)
 	}
 
-	statusManager := status.New(mgr.GetClient(), "calico", opts.KubernetesVersion)
+	statusManager := status.New(mgr.GetClient(), "calico", opts.KubernetesVersion, opts.EventRecorder)
 
 	// Create the SharedIndexInformer used by the typhaAutoscaler
 	nodeListWatch := cache.NewListWatchFromClient(opts.K8sClientset.CoreV1().RESTClient(), "nodes", "", fields.Everything())
@@ -326,28 +365,37 @@ func newReconciler(mgr manager.Manager, opts options.ControllerOptions) (*Reconc
 	typhaListWatch := cache.NewListWatchFromClient(opts.K8sClientset.AppsV1().RESTClient(), "deployments", "calico-system", fields.OneTermEqualSelector("metadata.name", "calico-typha"))
 	typhaScaler := newTyphaAutoscaler(opts.K8sClientset, nodeIndexInformer, typhaListWatch, statusManager)
 
+	// Create a monitor to report, and if configured pause, typha rollouts.
+	typhaUpgrades := newTyphaUpgradeMonitor(opts.K8sClientset, statusManager,
+		typhaUpgradeMonitorOptionBearerToken(mgr.GetConfig().BearerToken, mgr.GetConfig().BearerTokenFile))
+
 	r := &ReconcileInstallation{
-		config:               mgr.GetConfig(),
-		client:               mgr.GetClient(),
-		clientset:            opts.K8sClientset,
-		scheme:               mgr.GetScheme(),
-		shutdownContext:      opts.ShutdownContext,
-		watches:              make(map[runtime.Object]struct{}),
-		autoDetectedProvider: opts.DetectedProvider,
-		status:               statusManager,
-		typhaAutoscaler:      typhaScaler,
-		namespaceMigration:   nm,
-		enterpriseCRDsExist:  opts.EnterpriseCRDExists,
-		clusterDomain:        opts.ClusterDomain,
-		manageCRDs:           opts.ManageCRDs,
-		tierWatchReady:       &utils.ReadyFlag{},
-		migrationWatchReady:  &utils.ReadyFlag{},
-		newComponentHandler:  utils.NewComponentHandler,
-		v3CRDs:               opts.UseV3CRDs,
-		kubernetesVersion:    opts.KubernetesVersion,
+		config:                       mgr.GetConfig(),
+		client:                       mgr.GetClient(),
+		clientset:                    opts.K8sClientset,
+		scheme:                       mgr.GetScheme(),
+		shutdownContext:              opts.ShutdownContext,
+		watches:                      make(map[runtime.Object]struct{}),
+		autoDetectedProvider:         opts.DetectedProvider,
+		status:                       statusManager,
+		typhaAutoscaler:              typhaScaler,
+		typhaUpgradeMonitor:          typhaUpgrades,
+		namespaceMigration:           nm,
+		enterpriseCRDsExist:          opts.EnterpriseCRDExists,
+		clusterDomain:                opts.ClusterDomain,
+		manageCRDs:                   opts.ManageCRDs,
+		tierWatchReady:               &utils.ReadyFlag{},
+		migrationWatchReady:          &utils.ReadyFlag{},
+		newComponentHandler:          utils.NewComponentHandler,
+		serviceMonitorWatchReady:     &utils.ReadyFlag{},
+		clusterImagePolicyWatchReady: &utils.ReadyFlag{},
+		vpaWatchReady:                &utils.ReadyFlag{},
+		v3CRDs:                       opts.UseV3CRDs,
+		kubernetesVersion:            opts.KubernetesVersion,
 	}
 	r.status.Run(opts.ShutdownContext)
 	r.typhaAutoscaler.start(opts.ShutdownContext)
+	r.typhaUpgradeMonitor.start(opts.ShutdownContext)
 
 	return r, nil
 }
@@ -394,6 +442,7 @@ type ReconcileInstallation struct {
 	status                        status.StatusManager
 	typhaAutoscaler               *typhaAutoscaler
 	typhaAutoscalerNonClusterHost *typhaAutoscaler
+	typhaUpgradeMonitor           *typhaUpgradeMonitor
 	namespaceMigration            migration.NamespaceMigration
 	enterpriseCRDsExist           bool
 	migrationChecked              bool
@@ -401,6 +450,9 @@ type ReconcileInstallation struct {
 	manageCRDs                    bool
 	tierWatchReady                *utils.ReadyFlag
 	migrationWatchReady           *utils.ReadyFlag
+	serviceMonitorWatchReady      *utils.ReadyFlag
+	clusterImagePolicyWatchReady  *utils.ReadyFlag
+	vpaWatchReady                 *utils.ReadyFlag
 	v3CRDs                        bool
 	kubernetesVersion             *common.VersionInfo
 
@@ -799,7 +851,7 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 	preDefaultPatchFrom := client.MergeFrom(instance.DeepCopy())
 
 	// Mark CR found so we can report converter problems via tigerastatus
-	r.status.OnCRFound()
+	r.status.OnCRFound(instance)
 	// SetMetaData in the TigeraStatus such as observedGenerations.
 	defer r.status.SetMetaData(&instance.ObjectMeta)
 
@@ -817,6 +869,13 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		}
 	}
 
+	// Leave the managed resources alone while reconciliation is paused, so that manual changes made for
+	// debugging are not reverted. Deletion still needs to be processed so that the finalizers are removed.
+	if common.ReconcilePaused(instance) && !installationMarkedForDeletion {
+		reqLogger.Info("Reconciliation is paused", "annotation", common.ReconcileAnnotation)
+		return reconcile.Result{}, nil
+	}
+
 	instanceStatus := instance.Status
 	if !r.migrationChecked {
 		// update Installation resource with existing install if it exists.
@@ -1209,6 +1268,16 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 
 	felixPrometheusMetricsPort := defaultFelixMetricsDefaultPort
 
+	var typhaPrometheusTLS certificatemanagement.KeyPairInterface
+	if instance.Spec.TyphaMetricsTLSEnabled() {
+		typhaPrometheusTLS, err = certificateManager.GetOrCreateKeyPair(r.client, render.TyphaPrometheusTLSServerSecret, common.OperatorNamespace(), dns.GetServiceDNSNames(render.TyphaMetricsName, common.CalicoNamespace, r.clusterDomain))
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceCreateError, "Error creating TLS certificate", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		typhaNodeTLS.TrustedBundle.AddCertificates(typhaPrometheusTLS)
+	}
+
 	if instance.Spec.Variant.IsEnterprise() {
 
 		// Determine the port to use for nodeReporter metrics.
@@ -1288,6 +1357,20 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		}
 	}
 
+	// Create or Get the TLS certificate served by the operator's admission webhook.
+	var operatorWebhookTLS certificatemanagement.KeyPairInterface
+	if common.OperatorWebhookEnabled() {
+		operatorWebhookTLS, err = certificateManager.GetOrCreateKeyPair(
+			r.client,
+			operatorwebhook.TLSSecretName,
+			common.OperatorNamespace(),
+			dns.GetServiceDNSNames(operatorwebhook.ServiceName, common.OperatorNamespace(), r.clusterDomain))
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceCreateError, "Error creating operator webhook TLS certificate", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+	}
+
 	nodeAppArmorProfile := ""
 	a := instance.GetObjectMeta().GetAnnotations()
 	if val, ok := a[techPreviewFeatureSeccompApparmor]; ok {
@@ -1361,9 +1444,17 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 				rcertificatemanagement.NewKeyPairOption(nodePrometheusTLS, true, true),
 				rcertificatemanagement.NewKeyPairOption(typhaNodeTLS.TyphaSecret, true, true),
 				rcertificatemanagement.NewKeyPairOption(typhaNodeTLS.TyphaSecretNonClusterHost, true, true),
+				rcertificatemanagement.NewKeyPairOption(typhaPrometheusTLS, true, true),
 				rcertificatemanagement.NewKeyPairOption(kubeControllerTLS, true, true),
+				rcertificatemanagement.NewKeyPairOption(operatorWebhookTLS, true, false),
 			},
 			TrustedBundle: typhaNodeTLS.TrustedBundle,
+		}),
+		operatorwebhook.OperatorWebhook(&operatorwebhook.Configuration{
+			Enabled:           common.OperatorWebhookEnabled(),
+			OperatorName:      common.OperatorName(),
+			OperatorNamespace: common.OperatorNamespace(),
+			KeyPair:           operatorWebhookTLS,
 		}))
 
 	// Check if non-cluster host feature is enabled.
@@ -1409,16 +1500,82 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 	}
 
 	// Build a configuration for rendering calico/typha.
+	// Let the typha upgrade monitor know about any configuration changes, and keep any rollout it has paused paused.
+	typhaRolloutPaused := false
+	if r.typhaUpgradeMonitor != nil {
+		var pauseThreshold *int32
+		if tc := instance.Spec.TyphaConfiguration; tc != nil {
+			pauseThreshold = tc.RolloutPauseThresholdPercent
+		}
+		var metricsCAPEM []byte
+		if instance.Spec.TyphaMetricsTLSEnabled() {
+			metricsCAPEM = []byte(typhaNodeTLS.TrustedBundle.ConfigMap(common.CalicoNamespace).Data[certificatemanagement.TrustedCertConfigMapKeyName])
+		}
+		if err := r.typhaUpgradeMonitor.configure(instance.Spec.TyphaMetricsPort, pauseThreshold, metricsCAPEM, instance.Spec.TyphaMetricsAuthEnabled()); err != nil {
+			r.status.SetDegraded(operatorv1.InternalServerError, "Failed to configure the typha upgrade monitor", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		typhaRolloutPaused = r.typhaUpgradeMonitor.isPaused()
+	}
+	if instance.Spec.TyphaMetricsAuthEnabled() {
+		// Typha runs on the host network next to calico/node, so the port typha serves its metrics on for the
+		// kube-rbac-proxy must not clash with the ports that typha and calico/node already listen on.
+		upstream := int(instance.Spec.GetTyphaMetricsUpstreamPort())
+		healthPort := *felixConfiguration.Spec.HealthPort
+		if upstream == healthPort || upstream == healthPort-1 || upstream == felixPrometheusMetricsPort || upstream == nodeReporterMetricsPort {
+			err := fmt.Errorf("installation spec.TyphaMetricsUpstreamPort %d is already used by calico/node or calico/typha", upstream)
+			r.status.SetDegraded(operatorv1.InvalidConfigurationError, "invalid metrics port", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+	}
+	staleTyphaPools, err := getStaleTyphaPools(ctx, r.client, instance)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Failed to query typha pool Deployments", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	typhaCfg := render.TyphaConfiguration{
-		K8sServiceEp:      k8sapi.Endpoint,
-		Installation:      &instance.Spec,
-		TLS:               typhaNodeTLS,
-		MigrateNamespaces: needsNamespaceMigration,
-		ClusterDomain:     r.clusterDomain,
-		NonClusterHost:    nonclusterhost,
-		FelixHealthPort:   *felixConfiguration.Spec.HealthPort,
+		K8sServiceEp:            k8sapi.Endpoint,
+		Installation:            &instance.Spec,
+		TLS:                     typhaNodeTLS,
+		PrometheusServerTLS:     typhaPrometheusTLS,
+		MigrateNamespaces:       needsNamespaceMigration,
+		ClusterDomain:           r.clusterDomain,
+		NonClusterHost:          nonclusterhost,
+		FelixHealthPort:         *felixConfiguration.Spec.HealthPort,
+		ServiceMonitorCRDExists: r.serviceMonitorWatchReady != nil && r.serviceMonitorWatchReady.IsReady(),
+		VPACRDExists:            r.vpaWatchReady != nil && r.vpaWatchReady.IsReady(),
+		RolloutPaused:           typhaRolloutPaused,
+		StaleTyphaPools:         staleTyphaPools,
+	}
+	components = append(components, render.PriorityClasses(&instance.Spec), render.Typha(&typhaCfg))
+
+	prometheusAgentCfg := prometheusagent.Configuration{
+		Installation:  &instance.Spec,
+		PullSecrets:   pullSecrets,
+		TrustedBundle: typhaNodeTLS.TrustedBundle,
+		ClusterDomain: r.clusterDomain,
+	}
+	if rw := instance.Spec.MetricsRemoteWrite; rw != nil {
+		if prometheusAgentCfg.TLSSecret, err = getMetricsRemoteWriteSecret(ctx, r.client, rw.TLSSecretName); err == nil {
+			if prometheusAgentCfg.BasicAuthSecret, err = getMetricsRemoteWriteSecret(ctx, r.client, rw.BasicAuthSecretName, "username", "password"); err == nil {
+				prometheusAgentCfg.BearerTokenSecret, err = getMetricsRemoteWriteSecret(ctx, r.client, rw.BearerTokenSecretName, "token")
+			}
+		}
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error reading a metricsRemoteWrite secret", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+	}
+	prometheusAgent, err := prometheusagent.PrometheusAgent(&prometheusAgentCfg)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceRenderingError, "Error rendering the Prometheus agent", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	components = append(components, prometheusAgent)
+	if r.clusterImagePolicyWatchReady != nil && r.clusterImagePolicyWatchReady.IsReady() {
+		components = append(components, render.ImageVerification(&instance.Spec))
 	}
-	components = append(components, render.Typha(&typhaCfg))
 
 	// See the section 'Use of Finalizers for graceful termination' at the top of this file for terminating details.
 	canRemoveCNI := false
@@ -1643,6 +1800,12 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 				reqLogger.Info("An ImageSet exists for a different variant")
 			}
 		}
+
+		imageSet, err = imageset.ResolveImageSet(ctx, r.client, instance.Spec.Variant, &instance.Spec)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error resolving image digests", err, reqLogger)
+			return reconcile.Result{}, err
+		}
 	}
 
 	if err = imageset.ValidateImageSet(imageSet); err != nil {
@@ -1650,6 +1813,11 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		return reconcile.Result{}, err
 	}
 
+	if err = imageset.ValidateImageVerification(imageSet, instance.Spec.Variant, &instance.Spec); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Error validating ImageSet", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	if err = imageset.ResolveImages(imageSet, components...); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceValidationError, "Error resolving ImageSet for components", err, reqLogger)
 		return reconcile.Result{}, err
@@ -1666,6 +1834,14 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 	// we can have the CreateOrUpdate logic handle this for us.
 	r.status.AddDaemonsets([]types.NamespacedName{{Name: common.NodeDaemonSetName, Namespace: common.CalicoNamespace}})
 	r.status.AddDeployments([]types.NamespacedName{{Name: common.KubeControllersDeploymentName, Namespace: common.CalicoNamespace}})
+	if td := instance.Spec.TyphaDeployment; td != nil && td.Spec != nil {
+		for _, pool := range td.Spec.Pools {
+			r.status.AddDeployments([]types.NamespacedName{{Name: render.TyphaPoolName(pool.Name), Namespace: common.CalicoNamespace}})
+		}
+	}
+	for _, name := range staleTyphaPools {
+		r.status.RemoveDeployments(types.NamespacedName{Name: render.TyphaPoolName(name), Namespace: common.CalicoNamespace})
+	}
 	certificateManager.AddToStatusManager(r.status, common.CalicoNamespace)
 
 	// If eBPF is enabled in the operator API, patch FelixConfiguration to enable it within Felix.
@@ -1749,6 +1925,7 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		render.NodeTLSSecretName:                                     typhaNodeTLS.NodeSecret,
 		render.TyphaTLSSecretName + render.TyphaNonClusterHostSuffix: typhaNodeTLS.TyphaSecretNonClusterHost,
 		render.NodePrometheusTLSServerSecret:                         nodePrometheusTLS,
+		render.TyphaPrometheusTLSServerSecret:                        typhaPrometheusTLS,
 		kubecontrollers.KubeControllerPrometheusTLSSecret:            kubeControllerTLS,
 	}, r.status)
 
@@ -1802,23 +1979,26 @@ func calicoDirectoryExists() bool {
 	return err == nil
 }
 
+type keyPairFunc func(cli client.Client, secretName, secretNamespace string, dnsNames []string) (certificatemanagement.KeyPairInterface, error)
+
 func GetOrCreateTyphaNodeTLSConfig(cli client.Client, certificateManager certificatemanager.CertificateManager) (*render.TyphaNodeTLS, error) {
-	return getOrCreateTyphaNodeTLSConfig(cli, certificateManager, certificateManager.GetOrCreateKeyPair)
+	return getOrCreateTyphaNodeTLSConfig(cli, certificateManager, certificateManager.GetOrCreateKeyPair, certificateManager.GetOrRequestKeyPair)
 }
 
 func GetTyphaNodeTLSConfig(cli client.Client, certificateManager certificatemanager.CertificateManager) (*render.TyphaNodeTLS, error) {
-	return getOrCreateTyphaNodeTLSConfig(cli, certificateManager, certificateManager.GetKeyPair)
+	return getOrCreateTyphaNodeTLSConfig(cli, certificateManager, certificateManager.GetKeyPair, certificateManager.GetKeyPair)
 }
 
 // getOrCreateTyphaNodeTLSConfig reads and validates the CA ConfigMap and Secrets for
 // Typha and Felix configuration. It returns the validated resources or error
-// if there was one.
-func getOrCreateTyphaNodeTLSConfig(cli client.Client, certificateManager certificatemanager.CertificateManager, createKeyPairFunc func(cli client.Client, secretName, secretNamespace string, dnsNames []string) (certificatemanagement.KeyPairInterface, error)) (*render.TyphaNodeTLS, error) {
+// if there was one. The key pair of typha itself is obtained with createTyphaKeyPairFunc, so that it can be requested
+// from cert-manager.
+func getOrCreateTyphaNodeTLSConfig(cli client.Client, certificateManager certificatemanager.CertificateManager, createKeyPairFunc, createTyphaKeyPairFunc keyPairFunc) (*render.TyphaNodeTLS, error) {
 	// accumulate all the error messages so all problems with the certs
 	// and CA are reported.
 	var errMsgs []string
-	getOrCreateKeyPair := func(secretName, commonName string, requireCNOrURISAN bool) (keyPair certificatemanagement.KeyPairInterface, cn string, uriSAN string) {
-		keyPair, err := createKeyPairFunc(cli, secretName, common.OperatorNamespace(), []string{commonName})
+	getOrCreateKeyPair := func(getKeyPair keyPairFunc, secretName, commonName string, requireCNOrURISAN bool) (keyPair certificatemanagement.KeyPairInterface, cn string, uriSAN string) {
+		keyPair, err := getKeyPair(cli, secretName, common.OperatorNamespace(), []string{commonName})
 		if err != nil {
 			errMsgs = append(errMsgs, err.Error())
 		} else {
@@ -1843,9 +2023,9 @@ func getOrCreateTyphaNodeTLSConfig(cli client.Client, certificateManager certifi
 		}
 		return
 	}
-	node, nodeCommonName, nodeURISAN := getOrCreateKeyPair(render.NodeTLSSecretName, render.FelixCommonName, true)
-	typha, typhaCommonName, typhaURISAN := getOrCreateKeyPair(render.TyphaTLSSecretName, render.TyphaCommonName, true)
-	typhaNonClusterHost, _, _ := getOrCreateKeyPair(render.TyphaTLSSecretName+render.TyphaNonClusterHostSuffix, render.TyphaCommonName+render.TyphaNonClusterHostSuffix, false)
+	node, nodeCommonName, nodeURISAN := getOrCreateKeyPair(createKeyPairFunc, render.NodeTLSSecretName, render.FelixCommonName, true)
+	typha, typhaCommonName, typhaURISAN := getOrCreateKeyPair(createTyphaKeyPairFunc, render.TyphaTLSSecretName, render.TyphaCommonName, true)
+	typhaNonClusterHost, _, _ := getOrCreateKeyPair(createKeyPairFunc, render.TyphaTLSSecretName+render.TyphaNonClusterHostSuffix, render.TyphaCommonName+render.TyphaNonClusterHostSuffix, false)
 	var trustedBundle certificatemanagement.TrustedBundle
 	configMap, err := getConfigMap(cli, render.TyphaCAConfigMapName)
 	if err != nil {
@@ -2338,6 +2518,26 @@ func getConfigMap(client client.Client, cmName string) (*corev1.ConfigMap, error
 	return cm, nil
 }
 
+// getMetricsRemoteWriteSecret returns the named secret from the operator namespace, or nil if no name is given. It
+// returns an error if the secret does not exist or lacks any of the required keys.
+func getMetricsRemoteWriteSecret(ctx context.Context, cli client.Client, name string, requiredKeys ...string) (*corev1.Secret, error) {
+	if name == "" {
+		return nil, nil
+	}
+	s, err := utils.GetSecret(ctx, cli, name, common.OperatorNamespace())
+	if err != nil {
+		return nil, err
+	} else if s == nil {
+		return nil, fmt.Errorf("secret %s/%s not found", common.OperatorNamespace(), name)
+	}
+	for _, k := range requiredKeys {
+		if len(s.Data[k]) == 0 {
+			return nil, fmt.Errorf("secret %s/%s is missing the %q key", common.OperatorNamespace(), name, k)
+		}
+	}
+	return s, nil
+}
+
 func getBirdTemplates(client client.Client) (map[string]string, error) {
 	cm, err := getConfigMap(client, render.BirdTemplatesConfigMapName)
 	if err != nil || cm == nil {
@@ -2374,6 +2574,28 @@ func isHostedOpenShift(ctx context.Context, client client.Client) (bool, error)
 	return (infra.Status.ControlPlaneTopology == "External"), nil
 }
 
+// getStaleTyphaPools returns the names of the typha pools that have a Deployment in the cluster but are no longer
+// configured in the Installation's TyphaDeployment overrides.
+func getStaleTyphaPools(ctx context.Context, cli client.Client, install *operatorv1.Installation) ([]string, error) {
+	deployments := appsv1.DeploymentList{}
+	if err := cli.List(ctx, &deployments, client.InNamespace(common.CalicoNamespace), client.HasLabels{render.TyphaPoolLabelName}); err != nil {
+		return nil, err
+	}
+	configured := map[string]bool{}
+	if td := install.Spec.TyphaDeployment; td != nil && td.Spec != nil {
+		for _, pool := range td.Spec.Pools {
+			configured[pool.Name] = true
+		}
+	}
+	var stale []string
+	for _, d := range deployments.Items {
+		if name := d.Labels[render.TyphaPoolLabelName]; !configured[name] {
+			stale = append(stale, name)
+		}
+	}
+	return stale, nil
+}
+
 func updateInstallationForAWSNode(i *operatorv1.Installation, ds *appsv1.DaemonSet) error {
 	if ds == nil {
 		return nil
@@ -34,6 +34,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	schedv1 "k8s.io/api/scheduling/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -166,7 +167,7 @@ var _ = Describe("Testing core-controller installation", func() {
 			mockStatus.On("AddStatefulSets", mock.Anything).Return()
 			mockStatus.On("AddCronJobs", mock.Anything)
 			mockStatus.On("IsAvailable").Return(true)
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("ClearDegraded")
 			mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 			mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -780,7 +781,7 @@ var _ = Describe("Testing core-controller installation", func() {
 			mockStatus.On("AddStatefulSets", mock.Anything).Return()
 			mockStatus.On("AddCronJobs", mock.Anything)
 			mockStatus.On("IsAvailable").Return(true)
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("ClearDegraded")
 			mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 			mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -1002,7 +1003,7 @@ var _ = Describe("Testing core-controller installation", func() {
 			mockStatus.On("AddDaemonsets", mock.Anything).Return()
 			mockStatus.On("AddDeployments", mock.Anything).Return()
 			mockStatus.On("IsAvailable").Return(true)
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("ClearDegraded")
 			mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 			mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -1861,6 +1862,40 @@ var _ = Describe("Testing core-controller installation", func() {
 			Expect(test.GetResource(c, &rq)).To(BeNil())
 		})
 
+		It("should Reconcile with managed PriorityClasses and create them", func() {
+			cr.Spec.ManagedPriorityClasses = ptr.To(operator.ManagedPriorityClassesEnabled)
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, name := range []string{render.DataPlanePriorityClassName, render.ControlPlanePriorityClassName} {
+				pc := schedv1.PriorityClass{
+					TypeMeta:   metav1.TypeMeta{Kind: "PriorityClass", APIVersion: "scheduling.k8s.io/v1"},
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+				}
+				Expect(test.GetResource(c, &pc)).To(BeNil())
+			}
+			typha := appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: common.TyphaDeploymentName, Namespace: common.CalicoNamespace},
+			}
+			Expect(test.GetResource(c, &typha)).To(BeNil())
+			Expect(typha.Spec.Template.Spec.PriorityClassName).To(Equal(render.ControlPlanePriorityClassName))
+
+			By("deleting the PriorityClasses when they are no longer managed")
+			Expect(c.Get(ctx, types.NamespacedName{Name: cr.Name}, cr)).NotTo(HaveOccurred())
+			cr.Spec.ManagedPriorityClasses = ptr.To(operator.ManagedPriorityClassesDisabled)
+			Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			pc := schedv1.PriorityClass{
+				TypeMeta:   metav1.TypeMeta{Kind: "PriorityClass", APIVersion: "scheduling.k8s.io/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: render.DataPlanePriorityClassName},
+			}
+			Expect(apierrors.IsNotFound(test.GetResource(c, &pc))).To(BeTrue())
+		})
+
 		It("should Reconcile with no active operator ConfigMap", func() {
 			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
 			_, err := r.Reconcile(ctx, reconcile.Request{})
@@ -2290,7 +2325,7 @@ var _ = Describe("Testing core-controller installation", func() {
 			mockStatus.On("AddStatefulSets", mock.Anything).Return()
 			mockStatus.On("AddCronJobs", mock.Anything)
 			mockStatus.On("IsAvailable").Return(true)
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("ClearDegraded")
 			mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 			mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -2427,7 +2462,7 @@ var _ = Describe("Testing core-controller installation", func() {
 			mockStatus.On("AddStatefulSets", mock.Anything).Return()
 			mockStatus.On("AddCronJobs", mock.Anything)
 			mockStatus.On("IsAvailable").Return(true)
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("ClearDegraded")
 			mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 			mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -0,0 +1,462 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installation
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	k8stransport "k8s.io/client-go/transport"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	operator "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/status"
+	"github.com/tigera/operator/pkg/render"
+)
+
+var typhaUpgradeLog = logf.Log.WithName("typha_upgrade")
+
+const (
+	defaultTyphaUpgradeSyncPeriod = 30 * time.Second
+
+	// deploymentRevisionAnnotation is set by the Deployment controller on each ReplicaSet it owns.
+	deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+	typhaRolloutPausedWarningKey = "typha-rollout-paused"
+)
+
+// typhaMetrics holds the typha connection metrics that the typha upgrade monitor uses.
+type typhaMetrics struct {
+	active   float64
+	accepted float64
+	dropped  float64
+}
+
+// typhaMetricsScraper returns the connection metrics of the typha serving metrics at the given address.
+type typhaMetricsScraper func(ctx context.Context, addr string) (typhaMetrics, error)
+
+// typhaMetricsClient scrapes the typha Prometheus endpoints, over HTTPS when typha serves its metrics with TLS.
+type typhaMetricsClient struct {
+	scheme       string
+	client       *http.Client
+	caPEM        []byte
+	authenticate bool
+}
+
+// bearerToken holds the credentials the operator authenticates to the typha kube-rbac-proxy with, as a static token,
+// a token file that is re-read as it is rotated, or both.
+type bearerToken struct {
+	token     string
+	tokenFile string
+}
+
+// newTyphaMetricsClient returns a client that scrapes the typha metrics over plain HTTP if caPEM is empty. Otherwise,
+// it scrapes them over HTTPS, verifying that the serving certificate is valid for serverName and signed by caPEM.
+// If auth is not nil, each request carries its bearer token.
+func newTyphaMetricsClient(caPEM []byte, serverName string, auth *bearerToken) (*typhaMetricsClient, error) {
+	if len(caPEM) == 0 {
+		return &typhaMetricsClient{scheme: "http", client: http.DefaultClient}, nil
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("failed to parse the typha metrics CA bundle")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    roots,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	mc := &typhaMetricsClient{scheme: "https", client: &http.Client{Transport: transport}, caPEM: caPEM}
+	if auth != nil {
+		rt, err := k8stransport.NewBearerAuthWithRefreshRoundTripper(auth.token, auth.tokenFile, transport)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the bearer token for the typha metrics: %w", err)
+		}
+		mc.client = &http.Client{Transport: rt}
+		mc.authenticate = true
+	}
+	return mc, nil
+}
+
+// typhaUpgradeMonitor periodically inspects the typha Deployment and, while a rollout is in progress, reports the
+// ready replicas and connected clients of each typha revision in the TigeraStatus. If configured, it pauses the
+// rollout while up-level typha pods drop too many of the client connections they accept, and resumes it once the
+// rate falls back below the threshold.
+type typhaUpgradeMonitor struct {
+	client        kubernetes.Interface
+	statusManager status.StatusManager
+	bearerToken   bearerToken
+	syncPeriod    time.Duration
+	scrape        typhaMetricsScraper
+
+	lock           sync.Mutex
+	metricsClient  *typhaMetricsClient
+	metricsPort    *int32
+	pauseThreshold *int32
+	paused         bool
+
+	// The metrics most recently scraped from each up-level typha pod, keyed by pod name. Used to calculate the
+	// connection drop rate since the previous sync.
+	lastSamples map[string]typhaMetrics
+}
+
+type typhaUpgradeMonitorOption func(*typhaUpgradeMonitor)
+
+// typhaUpgradeMonitorOptionPeriod is an option that sets a custom sync period for the typha upgrade monitor.
+func typhaUpgradeMonitorOptionPeriod(syncPeriod time.Duration) typhaUpgradeMonitorOption {
+	return func(t *typhaUpgradeMonitor) {
+		t.syncPeriod = syncPeriod
+	}
+}
+
+// typhaUpgradeMonitorOptionScraper is an option that sets a custom typha metrics scraper.
+func typhaUpgradeMonitorOptionScraper(scrape typhaMetricsScraper) typhaUpgradeMonitorOption {
+	return func(t *typhaUpgradeMonitor) {
+		t.scrape = scrape
+	}
+}
+
+// typhaUpgradeMonitorOptionBearerToken is an option that sets the credentials the operator authenticates to the typha
+// kube-rbac-proxy with when the typha metrics require authentication.
+func typhaUpgradeMonitorOptionBearerToken(token, tokenFile string) typhaUpgradeMonitorOption {
+	return func(t *typhaUpgradeMonitor) {
+		t.bearerToken = bearerToken{token: token, tokenFile: tokenFile}
+	}
+}
+
+// newTyphaUpgradeMonitor creates a new typha upgrade monitor, optionally applying any options to the default instance.
+// The default sync period is 30 seconds.
+func newTyphaUpgradeMonitor(cs kubernetes.Interface, statusManager status.StatusManager, options ...typhaUpgradeMonitorOption) *typhaUpgradeMonitor {
+	t := &typhaUpgradeMonitor{
+		client:        cs,
+		statusManager: statusManager,
+		syncPeriod:    defaultTyphaUpgradeSyncPeriod,
+		metricsClient: &typhaMetricsClient{scheme: "http", client: http.DefaultClient},
+		lastSamples:   map[string]typhaMetrics{},
+	}
+	for _, option := range options {
+		option(t)
+	}
+	return t
+}
+
+// configure updates the typha metrics port and the rollout pause threshold from the Installation. Both may be nil.
+// metricsCAPEM is the CA bundle that verifies the typha metrics serving certificate when typha serves its metrics
+// over TLS, and is empty otherwise. If authenticate is true, the metrics are scraped with the operator's bearer token.
+func (t *typhaUpgradeMonitor) configure(metricsPort, pauseThreshold *int32, metricsCAPEM []byte, authenticate bool) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if !bytes.Equal(t.metricsClient.caPEM, metricsCAPEM) || t.metricsClient.authenticate != authenticate {
+		var auth *bearerToken
+		if authenticate {
+			auth = &t.bearerToken
+		}
+		mc, err := newTyphaMetricsClient(metricsCAPEM, fmt.Sprintf("%s.%s.svc", render.TyphaMetricsName, common.CalicoNamespace), auth)
+		if err != nil {
+			return err
+		}
+		t.metricsClient = mc
+	}
+	t.metricsPort = metricsPort
+	t.pauseThreshold = pauseThreshold
+	if t.metricsPort == nil || t.pauseThreshold == nil {
+		// Without metrics the drop rate can't be measured, so never hold the rollout.
+		t.paused = false
+	}
+	return nil
+}
+
+// isPaused returns whether the typha rollout should be paused. The core controller renders the typha Deployment with
+// this value so that reconciling doesn't undo a pause.
+func (t *typhaUpgradeMonitor) isPaused() bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.paused
+}
+
+// start starts the typha upgrade monitor, which syncs every sync period until the context is done.
+func (t *typhaUpgradeMonitor) start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(t.syncPeriod)
+		defer ticker.Stop()
+		typhaUpgradeLog.Info("Starting typha upgrade monitor", "syncPeriod", t.syncPeriod)
+
+		for {
+			if err := t.sync(ctx); err != nil {
+				typhaUpgradeLog.Error(err, "Failed to monitor typha rollout")
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				typhaUpgradeLog.Info("typha upgrade monitor shutting down")
+				return
+			}
+		}
+	}()
+}
+
+// sync reports the progress of any in-flight typha rollout, pausing or resuming it as needed.
+func (t *typhaUpgradeMonitor) sync(ctx context.Context) error {
+	t.lock.Lock()
+	metricsPort, pauseThreshold, paused := t.metricsPort, t.pauseThreshold, t.paused
+	scrape := t.scrape
+	if scrape == nil {
+		scrape = t.metricsClient.scrape
+	}
+	t.lock.Unlock()
+
+	d, err := t.client.AppsV1().Deployments(common.CalicoNamespace).Get(ctx, common.TyphaDeploymentName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			t.reset()
+			return nil
+		}
+		return fmt.Errorf("failed to get the typha Deployment: %w", err)
+	}
+	if !rolloutInProgress(d) && !paused {
+		t.reset()
+		return nil
+	}
+
+	revisions, upLevel, err := t.typhaRevisions(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	pods, err := t.client.CoreV1().Pods(common.CalicoNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", render.AppLabelName, render.TyphaK8sAppName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list typha pods: %w", err)
+	}
+
+	byRevision := map[string]*operator.TyphaRevisionStatus{}
+	samples := map[string]typhaMetrics{}
+	var accepted, dropped float64
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		revision, ok := revisions[pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]]
+		if !ok {
+			continue
+		}
+		rs := byRevision[revision]
+		if rs == nil {
+			rs = &operator.TyphaRevisionStatus{Revision: revision}
+			byRevision[revision] = rs
+		}
+		if !podReady(pod) {
+			continue
+		}
+		rs.ReadyReplicas++
+
+		if metricsPort == nil || pod.Status.PodIP == "" {
+			continue
+		}
+		m, err := scrape(ctx, net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(*metricsPort))))
+		if err != nil {
+			// Without the metrics of the up-level pods the rollout can't be paused, so make the failure visible.
+			typhaUpgradeLog.Error(err, "Failed to scrape typha metrics", "pod", pod.Name)
+			continue
+		}
+		clients := int64(m.active)
+		if rs.ConnectedClients != nil {
+			clients += *rs.ConnectedClients
+		}
+		rs.ConnectedClients = &clients
+
+		if revision == upLevel {
+			samples[pod.Name] = m
+			if last, ok := t.lastSamples[pod.Name]; ok && m.accepted >= last.accepted && m.dropped >= last.dropped {
+				accepted += m.accepted - last.accepted
+				dropped += m.dropped - last.dropped
+			}
+		}
+	}
+	t.lastSamples = samples
+
+	if pauseThreshold != nil && metricsPort != nil {
+		dropPercent := float64(0)
+		if accepted > 0 {
+			dropPercent = dropped * 100 / accepted
+		} else if dropped > 0 {
+			// Pods that drop every connection they are handed may not accept any at all.
+			dropPercent = 100
+		}
+		shouldPause := dropPercent > float64(*pauseThreshold)
+		if shouldPause != paused {
+			if err := t.setPaused(ctx, shouldPause); err != nil {
+				return err
+			}
+			paused = shouldPause
+		}
+		if paused {
+			t.statusManager.SetWarning(typhaRolloutPausedWarningKey, fmt.Sprintf(
+				"Typha rollout paused: up-level typha pods dropped %.0f%% of the client connections they accepted", dropPercent))
+		} else {
+			t.statusManager.ClearWarning(typhaRolloutPausedWarningKey)
+		}
+	}
+
+	rollout := &operator.TyphaRolloutStatus{Paused: paused}
+	for _, rs := range byRevision {
+		rollout.Revisions = append(rollout.Revisions, *rs)
+	}
+	sort.Slice(rollout.Revisions, func(i, j int) bool {
+		return revisionNumber(rollout.Revisions[i].Revision) < revisionNumber(rollout.Revisions[j].Revision)
+	})
+	t.statusManager.SetTyphaRollout(rollout)
+	return nil
+}
+
+// reset clears any reported rollout once no rollout is in progress.
+func (t *typhaUpgradeMonitor) reset() {
+	t.lastSamples = map[string]typhaMetrics{}
+	t.statusManager.SetTyphaRollout(nil)
+	t.statusManager.ClearWarning(typhaRolloutPausedWarningKey)
+}
+
+// setPaused pauses or resumes the typha Deployment rollout.
+func (t *typhaUpgradeMonitor) setPaused(ctx context.Context, paused bool) error {
+	if paused {
+		typhaUpgradeLog.Info("Pausing typha rollout")
+	} else {
+		typhaUpgradeLog.Info("Resuming typha rollout")
+	}
+	patch := fmt.Sprintf(`{"spec":{"paused":%t}}`, paused)
+	_, err := t.client.AppsV1().Deployments(common.CalicoNamespace).Patch(ctx, common.TyphaDeploymentName, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update the typha Deployment rollout: %w", err)
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.paused = paused
+	return nil
+}
+
+// typhaRevisions returns the revision of each of the typha Deployment's ReplicaSets, keyed by pod template hash, along
+// with the latest (up-level) revision.
+func (t *typhaUpgradeMonitor) typhaRevisions(ctx context.Context, d *appsv1.Deployment) (map[string]string, string, error) {
+	rsList, err := t.client.AppsV1().ReplicaSets(common.CalicoNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", render.AppLabelName, render.TyphaK8sAppName),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list typha ReplicaSets: %w", err)
+	}
+
+	revisions := map[string]string{}
+	upLevel := ""
+	for _, rs := range rsList.Items {
+		if !metav1.IsControlledBy(&rs, d) {
+			continue
+		}
+		revision := rs.Annotations[deploymentRevisionAnnotation]
+		revisions[rs.Labels[appsv1.DefaultDeploymentUniqueLabelKey]] = revision
+		if revisionNumber(revision) > revisionNumber(upLevel) {
+			upLevel = revision
+		}
+	}
+	return revisions, upLevel, nil
+}
+
+// rolloutInProgress returns true if the Deployment has not finished rolling out its latest revision.
+func rolloutInProgress(d *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+	return d.Status.ObservedGeneration < d.Generation ||
+		d.Status.UpdatedReplicas < replicas ||
+		d.Status.Replicas > d.Status.UpdatedReplicas
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func revisionNumber(revision string) int64 {
+	n, err := strconv.ParseInt(revision, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// scrape reads the typha connection metrics from the Prometheus endpoint at the given address.
+func (c *typhaMetricsClient) scrape(ctx context.Context, addr string) (typhaMetrics, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s://%s/metrics", c.scheme, addr), nil)
+	if err != nil {
+		return typhaMetrics{}, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return typhaMetrics{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return typhaMetrics{}, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var m typhaMetrics
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		var target *float64
+		switch fields[0] {
+		case "typha_connections_active":
+			target = &m.active
+		case "typha_connections_accepted":
+			target = &m.accepted
+		case "typha_connections_dropped":
+			target = &m.dropped
+		default:
+			continue
+		}
+		if v, err := strconv.ParseFloat(fields[1], 64); err == nil {
+			*target = v
+		}
+	}
+	return m, scanner.Err()
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installation
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	operator "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/controller/status"
+)
+
+var _ = Describe("Test typha upgrade monitor", func() {
+	var statusManager *status.MockStatus
+	var c *kfake.Clientset
+	var ctx context.Context
+	var typha *appsv1.Deployment
+	var metrics map[string]typhaMetrics
+	var rollout *operator.TyphaRolloutStatus
+
+	scraper := func(_ context.Context, addr string) (typhaMetrics, error) {
+		m, ok := metrics[addr]
+		if !ok {
+			return typhaMetrics{}, fmt.Errorf("no metrics for %s", addr)
+		}
+		return m, nil
+	}
+
+	createReplicaSet := func(hash, revision string) {
+		_, err := c.AppsV1().ReplicaSets("calico-system").Create(ctx, &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "calico-typha-" + hash,
+				Namespace:   "calico-system",
+				Labels:      map[string]string{"k8s-app": "calico-typha", "pod-template-hash": hash},
+				Annotations: map[string]string{"deployment.kubernetes.io/revision": revision},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(typha, appsv1.SchemeGroupVersion.WithKind("Deployment")),
+				},
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	createPod := func(name, hash, ip string) {
+		_, err := c.CoreV1().Pods("calico-system").Create(ctx, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "calico-system",
+				Labels:    map[string]string{"k8s-app": "calico-typha", "pod-template-hash": hash},
+			},
+			Status: corev1.PodStatus{
+				PodIP:      ip,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		statusManager = new(status.MockStatus)
+		statusManager.On("SetTyphaRollout", mock.Anything).Run(func(args mock.Arguments) {
+			rollout, _ = args.Get(0).(*operator.TyphaRolloutStatus)
+		}).Return()
+		rollout = nil
+		metrics = map[string]typhaMetrics{}
+
+		c = kfake.NewClientset()
+		var err error
+		typha, err = c.AppsV1().Deployments("calico-system").Create(ctx, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "calico-typha",
+				Namespace:  "calico-system",
+				UID:        types.UID("typha-uid"),
+				Generation: 2,
+			},
+			Spec: appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: 2,
+				Replicas:           3,
+				UpdatedReplicas:    1,
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should clear the rollout status when typha is not rolling out", func() {
+		typha.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2}
+		_, err := c.AppsV1().Deployments("calico-system").Update(ctx, typha, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		statusManager.On("ClearWarning", typhaRolloutPausedWarningKey).Return()
+
+		t := newTyphaUpgradeMonitor(c, statusManager, typhaUpgradeMonitorOptionScraper(scraper))
+		Expect(t.sync(ctx)).To(Succeed())
+		statusManager.AssertCalled(GinkgoT(), "SetTyphaRollout", (*operator.TyphaRolloutStatus)(nil))
+	})
+
+	It("should report the ready replicas and connected clients of each revision", func() {
+		createReplicaSet("old", "1")
+		createReplicaSet("new", "2")
+		createPod("typha-a", "old", "10.0.0.1")
+		createPod("typha-b", "old", "10.0.0.2")
+		createPod("typha-c", "new", "10.0.0.3")
+		metrics["10.0.0.1:9093"] = typhaMetrics{active: 40}
+		metrics["10.0.0.2:9093"] = typhaMetrics{active: 50}
+		metrics["10.0.0.3:9093"] = typhaMetrics{active: 10}
+
+		t := newTyphaUpgradeMonitor(c, statusManager, typhaUpgradeMonitorOptionScraper(scraper))
+		Expect(t.configure(ptr.To(int32(9093)), nil, nil, false)).To(Succeed())
+		Expect(t.sync(ctx)).To(Succeed())
+
+		Expect(rollout).To(Equal(&operator.TyphaRolloutStatus{
+			Revisions: []operator.TyphaRevisionStatus{
+				{Revision: "1", ReadyReplicas: 2, ConnectedClients: ptr.To(int64(90))},
+				{Revision: "2", ReadyReplicas: 1, ConnectedClients: ptr.To(int64(10))},
+			},
+		}))
+	})
+
+	It("should not report connected clients when typha metrics are disabled", func() {
+		createReplicaSet("new", "2")
+		createPod("typha-c", "new", "10.0.0.3")
+
+		t := newTyphaUpgradeMonitor(c, statusManager, typhaUpgradeMonitorOptionScraper(scraper))
+		Expect(t.sync(ctx)).To(Succeed())
+
+		Expect(rollout).To(Equal(&operator.TyphaRolloutStatus{
+			Revisions: []operator.TyphaRevisionStatus{{Revision: "2", ReadyReplicas: 1}},
+		}))
+	})
+
+	It("should pause the rollout while up-level typhas drop too many connections", func() {
+		createReplicaSet("old", "1")
+		createReplicaSet("new", "2")
+		createPod("typha-a", "old", "10.0.0.1")
+		createPod("typha-c", "new", "10.0.0.3")
+		// Connections dropped by back-level typhas are expected while they shed load and must be ignored.
+		metrics["10.0.0.1:9093"] = typhaMetrics{active: 10, accepted: 100, dropped: 90}
+		metrics["10.0.0.3:9093"] = typhaMetrics{active: 10, accepted: 100, dropped: 0}
+		statusManager.On("SetWarning", typhaRolloutPausedWarningKey, mock.Anything).Return()
+		statusManager.On("ClearWarning", typhaRolloutPausedWarningKey).Return()
+
+		t := newTyphaUpgradeMonitor(c, statusManager, typhaUpgradeMonitorOptionScraper(scraper))
+		Expect(t.configure(ptr.To(int32(9093)), ptr.To(int32(10)), nil, false)).To(Succeed())
+
+		By("taking a baseline sample")
+		Expect(t.sync(ctx)).To(Succeed())
+		Expect(t.isPaused()).To(BeFalse())
+
+		By("pausing once the drop rate exceeds the threshold")
+		metrics["10.0.0.1:9093"] = typhaMetrics{active: 5, accepted: 100, dropped: 95}
+		metrics["10.0.0.3:9093"] = typhaMetrics{active: 12, accepted: 120, dropped: 5}
+		Expect(t.sync(ctx)).To(Succeed())
+		Expect(t.isPaused()).To(BeTrue())
+		Expect(rollout.Paused).To(BeTrue())
+		d, err := c.AppsV1().Deployments("calico-system").Get(ctx, "calico-typha", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Spec.Paused).To(BeTrue())
+		statusManager.AssertCalled(GinkgoT(), "SetWarning", typhaRolloutPausedWarningKey, mock.Anything)
+
+		By("resuming once the drop rate falls back below the threshold")
+		metrics["10.0.0.3:9093"] = typhaMetrics{active: 20, accepted: 140, dropped: 5}
+		Expect(t.sync(ctx)).To(Succeed())
+		Expect(t.isPaused()).To(BeFalse())
+		d, err = c.AppsV1().Deployments("calico-system").Get(ctx, "calico-typha", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Spec.Paused).To(BeFalse())
+
+		By("pausing when up-level typhas drop connections without accepting any")
+		metrics["10.0.0.3:9093"] = typhaMetrics{active: 20, accepted: 140, dropped: 8}
+		Expect(t.sync(ctx)).To(Succeed())
+		Expect(t.isPaused()).To(BeTrue())
+	})
+
+	It("should scrape typha connection metrics", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/metrics"))
+			_, _ = fmt.Fprint(w, strings.Join([]string{
+				"# HELP typha_connections_active Number of open client connections.",
+				"# TYPE typha_connections_active gauge",
+				"typha_connections_active 12",
+				"typha_connections_accepted 30",
+				"typha_connections_dropped 4",
+				"typha_log_errors 1",
+			}, "\n"))
+		}))
+		defer server.Close()
+
+		mc, err := newTyphaMetricsClient(nil, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+		m, err := mc.scrape(ctx, strings.TrimPrefix(server.URL, "http://"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m).To(Equal(typhaMetrics{active: 12, accepted: 30, dropped: 4}))
+	})
+
+	It("should scrape typha connection metrics over TLS", func() {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, "typha_connections_active 3\n")
+		}))
+		defer server.Close()
+		caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		addr := strings.TrimPrefix(server.URL, "https://")
+
+		By("rejecting the serving certificate without the CA")
+		mc, err := newTyphaMetricsClient(nil, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = mc.scrape(ctx, addr)
+		Expect(err).To(HaveOccurred())
+
+		By("verifying the serving certificate with the CA")
+		mc, err = newTyphaMetricsClient(caPEM, "example.com", nil)
+		Expect(err).NotTo(HaveOccurred())
+		m, err := mc.scrape(ctx, addr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m).To(Equal(typhaMetrics{active: 3}))
+	})
+
+	It("should authenticate to the typha metrics with a bearer token", func() {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer operator-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = fmt.Fprint(w, "typha_connections_active 3\n")
+		}))
+		defer server.Close()
+		caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		addr := strings.TrimPrefix(server.URL, "https://")
+
+		mc, err := newTyphaMetricsClient(caPEM, "example.com", nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = mc.scrape(ctx, addr)
+		Expect(err).To(MatchError(ContainSubstring("401")))
+
+		mc, err = newTyphaMetricsClient(caPEM, "example.com", &bearerToken{token: "operator-token"})
+		Expect(err).NotTo(HaveOccurred())
+		m, err := mc.scrape(ctx, addr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m).To(Equal(typhaMetrics{active: 3}))
+	})
+})
@@ -15,7 +15,6 @@
 package installation
 
 import (
-	"errors"
 	"fmt"
 	"net"
 	"path"
@@ -23,17 +22,11 @@ import (
 
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
-	"github.com/tigera/operator/pkg/common/validation"
-	node "github.com/tigera/operator/pkg/common/validation/calico-node"
-	csinodedriver "github.com/tigera/operator/pkg/common/validation/csi-node-driver"
-	kubecontrollers "github.com/tigera/operator/pkg/common/validation/kube-controllers"
-	typha "github.com/tigera/operator/pkg/common/validation/typha"
+	"github.com/tigera/operator/pkg/common/validation/resources"
 	"github.com/tigera/operator/pkg/controller/k8sapi"
 	"github.com/tigera/operator/pkg/controller/utils"
 	"github.com/tigera/operator/pkg/render"
-	rcc "github.com/tigera/operator/pkg/render/common/components"
 	appsv1 "k8s.io/api/apps/v1"
-	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
 
@@ -314,75 +307,9 @@ func validateCustomResource(instance *operatorv1.Installation) error {
 			instance.Spec.NodeUpdateStrategy.RollingUpdate)
 	}
 
-	if instance.Spec.ControlPlaneNodeSelector != nil {
-		if v, ok := instance.Spec.ControlPlaneNodeSelector["beta.kubernetes.io/os"]; ok && v != "linux" {
-			return fmt.Errorf("installation spec.ControlPlaneNodeSelector 'beta.kubernetes.io/os=%s' is not supported", v)
-		}
-		if v, ok := instance.Spec.ControlPlaneNodeSelector["kubernetes.io/os"]; ok && v != "linux" {
-			return fmt.Errorf("installation spec.ControlPlaneNodeSelector 'kubernetes.io/os=%s' is not supported", v)
-		}
-	}
-
-	if instance.Spec.ControlPlaneReplicas != nil && *instance.Spec.ControlPlaneReplicas <= 0 {
-		return fmt.Errorf("installation spec.ControlPlaneReplicas should be greater than 0")
-	}
-
-	validComponentNames := map[operatorv1.ComponentName]struct{}{
-		operatorv1.ComponentNameKubeControllers: {},
-		operatorv1.ComponentNameNode:            {},
-		operatorv1.ComponentNameTypha:           {},
-	}
-
-	for _, resource := range instance.Spec.ComponentResources {
-		if _, ok := validComponentNames[resource.ComponentName]; !ok {
-			return fmt.Errorf("installation spec.ComponentResources.ComponentName %s is not supported", resource.ComponentName)
-		}
-	}
-
-	// Verify that non-privileged mode is not Enabled, since it's been deprecated.
-	if instance.Spec.NonPrivileged != nil && *instance.Spec.NonPrivileged == operatorv1.NonPrivilegedEnabled {
-		return fmt.Errorf("non-privileged Calico is deprecated and cannot be Enabled; please, remove this field from your installation spec")
-	}
-
-	// Verify the CalicoNodeDaemonSet overrides, if specified, is valid.
-	if ds := instance.Spec.CalicoNodeDaemonSet; ds != nil {
-		err := validation.ValidateReplicatedPodResourceOverrides(ds, node.ValidateCalicoNodeDaemonSetContainer, node.ValidateCalicoNodeDaemonSetInitContainer)
-		err2 := validateExclusiveInitContainers(rcc.GetInitContainers(ds))
-		if err != nil || err2 != nil {
-			return fmt.Errorf("installation spec.CalicoNodeDaemonSet is not valid: %w", errors.Join(err, err2))
-		}
-	}
-
-	// Verify the CalicoNodeWindowsDaemonSet overrides, if specified, is valid.
-	if ds := instance.Spec.CalicoNodeWindowsDaemonSet; ds != nil {
-		err := validation.ValidateReplicatedPodResourceOverrides(ds, node.ValidateCalicoNodeWindowsDaemonSetContainer, node.ValidateCalicoNodeWindowsDaemonSetInitContainer)
-		if err != nil {
-			return fmt.Errorf("installation spec.CalicoNodeWindowsDaemonSet is not valid: %w", err)
-		}
-	}
-
-	// Verify the CalicoKubeControllersDeployment overrides, if specified, is valid.
-	if deploy := instance.Spec.CalicoKubeControllersDeployment; deploy != nil {
-		err := validation.ValidateReplicatedPodResourceOverrides(deploy, kubecontrollers.ValidateCalicoKubeControllersDeploymentContainer, validation.NoContainersDefined)
-		if err != nil {
-			return fmt.Errorf("installation spec.CalicoKubeControllersDeployment is not valid: %w", err)
-		}
-	}
-
-	// Verify the TyphaDeployment overrides, if specified, is valid.
-	if deploy := instance.Spec.TyphaDeployment; deploy != nil {
-		err := validation.ValidateReplicatedPodResourceOverrides(deploy, typha.ValidateTyphaDeploymentContainer, typha.ValidateTyphaDeploymentInitContainer)
-		if err != nil {
-			return fmt.Errorf("installation spec.TyphaDeployment is not valid: %w", err)
-		}
-	}
-
-	// Verify the CSINodeDriverDaemonSet overrides, if specified, is valid.
-	if ds := instance.Spec.CSINodeDriverDaemonSet; ds != nil {
-		err := validation.ValidateReplicatedPodResourceOverrides(ds, csinodedriver.ValidateCSINodeDriverDaemonSetContainer, validation.NoContainersDefined)
-		if err != nil {
-			return fmt.Errorf("installation spec.CSINodeDriverDaemonSet is not valid: %w", err)
-		}
+	// Verify the parts of the spec that do not depend on defaulting.
+	if err := resources.ValidateInstallation(instance); err != nil {
+		return err
 	}
 
 	// Verify CNILogging to not exist for non-calico cni
@@ -414,31 +341,19 @@ func validateCustomResource(instance *operatorv1.Installation) error {
 		}
 	}
 
-	if operatorv1.IsFIPSModeEnabled(instance.Spec.FIPSMode) && instance.Spec.Variant.IsEnterprise() {
-		return fmt.Errorf("installation spec.FIPSMode=%v combined with spec.Variant=%s is not supported", *instance.Spec.FIPSMode, instance.Spec.Variant)
+	// The Windows components of Enterprise have no FIPS images.
+	if operatorv1.IsFIPSModeEnabled(instance.Spec.FIPSMode) && instance.Spec.Variant.IsEnterprise() && common.WindowsEnabled(instance.Spec) {
+		return fmt.Errorf("installation spec.FIPSMode=%v combined with spec.Variant=%s is not supported when Calico for Windows is enabled", *instance.Spec.FIPSMode, instance.Spec.Variant)
 	}
 
 	if instance.Spec.KubernetesProvider != operatorv1.ProviderAKS && instance.Spec.Azure != nil {
 		return fmt.Errorf("installation spec.Azure should be set only for AKS provider")
 	}
 
-	return nil
-}
-
-// validateExclusiveInitContainers checks that the init containers do not contain both mount-bpffs and ebpf-bootstrap.
-func validateExclusiveInitContainers(initContainers []v1.Container) error {
-	hasMountBpffs, hasEbpfBootstrap := false, false
-	for _, c := range initContainers {
-		switch c.Name {
-		case "mount-bpffs":
-			hasMountBpffs = true
-		case "ebpf-bootstrap":
-			hasEbpfBootstrap = true
-		}
-		if hasMountBpffs && hasEbpfBootstrap {
-			return fmt.Errorf("init container names mount-bpffs and ebpf-bootstrap are mutually exclusive, please remove one of them")
-		}
+	if m := instance.Spec.ComponentPolicyMode; m != nil && *m == operatorv1.ComponentPolicyModeStaged && !instance.Spec.Variant.IsEnterprise() {
+		return fmt.Errorf("installation spec.ComponentPolicyMode %s is only supported by the %s variant", *m, operatorv1.CalicoEnterprise)
 	}
+
 	return nil
 }
 
@@ -16,10 +16,13 @@ package installation
 
 import (
 	"path/filepath"
+	"time"
 
 	"github.com/tigera/operator/pkg/render"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -476,6 +479,28 @@ var _ = Describe("Installation validation tests", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	DescribeTable("validate Spec.CertificateRotation", func(duration, renewBefore time.Duration, valid bool) {
+		instance.Spec.CertificateRotation = &operator.CertificateRotation{}
+		if duration != 0 {
+			instance.Spec.CertificateRotation.CertificateDuration = &metav1.Duration{Duration: duration}
+		}
+		if renewBefore != 0 {
+			instance.Spec.CertificateRotation.RenewBefore = &metav1.Duration{Duration: renewBefore}
+		}
+		err := validateCustomResource(instance)
+		if valid {
+			Expect(err).NotTo(HaveOccurred())
+		} else {
+			Expect(err).To(HaveOccurred())
+		}
+	},
+		Entry("empty", time.Duration(0), time.Duration(0), true),
+		Entry("duration and renewal window set", 90*24*time.Hour, 7*24*time.Hour, true),
+		Entry("duration shorter than the default renewal window", 7*24*time.Hour, time.Duration(0), false),
+		Entry("renewal window longer than the duration", 48*time.Hour, 72*time.Hour, false),
+		Entry("negative duration", -time.Hour, time.Duration(0), false),
+	)
+
 	Describe("validate Calico CNI plugin Type", func() {
 		DescribeTable("test invalid IPAM",
 			func(ipam operator.IPAMPluginType) {
@@ -1060,6 +1085,131 @@ var _ = Describe("Installation validation tests", func() {
 			err = validateCustomResource(instance)
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should validate the rollout settings", func() {
+			instance.Spec.TyphaDeployment = &operator.TyphaDeployment{
+				Spec: &operator.TyphaDeploymentSpec{
+					Strategy: &operator.TyphaDeploymentStrategy{
+						RollingUpdate: &appsv1.RollingUpdateDeployment{
+							MaxSurge:       ptr.To(intstr.FromInt(0)),
+							MaxUnavailable: ptr.To(intstr.FromString("25%")),
+						},
+					},
+					Template: &operator.TyphaDeploymentPodTemplateSpec{
+						Spec: &operator.TyphaDeploymentPodSpec{
+							TerminationGracePeriodSeconds: ptr.To(int64(600)),
+						},
+					},
+				},
+			}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+
+			instance.Spec.TyphaDeployment.Spec.Strategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromString("0%"))
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+
+			instance.Spec.TyphaDeployment.Spec.Strategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromString("lots"))
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+
+			instance.Spec.TyphaDeployment.Spec.Strategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromInt(1))
+			instance.Spec.TyphaDeployment.Spec.Template.Spec.TerminationGracePeriodSeconds = ptr.To(int64(-1))
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+		})
+
+		It("should validate the typha pools", func() {
+			instance.Spec.TyphaDeployment = &operator.TyphaDeployment{
+				Spec: &operator.TyphaDeploymentSpec{
+					Pools: []operator.TyphaDeploymentPool{
+						{Name: "infra", Replicas: ptr.To(int32(2))},
+						{Name: "general"},
+					},
+				},
+			}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+
+			instance.Spec.TyphaDeployment.Spec.Pools[1].Name = "infra"
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+
+			instance.Spec.TyphaDeployment.Spec.Pools[1].Name = "noncluster-host"
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+
+			instance.Spec.TyphaDeployment.Spec.Pools[1].Name = "Not_Valid"
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+		})
+	})
+	Describe("validate TyphaConfiguration", func() {
+		It("should require the typha metrics port to pause rollouts", func() {
+			instance.Spec.TyphaConfiguration = &operator.TyphaConfiguration{
+				RolloutPauseThresholdPercent: ptr.To(int32(10)),
+			}
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+
+			instance.Spec.TyphaMetricsPort = ptr.To(int32(9093))
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+	})
+	Describe("validate TyphaMetricsAuth", func() {
+		It("should require the typha metrics port and TLS", func() {
+			instance.Spec.TyphaMetricsAuth = ptr.To(operator.MetricsAuthTokenReview)
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+
+			instance.Spec.TyphaMetricsPort = ptr.To(int32(9093))
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+
+			instance.Spec.TyphaMetricsTLS = ptr.To(operator.TyphaMetricsTLSDisabled)
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+
+			instance.Spec.TyphaMetricsTLS = nil
+			instance.Spec.FIPSMode = ptr.To(operator.FIPSModeEnabled)
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+		})
+
+		It("should not let the upstream port clash with the other host ports", func() {
+			instance.Spec.TyphaMetricsUpstreamPort = ptr.To(int32(9196))
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+
+			instance.Spec.TyphaMetricsPort = ptr.To(int32(9093))
+			instance.Spec.TyphaMetricsAuth = ptr.To(operator.MetricsAuthTokenReview)
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+
+			for _, port := range []int32{9093, 5473} {
+				instance.Spec.TyphaMetricsUpstreamPort = ptr.To(port)
+				Expect(validateCustomResource(instance)).To(HaveOccurred())
+			}
+
+			instance.Spec.TyphaMetricsUpstreamPort = nil
+			instance.Spec.NodeMetricsPort = ptr.To(int32(9096))
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+		})
+	})
+	Describe("validate MetricsRemoteWrite", func() {
+		It("should require a metrics port", func() {
+			instance.Spec.MetricsRemoteWrite = &operator.MetricsRemoteWrite{URL: "https://metrics.example.com/api/v1/write"}
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+
+			instance.Spec.NodeMetricsPort = ptr.To(int32(9091))
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+		It("should reject an invalid URL or scrape interval", func() {
+			instance.Spec.NodeMetricsPort = ptr.To(int32(9091))
+			instance.Spec.MetricsRemoteWrite = &operator.MetricsRemoteWrite{URL: "https://"}
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+
+			instance.Spec.MetricsRemoteWrite.URL = "https://metrics.example.com/api/v1/write"
+			instance.Spec.MetricsRemoteWrite.ScrapeInterval = &metav1.Duration{}
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+		})
+		It("should not allow both basic auth and a bearer token", func() {
+			instance.Spec.NodeMetricsPort = ptr.To(int32(9091))
+			instance.Spec.MetricsRemoteWrite = &operator.MetricsRemoteWrite{
+				URL:                   "https://metrics.example.com/api/v1/write",
+				BasicAuthSecretName:   "basic-auth",
+				BearerTokenSecretName: "token",
+			}
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
+
+			instance.Spec.MetricsRemoteWrite.BasicAuthSecretName = ""
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
 	})
 	Describe("validate Windows configuration", func() {
 		BeforeEach(func() {
@@ -1103,6 +1253,17 @@ var _ = Describe("Installation validation tests", func() {
 				}
 			})
 
+			It("should return an error if FIPSMode is enabled for Enterprise", func() {
+				instance.Spec.Variant = operator.CalicoEnterprise
+				instance.Spec.FIPSMode = ptr.To(operator.FIPSModeEnabled)
+				err := validateCustomResource(instance)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("installation spec.FIPSMode=Enabled combined with spec.Variant=CalicoEnterprise is not supported when Calico for Windows is enabled"))
+
+				instance.Spec.Variant = operator.Calico
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			})
+
 			It("should return an error if the k8s service endpoint configmap is not configured correctly", func() {
 				k8sapi.Endpoint = k8sapi.ServiceEndpoint{}
 				err := validateCustomResource(instance)
@@ -1261,7 +1422,7 @@ var _ = Describe("Installation validation tests", func() {
 		})
 	})
 	Describe("validate FIPSMode combined with Variant", func() {
-		DescribeTable("test that FIPSMode is not allowed in combination with Enterprise",
+		DescribeTable("test that FIPSMode is allowed in combination with Enterprise without Windows",
 			func(variant operator.ProductVariant, fipsMode operator.FIPSMode, expectErr bool) {
 				instance.Spec.Variant = variant
 				instance.Spec.FIPSMode = &fipsMode
@@ -1276,7 +1437,26 @@ var _ = Describe("Installation validation tests", func() {
 			Entry("Product: Calico FipsMode: Disabled", operator.Calico, operator.FIPSModeDisabled, false),
 			Entry("Product: Calico FipsMode: Enabled", operator.Calico, operator.FIPSModeEnabled, false),
 			Entry("Product: CalicoEnterprise FipsMode: Disabled", operator.CalicoEnterprise, operator.FIPSModeDisabled, false),
-			Entry("Product: CalicoEnterprise FipsMode: Enabled", operator.CalicoEnterprise, operator.FIPSModeEnabled, true),
+			Entry("Product: CalicoEnterprise FipsMode: Enabled", operator.CalicoEnterprise, operator.FIPSModeEnabled, false),
+		)
+	})
+	Describe("validate ComponentPolicyMode combined with Variant", func() {
+		DescribeTable("test that staged component policies are only allowed for Enterprise",
+			func(variant operator.ProductVariant, mode operator.ComponentPolicyMode, expectErr bool) {
+				instance.Spec.Variant = variant
+				instance.Spec.ComponentPolicyMode = &mode
+				err := validateCustomResource(instance)
+				if expectErr {
+					Expect(err).To(HaveOccurred())
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			},
+
+			Entry("Product: Calico Mode: Enforced", operator.Calico, operator.ComponentPolicyModeEnforced, false),
+			Entry("Product: Calico Mode: Staged", operator.Calico, operator.ComponentPolicyModeStaged, true),
+			Entry("Product: CalicoEnterprise Mode: Enforced", operator.CalicoEnterprise, operator.ComponentPolicyModeEnforced, false),
+			Entry("Product: CalicoEnterprise Mode: Staged", operator.CalicoEnterprise, operator.ComponentPolicyModeStaged, false),
 		)
 	})
 })
@@ -77,6 +77,10 @@ func AddWindowsController(mgr manager.Manager, opts options.ControllerOptions) e
 		return fmt.Errorf("tigera-windows-controller failed to watch primary resource: %w", err)
 	}
 
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("tigera-windows-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(c, InstallationName); err != nil {
 		return fmt.Errorf("tigera-windows-controller failed to watch calico Tigerastatus: %w", err)
@@ -185,7 +189,7 @@ type ReconcileWindows struct {
 
 // newWindowsReconciler returns a new reconcile.Reconciler
 func newWindowsReconciler(mgr manager.Manager, opts options.ControllerOptions) (*ReconcileWindows, error) {
-	statusManager := status.New(mgr.GetClient(), "calico-windows", opts.KubernetesVersion)
+	statusManager := status.New(mgr.GetClient(), "calico-windows", opts.KubernetesVersion, opts.EventRecorder)
 
 	r := &ReconcileWindows{
 		config:               mgr.GetConfig(),
@@ -229,7 +233,7 @@ func (r *ReconcileWindows) Reconcile(ctx context.Context, request reconcile.Requ
 	}
 
 	// Mark CR found so we can report converter problems via tigerastatus
-	r.status.OnCRFound()
+	r.status.OnCRFound(instance)
 	// FIXME: add logic to merge Installation status metadata
 
 	// FIXME: add logic to update Installation status conditions that doesn't conflict with
@@ -79,7 +79,7 @@ var _ = Describe("windows-controller installation tests", func() {
 			mockStatus.On("AddDaemonsets", mock.Anything).Return()
 			mockStatus.On("AddDeployments", mock.Anything).Return()
 			mockStatus.On("IsAvailable").Return(true)
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("ClearDegraded")
 			mockStatus.On("AddCertificateSigningRequests", mock.Anything)
 			mockStatus.On("ReadyToMonitor")
@@ -599,7 +599,7 @@ var _ = Describe("windows-controller installation tests", func() {
 					mockStatus.On("AddStatefulSets", mock.Anything).Return()
 					mockStatus.On("AddCronJobs", mock.Anything)
 					mockStatus.On("IsAvailable").Return(true)
-					mockStatus.On("OnCRFound").Return()
+					mockStatus.On("OnCRFound", mock.Anything).Return()
 					mockStatus.On("ClearDegraded")
 					mockStatus.On("AddCertificateSigningRequests", mock.Anything)
 					mockStatus.On("RemoveCertificateSigningRequests", mock.Anything)
@@ -123,6 +123,13 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 	if err = c.WatchObject(&operatorv1.ImageSet{}, eventHandler); err != nil {
 		return fmt.Errorf("intrusiondetection-controller failed to watch ImageSet: %w", err)
 	}
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("intrusiondetection-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("intrusiondetection-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	if err = utils.AddTigeraStatusWatch(c, tigeraStatusName); err != nil {
 		return fmt.Errorf("intrusiondetection-controller failed to watch intrusion-detection Tigerastatus: %w", err)
 	}
@@ -164,7 +171,7 @@ func newReconciler(mgr manager.Manager, opts options.ControllerOptions, licenseA
 	r := &ReconcileIntrusionDetection{
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
-		status:          status.New(mgr.GetClient(), tigeraStatusName, opts.KubernetesVersion),
+		status:          status.New(mgr.GetClient(), tigeraStatusName, opts.KubernetesVersion, opts.EventRecorder),
 		licenseAPIReady: licenseAPIReady,
 		dpiAPIReady:     dpiAPIReady,
 		tierWatchReady:  tierWatchReady,
@@ -241,7 +248,7 @@ func (r *ReconcileIntrusionDetection) Reconcile(ctx context.Context, request rec
 		// Error reading the object - requeue the request.
 		return reconcile.Result{}, err
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(instance)
 	reqLogger.V(2).Info("Loaded config", "config", instance)
 	// SetMetaData in the TigeraStatus such as observedGenerations.
 	defer r.status.SetMetaData(&instance.ObjectMeta)
@@ -460,14 +467,19 @@ func (r *ReconcileIntrusionDetection) Reconcile(ctx context.Context, request rec
 		ExternalElastic:              r.opts.ElasticExternal,
 		SyslogForwardingIsEnabled:    syslogForwardingIsEnabled(lc),
 	}
-	setUp := render.NewSetup(&render.SetUpConfiguration{
+	setUpCfg := &render.SetUpConfiguration{
 		OpenShift:       r.opts.DetectedProvider.IsOpenShift(),
 		Installation:    installationSpec,
 		PullSecrets:     pullSecrets,
 		Namespace:       helper.InstallNamespace(),
 		PSS:             getPSS(lc),
 		CreateNamespace: !tenant.MultiTenant(),
-	})
+	}
+	if _, err := setUpCfg.PodSecurityStandard(); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Failed to resolve the pod security standard of the namespace", err, reqLogger)
+		return reconcile.Result{}, nil
+	}
+	setUp := render.NewSetup(setUpCfg)
 	intrusionDetectionComponent := render.IntrusionDetection(intrusionDetectionCfg)
 
 	if err = imageset.ApplyImageSet(ctx, r.client, variant, intrusionDetectionComponent); err != nil {
@@ -624,12 +636,14 @@ func syslogForwardingIsEnabled(logCollector *operatorv1.LogCollector) bool {
 	if logCollector != nil && logCollector.Spec.AdditionalStores != nil {
 		syslog := logCollector.Spec.AdditionalStores.Syslog
 		if syslog != nil {
-			if syslog.LogTypes != nil {
-				for _, t := range syslog.LogTypes {
-					switch t {
-					case operatorv1.SyslogLogIDSEvents:
-						return true
-					}
+			var logTypes []operatorv1.SyslogLogType
+			for _, d := range syslog.GetDestinations() {
+				logTypes = append(logTypes, d.LogTypes...)
+			}
+			for _, t := range logTypes {
+				switch t {
+				case operatorv1.SyslogLogIDSEvents:
+					return true
 				}
 			}
 		}
@@ -87,7 +87,7 @@ var _ = Describe("IntrusionDetection controller tests", func() {
 		mockStatus.On("AddStatefulSets", mock.Anything).Return()
 		mockStatus.On("AddCronJobs", mock.Anything)
 		mockStatus.On("IsAvailable").Return(true)
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("ClearDegraded")
 		mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 		mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -316,7 +316,7 @@ var _ = Describe("IntrusionDetection controller tests", func() {
 
 		BeforeEach(func() {
 			mockStatus = &status.MockStatus{}
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("SetMetaData", mock.Anything).Return()
 
 			readyFlag = &utils.ReadyFlag{}
@@ -719,7 +719,7 @@ var _ = Describe("IntrusionDetection controller tests", func() {
 
 		BeforeEach(func() {
 			mockStatus = &status.MockStatus{}
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("SetMetaData", mock.Anything).Return()
 
 			// Update the reconciler to run in external ES mode for these tests.
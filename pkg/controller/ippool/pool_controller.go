@@ -64,7 +64,7 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		watches:              make(map[runtime.Object]struct{}),
 		autoDetectedProvider: opts.DetectedProvider,
 		opts:                 opts,
-		status:               status.New(mgr.GetClient(), tigeraStatusName, opts.KubernetesVersion),
+		status:               status.New(mgr.GetClient(), tigeraStatusName, opts.KubernetesVersion, opts.EventRecorder),
 	}
 	r.status.Run(opts.ShutdownContext)
 
@@ -165,7 +165,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		reqLogger.Error(err, "An error occurred when querying the Installation resource")
 		return reconcile.Result{}, err
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(installation)
 	defer r.status.SetMetaData(&installation.ObjectMeta)
 
 	// If the installation is terminating, do nothing.
@@ -100,7 +100,7 @@ var _ = Describe("IP Pool controller tests", func() {
 		Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
 
 		// Set up expected mocks.
-		mockStatus.On("OnCRFound")
+		mockStatus.On("OnCRFound", mock.Anything)
 		mockStatus.On("SetDegraded", operator.ResourceNotReady, "Waiting for Installation defaulting to occur", nil, mock.Anything)
 		mockStatus.On("SetMetaData", mock.Anything)
 
@@ -127,7 +127,7 @@ var _ = Describe("IP Pool controller tests", func() {
 		Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
 
 		// Set up expected mocks.
-		mockStatus.On("OnCRFound")
+		mockStatus.On("OnCRFound", mock.Anything)
 		mockStatus.On("SetMetaData", mock.Anything)
 		mockStatus.On("IsAvailable").Return(true)
 		mockStatus.On("ReadyToMonitor")
@@ -180,7 +180,7 @@ var _ = Describe("IP Pool controller tests", func() {
 		Expect(c.Create(ctx, &ipPool)).ShouldNot(HaveOccurred())
 
 		// Set up expected mocks.
-		mockStatus.On("OnCRFound")
+		mockStatus.On("OnCRFound", mock.Anything)
 		mockStatus.On("SetMetaData", mock.Anything)
 		mockStatus.On("IsAvailable").Return(true)
 		mockStatus.On("ReadyToMonitor")
@@ -231,7 +231,7 @@ var _ = Describe("IP Pool controller tests", func() {
 		Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
 
 		// Set up expected mocks.
-		mockStatus.On("OnCRFound")
+		mockStatus.On("OnCRFound", mock.Anything)
 		mockStatus.On("SetMetaData", mock.Anything)
 		mockStatus.On("IsAvailable").Return(true)
 		mockStatus.On("ReadyToMonitor")
@@ -281,7 +281,7 @@ var _ = Describe("IP Pool controller tests", func() {
 		Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
 
 		// Set up expected mocks.
-		mockStatus.On("OnCRFound")
+		mockStatus.On("OnCRFound", mock.Anything)
 		mockStatus.On("SetMetaData", mock.Anything)
 		mockStatus.On("IsAvailable").Return(true)
 		mockStatus.On("ReadyToMonitor")
@@ -327,7 +327,7 @@ var _ = Describe("IP Pool controller tests", func() {
 		Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
 
 		// Set up expected mocks.
-		mockStatus.On("OnCRFound")
+		mockStatus.On("OnCRFound", mock.Anything)
 		mockStatus.On("SetMetaData", mock.Anything)
 		mockStatus.On("IsAvailable").Return(true)
 		mockStatus.On("ReadyToMonitor")
@@ -103,7 +103,7 @@ func newReconciler(mgr manager.Manager, opts options.ControllerOptions) *Reconci
 	r := &ReconcileIstio{
 		Client:   mgr.GetClient(),
 		scheme:   mgr.GetScheme(),
-		status:   status.New(mgr.GetClient(), "istio", opts.KubernetesVersion),
+		status:   status.New(mgr.GetClient(), "istio", opts.KubernetesVersion, opts.EventRecorder),
 		provider: opts.DetectedProvider,
 	}
 
@@ -144,7 +144,7 @@ func (r *ReconcileIstio) Reconcile(ctx context.Context, request reconcile.Reques
 		return res, err
 	}
 
-	r.status.OnCRFound()
+	r.status.OnCRFound(instance)
 
 	// SetMetaData in the TigeraStatus such as observedGenerations.
 	defer r.status.SetMetaData(&instance.ObjectMeta)
@@ -79,7 +79,7 @@ var _ = Describe("Istio controller tests", func() {
 		mockStatus.On("AddStatefulSets", mock.Anything).Maybe().Return()
 		mockStatus.On("AddCronJobs", mock.Anything).Maybe()
 		mockStatus.On("IsAvailable").Maybe().Return(true)
-		mockStatus.On("OnCRFound").Maybe().Return()
+		mockStatus.On("OnCRFound", mock.Anything).Maybe().Return()
 		mockStatus.On("ClearDegraded").Maybe()
 		mockStatus.On("SetDegraded", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return()
 		mockStatus.On("ReadyToMonitor").Maybe()
@@ -138,7 +138,7 @@ var _ = Describe("Istio controller tests", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 
 			// Verify the status methods were called appropriately
-			mockStatus.AssertCalled(GinkgoT(), "OnCRFound")
+			mockStatus.AssertCalled(GinkgoT(), "OnCRFound", mock.Anything)
 			mockStatus.AssertCalled(GinkgoT(), "SetMetaData", mock.Anything)
 		})
 
@@ -155,7 +155,7 @@ var _ = Describe("Istio controller tests", func() {
 			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}})
 			Expect(err).ShouldNot(HaveOccurred())
 			// Verify OnCRFound was called since we have an Istio resource
-			mockStatus.AssertCalled(GinkgoT(), "OnCRFound")
+			mockStatus.AssertCalled(GinkgoT(), "OnCRFound", mock.Anything)
 		})
 
 		It("should handle missing Istio resource", func() {
@@ -192,7 +192,7 @@ var _ = Describe("Istio controller tests", func() {
 				Expect(err).ShouldNot(HaveOccurred())
 
 				// Verify that we got to the point where we found both CRs
-				mockStatus.AssertCalled(GinkgoT(), "OnCRFound")
+				mockStatus.AssertCalled(GinkgoT(), "OnCRFound", mock.Anything)
 				mockStatus.AssertCalled(GinkgoT(), "SetMetaData", mock.Anything)
 			})
 
@@ -266,7 +266,7 @@ var _ = Describe("Istio controller tests", func() {
 			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}})
 			Expect(err).ShouldNot(HaveOccurred())
 
-			mockStatus.AssertCalled(GinkgoT(), "OnCRFound")
+			mockStatus.AssertCalled(GinkgoT(), "OnCRFound", mock.Anything)
 			mockStatus.AssertCalled(GinkgoT(), "ReadyToMonitor")
 		})
 
@@ -47,7 +47,7 @@ var log = logf.Log.WithName(controllerName)
 // Add creates a new Reconciler Controller and adds it to the Manager. The Manager will set fields on the Controller
 // and start it when the Manager is started.
 func Add(mgr manager.Manager, opts options.ControllerOptions) error {
-	statusManager := status.New(mgr.GetClient(), ResourceName, opts.KubernetesVersion)
+	statusManager := status.New(mgr.GetClient(), ResourceName, opts.KubernetesVersion, opts.EventRecorder)
 	reconciler := newReconciler(mgr.GetClient(), mgr.GetScheme(), statusManager, opts.DetectedProvider, opts)
 
 	c, err := ctrlruntime.NewController(controllerName, mgr, controller.Options{Reconciler: reconciler})
@@ -131,7 +131,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	// Mark resource found so we can report problems via tigerastatus
-	r.status.OnCRFound()
+	r.status.OnCRFound(nil)
 
 	// Try to retrieve the kube-proxy DaemonSet.
 	kubeProxyDS := &appsv1.DaemonSet{}
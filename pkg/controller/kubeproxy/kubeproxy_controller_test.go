@@ -85,7 +85,7 @@ var _ = Describe("kube-proxy controller tests", func() {
 		}
 
 		mockStatus = &status.MockStatus{}
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("OnCRNotFound").Return()
 		mockStatus.On("ClearDegraded")
 		mockStatus.On("ReadyToMonitor")
@@ -17,25 +17,35 @@ package logcollector
 import (
 	"context"
 	"fmt"
+	"net"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/tigera/operator/pkg/dns"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
+	vpav1 "github.com/tigera/operator/pkg/apis/vpa/v1"
 	"github.com/tigera/operator/pkg/common"
+	fluentdvalidation "github.com/tigera/operator/pkg/common/validation/fluentd"
+	"github.com/tigera/operator/pkg/common/validation/resources"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
 	"github.com/tigera/operator/pkg/controller/options"
 	"github.com/tigera/operator/pkg/controller/status"
@@ -66,9 +76,12 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 
 	licenseAPIReady := &utils.ReadyFlag{}
 	tierWatchReady := &utils.ReadyFlag{}
+	logSourceWatchReady := &utils.ReadyFlag{}
+	logExportWatchReady := &utils.ReadyFlag{}
+	vpaWatchReady := &utils.ReadyFlag{}
 
 	// create the reconciler
-	reconciler := newReconciler(mgr, opts, licenseAPIReady, tierWatchReady)
+	reconciler := newReconciler(mgr, opts, licenseAPIReady, tierWatchReady, logSourceWatchReady, logExportWatchReady, vpaWatchReady)
 
 	// Create a new controller
 	c, err := ctrlruntime.NewController("logcollector-controller", mgr, controller.Options{Reconciler: reconcile.Reconciler(reconciler)})
@@ -82,24 +95,50 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		{Name: render.FluentdPolicyName, Namespace: render.LogCollectorNamespace},
 	})
 
+	// The LogSource CRD is installed by the core controller, so it may not exist yet when this controller starts.
+	go utils.WaitToAddResourceWatch(c, opts.K8sClientset, log, logSourceWatchReady, []client.Object{
+		&operatorv1.LogSource{TypeMeta: metav1.TypeMeta{Kind: "LogSource", APIVersion: operatorv1.GroupVersion.String()}},
+	})
+	go utils.WaitToAddResourceWatch(c, opts.K8sClientset, log, logExportWatchReady, []client.Object{
+		&operatorv1.LogExport{TypeMeta: metav1.TypeMeta{Kind: "LogExport", APIVersion: operatorv1.GroupVersion.String()}},
+	})
+
+	// Watch the fluentd VerticalPodAutoscaler. This watch can only be established once the VerticalPodAutoscaler
+	// CRDs are installed, so its readiness also tells us whether VerticalPodAutoscalers can be rendered.
+	go utils.WaitToAddResourceWatch(c, opts.K8sClientset, log, vpaWatchReady, []client.Object{
+		&vpav1.VerticalPodAutoscaler{
+			TypeMeta:   metav1.TypeMeta{Kind: vpav1.VerticalPodAutoscalerKind, APIVersion: vpav1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: render.FluentdNodeName, Namespace: render.LogCollectorNamespace},
+		},
+	})
+
 	if opts.MultiTenant {
 		if err = c.WatchObject(&operatorv1.Tenant{}, &handler.EnqueueRequestForObject{}); err != nil {
 			return fmt.Errorf("logcollector-controller failed to watch Tenant resource: %w", err)
 		}
+
+		// The tenant of the management cluster can provide its own fluentd filters in its namespace. That namespace
+		// is configured on the LogCollector, so watch the filters by name in all namespaces.
+		if err = utils.AddConfigMapWatch(c, render.FluentdFilterConfigMapName, "", &handler.EnqueueRequestForObject{}); err != nil {
+			return fmt.Errorf("logcollector-controller failed to watch tenant ConfigMap %s: %w", render.FluentdFilterConfigMapName, err)
+		}
 	}
 
 	return add(mgr, c)
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager, opts options.ControllerOptions, licenseAPIReady *utils.ReadyFlag, tierWatchReady *utils.ReadyFlag) reconcile.Reconciler {
+func newReconciler(mgr manager.Manager, opts options.ControllerOptions, licenseAPIReady *utils.ReadyFlag, tierWatchReady *utils.ReadyFlag, logSourceWatchReady *utils.ReadyFlag, logExportWatchReady *utils.ReadyFlag, vpaWatchReady *utils.ReadyFlag) reconcile.Reconciler {
 	c := &ReconcileLogCollector{
-		client:          mgr.GetClient(),
-		scheme:          mgr.GetScheme(),
-		status:          status.New(mgr.GetClient(), "log-collector", opts.KubernetesVersion),
-		licenseAPIReady: licenseAPIReady,
-		tierWatchReady:  tierWatchReady,
-		opts:            opts,
+		client:              mgr.GetClient(),
+		scheme:              mgr.GetScheme(),
+		status:              status.New(mgr.GetClient(), "log-collector", opts.KubernetesVersion, opts.EventRecorder),
+		licenseAPIReady:     licenseAPIReady,
+		tierWatchReady:      tierWatchReady,
+		logSourceWatchReady: logSourceWatchReady,
+		logExportWatchReady: logExportWatchReady,
+		vpaWatchReady:       vpaWatchReady,
+		opts:                opts,
 	}
 	c.status.Run(opts.ShutdownContext)
 	return c
@@ -130,8 +169,9 @@ func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 
 	for _, secretName := range []string{
 		render.ElasticsearchEksLogForwarderUserSecret,
-		render.S3FluentdSecretName, render.EksLogForwarderSecret,
-		render.SplunkFluentdTokenSecretName, monitor.PrometheusClientTLSSecretName,
+		render.S3FluentdSecretName, render.GCSFluentdSecretName, render.AzureBlobFluentdSecretName, render.EksLogForwarderSecret,
+		render.SplunkFluentdTokenSecretName, render.SyslogClientTLSSecretName, render.LokiFluentdCredentialSecretName, render.OTLPHeadersSecretName,
+		monitor.PrometheusClientTLSSecretName,
 		render.FluentdPrometheusTLSSecretName, render.TigeraLinseedSecret, render.VoltronLinseedPublicCert, render.EKSLogForwarderTLSSecretName,
 	} {
 		if err = utils.AddSecretsWatch(c, secretName, common.OperatorNamespace()); err != nil {
@@ -139,17 +179,36 @@ func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 		}
 	}
 
-	for _, configMapName := range []string{render.FluentdFilterConfigMapName, relasticsearch.ClusterConfigConfigMapName} {
+	// The credentials of S3 destinations may be in secrets with arbitrary names, so watch all the secrets in the
+	// operator namespace for them.
+	if err = utils.AddSecretsWatch(c, "", common.OperatorNamespace()); err != nil {
+		return fmt.Errorf("log-collector-controller failed to watch secrets: %v", err)
+	}
+
+	for _, configMapName := range []string{render.FluentdFilterConfigMapName, render.FluentdAdditionalOutputsConfigMapName, relasticsearch.ClusterConfigConfigMapName, render.LokiCAConfigMapName, render.OTLPCAConfigMapName} {
 		if err = utils.AddConfigMapWatch(c, configMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
 			return fmt.Errorf("logcollector-controller failed to watch ConfigMap %s: %v", configMapName, err)
 		}
 	}
 
+	// The CA certificates of Syslog destinations may be in ConfigMaps with arbitrary names, so watch all the
+	// ConfigMaps in the operator namespace for them.
+	if err = utils.AddConfigMapWatch(c, "", common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("logcollector-controller failed to watch ConfigMaps: %v", err)
+	}
+
 	err = c.WatchObject(&corev1.Node{}, &handler.EnqueueRequestForObject{})
 	if err != nil {
 		return fmt.Errorf("logcollector-controller failed to watch the node resource: %w", err)
 	}
 
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("logcollector-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("logcollector-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("logcollector-controller failed to watch log-collector Tigerastatus: %w", err)
@@ -158,6 +217,16 @@ func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 	if err = c.WatchObject(&operatorv1.NonClusterHost{}, &handler.EnqueueRequestForObject{}); err != nil {
 		return fmt.Errorf("logcollector-controller failed to watch resource: %w", err)
 	}
+
+	// Watch the log pipeline canary and LogExport Jobs, including their status, so that the PipelineHealthy and
+	// LogExport Succeeded conditions follow the result of each run.
+	err = c.WatchObject(&batchv1.Job{}, &handler.EnqueueRequestForObject{}, predicate.NewPredicateFuncs(func(object client.Object) bool {
+		app := object.GetLabels()["k8s-app"]
+		return object.GetNamespace() == render.LogCollectorNamespace && (app == render.FluentdPipelineCanaryName || app == render.LogExportName)
+	}))
+	if err != nil {
+		return fmt.Errorf("logcollector-controller failed to watch the log pipeline canary and LogExport Jobs: %w", err)
+	}
 	return nil
 }
 
@@ -166,12 +235,15 @@ var _ reconcile.Reconciler = &ReconcileLogCollector{}
 
 // ReconcileLogCollector reconciles a LogCollector object
 type ReconcileLogCollector struct {
-	client          client.Client
-	scheme          *runtime.Scheme
-	status          status.StatusManager
-	licenseAPIReady *utils.ReadyFlag
-	tierWatchReady  *utils.ReadyFlag
-	opts            options.ControllerOptions
+	client              client.Client
+	scheme              *runtime.Scheme
+	status              status.StatusManager
+	licenseAPIReady     *utils.ReadyFlag
+	tierWatchReady      *utils.ReadyFlag
+	logSourceWatchReady *utils.ReadyFlag
+	logExportWatchReady *utils.ReadyFlag
+	vpaWatchReady       *utils.ReadyFlag
+	opts                options.ControllerOptions
 }
 
 // GetLogCollector returns the default LogCollector instance with defaults populated.
@@ -184,10 +256,12 @@ func GetLogCollector(ctx context.Context, cli client.Client) (*operatorv1.LogCol
 	}
 
 	if instance.Spec.AdditionalStores != nil {
-		if instance.Spec.AdditionalStores.Syslog != nil {
-			_, _, _, err := url.ParseEndpoint(instance.Spec.AdditionalStores.Syslog.Endpoint)
-			if err != nil {
-				return nil, fmt.Errorf("syslog config has invalid Endpoint: %s", err)
+		if syslog := instance.Spec.AdditionalStores.Syslog; syslog != nil {
+			if syslog.Endpoint != "" {
+				_, _, _, err := url.ParseEndpoint(syslog.Endpoint)
+				if err != nil {
+					return nil, fmt.Errorf("syslog config has invalid Endpoint: %s", err)
+				}
 			}
 		}
 	}
@@ -230,6 +304,22 @@ func fillDefaults(instance *operatorv1.LogCollector) []string {
 				// Include the field that was modified (in case we need to display error messages)
 				modifiedFields = append(modifiedFields, "AdditionalStores.Syslog.Encryption")
 			}
+			// The destinations get the same defaults as the Syslog store.
+			for i := range syslog.Destinations {
+				d := &syslog.Destinations[i]
+				if len(d.LogTypes) == 0 {
+					d.LogTypes = []operatorv1.SyslogLogType{
+						operatorv1.SyslogLogAudit,
+						operatorv1.SyslogLogDNS,
+						operatorv1.SyslogLogFlows,
+					}
+					modifiedFields = append(modifiedFields, fmt.Sprintf("AdditionalStores.Syslog.Destinations[%s].LogTypes", d.Name))
+				}
+				if len(d.Encryption) == 0 {
+					d.Encryption = operatorv1.EncryptionNone
+					modifiedFields = append(modifiedFields, fmt.Sprintf("AdditionalStores.Syslog.Destinations[%s].Encryption", d.Name))
+				}
+			}
 		}
 	}
 	return modifiedFields
@@ -256,7 +346,7 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		return reconcile.Result{}, err
 	}
 	reqLogger.V(2).Info("Loaded config", "config", instance)
-	r.status.OnCRFound()
+	r.status.OnCRFound(instance)
 
 	// SetMetaData in the TigeraStatus such as observedGenerations.
 	defer r.status.SetMetaData(&instance.ObjectMeta)
@@ -275,6 +365,13 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		}
 	}
 
+	// Leave the managed resources alone while reconciliation is paused, so that manual changes made for
+	// debugging are not reverted.
+	if common.ReconcilePaused(instance) {
+		reqLogger.Info("Reconciliation is paused", "annotation", common.ReconcileAnnotation)
+		return reconcile.Result{}, nil
+	}
+
 	// Default fields on the LogCollector instance if needed.
 	preDefaultPatchFrom := client.MergeFrom(instance.DeepCopy())
 	modifiedFields := fillDefaults(instance)
@@ -287,6 +384,12 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		}
 	}
 
+	// Verify the LogCollector spec, e.g. the FluentdDaemonSet overrides.
+	if err := resources.ValidateLogCollector(instance); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "LogCollector is invalid", err, reqLogger)
+		return reconcile.Result{}, nil
+	}
+
 	if !utils.IsProjectCalicoV3Available(r.client, r.opts, reqLogger) {
 		r.status.SetDegraded(operatorv1.ResourceNotReady, "Waiting for Tigera API server to be ready", nil, reqLogger)
 		return reconcile.Result{}, nil
@@ -370,8 +473,7 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 	}
 
 	// fluentdKeyPair is the key pair fluentd presents to identify itself
-	httpInputServiceNames := dns.GetServiceDNSNames(render.FluentdInputService, render.LogCollectorNamespace, r.opts.ClusterDomain)
-	fluentdKeyPair, err := certificateManager.GetOrCreateKeyPair(r.client, render.FluentdPrometheusTLSSecretName, common.OperatorNamespace(), append([]string{render.FluentdPrometheusTLSSecretName}, httpInputServiceNames...))
+	fluentdKeyPair, err := certificateManager.GetOrRequestKeyPair(r.client, render.FluentdPrometheusTLSSecretName, common.OperatorNamespace(), []string{render.FluentdPrometheusTLSSecretName})
 	if err != nil {
 		r.status.SetDegraded(operatorv1.ResourceCreateError, "Error creating TLS certificate", err, reqLogger)
 		return reconcile.Result{}, err
@@ -386,6 +488,12 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
 	}
 
+	// The secondary Linseed endpoint takes over from the management cluster that Guardian is connected to.
+	if instance.Spec.LinseedFailover != nil && !managedCluster {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "linseedFailover can only be set on managed clusters", nil, reqLogger)
+		return reconcile.Result{}, nil
+	}
+
 	// Determine whether or not this is a multi-tenant management cluster.
 	multiTenantManagement := r.opts.MultiTenant && managementCluster != nil
 	if instance.Spec.MultiTenantManagementClusterNamespace != "" && !multiTenantManagement {
@@ -458,8 +566,19 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 	}
 
 	var s3Credential *render.S3Credential
+	var s3DestinationCredentials map[string]*render.S3Credential
 	if instance.Spec.AdditionalStores != nil {
-		if instance.Spec.AdditionalStores.S3 != nil {
+		// Security Lake shares the S3 credentials, unless it authenticates with an IAM role for the service account.
+		securityLake := instance.Spec.AdditionalStores.SecurityLake
+		s3 := instance.Spec.AdditionalStores.S3
+		if s3 != nil {
+			s3DestinationCredentials, err = getS3DestinationCredentials(r.client, s3.Destinations)
+			if err != nil {
+				r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with S3 destination credential secret", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+		}
+		if needsDefaultS3Credential(s3) || (securityLake != nil && securityLake.RoleARN == "") {
 			s3Credential, err = getS3Credential(r.client)
 			if err != nil {
 				r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with S3 credential secret", err, reqLogger)
@@ -472,6 +591,36 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		}
 	}
 
+	var gcsCredential *render.GCSCredential
+	if instance.Spec.AdditionalStores != nil {
+		if instance.Spec.AdditionalStores.GCS != nil {
+			gcsCredential, err = getGCSCredential(r.client)
+			if err != nil {
+				r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with GCS credential secret", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+			if gcsCredential == nil {
+				r.status.SetDegraded(operatorv1.ResourceNotFound, "GCS credential secret does not exist", nil, reqLogger)
+				return reconcile.Result{}, nil
+			}
+		}
+	}
+
+	var azureBlobCredential *render.AzureBlobCredential
+	if instance.Spec.AdditionalStores != nil {
+		if instance.Spec.AdditionalStores.AzureBlob != nil {
+			azureBlobCredential, err = getAzureBlobCredential(r.client)
+			if err != nil {
+				r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with Azure Blob credential secret", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+			if azureBlobCredential == nil {
+				r.status.SetDegraded(operatorv1.ResourceNotFound, "Azure Blob credential secret does not exist", nil, reqLogger)
+				return reconcile.Result{}, nil
+			}
+		}
+	}
+
 	var splunkCredential *render.SplunkCredential
 	if instance.Spec.AdditionalStores != nil {
 		if instance.Spec.AdditionalStores.Splunk != nil {
@@ -487,9 +636,51 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		}
 	}
 
+	var lokiCredential *render.LokiCredential
+	if instance.Spec.AdditionalStores != nil {
+		if instance.Spec.AdditionalStores.Loki != nil {
+			lokiCredential, err = getLokiCredential(r.client)
+			if err != nil {
+				r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with Loki credential secret", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+
+			lokiCert, err := getLokiCertificate(r.client)
+			if err != nil {
+				r.status.SetDegraded(operatorv1.ResourceReadError, "Error loading Loki certificate", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+			if lokiCert != nil {
+				trustedBundle.AddCertificates(lokiCert)
+			}
+		}
+	}
+
+	var otlpHeaders *render.OTLPHeaders
+	if instance.Spec.AdditionalStores != nil {
+		if instance.Spec.AdditionalStores.OTLP != nil {
+			otlpHeaders, err = getOTLPHeaders(r.client)
+			if err != nil {
+				r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with OTLP headers secret", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+
+			otlpCert, err := getOTLPCertificate(r.client)
+			if err != nil {
+				r.status.SetDegraded(operatorv1.ResourceReadError, "Error loading OTLP certificate", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+			if otlpCert != nil {
+				trustedBundle.AddCertificates(otlpCert)
+			}
+		}
+	}
+
 	var useSyslogCertificate bool
+	var syslogClientCredential *render.SyslogClientCredential
+	var syslogDestinationCAs map[string]certificatemanagement.CertificateInterface
 	if instance.Spec.AdditionalStores != nil {
-		if instance.Spec.AdditionalStores.Syslog != nil && instance.Spec.AdditionalStores.Syslog.Encryption == operatorv1.EncryptionTLS {
+		if syslog := instance.Spec.AdditionalStores.Syslog; syslogTLSEnabled(syslog) {
 			syslogCert, err := getSysLogCertificate(r.client)
 			if err != nil {
 				r.status.SetDegraded(operatorv1.ResourceReadError, "Error loading Syslog certificate", err, reqLogger)
@@ -499,6 +690,18 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 				useSyslogCertificate = true
 				trustedBundle.AddCertificates(syslogCert)
 			}
+
+			syslogClientCredential, err = getSyslogClientCredential(r.client)
+			if err != nil {
+				r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with Syslog client certificate secret", err, reqLogger)
+				return reconcile.Result{}, err
+			}
+
+			syslogDestinationCAs, err = getSyslogDestinationCertificates(r.client, syslog.GetDestinations())
+			if err != nil {
+				r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with Syslog destination CA ConfigMap", err, reqLogger)
+				return reconcile.Result{}, err
+			}
 		}
 	}
 
@@ -506,30 +709,57 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		if instance.Spec.AdditionalStores.Syslog != nil {
 			syslog := instance.Spec.AdditionalStores.Syslog
 
-			// If the user set Syslog.logTypes, we need to ensure that they did not include
+			// If the user set Syslog.logTypes, or the logTypes of a destination, we need to ensure that they did not include
 			// the v1.SyslogLogIDSEvents option if this is a managed cluster (i.e.
 			// ManagementClusterConnection CR is present). This is because IDS events
 			// are only forwarded within a non-managed cluster (where LogStorage is present).
-			if syslog.LogTypes != nil {
-				if err == nil && managedCluster {
-					for _, l := range syslog.LogTypes {
-						// Set status to degraded to warn user and let them fix the issue themselves.
-						if l == operatorv1.SyslogLogIDSEvents {
-							r.status.SetDegraded(operatorv1.ResourceValidationError, "IDSEvents option is not supported for Syslog config in a managed cluster", nil, reqLogger)
-							return reconcile.Result{}, err
-						}
+			var logTypes []operatorv1.SyslogLogType
+			for _, d := range syslog.GetDestinations() {
+				logTypes = append(logTypes, d.LogTypes...)
+			}
+			if err == nil && managedCluster {
+				for _, l := range logTypes {
+					// Set status to degraded to warn user and let them fix the issue themselves.
+					if l == operatorv1.SyslogLogIDSEvents {
+						r.status.SetDegraded(operatorv1.ResourceValidationError, "IDSEvents option is not supported for Syslog config in a managed cluster", nil, reqLogger)
+						return reconcile.Result{}, err
 					}
 				}
 			}
 		}
 	}
 
-	filters, err := getFluentdFilters(r.client)
+	filters, err := getFluentdFilters(r.client, tenant)
 	if err != nil {
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving Fluentd filters", err, reqLogger)
 		return reconcile.Result{}, err
 	}
 
+	additionalOutputs, err := getFluentdAdditionalOutputs(r.client)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Error with Fluentd additional outputs ConfigMap", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	if !exportLogs && len(additionalOutputs) > 0 {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Feature is not active - License does not support feature: export-logs", nil, reqLogger)
+		return reconcile.Result{}, nil
+	}
+
+	logSources, err := r.acceptLogSources(ctx, instance)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error updating the status of LogSources", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	logExports, err := r.acceptLogExports(ctx, exportLogs)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error updating the status of LogExports", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	if logExports.defaultCredential != nil && s3Credential == nil {
+		s3Credential = logExports.defaultCredential
+	}
+
 	var eksConfig *render.EksCloudwatchLogConfig
 	var esClusterConfig *relasticsearch.ClusterConfig
 	var eksLogForwarderKeyPair certificatemanagement.KeyPairInterface
@@ -546,11 +776,7 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 					r.status.SetDegraded(operatorv1.ResourceReadError, "Failed to get the elasticsearch cluster configuration", err, reqLogger)
 					return reconcile.Result{}, err
 				}
-				eksConfig, err = getEksCloudwatchLogConfig(r.client,
-					instance.Spec.AdditionalSources.EksCloudwatchLog.FetchInterval,
-					instance.Spec.AdditionalSources.EksCloudwatchLog.Region,
-					instance.Spec.AdditionalSources.EksCloudwatchLog.GroupName,
-					instance.Spec.AdditionalSources.EksCloudwatchLog.StreamPrefix)
+				eksConfig, err = getEksCloudwatchLogConfig(r.client, instance.Spec.AdditionalSources.EksCloudwatchLog)
 				if err != nil {
 					r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving EKS Cloudwatch Logs configuration", err, reqLogger)
 					return reconcile.Result{}, err
@@ -583,32 +809,76 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 			r.status.SetDegraded(operatorv1.ResourceReadError, "Failed to read parse endpoint from NonClusterHost resource", err, reqLogger)
 			return reconcile.Result{}, err
 		}
+		for _, cidr := range nonclusterhost.Spec.SourceCIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				r.status.SetDegraded(operatorv1.ResourceValidationError, "Invalid source CIDR in NonClusterHost resource", err, reqLogger)
+				return reconcile.Result{}, nil
+			}
+		}
+	}
+
+	// inputKeyPair is the key pair the fluentd input service for non-cluster hosts serves with.
+	var inputKeyPair certificatemanagement.KeyPairInterface
+	if nonclusterhost != nil {
+		httpInputServiceNames := dns.GetServiceDNSNames(render.FluentdInputService, render.LogCollectorNamespace, r.opts.ClusterDomain)
+		inputKeyPair, err = certificateManager.GetOrRequestKeyPair(r.client, render.FluentdInputTLSSecretName, common.OperatorNamespace(), httpInputServiceNames)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceCreateError, "Error creating TLS certificate", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+	}
+
+	// forwardInputKeyPair is the key pair the forward input of the fluentd aggregator serves with.
+	var forwardInputKeyPair certificatemanagement.KeyPairInterface
+	if instance.Spec.GetCollectorType() == operatorv1.LogCollectorTypeAggregator {
+		forwardInputServiceNames := dns.GetServiceDNSNames(render.FluentdForwardInputService, render.LogCollectorNamespace, r.opts.ClusterDomain)
+		forwardInputKeyPair, err = certificateManager.GetOrRequestKeyPair(r.client, render.FluentdForwardInputTLSSecretName, common.OperatorNamespace(), forwardInputServiceNames)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceCreateError, "Error creating TLS certificate", err, reqLogger)
+			return reconcile.Result{}, err
+		}
 	}
 
 	// Create a component handler to manage the rendered component.
 	handler := utils.NewComponentHandler(log, r.client, r.scheme, instance)
 
 	fluentdCfg := &render.FluentdConfiguration{
-		LogCollector:           instance,
-		ESClusterConfig:        esClusterConfig,
-		S3Credential:           s3Credential,
-		SplkCredential:         splunkCredential,
-		Filters:                filters,
-		EKSConfig:              eksConfig,
-		PullSecrets:            pullSecrets,
-		Installation:           installationSpec,
-		ClusterDomain:          r.opts.ClusterDomain,
-		OSType:                 rmeta.OSTypeLinux,
-		FluentdKeyPair:         fluentdKeyPair,
-		TrustedBundle:          trustedBundle,
-		ManagedCluster:         managedCluster,
-		UseSyslogCertificate:   useSyslogCertificate,
-		Tenant:                 tenant,
-		ExternalElastic:        r.opts.ElasticExternal,
-		EKSLogForwarderKeyPair: eksLogForwarderKeyPair,
-		PacketCapture:          packetcaptureapi,
-		NonClusterHost:         nonclusterhost,
-		LicenseExpired:         licenseExpired,
+		LogCollector:             instance,
+		ESClusterConfig:          esClusterConfig,
+		S3Credential:             s3Credential,
+		S3DestinationCredentials: s3DestinationCredentials,
+		GCSCredential:            gcsCredential,
+		AzureBlobCredential:      azureBlobCredential,
+		SplkCredential:           splunkCredential,
+		LokiCredential:           lokiCredential,
+		OTLPHeaders:              otlpHeaders,
+		Filters:                  filters,
+		AdditionalOutputs:        additionalOutputs,
+		LogSources:               logSources,
+		LogExports:               logExports.accepted,
+		LogExportCredentials:     logExports.credentials,
+		StaleLogExports:          logExports.stale,
+		EKSConfig:                eksConfig,
+		PullSecrets:              pullSecrets,
+		Installation:             installationSpec,
+		ClusterDomain:            r.opts.ClusterDomain,
+		OSType:                   rmeta.OSTypeLinux,
+		FluentdKeyPair:           fluentdKeyPair,
+		InputKeyPair:             inputKeyPair,
+		ClientCA:                 certificateManager.KeyPair(),
+		ForwardInputKeyPair:      forwardInputKeyPair,
+		TrustedBundle:            trustedBundle,
+		ManagedCluster:           managedCluster,
+		UseSyslogCertificate:     useSyslogCertificate,
+		SyslogClientCredential:   syslogClientCredential,
+		SyslogDestinationCAs:     syslogDestinationCAs,
+		Tenant:                   tenant,
+		ExternalElastic:          r.opts.ElasticExternal,
+		EKSLogForwarderKeyPair:   eksLogForwarderKeyPair,
+		PacketCapture:            packetcaptureapi,
+		NonClusterHost:           nonclusterhost,
+		LicenseExpired:           licenseExpired,
+		VPACRDExists:             r.vpaWatchReady != nil && r.vpaWatchReady.IsReady(),
 	}
 	// Render the fluentd component for Linux
 	comp := render.Fluentd(fluentdCfg)
@@ -622,6 +892,13 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		TrustedBundle: trustedBundle,
 	}
 
+	if inputKeyPair != nil {
+		certificateComponent.KeyPairOptions = append(certificateComponent.KeyPairOptions, rcertificatemanagement.NewKeyPairOption(inputKeyPair, true, true))
+	}
+	if forwardInputKeyPair != nil {
+		certificateComponent.KeyPairOptions = append(certificateComponent.KeyPairOptions, rcertificatemanagement.NewKeyPairOption(forwardInputKeyPair, true, true))
+	}
+
 	if installationSpec.KubernetesProvider.IsEKS() {
 		if instance.Spec.AdditionalSources != nil {
 			if instance.Spec.AdditionalSources.EksCloudwatchLog != nil {
@@ -631,14 +908,19 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		}
 	}
 
-	setUp := render.NewSetup(&render.SetUpConfiguration{
+	setUpCfg := &render.SetUpConfiguration{
 		OpenShift:       r.opts.DetectedProvider.IsOpenShift(),
 		Installation:    installationSpec,
 		PullSecrets:     pullSecrets,
 		Namespace:       render.LogCollectorNamespace,
-		PSS:             render.PSSPrivileged,
+		PSS:             render.LogCollectorPodSecurityStandard,
 		CreateNamespace: true,
-	})
+	}
+	if _, err := setUpCfg.PodSecurityStandard(); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Failed to resolve the pod security standard of the namespace", err, reqLogger)
+		return reconcile.Result{}, nil
+	}
+	setUp := render.NewSetup(setUpCfg)
 	components := []render.Component{
 		setUp,
 		comp,
@@ -665,22 +947,34 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 
 	if hasWindowsNodes {
 		fluentdCfg = &render.FluentdConfiguration{
-			LogCollector:           instance,
-			ESClusterConfig:        esClusterConfig,
-			S3Credential:           s3Credential,
-			SplkCredential:         splunkCredential,
-			Filters:                filters,
-			EKSConfig:              eksConfig,
-			PullSecrets:            pullSecrets,
-			Installation:           installationSpec,
-			ClusterDomain:          r.opts.ClusterDomain,
-			OSType:                 rmeta.OSTypeWindows,
-			TrustedBundle:          trustedBundle,
-			ManagedCluster:         managedCluster,
-			UseSyslogCertificate:   useSyslogCertificate,
-			FluentdKeyPair:         fluentdKeyPair,
-			EKSLogForwarderKeyPair: eksLogForwarderKeyPair,
-			LicenseExpired:         licenseExpired,
+			LogCollector:             instance,
+			ESClusterConfig:          esClusterConfig,
+			S3Credential:             s3Credential,
+			S3DestinationCredentials: s3DestinationCredentials,
+			GCSCredential:            gcsCredential,
+			AzureBlobCredential:      azureBlobCredential,
+			SplkCredential:           splunkCredential,
+			LokiCredential:           lokiCredential,
+			OTLPHeaders:              otlpHeaders,
+			Filters:                  filters,
+			AdditionalOutputs:        additionalOutputs,
+			LogSources:               logSources,
+			EKSConfig:                eksConfig,
+			PullSecrets:              pullSecrets,
+			Installation:             installationSpec,
+			ClusterDomain:            r.opts.ClusterDomain,
+			OSType:                   rmeta.OSTypeWindows,
+			TrustedBundle:            trustedBundle,
+			ManagedCluster:           managedCluster,
+			UseSyslogCertificate:     useSyslogCertificate,
+			SyslogClientCredential:   syslogClientCredential,
+			SyslogDestinationCAs:     syslogDestinationCAs,
+			FluentdKeyPair:           fluentdKeyPair,
+			Tenant:                   tenant,
+			ExternalElastic:          r.opts.ElasticExternal,
+			EKSLogForwarderKeyPair:   eksLogForwarderKeyPair,
+			LicenseExpired:           licenseExpired,
+			VPACRDExists:             r.vpaWatchReady != nil && r.vpaWatchReady.IsReady(),
 		}
 		comp = render.Fluentd(fluentdCfg)
 
@@ -706,10 +1000,30 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 
 	// Check BYO certificate expiry warnings.
 	certificatemanagement.CheckKeyPairWarnings(map[string]certificatemanagement.KeyPairInterface{
-		render.FluentdPrometheusTLSSecretName: fluentdKeyPair,
-		render.EKSLogForwarderTLSSecretName:   eksLogForwarderKeyPair,
+		render.FluentdPrometheusTLSSecretName:   fluentdKeyPair,
+		render.EKSLogForwarderTLSSecretName:     eksLogForwarderKeyPair,
+		render.FluentdInputTLSSecretName:        inputKeyPair,
+		render.FluentdForwardInputTLSSecretName: forwardInputKeyPair,
 	}, r.status)
 
+	// Publish the result of the most recent run of the log pipeline canary. The condition is persisted along with
+	// the rest of the CR status below.
+	if instance.Spec.PipelineCanary != nil {
+		condition, err := pipelineHealthyCondition(ctx, r.client, instance.Generation)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying the log pipeline canary Jobs", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		meta.SetStatusCondition(&instance.Status.Conditions, condition)
+	} else {
+		meta.RemoveStatusCondition(&instance.Status.Conditions, operatorv1.LogCollectorPipelineHealthy)
+	}
+
+	if err := r.updateLogExportStatuses(ctx, logExports.accepted); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error updating the status of LogExports", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	// Clear the degraded bit if we've reached this far.
 	r.status.ClearDegraded()
 
@@ -727,29 +1041,308 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 	return reconcile.Result{RequeueAfter: graceRequeueAfter}, nil
 }
 
+// acceptLogSources validates each LogSource, records the result in its Accepted condition and returns the LogSources
+// to add to the fluentd configuration, sorted so that the rendered configuration is stable.
+func (r *ReconcileLogCollector) acceptLogSources(ctx context.Context, instance *operatorv1.LogCollector) ([]operatorv1.LogSource, error) {
+	if !r.logSourceWatchReady.IsReady() {
+		return nil, nil
+	}
+	list := &operatorv1.LogSourceList{}
+	if err := r.client.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	var accepted []operatorv1.LogSource
+	for i := range list.Items {
+		ls := &list.Items[i]
+		condition := metav1.Condition{
+			Type:               operatorv1.LogSourceConditionAccepted,
+			Status:             metav1.ConditionTrue,
+			Reason:             operatorv1.LogSourceReasonValid,
+			Message:            "The LogSource was added to the fluentd configuration",
+			ObservedGeneration: ls.Generation,
+		}
+		if ct := instance.Spec.GetCollectorType(); ct == operatorv1.LogCollectorTypeAuditDeployment || ct == operatorv1.LogCollectorTypeAggregator {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = operatorv1.LogSourceReasonUnsupported
+			condition.Message = fmt.Sprintf("Container logs are not collected with the %s collector type", ct)
+		} else if err := resources.ValidateLogSource(ls); err != nil {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = operatorv1.LogSourceReasonInvalid
+			condition.Message = err.Error()
+		} else {
+			accepted = append(accepted, *ls)
+		}
+		if meta.SetStatusCondition(&ls.Status.Conditions, condition) {
+			if err := r.client.Status().Update(ctx, ls); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sort.Slice(accepted, func(i, j int) bool {
+		if accepted[i].Namespace != accepted[j].Namespace {
+			return accepted[i].Namespace < accepted[j].Namespace
+		}
+		return accepted[i].Name < accepted[j].Name
+	})
+	return accepted, nil
+}
+
+// logExports holds the LogExports that the log collector runs CronJobs for.
+type logExports struct {
+	// accepted are the LogExports to render a CronJob for, sorted by name.
+	accepted []operatorv1.LogExport
+	// credentials are the credentials of the accepted LogExports that have their own, keyed by LogExport name.
+	credentials map[string]*render.S3Credential
+	// defaultCredential is the log-collector-s3-credentials secret, read if an accepted LogExport writes with it.
+	defaultCredential *render.S3Credential
+	// stale are the names of the LogExports with a CronJob that are no longer accepted.
+	stale []string
+}
+
+// acceptLogExports reads the credentials of each LogExport, records a failure to do so in its Succeeded condition and
+// returns the LogExports to run, along with the LogExports whose CronJobs are to be deleted.
+func (r *ReconcileLogCollector) acceptLogExports(ctx context.Context, exportLogs bool) (logExports, error) {
+	result := logExports{credentials: map[string]*render.S3Credential{}}
+	if !r.logExportWatchReady.IsReady() {
+		return result, nil
+	}
+	list := &operatorv1.LogExportList{}
+	if err := r.client.List(ctx, list); err != nil {
+		return result, err
+	}
+
+	accepted := map[string]bool{}
+	for i := range list.Items {
+		le := &list.Items[i]
+		err := func() error {
+			if !exportLogs {
+				return fmt.Errorf("License does not support feature: %s", common.ExportLogsFeature)
+			}
+			s3 := le.Spec.Destination.S3
+			if s3 == nil {
+				return fmt.Errorf("LogExport has no destination")
+			}
+			if s3.CredentialsSecretName != "" {
+				credential, err := getS3CredentialFromSecret(r.client, s3.CredentialsSecretName)
+				if err != nil {
+					return err
+				}
+				if credential == nil {
+					return fmt.Errorf("secret %q does not exist", s3.CredentialsSecretName)
+				}
+				result.credentials[le.Name] = credential
+				return nil
+			}
+			if result.defaultCredential == nil {
+				credential, err := getS3Credential(r.client)
+				if err != nil {
+					return err
+				}
+				if credential == nil {
+					return fmt.Errorf("secret %q does not exist", render.S3FluentdSecretName)
+				}
+				result.defaultCredential = credential
+			}
+			return nil
+		}()
+		if err != nil {
+			condition := metav1.Condition{
+				Type:               operatorv1.LogExportConditionSucceeded,
+				Status:             metav1.ConditionFalse,
+				Reason:             operatorv1.LogExportReasonInvalid,
+				Message:            err.Error(),
+				ObservedGeneration: le.Generation,
+			}
+			if meta.SetStatusCondition(&le.Status.Conditions, condition) {
+				if err := r.client.Status().Update(ctx, le); err != nil {
+					return result, err
+				}
+			}
+			continue
+		}
+		accepted[le.Name] = true
+		result.accepted = append(result.accepted, *le)
+	}
+	sort.Slice(result.accepted, func(i, j int) bool { return result.accepted[i].Name < result.accepted[j].Name })
+
+	cronJobs := &batchv1.CronJobList{}
+	if err := r.client.List(ctx, cronJobs, client.InNamespace(render.LogCollectorNamespace), client.HasLabels{render.LogExportLabel}); err != nil {
+		return result, err
+	}
+	for _, cj := range cronJobs.Items {
+		if name := cj.Labels[render.LogExportLabel]; !accepted[name] {
+			result.stale = append(result.stale, name)
+		}
+	}
+	sort.Strings(result.stale)
+	return result, nil
+}
+
+// updateLogExportStatuses records the result of the most recently finished Job of each LogExport in its Succeeded
+// condition. The condition is unknown until the first run finishes.
+func (r *ReconcileLogCollector) updateLogExportStatuses(ctx context.Context, exports []operatorv1.LogExport) error {
+	for i := range exports {
+		le := &exports[i]
+		jobs := &batchv1.JobList{}
+		if err := r.client.List(ctx, jobs, client.InNamespace(render.LogCollectorNamespace), client.MatchingLabels{render.LogExportLabel: le.Name}); err != nil {
+			return err
+		}
+		condition := metav1.Condition{
+			Type:               operatorv1.LogExportConditionSucceeded,
+			Status:             metav1.ConditionUnknown,
+			Reason:             operatorv1.LogExportReasonPending,
+			Message:            "Waiting for the first run of the LogExport to finish",
+			ObservedGeneration: le.Generation,
+		}
+		latest := latestFinishedJobCondition(jobs.Items)
+		lastSuccessfulTime := le.Status.LastSuccessfulTime
+		switch {
+		case latest == nil:
+		case latest.Type == batchv1.JobComplete:
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = operatorv1.LogExportReasonSucceeded
+			condition.Message = fmt.Sprintf("The LogExport last succeeded at %s", latest.LastTransitionTime.UTC().Format(time.RFC3339))
+			lastSuccessfulTime = &latest.LastTransitionTime
+		default:
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = operatorv1.LogExportReasonFailed
+			condition.Message = fmt.Sprintf("The LogExport failed at %s", latest.LastTransitionTime.UTC().Format(time.RFC3339))
+			if latest.Message != "" {
+				condition.Message = fmt.Sprintf("%s: %s", condition.Message, latest.Message)
+			}
+		}
+
+		changed := meta.SetStatusCondition(&le.Status.Conditions, condition)
+		if lastSuccessfulTime != nil && (le.Status.LastSuccessfulTime == nil || !lastSuccessfulTime.Equal(le.Status.LastSuccessfulTime)) {
+			le.Status.LastSuccessfulTime = lastSuccessfulTime
+			changed = true
+		}
+		if changed {
+			if err := r.client.Status().Update(ctx, le); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// latestFinishedJobCondition returns the Complete or Failed condition of the most recently finished of the given Jobs,
+// or nil if none of them has finished.
+func latestFinishedJobCondition(jobs []batchv1.Job) *batchv1.JobCondition {
+	var latest *batchv1.JobCondition
+	for i := range jobs {
+		for j, c := range jobs[i].Status.Conditions {
+			if c.Status != corev1.ConditionTrue || (c.Type != batchv1.JobComplete && c.Type != batchv1.JobFailed) {
+				continue
+			}
+			if latest == nil || c.LastTransitionTime.After(latest.LastTransitionTime.Time) {
+				latest = &jobs[i].Status.Conditions[j]
+			}
+		}
+	}
+	return latest
+}
+
+// pipelineHealthyCondition returns the PipelineHealthy condition for the most recently finished log pipeline canary
+// Job. The condition is unknown until the first run finishes.
+func pipelineHealthyCondition(ctx context.Context, cli client.Client, generation int64) (metav1.Condition, error) {
+	condition := metav1.Condition{
+		Type:               operatorv1.LogCollectorPipelineHealthy,
+		Status:             metav1.ConditionUnknown,
+		Reason:             "Pending",
+		Message:            "Waiting for the first run of the log pipeline canary to finish",
+		ObservedGeneration: generation,
+	}
+
+	jobs := &batchv1.JobList{}
+	if err := cli.List(ctx, jobs, client.InNamespace(render.LogCollectorNamespace), client.MatchingLabels{"k8s-app": render.FluentdPipelineCanaryName}); err != nil {
+		return condition, err
+	}
+
+	latest := latestFinishedJobCondition(jobs.Items)
+	switch {
+	case latest == nil:
+	case latest.Type == batchv1.JobComplete:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Succeeded"
+		condition.Message = fmt.Sprintf("The log pipeline canary last succeeded at %s", latest.LastTransitionTime.UTC().Format(time.RFC3339))
+	default:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Failed"
+		condition.Message = fmt.Sprintf("The log pipeline canary failed at %s", latest.LastTransitionTime.UTC().Format(time.RFC3339))
+		if latest.Message != "" {
+			condition.Message = fmt.Sprintf("%s: %s", condition.Message, latest.Message)
+		}
+	}
+	return condition, nil
+}
+
+// needsDefaultS3Credential returns whether the S3 store writes to a bucket with the log-collector-s3-credentials
+// secret, either its own bucket or a destination without credentials of its own.
+func needsDefaultS3Credential(s3 *operatorv1.S3StoreSpec) bool {
+	if s3 == nil {
+		return false
+	}
+	if s3.BucketName != "" {
+		return true
+	}
+	for _, d := range s3.Destinations {
+		if d.CredentialsSecretName == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// getS3DestinationCredentials returns the credentials of the S3 destinations that have their own, keyed by
+// destination name.
+func getS3DestinationCredentials(client client.Client, destinations []operatorv1.S3DestinationSpec) (map[string]*render.S3Credential, error) {
+	credentials := map[string]*render.S3Credential{}
+	for _, d := range destinations {
+		if d.CredentialsSecretName == "" {
+			continue
+		}
+		credential, err := getS3CredentialFromSecret(client, d.CredentialsSecretName)
+		if err != nil {
+			return nil, err
+		}
+		if credential == nil {
+			return nil, fmt.Errorf("secret %q of S3 destination %q does not exist", d.CredentialsSecretName, d.Name)
+		}
+		credentials[d.Name] = credential
+	}
+	return credentials, nil
+}
+
 func getS3Credential(client client.Client) (*render.S3Credential, error) {
+	return getS3CredentialFromSecret(client, render.S3FluentdSecretName)
+}
+
+func getS3CredentialFromSecret(client client.Client, secretName string) (*render.S3Credential, error) {
 	secret := &corev1.Secret{}
 	secretNamespacedName := types.NamespacedName{
-		Name:      render.S3FluentdSecretName,
+		Name:      secretName,
 		Namespace: common.OperatorNamespace(),
 	}
 	if err := client.Get(context.Background(), secretNamespacedName, secret); err != nil {
 		if errors.IsNotFound(err) {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("failed to read secret %q: %s", render.S3FluentdSecretName, err)
+		return nil, fmt.Errorf("failed to read secret %q: %s", secretName, err)
 	}
 
 	var ok bool
 	var kId []byte
 	if kId, ok = secret.Data[render.S3KeyIdName]; !ok || len(kId) == 0 {
 		return nil, fmt.Errorf("expected secret %q to have a field named %q",
-			render.S3FluentdSecretName, render.S3KeyIdName)
+			secretName, render.S3KeyIdName)
 	}
 	var kSecret []byte
 	if kSecret, ok = secret.Data[render.S3KeySecretName]; !ok || len(kSecret) == 0 {
 		return nil, fmt.Errorf("expected secret %q to have a field named %q",
-			render.S3FluentdSecretName, render.S3KeySecretName)
+			secretName, render.S3KeySecretName)
 	}
 
 	return &render.S3Credential{
@@ -758,6 +1351,55 @@ func getS3Credential(client client.Client) (*render.S3Credential, error) {
 	}, nil
 }
 
+func getGCSCredential(client client.Client) (*render.GCSCredential, error) {
+	secret := &corev1.Secret{}
+	secretNamespacedName := types.NamespacedName{
+		Name:      render.GCSFluentdSecretName,
+		Namespace: common.OperatorNamespace(),
+	}
+	if err := client.Get(context.Background(), secretNamespacedName, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read secret %q: %s", render.GCSFluentdSecretName, err)
+	}
+
+	keyFile, ok := secret.Data[render.GCSKeyFileName]
+	if !ok || len(keyFile) == 0 {
+		return nil, fmt.Errorf("expected secret %q to have a field named %q",
+			render.GCSFluentdSecretName, render.GCSKeyFileName)
+	}
+
+	return &render.GCSCredential{
+		KeyFile: keyFile,
+	}, nil
+}
+
+func getAzureBlobCredential(client client.Client) (*render.AzureBlobCredential, error) {
+	secret := &corev1.Secret{}
+	secretNamespacedName := types.NamespacedName{
+		Name:      render.AzureBlobFluentdSecretName,
+		Namespace: common.OperatorNamespace(),
+	}
+	if err := client.Get(context.Background(), secretNamespacedName, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read secret %q: %s", render.AzureBlobFluentdSecretName, err)
+	}
+
+	accessKey, sasToken := secret.Data[render.AzureBlobAccessKeyName], secret.Data[render.AzureBlobSASTokenName]
+	if len(accessKey) == 0 && len(sasToken) == 0 {
+		return nil, fmt.Errorf("expected secret %q to have a field named %q or %q",
+			render.AzureBlobFluentdSecretName, render.AzureBlobAccessKeyName, render.AzureBlobSASTokenName)
+	}
+
+	return &render.AzureBlobCredential{
+		AccessKey: accessKey,
+		SASToken:  sasToken,
+	}, nil
+}
+
 func getSplunkCredential(client client.Client) (*render.SplunkCredential, error) {
 	tokenSecret := &corev1.Secret{}
 	tokenNamespacedName := types.NamespacedName{
@@ -782,26 +1424,70 @@ func getSplunkCredential(client client.Client) (*render.SplunkCredential, error)
 	}, nil
 }
 
-func getFluentdFilters(client client.Client) (*render.FluentdFilters, error) {
+// getFluentdFilters returns the fluentd filters to use. In multi-tenant management clusters, a fluentd-filters ConfigMap in the
+// tenant's namespace takes precedence over the one in the operator namespace.
+func getFluentdFilters(client client.Client, tenant *operatorv1.Tenant) (*render.FluentdFilters, error) {
+	namespaces := []string{common.OperatorNamespace()}
+	if tenant.MultiTenant() {
+		namespaces = append([]string{tenant.Namespace}, namespaces...)
+	}
+
+	cm := &corev1.ConfigMap{}
+	found := false
+	for _, ns := range namespaces {
+		cmNamespacedName := types.NamespacedName{
+			Name:      render.FluentdFilterConfigMapName,
+			Namespace: ns,
+		}
+		if err := client.Get(context.Background(), cmNamespacedName, cm); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read ConfigMap %q: %s", render.FluentdFilterConfigMapName, err)
+		}
+		found = true
+		break
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return &render.FluentdFilters{
+		Flow: cm.Data[render.FluentdFilterFlowName],
+		DNS:  cm.Data[render.FluentdFilterDNSName],
+	}, nil
+}
+
+// getFluentdAdditionalOutputs returns the user supplied fluentd output configuration files from the
+// fluentd-additional-outputs ConfigMap, keyed by file name. Each file may only contain <match> sections.
+func getFluentdAdditionalOutputs(client client.Client) (map[string]string, error) {
 	cm := &corev1.ConfigMap{}
 	cmNamespacedName := types.NamespacedName{
-		Name:      render.FluentdFilterConfigMapName,
+		Name:      render.FluentdAdditionalOutputsConfigMapName,
 		Namespace: common.OperatorNamespace(),
 	}
 	if err := client.Get(context.Background(), cmNamespacedName, cm); err != nil {
 		if errors.IsNotFound(err) {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("failed to read ConfigMap %q: %s", render.FluentdFilterConfigMapName, err)
+		return nil, fmt.Errorf("failed to read ConfigMap %q: %s", render.FluentdAdditionalOutputsConfigMapName, err)
 	}
 
-	return &render.FluentdFilters{
-		Flow: cm.Data[render.FluentdFilterFlowName],
-		DNS:  cm.Data[render.FluentdFilterDNSName],
-	}, nil
+	outputs := map[string]string{}
+	for name, config := range cm.Data {
+		if !strings.HasSuffix(name, ".conf") {
+			return nil, fmt.Errorf("ConfigMap %q key %q must end in .conf", render.FluentdAdditionalOutputsConfigMapName, name)
+		}
+		if err := fluentdvalidation.ValidateAdditionalOutput(config); err != nil {
+			return nil, fmt.Errorf("ConfigMap %q key %q is not valid: %w", render.FluentdAdditionalOutputsConfigMapName, name, err)
+		}
+		outputs[name] = config
+	}
+	return outputs, nil
 }
 
-func getEksCloudwatchLogConfig(client client.Client, interval int32, region, group, prefix string) (*render.EksCloudwatchLogConfig, error) {
+func getEksCloudwatchLogConfig(client client.Client, spec *operatorv1.EksCloudwatchLogsSpec) (*render.EksCloudwatchLogConfig, error) {
+	region, group, prefix, interval := spec.Region, spec.GroupName, spec.StreamPrefix, spec.FetchInterval
 	if region == "" {
 		return nil, fmt.Errorf("missing AWS region info")
 	}
@@ -818,6 +1504,28 @@ func getEksCloudwatchLogConfig(client client.Client, interval int32, region, gro
 		interval = 60
 	}
 
+	// The forwarder has no leader election, so every replica would forward each event.
+	if spec.Replicas != nil && *spec.Replicas > 1 {
+		return nil, fmt.Errorf("eks-log-forwarder replicas must be 0 or 1, got %d", *spec.Replicas)
+	}
+
+	config := &render.EksCloudwatchLogConfig{
+		AwsRegion:                 region,
+		GroupName:                 group,
+		StreamPrefix:              prefix,
+		FetchInterval:             interval,
+		RoleARN:                   spec.RoleARN,
+		ServiceAccountAnnotations: spec.ServiceAccountAnnotations,
+		NodeSelector:              spec.NodeSelector,
+		Tolerations:               spec.Tolerations,
+		Resources:                 spec.Resources,
+		Replicas:                  spec.Replicas,
+	}
+	if spec.RoleARN != "" {
+		// With IRSA, EKS injects the credentials of the role, so there is no secret to read.
+		return config, nil
+	}
+
 	secret := &corev1.Secret{}
 	secretNamespacedName := types.NamespacedName{
 		Name:      render.EksLogForwarderSecret,
@@ -835,14 +1543,46 @@ func getEksCloudwatchLogConfig(client client.Client, interval int32, region, gro
 		return nil, fmt.Errorf("incomplete Cloudwatch credentials")
 	}
 
-	return &render.EksCloudwatchLogConfig{
-		AwsId:         secret.Data[render.EksLogForwarderAwsId],
-		AwsKey:        secret.Data[render.EksLogForwarderAwsKey],
-		AwsRegion:     region,
-		GroupName:     group,
-		StreamPrefix:  prefix,
-		FetchInterval: interval,
-	}, nil
+	config.AwsId = secret.Data[render.EksLogForwarderAwsId]
+	config.AwsKey = secret.Data[render.EksLogForwarderAwsKey]
+	return config, nil
+}
+
+// syslogTLSEnabled returns whether fluentd connects to the server of the Syslog store, or of any of its destinations,
+// with TLS.
+func syslogTLSEnabled(syslog *operatorv1.SyslogStoreSpec) bool {
+	if syslog == nil {
+		return false
+	}
+	for _, d := range syslog.GetDestinations() {
+		if d.Encryption == operatorv1.EncryptionTLS {
+			return true
+		}
+	}
+	return false
+}
+
+// getSyslogDestinationCertificates returns the CA certificates of the Syslog destinations with TLS that have their
+// own, keyed by destination name.
+func getSyslogDestinationCertificates(client client.Client, destinations []operatorv1.SyslogDestinationSpec) (map[string]certificatemanagement.CertificateInterface, error) {
+	certificates := map[string]certificatemanagement.CertificateInterface{}
+	for _, d := range destinations {
+		if d.Encryption != operatorv1.EncryptionTLS || d.CAConfigMapName == "" {
+			continue
+		}
+		cm := &corev1.ConfigMap{}
+		if err := client.Get(context.Background(), types.NamespacedName{Name: d.CAConfigMapName, Namespace: common.OperatorNamespace()}, cm); err != nil {
+			if errors.IsNotFound(err) {
+				return nil, fmt.Errorf("ConfigMap %q of Syslog destination %q does not exist", d.CAConfigMapName, d.Name)
+			}
+			return nil, fmt.Errorf("failed to read ConfigMap %q: %s", d.CAConfigMapName, err)
+		}
+		if len(cm.Data[corev1.TLSCertKey]) == 0 {
+			return nil, fmt.Errorf("expected ConfigMap %q to have a field named %q", d.CAConfigMapName, corev1.TLSCertKey)
+		}
+		certificates[d.Name] = certificatemanagement.NewCertificate(d.CAConfigMapName, common.OperatorNamespace(), []byte(cm.Data[corev1.TLSCertKey]), nil)
+	}
+	return certificates, nil
 }
 
 func getSysLogCertificate(client client.Client) (certificatemanagement.CertificateInterface, error) {
@@ -866,3 +1606,135 @@ func getSysLogCertificate(client client.Client) (certificatemanagement.Certifica
 
 	return syslogCert, nil
 }
+
+// getSyslogClientCredential returns the client key pair fluentd uses for mutual TLS with the Syslog server, or nil
+// if the user has not provided one.
+func getSyslogClientCredential(client client.Client) (*render.SyslogClientCredential, error) {
+	secret := &corev1.Secret{}
+	secretNamespacedName := types.NamespacedName{
+		Name:      render.SyslogClientTLSSecretName,
+		Namespace: common.OperatorNamespace(),
+	}
+	if err := client.Get(context.Background(), secretNamespacedName, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read secret %q: %s", render.SyslogClientTLSSecretName, err)
+	}
+
+	cert, ok := secret.Data[corev1.TLSCertKey]
+	if !ok || len(cert) == 0 {
+		return nil, fmt.Errorf("expected secret %q to have a field named %q",
+			render.SyslogClientTLSSecretName, corev1.TLSCertKey)
+	}
+	key, ok := secret.Data[corev1.TLSPrivateKeyKey]
+	if !ok || len(key) == 0 {
+		return nil, fmt.Errorf("expected secret %q to have a field named %q",
+			render.SyslogClientTLSSecretName, corev1.TLSPrivateKeyKey)
+	}
+
+	return &render.SyslogClientCredential{
+		Cert: cert,
+		Key:  key,
+	}, nil
+}
+
+// getLokiCredential returns the credentials fluentd uses to authenticate to Loki, or nil if the user has not
+// provided any. The secret must contain either a token, or both a username and password.
+func getLokiCredential(client client.Client) (*render.LokiCredential, error) {
+	secret := &corev1.Secret{}
+	secretNamespacedName := types.NamespacedName{
+		Name:      render.LokiFluentdCredentialSecretName,
+		Namespace: common.OperatorNamespace(),
+	}
+	if err := client.Get(context.Background(), secretNamespacedName, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read secret %q: %s", render.LokiFluentdCredentialSecretName, err)
+	}
+
+	if token := secret.Data[render.LokiFluentdSecretTokenKey]; len(token) > 0 {
+		return &render.LokiCredential{Token: token}, nil
+	}
+
+	username := secret.Data[render.LokiFluentdSecretUsernameKey]
+	password := secret.Data[render.LokiFluentdSecretPasswordKey]
+	if len(username) == 0 || len(password) == 0 {
+		return nil, fmt.Errorf("expected secret %q to have either a field named %q or fields named %q and %q",
+			render.LokiFluentdCredentialSecretName, render.LokiFluentdSecretTokenKey,
+			render.LokiFluentdSecretUsernameKey, render.LokiFluentdSecretPasswordKey)
+	}
+
+	return &render.LokiCredential{
+		Username: username,
+		Password: password,
+	}, nil
+}
+
+// getLokiCertificate returns the CA certificate for the Loki server, or nil if Loki's certificate is signed by a
+// publicly trusted CA.
+func getLokiCertificate(client client.Client) (certificatemanagement.CertificateInterface, error) {
+	cm := &corev1.ConfigMap{}
+	cmNamespacedName := types.NamespacedName{
+		Name:      render.LokiCAConfigMapName,
+		Namespace: common.OperatorNamespace(),
+	}
+	if err := client.Get(context.Background(), cmNamespacedName, cm); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read ConfigMap %q: %s", render.LokiCAConfigMapName, err)
+	}
+	if len(cm.Data[corev1.TLSCertKey]) == 0 {
+		return nil, fmt.Errorf("expected ConfigMap %q to have a field named %q", render.LokiCAConfigMapName, corev1.TLSCertKey)
+	}
+	return certificatemanagement.NewCertificate(render.LokiCAConfigMapName, common.OperatorNamespace(), []byte(cm.Data[corev1.TLSCertKey]), nil), nil
+}
+
+// getOTLPHeaders returns the additional headers fluentd sends to the OpenTelemetry collector, or nil if the user
+// has not provided any. Each field of the secret is a header name and its value.
+func getOTLPHeaders(client client.Client) (*render.OTLPHeaders, error) {
+	secret := &corev1.Secret{}
+	secretNamespacedName := types.NamespacedName{
+		Name:      render.OTLPHeadersSecretName,
+		Namespace: common.OperatorNamespace(),
+	}
+	if err := client.Get(context.Background(), secretNamespacedName, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read secret %q: %s", render.OTLPHeadersSecretName, err)
+	}
+
+	if len(secret.Data) == 0 {
+		return nil, fmt.Errorf("expected secret %q to have at least one field", render.OTLPHeadersSecretName)
+	}
+	for name := range secret.Data {
+		if errs := utilvalidation.IsHTTPHeaderName(name); len(errs) > 0 {
+			return nil, fmt.Errorf("field %q of secret %q is not a valid header name: %s", name, render.OTLPHeadersSecretName, strings.Join(errs, ", "))
+		}
+	}
+
+	return &render.OTLPHeaders{Headers: secret.Data}, nil
+}
+
+// getOTLPCertificate returns the CA certificate for the OpenTelemetry collector, or nil if the collector's
+// certificate is signed by a publicly trusted CA.
+func getOTLPCertificate(client client.Client) (certificatemanagement.CertificateInterface, error) {
+	cm := &corev1.ConfigMap{}
+	cmNamespacedName := types.NamespacedName{
+		Name:      render.OTLPCAConfigMapName,
+		Namespace: common.OperatorNamespace(),
+	}
+	if err := client.Get(context.Background(), cmNamespacedName, cm); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read ConfigMap %q: %s", render.OTLPCAConfigMapName, err)
+	}
+	if len(cm.Data[corev1.TLSCertKey]) == 0 {
+		return nil, fmt.Errorf("expected ConfigMap %q to have a field named %q", render.OTLPCAConfigMapName, corev1.TLSCertKey)
+	}
+	return certificatemanagement.NewCertificate(render.OTLPCAConfigMapName, common.OperatorNamespace(), []byte(cm.Data[corev1.TLSCertKey]), nil), nil
+}
@@ -28,9 +28,11 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -77,9 +79,11 @@ var _ = Describe("LogCollector controller tests", func() {
 		mockStatus.On("AddCronJobs", mock.Anything)
 		mockStatus.On("RemoveCertificateSigningRequests", mock.Anything).Return()
 		mockStatus.On("RemoveDaemonsets", mock.Anything).Return()
+		mockStatus.On("RemoveDeployments", mock.Anything).Return()
+		mockStatus.On("RemoveCronJobs", mock.Anything).Return()
 		mockStatus.On("AddCertificateSigningRequests", mock.Anything).Return()
 		mockStatus.On("IsAvailable").Return(true)
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("ClearDegraded")
 		mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 		mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -90,11 +94,13 @@ var _ = Describe("LogCollector controller tests", func() {
 		// Create an object we can use throughout the test to do the compliance reconcile loops.
 		// As the parameters in the client changes, we expect the outcomes of the reconcile loops to change.
 		r = ReconcileLogCollector{
-			client:          c,
-			scheme:          scheme,
-			status:          mockStatus,
-			licenseAPIReady: &utils.ReadyFlag{},
-			tierWatchReady:  &utils.ReadyFlag{},
+			client:              c,
+			scheme:              scheme,
+			status:              mockStatus,
+			licenseAPIReady:     &utils.ReadyFlag{},
+			tierWatchReady:      &utils.ReadyFlag{},
+			logSourceWatchReady: &utils.ReadyFlag{},
+			logExportWatchReady: &utils.ReadyFlag{},
 			opts: options.ControllerOptions{
 				DetectedProvider: operatorv1.ProviderNone,
 			},
@@ -164,6 +170,8 @@ var _ = Describe("LogCollector controller tests", func() {
 		// Mark that watches were successful.
 		r.licenseAPIReady.MarkAsReady()
 		r.tierWatchReady.MarkAsReady()
+		r.logSourceWatchReady.MarkAsReady()
+		r.logExportWatchReady.MarkAsReady()
 	})
 
 	Context("image reconciliation", func() {
@@ -241,6 +249,54 @@ var _ = Describe("LogCollector controller tests", func() {
 					"sha256:fluentdwindowshash")))
 		})
 
+		It("should degrade when a secondary Linseed endpoint is set on a cluster that is not managed", func() {
+			logCollector := &operatorv1.LogCollector{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, logCollector)).NotTo(HaveOccurred())
+			logCollector.Spec.LinseedFailover = &operatorv1.LinseedFailover{SecondaryEndpoint: "https://voltron.standby.example.com:9443"}
+			Expect(c.Update(ctx, logCollector)).NotTo(HaveOccurred())
+			mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "linseedFailover can only be set on managed clusters", mock.Anything, mock.Anything).Return()
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "linseedFailover can only be set on managed clusters", mock.Anything, mock.Anything)
+		})
+
+		It("should reject more than one eks-log-forwarder replica", func() {
+			spec := &operatorv1.EksCloudwatchLogsSpec{
+				Region:    "us-west-1",
+				GroupName: "dummy-eks-cluster-cloudwatch-log-group",
+				RoleARN:   "arn:aws:iam::123456789012:role/eks-log-forwarder",
+				Replicas:  ptr.To(int32(2)),
+			}
+			_, err := getEksCloudwatchLogConfig(c, spec)
+			Expect(err).To(MatchError("eks-log-forwarder replicas must be 0 or 1, got 2"))
+
+			spec.Replicas = ptr.To(int32(1))
+			config, err := getEksCloudwatchLogConfig(c, spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*config.Replicas).To(Equal(int32(1)))
+		})
+
+		It("should degrade when the Installation forces a pod security standard that fluentd cannot run under", func() {
+			installation := &operatorv1.Installation{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, installation)).NotTo(HaveOccurred())
+			installation.Spec.NamespacePodSecurityStandards = []operatorv1.NamespacePodSecurityStandard{
+				{Namespace: render.LogCollectorNamespace, Level: operatorv1.PodSecurityStandardRestricted},
+			}
+			Expect(c.Update(ctx, installation)).NotTo(HaveOccurred())
+			mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Failed to resolve the pod security standard of the namespace", mock.Anything, mock.Anything).Return()
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "Failed to resolve the pod security standard of the namespace", mock.Anything, mock.Anything)
+
+			ds := appsv1.DaemonSet{
+				TypeMeta:   metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: render.LogCollectorNamespace},
+			}
+			Expect(test.GetResource(c, &ds)).NotTo(BeNil())
+		})
+
 		Context("Forward to S3", func() {
 			s3Vars := []corev1.EnvVar{
 				{
@@ -325,6 +381,50 @@ var _ = Describe("LogCollector controller tests", func() {
 				Expect(node.Env).To(ContainElements(s3Vars))
 			})
 
+			It("should forward logs to S3 destinations with their own credentials", func() {
+				lc := &operatorv1.LogCollector{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, lc)).NotTo(HaveOccurred())
+				lc.Spec.AdditionalStores.S3.Destinations = []operatorv1.S3DestinationSpec{{
+					Name:                  "audit",
+					Region:                "eu-west-1",
+					BucketName:            "audit-archive",
+					LogTypes:              []operatorv1.S3LogType{operatorv1.S3LogAudit},
+					CredentialsSecretName: "audit-archive-credentials",
+				}}
+				Expect(c.Update(ctx, lc)).NotTo(HaveOccurred())
+
+				By("Degrading while the credentials of the destination do not exist")
+				mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Error with S3 destination credential secret", mock.Anything, mock.Anything).Return()
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).Should(HaveOccurred())
+
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "audit-archive-credentials", Namespace: "tigera-operator"},
+					Data: map[string][]byte{
+						"key-secret": []byte("audit-secret"),
+						"key-id":     []byte("audit-id"),
+					},
+				})).NotTo(HaveOccurred())
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				secret := corev1.Secret{
+					TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+					ObjectMeta: metav1.ObjectMeta{Name: render.S3DestinationsSecretName, Namespace: render.LogCollectorNamespace},
+				}
+				Expect(test.GetResource(c, &secret)).To(BeNil())
+				Expect(secret.Data).To(Equal(map[string][]byte{
+					"audit-key-id":     []byte("audit-id"),
+					"audit-key-secret": []byte("audit-secret"),
+				}))
+				cm := corev1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+					ObjectMeta: metav1.ObjectMeta{Name: render.FluentdS3OutputsConfigMapName, Namespace: render.LogCollectorNamespace},
+				}
+				Expect(test.GetResource(c, &cm)).To(BeNil())
+				Expect(cm.Data).To(HaveKey("tigera.calico.ee_audit.conf"))
+			})
+
 			Context("Disable feature via license", func() {
 				BeforeEach(func() {
 					By("Deleting the previous license")
@@ -357,6 +457,428 @@ var _ = Describe("LogCollector controller tests", func() {
 			})
 		})
 
+		Context("FluentdDaemonSet overrides", func() {
+			BeforeEach(func() {
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+			})
+
+			It("should apply the overrides to the fluentd DaemonSet", func() {
+				Expect(c.Create(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+					Spec: operatorv1.LogCollectorSpec{
+						FluentdDaemonSet: &operatorv1.FluentdDaemonSet{
+							Spec: &operatorv1.FluentdDaemonSetSpec{
+								Template: &operatorv1.FluentdDaemonSetPodTemplateSpec{
+									Spec: &operatorv1.FluentdDaemonSetPodSpec{
+										NodeSelector:      map[string]string{"logging": "true"},
+										PriorityClassName: "logging-priority",
+									},
+								},
+							},
+						},
+					},
+				})).NotTo(HaveOccurred())
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ds := appsv1.DaemonSet{
+					TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fluentd-node",
+						Namespace: render.LogCollectorNamespace,
+					},
+				}
+				Expect(test.GetResource(c, &ds)).To(BeNil())
+				Expect(ds.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("logging", "true"))
+				Expect(ds.Spec.Template.Spec.PriorityClassName).To(Equal("logging-priority"))
+			})
+
+			It("should degrade when the overrides are invalid", func() {
+				Expect(c.Create(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+					Spec: operatorv1.LogCollectorSpec{
+						FluentdDaemonSet: &operatorv1.FluentdDaemonSet{
+							Spec: &operatorv1.FluentdDaemonSetSpec{
+								Template: &operatorv1.FluentdDaemonSetPodTemplateSpec{
+									Spec: &operatorv1.FluentdDaemonSetPodSpec{
+										Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists, Value: "invalid"}},
+									},
+								},
+							},
+						},
+					},
+				})).NotTo(HaveOccurred())
+				mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "LogCollector is invalid", mock.Anything, mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "LogCollector is invalid", mock.Anything, mock.Anything)
+			})
+
+			AfterEach(func() {
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("log pipeline canary", func() {
+			BeforeEach(func() {
+				lc := &operatorv1.LogCollector{}
+				Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, lc)).NotTo(HaveOccurred())
+				lc.Spec.PipelineCanary = &operatorv1.LogPipelineCanary{}
+				Expect(c.Update(ctx, lc)).NotTo(HaveOccurred())
+			})
+
+			canaryJob := func(name string, conditionType batchv1.JobConditionType, finished time.Time) *batchv1.Job {
+				return &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: render.LogCollectorNamespace,
+						Labels:    map[string]string{"k8s-app": render.FluentdPipelineCanaryName},
+					},
+					Status: batchv1.JobStatus{
+						Conditions: []batchv1.JobCondition{{
+							Type:               conditionType,
+							Status:             corev1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(finished),
+							Message:            "Job has reached the specified backoff limit",
+						}},
+					},
+				}
+			}
+
+			pipelineHealthy := func() *metav1.Condition {
+				lc := &operatorv1.LogCollector{}
+				Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, lc)).NotTo(HaveOccurred())
+				return meta.FindStatusCondition(lc.Status.Conditions, operatorv1.LogCollectorPipelineHealthy)
+			}
+
+			It("should render the canary CronJob and wait for its first run", func() {
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				cj := batchv1.CronJob{
+					TypeMeta:   metav1.TypeMeta{Kind: "CronJob", APIVersion: "batch/v1"},
+					ObjectMeta: metav1.ObjectMeta{Name: render.FluentdPipelineCanaryName, Namespace: render.LogCollectorNamespace},
+				}
+				Expect(test.GetResource(c, &cj)).To(BeNil())
+
+				condition := pipelineHealthy()
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
+			})
+
+			It("should publish the result of the most recent run", func() {
+				now := time.Now()
+				Expect(c.Create(ctx, canaryJob("canary-1", batchv1.JobComplete, now.Add(-time.Hour)))).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, canaryJob("canary-2", batchv1.JobFailed, now))).NotTo(HaveOccurred())
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				condition := pipelineHealthy()
+				Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				Expect(condition.Reason).To(Equal("Failed"))
+				Expect(condition.Message).To(ContainSubstring("backoff limit"))
+
+				Expect(c.Create(ctx, canaryJob("canary-3", batchv1.JobComplete, now.Add(time.Minute)))).NotTo(HaveOccurred())
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				condition = pipelineHealthy()
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal("Succeeded"))
+			})
+
+			It("should remove the condition when the canary is disabled", func() {
+				Expect(c.Create(ctx, canaryJob("canary-1", batchv1.JobComplete, time.Now()))).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pipelineHealthy()).NotTo(BeNil())
+
+				lc := &operatorv1.LogCollector{}
+				Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, lc)).NotTo(HaveOccurred())
+				lc.Spec.PipelineCanary = nil
+				Expect(c.Update(ctx, lc)).NotTo(HaveOccurred())
+
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pipelineHealthy()).To(BeNil())
+			})
+		})
+
+		Context("Non-cluster hosts", func() {
+			It("should render the non-cluster host access policy for the configured source CIDRs", func() {
+				Expect(c.Create(ctx, &operatorv1.NonClusterHost{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+					Spec: operatorv1.NonClusterHostSpec{
+						Endpoint:    "https://1.2.3.4:5678",
+						SourceCIDRs: []string{"10.10.0.0/16"},
+					},
+				})).NotTo(HaveOccurred())
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				policy := v3.NetworkPolicy{}
+				Expect(c.Get(ctx, client.ObjectKey{
+					Name:      render.FluentdNonClusterHostNetworkPolicyName,
+					Namespace: render.LogCollectorNamespace,
+				}, &policy)).NotTo(HaveOccurred())
+				Expect(policy.Spec.Ingress).To(HaveLen(1))
+				Expect(policy.Spec.Ingress[0].Source.Nets).To(Equal([]string{"10.10.0.0/16"}))
+			})
+
+			It("should degrade when a source CIDR is invalid", func() {
+				Expect(c.Create(ctx, &operatorv1.NonClusterHost{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+					Spec: operatorv1.NonClusterHostSpec{
+						Endpoint:    "https://1.2.3.4:5678",
+						SourceCIDRs: []string{"10.10.0.0"},
+					},
+				})).NotTo(HaveOccurred())
+				mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Invalid source CIDR in NonClusterHost resource", mock.Anything, mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "Invalid source CIDR in NonClusterHost resource", mock.Anything, mock.Anything)
+			})
+		})
+
+		Context("Forward to GCS", func() {
+			BeforeEach(func() {
+				By("Specify GCS log storage")
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+					Spec: operatorv1.LogCollectorSpec{
+						AdditionalStores: &operatorv1.AdditionalLogStoreSpec{
+							GCS: &operatorv1.GCSStoreSpec{
+								ProjectID:  "gcsProject",
+								BucketName: "gcsBucket",
+								BucketPath: "gcsPath",
+							},
+						},
+					},
+				})).NotTo(HaveOccurred())
+				By("Setting the license to export logs")
+				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{common.ExportLogsFeature}}})).NotTo(HaveOccurred())
+			})
+
+			It("should forward logs to gcs", func() {
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "log-collector-gcs-credentials",
+						Namespace: "tigera-operator",
+					},
+					Data: map[string][]byte{
+						"key.json": []byte("{}"),
+					},
+				})).NotTo(HaveOccurred())
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ds := appsv1.DaemonSet{
+					TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fluentd-node",
+						Namespace: render.LogCollectorNamespace,
+					},
+				}
+				Expect(test.GetResource(c, &ds)).To(BeNil())
+				Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+				Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+					corev1.EnvVar{Name: "GCS_STORAGE", Value: "true"},
+					corev1.EnvVar{Name: "GCS_PROJECT_ID", Value: "gcsProject"},
+					corev1.EnvVar{Name: "GCS_BUCKET_NAME", Value: "gcsBucket"},
+					corev1.EnvVar{Name: "GCS_BUCKET_PATH", Value: "gcsPath"},
+				))
+			})
+
+			It("should degrade when the gcs secret does not exist", func() {
+				mockStatus.On("SetDegraded", operatorv1.ResourceNotFound, "GCS credential secret does not exist", mock.Anything, mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotFound, "GCS credential secret does not exist", mock.Anything, mock.Anything)
+			})
+
+			AfterEach(func() {
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("Forward to Amazon Security Lake", func() {
+			createLogCollector := func(roleARN string) {
+				Expect(c.Create(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+					Spec: operatorv1.LogCollectorSpec{
+						AdditionalStores: &operatorv1.AdditionalLogStoreSpec{
+							SecurityLake: &operatorv1.SecurityLakeStoreSpec{
+								Region:     "us-east-1",
+								BucketName: "aws-security-data-lake-us-east-1-abc",
+								SourceName: "calico",
+								AccountID:  "123456789012",
+								RoleARN:    roleARN,
+							},
+						},
+					},
+				})).NotTo(HaveOccurred())
+			}
+
+			BeforeEach(func() {
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+				By("Setting the license to export logs")
+				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{common.ExportLogsFeature}}})).NotTo(HaveOccurred())
+			})
+
+			It("should forward logs to security lake using an IAM role for the service account", func() {
+				createLogCollector("arn:aws:iam::123456789012:role/calico-security-lake")
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				sa := corev1.ServiceAccount{
+					TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+					ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: render.LogCollectorNamespace},
+				}
+				Expect(test.GetResource(c, &sa)).To(BeNil())
+				Expect(sa.Annotations).To(HaveKeyWithValue("eks.amazonaws.com/role-arn", "arn:aws:iam::123456789012:role/calico-security-lake"))
+
+				ds := appsv1.DaemonSet{
+					TypeMeta:   metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+					ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: render.LogCollectorNamespace},
+				}
+				Expect(test.GetResource(c, &ds)).To(BeNil())
+				Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+					corev1.EnvVar{Name: "SECURITY_LAKE_STORAGE", Value: "true"},
+					corev1.EnvVar{Name: "SECURITY_LAKE_BUCKET_PATH", Value: "ext/calico/region=us-east-1/accountId=123456789012/eventDay=%Y%m%d/"},
+				))
+			})
+
+			It("should degrade when static credentials are needed and the s3 secret does not exist", func() {
+				createLogCollector("")
+				mockStatus.On("SetDegraded", operatorv1.ResourceNotFound, "S3 credential secret does not exist", mock.Anything, mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotFound, "S3 credential secret does not exist", mock.Anything, mock.Anything)
+			})
+
+			It("should degrade when the IAM role ARN is not valid", func() {
+				createLogCollector("calico-security-lake")
+				mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "LogCollector is invalid", mock.Anything, mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "LogCollector is invalid", mock.Anything, mock.Anything)
+			})
+
+			AfterEach(func() {
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("Forward to Azure Blob", func() {
+			BeforeEach(func() {
+				By("Specify Azure Blob log storage")
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+					Spec: operatorv1.LogCollectorSpec{
+						AdditionalStores: &operatorv1.AdditionalLogStoreSpec{
+							AzureBlob: &operatorv1.AzureBlobStoreSpec{
+								StorageAccount: "azureAccount",
+								Container:      "azureContainer",
+								Path:           "azurePath",
+							},
+						},
+					},
+				})).NotTo(HaveOccurred())
+				By("Setting the license to export logs")
+				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{common.ExportLogsFeature}}})).NotTo(HaveOccurred())
+			})
+
+			It("should forward logs to azure blob storage", func() {
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "log-collector-azure-blob-credentials",
+						Namespace: "tigera-operator",
+					},
+					Data: map[string][]byte{
+						"access-key": []byte("accesskey"),
+					},
+				})).NotTo(HaveOccurred())
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ds := appsv1.DaemonSet{
+					TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fluentd-node",
+						Namespace: render.LogCollectorNamespace,
+					},
+				}
+				Expect(test.GetResource(c, &ds)).To(BeNil())
+				Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+				Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+					corev1.EnvVar{Name: "AZURE_BLOB_STORAGE", Value: "true"},
+					corev1.EnvVar{Name: "AZURE_STORAGE_ACCOUNT", Value: "azureAccount"},
+					corev1.EnvVar{Name: "AZURE_STORAGE_CONTAINER", Value: "azureContainer"},
+					corev1.EnvVar{Name: "AZURE_STORAGE_PATH", Value: "azurePath"},
+				))
+			})
+
+			It("should degrade when the azure blob secret has no credentials", func() {
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "log-collector-azure-blob-credentials",
+						Namespace: "tigera-operator",
+					},
+					Data: map[string][]byte{},
+				})).NotTo(HaveOccurred())
+				mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Error with Azure Blob credential secret", mock.Anything, mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).Should(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "Error with Azure Blob credential secret", mock.Anything, mock.Anything)
+			})
+
+			It("should degrade when the azure blob secret does not exist", func() {
+				mockStatus.On("SetDegraded", operatorv1.ResourceNotFound, "Azure Blob credential secret does not exist", mock.Anything, mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotFound, "Azure Blob credential secret does not exist", mock.Anything, mock.Anything)
+			})
+
+			AfterEach(func() {
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("Forward to Splunk", func() {
 			splunkVars := []corev1.EnvVar{
 				{
@@ -531,6 +1053,116 @@ var _ = Describe("LogCollector controller tests", func() {
 				Expect(node.Env).To(ContainElements(syslogVars))
 			})
 
+			It("should forward logs to syslog destinations with their own CA", func() {
+				lc := &operatorv1.LogCollector{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, lc)).NotTo(HaveOccurred())
+				lc.Spec.AdditionalStores.Syslog.Destinations = []operatorv1.SyslogDestinationSpec{{
+					Name:            "audit",
+					Endpoint:        "tcp://5.6.7.8:6514",
+					Encryption:      operatorv1.EncryptionTLS,
+					CAConfigMapName: "audit-syslog-ca",
+				}}
+				Expect(c.Update(ctx, lc)).NotTo(HaveOccurred())
+
+				By("Degrading while the CA of the destination does not exist")
+				mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Error with Syslog destination CA ConfigMap", mock.Anything, mock.Anything).Return()
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).Should(HaveOccurred())
+
+				Expect(c.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "audit-syslog-ca", Namespace: common.OperatorNamespace()},
+					Data:       map[string]string{corev1.TLSCertKey: "cert"},
+				})).NotTo(HaveOccurred())
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Defaulting the log types of the destination")
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, lc)).NotTo(HaveOccurred())
+				Expect(lc.Spec.AdditionalStores.Syslog.Destinations[0].LogTypes).To(Equal([]operatorv1.SyslogLogType{
+					operatorv1.SyslogLogAudit,
+					operatorv1.SyslogLogDNS,
+					operatorv1.SyslogLogFlows,
+				}))
+
+				cm := corev1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+					ObjectMeta: metav1.ObjectMeta{Name: render.FluentdSyslogOutputsConfigMapName, Namespace: render.LogCollectorNamespace},
+				}
+				Expect(test.GetResource(c, &cm)).To(BeNil())
+				Expect(cm.Data).To(HaveKey("tigera.calico.ee_audit.conf"))
+				Expect(cm.Data["tigera.calico.ee_audit.conf"]).To(ContainSubstring("ca_file /etc/fluentd/syslog-destination-cas/audit.crt\n"))
+
+				cas := corev1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+					ObjectMeta: metav1.ObjectMeta{Name: render.FluentdSyslogDestinationCAsConfigMapName, Namespace: render.LogCollectorNamespace},
+				}
+				Expect(test.GetResource(c, &cas)).To(BeNil())
+				Expect(cas.Data).To(Equal(map[string]string{"audit.crt": "cert"}))
+			})
+
+			Context("with mutual TLS", func() {
+				BeforeEach(func() {
+					lc := &operatorv1.LogCollector{}
+					Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-secure"}, lc)).NotTo(HaveOccurred())
+					lc.Spec.AdditionalStores.Syslog.Encryption = operatorv1.EncryptionTLS
+					Expect(c.Update(ctx, lc)).NotTo(HaveOccurred())
+				})
+
+				It("should mount the syslog client certificate", func() {
+					Expect(c.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      render.SyslogClientTLSSecretName,
+							Namespace: common.OperatorNamespace(),
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       []byte("cert"),
+							corev1.TLSPrivateKeyKey: []byte("key"),
+						},
+					})).NotTo(HaveOccurred())
+
+					_, err := r.Reconcile(ctx, reconcile.Request{})
+					Expect(err).ShouldNot(HaveOccurred())
+
+					secret := corev1.Secret{
+						TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      render.SyslogClientTLSSecretName,
+							Namespace: render.LogCollectorNamespace,
+						},
+					}
+					Expect(test.GetResource(c, &secret)).To(BeNil())
+
+					ds := appsv1.DaemonSet{
+						TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fluentd-node",
+							Namespace: render.LogCollectorNamespace,
+						},
+					}
+					Expect(test.GetResource(c, &ds)).To(BeNil())
+					Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+						corev1.EnvVar{Name: "SYSLOG_CLIENT_CERT_FILE", Value: "/etc/fluentd/syslog-client-tls/tls.crt"},
+						corev1.EnvVar{Name: "SYSLOG_CLIENT_KEY_FILE", Value: "/etc/fluentd/syslog-client-tls/tls.key"},
+					))
+				})
+
+				It("should degrade when the syslog client certificate secret is incomplete", func() {
+					Expect(c.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      render.SyslogClientTLSSecretName,
+							Namespace: common.OperatorNamespace(),
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey: []byte("cert"),
+						},
+					})).NotTo(HaveOccurred())
+					mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Error with Syslog client certificate secret", mock.Anything, mock.Anything).Return()
+
+					_, err := r.Reconcile(ctx, reconcile.Request{})
+					Expect(err).Should(HaveOccurred())
+				})
+			})
+
 			Context("Disable feature via license", func() {
 				BeforeEach(func() {
 					By("Deleting the previous license")
@@ -563,6 +1195,184 @@ var _ = Describe("LogCollector controller tests", func() {
 				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
 			})
 		})
+		Context("Forward to Loki", func() {
+			BeforeEach(func() {
+				By("Specify Loki log storage")
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+					Spec: operatorv1.LogCollectorSpec{
+						AdditionalStores: &operatorv1.AdditionalLogStoreSpec{
+							Loki: &operatorv1.LokiStoreSpec{
+								URL: "https://loki.example.com:3100",
+							},
+						},
+					},
+				})).NotTo(HaveOccurred())
+				By("Setting the license to export logs")
+				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{common.ExportLogsFeature}}})).NotTo(HaveOccurred())
+			})
+
+			It("should forward logs to Loki with basic auth credentials", func() {
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      render.LokiFluentdCredentialSecretName,
+						Namespace: common.OperatorNamespace(),
+					},
+					Data: map[string][]byte{
+						"username": []byte("user"),
+						"password": []byte("pass"),
+					},
+				})).NotTo(HaveOccurred())
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				secret := corev1.Secret{
+					TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      render.LokiFluentdCredentialSecretName,
+						Namespace: render.LogCollectorNamespace,
+					},
+				}
+				Expect(test.GetResource(c, &secret)).To(BeNil())
+
+				ds := appsv1.DaemonSet{
+					TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fluentd-node",
+						Namespace: render.LogCollectorNamespace,
+					},
+				}
+				Expect(test.GetResource(c, &ds)).To(BeNil())
+				Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElement(
+					corev1.EnvVar{Name: "LOKI_URL", Value: "https://loki.example.com:3100"},
+				))
+			})
+
+			It("should degrade when the Loki credential secret is incomplete", func() {
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      render.LokiFluentdCredentialSecretName,
+						Namespace: common.OperatorNamespace(),
+					},
+					Data: map[string][]byte{
+						"username": []byte("user"),
+					},
+				})).NotTo(HaveOccurred())
+				mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Error with Loki credential secret", mock.Anything, mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).Should(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
+			})
+		})
+		Context("Forward to OTLP", func() {
+			BeforeEach(func() {
+				By("Specify OTLP log storage")
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+					Spec: operatorv1.LogCollectorSpec{
+						AdditionalStores: &operatorv1.AdditionalLogStoreSpec{
+							OTLP: &operatorv1.OTLPStoreSpec{
+								Endpoint: "https://otel-collector.example.com:4317",
+							},
+						},
+					},
+				})).NotTo(HaveOccurred())
+				By("Setting the license to export logs")
+				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{common.ExportLogsFeature}}})).NotTo(HaveOccurred())
+			})
+
+			It("should forward logs to OTLP with headers", func() {
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      render.OTLPHeadersSecretName,
+						Namespace: common.OperatorNamespace(),
+					},
+					Data: map[string][]byte{
+						"Authorization": []byte("Bearer token"),
+					},
+				})).NotTo(HaveOccurred())
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				secret := corev1.Secret{
+					TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      render.OTLPHeadersSecretName,
+						Namespace: render.LogCollectorNamespace,
+					},
+				}
+				Expect(test.GetResource(c, &secret)).To(BeNil())
+				Expect(secret.Data).To(HaveKeyWithValue("Authorization", []byte("Bearer token")))
+
+				ds := appsv1.DaemonSet{
+					TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fluentd-node",
+						Namespace: render.LogCollectorNamespace,
+					},
+				}
+				Expect(test.GetResource(c, &ds)).To(BeNil())
+				Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+					corev1.EnvVar{Name: "OTLP_ENDPOINT", Value: "https://otel-collector.example.com:4317"},
+					corev1.EnvVar{Name: "OTLP_PROTOCOL", Value: "grpc"},
+				))
+
+			})
+
+			It("should degrade when the OTLP headers secret has an invalid header name", func() {
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      render.OTLPHeadersSecretName,
+						Namespace: common.OperatorNamespace(),
+					},
+					Data: map[string][]byte{
+						"bad header": []byte("value"),
+					},
+				})).NotTo(HaveOccurred())
+				mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Error with OTLP headers secret", mock.Anything, mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).Should(HaveOccurred())
+			})
+
+			It("should degrade when the OTLP CA ConfigMap has no certificate", func() {
+				Expect(c.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      render.OTLPCAConfigMapName,
+						Namespace: common.OperatorNamespace(),
+					},
+					Data: map[string]string{"ca.crt": "cert"},
+				})).NotTo(HaveOccurred())
+				mockStatus.On("SetDegraded", operatorv1.ResourceReadError, "Error loading OTLP certificate", mock.Anything, mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).Should(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(c.Delete(ctx, &operatorv1.LogCollector{
+					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				})).NotTo(HaveOccurred())
+				Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{}}})).NotTo(HaveOccurred())
+			})
+		})
 		Context("reconcile for Status condition update from tigerastatus", func() {
 			generation := int64(2)
 			It("should reconcile with one item ", func() {
@@ -742,17 +1552,19 @@ var _ = Describe("LogCollector controller tests", func() {
 
 		BeforeEach(func() {
 			mockStatus = &status.MockStatus{}
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("SetMetaData", mock.Anything).Return()
 
 			readyFlag = &utils.ReadyFlag{}
 			readyFlag.MarkAsReady()
 			r = ReconcileLogCollector{
-				client:          c,
-				scheme:          scheme,
-				status:          mockStatus,
-				licenseAPIReady: readyFlag,
-				tierWatchReady:  readyFlag,
+				client:              c,
+				scheme:              scheme,
+				status:              mockStatus,
+				licenseAPIReady:     readyFlag,
+				tierWatchReady:      readyFlag,
+				logSourceWatchReady: readyFlag,
+				logExportWatchReady: readyFlag,
 				opts: options.ControllerOptions{
 					DetectedProvider: operatorv1.ProviderNone,
 				},
@@ -806,6 +1618,96 @@ var _ = Describe("LogCollector controller tests", func() {
 		})
 	})
 
+	Context("fluentd filters", func() {
+		createFilters := func(namespace, flow string) {
+			Expect(c.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: render.FluentdFilterConfigMapName, Namespace: namespace},
+				Data:       map[string]string{render.FluentdFilterFlowName: flow},
+			})).NotTo(HaveOccurred())
+		}
+
+		It("should read the filters from the operator namespace", func() {
+			createFilters(common.OperatorNamespace(), "operator-flow-filter")
+
+			filters, err := getFluentdFilters(c, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).NotTo(BeNil())
+			Expect(filters.Flow).To(Equal("operator-flow-filter"))
+		})
+
+		It("should prefer the tenant's filters in multi-tenant management clusters", func() {
+			tenant := &operatorv1.Tenant{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "tenant-a"}}
+			createFilters(common.OperatorNamespace(), "operator-flow-filter")
+
+			filters, err := getFluentdFilters(c, tenant)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters.Flow).To(Equal("operator-flow-filter"))
+
+			createFilters("tenant-a", "tenant-flow-filter")
+			filters, err = getFluentdFilters(c, tenant)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters.Flow).To(Equal("tenant-flow-filter"))
+		})
+
+		It("should return no filters when none are configured", func() {
+			filters, err := getFluentdFilters(c, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeNil())
+		})
+	})
+
+	Context("fluentd additional outputs", func() {
+		createOutputs := func(data map[string]string) {
+			Expect(c.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: render.FluentdAdditionalOutputsConfigMapName, Namespace: common.OperatorNamespace()},
+				Data:       data,
+			})).NotTo(HaveOccurred())
+		}
+
+		It("should read valid outputs from the operator namespace", func() {
+			createOutputs(map[string]string{
+				"kafka.conf": "# Send flow logs to kafka\n<match tigera.calico.flows>\n  @type kafka2\n  <format>\n    @type json\n  </format>\n</match>\n",
+			})
+
+			outputs, err := getFluentdAdditionalOutputs(c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outputs).To(HaveKey("kafka.conf"))
+		})
+
+		It("should return no outputs when none are configured", func() {
+			outputs, err := getFluentdAdditionalOutputs(c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(outputs).To(BeNil())
+		})
+
+		It("should reject keys that are not .conf files", func() {
+			createOutputs(map[string]string{"kafka": "<match **>\n  @type null\n</match>"})
+
+			_, err := getFluentdAdditionalOutputs(c)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject configuration other than match sections", func() {
+			for _, config := range []string{
+				"<source>\n  @type forward\n</source>",
+				"<match **>\n  @type null\n",
+				"<match **>\n  @include /etc/passwd\n</match>",
+				"@type null",
+				"# only a comment",
+			} {
+				cm := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: render.FluentdAdditionalOutputsConfigMapName, Namespace: common.OperatorNamespace()},
+					Data:       map[string]string{"output.conf": config},
+				}
+				Expect(c.Create(ctx, cm)).NotTo(HaveOccurred())
+
+				_, err := getFluentdAdditionalOutputs(c)
+				Expect(err).To(HaveOccurred(), config)
+				Expect(c.Delete(ctx, cm)).NotTo(HaveOccurred())
+			}
+		})
+	})
+
 	Context("Reconciliation", func() {
 		It("create namespace, operator secrets role and pull secrets", func() {
 			result, err := r.Reconcile(ctx, reconcile.Request{})
@@ -848,6 +1750,153 @@ var _ = Describe("LogCollector controller tests", func() {
 		})
 	})
 
+	Context("LogSources", func() {
+		It("should add the valid LogSources to the fluentd configuration and report their status", func() {
+			Expect(c.Create(ctx, &operatorv1.LogSource{
+				ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "shop"},
+				Spec: operatorv1.LogSourceSpec{
+					ContainerName: "checkout",
+					Parser:        operatorv1.LogParserSpec{Type: operatorv1.LogParserTypeJSON},
+				},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, &operatorv1.LogSource{
+				ObjectMeta: metav1.ObjectMeta{Name: "payments", Namespace: "shop"},
+				Spec: operatorv1.LogSourceSpec{
+					ContainerName: "payments",
+					Parser:        operatorv1.LogParserSpec{Type: operatorv1.LogParserTypeRegexp},
+				},
+			})).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			cm := corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: render.FluentdLogSourcesConfigMapName, Namespace: render.LogCollectorNamespace},
+			}
+			Expect(test.GetResource(c, &cm)).To(BeNil())
+			Expect(cm.Data).To(HaveLen(1))
+			Expect(cm.Data).To(HaveKey("shop_checkout.conf"))
+
+			valid := &operatorv1.LogSource{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "checkout", Namespace: "shop"}, valid)).NotTo(HaveOccurred())
+			accepted := meta.FindStatusCondition(valid.Status.Conditions, operatorv1.LogSourceConditionAccepted)
+			Expect(accepted).NotTo(BeNil())
+			Expect(accepted.Status).To(Equal(metav1.ConditionTrue))
+			Expect(accepted.Reason).To(Equal(operatorv1.LogSourceReasonValid))
+
+			invalid := &operatorv1.LogSource{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "payments", Namespace: "shop"}, invalid)).NotTo(HaveOccurred())
+			accepted = meta.FindStatusCondition(invalid.Status.Conditions, operatorv1.LogSourceConditionAccepted)
+			Expect(accepted).NotTo(BeNil())
+			Expect(accepted.Status).To(Equal(metav1.ConditionFalse))
+			Expect(accepted.Reason).To(Equal(operatorv1.LogSourceReasonInvalid))
+			Expect(accepted.Message).To(ContainSubstring("spec.Parser.Expression is required"))
+		})
+	})
+
+	Context("LogExports", func() {
+		BeforeEach(func() {
+			Expect(c.Delete(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, &v3.LicenseKey{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Status: v3.LicenseKeyStatus{Features: []string{common.ExportLogsFeature}}})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "export-creds", Namespace: common.OperatorNamespace()},
+				Data: map[string][]byte{
+					render.S3KeyIdName:     []byte("id"),
+					render.S3KeySecretName: []byte("secret"),
+				},
+			})).NotTo(HaveOccurred())
+		})
+
+		logExport := func(name, secretName string) *operatorv1.LogExport {
+			return &operatorv1.LogExport{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: operatorv1.LogExportSpec{
+					Schedule: "0 0 * * *",
+					Query:    operatorv1.LogExportQuery{LogType: operatorv1.LogExportLogFlows},
+					Destination: operatorv1.LogExportDestination{
+						S3: &operatorv1.LogExportS3Destination{
+							Region:                "us-west-1",
+							BucketName:            "reports",
+							CredentialsSecretName: secretName,
+						},
+					},
+				},
+			}
+		}
+
+		succeeded := func(name string) (*operatorv1.LogExport, *metav1.Condition) {
+			le := &operatorv1.LogExport{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name}, le)).NotTo(HaveOccurred())
+			return le, meta.FindStatusCondition(le.Status.Conditions, operatorv1.LogExportConditionSucceeded)
+		}
+
+		It("should render a CronJob for each valid LogExport and report the result of its runs", func() {
+			Expect(c.Create(ctx, logExport("daily-flows", "export-creds"))).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, logExport("missing-creds", "does-not-exist"))).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			cj := batchv1.CronJob{
+				TypeMeta:   metav1.TypeMeta{Kind: "CronJob", APIVersion: "batch/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: render.LogExportCronJobName("daily-flows"), Namespace: render.LogCollectorNamespace},
+			}
+			Expect(test.GetResource(c, &cj)).To(BeNil())
+			Expect(cj.Spec.Schedule).To(Equal("0 0 * * *"))
+			Expect(test.GetResource(c, &corev1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: render.LogExportsSecretName, Namespace: render.LogCollectorNamespace},
+			})).To(BeNil())
+			Expect(test.GetResource(c, &batchv1.CronJob{
+				TypeMeta:   metav1.TypeMeta{Kind: "CronJob", APIVersion: "batch/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: render.LogExportCronJobName("missing-creds"), Namespace: render.LogCollectorNamespace},
+			})).NotTo(BeNil())
+
+			_, condition := succeeded("missing-creds")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(operatorv1.LogExportReasonInvalid))
+			Expect(condition.Message).To(ContainSubstring("does-not-exist"))
+
+			_, condition = succeeded("daily-flows")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
+			Expect(condition.Reason).To(Equal(operatorv1.LogExportReasonPending))
+
+			By("Finishing a run of the export")
+			Expect(c.Create(ctx, &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "log-export-daily-flows-1",
+					Namespace: render.LogCollectorNamespace,
+					Labels:    map[string]string{"k8s-app": render.LogExportName, render.LogExportLabel: "daily-flows"},
+				},
+				Status: batchv1.JobStatus{
+					Conditions: []batchv1.JobCondition{{
+						Type:               batchv1.JobComplete,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: metav1.Now(),
+					}},
+				},
+			})).NotTo(HaveOccurred())
+
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			le, condition := succeeded("daily-flows")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(operatorv1.LogExportReasonSucceeded))
+			Expect(le.Status.LastSuccessfulTime).NotTo(BeNil())
+
+			By("Deleting the LogExport")
+			Expect(c.Delete(ctx, le)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(test.GetResource(c, &cj)).NotTo(BeNil())
+		})
+	})
+
 	Context("License expiry", func() {
 		It("should set degraded status and delete fluentd DaemonSet when license is expired", func() {
 			// First reconcile to create fluentd resources.
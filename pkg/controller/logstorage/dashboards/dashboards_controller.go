@@ -76,7 +76,7 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 	r := &DashboardsSubController{
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
-		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageDashboards, opts.KubernetesVersion),
+		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageDashboards, opts.KubernetesVersion, opts.EventRecorder),
 		clusterDomain:   opts.ClusterDomain,
 		provider:        opts.DetectedProvider,
 		tierWatchReady:  &utils.ReadyFlag{},
@@ -252,7 +252,7 @@ func (d DashboardsSubController) Reconcile(ctx context.Context, request reconcil
 		return reconcile.Result{}, err
 	}
 
-	d.status.OnCRFound()
+	d.status.OnCRFound(logStorage)
 
 	// Determine where to access Kibana.
 	kibanaHost := "tigera-secure-kb-http.tigera-kibana.svc"
@@ -215,7 +215,7 @@ var _ = Describe("LogStorage controller", func() {
 
 				BeforeEach(func() {
 					setUpLogStorageComponents(cli, ctx, storageClassName, certificateManager)
-					mockStatus.On("OnCRFound").Return()
+					mockStatus.On("OnCRFound", mock.Anything).Return()
 					// mockStatus.On("SetMetaData", mock.Anything).Return()
 				})
 
@@ -320,7 +320,7 @@ var _ = Describe("LogStorage controller", func() {
 				mockStatus.On("Run").Return()
 				mockStatus.On("AddStatefulSets", mock.Anything)
 				mockStatus.On("RemoveCertificateSigningRequests", mock.Anything).Return()
-				mockStatus.On("OnCRFound").Return()
+				mockStatus.On("OnCRFound", mock.Anything).Return()
 				mockStatus.On("ReadyToMonitor")
 				mockStatus.On("RemoveCronJobs", mock.Anything)
 			})
@@ -414,21 +414,25 @@ var _ = Describe("LogStorage controller", func() {
 				// Modify ConfigMap we expect to be reverted by a call to Reconcile
 				_, ok := esConfigMap.Data["test-field"]
 				Expect(ok).To(BeFalse())
+				renderedData := esConfigMap.Data
 
 				esConfigMap.Data = map[string]string{
 					"test-field": "test-data",
 				}
-				Expect(cli.Update(ctx, &esConfigMap)).NotTo(HaveOccurred())
+				Expect(cli.Update(ctx, &esConfigMap, client.FieldOwner("kubectl-edit"))).NotTo(HaveOccurred())
 
 				mockStatus.On("ClearDegraded")
 				result, err = r.Reconcile(ctx, reconcile.Request{})
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(result).Should(Equal(successResult))
 
-				// Verify that the ConfigMap was reverted to the original state
+				// Verify that the data of the operator was reverted to the original state. The field that was added
+				// by someone else is theirs, so the apply leaves it alone.
 				Expect(cli.Get(ctx, esConfigMapKey, &esConfigMap)).NotTo(HaveOccurred())
-				_, ok = esConfigMap.Data["test-field"]
-				Expect(ok).To(BeFalse())
+				for k, v := range renderedData {
+					Expect(esConfigMap.Data).To(HaveKeyWithValue(k, v))
+				}
+				Expect(esConfigMap.Data).To(HaveKeyWithValue("test-field", "test-data"))
 
 				mockStatus.AssertExpectations(GinkgoT())
 			})
@@ -1149,7 +1153,7 @@ var _ = Describe("LogStorage controller", func() {
 
 					mockStatus = &status.MockStatus{}
 					mockStatus.On("Run").Return()
-					mockStatus.On("OnCRFound").Return()
+					mockStatus.On("OnCRFound", mock.Anything).Return()
 					// mockStatus.On("SetMetaData", mock.Anything).Return()
 
 					var err error
@@ -1210,7 +1214,7 @@ var _ = Describe("LogStorage controller", func() {
 				mockStatus.On("AddStatefulSets", mock.Anything)
 				mockStatus.On("RemoveCertificateSigningRequests", mock.Anything)
 				mockStatus.On("ClearDegraded", mock.Anything)
-				mockStatus.On("OnCRFound").Return()
+				mockStatus.On("OnCRFound", mock.Anything).Return()
 				mockStatus.On("ReadyToMonitor")
 				mockStatus.On("RemoveCronJobs", mock.Anything)
 				readyFlag = &utils.ReadyFlag{}
@@ -62,7 +62,7 @@ func AddExternalES(mgr manager.Manager, opts options.ControllerOptions) error {
 	r := &ExternalESController{
 		client: mgr.GetClient(),
 		scheme: mgr.GetScheme(),
-		status: status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageElastic, opts.KubernetesVersion),
+		status: status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageElastic, opts.KubernetesVersion, opts.EventRecorder),
 		opts:   opts,
 	}
 	r.status.Run(opts.ShutdownContext)
@@ -117,7 +117,7 @@ func (r *ExternalESController) Reconcile(ctx context.Context, request reconcile.
 		r.status.OnCRNotFound()
 		return reconcile.Result{}, nil
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(ls)
 
 	_, installationSpec, err := utils.GetInstallationSpec(context.Background(), r.client)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
 
 	admissionv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -183,7 +184,7 @@ var _ = Describe("External ES Controller", func() {
 			})).NotTo(HaveOccurred())
 		mockStatus = &status.MockStatus{}
 		mockStatus.On("Run").Return()
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("ReadyToMonitor")
 	})
 
@@ -75,7 +75,7 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 	r := &ESMetricsSubController{
 		client:         mgr.GetClient(),
 		scheme:         mgr.GetScheme(),
-		status:         status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageESMetrics, opts.KubernetesVersion),
+		status:         status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageESMetrics, opts.KubernetesVersion, opts.EventRecorder),
 		clusterDomain:  opts.ClusterDomain,
 		provider:       opts.DetectedProvider,
 		tierWatchReady: &utils.ReadyFlag{},
@@ -99,6 +99,9 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 	if err = utils.AddConfigMapWatch(c, certificatemanagement.TrustedCertConfigMapName, render.ElasticsearchNamespace, &handler.EnqueueRequestForObject{}); err != nil {
 		return fmt.Errorf("log-storage-esmetrics-controller failed to watch the Service resource: %w", err)
 	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("log-storage-esmetrics-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
 
 	secretsToWatch := []string{
 		esmetrics.ElasticsearchMetricsSecret,
@@ -145,7 +148,7 @@ func (r *ESMetricsSubController) Reconcile(ctx context.Context, request reconcil
 		return reconcile.Result{}, err
 	}
 
-	r.status.OnCRFound()
+	r.status.OnCRFound(logStorage)
 
 	// Wait for the initializing controller to indicate that the LogStorage object is actionable.
 	if logStorage.Status.State != operatorv1.TigeraStatusReady {
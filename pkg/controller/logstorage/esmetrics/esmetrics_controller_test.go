@@ -100,7 +100,7 @@ var _ = Describe("LogStorage Linseed controller", func() {
 		mockStatus.On("Run").Return()
 		mockStatus.On("AddDeployments", mock.Anything)
 		mockStatus.On("ReadyToMonitor")
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("ReadyToMonitor")
 		mockStatus.On("ClearDegraded")
 
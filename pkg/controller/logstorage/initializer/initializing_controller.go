@@ -17,6 +17,7 @@ package initializer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 
@@ -68,7 +69,7 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		scheme:      mgr.GetScheme(),
 		multiTenant: opts.MultiTenant,
 		externalES:  opts.ElasticExternal,
-		status:      status.New(mgr.GetClient(), TigeraStatusName, opts.KubernetesVersion),
+		status:      status.New(mgr.GetClient(), TigeraStatusName, opts.KubernetesVersion, opts.EventRecorder),
 	}
 	r.status.Run(opts.ShutdownContext)
 
@@ -184,6 +185,10 @@ func validateLogStorage(spec *operatorv1.LogStorageSpec) error {
 		return err
 	}
 
+	if err := validateIndexLifecycle(spec); err != nil {
+		return err
+	}
+
 	return validateComponentResources(spec)
 }
 
@@ -201,6 +206,22 @@ func validateReplicasForNodeCount(spec *operatorv1.LogStorageSpec) error {
 	return nil
 }
 
+func validateIndexLifecycle(spec *operatorv1.LogStorageSpec) error {
+	if spec.Indices == nil || spec.Indices.Lifecycle == nil {
+		return nil
+	}
+
+	lifecycle := spec.Indices.Lifecycle
+	if age := lifecycle.RolloverMaxAge; age != nil && (age.Duration <= 0 || age.Duration%time.Second != 0) {
+		return fmt.Errorf("LogStorage spec.indices.lifecycle.rolloverMaxAge (%s) must be a positive whole number of seconds", age.Duration)
+	}
+	if size := lifecycle.RolloverMaxSize; size != nil && size.Sign() <= 0 {
+		return fmt.Errorf("LogStorage spec.indices.lifecycle.rolloverMaxSize (%s) must be positive", size.String())
+	}
+
+	return nil
+}
+
 func validateComponentResources(spec *operatorv1.LogStorageSpec) error {
 	if spec.ComponentResources == nil {
 		return fmt.Errorf("LogStorage spec.ComponentResources is nil %+v", spec)
@@ -234,7 +255,7 @@ func (r *LogStorageInitializer) Reconcile(ctx context.Context, request reconcile
 	}
 
 	// We found the LogStorage instance.
-	r.status.OnCRFound()
+	r.status.OnCRFound(ls)
 
 	// Get Installation resource.
 	_, installationSpec, err := utils.GetInstallationSpec(context.Background(), r.client)
@@ -280,25 +301,36 @@ func (r *LogStorageInitializer) Reconcile(ctx context.Context, request reconcile
 
 	// Before we can create secrets, we need to ensure the tigera-elasticsearch namespace exists.
 	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, ls)
-	components := []render.Component{render.NewSetup(&render.SetUpConfiguration{
+	esPSS, esRequiredPSS := r.elasticsearchPSS()
+	setUpCfgs := []*render.SetUpConfiguration{{
 		OpenShift:       r.provider.IsOpenShift(),
 		Installation:    installationSpec,
 		PullSecrets:     pullSecrets,
 		Namespace:       render.ElasticsearchNamespace,
-		PSS:             r.elasticsearchPSS(),
+		PSS:             esPSS,
+		RequiredPSS:     esRequiredPSS,
 		CreateNamespace: true,
-	})}
+	}}
 
 	// Multitenant clusters do not get kibana, so namespace creation can be skipped.
 	if !r.multiTenant {
-		components = append(components, render.NewSetup(&render.SetUpConfiguration{
+		setUpCfgs = append(setUpCfgs, &render.SetUpConfiguration{
 			OpenShift:       r.provider.IsOpenShift(),
 			Installation:    installationSpec,
 			PullSecrets:     pullSecrets,
 			Namespace:       kibana.Namespace,
-			PSS:             render.PSSBaseline,
+			PSS:             kibana.PodSecurityStandard,
 			CreateNamespace: true,
-		}))
+		})
+	}
+
+	var components []render.Component
+	for _, setUpCfg := range setUpCfgs {
+		if _, err := setUpCfg.PodSecurityStandard(); err != nil {
+			r.status.SetDegraded(operatorv1.ResourceValidationError, "Failed to resolve the pod security standard of the namespace", err, reqLogger)
+			return reconcile.Result{}, nil
+		}
+		components = append(components, render.NewSetup(setUpCfg))
 	}
 
 	for _, component := range components {
@@ -325,11 +357,13 @@ func (r *LogStorageInitializer) Reconcile(ctx context.Context, request reconcile
 	return reconcile.Result{}, nil
 }
 
-func (r *LogStorageInitializer) elasticsearchPSS() render.PodSecurityStandard {
+// elasticsearchPSS returns the pod security standard that the Elasticsearch namespace enforces by default, and the
+// least privileged one that its components can run under.
+func (r *LogStorageInitializer) elasticsearchPSS() (render.PodSecurityStandard, render.PodSecurityStandard) {
 	if r.externalES {
-		return render.PSSBaseline
+		return render.PSSBaseline, render.ExternalElasticsearchPodSecurityStandard
 	}
-	return render.PSSPrivileged
+	return render.ElasticsearchPodSecurityStandard, render.ElasticsearchPodSecurityStandard
 }
 
 func (r *LogStorageInitializer) setConditionReady(ctx context.Context, ls *operatorv1.LogStorage, log logr.Logger) error {
@@ -122,7 +122,7 @@ var _ = Describe("LogStorage Initializing controller", func() {
 
 			mockStatus = &status.MockStatus{}
 			mockStatus.On("Run")
-			mockStatus.On("OnCRFound")
+			mockStatus.On("OnCRFound", mock.Anything)
 			mockStatus.On("SetMetaData", mock.Anything)
 			mockStatus.On("ReadyToMonitor")
 			mockStatus.On("ClearDegraded")
@@ -425,6 +425,36 @@ var _ = Describe("LogStorage Initializing controller", func() {
 		})
 	})
 
+	Context("validateIndexLifecycle", func() {
+		It("should accept a rollover policy", func() {
+			size := resource.MustParse("50Gi")
+			spec := &operatorv1.LogStorageSpec{
+				Indices: &operatorv1.Indices{Lifecycle: &operatorv1.IndexLifecycle{
+					RolloverMaxAge:  &metav1.Duration{Duration: 12 * time.Hour},
+					RolloverMaxSize: &size,
+				}},
+			}
+			Expect(validateIndexLifecycle(spec)).To(BeNil())
+		})
+
+		It("should reject a rollover age that is not a whole number of seconds", func() {
+			spec := &operatorv1.LogStorageSpec{
+				Indices: &operatorv1.Indices{Lifecycle: &operatorv1.IndexLifecycle{
+					RolloverMaxAge: &metav1.Duration{Duration: 1500 * time.Millisecond},
+				}},
+			}
+			Expect(validateIndexLifecycle(spec)).NotTo(BeNil())
+		})
+
+		It("should reject a rollover size that is not positive", func() {
+			size := resource.MustParse("0")
+			spec := &operatorv1.LogStorageSpec{
+				Indices: &operatorv1.Indices{Lifecycle: &operatorv1.IndexLifecycle{RolloverMaxSize: &size}},
+			}
+			Expect(validateIndexLifecycle(spec)).NotTo(BeNil())
+		})
+	})
+
 	Context("validateComponentResources", func() {
 		ls := operatorv1.LogStorage{Spec: operatorv1.LogStorageSpec{}}
 
@@ -82,7 +82,7 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
 		clusterDomain:   opts.ClusterDomain,
-		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageKubeController, opts.KubernetesVersion),
+		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageKubeController, opts.KubernetesVersion, opts.EventRecorder),
 		elasticExternal: opts.ElasticExternal,
 		multiTenant:     opts.MultiTenant,
 		tierWatchReady:  &utils.ReadyFlag{},
@@ -110,6 +110,9 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 	if err = c.WatchObject(&operatorv1.ManagementClusterConnection{}, eventHandler); err != nil {
 		return fmt.Errorf("log-storage-kubecontrollers failed to watch ManagementClusterConnection resource: %w", err)
 	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("log-storage-kubecontrollers failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
 	if err = utils.AddTigeraStatusWatch(c, initializer.TigeraStatusLogStorageKubeController); err != nil {
 		return fmt.Errorf("logstorage-controller failed to watch logstorage Tigerastatus: %w", err)
 	}
@@ -193,7 +196,7 @@ func (r *ESKubeControllersController) Reconcile(ctx context.Context, request rec
 	}
 
 	// We found the LogStorage instance (and Tenant instance if in multi-tenant mode).
-	r.status.OnCRFound()
+	r.status.OnCRFound(logStorage)
 
 	// Wait for the initializing controller to indicate that the LogStorage object is actionable.
 	if logStorage.Status.State != operatorv1.TigeraStatusReady {
@@ -160,7 +160,7 @@ var _ = Describe("LogStorage ES kube-controllers controller", func() {
 		mockStatus.On("AddStatefulSets", mock.Anything)
 		mockStatus.On("RemoveCertificateSigningRequests", mock.Anything).Return()
 		mockStatus.On("AddCronJobs", mock.Anything)
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("ReadyToMonitor")
 		mockStatus.On("SetDegraded", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 		mockStatus.On("ClearDegraded")
@@ -164,7 +164,7 @@ var _ = Describe("LogStorage Linseed controller", func() {
 			mockStatus.On("AddStatefulSets", mock.Anything)
 			mockStatus.On("RemoveCertificateSigningRequests", mock.Anything).Return()
 			mockStatus.On("AddCronJobs", mock.Anything)
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("ReadyToMonitor")
 			mockStatus.On("SetDegraded", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 			mockStatus.On("ClearDegraded")
@@ -322,7 +322,7 @@ var _ = Describe("LogStorage Linseed controller", func() {
 			mockStatus.On("AddStatefulSets", mock.Anything)
 			mockStatus.On("RemoveCertificateSigningRequests", mock.Anything).Return()
 			mockStatus.On("AddCronJobs", mock.Anything)
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("ReadyToMonitor")
 			mockStatus.On("SetDegraded", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 			mockStatus.On("ClearDegraded")
@@ -426,7 +426,7 @@ var _ = Describe("LogStorage Linseed controller", func() {
 			Expect(errors.IsNotFound(err)).Should(BeTrue())
 
 			// Check that OnCRFound was not called.
-			mockStatus.AssertNotCalled(GinkgoT(), "OnCRFound")
+			mockStatus.AssertNotCalled(GinkgoT(), "OnCRFound", mock.Anything)
 		})
 
 		It("should reconcile resources for a cluster", func() {
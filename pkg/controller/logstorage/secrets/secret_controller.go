@@ -76,7 +76,7 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		scheme:          mgr.GetScheme(),
 		clusterDomain:   opts.ClusterDomain,
 		multiTenant:     opts.MultiTenant,
-		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageSecrets, opts.KubernetesVersion),
+		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageSecrets, opts.KubernetesVersion, opts.EventRecorder),
 		elasticExternal: opts.ElasticExternal,
 	}
 	r.status.Run(opts.ShutdownContext)
@@ -107,6 +107,13 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 	if err = c.WatchObject(&operatorv1.ManagementClusterConnection{}, eventHandler); err != nil {
 		return fmt.Errorf("log-storage-secrets-controller failed to watch ManagementClusterConnection resource: %w", err)
 	}
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("log-storage-secrets-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("log-storage-secrets-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	if err = utils.AddTigeraStatusWatch(c, initializer.TigeraStatusLogStorageSecrets); err != nil {
 		return fmt.Errorf("logstorage-controller failed to watch logstorage Tigerastatus: %w", err)
 	}
@@ -190,7 +197,7 @@ func (r *SecretSubController) Reconcile(ctx context.Context, request reconcile.R
 	}
 
 	// We found the LogStorage instance.
-	r.status.OnCRFound()
+	r.status.OnCRFound(ls)
 
 	// We skip requests without a namespace specified in multi-tenant setups.
 	if r.multiTenant && request.Namespace == "" {
@@ -83,7 +83,7 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
 		multiTenant:     opts.MultiTenant,
-		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageUsers, opts.KubernetesVersion),
+		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageUsers, opts.KubernetesVersion, opts.EventRecorder),
 		esClientFn:      utils.NewElasticClient,
 		elasticExternal: opts.ElasticExternal,
 	}
@@ -194,7 +194,7 @@ func (r *UserController) Reconcile(ctx context.Context, request reconcile.Reques
 	}
 
 	// We found the LogStorage instance (and Tenant instance if in multi-tenant mode).
-	r.status.OnCRFound()
+	r.status.OnCRFound(logStorage)
 
 	// Wait for the initializing controller to indicate that the LogStorage object is actionable.
 	if logStorage.Status.State != operatorv1.TigeraStatusReady {
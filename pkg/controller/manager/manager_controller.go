@@ -20,6 +20,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -71,9 +72,10 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 
 	licenseAPIReady := &utils.ReadyFlag{}
 	tierWatchReady := &utils.ReadyFlag{}
+	managedClusterWatchReady := &utils.ReadyFlag{}
 
 	// create the reconciler
-	reconciler := newReconciler(mgr, opts, licenseAPIReady, tierWatchReady)
+	reconciler := newReconciler(mgr, opts, licenseAPIReady, tierWatchReady, managedClusterWatchReady)
 
 	// Create a new controller
 	c, err := ctrlruntime.NewController("manager-controller", mgr, controller.Options{Reconciler: reconciler})
@@ -97,6 +99,12 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 
 	go utils.WaitToAddLicenseKeyWatch(c, opts.K8sClientset, log, licenseAPIReady)
 	go utils.WaitToAddTierWatch(networkpolicy.CalicoTierName, c, opts.K8sClientset, log, tierWatchReady)
+	if !opts.MultiTenant {
+		// ManagedClusters are only read while rotating the tunnel CA, which multi-tenant clusters do not support.
+		go utils.WaitToAddResourceWatch(c, opts.K8sClientset, log, managedClusterWatchReady, []client.Object{
+			&v3.ManagedCluster{TypeMeta: metav1.TypeMeta{Kind: v3.KindManagedCluster}},
+		})
+	}
 	policiesToWatch := []types.NamespacedName{
 		{Name: render.ManagerPolicyName, Namespace: helper.InstallNamespace()},
 	}
@@ -149,6 +157,13 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 	if err = c.WatchObject(&operatorv1.Authentication{}, eventHandler); err != nil {
 		return fmt.Errorf("manager-controller failed to watch resource: %w", err)
 	}
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("manager-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("manager-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("manager-controller failed to watch manager Tigerastatus: %w", err)
 	}
@@ -174,7 +189,7 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 			// We need to watch for es-gateway certificate because ui-apis still creates a
 			// client to talk to elastic via es-gateway
 			render.ManagerTLSSecretName, relasticsearch.PublicCertSecret,
-			render.VoltronTunnelSecretName, render.VoltronAdditionalTunnelSecretName,
+			render.VoltronTunnelSecretName, render.VoltronAdditionalTunnelSecretName, render.VoltronNextTunnelSecretName,
 			render.ComplianceServerCertSecret, render.PacketCaptureServerCert,
 			render.ManagerInternalTLSSecretName, monitor.PrometheusServerTLSSecretName, certificatemanagement.CASecretName,
 		} {
@@ -206,14 +221,15 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager, opts options.ControllerOptions, licenseAPIReady *utils.ReadyFlag, tierWatchReady *utils.ReadyFlag) reconcile.Reconciler {
+func newReconciler(mgr manager.Manager, opts options.ControllerOptions, licenseAPIReady, tierWatchReady, managedClusterWatchReady *utils.ReadyFlag) reconcile.Reconciler {
 	c := &ReconcileManager{
-		client:          mgr.GetClient(),
-		scheme:          mgr.GetScheme(),
-		status:          status.New(mgr.GetClient(), "manager", opts.KubernetesVersion),
-		licenseAPIReady: licenseAPIReady,
-		tierWatchReady:  tierWatchReady,
-		opts:            opts,
+		client:                   mgr.GetClient(),
+		scheme:                   mgr.GetScheme(),
+		status:                   status.New(mgr.GetClient(), "manager", opts.KubernetesVersion, opts.EventRecorder),
+		licenseAPIReady:          licenseAPIReady,
+		tierWatchReady:           tierWatchReady,
+		managedClusterWatchReady: managedClusterWatchReady,
+		opts:                     opts,
 	}
 	c.status.Run(opts.ShutdownContext)
 	return c
@@ -231,6 +247,9 @@ type ReconcileManager struct {
 	licenseAPIReady *utils.ReadyFlag
 	tierWatchReady  *utils.ReadyFlag
 	opts            options.ControllerOptions
+
+	// managedClusterWatchReady is set once ManagedClusters are watched, and so can be read from the cache.
+	managedClusterWatchReady *utils.ReadyFlag
 }
 
 // GetManager returns the default manager instance with defaults populated.
@@ -287,7 +306,7 @@ func (r *ReconcileManager) Reconcile(ctx context.Context, request reconcile.Requ
 		return reconcile.Result{}, err
 	}
 	logc.V(2).Info("Loaded config", "config", instance)
-	r.status.OnCRFound()
+	r.status.OnCRFound(instance)
 
 	// SetMetaData in the TigeraStatus such as observedGenerations.
 	defer r.status.SetMetaData(&instance.ObjectMeta)
@@ -530,6 +549,8 @@ func (r *ReconcileManager) Reconcile(ctx context.Context, request reconcile.Requ
 	var linseedVoltronServerCert certificatemanagement.KeyPairInterface
 	var tunnelServerCert certificatemanagement.KeyPairInterface
 	var tunnelSecretPassthrough render.Component
+	var nextTunnelServerCert certificatemanagement.KeyPairInterface
+	var caRotation tunnelCARotation
 
 	if managementCluster != nil {
 		preDefaultPatchFrom := client.MergeFrom(managementCluster.DeepCopy())
@@ -592,11 +613,36 @@ func (r *ReconcileManager) Reconcile(ctx context.Context, request reconcile.Requ
 					i--
 				}
 			}
+
+			// Progress any rotation of the tunnel CA. While a rotation is in progress, Voltron serves the replacement
+			// CA alongside the current one.
+			caRotation, err = r.rotateTunnelCA(ctx, managementCluster, tunnelCASecret, serverName, logc)
+			if err != nil {
+				r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error rotating the tunnel CA", err, logc)
+				return reconcile.Result{}, err
+			}
+			if caRotation.next != nil {
+				nextTunnelServerCert = certificatemanagement.NewKeyPair(caRotation.next, nil, "")
+			}
 		}
 
 		// We use the CA as the server cert.
 		tunnelServerCert = certificatemanagement.NewKeyPair(tunnelCASecret, nil, "")
-		tunnelSecretPassthrough = render.NewCreationPassthrough(tunnelCASecret)
+		switch {
+		case caRotation.next != nil:
+			tunnelSecretPassthrough = render.NewCreationPassthrough(tunnelCASecret, caRotation.next)
+		case caRotation.swapped:
+			// The replacement CA is now the tunnel CA, so remove the secret that held it and its copy.
+			tunnelSecretPassthrough = render.NewPassthrough(
+				[]client.Object{tunnelCASecret},
+				[]client.Object{
+					&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: render.VoltronNextTunnelSecretName, Namespace: helper.TruthNamespace()}},
+					&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: render.VoltronNextTunnelSecretName, Namespace: helper.InstallNamespace()}},
+				},
+			)
+		default:
+			tunnelSecretPassthrough = render.NewCreationPassthrough(tunnelCASecret)
+		}
 	}
 
 	keyValidatorConfig, err := utils.GetKeyValidatorConfig(ctx, r.client, authenticationCR, r.opts.ClusterDomain)
@@ -705,6 +751,7 @@ func (r *ReconcileManager) Reconcile(ctx context.Context, request reconcile.Requ
 		NonClusterHost:             nonclusterhost,
 		TunnelServerCert:           tunnelServerCert,
 		AdditionalTunnelServerCert: additionalTunnelServerCert,
+		NextTunnelServerCert:       nextTunnelServerCert,
 		InternalTLSKeyPair:         internalTrafficSecret,
 		ClusterDomain:              r.opts.ClusterDomain,
 		ESLicenseType:              elasticLicenseType,
@@ -750,6 +797,7 @@ func (r *ReconcileManager) Reconcile(ctx context.Context, request reconcile.Requ
 				rcertificatemanagement.NewKeyPairOption(internalTrafficSecret, true, true),
 				rcertificatemanagement.NewKeyPairOption(tunnelServerCert, false, true),
 				rcertificatemanagement.NewKeyPairOption(additionalTunnelServerCert, false, true),
+				rcertificatemanagement.NewKeyPairOption(nextTunnelServerCert, false, true),
 			},
 			TrustedBundle: bundleMaker,
 		}),
@@ -782,7 +830,7 @@ func (r *ReconcileManager) Reconcile(ctx context.Context, request reconcile.Requ
 		}
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: caRotation.requeueAfter}, nil
 }
 
 func fillDefaults(mc *operatorv1.ManagementCluster) {
@@ -16,10 +16,14 @@ package manager
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
 	"fmt"
+	"math/big"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -37,6 +41,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 	operatorv1 "github.com/tigera/operator/api/v1"
@@ -144,7 +149,7 @@ var _ = Describe("Manager controller tests", func() {
 			mockStatus.On("RemoveCertificateSigningRequests", mock.Anything).Return()
 			mockStatus.On("AddCronJobs", mock.Anything)
 			mockStatus.On("IsAvailable").Return(true)
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("ClearDegraded")
 			mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 			mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -157,11 +162,12 @@ var _ = Describe("Manager controller tests", func() {
 			mockStatus.On("SetMetaData", mock.Anything).Return()
 
 			r = ReconcileManager{
-				client:          c,
-				scheme:          scheme,
-				status:          mockStatus,
-				licenseAPIReady: &utils.ReadyFlag{},
-				tierWatchReady:  &utils.ReadyFlag{},
+				client:                   c,
+				scheme:                   scheme,
+				status:                   mockStatus,
+				licenseAPIReady:          &utils.ReadyFlag{},
+				tierWatchReady:           &utils.ReadyFlag{},
+				managedClusterWatchReady: &utils.ReadyFlag{},
 				opts: options.ControllerOptions{
 					ClusterDomain:    clusterDomain,
 					DetectedProvider: operatorv1.ProviderNone,
@@ -447,11 +453,12 @@ var _ = Describe("Manager controller tests", func() {
 			mockStatus = &status.MockStatus{}
 
 			r = ReconcileManager{
-				client:          c,
-				scheme:          scheme,
-				status:          mockStatus,
-				licenseAPIReady: &utils.ReadyFlag{},
-				tierWatchReady:  &utils.ReadyFlag{},
+				client:                   c,
+				scheme:                   scheme,
+				status:                   mockStatus,
+				licenseAPIReady:          &utils.ReadyFlag{},
+				tierWatchReady:           &utils.ReadyFlag{},
+				managedClusterWatchReady: &utils.ReadyFlag{},
 				opts: options.ControllerOptions{
 					DetectedProvider: operatorv1.ProviderNone,
 				},
@@ -525,7 +532,7 @@ var _ = Describe("Manager controller tests", func() {
 				mockStatus.On("AddStatefulSets", mock.Anything).Return()
 				mockStatus.On("AddCronJobs", mock.Anything)
 				mockStatus.On("IsAvailable").Return(true)
-				mockStatus.On("OnCRFound").Return()
+				mockStatus.On("OnCRFound", mock.Anything).Return()
 				mockStatus.On("ClearDegraded")
 				mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 				mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -701,17 +708,18 @@ var _ = Describe("Manager controller tests", func() {
 				var readyFlag *utils.ReadyFlag
 				BeforeEach(func() {
 					mockStatus = &status.MockStatus{}
-					mockStatus.On("OnCRFound").Return()
+					mockStatus.On("OnCRFound", mock.Anything).Return()
 					mockStatus.On("SetMetaData", mock.Anything).Return()
 
 					readyFlag = &utils.ReadyFlag{}
 					readyFlag.MarkAsReady()
 					r = ReconcileManager{
-						client:          c,
-						scheme:          scheme,
-						status:          mockStatus,
-						licenseAPIReady: readyFlag,
-						tierWatchReady:  readyFlag,
+						client:                   c,
+						scheme:                   scheme,
+						status:                   mockStatus,
+						licenseAPIReady:          readyFlag,
+						tierWatchReady:           readyFlag,
+						managedClusterWatchReady: readyFlag,
 						opts: options.ControllerOptions{
 							DetectedProvider: operatorv1.ProviderNone,
 						},
@@ -732,7 +740,7 @@ var _ = Describe("Manager controller tests", func() {
 				It("should degrade if license is not present", func() {
 					Expect(c.Delete(ctx, licenseKey)).NotTo(HaveOccurred())
 					mockStatus = &status.MockStatus{}
-					mockStatus.On("OnCRFound").Return()
+					mockStatus.On("OnCRFound", mock.Anything).Return()
 					mockStatus.On("SetDegraded", operatorv1.ResourceNotFound, "License not found", "licensekeies.projectcalico.org \"default\" not found", mock.Anything).Return()
 					mockStatus.On("SetMetaData", mock.Anything).Return()
 					r.status = mockStatus
@@ -747,7 +755,7 @@ var _ = Describe("Manager controller tests", func() {
 					compliance.Status.State = ""
 					Expect(c.Status().Update(ctx, compliance)).NotTo(HaveOccurred())
 					mockStatus = &status.MockStatus{}
-					mockStatus.On("OnCRFound").Return()
+					mockStatus.On("OnCRFound", mock.Anything).Return()
 					mockStatus.On("SetDegraded", operatorv1.ResourceNotReady, "Compliance is not ready", mock.Anything, mock.Anything).Return()
 					mockStatus.On("SetMetaData", mock.Anything).Return()
 					r.status = mockStatus
@@ -761,7 +769,7 @@ var _ = Describe("Manager controller tests", func() {
 				DescribeTable("should not degrade when compliance CR or compliance license feature is not present/active", func(crPresent, licenseFeatureActive bool) {
 					mockStatus = &status.MockStatus{}
 					mockStatus.On("IsAvailable").Return(true)
-					mockStatus.On("OnCRFound").Return()
+					mockStatus.On("OnCRFound", mock.Anything).Return()
 					mockStatus.On("AddDeployments", mock.Anything)
 					mockStatus.On("RemoveDeployments", []types.NamespacedName{{Name: render.LegacyManagerDeploymentName, Namespace: render.LegacyManagerNamespace}}).Return()
 					mockStatus.On("ClearDegraded")
@@ -1096,6 +1104,192 @@ var _ = Describe("Manager controller tests", func() {
 					Expect(len(clusterConnection.OwnerReferences)).To(Equal(1))
 					Expect(clusterConnection.OwnerReferences[0].Kind).To(Equal("Manager"))
 				})
+
+				Context("tunnel CA rotation", func() {
+					var tunnelSecret, nextSecret *corev1.Secret
+
+					BeforeEach(func() {
+						r.managedClusterWatchReady = &utils.ReadyFlag{}
+						r.managedClusterWatchReady.MarkAsReady()
+
+						var err error
+						tunnelSecret, err = certificatemanagement.CreateSelfSignedSecret(render.VoltronTunnelSecretName, common.OperatorNamespace(), "tigera-voltron", []string{"voltron"})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(c.Create(ctx, tunnelSecret)).NotTo(HaveOccurred())
+						nextSecret, err = certificatemanagement.CreateSelfSignedSecret(render.VoltronNextTunnelSecretName, common.OperatorNamespace(), "tigera-voltron", []string{"voltron"})
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(c.Create(ctx, &operatorv1.Manager{
+							ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure", Namespace: common.OperatorNamespace()},
+						})).NotTo(HaveOccurred())
+					})
+
+					createManagementCluster := func(interval time.Duration) {
+						Expect(c.Create(ctx, &operatorv1.ManagementCluster{
+							ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+							Spec: operatorv1.ManagementClusterSpec{
+								TunnelCARotationInterval: &metav1.Duration{Duration: interval},
+							},
+						})).NotTo(HaveOccurred())
+					}
+
+					It("should not rotate the tunnel CA before the rotation interval has elapsed", func() {
+						createManagementCluster(24 * time.Hour)
+
+						result, err := r.Reconcile(ctx, reconcile.Request{})
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(result.RequeueAfter).To(BeNumerically(">", 23*time.Hour))
+
+						err = c.Get(ctx, types.NamespacedName{Name: render.VoltronNextTunnelSecretName, Namespace: common.OperatorNamespace()}, &corev1.Secret{})
+						Expect(kerror.IsNotFound(err)).To(BeTrue())
+					})
+
+					It("should generate a replacement CA and serve it from Voltron once the rotation interval has elapsed", func() {
+						createManagementCluster(time.Nanosecond)
+
+						_, err := r.Reconcile(ctx, reconcile.Request{})
+						Expect(err).ShouldNot(HaveOccurred())
+
+						next := &corev1.Secret{}
+						Expect(c.Get(ctx, types.NamespacedName{Name: render.VoltronNextTunnelSecretName, Namespace: common.OperatorNamespace()}, next)).NotTo(HaveOccurred())
+						Expect(c.Get(ctx, types.NamespacedName{Name: render.VoltronNextTunnelSecretName, Namespace: render.ManagerNamespace}, &corev1.Secret{})).NotTo(HaveOccurred())
+						Expect(next.Data[corev1.TLSCertKey]).NotTo(Equal(tunnelSecret.Data[corev1.TLSCertKey]))
+
+						current := &corev1.Secret{}
+						Expect(c.Get(ctx, types.NamespacedName{Name: render.VoltronTunnelSecretName, Namespace: common.OperatorNamespace()}, current)).NotTo(HaveOccurred())
+						Expect(current.Data[corev1.TLSCertKey]).To(Equal(tunnelSecret.Data[corev1.TLSCertKey]))
+
+						deployment := appsv1.Deployment{}
+						Expect(c.Get(ctx, types.NamespacedName{Name: render.ManagerName, Namespace: render.ManagerNamespace}, &deployment)).NotTo(HaveOccurred())
+						Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", render.VoltronNextTunnelSecretName)))
+					})
+
+					It("should keep serving both CAs while a managed cluster has not moved to the replacement CA", func() {
+						createManagementCluster(time.Nanosecond)
+						Expect(c.Create(ctx, nextSecret)).NotTo(HaveOccurred())
+						currentCert, _, err := certificatemanagement.CreateClientCertificate(tunnelSecret, "managed")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(c.Create(ctx, &v3.ManagedCluster{
+							ObjectMeta: metav1.ObjectMeta{Name: "managed"},
+							Spec:       v3.ManagedClusterSpec{Certificate: currentCert},
+							Status: v3.ManagedClusterStatus{
+								Conditions: []v3.ManagedClusterStatusCondition{
+									{Type: v3.ManagedClusterStatusTypeConnected, Status: v3.ManagedClusterStatusValueFalse},
+								},
+							},
+						})).NotTo(HaveOccurred())
+
+						result, err := r.Reconcile(ctx, reconcile.Request{})
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(result.RequeueAfter).To(Equal(tunnelCARotationCheckInterval))
+
+						Expect(c.Get(ctx, types.NamespacedName{Name: render.VoltronNextTunnelSecretName, Namespace: common.OperatorNamespace()}, &corev1.Secret{})).NotTo(HaveOccurred())
+						current := &corev1.Secret{}
+						Expect(c.Get(ctx, types.NamespacedName{Name: render.VoltronTunnelSecretName, Namespace: common.OperatorNamespace()}, current)).NotTo(HaveOccurred())
+						Expect(current.Data[corev1.TLSCertKey]).To(Equal(tunnelSecret.Data[corev1.TLSCertKey]))
+
+						By("reissuing the certificate of the managed cluster with the replacement CA")
+						managedCluster := &v3.ManagedCluster{}
+						Expect(c.Get(ctx, types.NamespacedName{Name: "managed"}, managedCluster)).NotTo(HaveOccurred())
+						roots, err := caPool(nextSecret)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(signedBy(managedCluster.Spec.Certificate, roots)).To(BeTrue())
+
+						guardianSecret := &corev1.Secret{}
+						Expect(yaml.Unmarshal([]byte(managedCluster.Spec.InstallationManifest), guardianSecret)).NotTo(HaveOccurred())
+						Expect(guardianSecret.Name).To(Equal(render.GuardianSecretName))
+						Expect(guardianSecret.Namespace).To(Equal(common.OperatorNamespace()))
+						Expect(signedBy(guardianSecret.Data["managed-cluster.crt"], roots)).To(BeTrue())
+
+						By("trusting both the current and the reissued certificate until the rotation completes")
+						Expect(string(managedCluster.Spec.Certificate)).To(Equal(string(currentCert) + string(guardianSecret.Data["managed-cluster.crt"])))
+						Expect(guardianSecret.Data).To(HaveKey("managed-cluster.key"))
+						Expect(string(guardianSecret.Data["management-cluster.crt"])).To(Equal(
+							string(tunnelSecret.Data[corev1.TLSCertKey]) + string(nextSecret.Data[corev1.TLSCertKey])))
+
+						By("not reissuing a certificate that is already signed by the replacement CA")
+						_, err = r.Reconcile(ctx, reconcile.Request{})
+						Expect(err).ShouldNot(HaveOccurred())
+						reissued := &v3.ManagedCluster{}
+						Expect(c.Get(ctx, types.NamespacedName{Name: "managed"}, reissued)).NotTo(HaveOccurred())
+						Expect(reissued.Spec.Certificate).To(Equal(managedCluster.Spec.Certificate))
+					})
+
+					It("should wait for the ManagedCluster watch before progressing a rotation", func() {
+						r.managedClusterWatchReady = &utils.ReadyFlag{}
+						createManagementCluster(24 * time.Hour)
+						Expect(c.Create(ctx, nextSecret)).NotTo(HaveOccurred())
+
+						result, err := r.Reconcile(ctx, reconcile.Request{})
+						Expect(err).ShouldNot(HaveOccurred())
+						Expect(result.RequeueAfter).To(Equal(tunnelCARotationCheckInterval))
+
+						current := &corev1.Secret{}
+						Expect(c.Get(ctx, types.NamespacedName{Name: render.VoltronTunnelSecretName, Namespace: common.OperatorNamespace()}, current)).NotTo(HaveOccurred())
+						Expect(current.Data[corev1.TLSCertKey]).To(Equal(tunnelSecret.Data[corev1.TLSCertKey]))
+					})
+
+					It("should swap in the replacement CA once all managed clusters have moved to it", func() {
+						createManagementCluster(24 * time.Hour)
+						Expect(c.Create(ctx, nextSecret)).NotTo(HaveOccurred())
+
+						_, err := r.Reconcile(ctx, reconcile.Request{})
+						Expect(err).ShouldNot(HaveOccurred())
+
+						current := &corev1.Secret{}
+						Expect(c.Get(ctx, types.NamespacedName{Name: render.VoltronTunnelSecretName, Namespace: common.OperatorNamespace()}, current)).NotTo(HaveOccurred())
+						Expect(current.Data[corev1.TLSCertKey]).To(Equal(nextSecret.Data[corev1.TLSCertKey]))
+						Expect(current.Data[corev1.TLSPrivateKeyKey]).To(Equal(nextSecret.Data[corev1.TLSPrivateKeyKey]))
+
+						err = c.Get(ctx, types.NamespacedName{Name: render.VoltronNextTunnelSecretName, Namespace: common.OperatorNamespace()}, &corev1.Secret{})
+						Expect(kerror.IsNotFound(err)).To(BeTrue())
+					})
+
+					It("should complete the rotation once the grace period has elapsed even if a managed cluster has not moved", func() {
+						createManagementCluster(24 * time.Hour)
+
+						// Generate a replacement CA that was created before the grace period.
+						key, err := rsa.GenerateKey(rand.Reader, certificatemanagement.VoltronKeySizeBits)
+						Expect(err).NotTo(HaveOccurred())
+						tmpl := &x509.Certificate{
+							IsCA:                  true,
+							BasicConstraintsValid: true,
+							SerialNumber:          big.NewInt(1),
+							Subject:               pkix.Name{CommonName: "tigera-voltron"},
+							NotBefore:             time.Now().Add(-tunnelCARotationGracePeriod - time.Hour),
+							NotAfter:              time.Now().Add(24 * time.Hour),
+							KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+						}
+						der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+						Expect(err).NotTo(HaveOccurred())
+						nextSecret.Data = map[string][]byte{
+							corev1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+							corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+						}
+						Expect(c.Create(ctx, nextSecret)).NotTo(HaveOccurred())
+
+						currentCert, _, err := certificatemanagement.CreateClientCertificate(tunnelSecret, "managed")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(c.Create(ctx, &v3.ManagedCluster{
+							ObjectMeta: metav1.ObjectMeta{Name: "managed"},
+							Spec:       v3.ManagedClusterSpec{Certificate: currentCert},
+						})).NotTo(HaveOccurred())
+
+						_, err = r.Reconcile(ctx, reconcile.Request{})
+						Expect(err).ShouldNot(HaveOccurred())
+
+						current := &corev1.Secret{}
+						Expect(c.Get(ctx, types.NamespacedName{Name: render.VoltronTunnelSecretName, Namespace: common.OperatorNamespace()}, current)).NotTo(HaveOccurred())
+						Expect(current.Data[corev1.TLSCertKey]).To(Equal(nextSecret.Data[corev1.TLSCertKey]))
+
+						By("only keeping the certificate signed by the replacement CA")
+						managedCluster := &v3.ManagedCluster{}
+						Expect(c.Get(ctx, types.NamespacedName{Name: "managed"}, managedCluster)).NotTo(HaveOccurred())
+						guardianSecret := &corev1.Secret{}
+						Expect(yaml.Unmarshal([]byte(managedCluster.Spec.InstallationManifest), guardianSecret)).NotTo(HaveOccurred())
+						Expect(managedCluster.Spec.Certificate).To(Equal(guardianSecret.Data["managed-cluster.crt"]))
+					})
+				})
 			})
 
 			Context("FIPS reconciliation", func() {
@@ -1188,7 +1382,7 @@ var _ = Describe("Manager controller tests", func() {
 			tenantBNamespace := "tenant-b"
 
 			BeforeEach(func() {
-				mockStatus.On("OnCRFound").Return()
+				mockStatus.On("OnCRFound", mock.Anything).Return()
 				mockStatus.On("SetMetaData", mock.Anything).Return()
 				mockStatus.On("RemoveCertificateSigningRequests", mock.Anything)
 				mockStatus.On("AddDeployments", mock.Anything).Return()
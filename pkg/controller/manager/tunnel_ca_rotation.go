@@ -0,0 +1,311 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/pkg/render"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
+)
+
+// tunnelCARotationCheckInterval is how often the controller checks whether the managed clusters have moved to the
+// replacement tunnel CA while a rotation is in progress.
+const tunnelCARotationCheckInterval = 5 * time.Minute
+
+// tunnelCARotationGracePeriod is how long after the replacement tunnel CA is generated the managed clusters are given
+// to apply their reissued guardian secret. Voltron trusts the certificates signed by both CAs until then, after which
+// the rotation completes even if some managed clusters have not moved.
+const tunnelCARotationGracePeriod = 7 * 24 * time.Hour
+
+// The keys of the guardian secret in a managed cluster.
+const (
+	guardianCertKey      = "managed-cluster.crt"
+	guardianKeyKey       = "managed-cluster.key"
+	guardianTunnelCAsKey = "management-cluster.crt"
+)
+
+// guardianSecretComment heads the installation manifest of a managed cluster whose certificate has been reissued.
+const guardianSecretComment = "# Apply to the managed cluster to move it to the replacement tunnel CA of its management cluster.\n"
+
+// tunnelCARotation is the outcome of a step of the tunnel CA rotation.
+type tunnelCARotation struct {
+	// next is the secret holding the replacement tunnel CA, or nil if no rotation is in progress.
+	next *corev1.Secret
+
+	// swapped is true if the replacement CA has just been copied into the tunnel secret, so that the secret
+	// holding it should be deleted.
+	swapped bool
+
+	// requeueAfter is how long to wait before the rotation should be checked again, or zero if it need not be.
+	requeueAfter time.Duration
+}
+
+// rotateTunnelCA progresses the rotation of the tunnel CA held in tunnelCASecret:
+//   - Once the rotation interval has elapsed, a replacement CA is generated. Voltron serves it alongside the current
+//     CA and the apiserver signs the certificates of new managed clusters with it.
+//   - The guardian certificates of existing managed clusters are reissued with the replacement CA, and delivered
+//     through the installation manifest of each managed cluster along with both CAs.
+//   - Once the grace period has elapsed, or earlier if no managed cluster holds a certificate signed by the current
+//     CA, the replacement is copied into tunnelCASecret, which the caller must then update, and the certificates
+//     signed by the current CA are removed from the managed clusters.
+func (r *ReconcileManager) rotateTunnelCA(ctx context.Context, mc *operatorv1.ManagementCluster, tunnelCASecret *corev1.Secret, serverName string, log logr.Logger) (tunnelCARotation, error) {
+	// Only the CA that the operator generates for single-tenant clusters can be rotated.
+	if r.opts.MultiTenant || mc.Spec.TLS == nil || mc.Spec.TLS.SecretName != render.VoltronTunnelSecretName {
+		return tunnelCARotation{}, nil
+	}
+
+	next, err := utils.GetSecret(ctx, r.client, render.VoltronNextTunnelSecretName, tunnelCASecret.Namespace)
+	if err != nil {
+		return tunnelCARotation{}, err
+	}
+
+	if next == nil {
+		interval := mc.Spec.TunnelCARotationInterval
+		if interval == nil {
+			return tunnelCARotation{}, nil
+		}
+		if interval.Duration <= 0 {
+			return tunnelCARotation{}, fmt.Errorf("spec.tunnelCARotationInterval must be positive")
+		}
+		cert, err := certificatemanagement.ParseCertificate(tunnelCASecret.Data[corev1.TLSCertKey])
+		if err != nil {
+			return tunnelCARotation{}, fmt.Errorf("failed to parse the certificate in secret %s: %w", tunnelCASecret.Name, err)
+		}
+		if due := time.Until(cert.NotBefore.Add(interval.Duration)); due > 0 {
+			return tunnelCARotation{requeueAfter: due}, nil
+		}
+
+		log.Info("Tunnel CA rotation interval has elapsed, generating a replacement CA")
+		next, err = certificatemanagement.CreateSelfSignedSecret(render.VoltronNextTunnelSecretName, tunnelCASecret.Namespace, "tigera-voltron", []string{serverName})
+		if err != nil {
+			return tunnelCARotation{}, err
+		}
+		return tunnelCARotation{next: next, requeueAfter: tunnelCARotationCheckInterval}, nil
+	}
+
+	// ManagedClusters are read from the cache, which only holds them once they are watched.
+	if !r.managedClusterWatchReady.IsReady() {
+		log.Info("Waiting for the ManagedCluster watch to be established before progressing the tunnel CA rotation")
+		return tunnelCARotation{next: next, requeueAfter: tunnelCARotationCheckInterval}, nil
+	}
+	if err := r.reissueGuardianCertificates(ctx, tunnelCASecret, next, log); err != nil {
+		return tunnelCARotation{}, err
+	}
+
+	migrated, err := r.managedClustersMigrated(ctx, next)
+	if err != nil {
+		return tunnelCARotation{}, err
+	}
+	if !migrated {
+		nextCert, err := certificatemanagement.ParseCertificate(next.Data[corev1.TLSCertKey])
+		if err != nil {
+			return tunnelCARotation{}, fmt.Errorf("failed to parse the certificate in secret %s: %w", next.Name, err)
+		}
+		if remaining := time.Until(nextCert.NotBefore.Add(tunnelCARotationGracePeriod)); remaining > 0 {
+			return tunnelCARotation{next: next, requeueAfter: min(remaining, tunnelCARotationCheckInterval)}, nil
+		}
+		log.Info("Tunnel CA rotation grace period has elapsed, completing the rotation")
+	} else {
+		log.Info("All managed clusters have moved to the replacement tunnel CA, completing the rotation")
+	}
+	if err := r.dropPreviousCertificates(ctx, next, log); err != nil {
+		return tunnelCARotation{}, err
+	}
+
+	tunnelCASecret.Data = next.Data
+	if tunnelCASecret.Labels == nil {
+		tunnelCASecret.Labels = map[string]string{}
+	}
+	for k, v := range next.Labels {
+		tunnelCASecret.Labels[k] = v
+	}
+	if tunnelCASecret.Annotations == nil {
+		tunnelCASecret.Annotations = map[string]string{}
+	}
+	for k, v := range next.Annotations {
+		tunnelCASecret.Annotations[k] = v
+	}
+	if interval := mc.Spec.TunnelCARotationInterval; interval != nil {
+		return tunnelCARotation{swapped: true, requeueAfter: interval.Duration}, nil
+	}
+	return tunnelCARotation{swapped: true}, nil
+}
+
+// reissueGuardianCertificates issues every managed cluster that holds no certificate signed by the CA in next a new
+// certificate signed by it. The new certificate is appended to the current one, so that Voltron accepts the managed
+// cluster with either until the rotation completes. The certificate and its key are written to the installation
+// manifest of the managed cluster as its guardian secret, which also trusts both the current and the replacement CA
+// so that guardian can connect to Voltron before and after the swap.
+func (r *ReconcileManager) reissueGuardianCertificates(ctx context.Context, current, next *corev1.Secret, log logr.Logger) error {
+	roots, err := caPool(next)
+	if err != nil {
+		return err
+	}
+	tunnelCAs := append(append([]byte{}, current.Data[corev1.TLSCertKey]...), next.Data[corev1.TLSCertKey]...)
+
+	managedClusters := &v3.ManagedClusterList{}
+	if err := r.client.List(ctx, managedClusters); err != nil {
+		return fmt.Errorf("failed to list managed clusters: %w", err)
+	}
+	for i := range managedClusters.Items {
+		managedCluster := &managedClusters.Items[i]
+		if signedBy(managedCluster.Spec.Certificate, roots) {
+			continue
+		}
+		cert, key, err := certificatemanagement.CreateClientCertificate(next, managedCluster.Name)
+		if err != nil {
+			return fmt.Errorf("failed to issue a certificate for managed cluster %s: %w", managedCluster.Name, err)
+		}
+		manifest, err := guardianSecretManifest(managedCluster, cert, key, tunnelCAs)
+		if err != nil {
+			return err
+		}
+		managedCluster.Spec.Certificate = append(append([]byte{}, managedCluster.Spec.Certificate...), cert...)
+		managedCluster.Spec.InstallationManifest = manifest
+		if err := r.client.Update(ctx, managedCluster); err != nil {
+			return fmt.Errorf("failed to update managed cluster %s: %w", managedCluster.Name, err)
+		}
+		log.Info("Reissued the certificate of a managed cluster with the replacement tunnel CA", "managedCluster", managedCluster.Name)
+	}
+	return nil
+}
+
+// guardianSecretManifest returns the manifest of the guardian secret of the given managed cluster.
+func guardianSecretManifest(managedCluster *v3.ManagedCluster, cert, key, tunnelCAs []byte) (string, error) {
+	namespace := managedCluster.Spec.OperatorNamespace
+	if namespace == "" {
+		namespace = common.OperatorNamespace()
+	}
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: render.GuardianSecretName, Namespace: namespace},
+		Data: map[string][]byte{
+			guardianCertKey:      cert,
+			guardianKeyKey:       key,
+			guardianTunnelCAsKey: tunnelCAs,
+		},
+	}
+	manifest, err := yaml.Marshal(secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the guardian secret of managed cluster %s: %w", managedCluster.Name, err)
+	}
+	return guardianSecretComment + string(manifest), nil
+}
+
+// managedClustersMigrated returns true if every managed cluster only holds certificates signed by the CA in the given
+// secret, which is the case for the managed clusters created since the rotation started.
+func (r *ReconcileManager) managedClustersMigrated(ctx context.Context, caSecret *corev1.Secret) (bool, error) {
+	roots, err := caPool(caSecret)
+	if err != nil {
+		return false, err
+	}
+
+	managedClusters := &v3.ManagedClusterList{}
+	if err := r.client.List(ctx, managedClusters); err != nil {
+		return false, fmt.Errorf("failed to list managed clusters: %w", err)
+	}
+	for _, managedCluster := range managedClusters.Items {
+		if len(certificatesSignedBy(managedCluster.Spec.Certificate, roots)) != len(certificateBlocks(managedCluster.Spec.Certificate)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// dropPreviousCertificates removes the certificates that are not signed by the CA in the given secret from every
+// managed cluster, so that Voltron stops accepting them once the rotation completes.
+func (r *ReconcileManager) dropPreviousCertificates(ctx context.Context, caSecret *corev1.Secret, log logr.Logger) error {
+	roots, err := caPool(caSecret)
+	if err != nil {
+		return err
+	}
+
+	managedClusters := &v3.ManagedClusterList{}
+	if err := r.client.List(ctx, managedClusters); err != nil {
+		return fmt.Errorf("failed to list managed clusters: %w", err)
+	}
+	for i := range managedClusters.Items {
+		managedCluster := &managedClusters.Items[i]
+		certs := certificatesSignedBy(managedCluster.Spec.Certificate, roots)
+		if len(certs) == 0 || len(certs) == len(certificateBlocks(managedCluster.Spec.Certificate)) {
+			continue
+		}
+		managedCluster.Spec.Certificate = bytes.Join(certs, nil)
+		if err := r.client.Update(ctx, managedCluster); err != nil {
+			return fmt.Errorf("failed to update managed cluster %s: %w", managedCluster.Name, err)
+		}
+		log.Info("Removed the certificate signed by the previous tunnel CA from a managed cluster", "managedCluster", managedCluster.Name)
+	}
+	return nil
+}
+
+func caPool(caSecret *corev1.Secret) (*x509.CertPool, error) {
+	ca, err := certificatemanagement.ParseCertificate(caSecret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the certificate in secret %s: %w", caSecret.Name, err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	return roots, nil
+}
+
+// signedBy returns true if one of the given PEM encoded certificates is signed by one of the given CAs.
+func signedBy(certsPEM []byte, roots *x509.CertPool) bool {
+	return len(certificatesSignedBy(certsPEM, roots)) > 0
+}
+
+// certificatesSignedBy returns the PEM encoded certificates among the given ones that are signed by one of the
+// given CAs.
+func certificatesSignedBy(certsPEM []byte, roots *x509.CertPool) [][]byte {
+	var signed [][]byte
+	for _, certPEM := range certificateBlocks(certsPEM) {
+		cert, err := certificatemanagement.ParseCertificate(certPEM)
+		if err != nil {
+			continue
+		}
+		if _, err := cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}}); err == nil {
+			signed = append(signed, certPEM)
+		}
+	}
+	return signed
+}
+
+// certificateBlocks splits the given PEM data into its PEM encoded blocks.
+func certificateBlocks(certsPEM []byte) [][]byte {
+	var blocks [][]byte
+	for {
+		var block *pem.Block
+		block, certsPEM = pem.Decode(certsPEM)
+		if block == nil {
+			return blocks
+		}
+		blocks = append(blocks, pem.EncodeToMemory(block))
+	}
+}
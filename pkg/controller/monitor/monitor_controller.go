@@ -103,7 +103,7 @@ func newReconciler(mgr manager.Manager, opts options.ControllerOptions, promethe
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
 		provider:        opts.DetectedProvider,
-		status:          status.New(mgr.GetClient(), "monitor", opts.KubernetesVersion),
+		status:          status.New(mgr.GetClient(), "monitor", opts.KubernetesVersion, opts.EventRecorder),
 		prometheusReady: prometheusReady,
 		tierWatchReady:  tierWatchReady,
 		licenseAPIReady: licenseAPIReady,
@@ -145,12 +145,18 @@ func add(_ manager.Manager, c ctrlruntime.Controller) error {
 		return fmt.Errorf("monitor-controller failed to watch FelixConfiguration resource: %w", err)
 	}
 
+	// The LogCollector configures the port that Prometheus scrapes the fluentd metrics on.
+	if err = c.WatchObject(&operatorv1.LogCollector{}, &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("monitor-controller failed to watch LogCollector resource: %w", err)
+	}
+
 	for _, secret := range []string{
 		certificatemanagement.CASecretName,
 		esmetrics.ElasticsearchMetricsServerTLSSecret,
 		monitor.PrometheusServerTLSSecretName,
 		render.FluentdPrometheusTLSSecretName,
 		render.NodePrometheusTLSServerSecret,
+		render.TyphaPrometheusTLSServerSecret,
 		kubecontrollers.KubeControllerPrometheusTLSSecret,
 		render.EKSLogForwarderTLSSecretName,
 	} {
@@ -170,6 +176,13 @@ func add(_ manager.Manager, c ctrlruntime.Controller) error {
 		return fmt.Errorf("monitor-controller failed to watch resource: %w", err)
 	}
 
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("monitor-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("monitor-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("monitor-controller failed to watch monitor Tigerastatus: %w", err)
@@ -217,7 +230,7 @@ func (r *ReconcileMonitor) Reconcile(ctx context.Context, request reconcile.Requ
 		return reconcile.Result{}, err
 	}
 	reqLogger.V(2).Info("Loaded config", "config", instance)
-	r.status.OnCRFound()
+	r.status.OnCRFound(instance)
 	// SetMetaData in the TigeraStatus such as observedGenerations.
 	defer r.status.SetMetaData(&instance.ObjectMeta)
 
@@ -340,6 +353,7 @@ func (r *ReconcileMonitor) Reconcile(ctx context.Context, request reconcile.Requ
 		esmetrics.ElasticsearchMetricsServerTLSSecret,
 		render.FluentdPrometheusTLSSecretName,
 		render.NodePrometheusTLSServerSecret,
+		render.TyphaPrometheusTLSServerSecret,
 		render.CalicoAPIServerTLSSecretName,
 		kubecontrollers.KubeControllerPrometheusTLSSecret,
 	} {
@@ -416,6 +430,12 @@ func (r *ReconcileMonitor) Reconcile(ctx context.Context, request reconcile.Requ
 		return reconcile.Result{}, err
 	}
 
+	fluentdMetricsPort, err := utils.GetFluentdMetricsPort(ctx, r.client)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving LogCollector", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	// Create operator TLS keypair only when mTLS is enabled (METRICS_SCHEME=https).
 	// The Service and ServiceMonitor are created whenever metrics are enabled.
 	operatorMetricsEnabled := common.MetricsEnabled()
@@ -444,11 +464,14 @@ func (r *ReconcileMonitor) Reconcile(ctx context.Context, request reconcile.Requ
 		OpenShift:                     r.provider.IsOpenShift(),
 		KubeControllerPort:            kubeControllersMetricsPort,
 		FelixPrometheusMetricsEnabled: utils.IsFelixPrometheusMetricsEnabled(felixConfiguration),
+		FelixMetricsPort:              utils.GetFelixPrometheusMetricsPort(felixConfiguration),
+		FluentdMetricsPort:            fluentdMetricsPort,
 		LicenseExpired:                licenseExpired,
 		OperatorMetricsEnabled:        operatorMetricsEnabled,
 		OperatorNamespace:             common.OperatorNamespace(),
 		OperatorName:                  common.OperatorName(),
 		OperatorTLSSecret:             operatorTLSSecret,
+		OperatorHealthEnabled:         common.HealthEnabled(),
 	}
 
 	// Render prometheus component
@@ -85,7 +85,7 @@ var _ = Describe("Monitor controller tests", func() {
 		mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 		mockStatus.On("ClearWarning", mock.Anything).Return()
 		mockStatus.On("IsAvailable").Return(true)
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("ReadyToMonitor")
 		mockStatus.On("RemoveDeployments", mock.Anything)
 		mockStatus.On("RemoveCertificateSigningRequests", common.TigeraPrometheusNamespace)
@@ -257,7 +257,7 @@ var _ = Describe("Monitor controller tests", func() {
 		It("should degrade and wait if tier is ready but tier watch is not ready", func() {
 			r.tierWatchReady = &utils.ReadyFlag{}
 			mockStatus = &status.MockStatus{}
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("RemoveCertificateSigningRequests", mock.Anything)
 			mockStatus.On("SetMetaData", mock.Anything).Return()
 			mockStatus.On("AddStatefulSets", mock.Anything).Return()
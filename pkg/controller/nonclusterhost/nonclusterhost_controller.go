@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"reflect"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -28,11 +29,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/certificatemanager"
+	"github.com/tigera/operator/pkg/controller/csr"
 	"github.com/tigera/operator/pkg/controller/options"
 	"github.com/tigera/operator/pkg/controller/status"
 	"github.com/tigera/operator/pkg/controller/utils"
 	"github.com/tigera/operator/pkg/ctrlruntime"
+	"github.com/tigera/operator/pkg/render"
 	"github.com/tigera/operator/pkg/render/nonclusterhost"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
 	"github.com/tigera/operator/pkg/url"
 )
 
@@ -61,7 +67,7 @@ func newReconciler(mgr manager.Manager, opts options.ControllerOptions) reconcil
 	r := &ReconcileNonClusterHost{
 		client: mgr.GetClient(),
 		scheme: mgr.GetScheme(),
-		status: status.New(mgr.GetClient(), "non-cluster-hosts", opts.KubernetesVersion),
+		status: status.New(mgr.GetClient(), "non-cluster-hosts", opts.KubernetesVersion, opts.EventRecorder),
 	}
 	r.status.Run(opts.ShutdownContext)
 	return r
@@ -97,7 +103,7 @@ func (r *ReconcileNonClusterHost) Reconcile(ctx context.Context, request reconci
 	}
 
 	logc.V(2).Info("Loaded config", "config", instance)
-	r.status.OnCRFound()
+	r.status.OnCRFound(instance)
 	defer r.status.SetMetaData(&instance.ObjectMeta)
 
 	// Validate endpoint fields
@@ -124,6 +130,15 @@ func (r *ReconcileNonClusterHost) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, err
 	}
 
+	// Publish how non-cluster hosts obtain a client certificate for the fluentd input service.
+	if distribution := logInputClientCertificate(instance); !reflect.DeepEqual(distribution, instance.Status.LogInputClientCertificate) {
+		instance.Status.LogInputClientCertificate = distribution
+		if err = r.client.Status().Update(ctx, instance); err != nil {
+			r.status.SetDegraded(operatorv1.ResourceUpdateError, "Failed to update NonClusterHost status", err, logc)
+			return reconcile.Result{}, err
+		}
+	}
+
 	r.status.ReadyToMonitor()
 	r.status.ClearDegraded()
 
@@ -133,3 +148,23 @@ func (r *ReconcileNonClusterHost) Reconcile(ctx context.Context, request reconci
 
 	return reconcile.Result{}, nil
 }
+
+// logInputClientCertificate returns how non-cluster hosts obtain the client certificate the fluentd input service
+// requires, or nil if it does not require one. Hosts request the certificate from the CSR controller, which signs it
+// once it has verified that the host has a HostEndpoint and that its service account may request the common name.
+func logInputClientCertificate(instance *operatorv1.NonClusterHost) *operatorv1.ClientCertificateDistribution {
+	if !instance.LogInputClientCertificateRequired() {
+		return nil
+	}
+	return &operatorv1.ClientCertificateDistribution{
+		TokenSecret: fmt.Sprintf("%s/%s", common.CalicoNamespace, nonclusterhost.NonClusterHostObjectName),
+		SignerName:  certificatemanager.OperatorCSRSignerName,
+		CSRName:     render.FluentdInputClientTLSSecretName + ":<hostname>",
+		CSRLabels: []string{
+			csr.LabelName + "=" + render.FluentdInputClientCommonName,
+			csr.HostnameLabelName + "=<hostname>",
+		},
+		CommonName:        render.FluentdInputClientCommonName,
+		CABundleConfigMap: fmt.Sprintf("%s/%s", common.CalicoNamespace, certificatemanagement.TrustedCertConfigMapName),
+	}
+}
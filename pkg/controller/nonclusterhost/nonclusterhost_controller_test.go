@@ -58,7 +58,7 @@ var _ = Describe("NonClusterHost controller tests", func() {
 		mockStatus = &status.MockStatus{}
 		mockStatus.On("ClearDegraded")
 		mockStatus.On("IsAvailable").Return(true)
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("OnCRNotFound").Return()
 		mockStatus.On("ReadyToMonitor")
 		mockStatus.On("SetMetaData", mock.Anything).Return()
@@ -108,6 +108,45 @@ var _ = Describe("NonClusterHost controller tests", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should publish how hosts obtain a fluentd input client certificate when one is required", func() {
+			clientCertificate := operatorv1.LogInputClientAuthClientCertificate
+			nonclusterhost.Spec.LogInputClientAuth = &clientCertificate
+			Expect(cli.Create(ctx, nonclusterhost)).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			instance := &operatorv1.NonClusterHost{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+			Expect(instance.Status.LogInputClientCertificate).To(Equal(&operatorv1.ClientCertificateDistribution{
+				TokenSecret: "calico-system/tigera-noncluster-host",
+				SignerName:  "tigera.io/operator-signer",
+				CSRName:     "tigera-fluentd-http-input-client-tls:<hostname>",
+				CSRLabels: []string{
+					"operator.tigera.io/csr=fluentd-http-input-client",
+					"nonclusterhost.tigera.io/hostname=<hostname>",
+				},
+				CommonName:        "fluentd-http-input-client",
+				CABundleConfigMap: "calico-system/tigera-ca-bundle",
+			}))
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-noncluster-host"}, cr)).NotTo(HaveOccurred())
+			Expect(cr.Rules).To(ContainElement(rbacv1.PolicyRule{
+				APIGroups:     []string{"certificates.tigera.io"},
+				Resources:     []string{"certificatesigningrequests/common-name"},
+				Verbs:         []string{"create"},
+				ResourceNames: []string{"typha-server-noncluster-host", "fluentd-http-input-client"},
+			}))
+
+			// The status is cleared once client certificates are no longer required.
+			instance.Spec.LogInputClientAuth = nil
+			Expect(cli.Update(ctx, instance)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, instance)).NotTo(HaveOccurred())
+			Expect(instance.Status.LogInputClientCertificate).To(BeNil())
+		})
+
 		It("should set degraded status if endpoint is invalid", func() {
 			mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Invalid endpoint", mock.Anything, mock.Anything).Return()
 
@@ -20,6 +20,7 @@ import (
 	v1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 )
 
 // ControllerOptions are passed to controllers when added to the controller manager. They
@@ -37,6 +38,9 @@ type ControllerOptions struct {
 	// Kubernetes clientset used by controllers to create watchers and informers.
 	K8sClientset *kubernetes.Clientset
 
+	// EventRecorder publishes Kubernetes Events on the operator's custom resources.
+	EventRecorder record.EventRecorder
+
 	// Whether or not the operator is running in multi-tenant mode.
 	// When true, this means some CRDs are installed as namespace scoped
 	// instead of cluster scoped.
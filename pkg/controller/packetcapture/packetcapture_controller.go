@@ -87,6 +87,13 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		return fmt.Errorf("packetcapture-controller failed to watch ImageSet: %w", err)
 	}
 
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("packetcapture-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("packetcapture-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("packetcapture-controller failed to watch packetcapture TigeraStatus: %w", err)
 	}
@@ -101,7 +108,7 @@ func newReconciler(mgr manager.Manager, opts options.ControllerOptions, tierWatc
 	r := &ReconcilePacketCapture{
 		client:         mgr.GetClient(),
 		scheme:         mgr.GetScheme(),
-		status:         status.New(mgr.GetClient(), ResourceName, opts.KubernetesVersion),
+		status:         status.New(mgr.GetClient(), ResourceName, opts.KubernetesVersion, opts.EventRecorder),
 		tierWatchReady: tierWatchReady,
 		opts:           opts,
 	}
@@ -141,7 +148,7 @@ func (r *ReconcilePacketCapture) Reconcile(ctx context.Context, request reconcil
 		return reconcile.Result{}, err
 	}
 
-	r.status.OnCRFound()
+	r.status.OnCRFound(packetcaptureapi)
 	reqLogger.V(2).Info("Loaded config", "config", packetcaptureapi)
 
 	defer r.status.SetMetaData(&packetcaptureapi.ObjectMeta)
@@ -137,7 +137,7 @@ var _ = Describe("packet capture controller tests", func() {
 		mockStatus = &status.MockStatus{}
 		mockStatus.On("AddDeployments", mock.Anything).Return()
 		mockStatus.On("IsAvailable").Return(true)
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("ClearDegraded")
 		mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 		mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -309,7 +309,7 @@ var _ = Describe("packet capture controller tests", func() {
 
 		BeforeEach(func() {
 			mockStatus = &status.MockStatus{}
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("SetMetaData", mock.Anything).Return()
 
 			readyFlag = &utils.ReadyFlag{}
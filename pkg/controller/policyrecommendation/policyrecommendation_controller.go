@@ -133,6 +133,13 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 		return fmt.Errorf("policy-recommendation-controller failed to watch ManagementClusterConnection resource: %w", err)
 	}
 
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("policy-recommendation-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
+	if err = utils.AddConfigMapWatch(c, certificatemanager.ServiceDNSAliasesConfigMapName, common.OperatorNamespace(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("policy-recommendation-controller failed to watch the service DNS aliases ConfigMap: %w", err)
+	}
+
 	// Watch for changes to TigeraStatus
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("policy-recommendation-controller failed to watch policy-recommendation Tigerastatus: %w", err)
@@ -152,7 +159,7 @@ func newReconciler(
 	r := &ReconcilePolicyRecommendation{
 		client:                   mgr.GetClient(),
 		scheme:                   mgr.GetScheme(),
-		status:                   status.New(mgr.GetClient(), "policy-recommendation", opts.KubernetesVersion),
+		status:                   status.New(mgr.GetClient(), "policy-recommendation", opts.KubernetesVersion, opts.EventRecorder),
 		licenseAPIReady:          licenseAPIReady,
 		tierWatchReady:           tierWatchReady,
 		policyRecScopeWatchReady: policyRecScopeWatchReady,
@@ -235,7 +242,7 @@ func (r *ReconcilePolicyRecommendation) Reconcile(ctx context.Context, request r
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying policy-recommendation", err, logc)
 		return reconcile.Result{}, err
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(policyRecommendation)
 	logc.V(2).Info("Loaded config", "config", policyRecommendation)
 
 	// SetMetaData in the TigeraStatus such as observedGenerations
@@ -81,7 +81,7 @@ var _ = Describe("PolicyRecommendation controller tests", func() {
 		mockStatus.On("AddStatefulSets", mock.Anything).Return()
 		mockStatus.On("AddCronJobs", mock.Anything)
 		mockStatus.On("IsAvailable").Return(true)
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("ClearDegraded")
 		mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 		mockStatus.On("ClearWarning", mock.Anything).Return()
@@ -236,7 +236,7 @@ var _ = Describe("PolicyRecommendation controller tests", func() {
 
 		BeforeEach(func() {
 			mockStatus = &status.MockStatus{}
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("SetMetaData", mock.Anything).Return()
 
 			readyFlag = &utils.ReadyFlag{}
@@ -495,7 +495,7 @@ var _ = Describe("PolicyRecommendation controller tests", func() {
 			mockStatus.On("AddStatefulSets", mock.Anything).Return()
 			mockStatus.On("AddCronJobs", mock.Anything)
 			mockStatus.On("IsAvailable").Return(true)
-			mockStatus.On("OnCRFound").Return()
+			mockStatus.On("OnCRFound", mock.Anything).Return()
 			mockStatus.On("ClearDegraded")
 			mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
 			mockStatus.On("ClearWarning", mock.Anything).Return()
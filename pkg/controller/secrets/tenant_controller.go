@@ -64,7 +64,7 @@ func AddTenantController(mgr manager.Manager, opts options.ControllerOptions) er
 		scheme:          mgr.GetScheme(),
 		clusterDomain:   opts.ClusterDomain,
 		elasticExternal: opts.ElasticExternal,
-		status:          status.New(mgr.GetClient(), "secrets", opts.KubernetesVersion),
+		status:          status.New(mgr.GetClient(), "secrets", opts.KubernetesVersion, opts.EventRecorder),
 		log:             logf.Log.WithName("controller_tenant_secrets"),
 	}
 	r.status.Run(opts.ShutdownContext)
@@ -96,6 +96,9 @@ func AddTenantController(mgr manager.Manager, opts options.ControllerOptions) er
 	if err = utils.AddConfigMapWatch(c, certificatemanagement.TrustedCertConfigMapNamePublic, "", &handler.EnqueueRequestForObject{}); err != nil {
 		return fmt.Errorf("tenant-controller failed to watch ConfigMap resource: %w", err)
 	}
+	if err = utils.AddConfigMapWatchWithLabel(c, common.OperatorNamespace(), certificatemanagement.TrustedBundleAddLabel); err != nil {
+		return fmt.Errorf("tenant-controller failed to watch trusted bundle ConfigMaps: %w", err)
+	}
 
 	return nil
 }
@@ -117,7 +120,7 @@ func (r *TenantController) Reconcile(ctx context.Context, request reconcile.Requ
 		r.status.SetDegraded(operatorv1.ResourceReadError, "An error occurred while querying Tenant", err, logc)
 		return reconcile.Result{}, err
 	}
-	r.status.OnCRFound()
+	r.status.OnCRFound(tenant)
 
 	// Get all Tenants so we can perform validation.
 	tenants := operatorv1.TenantList{}
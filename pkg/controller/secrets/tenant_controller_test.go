@@ -158,7 +158,7 @@ var _ = Describe("Tenant controller", func() {
 		// Create the reconciler for the test.
 		mockStatus = &status.MockStatus{}
 		mockStatus.On("Run").Return()
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("ReadyToMonitor")
 		mockStatus.On("ClearDegraded")
 		mockStatus.On("RemoveCertificateSigningRequests", mock.Anything).Return()
@@ -24,6 +24,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // TODO use mockery to generate mock
@@ -39,8 +40,8 @@ func (m *MockStatus) ReadyToMonitor() {
 	m.Called()
 }
 
-func (m *MockStatus) OnCRFound() {
-	m.Called()
+func (m *MockStatus) OnCRFound(cr client.Object) {
+	m.Called(cr)
 }
 
 func (m *MockStatus) OnCRNotFound() {
@@ -87,6 +88,19 @@ func (m *MockStatus) RemoveCertificateSigningRequests(label string) {
 	m.Called(label)
 }
 
+// AddRenderedResources is called for every rendered component, so it is not recorded to avoid every test having
+// to expect it.
+func (m *MockStatus) AddRenderedResources(rrs []operator.RenderedResource) {
+}
+
+// RemoveRenderedResources is not recorded for the same reason as AddRenderedResources.
+func (m *MockStatus) RemoveRenderedResources(rrs ...operator.RenderedResource) {
+}
+
+func (m *MockStatus) SetTyphaRollout(rollout *operator.TyphaRolloutStatus) {
+	m.Called(rollout)
+}
+
 func (m *MockStatus) SetDegraded(reason operator.TigeraStatusReason, msg string, err error, log logr.Logger) {
 	if err != nil {
 		m.Called(reason, msg, err.Error(), log)
@@ -27,6 +27,7 @@ import (
 
 	operator "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/operatormetrics"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	certV1 "k8s.io/api/certificates/v1"
@@ -36,6 +37,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -69,7 +71,7 @@ const (
 // be actioned.
 type StatusManager interface {
 	Run(ctx context.Context)
-	OnCRFound()
+	OnCRFound(cr client.Object)
 	OnCRNotFound()
 	AddDaemonsets(dss []types.NamespacedName)
 	AddDeployments(deps []types.NamespacedName)
@@ -81,6 +83,9 @@ type StatusManager interface {
 	RemoveStatefulSets(sss ...types.NamespacedName)
 	RemoveCronJobs(cjs ...types.NamespacedName)
 	RemoveCertificateSigningRequests(name string)
+	AddRenderedResources(rrs []operator.RenderedResource)
+	RemoveRenderedResources(rrs ...operator.RenderedResource)
+	SetTyphaRollout(rollout *operator.TyphaRolloutStatus)
 	SetDegraded(reason operator.TigeraStatusReason, msg string, err error, log logr.Logger)
 	ClearDegraded()
 	SetWarning(key string, msg string)
@@ -100,10 +105,21 @@ type statusManager struct {
 	statefulsets              map[string]types.NamespacedName
 	cronjobs                  map[string]types.NamespacedName
 	certificatestatusrequests map[string]map[string]string
+	renderedResources         map[string]operator.RenderedResource
+	typhaRollout              *operator.TyphaRolloutStatus
 	lock                      sync.Mutex
 	enabled                   *bool
 	kubernetesVersion         *common.VersionInfo
 
+	// recorder publishes an Event on cr each time the component is explicitly degraded, so that failures are
+	// visible when describing the CR. Either may be nil, in which case no Events are published.
+	recorder record.EventRecorder
+	cr       client.Object
+
+	// paused tracks whether reconciliation has been paused with an annotation on the CR, as of the last call to
+	// OnCRFound.
+	paused bool
+
 	// Track degraded state as set by external controllers.
 	degraded               bool
 	explicitDegradedMsg    string
@@ -129,7 +145,7 @@ type statusManager struct {
 	observedGeneration int64
 }
 
-func New(client client.Client, component string, kubernetesVersion *common.VersionInfo) StatusManager {
+func New(client client.Client, component string, kubernetesVersion *common.VersionInfo, recorder record.EventRecorder) StatusManager {
 	// Best-effort initialization of CR status by checking for its existence.
 	crExists := true
 	ts := &operator.TigeraStatus{}
@@ -149,8 +165,10 @@ func New(client client.Client, component string, kubernetesVersion *common.Versi
 		statefulsets:              make(map[string]types.NamespacedName),
 		cronjobs:                  make(map[string]types.NamespacedName),
 		certificatestatusrequests: make(map[string]map[string]string),
+		renderedResources:         make(map[string]operator.RenderedResource),
 		warnings:                  make(map[string]string),
 		kubernetesVersion:         kubernetesVersion,
+		recorder:                  recorder,
 		crExists:                  crExists,
 	}
 }
@@ -168,6 +186,7 @@ func (m *statusManager) updateStatus() {
 		return
 	}
 	// This status manager is enabled. Perform a sync.
+	m.syncPaused()
 
 	// Unless we've been given an explicit degraded reason we are not ready to start reporting statuses until
 	// ReadyToMonitor has been called by the owner of the status manager. This means there's no point in syncing
@@ -217,6 +236,33 @@ func (m *statusManager) updateStatus() {
 	}
 }
 
+// syncPaused reports whether reconciliation has been paused. The condition is only added to the TigeraStatus once
+// the component is paused, and is cleared rather than removed when reconciliation resumes.
+func (m *statusManager) syncPaused() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.paused {
+		m.set(true, operator.TigeraStatusCondition{
+			Type:    operator.ComponentReconcilePaused,
+			Status:  operator.ConditionTrue,
+			Reason:  string(operator.PausedByAnnotation),
+			Message: fmt.Sprintf("Reconciliation is paused by the %s=%s annotation", common.ReconcileAnnotation, common.ReconcilePausedValue),
+		})
+		return
+	}
+
+	ts := &operator.TigeraStatus{}
+	if err := m.client.Get(context.TODO(), types.NamespacedName{Name: m.component}, ts); err != nil {
+		return
+	}
+	for _, c := range ts.Status.Conditions {
+		if c.Type == operator.ComponentReconcilePaused && c.Status == operator.ConditionTrue {
+			m.set(true, operator.TigeraStatusCondition{Type: operator.ComponentReconcilePaused, Status: operator.ConditionFalse, Reason: string(operator.Unknown)})
+			return
+		}
+	}
+}
+
 func (m *statusManager) isExplicitlyDegraded() bool {
 	m.lock.Lock()
 	defer m.lock.Unlock()
@@ -257,12 +303,15 @@ func (m *statusManager) ReadyToMonitor() {
 
 // OnCRFound indicates to the status manager that it should start reporting status. Until called,
 // the status manager will be be in a "dormant" state, and will not write status to the API.
-// Call this function from a controller once it has first received an instance of its CRD.
-func (m *statusManager) OnCRFound() {
+// Call this function from a controller once it has first received an instance of its CRD. Events for
+// degraded conditions are published on the given CR, which may be nil for controllers without one.
+func (m *statusManager) OnCRFound(cr client.Object) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	t := true
 	m.enabled = &t
+	m.cr = cr
+	m.paused = common.ReconcilePaused(cr)
 }
 
 // OnCRNotFound indicates that the CR managed by the parent controller has not been found. The
@@ -275,12 +324,15 @@ func (m *statusManager) OnCRNotFound() {
 	defer m.lock.Unlock()
 	f := false
 	m.enabled = &f
+	m.cr = nil
 	m.progressing = []string{}
 	m.failing = []string{}
 	m.daemonsets = make(map[string]types.NamespacedName)
 	m.deployments = make(map[string]types.NamespacedName)
 	m.statefulsets = make(map[string]types.NamespacedName)
 	m.cronjobs = make(map[string]types.NamespacedName)
+	m.renderedResources = make(map[string]operator.RenderedResource)
+	m.typhaRollout = nil
 	m.warnings = make(map[string]string)
 }
 
@@ -370,6 +422,52 @@ func (m *statusManager) RemoveCertificateSigningRequests(name string) {
 	delete(m.certificatestatusrequests, name)
 }
 
+// AddRenderedResources tells the status manager to report the given objects, and the hashes of their desired state,
+// in the TigeraStatus.
+func (m *statusManager) AddRenderedResources(rrs []operator.RenderedResource) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, rr := range rrs {
+		m.renderedResources[renderedResourceKey(rr)] = rr
+	}
+}
+
+// RemoveRenderedResources tells the status manager to stop reporting the given objects. Only the kind, name and
+// namespace of each object are used.
+func (m *statusManager) RemoveRenderedResources(rrs ...operator.RenderedResource) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, rr := range rrs {
+		delete(m.renderedResources, renderedResourceKey(rr))
+	}
+}
+
+func renderedResourceKey(rr operator.RenderedResource) string {
+	return fmt.Sprintf("%s/%s/%s", rr.Kind, rr.Namespace, rr.Name)
+}
+
+// renderedResourceList returns the rendered resources in a stable order.
+func (m *statusManager) renderedResourceList() []operator.RenderedResource {
+	if len(m.renderedResources) == 0 {
+		return nil
+	}
+	rrs := make([]operator.RenderedResource, 0, len(m.renderedResources))
+	for _, rr := range m.renderedResources {
+		rrs = append(rrs, rr)
+	}
+	sort.Slice(rrs, func(i, j int) bool {
+		return renderedResourceKey(rrs[i]) < renderedResourceKey(rrs[j])
+	})
+	return rrs
+}
+
+// SetTyphaRollout sets the typha rollout progress reported in the TigeraStatus. A nil rollout clears it.
+func (m *statusManager) SetTyphaRollout(rollout *operator.TyphaRolloutStatus) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.typhaRollout = rollout.DeepCopy()
+}
+
 // SetDegraded sets degraded state with the provided reason and message.
 func (m *statusManager) SetDegraded(reason operator.TigeraStatusReason, msg string, err error, log logr.Logger) {
 	log.WithValues("reason", string(reason)).Error(err, msg)
@@ -386,6 +484,9 @@ func (m *statusManager) SetDegraded(reason operator.TigeraStatusReason, msg stri
 	} else {
 		m.explicitDegradedMsg = msg
 	}
+	if m.recorder != nil && m.cr != nil {
+		m.recorder.Event(m.cr, corev1.EventTypeWarning, string(reason), m.explicitDegradedMsg)
+	}
 }
 
 // ClearDegraded clears degraded state.
@@ -790,8 +891,13 @@ func (m *statusManager) set(retry bool, conditions ...operator.TigeraStatusCondi
 		}
 	}
 
+	ts.Status.RenderedResources = m.renderedResourceList()
+	ts.Status.TyphaRollout = m.typhaRollout.DeepCopy()
+
 	// If nothing has changed, we don't need to update in the API.
-	if reflect.DeepEqual(ts.Status.Conditions, old.Status.Conditions) {
+	if reflect.DeepEqual(ts.Status.Conditions, old.Status.Conditions) &&
+		reflect.DeepEqual(ts.Status.RenderedResources, old.Status.RenderedResources) &&
+		reflect.DeepEqual(ts.Status.TyphaRollout, old.Status.TyphaRollout) {
 		return
 	}
 
@@ -806,14 +912,27 @@ func (m *statusManager) set(retry bool, conditions ...operator.TigeraStatusCondi
 			if retry && errors.IsConflict(err) {
 				log.WithValues("reason", err).V(1).Info("update to tigera status conflicted, retrying")
 				m.set(false, conditions...)
-			} else {
-				log.WithValues("reason", err).Info("Failed to update tigera status")
+				return
 			}
+			log.WithValues("reason", err).Info("Failed to update tigera status")
 		}
 	}
+	if err == nil && isDegraded(ts.Status.Conditions) && !isDegraded(old.Status.Conditions) {
+		operatormetrics.DegradedTransitions.WithLabelValues(m.component).Inc()
+	}
 	m.crExists = true
 }
 
+// isDegraded returns whether the Degraded condition is among the given conditions and true.
+func isDegraded(conditions []operator.TigeraStatusCondition) bool {
+	for _, c := range conditions {
+		if c.Type == operator.ComponentDegraded {
+			return c.Status == operator.ConditionTrue
+		}
+	}
+	return false
+}
+
 func (m *statusManager) setAvailable(reason operator.TigeraStatusReason, msg string) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
@@ -1047,9 +1166,7 @@ func UpdateStatusCondition(statuscondition []metav1.Condition, conditions []oper
 		}
 
 		for i, c := range statuscondition {
-			if condition.Type == operator.ComponentAvailable && c.Type == string(operator.ComponentReady) ||
-				condition.Type == operator.ComponentDegraded && c.Type == string(operator.ComponentDegraded) ||
-				condition.Type == operator.ComponentProgressing && c.Type == string(operator.ComponentProgressing) {
+			if c.Type == ctype {
 				if !reflect.DeepEqual(c.Status, condition.Status) {
 					ic.LastTransitionTime = metav1.NewTime(time.Now())
 				}
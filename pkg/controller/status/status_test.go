@@ -16,9 +16,11 @@ package status
 
 import (
 	"context"
+	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 
 	appsv1 "k8s.io/api/apps/v1"
 	certV1 "k8s.io/api/certificates/v1"
@@ -28,6 +30,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 
 	controllerRuntimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -36,6 +40,7 @@ import (
 	"github.com/tigera/operator/pkg/apis"
 	"github.com/tigera/operator/pkg/common"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/operatormetrics"
 )
 
 var _ = Describe("Status reporting tests", func() {
@@ -58,7 +63,7 @@ var _ = Describe("Status reporting tests", func() {
 		Expect(corev1.AddToScheme(scheme)).NotTo(HaveOccurred())
 		client = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
 
-		sm = New(client, "test-component", &common.VersionInfo{Major: 1, Minor: 19}).(*statusManager)
+		sm = New(client, "test-component", &common.VersionInfo{Major: 1, Minor: 19}, nil).(*statusManager)
 		Expect(sm.IsAvailable()).To(BeFalse())
 
 		oldScheme := runtime.NewScheme()
@@ -67,7 +72,7 @@ var _ = Describe("Status reporting tests", func() {
 		Expect(err).NotTo(HaveOccurred())
 		oldVersionClient = fake.NewClientBuilder().WithScheme(oldScheme).Build()
 
-		oldVersionSm = New(oldVersionClient, "test-component", &common.VersionInfo{Major: 1, Minor: 18}).(*statusManager)
+		oldVersionSm = New(oldVersionClient, "test-component", &common.VersionInfo{Major: 1, Minor: 18}, nil).(*statusManager)
 		Expect(oldVersionSm.IsAvailable()).To(BeFalse())
 	})
 
@@ -111,7 +116,7 @@ var _ = Describe("Status reporting tests", func() {
 
 	Context("with CR found", func() {
 		BeforeEach(func() {
-			sm.OnCRFound()
+			sm.OnCRFound(nil)
 			// sync doesn't actually run so it needs to be set explicitly here.
 			sm.hasSynced = true
 		})
@@ -591,6 +596,25 @@ var _ = Describe("Status reporting tests", func() {
 			Expect(sm.IsProgressing()).To(BeFalse())
 		})
 
+		It("should count the transitions to degraded", func() {
+			degradedTransitions := func() float64 {
+				return testutil.ToFloat64(operatormetrics.DegradedTransitions.WithLabelValues("test-component"))
+			}
+			before := degradedTransitions()
+
+			sm.setDegraded(operator.ResourceReadError, "first")
+			Expect(degradedTransitions()).To(Equal(before + 1))
+
+			// A different message while still degraded is not a transition.
+			sm.setDegraded(operator.ResourceReadError, "second")
+			Expect(degradedTransitions()).To(Equal(before + 1))
+
+			sm.clearDegraded()
+			Expect(degradedTransitions()).To(Equal(before + 1))
+			sm.setDegraded(operator.ResourceReadError, "third")
+			Expect(degradedTransitions()).To(Equal(before + 2))
+		})
+
 		It("should include warnings in Available message", func() {
 			sm.ReadyToMonitor()
 			Expect(sm.IsAvailable()).To(BeTrue())
@@ -626,6 +650,57 @@ var _ = Describe("Status reporting tests", func() {
 			}
 		})
 
+		It("should report rendered resources", func() {
+			sm.ReadyToMonitor()
+			sm.AddRenderedResources([]operator.RenderedResource{
+				{Kind: "Service", Name: "b", Namespace: "ns", Hash: "hash-b"},
+				{Kind: "ClusterRole", Name: "a", Hash: "hash-a"},
+			})
+			sm.updateStatus()
+
+			stat := &operator.TigeraStatus{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: "test-component"}, stat)).NotTo(HaveOccurred())
+			Expect(stat.Status.RenderedResources).To(Equal([]operator.RenderedResource{
+				{Kind: "ClusterRole", Name: "a", Hash: "hash-a"},
+				{Kind: "Service", Name: "b", Namespace: "ns", Hash: "hash-b"},
+			}))
+
+			// Updating a hash and removing a resource should be reflected even though the conditions are unchanged.
+			sm.AddRenderedResources([]operator.RenderedResource{{Kind: "Service", Name: "b", Namespace: "ns", Hash: "hash-b2"}})
+			sm.RemoveRenderedResources(operator.RenderedResource{Kind: "ClusterRole", Name: "a"})
+			sm.updateStatus()
+
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: "test-component"}, stat)).NotTo(HaveOccurred())
+			Expect(stat.Status.RenderedResources).To(Equal([]operator.RenderedResource{
+				{Kind: "Service", Name: "b", Namespace: "ns", Hash: "hash-b2"},
+			}))
+		})
+
+		It("should report the typha rollout", func() {
+			sm.ReadyToMonitor()
+			sm.SetTyphaRollout(&operator.TyphaRolloutStatus{
+				Paused: true,
+				Revisions: []operator.TyphaRevisionStatus{
+					{Revision: "1", ReadyReplicas: 2, ConnectedClients: ptr.To(int64(100))},
+					{Revision: "2", ReadyReplicas: 1},
+				},
+			})
+			sm.updateStatus()
+
+			stat := &operator.TigeraStatus{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: "test-component"}, stat)).NotTo(HaveOccurred())
+			Expect(stat.Status.TyphaRollout).NotTo(BeNil())
+			Expect(stat.Status.TyphaRollout.Paused).To(BeTrue())
+			Expect(stat.Status.TyphaRollout.Revisions).To(HaveLen(2))
+
+			// Clearing the rollout should be reflected even though the conditions are unchanged.
+			sm.SetTyphaRollout(nil)
+			sm.updateStatus()
+
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: "test-component"}, stat)).NotTo(HaveOccurred())
+			Expect(stat.Status.TyphaRollout).To(BeNil())
+		})
+
 		It("should sort multiple warnings deterministically", func() {
 			sm.ReadyToMonitor()
 			sm.SetWarning("cert-b", "warning B")
@@ -880,4 +955,75 @@ var _ = Describe("Status reporting tests", func() {
 				}, false, true),
 		)
 	})
+
+	Context("paused reconciliation", func() {
+		pausedCondition := func() *operator.TigeraStatusCondition {
+			ts := &operator.TigeraStatus{}
+			Expect(client.Get(ctx, types.NamespacedName{Name: "test-component"}, ts)).NotTo(HaveOccurred())
+			for _, c := range ts.Status.Conditions {
+				if c.Type == operator.ComponentReconcilePaused {
+					return &c
+				}
+			}
+			return nil
+		}
+
+		It("should only report the condition once reconciliation has been paused", func() {
+			sm.OnCRFound(&operator.APIServer{})
+			sm.ReadyToMonitor()
+			sm.updateStatus()
+			Expect(pausedCondition()).To(BeNil())
+
+			sm.OnCRFound(&operator.APIServer{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"operator.tigera.io/reconcile": "paused"},
+			}})
+			sm.updateStatus()
+			c := pausedCondition()
+			Expect(c).NotTo(BeNil())
+			Expect(c.Status).To(Equal(operator.ConditionTrue))
+			Expect(c.Reason).To(Equal(string(operator.PausedByAnnotation)))
+
+			sm.OnCRFound(&operator.APIServer{})
+			sm.updateStatus()
+			c = pausedCondition()
+			Expect(c).NotTo(BeNil())
+			Expect(c.Status).To(Equal(operator.ConditionFalse))
+		})
+
+		It("should copy the condition to the CR status conditions", func() {
+			conditions := []operator.TigeraStatusCondition{
+				{Type: operator.ComponentReconcilePaused, Status: operator.ConditionTrue, Reason: string(operator.PausedByAnnotation)},
+			}
+			crConditions := UpdateStatusCondition(nil, conditions)
+			crConditions = UpdateStatusCondition(crConditions, conditions)
+			Expect(crConditions).To(HaveLen(1))
+			Expect(crConditions[0].Type).To(Equal("ReconcilePaused"))
+			Expect(crConditions[0].Status).To(Equal(metav1.ConditionTrue))
+		})
+	})
+
+	Context("events", func() {
+		var recorder *record.FakeRecorder
+
+		BeforeEach(func() {
+			recorder = record.NewFakeRecorder(10)
+			sm = New(client, "test-component", &common.VersionInfo{Major: 1, Minor: 19}, recorder).(*statusManager)
+		})
+
+		It("should publish a warning Event on the CR when degraded", func() {
+			sm.OnCRFound(&operator.LogCollector{ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"}})
+			sm.SetDegraded(operator.ResourceValidationError, "LogCollector is invalid", fmt.Errorf("bad toleration"), log)
+			Expect(recorder.Events).To(Receive(Equal("Warning ResourceValidationError LogCollector is invalid: bad toleration")))
+		})
+
+		It("should not publish Events until the CR has been found", func() {
+			sm.SetDegraded(operator.ResourceReadError, "Error querying LogCollector", nil, log)
+			Expect(recorder.Events).NotTo(Receive())
+
+			sm.OnCRFound(&operator.LogCollector{ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"}})
+			sm.OnCRNotFound()
+			sm.SetDegraded(operator.ResourceReadError, "Error querying LogCollector", nil, log)
+			Expect(recorder.Events).NotTo(Receive())
+		})
+	})
 })
@@ -0,0 +1,176 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundle
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/options"
+	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/pkg/controller/utils/imageset"
+	"github.com/tigera/operator/pkg/ctrlruntime"
+	"github.com/tigera/operator/pkg/render"
+	collector "github.com/tigera/operator/pkg/supportbundle"
+)
+
+var log = logf.Log.WithName("controller_supportbundle")
+
+// The support bundle controller runs a collection Job for each new SupportBundle, and reports the progress of the Job
+// on the status of the SupportBundle. Once the bundle has completed or failed, the SupportBundle is left alone.
+
+// Add creates a new support bundle controller and adds it to the Manager.
+func Add(mgr manager.Manager, opts options.ControllerOptions) error {
+	r := &ReconcileSupportBundle{
+		client: mgr.GetClient(),
+		scheme: mgr.GetScheme(),
+	}
+
+	c, err := ctrlruntime.NewController("support-bundle-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return fmt.Errorf("failed to create support-bundle-controller: %w", err)
+	}
+
+	if err = c.WatchObject(&operatorv1.SupportBundle{}, &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("support-bundle-controller failed to watch SupportBundle resource: %w", err)
+	}
+
+	// Watch the collection Jobs, including their status, so that the phase of each SupportBundle follows its Job.
+	err = c.WatchObject(&batchv1.Job{}, handler.EnqueueRequestForOwner(
+		mgr.GetScheme(), mgr.GetRESTMapper(), &operatorv1.SupportBundle{}, handler.OnlyControllerOwner(),
+	))
+	if err != nil {
+		return fmt.Errorf("support-bundle-controller failed to watch the collection Jobs: %w", err)
+	}
+	return nil
+}
+
+var _ reconcile.Reconciler = &ReconcileSupportBundle{}
+
+type ReconcileSupportBundle struct {
+	client client.Client
+	scheme *runtime.Scheme
+}
+
+func (r *ReconcileSupportBundle) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.V(2).Info("Reconciling SupportBundle")
+
+	bundle := &operatorv1.SupportBundle{}
+	if err := r.client.Get(ctx, request.NamespacedName, bundle); err != nil {
+		if errors.IsNotFound(err) {
+			// The collection Job is owned by the SupportBundle, so it is garbage collected along with it.
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if bundle.Status.Phase == operatorv1.SupportBundleCompleted || bundle.Status.Phase == operatorv1.SupportBundleFailed {
+		return reconcile.Result{}, nil
+	}
+
+	// A bundle is most useful when the installation is broken, so collect it with the default images and registry
+	// if there is no Installation.
+	variant, installation, err := utils.GetInstallationSpec(ctx, r.client)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			reqLogger.Error(err, "Error querying installation")
+			return reconcile.Result{}, err
+		}
+		installation = &operatorv1.InstallationSpec{}
+	}
+	pullSecrets, err := utils.GetInstallationPullSecrets(installation, r.client)
+	if err != nil {
+		reqLogger.Error(err, "Error retrieving pull secrets")
+		return reconcile.Result{}, err
+	}
+
+	component := render.SupportBundle(&render.SupportBundleConfiguration{
+		SupportBundle: bundle,
+		Installation:  installation,
+		PullSecrets:   pullSecrets,
+	})
+	if err = imageset.ApplyImageSet(ctx, r.client, variant, component); err != nil {
+		return r.fail(ctx, bundle, fmt.Sprintf("Error with images from ImageSet: %v", err))
+	}
+	ch := utils.NewComponentHandler(log, r.client, r.scheme, bundle)
+	if err = ch.CreateOrUpdateOrDelete(ctx, component, nil); err != nil {
+		reqLogger.Error(err, "Error creating the support bundle collection Job")
+		return reconcile.Result{}, err
+	}
+
+	job := &batchv1.Job{}
+	if err = r.client.Get(ctx, client.ObjectKey{Name: render.SupportBundleJobName(bundle.Name), Namespace: common.OperatorNamespace()}, job); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, r.updateStatus(ctx, bundle, job)
+}
+
+// updateStatus sets the phase of the SupportBundle from the conditions of its collection Job.
+func (r *ReconcileSupportBundle) updateStatus(ctx context.Context, bundle *operatorv1.SupportBundle, job *batchv1.Job) error {
+	status := operatorv1.SupportBundleStatus{
+		Phase:    operatorv1.SupportBundleCollecting,
+		Location: collector.Location(bundle),
+	}
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			status.Phase = operatorv1.SupportBundleCompleted
+			status.CompletionTime = &metav1.Time{Time: c.LastTransitionTime.Time}
+		case batchv1.JobFailed:
+			status.Phase = operatorv1.SupportBundleFailed
+			status.Location = ""
+			status.Message = fmt.Sprintf("The collection Job %s failed: %s", job.Name, c.Message)
+		}
+	}
+	if equalStatus(bundle.Status, status) {
+		return nil
+	}
+	bundle.Status = status
+	return r.client.Status().Update(ctx, bundle)
+}
+
+// fail marks the SupportBundle as failed with the given message.
+func (r *ReconcileSupportBundle) fail(ctx context.Context, bundle *operatorv1.SupportBundle, message string) (reconcile.Result, error) {
+	log.Info("Failed to collect support bundle", "name", bundle.Name, "reason", message)
+	bundle.Status = operatorv1.SupportBundleStatus{Phase: operatorv1.SupportBundleFailed, Message: message}
+	return reconcile.Result{}, r.client.Status().Update(ctx, bundle)
+}
+
+func equalStatus(a, b operatorv1.SupportBundleStatus) bool {
+	if a.Phase != b.Phase || a.Location != b.Location || a.Message != b.Message {
+		return false
+	}
+	if a.CompletionTime == nil || b.CompletionTime == nil {
+		return a.CompletionTime == b.CompletionTime
+	}
+	return a.CompletionTime.Equal(b.CompletionTime)
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundle
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	"github.com/tigera/operator/pkg/common"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/render"
+)
+
+var _ = Describe("support bundle controller tests", func() {
+	var (
+		ctx     context.Context
+		cli     client.Client
+		r       *ReconcileSupportBundle
+		bundle  *operatorv1.SupportBundle
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme, false)).NotTo(HaveOccurred())
+		Expect(batchv1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		cli = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		r = &ReconcileSupportBundle{client: cli, scheme: scheme}
+
+		bundle = &operatorv1.SupportBundle{
+			ObjectMeta: metav1.ObjectMeta{Name: "case-1234"},
+			Spec: operatorv1.SupportBundleSpec{
+				Destination: operatorv1.SupportBundleDestination{
+					PersistentVolumeClaim: &operatorv1.SupportBundlePersistentVolumeClaimDestination{ClaimName: "bundles"},
+				},
+			},
+		}
+		Expect(cli.Create(ctx, bundle)).NotTo(HaveOccurred())
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: bundle.Name}}
+	})
+
+	getJob := func() *batchv1.Job {
+		job := &batchv1.Job{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "support-bundle-case-1234", Namespace: common.OperatorNamespace()}, job)).NotTo(HaveOccurred())
+		return job
+	}
+
+	getBundle := func() *operatorv1.SupportBundle {
+		b := &operatorv1.SupportBundle{}
+		Expect(cli.Get(ctx, client.ObjectKey{Name: bundle.Name}, b)).NotTo(HaveOccurred())
+		return b
+	}
+
+	setJobCondition := func(condition batchv1.JobCondition) {
+		job := getJob()
+		job.Status.Conditions = append(job.Status.Conditions, condition)
+		Expect(cli.Status().Update(ctx, job)).NotTo(HaveOccurred())
+	}
+
+	It("should run a collection Job even without an Installation", func() {
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		job := getJob()
+		Expect(job.Labels).To(HaveKeyWithValue(render.SupportBundleLabel, "case-1234"))
+		Expect(job.OwnerReferences).To(HaveLen(1))
+		Expect(job.OwnerReferences[0].Kind).To(Equal("SupportBundle"))
+		Expect(job.OwnerReferences[0].Name).To(Equal("case-1234"))
+		podSpec := job.Spec.Template.Spec
+		Expect(podSpec.ServiceAccountName).To(Equal(common.OperatorServiceAccount()))
+		Expect(podSpec.Containers[0].Image).To(ContainSubstring("tigera/operator"))
+		Expect(podSpec.Containers[0].Args).To(ConsistOf("--collect-support-bundle=case-1234"))
+		Expect(podSpec.Containers[0].VolumeMounts).To(ConsistOf(corev1.VolumeMount{Name: "support-bundle", MountPath: "/support-bundle"}))
+		Expect(podSpec.Volumes).To(ConsistOf(corev1.Volume{
+			Name:         "support-bundle",
+			VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "bundles"}},
+		}))
+
+		status := getBundle().Status
+		Expect(status.Phase).To(Equal(operatorv1.SupportBundleCollecting))
+		Expect(status.Location).To(Equal("pvc://bundles/case-1234.tar.gz"))
+	})
+
+	It("should use the registry and pull secrets of the Installation", func() {
+		Expect(cli.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: common.OperatorNamespace()}})).NotTo(HaveOccurred())
+		Expect(cli.Create(ctx, &operatorv1.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: operatorv1.InstallationSpec{
+				Registry:         "example.com/",
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "pull-secret"}},
+			},
+		})).NotTo(HaveOccurred())
+
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		podSpec := getJob().Spec.Template.Spec
+		Expect(podSpec.Containers[0].Image).To(HavePrefix("example.com/tigera/operator"))
+		Expect(podSpec.ImagePullSecrets).To(ConsistOf(corev1.LocalObjectReference{Name: "pull-secret"}))
+	})
+
+	It("should report the bundle as completed once the Job completes", func() {
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		completed := metav1.Now()
+		setJobCondition(batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue, LastTransitionTime: completed})
+		_, err = r.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		status := getBundle().Status
+		Expect(status.Phase).To(Equal(operatorv1.SupportBundleCompleted))
+		Expect(status.Location).To(Equal("pvc://bundles/case-1234.tar.gz"))
+		Expect(status.CompletionTime).NotTo(BeNil())
+		Expect(status.CompletionTime.Unix()).To(Equal(completed.Unix()))
+
+		// A completed bundle is not collected again, even if its Job is gone.
+		Expect(cli.Delete(ctx, getJob())).NotTo(HaveOccurred())
+		_, err = r.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cli.Get(ctx, client.ObjectKey{Name: "support-bundle-case-1234", Namespace: common.OperatorNamespace()}, &batchv1.Job{})).To(HaveOccurred())
+	})
+
+	It("should report the bundle as failed if the Job fails", func() {
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		setJobCondition(batchv1.JobCondition{
+			Type:               batchv1.JobFailed,
+			Status:             corev1.ConditionTrue,
+			Message:            "Job has reached the specified backoff limit",
+			LastTransitionTime: metav1.Now(),
+		})
+		_, err = r.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		status := getBundle().Status
+		Expect(status.Phase).To(Equal(operatorv1.SupportBundleFailed))
+		Expect(status.Location).To(BeEmpty())
+		Expect(status.Message).To(Equal("The collection Job support-bundle-case-1234 failed: Job has reached the specified backoff limit"))
+	})
+
+	It("should do nothing if the SupportBundle is gone", func() {
+		Expect(cli.Delete(ctx, bundle)).NotTo(HaveOccurred())
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -61,7 +61,7 @@ func Add(mgr manager.Manager, opts options.ControllerOptions) error {
 	r := &ReconcileTiers{
 		client: mgr.GetClient(),
 		scheme: mgr.GetScheme(),
-		status: status.New(mgr.GetClient(), "tiers", opts.KubernetesVersion),
+		status: status.New(mgr.GetClient(), "tiers", opts.KubernetesVersion, opts.EventRecorder),
 		opts:   opts,
 	}
 	r.status.Run(opts.ShutdownContext)
@@ -115,7 +115,7 @@ func (r *ReconcileTiers) Reconcile(ctx context.Context, request reconcile.Reques
 	reqLogger.Info("Reconciling Tiers")
 
 	// Mark CR as found even though this controller is not associated with a CR, as OnCRFound() enables TigeraStatus reporting.
-	r.status.OnCRFound()
+	r.status.OnCRFound(nil)
 
 	if !utils.IsProjectCalicoV3Available(r.client, r.opts, reqLogger) {
 		r.status.SetDegraded(operatorv1.ResourceNotReady, "Waiting for Tigera API server to be ready", nil, reqLogger)
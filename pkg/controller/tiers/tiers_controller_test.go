@@ -58,7 +58,7 @@ var _ = Describe("tier controller tests", func() {
 		ctx = context.Background()
 
 		mockStatus = &status.MockStatus{}
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 
 		// Mark that the watches were successful.
 		readyFlag = &utils.ReadyFlag{}
@@ -135,7 +135,7 @@ var _ = Describe("tier controller tests", func() {
 		err := c.Delete(ctx, &operatorv1.APIServer{ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"}})
 		Expect(err).ShouldNot(HaveOccurred())
 		mockStatus = &status.MockStatus{}
-		mockStatus.On("OnCRFound").Return()
+		mockStatus.On("OnCRFound", mock.Anything).Return()
 		mockStatus.On("SetDegraded", operatorv1.ResourceNotReady, "Waiting for Tigera API server to be ready", mock.Anything, mock.Anything).Return()
 		r = ReconcileTiers{
 			client:             c,
@@ -167,7 +167,7 @@ var _ = Describe("tier controller tests", func() {
 				DetectedProvider: operatorv1.ProviderNone,
 			},
 		}
-		mockStatus.On("OnCRFound")
+		mockStatus.On("OnCRFound", mock.Anything)
 		mockStatus.On("ReadyToMonitor")
 		mockStatus.On("ClearDegraded")
 		_, err := r.Reconcile(ctx, reconcile.Request{})
@@ -197,7 +197,7 @@ var _ = Describe("tier controller tests", func() {
 				DetectedProvider: operatorv1.ProviderNone,
 			},
 		}
-		mockStatus.On("OnCRFound")
+		mockStatus.On("OnCRFound", mock.Anything)
 		mockStatus.On("ReadyToMonitor")
 		mockStatus.On("ClearDegraded")
 		_, err := r.Reconcile(ctx, reconcile.Request{})
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgradecheck
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	apiextenv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/components"
+	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/pkg/controller/utils/imageset"
+	"github.com/tigera/operator/pkg/imports/crds"
+)
+
+// Config contains the cluster information the checks depend on.
+type Config struct {
+	KubernetesVersion *common.VersionInfo
+
+	// ManageCRDs is true if the operator installs the CRDs itself, in which case they need not be present before
+	// the upgrade.
+	ManageCRDs bool
+	UseV3CRDs  bool
+}
+
+// Upgrade describes a pending change of the version of the installed components.
+type Upgrade struct {
+	// From is the version the components are running, as reported in the Installation status.
+	From string
+	// To is the version the components are being upgraded to. If an ImageSet is used, this is its name.
+	To string
+}
+
+// PendingUpgrade returns the upgrade that applying the given Installation would perform, or nil if the components
+// are already running the target version or have not been installed yet.
+func PendingUpgrade(ctx context.Context, cli client.Client, installation *operatorv1.Installation) (*Upgrade, error) {
+	if installation.Status.CalicoVersion == "" {
+		// Nothing is installed yet, so this is not an upgrade.
+		return nil, nil
+	}
+
+	from, to := installation.Status.CalicoVersion, components.CalicoRelease
+	if installation.Spec.Variant.IsEnterprise() {
+		to = components.EnterpriseRelease
+	}
+	if installation.Status.Variant != installation.Spec.Variant {
+		from = fmt.Sprintf("%s %s", installation.Status.Variant, from)
+		to = fmt.Sprintf("%s %s", installation.Spec.Variant, to)
+	}
+
+	// A new ImageSet also moves the components to other images, even without a change of release.
+	imageSet, err := imageset.GetImageSet(ctx, cli, installation.Spec.Variant)
+	if err != nil {
+		return nil, err
+	}
+	if imageSet != nil && imageSet.Name != installation.Status.ImageSet {
+		if installation.Status.ImageSet != "" {
+			from = installation.Status.ImageSet
+		}
+		to = imageSet.Name
+	}
+
+	if from == to {
+		return nil, nil
+	}
+	return &Upgrade{From: from, To: to}, nil
+}
+
+// Violations runs the compatibility checks against the cluster and returns a description of each violation found.
+func Violations(ctx context.Context, cli client.Client, cfg Config, installation *operatorv1.Installation) ([]string, error) {
+	violations := kubernetesVersionViolations(cfg.KubernetesVersion, installation.Spec.Variant)
+
+	v, err := crdViolations(ctx, cli, cfg, installation.Spec.Variant)
+	if err != nil {
+		return nil, err
+	}
+	return append(violations, v...), nil
+}
+
+// Warnings returns a description of each deprecated field in use. Deprecated fields are still supported by the
+// target release, so they do not fail the checks.
+func Warnings(ctx context.Context, cli client.Client, installation *operatorv1.Installation) ([]string, error) {
+	return deprecatedFieldWarnings(ctx, cli, installation)
+}
+
+// kubernetesVersionViolations checks the Kubernetes version against the range that the release metadata of the
+// target release publishes. A bound that the release metadata does not publish is not checked.
+func kubernetesVersionViolations(v *common.VersionInfo, variant operatorv1.ProductVariant) []string {
+	if v == nil {
+		return nil
+	}
+	minVersion, maxVersion := components.CalicoMinKubernetesVersion, components.CalicoMaxKubernetesVersion
+	if variant.IsEnterprise() {
+		minVersion, maxVersion = components.EnterpriseMinKubernetesVersion, components.EnterpriseMaxKubernetesVersion
+	}
+	if minimum := parseVersion(minVersion); minimum != nil && less(*v, *minimum) {
+		return []string{fmt.Sprintf("Kubernetes %d.%d is not supported, the oldest supported version is %s", v.Major, v.Minor, minVersion)}
+	}
+	if maximum := parseVersion(maxVersion); maximum != nil && less(*maximum, *v) {
+		return []string{fmt.Sprintf("Kubernetes %d.%d is not supported, the newest supported version is %s", v.Major, v.Minor, maxVersion)}
+	}
+	return nil
+}
+
+// parseVersion parses a major.minor version, and returns nil if it is not one.
+func parseVersion(version string) *common.VersionInfo {
+	major, minor, ok := strings.Cut(version, ".")
+	if !ok {
+		return nil
+	}
+	maj, err := strconv.Atoi(major)
+	if err != nil {
+		return nil
+	}
+	mnr, err := strconv.Atoi(minor)
+	if err != nil {
+		return nil
+	}
+	return &common.VersionInfo{Major: maj, Minor: mnr}
+}
+
+func less(a, b common.VersionInfo) bool {
+	return a.Major < b.Major || (a.Major == b.Major && a.Minor < b.Minor)
+}
+
+// crdViolations checks that the CRDs of the target release are installed when they are managed outside the operator.
+func crdViolations(ctx context.Context, cli client.Client, cfg Config, variant operatorv1.ProductVariant) ([]string, error) {
+	if cfg.ManageCRDs {
+		return nil, nil
+	}
+	var violations []string
+	for _, crd := range crds.GetCRDs(variant, cfg.UseV3CRDs) {
+		if err := cli.Get(ctx, client.ObjectKey{Name: crd.Name}, &apiextenv1.CustomResourceDefinition{}); err != nil {
+			if !errors.IsNotFound(err) {
+				return nil, err
+			}
+			violations = append(violations, fmt.Sprintf("CRD %s is not installed", crd.Name))
+		}
+	}
+	return violations, nil
+}
+
+// deprecatedFieldWarnings checks for fields that are deprecated in favour of others.
+func deprecatedFieldWarnings(ctx context.Context, cli client.Client, installation *operatorv1.Installation) ([]string, error) {
+	var warnings []string
+	spec := installation.Spec
+	if len(spec.ComponentResources) > 0 {
+		warnings = append(warnings, "Installation spec.componentResources is deprecated, use spec.calicoNodeDaemonSet, spec.typhaDeployment and spec.calicoKubeControllersDeployment")
+	}
+	if spec.NonPrivileged != nil && *spec.NonPrivileged == operatorv1.NonPrivilegedEnabled {
+		warnings = append(warnings, "Installation spec.nonPrivileged is deprecated")
+	}
+	if spec.CalicoWindowsUpgradeDaemonSet != nil {
+		warnings = append(warnings, "Installation spec.calicoWindowsUpgradeDaemonSet is deprecated")
+	}
+
+	if spec.Variant.IsEnterprise() {
+		authentication, err := utils.GetAuthentication(ctx, cli)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if authentication != nil && authentication.Spec.OIDC != nil {
+			if authentication.Spec.OIDC.UsernamePrefix != "" {
+				warnings = append(warnings, "Authentication spec.oidc.usernamePrefix is deprecated, use spec.usernamePrefix")
+			}
+			if authentication.Spec.OIDC.GroupsPrefix != "" {
+				warnings = append(warnings, "Authentication spec.oidc.groupsPrefix is deprecated, use spec.groupsPrefix")
+			}
+		}
+	}
+	return warnings, nil
+}
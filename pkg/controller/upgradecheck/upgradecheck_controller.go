@@ -0,0 +1,162 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgradecheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/controller/options"
+	"github.com/tigera/operator/pkg/controller/status"
+	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/pkg/controller/utils/imageset"
+	"github.com/tigera/operator/pkg/ctrlruntime"
+)
+
+// ResourceName is the name of the TigeraStatus that reports the result of the pre-upgrade checks.
+const ResourceName = "upgrade-check"
+
+// deprecatedFieldsWarningKey is the key of the status warning that lists the deprecated fields in use.
+const deprecatedFieldsWarningKey = "deprecated-fields"
+
+var log = logf.Log.WithName("controller_upgradecheck")
+
+// The upgrade check controller runs the pre-upgrade compatibility checks whenever the installed components are about
+// to move to a new version, and reports any violations on its TigeraStatus. The checks do not hold back the upgrade,
+// as the components are upgraded by many controllers, and holding back only some of them would leave the components
+// at different versions. Deprecated fields in use are reported as warnings.
+
+// Add creates a new upgrade check controller and adds it to the Manager.
+func Add(mgr manager.Manager, opts options.ControllerOptions) error {
+	r := &ReconcileUpgradeCheck{
+		client: mgr.GetClient(),
+		scheme: mgr.GetScheme(),
+		status: status.New(mgr.GetClient(), ResourceName, opts.KubernetesVersion, opts.EventRecorder),
+		opts:   opts,
+	}
+	r.status.Run(opts.ShutdownContext)
+
+	c, err := ctrlruntime.NewController("upgrade-check-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return fmt.Errorf("failed to create upgrade-check-controller: %w", err)
+	}
+
+	if err = utils.AddInstallationWatch(c); err != nil {
+		return fmt.Errorf("upgrade-check-controller failed to watch Installation resource: %w", err)
+	}
+	if err = imageset.AddImageSetWatch(c); err != nil {
+		return fmt.Errorf("upgrade-check-controller failed to watch ImageSet: %w", err)
+	}
+	if opts.EnterpriseCRDExists {
+		if err = c.WatchObject(&operatorv1.Authentication{}, &handler.EnqueueRequestForObject{}); err != nil {
+			return fmt.Errorf("upgrade-check-controller failed to watch Authentication resource: %w", err)
+		}
+	}
+
+	// CRDs may be installed at any time, so check again periodically.
+	if err = utils.AddPeriodicReconcile(c, utils.PeriodicReconcileTime, &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("upgrade-check-controller failed to create periodic reconcile watch: %w", err)
+	}
+	return nil
+}
+
+var _ reconcile.Reconciler = &ReconcileUpgradeCheck{}
+
+type ReconcileUpgradeCheck struct {
+	client client.Client
+	scheme *runtime.Scheme
+	status status.StatusManager
+	opts   options.ControllerOptions
+}
+
+func (r *ReconcileUpgradeCheck) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.V(2).Info("Reconciling upgrade checks")
+
+	installation := &operatorv1.Installation{}
+	if err := r.client.Get(ctx, utils.DefaultInstanceKey, installation); err != nil {
+		if errors.IsNotFound(err) {
+			r.status.OnCRNotFound()
+			return reconcile.Result{}, nil
+		}
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying installation", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	// Mark CR as found even though this controller is not associated with a CR of its own, as OnCRFound() enables
+	// TigeraStatus reporting.
+	r.status.OnCRFound(nil)
+
+	warnings, err := Warnings(ctx, r.client, installation)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error running pre-upgrade checks", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	if len(warnings) > 0 {
+		r.status.SetWarning(deprecatedFieldsWarningKey, strings.Join(warnings, "; "))
+	} else {
+		r.status.ClearWarning(deprecatedFieldsWarningKey)
+	}
+
+	violations, upgrade, err := Check(ctx, r.client, r.config(), installation)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error running pre-upgrade checks", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	if len(violations) > 0 {
+		r.status.SetDegraded(operatorv1.UpgradeError, FailedMessage(upgrade, violations), nil, reqLogger)
+		return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
+	}
+
+	r.status.ReadyToMonitor()
+	r.status.ClearDegraded()
+	return reconcile.Result{}, nil
+}
+
+func (r *ReconcileUpgradeCheck) config() Config {
+	return Config{
+		KubernetesVersion: r.opts.KubernetesVersion,
+		ManageCRDs:        r.opts.ManageCRDs,
+		UseV3CRDs:         r.opts.UseV3CRDs,
+	}
+}
+
+// Check returns the violations of the pending upgrade of the given Installation, if there is one.
+func Check(ctx context.Context, cli client.Client, cfg Config, installation *operatorv1.Installation) ([]string, *Upgrade, error) {
+	upgrade, err := PendingUpgrade(ctx, cli, installation)
+	if err != nil || upgrade == nil {
+		return nil, nil, err
+	}
+	violations, err := Violations(ctx, cli, cfg, installation)
+	if err != nil {
+		return nil, nil, err
+	}
+	return violations, upgrade, nil
+}
+
+// FailedMessage describes an upgrade that fails the pre-upgrade checks with the given violations.
+func FailedMessage(upgrade *Upgrade, violations []string) string {
+	return fmt.Sprintf("Upgrade from %s to %s fails pre-upgrade checks: %s", upgrade.From, upgrade.To, strings.Join(violations, "; "))
+}
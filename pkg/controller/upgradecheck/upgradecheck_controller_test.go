@@ -0,0 +1,163 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgradecheck
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/components"
+	"github.com/tigera/operator/pkg/controller/options"
+	"github.com/tigera/operator/pkg/controller/status"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/imports/crds"
+)
+
+var _ = Describe("upgrade check controller tests", func() {
+	var r ReconcileUpgradeCheck
+	var c client.Client
+	var ctx context.Context
+	var mockStatus *status.MockStatus
+	var installation *operatorv1.Installation
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme, false)).NotTo(HaveOccurred())
+		c = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		ctx = context.Background()
+
+		mockStatus = &status.MockStatus{}
+		mockStatus.On("OnCRFound", mock.Anything).Return()
+		mockStatus.On("SetWarning", mock.Anything, mock.Anything).Return()
+		mockStatus.On("ClearWarning", mock.Anything).Return()
+
+		r = ReconcileUpgradeCheck{
+			client: c,
+			scheme: scheme,
+			status: mockStatus,
+			opts: options.ControllerOptions{
+				KubernetesVersion: &common.VersionInfo{Major: 1, Minor: 33},
+				ManageCRDs:        true,
+			},
+		}
+
+		installation = &operatorv1.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       operatorv1.InstallationSpec{Variant: operatorv1.Calico},
+			Status:     operatorv1.InstallationStatus{Variant: operatorv1.Calico, CalicoVersion: "v3.29.0"},
+		}
+	})
+
+	expectFailed := func(violations ...string) {
+		mockStatus.On("SetDegraded", operatorv1.UpgradeError, mock.Anything, mock.Anything, mock.Anything).Return()
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.UpgradeError, mock.Anything, mock.Anything, mock.Anything)
+		msg := mockStatus.Calls[len(mockStatus.Calls)-1].Arguments.Get(1).(string)
+		Expect(msg).To(HavePrefix("Upgrade from v3.29.0 to " + components.CalicoRelease + " fails pre-upgrade checks"))
+		for _, v := range violations {
+			Expect(msg).To(ContainSubstring(v))
+		}
+	}
+
+	expectAllowed := func() {
+		mockStatus.On("ReadyToMonitor").Return()
+		mockStatus.On("ClearDegraded").Return()
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		mockStatus.AssertCalled(GinkgoT(), "ClearDegraded")
+		mockStatus.AssertNotCalled(GinkgoT(), "SetDegraded", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	}
+
+	It("should allow an upgrade that passes the checks", func() {
+		Expect(c.Create(ctx, installation)).NotTo(HaveOccurred())
+		expectAllowed()
+		mockStatus.AssertCalled(GinkgoT(), "ClearWarning", deprecatedFieldsWarningKey)
+	})
+
+	It("should not check a fresh installation", func() {
+		installation.Status = operatorv1.InstallationStatus{}
+		installation.Spec.ComponentResources = []operatorv1.ComponentResource{{ComponentName: operatorv1.ComponentNameNode}}
+		Expect(c.Create(ctx, installation)).NotTo(HaveOccurred())
+		r.opts.KubernetesVersion = &common.VersionInfo{Major: 1, Minor: 20}
+		expectAllowed()
+	})
+
+	It("should fail an upgrade on a Kubernetes version outside the range of the release metadata", func() {
+		Expect(c.Create(ctx, installation)).NotTo(HaveOccurred())
+		r.opts.KubernetesVersion = &common.VersionInfo{Major: 1, Minor: 20}
+		expectFailed("Kubernetes 1.20 is not supported, the oldest supported version is " + components.CalicoMinKubernetesVersion)
+	})
+
+	It("should fail an upgrade on a Kubernetes version newer than the release supports", func() {
+		Expect(c.Create(ctx, installation)).NotTo(HaveOccurred())
+		r.opts.KubernetesVersion = &common.VersionInfo{Major: 2, Minor: 0}
+		expectFailed("Kubernetes 2.0 is not supported, the newest supported version is " + components.CalicoMaxKubernetesVersion)
+	})
+
+	It("should block an upgrade when the CRDs of the new release are missing", func() {
+		Expect(c.Create(ctx, installation)).NotTo(HaveOccurred())
+		r.opts.ManageCRDs = false
+		all := crds.GetCRDs(operatorv1.Calico, false)
+		for _, crd := range all[1:] {
+			Expect(c.Create(ctx, crd)).NotTo(HaveOccurred())
+		}
+		expectFailed("CRD " + all[0].Name + " is not installed")
+	})
+
+	It("should warn about deprecated fields without failing the upgrade", func() {
+		installation.Spec.ComponentResources = []operatorv1.ComponentResource{{
+			ComponentName:        operatorv1.ComponentNameNode,
+			ResourceRequirements: &corev1.ResourceRequirements{},
+		}}
+		Expect(c.Create(ctx, installation)).NotTo(HaveOccurred())
+		expectAllowed()
+		mockStatus.AssertCalled(GinkgoT(), "SetWarning", deprecatedFieldsWarningKey, mock.MatchedBy(func(msg string) bool {
+			return strings.Contains(msg, "Installation spec.componentResources is deprecated")
+		}))
+		mockStatus.AssertNotCalled(GinkgoT(), "ClearWarning", mock.Anything)
+	})
+
+	It("should check a change of ImageSet", func() {
+		installation.Status.CalicoVersion = components.CalicoRelease
+		installation.Status.ImageSet = "calico-v3.29.0"
+		Expect(c.Create(ctx, installation)).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, &operatorv1.ImageSet{ObjectMeta: metav1.ObjectMeta{Name: "calico-" + components.CalicoRelease}})).NotTo(HaveOccurred())
+
+		upgrade, err := PendingUpgrade(ctx, c, installation)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upgrade).To(Equal(&Upgrade{From: "calico-v3.29.0", To: "calico-" + components.CalicoRelease}))
+	})
+
+	It("should not report an upgrade once the new version is running", func() {
+		installation.Status.CalicoVersion = components.CalicoRelease
+		upgrade, err := PendingUpgrade(ctx, c, installation)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upgrade).To(BeNil())
+	})
+})
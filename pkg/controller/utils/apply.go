@@ -0,0 +1,297 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+	"k8s.io/apimachinery/pkg/util/sets"
+	applyconfigurations "k8s.io/client-go/applyconfigurations"
+	kscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/csaupgrade"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v6/typed"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	"github.com/tigera/operator/pkg/common"
+)
+
+// fieldOwner is the field manager that the operator applies the objects it renders with.
+const fieldOwner = "tigera-operator"
+
+// csaFieldManagers are the field managers that objects were updated with before the operator used server-side
+// apply: the default one of the operator's client, which is named after its binary, and the operator's own one,
+// which it still creates objects with.
+var csaFieldManagers = sets.New(fieldOwner, strings.SplitN(rest.DefaultKubernetesUserAgent(), "/", 2)[0])
+
+// typeConverter converts objects into the structured values that managed fields are computed from. It uses the
+// schemas of the Kubernetes types, and treats the types it has no schema for, such as custom resources, like the
+// API server treats objects without a structural schema.
+var typeConverter managedfields.TypeConverter = fallbackTypeConverter{
+	applyconfigurations.NewTypeConverter(kscheme.Scheme),
+	managedfields.NewDeducedTypeConverter(),
+}
+
+// strippedFields are the fields that the API server leaves out of the managed fields of an object.
+var strippedFields = fieldpath.NewSet(
+	fieldpath.MakePathOrDie("apiVersion"),
+	fieldpath.MakePathOrDie("kind"),
+	fieldpath.MakePathOrDie("metadata"),
+	fieldpath.MakePathOrDie("metadata", "name"),
+	fieldpath.MakePathOrDie("metadata", "namespace"),
+)
+
+// apply applies the desired state of an object with server-side apply, so that the operator owns the fields it
+// renders and leaves the fields that other controllers and users set alone. The apply is skipped when the object
+// already has the state that the operator last applied to it, so that reconciling unchanged objects does not write
+// to the API server.
+func (c *componentHandler) apply(ctx context.Context, desired, current client.Object) (bool, error) {
+	switch d := desired.(type) {
+	case *v3.UISettings:
+		// UISettings are always owned by the group, so never modify the OwnerReferences that are returned by the
+		// APIServer.
+		d.SetOwnerReferences(current.GetOwnerReferences())
+	case *rbacv1.ClusterRole:
+		// The rules of an aggregated ClusterRole are maintained by the Kubernetes controller manager.
+		if d.AggregationRule != nil {
+			d.Rules = current.(*rbacv1.ClusterRole).Rules
+		}
+	}
+	if checkIfMultipleOwnersLabel(desired) {
+		// Objects with multiple owners, such as the ones that the egress gateways of a namespace share, are applied
+		// by each of their owners under the same field manager, so keep the owner references of the others.
+		desired.SetOwnerReferences(mergeOwnerReferences(desired.GetOwnerReferences(), current.GetOwnerReferences()))
+	}
+	config, err := c.applyConfiguration(desired)
+	if err != nil {
+		return false, err
+	}
+	if appliedStateMatches(current, config) {
+		return false, nil
+	}
+
+	if err := c.removeOtherControllers(ctx, desired, current); err != nil {
+		return false, err
+	}
+
+	// Take over the fields that the operator set with updates before it used server-side apply, so that the
+	// fields it no longer renders are removed by the apply.
+	if patch, err := csaupgrade.UpgradeManagedFieldsPatch(current, csaFieldManagers, fieldOwner); err != nil {
+		return false, err
+	} else if patch != nil {
+		if err := c.client.Patch(ctx, current, client.RawPatch(types.JSONPatchType, patch)); err != nil {
+			return false, err
+		}
+	}
+
+	err = c.client.Apply(ctx, client.ApplyConfigurationFromUnstructured(config), client.FieldOwner(fieldOwner), client.ForceOwnership)
+	if err != nil {
+		return false, err
+	}
+	desired.SetGeneration(config.GetGeneration())
+	return true, nil
+}
+
+// removeOtherControllers removes the owner references of other controllers from the current object when the
+// desired object has a controller. An object can only have one controller, and an apply only removes the owner
+// references that the operator applied itself, not the ones that, for example, older versions of the operator set
+// from other controllers.
+func (c *componentHandler) removeOtherControllers(ctx context.Context, desired, current client.Object) error {
+	controller := metav1.GetControllerOfNoCopy(desired)
+	if controller == nil {
+		return nil
+	}
+	var refs []metav1.OwnerReference
+	for _, ref := range current.GetOwnerReferences() {
+		if ref.Controller == nil || !*ref.Controller || ref.UID == controller.UID {
+			refs = append(refs, ref)
+		}
+	}
+	if len(refs) == len(current.GetOwnerReferences()) {
+		return nil
+	}
+	patchFrom := client.MergeFrom(current.DeepCopyObject().(client.Object))
+	current.SetOwnerReferences(refs)
+	return c.client.Patch(ctx, current, patchFrom)
+}
+
+// mergeOwnerReferences returns the desired owner references along with the current ones of other owners, ordered by
+// UID so that every owner applies the same list.
+func mergeOwnerReferences(desired, current []metav1.OwnerReference) []metav1.OwnerReference {
+	refs := append([]metav1.OwnerReference{}, desired...)
+	for _, ref := range current {
+		if !slices.ContainsFunc(refs, func(r metav1.OwnerReference) bool { return r.UID == ref.UID }) {
+			refs = append(refs, ref)
+		}
+	}
+	slices.SortFunc(refs, func(a, b metav1.OwnerReference) int { return strings.Compare(string(a.UID), string(b.UID)) })
+	return refs
+}
+
+// applyConfiguration returns the fields of the desired object that the operator applies. The fields that are set
+// by the API server are left out, along with the fields that are not set, since an apply would otherwise take
+// ownership of them.
+func (c *componentHandler) applyConfiguration(desired client.Object) (*unstructured.Unstructured, error) {
+	gvk := desired.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() {
+		var err error
+		if gvk, err = apiutil.GVKForObject(desired, c.scheme); err != nil {
+			return nil, err
+		}
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired)
+	if err != nil {
+		return nil, err
+	}
+	delete(content, "status")
+	config := &unstructured.Unstructured{Object: removeNulls(content).(map[string]interface{})}
+	config.SetGroupVersionKind(gvk)
+
+	for _, field := range []string{"resourceVersion", "uid", "generation", "creationTimestamp", "managedFields", "selfLink"} {
+		unstructured.RemoveNestedField(config.Object, "metadata", field)
+	}
+	if labels := config.GetLabels(); labels != nil {
+		delete(labels, common.MultipleOwnersLabel)
+		config.SetLabels(labels)
+	}
+	return config, nil
+}
+
+// appliedStateMatches returns true if the current object has the state of the given apply configuration: the
+// operator owns exactly the fields of the configuration, and they have the values of the configuration. The fields
+// that the API server defaults and that other controllers set are not compared.
+func appliedStateMatches(current client.Object, config *unstructured.Unstructured) bool {
+	var owned *fieldpath.Set
+	for _, entry := range current.GetManagedFields() {
+		if entry.Manager != fieldOwner || entry.Operation != metav1.ManagedFieldsOperationApply || entry.Subresource != "" || entry.FieldsV1 == nil {
+			continue
+		}
+		owned = &fieldpath.Set{}
+		if err := owned.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+			return false
+		}
+	}
+	if owned == nil {
+		return false
+	}
+
+	tv, err := typeConverter.ObjectToTyped(config, typed.AllowDuplicates)
+	if err != nil {
+		return false
+	}
+	fields, err := tv.ToFieldSet()
+	if err != nil || !fields.Difference(strippedFields).Equals(owned) {
+		return false
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(current)
+	if err != nil {
+		return false
+	}
+	// Objects read with a typed client do not have their kind set.
+	content["apiVersion"], content["kind"] = config.GetAPIVersion(), config.GetKind()
+	return containsValues(content, config.Object)
+}
+
+// containsValues returns true if the current value has all the values of the desired one. Maps may have more keys
+// than the desired ones, which covers both the keys that other controllers set and the fields that the API server
+// defaults, while lists must have the same length.
+func containsValues(current, desired interface{}) bool {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		c, ok := current.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range d {
+			if cv, ok := c[k]; !ok || !containsValues(cv, v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		c, ok := current.([]interface{})
+		if !ok || len(c) != len(d) {
+			return false
+		}
+		for i := range d {
+			if !containsValues(c[i], d[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(current, desired)
+	}
+}
+
+// removeNulls removes the null values that the zero values of optional fields are converted to, since applying
+// them would set the fields to null rather than leave them unset. Empty maps are kept since some of them are
+// meaningful, such as an emptyDir volume.
+func removeNulls(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if item == nil {
+				delete(v, k)
+				continue
+			}
+			v[k] = removeNulls(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = removeNulls(item)
+		}
+	}
+	return value
+}
+
+// fallbackTypeConverter converts objects with the first of its converters that has a schema for them.
+type fallbackTypeConverter []managedfields.TypeConverter
+
+func (f fallbackTypeConverter) ObjectToTyped(obj runtime.Object, opts ...typed.ValidationOptions) (*typed.TypedValue, error) {
+	var err error
+	for _, tc := range f {
+		var tv *typed.TypedValue
+		if tv, err = tc.ObjectToTyped(obj, opts...); err == nil {
+			return tv, nil
+		}
+	}
+	return nil, err
+}
+
+func (f fallbackTypeConverter) TypedToObject(tv *typed.TypedValue) (runtime.Object, error) {
+	var err error
+	for _, tc := range f {
+		var obj runtime.Object
+		if obj, err = tc.TypedToObject(tv); err == nil {
+			return obj, nil
+		}
+	}
+	return nil, err
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+)
+
+// archLabel is the well-known label with the architecture of a node.
+const archLabel = "kubernetes.io/arch"
+
+// applyArchitectureRestrictions schedules the pods of the given objects on nodes of the architectures that their
+// images are available for, when an ImageSet limits the digests of the images to some architectures. It returns an
+// error naming the objects whose pods cannot run on any of the nodes in the cluster, so that the component is
+// degraded rather than left with pods that can never be scheduled.
+func (c *componentHandler) applyArchitectureRestrictions(ctx context.Context, objs []client.Object) error {
+	// Only images that are pinned by digest can be limited to some architectures, so skip the lookup of the
+	// ImageSets when there are none.
+	pinned := false
+	for _, obj := range objs {
+		modifyPodSpec(obj, func(podSpec *v1.PodSpec) {
+			for _, container := range append(append([]v1.Container{}, podSpec.InitContainers...), podSpec.Containers...) {
+				if strings.Contains(container.Image, "@") {
+					pinned = true
+				}
+			}
+		})
+	}
+	if !pinned {
+		return nil
+	}
+
+	imageArchs := c.imageArchitectures(ctx)
+	if len(imageArchs) == 0 {
+		return nil
+	}
+
+	var nodeArchs map[string]bool
+	nodesListed := false
+	var problems []string
+	for _, obj := range objs {
+		modifyPodSpec(obj, func(podSpec *v1.PodSpec) {
+			archs, limited := podArchitectures(podSpec, imageArchs)
+			if !limited {
+				return
+			}
+			if len(archs) == 0 {
+				problems = append(problems, fmt.Sprintf("the images of %s are not available for a common architecture", describeObject(obj)))
+				return
+			}
+			requireNodeArchitectures(podSpec, archs)
+
+			if !nodesListed {
+				nodeArchs = c.nodeArchitectures(ctx)
+				nodesListed = true
+			}
+			if nodeArchs == nil {
+				return
+			}
+			for _, arch := range archs {
+				if nodeArchs[arch] {
+					return
+				}
+			}
+			problems = append(problems, fmt.Sprintf("the images of %s are only available for %s, and there are no nodes with that architecture",
+				describeObject(obj), strings.Join(archs, ", ")))
+		})
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// imageArchitectures returns the architectures that each image digest in the ImageSets is limited to. Digests of
+// images that are available for every architecture are left out.
+func (c *componentHandler) imageArchitectures(ctx context.Context) map[string][]string {
+	isl := &operatorv1.ImageSetList{}
+	if err := c.client.List(ctx, isl); err != nil {
+		c.log.V(1).Info("Unable to list ImageSets, not restricting the architectures of pods", "reason", err)
+		return nil
+	}
+	imageArchs := map[string][]string{}
+	for _, is := range isl.Items {
+		for _, img := range is.Spec.Images {
+			for _, arch := range img.Architectures {
+				imageArchs[img.Digest] = append(imageArchs[img.Digest], arch.Architecture)
+			}
+		}
+	}
+	return imageArchs
+}
+
+// nodeArchitectures returns the architectures of the nodes in the cluster, or nil if the nodes cannot be listed.
+func (c *componentHandler) nodeArchitectures(ctx context.Context) map[string]bool {
+	nodes := &v1.NodeList{}
+	if err := c.client.List(ctx, nodes); err != nil {
+		c.log.V(1).Info("Unable to list nodes, not checking the architectures of pods", "reason", err)
+		return nil
+	}
+	archs := map[string]bool{}
+	for _, node := range nodes.Items {
+		archs[node.Labels[archLabel]] = true
+	}
+	return archs
+}
+
+// podArchitectures returns the architectures that all the images of the pod are available for, and whether any of
+// the images is limited to some architectures.
+func podArchitectures(podSpec *v1.PodSpec, imageArchs map[string][]string) ([]string, bool) {
+	var archs map[string]bool
+	limited := false
+	for _, container := range append(append([]v1.Container{}, podSpec.InitContainers...), podSpec.Containers...) {
+		_, digest, ok := strings.Cut(container.Image, "@")
+		if !ok {
+			continue
+		}
+		available, ok := imageArchs[digest]
+		if !ok {
+			continue
+		}
+		limited = true
+		next := map[string]bool{}
+		for _, arch := range available {
+			if archs == nil || archs[arch] {
+				next[arch] = true
+			}
+		}
+		archs = next
+	}
+
+	var result []string
+	for arch := range archs {
+		result = append(result, arch)
+	}
+	sort.Strings(result)
+	return result, limited
+}
+
+// requireNodeArchitectures adds a required node affinity for the given architectures to the pod. The requirement is
+// added to each of the existing node selector terms, since only one of them has to match.
+func requireNodeArchitectures(podSpec *v1.PodSpec, archs []string) {
+	requirement := v1.NodeSelectorRequirement{Key: archLabel, Operator: v1.NodeSelectorOpIn, Values: archs}
+
+	// The affinity may be shared with the configuration the component was rendered from, so modify a copy.
+	affinity := podSpec.Affinity.DeepCopy()
+	if affinity == nil {
+		affinity = &v1.Affinity{}
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &v1.NodeAffinity{}
+	}
+	if affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &v1.NodeSelector{}
+	}
+	selector := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(selector.NodeSelectorTerms) == 0 {
+		selector.NodeSelectorTerms = []v1.NodeSelectorTerm{{}}
+	}
+	for i := range selector.NodeSelectorTerms {
+		term := &selector.NodeSelectorTerms[i]
+		found := false
+		for _, expr := range term.MatchExpressions {
+			if reflect.DeepEqual(expr, requirement) {
+				found = true
+			}
+		}
+		if !found {
+			term.MatchExpressions = append(term.MatchExpressions, requirement)
+		}
+	}
+	podSpec.Affinity = affinity
+}
+
+// describeObject returns the kind, namespace and name of the object for use in messages.
+func describeObject(obj client.Object) string {
+	kind := reflect.TypeOf(obj).Elem().Name()
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s", kind, obj.GetName())
+	}
+	return fmt.Sprintf("%s %s/%s", kind, obj.GetNamespace(), obj.GetName())
+}
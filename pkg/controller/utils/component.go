@@ -16,6 +16,9 @@ package utils
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"maps"
 	"reflect"
@@ -46,8 +49,10 @@ import (
 	"github.com/tigera/operator/pkg/apigroup"
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/controller/status"
+	"github.com/tigera/operator/pkg/operatormetrics"
 	"github.com/tigera/operator/pkg/render"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/networkpolicy"
 )
 
 const TLS_CIPHERS_ENV_VAR_NAME = "TLS_CIPHER_SUITES"
@@ -103,40 +108,61 @@ func (c *componentHandler) create(ctx context.Context, obj client.Object, opts .
 	cp := obj.DeepCopyObject().(client.Object)
 
 	// Pass to the client.
-	err := c.client.Create(ctx, obj, opts...)
+	err := c.client.Create(ctx, obj, append(opts, client.FieldOwner(fieldOwner))...)
 	if err != nil {
 		return err
 	}
 
 	// Update the caches so that we don't try to update the object on subsequent reconciliations.
 	dCache.set(cp, obj.GetGeneration())
+	operatormetrics.RecordObjectOperation(ctx, operatormetrics.OperationCreate)
 	return nil
 }
 
-func (c *componentHandler) update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
-	logCtx := ContextLoggerForResource(c.log, obj)
-	if !c.needsUpdate(ctx, obj) {
+// update applies the desired state of an object that already exists. The merged object is the desired state merged
+// with the current one, which is what the cache compares against on subsequent reconciliations.
+func (c *componentHandler) update(ctx context.Context, desired, merged, current client.Object) error {
+	logCtx := ContextLoggerForResource(c.log, merged)
+	if !c.needsUpdate(ctx, merged) {
 		// The object does not need to be updated, so we can skip it.
 		logCtx.V(2).Info("Object does not need to be updated, skipping")
 		return nil
 	}
-	logCtx.V(2).Info("Object needs to be updated")
 
 	// Make a deep copy of the object, so we can stash away the original object in the cache.
-	cp := obj.DeepCopyObject().(client.Object)
+	cp := merged.DeepCopyObject().(client.Object)
 
-	// Pass to the client.
-	err := c.client.Update(ctx, obj, opts...)
+	var applied bool
+	var err error
+	desired = desired.DeepCopyObject().(client.Object)
+	switch merged.(type) {
+	case *esv1.Elasticsearch, *kbv1.Kibana:
+		// The ECK resources are still updated with the state merged from the current one. Their Go types keep the
+		// state of their associations in unexported fields, which the structured merge of an apply cannot convert,
+		// and mergeState only updates them when their spec changes anyway.
+		err = c.client.Update(ctx, merged)
+		applied = true
+		desired.SetGeneration(merged.GetGeneration())
+	default:
+		applied, err = c.apply(ctx, desired, current)
+	}
 	if err != nil {
 		if errors.IsNotFound(err) {
 			// Inlalidate our cached object if it was not found.
-			dCache.delete(obj)
+			dCache.delete(merged)
 		}
 		return err
 	}
+	if !applied {
+		logCtx.V(2).Info("Object already has the applied state, skipping")
+		dCache.set(cp, current.GetGeneration())
+		return nil
+	}
+	logCtx.V(2).Info("Applied object")
 
 	// Update the caches so that we don't try to update the object on subsequent reconciliations.
-	dCache.set(cp, obj.GetGeneration())
+	dCache.set(cp, desired.GetGeneration())
+	operatormetrics.RecordObjectOperation(ctx, operatormetrics.OperationUpdate)
 	return nil
 }
 
@@ -163,6 +189,7 @@ func (c *componentHandler) delete(ctx context.Context, obj client.Object, opts .
 
 	// Invalidate our cached object.
 	dCache.delete(obj)
+	operatormetrics.RecordObjectOperation(ctx, operatormetrics.OperationDelete)
 	return nil
 }
 
@@ -426,7 +453,7 @@ func (c *componentHandler) createOrUpdateObject(ctx context.Context, obj client.
 				return nil
 			}
 		}
-		if err := c.update(ctx, mobj); err != nil {
+		if err := c.update(ctx, obj, mobj, cur); err != nil {
 			logCtx.WithValues("key", key).Info("Failed to update object.")
 			return err
 		}
@@ -440,10 +467,26 @@ func resetMetadataForCreate(obj client.Object) {
 	obj.SetCreationTimestamp(metav1.Time{})
 }
 
+// renderedResource returns the identity of the given object along with a hash of its desired state, for reporting
+// in the TigeraStatus.
+func renderedResource(obj client.Object) operatorv1.RenderedResource {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" {
+		kind = reflect.TypeOf(obj).Elem().Name()
+	}
+	rr := operatorv1.RenderedResource{Kind: kind, Name: obj.GetName(), Namespace: obj.GetNamespace()}
+	if b, err := json.Marshal(obj); err == nil {
+		sum := sha256.Sum256(b)
+		rr.Hash = hex.EncodeToString(sum[:])
+	}
+	return rr
+}
+
 func (c *componentHandler) CreateOrUpdateOrDelete(ctx context.Context, component render.Component, status status.StatusManager) error {
 	// Before creating the component, make sure that it is ready. This provides a hook to do
 	// dependency checking for the component.
 	cmpLog := c.log.WithValues("component", reflect.TypeOf(component))
+	ctx = operatormetrics.WithComponent(ctx, componentName(component))
 	cmpLog.V(2).Info("Checking if component is ready")
 	if !component.Ready() {
 		cmpLog.Info("Component is not ready, skipping")
@@ -457,6 +500,7 @@ func (c *componentHandler) CreateOrUpdateOrDelete(ctx context.Context, component
 	var deployments []types.NamespacedName
 	var statefulsets []types.NamespacedName
 	var cronJobs []types.NamespacedName
+	var renderedResources []operatorv1.RenderedResource
 
 	objsToCreate, objsToDelete := component.Objects()
 	osType := component.SupportedOSType()
@@ -467,6 +511,25 @@ func (c *componentHandler) CreateOrUpdateOrDelete(ctx context.Context, component
 		}
 	}
 
+	objsToCreate, objsToDelete, policyModes := c.applyComponentPolicyMode(ctx, objsToCreate, objsToDelete)
+	objsToCreate, objsToDelete, err := c.applyNamespaceScope(objsToCreate, objsToDelete)
+	if err != nil {
+		cmpLog.Error(err, "Component cannot be reconciled in the namespaces the operator is restricted to")
+		return err
+	}
+
+	gc := c.newGarbageCollection(component, objsToCreate, objsToDelete)
+	if gc != nil {
+		objsToCreate = gc.label(objsToCreate)
+	}
+
+	// Objects whose images cannot run on any node are still created, so that they start once a node of the right
+	// architecture joins, but the error is returned once the component has been reconciled.
+	archErr := c.applyArchitectureRestrictions(ctx, objsToCreate)
+	if archErr != nil {
+		cmpLog.Error(archErr, "Component images are not available for the architectures of the nodes")
+	}
+
 	var alreadyExistsErr error = nil
 
 	for _, obj := range objsToCreate {
@@ -488,6 +551,7 @@ func (c *componentHandler) CreateOrUpdateOrDelete(ctx context.Context, component
 				alreadyRetriedConflict = true
 				goto conflictRetry
 			} else {
+				err = c.namespaceScopeError(obj, err)
 				cmpLog.Error(err, "Failed to create or update object", "key", key)
 				return err
 			}
@@ -504,6 +568,7 @@ func (c *componentHandler) CreateOrUpdateOrDelete(ctx context.Context, component
 		case *batchv1.CronJob:
 			cronJobs = append(cronJobs, key)
 		}
+		renderedResources = append(renderedResources, renderedResource(obj))
 
 		continue
 	}
@@ -522,11 +587,14 @@ func (c *componentHandler) CreateOrUpdateOrDelete(ctx context.Context, component
 		if len(cronJobs) > 0 {
 			status.AddCronJobs(cronJobs)
 		}
+		if len(renderedResources) > 0 {
+			status.AddRenderedResources(renderedResources)
+		}
 	}
 
 	for _, obj := range objsToDelete {
 		err := c.delete(ctx, obj)
-		if err != nil && !errors.IsNotFound(err) {
+		if err != nil && !errors.IsNotFound(err) && !outOfNamespaceScope(obj, err) {
 			logCtx := ContextLoggerForResource(c.log, obj)
 			logCtx.Error(err, fmt.Sprintf("Error deleting object %v", obj))
 			return err
@@ -544,8 +612,14 @@ func (c *componentHandler) CreateOrUpdateOrDelete(ctx context.Context, component
 			case *batchv1.CronJob:
 				status.RemoveCronJobs(key)
 			}
+			status.RemoveRenderedResources(renderedResource(obj))
 		}
 	}
+	componentPolicyModes.set(policyModes)
+
+	if gc != nil {
+		c.collect(ctx, gc, status)
+	}
 
 	cmpLog.V(1).Info("Done reconciling component")
 	// TODO Get each controller to explicitly call ReadyToMonitor on the status manager instead of doing it here.
@@ -553,6 +627,10 @@ func (c *componentHandler) CreateOrUpdateOrDelete(ctx context.Context, component
 		status.ReadyToMonitor()
 	}
 
+	if archErr != nil {
+		return archErr
+	}
+
 	// alreadyExistsErr is only non-nil if this component handler is in "create only" mode and
 	// one (or more) of objsToCreate already existed.
 	return alreadyExistsErr
@@ -642,6 +720,15 @@ func mergeState(desired client.Object, current runtime.Object) client.Object {
 			ds.Spec.ClusterIP = cs.Spec.ClusterIP
 		}
 		return ds
+	case *rbacv1.ClusterRole:
+		// The rules of an aggregated ClusterRole are maintained by the Kubernetes controller manager, so keep them
+		// rather than clearing them on every update.
+		ccr := current.(*rbacv1.ClusterRole)
+		dcr := desired.(*rbacv1.ClusterRole)
+		if dcr.AggregationRule != nil {
+			dcr.Rules = ccr.Rules
+		}
+		return dcr
 	case *batchv1.Job:
 		cj := current.(*batchv1.Job)
 		dj := desired.(*batchv1.Job)
@@ -810,17 +897,18 @@ func modifyPodSpec(obj client.Object, f func(*v1.PodSpec)) {
 	}
 }
 
-// setImagePullPolicy applies an image pull policy to all containers and init containers in
-// the given pod spec. If configuredPolicy is non-nil it is applied to every container,
-// overriding any policy the renderer set — this is what lets a user force IfNotPresent or
-// Never for air-gapped clusters. If configuredPolicy is nil, containers that do not already
-// specify a policy fall back to IfNotPresent.
+// setImagePullPolicy applies an image pull policy to the containers and init containers in
+// the given pod spec that do not already specify one. Renderers leave the policy unset, so a
+// container only has a policy here if it came from a component override, which takes precedence
+// over the Installation. Otherwise the configured policy is applied if non-nil — this is what
+// lets a user force IfNotPresent or Never for air-gapped clusters — falling back to IfNotPresent.
 func setImagePullPolicy(podSpec *v1.PodSpec, configuredPolicy *v1.PullPolicy) {
 	apply := func(c *v1.Container) {
 		switch {
+		case c.ImagePullPolicy != "":
 		case configuredPolicy != nil:
 			c.ImagePullPolicy = *configuredPolicy
-		case c.ImagePullPolicy == "":
+		default:
 			c.ImagePullPolicy = v1.PullIfNotPresent
 		}
 	}
@@ -1103,11 +1191,26 @@ func addNameLabel(obj metav1.Object, name string) {
 // that owns this object.
 // For more on recommended labels see: https://kubernetes.io/docs/concepts/overview/working-with-objects/common-labels/
 func addInstanceLabel(obj metav1.Object, cr metav1.Object) {
+	// An object with multiple owners is not an instance of any one of them, and each owner would otherwise apply
+	// its own name.
+	if checkIfMultipleOwnersLabel(obj) {
+		return
+	}
 	if obj.GetLabels()["app.kubernetes.io/instance"] == "" && cr != nil {
 		obj.GetLabels()["app.kubernetes.io/instance"] = sanitizeLabel(cr.GetName())
 	}
 }
 
+// componentName returns the name of the type of the component, for example render.typhaComponent, which the object
+// operation metrics are labeled with.
+func componentName(component render.Component) string {
+	t := reflect.TypeOf(component)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.String()
+}
+
 // addComponentLabel sets the component within the architecture. We use the kind of the custom resource that owns this
 // object.
 // For more on recommended labels see: https://kubernetes.io/docs/concepts/overview/working-with-objects/common-labels/
@@ -1187,6 +1290,146 @@ func (c *componentHandler) injectAPIGroupEnv(obj client.Object) {
 	}
 }
 
+// policyModeCache records whether each component policy was last reconciled as a staged policy, so that the policy
+// it replaces is only deleted when the mode changes rather than on every reconcile.
+type policyModeCache struct {
+	sync.Mutex
+	staged map[string]bool
+}
+
+func newPolicyModeCache() *policyModeCache {
+	return &policyModeCache{staged: map[string]bool{}}
+}
+
+func (c *policyModeCache) get(key string) (bool, bool) {
+	c.Lock()
+	defer c.Unlock()
+	staged, ok := c.staged[key]
+	return staged, ok
+}
+
+func (c *policyModeCache) set(modes map[string]bool) {
+	c.Lock()
+	defer c.Unlock()
+	maps.Copy(c.staged, modes)
+}
+
+var componentPolicyModes = newPolicyModeCache()
+
+// applyComponentPolicyMode renders the policies in the calico-system tier as staged policies when the Installation
+// asks for them to only be reported on, and deletes the enforced policies they replace. Otherwise, any staged
+// policies left over from a previous mode are deleted. The replaced policies are only deleted when the mode of a
+// policy changes, or on the first reconcile after the operator starts. The returned modes must be recorded in
+// componentPolicyModes once the replaced policies have been deleted.
+func (c *componentHandler) applyComponentPolicyMode(ctx context.Context, objsToCreate, objsToDelete []client.Object) ([]client.Object, []client.Object, map[string]bool) {
+	hasPolicies := false
+	for _, obj := range slices.Concat(objsToCreate, objsToDelete) {
+		if stagedComponentPolicy(obj) != nil {
+			hasPolicies = true
+			break
+		}
+	}
+	if !hasPolicies {
+		return objsToCreate, objsToDelete, nil
+	}
+
+	variant, installation, err := GetInstallationSpec(ctx, c.client)
+	if err != nil || !variant.IsEnterprise() {
+		// Staged policies are only available in Enterprise.
+		return objsToCreate, objsToDelete, nil
+	}
+	staged := installation.ComponentPolicyMode != nil && *installation.ComponentPolicyMode == operatorv1.ComponentPolicyModeStaged
+
+	// Policies that are no longer needed must be deleted whichever mode they were rendered in.
+	toDelete := objsToDelete
+	for _, obj := range objsToDelete {
+		if isComponentPolicyName(obj) {
+			if stagedObj := stagedComponentPolicy(obj); stagedObj != nil {
+				toDelete = append(toDelete, stagedObj)
+			}
+		}
+	}
+
+	var toCreate []client.Object
+	modes := map[string]bool{}
+	for _, obj := range objsToCreate {
+		stagedObj := stagedComponentPolicy(obj)
+		if stagedObj == nil {
+			toCreate = append(toCreate, obj)
+			continue
+		}
+		replaced := stagedObj
+		if staged {
+			toCreate = append(toCreate, stagedObj)
+			replaced = obj
+		} else {
+			toCreate = append(toCreate, obj)
+		}
+		key := fmt.Sprintf("%T/%s", obj, client.ObjectKeyFromObject(obj))
+		if lastStaged, ok := componentPolicyModes.get(key); !ok || lastStaged != staged {
+			toDelete = append(toDelete, replaced)
+		}
+		modes[key] = staged
+	}
+	return toCreate, toDelete, modes
+}
+
+// isComponentPolicyName returns whether the given object is named like a policy in the calico-system tier. Objects
+// to delete are not required to have a spec, so the tier cannot be relied on.
+func isComponentPolicyName(obj client.Object) bool {
+	return strings.HasPrefix(obj.GetName(), networkpolicy.CalicoComponentPolicyPrefix)
+}
+
+// stagedComponentPolicy returns the staged equivalent of the given policy if it is in the calico-system tier, or
+// nil if the object is not such a policy.
+func stagedComponentPolicy(obj client.Object) client.Object {
+	switch p := obj.(type) {
+	case *v3.NetworkPolicy:
+		if p.Spec.Tier != networkpolicy.CalicoTierName && !isComponentPolicyName(p) {
+			return nil
+		}
+		return &v3.StagedNetworkPolicy{
+			TypeMeta:   metav1.TypeMeta{Kind: v3.KindStagedNetworkPolicy, APIVersion: "projectcalico.org/v3"},
+			ObjectMeta: *p.ObjectMeta.DeepCopy(),
+			Spec: v3.StagedNetworkPolicySpec{
+				StagedAction:           v3.StagedActionSet,
+				Tier:                   p.Spec.Tier,
+				Order:                  p.Spec.Order,
+				Ingress:                p.Spec.Ingress,
+				Egress:                 p.Spec.Egress,
+				Selector:               p.Spec.Selector,
+				Types:                  p.Spec.Types,
+				ServiceAccountSelector: p.Spec.ServiceAccountSelector,
+				PerformanceHints:       p.Spec.PerformanceHints,
+			},
+		}
+	case *v3.GlobalNetworkPolicy:
+		if p.Spec.Tier != networkpolicy.CalicoTierName && !isComponentPolicyName(p) {
+			return nil
+		}
+		return &v3.StagedGlobalNetworkPolicy{
+			TypeMeta:   metav1.TypeMeta{Kind: v3.KindStagedGlobalNetworkPolicy, APIVersion: "projectcalico.org/v3"},
+			ObjectMeta: *p.ObjectMeta.DeepCopy(),
+			Spec: v3.StagedGlobalNetworkPolicySpec{
+				StagedAction:           v3.StagedActionSet,
+				Tier:                   p.Spec.Tier,
+				Order:                  p.Spec.Order,
+				Ingress:                p.Spec.Ingress,
+				Egress:                 p.Spec.Egress,
+				Selector:               p.Spec.Selector,
+				Types:                  p.Spec.Types,
+				DoNotTrack:             p.Spec.DoNotTrack,
+				PreDNAT:                p.Spec.PreDNAT,
+				ApplyOnForward:         p.Spec.ApplyOnForward,
+				ServiceAccountSelector: p.Spec.ServiceAccountSelector,
+				NamespaceSelector:      p.Spec.NamespaceSelector,
+				PerformanceHints:       p.Spec.PerformanceHints,
+			},
+		}
+	}
+	return nil
+}
+
 // mergeEnvVars adds or updates env vars in existing. If an env var with the
 // same name already exists, its value is updated in place.
 func mergeEnvVars(existing []v1.EnvVar, toMerge []v1.EnvVar) []v1.EnvVar {
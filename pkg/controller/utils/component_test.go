@@ -27,11 +27,13 @@ import (
 	kbv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/kibana/v1"
 	ocsv1 "github.com/openshift/api/security/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	apps "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	restMeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -46,6 +48,8 @@ import (
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/controller/status"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/namespacescope"
+	"github.com/tigera/operator/pkg/operatormetrics"
 	"github.com/tigera/operator/pkg/render"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 )
@@ -80,7 +84,7 @@ var _ = Describe("Component handler tests", func() {
 
 		c = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
 		ctx = context.Background()
-		sm = status.New(c, "fake-component", &common.VersionInfo{Major: 1, Minor: 19})
+		sm = status.New(c, "fake-component", &common.VersionInfo{Major: 1, Minor: 19}, nil)
 
 		// We need to provide something to handler even though it seems to be unused..
 		instance = &operatorv1.Manager{
@@ -755,18 +759,283 @@ var _ = Describe("Component handler tests", func() {
 			Expect(ps.InitContainers[0].ImagePullPolicy).To(Equal(corev1.PullIfNotPresent))
 		})
 
-		It("overrides every container's policy when a policy is configured on the Installation", func() {
+		It("applies the policy configured on the Installation to containers without an explicit policy", func() {
 			ps := &corev1.PodSpec{
 				Containers:     []corev1.Container{{Image: "a"}, {Image: "b", ImagePullPolicy: corev1.PullAlways}},
-				InitContainers: []corev1.Container{{Image: "init", ImagePullPolicy: corev1.PullAlways}},
+				InitContainers: []corev1.Container{{Image: "init"}},
 			}
 			setImagePullPolicy(ps, ptr.To(corev1.PullNever))
 			Expect(ps.Containers[0].ImagePullPolicy).To(Equal(corev1.PullNever))
-			Expect(ps.Containers[1].ImagePullPolicy).To(Equal(corev1.PullNever), "configured policy must win over renderer defaults")
+			Expect(ps.Containers[1].ImagePullPolicy).To(Equal(corev1.PullAlways), "component override policy must win over the Installation")
 			Expect(ps.InitContainers[0].ImagePullPolicy).To(Equal(corev1.PullNever))
 		})
 	})
 
+	Describe("image architectures", func() {
+		var fc *fakeComponent
+
+		deployment := func(images ...string) *apps.Deployment {
+			d := &apps.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "component", Namespace: "calico-system"}}
+			for i, image := range images {
+				d.Spec.Template.Spec.Containers = append(d.Spec.Template.Spec.Containers, corev1.Container{Name: fmt.Sprintf("c%d", i), Image: image})
+			}
+			return d
+		}
+
+		getAffinity := func() *corev1.Affinity {
+			d := &apps.Deployment{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "component", Namespace: "calico-system"}, d)).NotTo(HaveOccurred())
+			return d.Spec.Template.Spec.Affinity
+		}
+
+		BeforeEach(func() {
+			Expect(c.Create(ctx, &operatorv1.ImageSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "calico-test"},
+				Spec: operatorv1.ImageSetSpec{Images: []operatorv1.Image{
+					{Image: "calico/multiarch", Digest: "sha256:multiarch"},
+					{Image: "calico/amd64-arm64", Digest: "sha256:amd64-arm64", Architectures: []operatorv1.ImageArchitecture{
+						{Architecture: "amd64", Digest: "sha256:amd64"},
+						{Architecture: "arm64", Digest: "sha256:arm64"},
+					}},
+					{Image: "calico/arm64", Digest: "sha256:arm64-only", Architectures: []operatorv1.ImageArchitecture{
+						{Architecture: "arm64", Digest: "sha256:arm64-only"},
+					}},
+					{Image: "calico/s390x", Digest: "sha256:s390x-only", Architectures: []operatorv1.ImageArchitecture{
+						{Architecture: "s390x", Digest: "sha256:s390x-only"},
+					}},
+				}},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-amd64", Labels: map[string]string{"kubernetes.io/arch": "amd64"}}})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-arm64", Labels: map[string]string{"kubernetes.io/arch": "arm64"}}})).NotTo(HaveOccurred())
+		})
+
+		It("leaves pods whose images are available for every architecture alone", func() {
+			fc = &fakeComponent{objs: []client.Object{deployment("example.com/calico/multiarch@sha256:multiarch", "example.com/calico/other:v1")}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+			Expect(getAffinity()).To(BeNil())
+		})
+
+		It("schedules pods on the architectures that all their images are available for", func() {
+			fc = &fakeComponent{objs: []client.Object{deployment("example.com/calico/amd64-arm64@sha256:amd64-arm64", "example.com/calico/multiarch@sha256:multiarch")}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+			Expect(getAffinity().NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(corev1.NodeSelectorTerm{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"amd64", "arm64"}}},
+			}))
+
+			fc = &fakeComponent{objs: []client.Object{deployment("example.com/calico/amd64-arm64@sha256:amd64-arm64", "example.com/calico/arm64@sha256:arm64-only")}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+			Expect(getAffinity().NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(corev1.NodeSelectorTerm{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"arm64"}}},
+			}))
+		})
+
+		It("adds the architectures to each of the existing node selector terms without modifying the rendered affinity", func() {
+			d := deployment("example.com/calico/arm64@sha256:arm64-only")
+			rendered := &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}}}},
+					{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"b"}}}},
+				}},
+			}}
+			d.Spec.Template.Spec.Affinity = rendered
+			fc = &fakeComponent{objs: []client.Object{d}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			arch := corev1.NodeSelectorRequirement{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"arm64"}}
+			Expect(getAffinity().NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(
+				corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}}, arch}},
+				corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"b"}}, arch}},
+			))
+			Expect(rendered.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(HaveLen(1))
+		})
+
+		It("returns an error if the images cannot run on any of the nodes", func() {
+			fc = &fakeComponent{objs: []client.Object{deployment("example.com/calico/s390x@sha256:s390x-only")}}
+			err := handler.CreateOrUpdateOrDelete(ctx, fc, sm)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("the images of Deployment calico-system/component are only available for s390x, and there are no nodes with that architecture"))
+
+			// The Deployment is still created, so that it runs once a node of the architecture joins.
+			Expect(getAffinity().NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(corev1.NodeSelectorTerm{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"s390x"}}},
+			}))
+		})
+
+		It("returns an error if the images have no architecture in common", func() {
+			fc = &fakeComponent{objs: []client.Object{deployment("example.com/calico/arm64@sha256:arm64-only", "example.com/calico/s390x@sha256:s390x-only")}}
+			err := handler.CreateOrUpdateOrDelete(ctx, fc, sm)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("the images of Deployment calico-system/component are not available for a common architecture"))
+		})
+	})
+
+	Describe("component policy mode", func() {
+		var installation *operatorv1.Installation
+		var fc *fakeComponent
+
+		BeforeEach(func() {
+			componentPolicyModes = newPolicyModeCache()
+			installation = &operatorv1.Installation{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       operatorv1.InstallationSpec{ComponentPolicyMode: ptr.To(operatorv1.ComponentPolicyModeStaged)},
+				Status:     operatorv1.InstallationStatus{Variant: operatorv1.CalicoEnterprise},
+			}
+			Expect(c.Create(ctx, installation)).NotTo(HaveOccurred())
+
+			fc = &fakeComponent{
+				objs: []client.Object{
+					&v3.NetworkPolicy{
+						ObjectMeta: metav1.ObjectMeta{Name: "calico-system.component-access", Namespace: "calico-system"},
+						Spec:       v3.NetworkPolicySpec{Tier: "calico-system", Selector: "k8s-app == 'component'"},
+					},
+					&v3.GlobalNetworkPolicy{
+						ObjectMeta: metav1.ObjectMeta{Name: "calico-system.global-access"},
+						Spec:       v3.GlobalNetworkPolicySpec{Tier: "calico-system", NamespaceSelector: "all()"},
+					},
+					&v3.NetworkPolicy{
+						ObjectMeta: metav1.ObjectMeta{Name: "user-policy", Namespace: "calico-system"},
+						Spec:       v3.NetworkPolicySpec{Tier: "default"},
+					},
+				},
+			}
+		})
+
+		It("renders the calico-system tier policies as staged policies and deletes the enforced ones", func() {
+			Expect(c.Create(ctx, &v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "calico-system.component-access", Namespace: "calico-system"}})).NotTo(HaveOccurred())
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			snp := &v3.StagedNetworkPolicy{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "calico-system.component-access", Namespace: "calico-system"}, snp)).NotTo(HaveOccurred())
+			Expect(snp.Spec.StagedAction).To(Equal(v3.StagedActionSet))
+			Expect(snp.Spec.Tier).To(Equal("calico-system"))
+			Expect(snp.Spec.Selector).To(Equal("k8s-app == 'component'"))
+
+			sgnp := &v3.StagedGlobalNetworkPolicy{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "calico-system.global-access"}, sgnp)).NotTo(HaveOccurred())
+			Expect(sgnp.Spec.NamespaceSelector).To(Equal("all()"))
+
+			err := c.Get(ctx, client.ObjectKey{Name: "calico-system.component-access", Namespace: "calico-system"}, &v3.NetworkPolicy{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = c.Get(ctx, client.ObjectKey{Name: "calico-system.global-access"}, &v3.GlobalNetworkPolicy{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			// Policies outside of the calico-system tier are left alone.
+			Expect(c.Get(ctx, client.ObjectKey{Name: "user-policy", Namespace: "calico-system"}, &v3.NetworkPolicy{})).NotTo(HaveOccurred())
+		})
+
+		It("deletes the staged policies when switching back to enforced policies", func() {
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			installation.Spec.ComponentPolicyMode = ptr.To(operatorv1.ComponentPolicyModeEnforced)
+			Expect(c.Update(ctx, installation)).NotTo(HaveOccurred())
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: "calico-system.component-access", Namespace: "calico-system"}, &v3.NetworkPolicy{})).NotTo(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "calico-system.global-access"}, &v3.GlobalNetworkPolicy{})).NotTo(HaveOccurred())
+			err := c.Get(ctx, client.ObjectKey{Name: "calico-system.component-access", Namespace: "calico-system"}, &v3.StagedNetworkPolicy{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = c.Get(ctx, client.ObjectKey{Name: "calico-system.global-access"}, &v3.StagedGlobalNetworkPolicy{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("only deletes the replaced policies when the mode changes", func() {
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			// The enforced policy is not deleted again while the mode stays the same.
+			Expect(c.Create(ctx, &v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "calico-system.component-access", Namespace: "calico-system"}})).NotTo(HaveOccurred())
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "calico-system.component-access", Namespace: "calico-system"}, &v3.NetworkPolicy{})).NotTo(HaveOccurred())
+
+			By("deleting the staged policies once the mode changes")
+			installation.Spec.ComponentPolicyMode = ptr.To(operatorv1.ComponentPolicyModeEnforced)
+			Expect(c.Update(ctx, installation)).NotTo(HaveOccurred())
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+			err := c.Get(ctx, client.ObjectKey{Name: "calico-system.component-access", Namespace: "calico-system"}, &v3.StagedNetworkPolicy{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("renders enforced policies for Calico", func() {
+			installation.Status.Variant = operatorv1.Calico
+			Expect(c.Status().Update(ctx, installation)).NotTo(HaveOccurred())
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: "calico-system.component-access", Namespace: "calico-system"}, &v3.NetworkPolicy{})).NotTo(HaveOccurred())
+			err := c.Get(ctx, client.ObjectKey{Name: "calico-system.component-access", Namespace: "calico-system"}, &v3.StagedNetworkPolicy{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("namespace scope", func() {
+		BeforeEach(func() {
+			c = ctrlrfake.DefaultFakeClientBuilder(scheme).WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme)).Build()
+			handler = NewComponentHandler(logf.Log, c, scheme, instance)
+			namespacescope.Set([]string{"team-a"})
+		})
+
+		AfterEach(func() {
+			namespacescope.Set(nil)
+		})
+
+		It("renders Roles in the managed namespaces in place of ClusterRoles for namespaced resources", func() {
+			subjects := []rbacv1.Subject{{Kind: "ServiceAccount", Name: "reader", Namespace: "team-a"}}
+			fc := &fakeComponent{
+				objs: []client.Object{
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+					&rbacv1.ClusterRole{
+						ObjectMeta: metav1.ObjectMeta{Name: "pod-reader"},
+						Rules:      []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods", "pods/log"}, Verbs: []string{"get"}}},
+					},
+					&rbacv1.ClusterRoleBinding{
+						ObjectMeta: metav1.ObjectMeta{Name: "pod-reader"},
+						RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "pod-reader"},
+						Subjects:   subjects,
+					},
+					&rbacv1.ClusterRole{
+						ObjectMeta: metav1.ObjectMeta{Name: "node-reader"},
+						Rules:      []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"get"}}},
+					},
+					&rbacv1.ClusterRoleBinding{
+						ObjectMeta: metav1.ObjectMeta{Name: "node-reader"},
+						RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "node-reader"},
+						Subjects:   subjects,
+					},
+				},
+			}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			for _, ns := range []string{"team-a", common.OperatorNamespace()} {
+				role := &rbacv1.Role{}
+				Expect(c.Get(ctx, client.ObjectKey{Name: "pod-reader", Namespace: ns}, role)).NotTo(HaveOccurred())
+				Expect(role.Rules).To(Equal(fc.objs[1].(*rbacv1.ClusterRole).Rules))
+				binding := &rbacv1.RoleBinding{}
+				Expect(c.Get(ctx, client.ObjectKey{Name: "pod-reader", Namespace: ns}, binding)).NotTo(HaveOccurred())
+				Expect(binding.RoleRef).To(Equal(rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: "pod-reader"}))
+				Expect(binding.Subjects).To(Equal(subjects))
+			}
+			err := c.Get(ctx, client.ObjectKey{Name: "pod-reader"}, &rbacv1.ClusterRole{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = c.Get(ctx, client.ObjectKey{Name: "team-a"}, &corev1.Namespace{})
+			Expect(errors.IsNotFound(err)).To(BeTrue(), "namespaces are expected to already exist")
+
+			// Cluster-scoped resources still need a ClusterRole.
+			Expect(c.Get(ctx, client.ObjectKey{Name: "node-reader"}, &rbacv1.ClusterRole{})).NotTo(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "node-reader"}, &rbacv1.ClusterRoleBinding{})).NotTo(HaveOccurred())
+		})
+
+		It("returns an error for objects in namespaces the operator is not restricted to", func() {
+			fc := &fakeComponent{
+				objs: []client.Object{
+					&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "team-a"}},
+					&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "team-b"}},
+				},
+			}
+			err := handler.CreateOrUpdateOrDelete(ctx, fc, sm)
+			Expect(err).To(MatchError(ContainSubstring("ConfigMap config is in namespace team-b")))
+			err = c.Get(ctx, client.ObjectKey{Name: "config", Namespace: "team-a"}, &corev1.ConfigMap{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
 	DescribeTable("ensuring os node selectors", func(component render.Component, key client.ObjectKey, obj client.Object, expectedNodeSelectors map[string]string) {
 		Expect(handler.CreateOrUpdateOrDelete(ctx, component, sm)).ShouldNot(HaveOccurred())
 		Expect(c.Get(ctx, key, obj)).ShouldNot(HaveOccurred())
@@ -1203,6 +1472,131 @@ var _ = Describe("Component handler tests", func() {
 		),
 	)
 
+	It("identifies and hashes rendered resources for the status manager", func() {
+		svc := &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "my-service", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080}}},
+		}
+		rr := renderedResource(svc)
+		Expect(rr.Kind).To(Equal("Service"))
+		Expect(rr.Name).To(Equal("my-service"))
+		Expect(rr.Namespace).To(Equal("default"))
+		Expect(rr.Hash).NotTo(BeEmpty())
+		Expect(renderedResource(svc.DeepCopy()).Hash).To(Equal(rr.Hash))
+
+		svc.Spec.Ports[0].Port = 9090
+		Expect(renderedResource(svc).Hash).NotTo(Equal(rr.Hash))
+
+		// Objects rendered without a TypeMeta fall back to their Go type name.
+		cr := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "my-role"}}
+		Expect(renderedResource(cr).Kind).To(Equal("ClusterRole"))
+		Expect(renderedResource(cr).Namespace).To(BeEmpty())
+	})
+
+	It("counts the objects it creates, updates and deletes for the controller and component", func() {
+		ctx = operatormetrics.WithController(ctx, "test-controller")
+		operations := func(operation string) float64 {
+			return testutil.ToFloat64(operatormetrics.ObjectOperations.WithLabelValues("test-controller", "utils.fakeComponent", operation))
+		}
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "counted", Namespace: "calico-system"},
+			Data:       map[string]string{"key": "a"},
+		}
+
+		fc := &fakeComponent{supportedOSType: rmeta.OSTypeLinux, objs: []client.Object{cm.DeepCopy()}}
+		Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+		Expect(operations(operatormetrics.OperationCreate)).To(Equal(1.0))
+		Expect(operations(operatormetrics.OperationUpdate)).To(Equal(0.0))
+
+		cm.Data["key"] = "b"
+		fc = &fakeComponent{supportedOSType: rmeta.OSTypeLinux, objs: []client.Object{cm.DeepCopy()}}
+		Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+		Expect(operations(operatormetrics.OperationUpdate)).To(Equal(1.0))
+
+		fc = &fakeComponent{supportedOSType: rmeta.OSTypeLinux, toDelete: []client.Object{cm.DeepCopy()}}
+		Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+		Expect(operations(operatormetrics.OperationDelete)).To(Equal(1.0))
+	})
+
+	Describe("server-side apply", func() {
+		var updates func() float64
+
+		BeforeEach(func() {
+			c = ctrlrfake.DefaultFakeClientBuilder(scheme).WithReturnManagedFields().Build()
+			handler = NewComponentHandler(logf.Log, c, scheme, instance)
+			ctx = operatormetrics.WithController(ctx, "apply-test-controller")
+			updates = func() float64 {
+				return testutil.ToFloat64(operatormetrics.ObjectOperations.WithLabelValues("apply-test-controller", "utils.fakeComponent", operatormetrics.OperationUpdate))
+			}
+			dCache = newCache()
+		})
+
+		It("skips objects that are unchanged since they were last applied", func() {
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "applied", Namespace: "calico-system"},
+				Data:       map[string]string{"key": "a"},
+			}
+			fc := &fakeComponent{supportedOSType: rmeta.OSTypeLinux, objs: []client.Object{cm.DeepCopy()}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			// The cache of the operator does not survive a restart, so the first reconcile after it takes over the
+			// fields the operator created the object with, and later ones find that nothing changed.
+			before := updates()
+			dCache = newCache()
+			fc = &fakeComponent{supportedOSType: rmeta.OSTypeLinux, objs: []client.Object{cm.DeepCopy()}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+			Expect(updates()).To(Equal(before + 1))
+
+			current := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), current)).NotTo(HaveOccurred())
+			dCache = newCache()
+			fc = &fakeComponent{supportedOSType: rmeta.OSTypeLinux, objs: []client.Object{cm.DeepCopy()}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+			Expect(updates()).To(Equal(before + 1))
+
+			unchanged := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), unchanged)).NotTo(HaveOccurred())
+			Expect(unchanged.ResourceVersion).To(Equal(current.ResourceVersion))
+
+			By("applying the object when someone else changes a field the operator owns")
+			unchanged.Data["key"] = "changed"
+			Expect(c.Update(ctx, unchanged, client.FieldOwner("kubectl"))).NotTo(HaveOccurred())
+			dCache = newCache()
+			fc = &fakeComponent{supportedOSType: rmeta.OSTypeLinux, objs: []client.Object{cm.DeepCopy()}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+			Expect(updates()).To(Equal(before + 2))
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), current)).NotTo(HaveOccurred())
+			Expect(current.Data).To(Equal(map[string]string{"key": "a"}))
+		})
+
+		It("removes the fields it no longer renders and keeps the ones others set", func() {
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "applied", Namespace: "calico-system"},
+				Data:       map[string]string{"key": "a", "removed": "b"},
+			}
+			fc := &fakeComponent{supportedOSType: rmeta.OSTypeLinux, objs: []client.Object{cm.DeepCopy()}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			current := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), current)).NotTo(HaveOccurred())
+			current.Labels["external"] = "label"
+			Expect(c.Update(ctx, current, client.FieldOwner("kubectl"))).NotTo(HaveOccurred())
+
+			delete(cm.Data, "removed")
+			fc = &fakeComponent{supportedOSType: rmeta.OSTypeLinux, objs: []client.Object{cm.DeepCopy()}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), current)).NotTo(HaveOccurred())
+			Expect(current.Data).To(Equal(map[string]string{"key": "a"}))
+			Expect(current.Labels).To(HaveKeyWithValue("external", "label"))
+			Expect(current.ManagedFields).To(ContainElement(And(
+				HaveField("Manager", "tigera-operator"),
+				HaveField("Operation", metav1.ManagedFieldsOperationApply),
+			)))
+		})
+	})
+
 	It("recreates a service if its ClusterIP is removed", func() {
 		// Simulate creation of a service by earlier version of operator that includes a ClusterIP.
 		svcWithIP := &corev1.Service{
@@ -1447,6 +1841,94 @@ var _ = Describe("Component handler tests", func() {
 			"Expected update of ClusterRoleBinding to rev resourceversion to 2")
 	})
 
+	Describe("garbage collection", func() {
+		BeforeEach(func() {
+			c = ctrlrfake.DefaultFakeClientBuilder(scheme).WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme)).Build()
+			handler = NewComponentHandler(logf.Log, c, scheme, instance)
+			collectedMu.Lock()
+			collected = map[string]string{}
+			collectedMu.Unlock()
+		})
+
+		clusterRole := func(name string) *rbacv1.ClusterRole {
+			return &rbacv1.ClusterRole{
+				TypeMeta:   metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+			}
+		}
+		serviceAccount := &corev1.ServiceAccount{
+			TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "gc-sa", Namespace: "default"},
+		}
+
+		It("should label the rendered objects and delete the ones that are no longer rendered", func() {
+			fc := &fakeGCComponent{identity: "gc", fakeComponent: fakeComponent{objs: []client.Object{clusterRole("gc-old"), serviceAccount}}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).To(Succeed())
+
+			sa := &corev1.ServiceAccount{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(serviceAccount), sa)).To(Succeed())
+			Expect(sa.Labels).To(HaveKeyWithValue(render.GarbageCollectionComponentLabel, "gc"))
+
+			// Rename the ClusterRole, as a new release would.
+			fc.objs = []client.Object{clusterRole("gc-new"), serviceAccount}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).To(Succeed())
+
+			err := c.Get(ctx, client.ObjectKey{Name: "gc-old"}, &rbacv1.ClusterRole{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			cr := &rbacv1.ClusterRole{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "gc-new"}, cr)).To(Succeed())
+			Expect(cr.Labels).To(HaveKeyWithValue(render.GarbageCollectionComponentLabel, "gc"))
+		})
+
+		It("should only collect when the rendered objects change", func() {
+			fc := &fakeGCComponent{identity: "gc", fakeComponent: fakeComponent{objs: []client.Object{clusterRole("gc-new")}}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).To(Succeed())
+
+			// An object labeled while the rendered objects stay the same is left until they change.
+			stale := clusterRole("gc-stale")
+			stale.Labels = map[string]string{render.GarbageCollectionComponentLabel: "gc"}
+			Expect(c.Create(ctx, stale)).To(Succeed())
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "gc-stale"}, &rbacv1.ClusterRole{})).To(Succeed())
+
+			fc.objs = append(fc.objs, serviceAccount)
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).To(Succeed())
+			err := c.Get(ctx, client.ObjectKey{Name: "gc-stale"}, &rbacv1.ClusterRole{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should not delete objects of other components or objects without the labels", func() {
+			other := clusterRole("other")
+			other.Labels = map[string]string{render.GarbageCollectionComponentLabel: "other"}
+			Expect(c.Create(ctx, other)).To(Succeed())
+			Expect(c.Create(ctx, clusterRole("unlabeled"))).To(Succeed())
+
+			fc := &fakeGCComponent{identity: "gc", fakeComponent: fakeComponent{objs: []client.Object{clusterRole("gc-new")}}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: "other"}, &rbacv1.ClusterRole{})).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "unlabeled"}, &rbacv1.ClusterRole{})).To(Succeed())
+		})
+
+		It("should not garbage collect components with an empty identity", func() {
+			fc := &fakeGCComponent{fakeComponent: fakeComponent{objs: []client.Object{clusterRole("not-gc")}}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).To(Succeed())
+
+			cr := &rbacv1.ClusterRole{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "not-gc"}, cr)).To(Succeed())
+			Expect(cr.Labels).NotTo(HaveKey(render.GarbageCollectionComponentLabel))
+		})
+
+		It("should not label the objects of components that are not garbage collected", func() {
+			fc := &fakeComponent{objs: []client.Object{clusterRole("not-gc")}}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).To(Succeed())
+
+			cr := &rbacv1.ClusterRole{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "not-gc"}, cr)).To(Succeed())
+			Expect(cr.Labels).NotTo(HaveKey(render.GarbageCollectionComponentLabel))
+		})
+	})
+
 	Context("with a terminating Namespace", func() {
 		var ns *corev1.Namespace
 		BeforeEach(func() {
@@ -1946,6 +2428,30 @@ var _ = Describe("Component handler tests", func() {
 			Expect(sa.ImagePullSecrets).To(HaveLen(1))
 		})
 	})
+	Context("aggregated cluster role updates", func() {
+		It("preserves the rules filled in by the aggregation controller", func() {
+			cr := &rbacv1.ClusterRole{
+				ObjectMeta: metav1.ObjectMeta{Name: "aggregated"},
+				AggregationRule: &rbacv1.AggregationRule{
+					ClusterRoleSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"aggregate-to-aggregated": "true"}}},
+				},
+				Rules: []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}},
+			}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+
+			cr = cr.DeepCopy()
+			cr.ResourceVersion = ""
+			cr.Rules = nil
+			fc := &fakeComponent{
+				supportedOSType: rmeta.OSTypeLinux,
+				objs:            []client.Object{cr},
+			}
+
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "aggregated"}, cr)).NotTo(HaveOccurred())
+			Expect(cr.Rules).To(HaveLen(1))
+		})
+	})
 	Context("volumes and volume mounts", func() {
 		It("orders by name alphabetically", func() {
 			fc := &fakeComponent{
@@ -2057,7 +2563,9 @@ var _ = Describe("Mocked client Component handler tests", func() {
 		c = &mc
 		ctx = context.Background()
 
-		handler = NewComponentHandler(logf.Log, c, runtime.NewScheme(), nil)
+		scheme := runtime.NewScheme()
+		Expect(apps.SchemeBuilder.AddToScheme(scheme)).ShouldNot(HaveOccurred())
+		handler = NewComponentHandler(logf.Log, c, scheme, nil)
 
 		// Use a new cache for each test.
 		dCache = newCache()
@@ -2106,7 +2614,7 @@ var _ = Describe("Mocked client Component handler tests", func() {
 				InputMutator: setToDS,
 			})
 			mc.Info = append(mc.Info, mockReturn{
-				Method: "Update",
+				Method: "Apply",
 				Return: errors.NewConflict(schema.GroupResource{}, "error name", fmt.Errorf("test error message")),
 			})
 
@@ -2126,9 +2634,8 @@ var _ = Describe("Mocked client Component handler tests", func() {
 				InputMutator: setToDS,
 			})
 			mc.Info = append(mc.Info, mockReturn{
-				Method:       "Update",
-				Return:       nil,
-				InputMutator: setToDS,
+				Method: "Apply",
+				Return: nil,
 			})
 
 			err := handler.CreateOrUpdateOrDelete(ctx, fc, nil)
@@ -2154,7 +2661,7 @@ var _ = Describe("Mocked client Component handler tests", func() {
 				InputMutator: setToDS,
 			})
 			mc.Info = append(mc.Info, mockReturn{
-				Method: "Update",
+				Method: "Apply",
 				Return: errors.NewConflict(schema.GroupResource{}, "error name", fmt.Errorf("test error message")),
 			})
 
@@ -2174,7 +2681,7 @@ var _ = Describe("Mocked client Component handler tests", func() {
 				InputMutator: setToDS,
 			})
 			mc.Info = append(mc.Info, mockReturn{
-				Method: "Update",
+				Method: "Apply",
 				Return: errors.NewConflict(schema.GroupResource{}, "error name", fmt.Errorf("test error message 2")),
 			})
 
@@ -2216,13 +2723,16 @@ var _ = Describe("Mocked client Component handler tests", func() {
 		}
 
 		// Two Get calls are issued up-front to load the InstallationSpec
-		// (one for the Installation, one for the overlay).
+		// (one for the Installation, one for the overlay). The InstallationSpec is
+		// loaded twice for policies in the calico-system tier: once to check the
+		// component policy mode and once when the policy is reconciled.
 		installationGets := func() {
 			mc.Info = append(mc.Info, mockReturn{Method: "Get", Return: nil})
 			mc.Info = append(mc.Info, mockReturn{Method: "Get", Return: nil})
 		}
 
 		It("NetworkPolicy updates are omitted if there is no change", func() {
+			installationGets()
 			installationGets()
 			mc.Info = append(mc.Info, mockReturn{
 				Method:       "Get",
@@ -2232,7 +2742,7 @@ var _ = Describe("Mocked client Component handler tests", func() {
 
 			err := handler.CreateOrUpdateOrDelete(ctx, fc, nil)
 			Expect(err).To(BeNil())
-			Expect(mc.Index).To(Equal(3))
+			Expect(mc.Index).To(Equal(5))
 		})
 
 		It("NetworkPolicy updates are applied if there is a change", func() {
@@ -2244,6 +2754,7 @@ var _ = Describe("Mocked client Component handler tests", func() {
 				}
 			}
 
+			installationGets()
 			installationGets()
 			mc.Info = append(mc.Info, mockReturn{
 				Method:       "Get",
@@ -2252,14 +2763,13 @@ var _ = Describe("Mocked client Component handler tests", func() {
 			})
 
 			mc.Info = append(mc.Info, mockReturn{
-				Method:       "Update",
-				Return:       nil,
-				InputMutator: setToBaseNP,
+				Method: "Apply",
+				Return: nil,
 			})
 
 			err := handler.CreateOrUpdateOrDelete(ctx, fc, nil)
 			Expect(err).To(BeNil())
-			Expect(mc.Index).To(Equal(4))
+			Expect(mc.Index).To(Equal(6))
 		})
 	})
 
@@ -2318,9 +2828,8 @@ var _ = Describe("Mocked client Component handler tests", func() {
 			})
 
 			mc.Info = append(mc.Info, mockReturn{
-				Method:       "Update",
-				Return:       nil,
-				InputMutator: setToBaseTier,
+				Method: "Apply",
+				Return: nil,
 			})
 
 			err := handler.CreateOrUpdateOrDelete(ctx, fc, nil)
@@ -2333,6 +2842,7 @@ var _ = Describe("Mocked client Component handler tests", func() {
 // A fake component that only returns ready and always creates the "test-namespace" Namespace.
 type fakeComponent struct {
 	objs            []client.Object
+	toDelete        []client.Object
 	supportedOSType rmeta.OSType
 }
 
@@ -2345,13 +2855,22 @@ func (c *fakeComponent) ResolveImages(is *operatorv1.ImageSet) error {
 }
 
 func (c *fakeComponent) Objects() ([]client.Object, []client.Object) {
-	return c.objs, nil
+	return c.objs, c.toDelete
 }
 
 func (c *fakeComponent) SupportedOSType() rmeta.OSType {
 	return c.supportedOSType
 }
 
+type fakeGCComponent struct {
+	fakeComponent
+	identity string
+}
+
+func (c *fakeGCComponent) GarbageCollectionIdentity() string {
+	return c.identity
+}
+
 type mockReturn struct {
 	Method       string
 	Return       interface{}
@@ -2400,7 +2919,23 @@ func (mc *mockClient) List(ctx context.Context, list client.ObjectList, opts ...
 }
 
 func (mc *mockClient) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
-	panic("Apply not implemented in mockClient")
+	defer func() { mc.Index++ }()
+	funcName := "Apply"
+	if len(mc.Info) <= mc.Index {
+		panic(fmt.Sprintf("mockClient Info doesn't have enough entries for %s", funcName))
+	}
+	if mc.Info[mc.Index].Method != funcName {
+		panic(fmt.Sprintf("mockClient current (%d) call is for %v, not %s", mc.Index, mc.Info[mc.Index].Method, funcName))
+	}
+	if mc.Info[mc.Index].Return == nil {
+		return nil
+	}
+
+	v, ok := mc.Info[mc.Index].Return.(error)
+	if !ok {
+		panic(fmt.Sprintf("mockClient Info didn't have right type for entry %d for %s", mc.Index, funcName))
+	}
+	return v
 }
 
 func (mc *mockClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
@@ -24,6 +24,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// defaultFelixPrometheusMetricsPort is the port Felix serves Prometheus metrics on unless the FelixConfiguration
+// sets another.
+const defaultFelixPrometheusMetricsPort = 9091
+
 func PatchFelixConfiguration(ctx context.Context, c client.Client, patchFn func(fc *v3.FelixConfiguration) (bool, error)) (*v3.FelixConfiguration, error) {
 	// Fetch any existing default FelixConfiguration object.
 	fc := &v3.FelixConfiguration{}
@@ -70,6 +74,14 @@ func GetFelixConfiguration(ctx context.Context, c client.Client) (*v3.FelixConfi
 	return fc, nil
 }
 
+// GetFelixPrometheusMetricsPort returns the port Felix serves Prometheus metrics on.
+func GetFelixPrometheusMetricsPort(felixConfiguration *v3.FelixConfiguration) int {
+	if felixConfiguration.Spec.PrometheusMetricsPort != nil {
+		return *felixConfiguration.Spec.PrometheusMetricsPort
+	}
+	return defaultFelixPrometheusMetricsPort
+}
+
 func IsFelixPrometheusMetricsEnabled(felixConfiguration *v3.FelixConfiguration) bool {
 	if felixConfiguration.Spec.PrometheusMetricsEnabled != nil {
 		return *felixConfiguration.Spec.PrometheusMetricsEnabled
@@ -0,0 +1,225 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/tigera/operator/pkg/controller/status"
+	"github.com/tigera/operator/pkg/render"
+)
+
+// garbageCollectedKinds are always checked for stale objects, even if the component no longer renders any object of
+// the kind, as renamed RBAC would otherwise accumulate across upgrades.
+var garbageCollectedKinds = []schema.GroupVersionKind{
+	{Version: "v1", Kind: "ServiceAccount"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRoleBinding"},
+}
+
+// collected holds the digest of the objects each component rendered the last time its stale objects were collected.
+// Stale objects can only appear when the objects a component renders change, so collection, which lists every
+// garbage collected kind in each namespace of the component, is skipped while the digest stays the same.
+var (
+	collectedMu sync.Mutex
+	collected   = map[string]string{}
+)
+
+type gcKey struct {
+	schema.GroupKind
+	types.NamespacedName
+}
+
+// garbageCollection tracks the objects rendered by a GarbageCollectedComponent.
+type garbageCollection struct {
+	identity   string
+	digest     string
+	rendered   map[gcKey]bool
+	kinds      map[schema.GroupVersionKind]bool
+	namespaces map[string]bool
+}
+
+// newGarbageCollection returns the garbage collection state of the given component, or nil if the component is not
+// garbage collected.
+func (c *componentHandler) newGarbageCollection(component render.Component, objsToCreate, objsToDelete []client.Object) *garbageCollection {
+	gc, ok := component.(render.GarbageCollectedComponent)
+	if !ok || c.createOnly || gc.GarbageCollectionIdentity() == "" {
+		return nil
+	}
+	g := &garbageCollection{
+		identity:   gc.GarbageCollectionIdentity(),
+		rendered:   map[gcKey]bool{},
+		kinds:      map[schema.GroupVersionKind]bool{},
+		namespaces: map[string]bool{},
+	}
+	for _, gvk := range garbageCollectedKinds {
+		g.kinds[gvk] = true
+	}
+
+	var ids []string
+	for _, obj := range objsToCreate {
+		gvk, err := apiutil.GVKForObject(obj, c.scheme)
+		if err != nil {
+			continue
+		}
+		g.kinds[gvk] = true
+		g.rendered[gcKey{gvk.GroupKind(), client.ObjectKeyFromObject(obj)}] = true
+		if ns := obj.GetNamespace(); ns != "" {
+			g.namespaces[ns] = true
+		}
+		ids = append(ids, fmt.Sprintf("create %s/%s/%s", gvk.GroupKind(), obj.GetNamespace(), obj.GetName()))
+	}
+	for _, obj := range objsToDelete {
+		if gvk, err := apiutil.GVKForObject(obj, c.scheme); err == nil {
+			g.kinds[gvk] = true
+			ids = append(ids, fmt.Sprintf("delete %s/%s/%s", gvk.GroupKind(), obj.GetNamespace(), obj.GetName()))
+		}
+		if ns := obj.GetNamespace(); ns != "" {
+			g.namespaces[ns] = true
+		}
+	}
+
+	sort.Strings(ids)
+	sum := sha256.New()
+	for _, id := range ids {
+		sum.Write([]byte(id + "\n"))
+	}
+	g.digest = hex.EncodeToString(sum.Sum(nil))
+	return g
+}
+
+// label returns a copy of the given objects with the garbage collection label set. The label only identifies the
+// component, so it does not change when the component renders other objects.
+func (g *garbageCollection) label(objs []client.Object) []client.Object {
+	labeled := make([]client.Object, 0, len(objs))
+	for _, obj := range objs {
+		obj = obj.DeepCopyObject().(client.Object)
+		l := obj.GetLabels()
+		if l == nil {
+			l = map[string]string{}
+		}
+		l[render.GarbageCollectionComponentLabel] = g.identity
+		obj.SetLabels(l)
+		labeled = append(labeled, obj)
+	}
+	return labeled
+}
+
+// collect deletes the objects labeled with the identity of the component that are no longer rendered. Garbage
+// collection is best effort: errors are logged and the objects are tried again on the next reconcile.
+func (c *componentHandler) collect(ctx context.Context, g *garbageCollection, status status.StatusManager) {
+	collectedMu.Lock()
+	upToDate := collected[g.identity] == g.digest
+	collectedMu.Unlock()
+	if upToDate {
+		return
+	}
+
+	selector := client.MatchingLabels{render.GarbageCollectionComponentLabel: g.identity}
+	complete := true
+	for gvk := range g.kinds {
+		namespaced, err := apiutil.IsGVKNamespaced(gvk, c.client.RESTMapper())
+		if err != nil {
+			// The kind is not served by the API server, so there is nothing to collect.
+			continue
+		}
+		namespaces := []string{""}
+		if namespaced {
+			namespaces = namespaces[:0]
+			for ns := range g.namespaces {
+				namespaces = append(namespaces, ns)
+			}
+		}
+		for _, ns := range namespaces {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+			if err := c.client.List(ctx, list, client.InNamespace(ns), selector); err != nil {
+				c.log.V(1).Info("Unable to list objects for garbage collection", "kind", gvk.Kind, "namespace", ns, "error", err)
+				complete = false
+				continue
+			}
+			for i := range list.Items {
+				key := client.ObjectKeyFromObject(&list.Items[i])
+				if g.rendered[gcKey{gvk.GroupKind(), key}] {
+					continue
+				}
+				obj, err := c.typedObject(gvk, key)
+				if err != nil {
+					c.log.V(1).Info("Unable to garbage collect object", "kind", gvk.Kind, "key", key, "error", err)
+					continue
+				}
+				ContextLoggerForResource(c.log, obj).Info("Deleting stale object", "component", g.identity)
+				if err := c.delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+					c.log.Error(err, "Failed to delete stale object", "kind", gvk.Kind, "key", key)
+					complete = false
+					continue
+				}
+				if status != nil {
+					removeFromStatus(status, gvk.GroupKind(), key)
+				}
+			}
+		}
+	}
+
+	if complete {
+		collectedMu.Lock()
+		collected[g.identity] = g.digest
+		collectedMu.Unlock()
+	}
+}
+
+// typedObject returns an object of the given kind and key, as the component handler works with typed objects.
+func (c *componentHandler) typedObject(gvk schema.GroupVersionKind, key types.NamespacedName) (client.Object, error) {
+	o, err := c.scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := o.(client.Object)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an object", gvk.Kind)
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	obj.SetName(key.Name)
+	obj.SetNamespace(key.Namespace)
+	return obj, nil
+}
+
+// removeFromStatus stops the status manager from monitoring a workload that was garbage collected.
+func removeFromStatus(status status.StatusManager, gk schema.GroupKind, key types.NamespacedName) {
+	switch gk {
+	case schema.GroupKind{Group: "apps", Kind: "Deployment"}:
+		status.RemoveDeployments(key)
+	case schema.GroupKind{Group: "apps", Kind: "DaemonSet"}:
+		status.RemoveDaemonsets(key)
+	case schema.GroupKind{Group: "apps", Kind: "StatefulSet"}:
+		status.RemoveStatefulSets(key)
+	case schema.GroupKind{Group: "batch", Kind: "CronJob"}:
+		status.RemoveCronJobs(key)
+	}
+}
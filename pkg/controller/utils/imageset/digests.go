@@ -0,0 +1,192 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imageset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	operator "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/components"
+	"github.com/tigera/operator/pkg/controller/utils"
+)
+
+const (
+	digestLookupTimeout = 10 * time.Second
+
+	// Failed lookups are retried after a backoff that doubles with each failure, so that an unreachable registry
+	// does not slow down every reconcile.
+	minDigestRetryBackoff = 30 * time.Second
+	maxDigestRetryBackoff = 10 * time.Minute
+)
+
+var log = logf.Log.WithName("imageset")
+
+// lookupDigest returns the digest that the given image reference points to. It is a variable so that it can be
+// stubbed out in tests.
+var lookupDigest = func(ctx context.Context, ref string, keychain authn.Keychain) (string, error) {
+	r, err := name.ParseReference(ref)
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(ctx, digestLookupTimeout)
+	defer cancel()
+	desc, err := remote.Head(r, remote.WithContext(ctx), remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return "", err
+	}
+	return desc.Digest.String(), nil
+}
+
+// now is a variable so that it can be stubbed out in tests.
+var now = time.Now
+
+// digestLookup is the result of resolving an image reference.
+type digestLookup struct {
+	digest string
+	err    error
+	// failures is the number of consecutive failed lookups and retryAt the time before which the last failure is
+	// returned rather than looking the reference up again.
+	failures int
+	retryAt  time.Time
+}
+
+// digests caches the digests that image references have been resolved to. The tags of a release are not expected
+// to move, so they are only looked up once for the lifetime of the operator.
+var digests = struct {
+	sync.Mutex
+	byRef map[string]*digestLookup
+}{byRef: map[string]*digestLookup{}}
+
+// ResolveImageSet returns an ImageSet that pins the images of the given variant to the digests their tags point to,
+// when the installation asks for images to be resolved by digest. It returns nil otherwise. Images that cannot be
+// resolved are left out of the ImageSet, so that only the components that use them fail to render.
+func ResolveImageSet(ctx context.Context, c client.Client, v operator.ProductVariant, installation *operator.InstallationSpec) (*operator.ImageSet, error) {
+	if installation == nil || installation.ImageResolution == nil || *installation.ImageResolution != operator.ImageResolutionDigest {
+		return nil, nil
+	}
+
+	pullSecrets, err := utils.GetInstallationPullSecrets(installation, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull secrets to resolve image digests: %w", err)
+	}
+	keychain, err := newPullSecretKeychain(pullSecrets)
+	if err != nil {
+		return nil, err
+	}
+
+	images, imagePath := components.CalicoImages, components.CalicoImagePath
+	if v.IsEnterprise() {
+		images, imagePath = components.EnterpriseImages, components.TigeraImagePath
+	}
+
+	is := &operator.ImageSet{ObjectMeta: metav1.ObjectMeta{Name: getSetName(v)}}
+	for _, img := range images {
+		ref, err := components.GetReference(img, installation.Registry, installation.ImagePath, installation.ImagePrefix, nil, installation.ComponentImages...)
+		if err != nil {
+			return nil, err
+		}
+		digest, err := resolveDigest(ctx, ref, keychain)
+		if err != nil {
+			log.Error(err, "Unable to resolve image digest", "image", ref)
+			continue
+		}
+		is.Spec.Images = append(is.Spec.Images, operator.Image{Image: imagePath + img.Image, Digest: digest})
+	}
+	return is, nil
+}
+
+// resolveDigest returns the cached digest of the reference, or looks it up. The cache is not locked during the lookup,
+// so that a slow registry does not block the other controllers that resolve digests.
+func resolveDigest(ctx context.Context, ref string, keychain authn.Keychain) (string, error) {
+	digests.Lock()
+	cached, ok := digests.byRef[ref]
+	digests.Unlock()
+	if ok && (cached.err == nil || now().Before(cached.retryAt)) {
+		return cached.digest, cached.err
+	}
+
+	digest, err := lookupDigest(ctx, ref, keychain)
+	result := &digestLookup{digest: digest, err: err}
+	if err != nil {
+		if ok {
+			result.failures = cached.failures
+		}
+		result.failures++
+		backoff := maxDigestRetryBackoff
+		if shift := result.failures - 1; shift < 5 {
+			backoff = min(minDigestRetryBackoff<<shift, maxDigestRetryBackoff)
+		}
+		result.retryAt = now().Add(backoff)
+	}
+
+	digests.Lock()
+	defer digests.Unlock()
+	digests.byRef[ref] = result
+	return digest, err
+}
+
+// pullSecretKeychain authenticates to registries with the credentials from docker config pull secrets.
+type pullSecretKeychain map[string]authn.AuthConfig
+
+func newPullSecretKeychain(secrets []*corev1.Secret) (pullSecretKeychain, error) {
+	keychain := pullSecretKeychain{}
+	for _, s := range secrets {
+		data, ok := s.Data[corev1.DockerConfigJsonKey]
+		if !ok {
+			continue
+		}
+		cfg := struct {
+			Auths map[string]authn.AuthConfig `json:"auths"`
+		}{}
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse pull secret %s: %w", s.Name, err)
+		}
+		for server, auth := range cfg.Auths {
+			keychain[registryHost(server)] = auth
+		}
+	}
+	return keychain, nil
+}
+
+func (k pullSecretKeychain) Resolve(r authn.Resource) (authn.Authenticator, error) {
+	if auth, ok := k[r.RegistryStr()]; ok {
+		return authn.FromConfig(auth), nil
+	}
+	return authn.Anonymous, nil
+}
+
+// registryHost returns the registry host of a docker config server, which may be a URL such as
+// https://index.docker.io/v1/.
+func registryHost(server string) string {
+	server = strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	server, _, _ = strings.Cut(server, "/")
+	if server == "docker.io" {
+		return name.DefaultRegistry
+	}
+	return server
+}
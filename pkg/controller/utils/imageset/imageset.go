@@ -19,12 +19,15 @@ import (
 	"fmt"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
 	operator "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/components"
+	"github.com/tigera/operator/pkg/controller/utils"
 	"github.com/tigera/operator/pkg/ctrlruntime"
 	"github.com/tigera/operator/pkg/render"
-	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/handler"
 )
 
 const (
@@ -33,7 +36,8 @@ const (
 )
 
 // ApplyImageSet gets the appropriate ImageSet, validates the ImageSet, and calls ResolveImages
-// passing in the ImageSet on each of the comps.
+// passing in the ImageSet on each of the comps. If there is no ImageSet, one is resolved from the
+// registry when the Installation asks for images to be pinned by digest.
 func ApplyImageSet(ctx context.Context, c client.Client, v operator.ProductVariant, comps ...render.Component) error {
 	imageSet, err := GetImageSet(ctx, c, v)
 	if err != nil {
@@ -44,6 +48,19 @@ func ApplyImageSet(ctx context.Context, c client.Client, v operator.ProductVaria
 		return err
 	}
 
+	if imageSet == nil {
+		_, installation, err := utils.GetInstallationSpec(ctx, c)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if imageSet, err = ResolveImageSet(ctx, c, v, installation); err != nil {
+			return err
+		}
+		if err = ValidateImageVerification(imageSet, v, installation); err != nil {
+			return err
+		}
+	}
+
 	return ResolveImages(imageSet, comps...)
 }
 
@@ -174,6 +191,11 @@ func ValidateImageSet(is *operator.ImageSet) error {
 		if !strings.HasPrefix(img.Digest, "sha256:") {
 			invalidDigests = append(invalidDigests, fmt.Sprintf("%s@%s", img.Image, img.Digest))
 		}
+		for _, arch := range img.Architectures {
+			if arch.Architecture == "" || !strings.HasPrefix(arch.Digest, "sha256:") {
+				invalidDigests = append(invalidDigests, fmt.Sprintf("%s@%s (architecture %q)", img.Image, arch.Digest, arch.Architecture))
+			}
+		}
 	}
 
 	if len(unknownImages) == 0 && len(invalidDigests) == 0 {
@@ -192,6 +214,16 @@ func ValidateImageSet(is *operator.ImageSet) error {
 	return fmt.Errorf("ImageSet %s: %s", is.Name, strings.Join(errMsgs, "; "))
 }
 
+// ValidateImageVerification returns an error if image verification is enabled but there is no ImageSet. The
+// signatures of the images are verified against their digests, so they must be pinned by an ImageSet, either one
+// that was created by the user or one from ResolveImageSet.
+func ValidateImageVerification(is *operator.ImageSet, v operator.ProductVariant, installation *operator.InstallationSpec) error {
+	if is != nil || installation == nil || installation.ImageVerification == nil {
+		return nil
+	}
+	return fmt.Errorf("an ImageSet named %s or image resolution by digest is required while image verification is enabled", getSetName(v))
+}
+
 func ResolveImages(is *operator.ImageSet, comps ...render.Component) error {
 	errMsgs := []string{}
 	for _, comp := range comps {
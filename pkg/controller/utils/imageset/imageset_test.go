@@ -17,18 +17,25 @@ package imageset
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
 	//"k8s.io/client-go/kubernetes/fake"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	operator "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/apis"
+	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/components"
 )
 
@@ -52,6 +59,143 @@ var _ = Describe("imageset tests", func() {
 		})
 	})
 
+	Context("image verification", func() {
+		installation := &operator.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: operator.InstallationSpec{
+				Variant:           operator.Calico,
+				ImageVerification: &operator.ImageVerification{PublicKey: "key"},
+			},
+		}
+
+		It("should require an ImageSet while image verification is enabled", func() {
+			c := fake.NewClientBuilder().WithScheme(kscheme.Scheme).WithObjects(installation.DeepCopy()).Build()
+			e := ApplyImageSet(context.Background(), c, operator.Calico)
+			Expect(e).To(MatchError(fmt.Sprintf("an ImageSet named calico-%s or image resolution by digest is required while image verification is enabled", components.CalicoRelease)))
+		})
+
+		It("should accept an ImageSet while image verification is enabled", func() {
+			c := fake.NewClientBuilder().WithScheme(kscheme.Scheme).WithObjects(
+				installation.DeepCopy(),
+				&operator.ImageSet{
+					ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("calico-%s", components.CalicoRelease)},
+				},
+			).Build()
+			Expect(ApplyImageSet(context.Background(), c, operator.Calico)).To(Succeed())
+		})
+	})
+
+	Context("digest resolution", func() {
+		var lookups map[string]authn.AuthConfig
+		var restore func()
+
+		BeforeEach(func() {
+			lookups = map[string]authn.AuthConfig{}
+			digests.byRef = map[string]*digestLookup{}
+			original, originalNow := lookupDigest, now
+			lookupDigest = func(ctx context.Context, ref string, keychain authn.Keychain) (string, error) {
+				r, err := name.ParseReference(ref)
+				Expect(err).NotTo(HaveOccurred())
+				auth, err := keychain.Resolve(r.Context())
+				Expect(err).NotTo(HaveOccurred())
+				cfg, err := auth.Authorization()
+				Expect(err).NotTo(HaveOccurred())
+				lookups[ref] = *cfg
+				if strings.HasSuffix(ref, "/calico/whisker:"+components.ComponentCalicoWhisker.Version) {
+					return "", fmt.Errorf("not found")
+				}
+				return "sha256:" + ref, nil
+			}
+			restore = func() { lookupDigest, now = original, originalNow }
+		})
+
+		AfterEach(func() {
+			restore()
+		})
+
+		It("should not resolve digests unless asked to", func() {
+			c := fake.NewClientBuilder().WithScheme(kscheme.Scheme).Build()
+			for _, mode := range []*operator.ImageResolution{nil, ptr.To(operator.ImageResolutionTag)} {
+				is, err := ResolveImageSet(context.Background(), c, operator.Calico, &operator.InstallationSpec{ImageResolution: mode})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(is).To(BeNil())
+			}
+			Expect(lookups).To(BeEmpty())
+		})
+
+		It("should pin the images of the variant to the digests of their tags", func() {
+			c := fake.NewClientBuilder().WithScheme(kscheme.Scheme).WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "pull-secret", Namespace: common.OperatorNamespace()},
+				Data: map[string][]byte{
+					corev1.DockerConfigJsonKey: []byte(`{"auths":{"https://registry.example.com/v1/":{"username":"user","password":"pass"}}}`),
+				},
+			}).Build()
+			installation := &operator.InstallationSpec{
+				Registry:         "registry.example.com/",
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "pull-secret"}},
+				ImageResolution:  ptr.To(operator.ImageResolutionDigest),
+			}
+
+			is, err := ResolveImageSet(context.Background(), c, operator.Calico, installation)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(is.Name).To(Equal(fmt.Sprintf("calico-%s", components.CalicoRelease)))
+			Expect(ValidateImageSet(is)).To(Succeed())
+
+			nodeRef := fmt.Sprintf("registry.example.com/calico/node:%s", components.ComponentCalicoNode.Version)
+			Expect(is.Spec.Images).To(ContainElement(operator.Image{Image: "calico/node", Digest: "sha256:" + nodeRef}))
+			Expect(lookups).To(HaveKey(nodeRef))
+			Expect(lookups[nodeRef].Username).To(Equal("user"))
+			Expect(lookups[nodeRef].Password).To(Equal("pass"))
+
+			// Images that could not be resolved are left out.
+			Expect(is.Spec.Images).NotTo(ContainElement(HaveField("Image", "calico/whisker")))
+
+			// Digests are cached.
+			lookups = map[string]authn.AuthConfig{}
+			_, err = ResolveImageSet(context.Background(), c, operator.Calico, installation)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lookups).NotTo(HaveKey(nodeRef))
+		})
+
+		It("should back off from looking up images that could not be resolved", func() {
+			c := fake.NewClientBuilder().WithScheme(kscheme.Scheme).Build()
+			installation := &operator.InstallationSpec{ImageResolution: ptr.To(operator.ImageResolutionDigest)}
+			whiskerRef := fmt.Sprintf("quay.io/calico/whisker:%s", components.ComponentCalicoWhisker.Version)
+			start := time.Now()
+			now = func() time.Time { return start }
+
+			_, err := ResolveImageSet(context.Background(), c, operator.Calico, installation)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lookups).To(HaveKey(whiskerRef))
+
+			By("not looking the image up again during the backoff")
+			lookups = map[string]authn.AuthConfig{}
+			now = func() time.Time { return start.Add(minDigestRetryBackoff - time.Second) }
+			_, err = ResolveImageSet(context.Background(), c, operator.Calico, installation)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lookups).NotTo(HaveKey(whiskerRef))
+
+			By("looking it up again after the backoff, and doubling the backoff")
+			now = func() time.Time { return start.Add(minDigestRetryBackoff) }
+			_, err = ResolveImageSet(context.Background(), c, operator.Calico, installation)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lookups).To(HaveKey(whiskerRef))
+			Expect(digests.byRef[whiskerRef].retryAt).To(Equal(start.Add(3 * minDigestRetryBackoff)))
+		})
+
+		It("should satisfy image verification without an ImageSet", func() {
+			c := fake.NewClientBuilder().WithScheme(kscheme.Scheme).WithObjects(&operator.Installation{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: operator.InstallationSpec{
+					Variant:           operator.Calico,
+					ImageVerification: &operator.ImageVerification{PublicKey: "key"},
+					ImageResolution:   ptr.To(operator.ImageResolutionDigest),
+				},
+			}).Build()
+			Expect(ApplyImageSet(context.Background(), c, operator.Calico)).To(Succeed())
+		})
+	})
+
 	Context("Test imageset validation", func() {
 		DescribeTable("", func(v operator.ProductVariant) {
 			nm := fmt.Sprintf("calico-%s", components.CalicoRelease)
@@ -106,6 +250,24 @@ var _ = Describe("imageset tests", func() {
 			err = ApplyImageSet(context.Background(), c, v)
 			Expect(err).ToNot(BeNil())
 			Expect(err.Error()).To(ContainSubstring("bad digest images"))
+			c = fake.NewClientBuilder().WithScheme(kscheme.Scheme).WithObjects(
+				&operator.ImageSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: nm,
+					},
+					Spec: operator.ImageSetSpec{
+						Images: []operator.Image{
+							{Image: "calico/node", Digest: "sha256:xxxxxxxxx", Architectures: []operator.ImageArchitecture{
+								{Architecture: "amd64", Digest: "sha256:yyyyyyyyy"},
+								{Architecture: "arm64", Digest: "zzzzzzzzz"},
+							}},
+						},
+					},
+				},
+			).Build()
+			err = ApplyImageSet(context.Background(), c, v)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`bad digest images: calico/node@zzzzzzzzz (architecture "arm64")`))
 		},
 			Entry("Calico variant", operator.Calico),
 			Entry("Enterprise variant", operator.CalicoEnterprise),
@@ -55,6 +55,12 @@ func OverrideInstallationSpec(cfg, override operatorv1.InstallationSpec) operato
 		inst.ImagePrefix = override.ImagePrefix
 	}
 
+	switch compareFields(inst.ComponentImages, override.ComponentImages) {
+	case BOnlySet, Different:
+		inst.ComponentImages = make([]operatorv1.ComponentImage, len(override.ComponentImages))
+		copy(inst.ComponentImages, override.ComponentImages)
+	}
+
 	switch compareFields(inst.ImagePullSecrets, override.ImagePullSecrets) {
 	case BOnlySet, Different:
 		inst.ImagePullSecrets = make([]v1.LocalObjectReference, len(override.ImagePullSecrets))
@@ -66,6 +72,21 @@ func OverrideInstallationSpec(cfg, override operatorv1.InstallationSpec) operato
 		inst.ImagePullPolicy = ptr.To(*override.ImagePullPolicy)
 	}
 
+	switch compareFields(inst.ImageVerification, override.ImageVerification) {
+	case BOnlySet, Different:
+		inst.ImageVerification = override.ImageVerification.DeepCopy()
+	}
+
+	switch compareFields(inst.ImageResolution, override.ImageResolution) {
+	case BOnlySet, Different:
+		inst.ImageResolution = ptr.To(*override.ImageResolution)
+	}
+
+	switch compareFields(inst.ComponentPolicyMode, override.ComponentPolicyMode) {
+	case BOnlySet, Different:
+		inst.ComponentPolicyMode = ptr.To(*override.ComponentPolicyMode)
+	}
+
 	switch compareFields(inst.KubernetesProvider, override.KubernetesProvider) {
 	case BOnlySet, Different:
 		inst.KubernetesProvider = override.KubernetesProvider
@@ -114,6 +135,36 @@ func OverrideInstallationSpec(cfg, override operatorv1.InstallationSpec) operato
 		inst.TyphaMetricsPort = override.TyphaMetricsPort
 	}
 
+	switch compareFields(inst.TyphaMetricsTLS, override.TyphaMetricsTLS) {
+	case BOnlySet, Different:
+		inst.TyphaMetricsTLS = override.TyphaMetricsTLS
+	}
+
+	switch compareFields(inst.TyphaMetricsAuth, override.TyphaMetricsAuth) {
+	case BOnlySet, Different:
+		inst.TyphaMetricsAuth = override.TyphaMetricsAuth
+	}
+
+	switch compareFields(inst.TyphaMetricsUpstreamPort, override.TyphaMetricsUpstreamPort) {
+	case BOnlySet, Different:
+		inst.TyphaMetricsUpstreamPort = override.TyphaMetricsUpstreamPort
+	}
+
+	switch compareFields(inst.MetricsRemoteWrite, override.MetricsRemoteWrite) {
+	case BOnlySet, Different:
+		inst.MetricsRemoteWrite = override.MetricsRemoteWrite.DeepCopy()
+	}
+
+	switch compareFields(inst.RBACMode, override.RBACMode) {
+	case BOnlySet, Different:
+		inst.RBACMode = override.RBACMode
+	}
+
+	switch compareFields(inst.VerticalPodAutoscaling, override.VerticalPodAutoscaling) {
+	case BOnlySet, Different:
+		inst.VerticalPodAutoscaling = override.VerticalPodAutoscaling.DeepCopy()
+	}
+
 	switch compareFields(inst.FlexVolumePath, override.FlexVolumePath) {
 	case BOnlySet, Different:
 		inst.FlexVolumePath = override.FlexVolumePath
@@ -147,11 +198,26 @@ func OverrideInstallationSpec(cfg, override operatorv1.InstallationSpec) operato
 		override.CertificateManagement.DeepCopyInto(inst.CertificateManagement)
 	}
 
+	switch compareFields(inst.CertManager, override.CertManager) {
+	case BOnlySet, Different:
+		inst.CertManager = override.CertManager.DeepCopy()
+	}
+
+	switch compareFields(inst.CertificateRotation, override.CertificateRotation) {
+	case BOnlySet, Different:
+		inst.CertificateRotation = override.CertificateRotation.DeepCopy()
+	}
+
 	switch compareFields(inst.TLSCipherSuites, override.TLSCipherSuites) {
 	case BOnlySet, Different:
 		inst.TLSCipherSuites = override.TLSCipherSuites
 	}
 
+	switch compareFields(inst.TLSSecurityProfile, override.TLSSecurityProfile) {
+	case BOnlySet, Different:
+		inst.TLSSecurityProfile = override.TLSSecurityProfile
+	}
+
 	switch compareFields(inst.NonPrivileged, override.NonPrivileged) {
 	case BOnlySet, Different:
 		inst.NonPrivileged = override.NonPrivileged
@@ -191,6 +257,16 @@ func OverrideInstallationSpec(cfg, override operatorv1.InstallationSpec) operato
 		inst.TyphaDeployment = mergeTyphaDeployment(inst.TyphaDeployment, override.TyphaDeployment)
 	}
 
+	switch compareFields(inst.TyphaConfiguration, override.TyphaConfiguration) {
+	case BOnlySet, Different:
+		inst.TyphaConfiguration = override.TyphaConfiguration.DeepCopy()
+	}
+
+	switch compareFields(inst.TyphaService, override.TyphaService) {
+	case BOnlySet, Different:
+		inst.TyphaService = override.TyphaService.DeepCopy()
+	}
+
 	switch compareFields(inst.CalicoWindowsUpgradeDaemonSet, override.CalicoWindowsUpgradeDaemonSet) {
 	case BOnlySet:
 		inst.CalicoWindowsUpgradeDaemonSet = override.CalicoWindowsUpgradeDaemonSet.DeepCopy()
@@ -203,6 +279,16 @@ func OverrideInstallationSpec(cfg, override operatorv1.InstallationSpec) operato
 		inst.FIPSMode = override.FIPSMode
 	}
 
+	switch compareFields(inst.ManagedPriorityClasses, override.ManagedPriorityClasses) {
+	case BOnlySet, Different:
+		inst.ManagedPriorityClasses = override.ManagedPriorityClasses
+	}
+
+	switch compareFields(inst.PodDisruptionBudgets, override.PodDisruptionBudgets) {
+	case BOnlySet, Different:
+		inst.PodDisruptionBudgets = override.PodDisruptionBudgets
+	}
+
 	switch compareFields(inst.Logging, override.Logging) {
 	case BOnlySet, Different:
 		inst.Logging = override.Logging
@@ -230,6 +316,12 @@ func OverrideInstallationSpec(cfg, override operatorv1.InstallationSpec) operato
 		inst.Proxy = override.Proxy
 	}
 
+	switch compareFields(inst.NamespacePodSecurityStandards, override.NamespacePodSecurityStandards) {
+	case BOnlySet, Different:
+		inst.NamespacePodSecurityStandards = make([]operatorv1.NamespacePodSecurityStandard, len(override.NamespacePodSecurityStandards))
+		copy(inst.NamespacePodSecurityStandards, override.NamespacePodSecurityStandards)
+	}
+
 	return inst
 }
 
@@ -17,6 +17,7 @@ package utils
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -25,6 +26,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
@@ -559,6 +561,48 @@ var _ = Describe("Installation merge tests", func() {
 		Entry("Both set not matching", intPtr(1460), intPtr(8981), intPtr(8981)),
 	)
 
+	_typhaConfigA := &opv1.TyphaConfiguration{MaxConnectionsUpperLimit: intPtr(5000)}
+	_typhaConfigB := &opv1.TyphaConfiguration{ConnectionDropIntervalSeconds: intPtr(2)}
+	DescribeTable("merge TyphaConfiguration", func(main, second, expect *opv1.TyphaConfiguration) {
+		m := opv1.InstallationSpec{}
+		s := opv1.InstallationSpec{}
+		if main != nil {
+			m.TyphaConfiguration = main
+		}
+		if second != nil {
+			s.TyphaConfiguration = second
+		}
+		inst := OverrideInstallationSpec(m, s)
+		Expect(inst.TyphaConfiguration).To(Equal(expect))
+	},
+		Entry("Both unset", nil, nil, nil),
+		Entry("Main only set", _typhaConfigA, nil, _typhaConfigA),
+		Entry("Second only set", nil, _typhaConfigB, _typhaConfigB),
+		Entry("Both set equal", _typhaConfigA, _typhaConfigA, _typhaConfigA),
+		Entry("Both set not matching", _typhaConfigA, _typhaConfigB, _typhaConfigB),
+	)
+
+	_certRotationA := &opv1.CertificateRotation{CertificateDuration: &metav1.Duration{Duration: 48 * time.Hour}}
+	_certRotationB := &opv1.CertificateRotation{RenewBefore: &metav1.Duration{Duration: time.Hour}}
+	DescribeTable("merge CertificateRotation", func(main, second, expect *opv1.CertificateRotation) {
+		m := opv1.InstallationSpec{}
+		s := opv1.InstallationSpec{}
+		if main != nil {
+			m.CertificateRotation = main
+		}
+		if second != nil {
+			s.CertificateRotation = second
+		}
+		inst := OverrideInstallationSpec(m, s)
+		Expect(inst.CertificateRotation).To(Equal(expect))
+	},
+		Entry("Both unset", nil, nil, nil),
+		Entry("Main only set", _certRotationA, nil, _certRotationA),
+		Entry("Second only set", nil, _certRotationB, _certRotationB),
+		Entry("Both set equal", _certRotationA, _certRotationA, _certRotationA),
+		Entry("Both set not matching", _certRotationA, _certRotationB, _certRotationB),
+	)
+
 	DescribeTable("merge FlexVolumePath", func(main, second, expect string) {
 		m := opv1.InstallationSpec{}
 		s := opv1.InstallationSpec{}
@@ -0,0 +1,175 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/tigera/operator/pkg/namespacescope"
+)
+
+// applyNamespaceScope adapts the objects of a component to the namespaces the operator is restricted to, if any.
+// ClusterRoles that only grant access to namespaced resources are rendered as Roles in each managed namespace,
+// and their bindings as RoleBindings. Namespaces are expected to already exist and are left alone. An error is
+// returned if the component has objects in a namespace the operator does not manage.
+func (c *componentHandler) applyNamespaceScope(objsToCreate, objsToDelete []client.Object) ([]client.Object, []client.Object, error) {
+	if !namespacescope.Restricted() {
+		return objsToCreate, objsToDelete, nil
+	}
+	namespaces := namespacescope.Namespaces()
+
+	convertible := map[string]bool{}
+	for _, obj := range objsToCreate {
+		ns := obj.GetNamespace()
+		if _, ok := obj.(*v1.Namespace); ok {
+			ns = obj.GetName()
+		}
+		if ns != "" && !namespacescope.Manages(ns) {
+			return nil, nil, fmt.Errorf("%s %s is in namespace %s, which the operator is not restricted to (%s)",
+				c.kind(obj), obj.GetName(), ns, strings.Join(namespaces, ", "))
+		}
+		if cr, ok := obj.(*rbacv1.ClusterRole); ok && cr.AggregationRule == nil && c.namespacedRules(cr.Rules) {
+			convertible[cr.Name] = true
+		}
+	}
+
+	var toCreate []client.Object
+	for _, obj := range objsToCreate {
+		switch o := obj.(type) {
+		case *v1.Namespace:
+			continue
+		case *rbacv1.ClusterRole:
+			if convertible[o.Name] {
+				for _, ns := range namespaces {
+					toCreate = append(toCreate, &rbacv1.Role{
+						TypeMeta:   metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"},
+						ObjectMeta: namespacedObjectMeta(o.ObjectMeta, ns),
+						Rules:      o.Rules,
+					})
+				}
+				continue
+			}
+		case *rbacv1.ClusterRoleBinding:
+			if o.RoleRef.Kind == "ClusterRole" && convertible[o.RoleRef.Name] {
+				for _, ns := range namespaces {
+					toCreate = append(toCreate, &rbacv1.RoleBinding{
+						TypeMeta:   metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+						ObjectMeta: namespacedObjectMeta(o.ObjectMeta, ns),
+						RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: o.RoleRef.Name},
+						Subjects:   o.Subjects,
+					})
+				}
+				continue
+			}
+		case *rbacv1.RoleBinding:
+			if o.RoleRef.Kind == "ClusterRole" && convertible[o.RoleRef.Name] {
+				o = o.DeepCopy()
+				o.RoleRef.Kind = "Role"
+				obj = o
+			}
+		}
+		toCreate = append(toCreate, obj)
+	}
+
+	// Objects outside of the managed namespaces cannot exist, and the Roles and RoleBindings that replaced deleted
+	// cluster RBAC must be deleted with it.
+	var toDelete []client.Object
+	for _, obj := range objsToDelete {
+		switch obj.(type) {
+		case *v1.Namespace:
+			continue
+		case *rbacv1.ClusterRole:
+			for _, ns := range namespaces {
+				toDelete = append(toDelete, &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: obj.GetName(), Namespace: ns}})
+			}
+		case *rbacv1.ClusterRoleBinding:
+			for _, ns := range namespaces {
+				toDelete = append(toDelete, &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: obj.GetName(), Namespace: ns}})
+			}
+		}
+		if ns := obj.GetNamespace(); ns == "" || namespacescope.Manages(ns) {
+			toDelete = append(toDelete, obj)
+		}
+	}
+	return toCreate, toDelete, nil
+}
+
+// namespacedRules returns whether the given rules only grant access to namespaced resources, and so can be granted
+// by a Role instead of a ClusterRole.
+func (c *componentHandler) namespacedRules(rules []rbacv1.PolicyRule) bool {
+	for _, rule := range rules {
+		if len(rule.NonResourceURLs) > 0 {
+			return false
+		}
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				if group == rbacv1.APIGroupAll || resource == rbacv1.ResourceAll {
+					return false
+				}
+				resource, _, _ = strings.Cut(resource, "/")
+				gvk, err := c.client.RESTMapper().KindFor(schema.GroupVersionResource{Group: group, Resource: resource})
+				if err != nil {
+					// Resources that are not known to the API server are assumed to be cluster scoped.
+					return false
+				}
+				if namespaced, err := apiutil.IsGVKNamespaced(gvk, c.client.RESTMapper()); err != nil || !namespaced {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// outOfNamespaceScope returns whether the given error is the API server denying access to a cluster-scoped object
+// because the operator is restricted to namespaces.
+func outOfNamespaceScope(obj client.Object, err error) bool {
+	return namespacescope.Restricted() && obj.GetNamespace() == "" && errors.IsForbidden(err)
+}
+
+// namespaceScopeError explains an error creating a cluster-scoped object when the operator is restricted to
+// namespaces and so does not have access to it.
+func (c *componentHandler) namespaceScopeError(obj client.Object, err error) error {
+	if !outOfNamespaceScope(obj, err) {
+		return err
+	}
+	return fmt.Errorf("%s %s is cluster scoped and cannot be managed while the operator is restricted to namespaces (%s): %w",
+		c.kind(obj), obj.GetName(), strings.Join(namespacescope.Namespaces(), ", "), err)
+}
+
+func (c *componentHandler) kind(obj client.Object) string {
+	if gvk, err := apiutil.GVKForObject(obj, c.scheme); err == nil {
+		return gvk.Kind
+	}
+	return fmt.Sprintf("%T", obj)
+}
+
+func namespacedObjectMeta(meta metav1.ObjectMeta, ns string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Namespace:   ns,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
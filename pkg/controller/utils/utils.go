@@ -223,6 +223,27 @@ func AddSecretWatchWithLabel(c ctrlruntime.Controller, ns, label string) error {
 	})
 }
 
+// AddConfigMapWatchWithLabel adds a ConfigMap watch for ConfigMaps with the given label in the given namespace.
+// If no namespace is provided, it watches cluster-wide. Updates that remove the label are also reported, so that
+// the ConfigMap stops being used.
+func AddConfigMapWatchWithLabel(c ctrlruntime.Controller, ns, label string) error {
+	return c.WatchObject(&corev1.ConfigMap{}, &handler.EnqueueRequestForObject{}, &predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			_, hasLabel := e.Object.GetLabels()[label]
+			return (ns == "" || e.Object.GetNamespace() == ns) && hasLabel
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			_, hadLabel := e.ObjectOld.GetLabels()[label]
+			_, hasLabel := e.ObjectNew.GetLabels()[label]
+			return (ns == "" || e.ObjectNew.GetNamespace() == ns) && (hadLabel || hasLabel)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			_, hasLabel := e.Object.GetLabels()[label]
+			return (ns == "" || e.Object.GetNamespace() == ns) && hasLabel
+		},
+	})
+}
+
 // AddCSRWatchWithRelevancyFn adds a watch for CSRs with the given label. isRelevantFn is a function that returns true for
 // items that are relevant to the caller.
 func AddCSRWatchWithRelevancyFn(c ctrlruntime.Controller, isRelevantFn func(*certificatesv1.CertificateSigningRequest) bool) error {
@@ -688,9 +709,13 @@ type resourceWatchContext struct {
 // watched objects. This is useful for resources whose meaningful changes are status-only updates
 // that don't bump generation (e.g., DatastoreMigration phase transitions).
 func WaitToAddResourceWatch(controller ctrlruntime.Controller, c kubernetes.Interface, log logr.Logger, flag *ReadyFlag, objs []client.Object, predicates ...predicate.Predicate) {
-	// Track resources left to watch and establish their watch context.
+	// Track resources left to watch and establish their watch context. Watches that are a dependency of the
+	// controller, as signaled by the flag, are reported as pending in the health registry until they are established.
 	resourcesToWatch := map[client.Object]resourceWatchContext{}
 	for _, obj := range objs {
+		if flag != nil {
+			ctrlruntime.WatchPending(controller, obj)
+		}
 		pred := createPredicateForObject(obj)
 		if len(predicates) > 0 {
 			pred = predicate.And(predicates...)
@@ -729,6 +754,9 @@ func WaitToAddResourceWatch(controller ctrlruntime.Controller, c kubernetes.Inte
 				objLog.WithValues("Error", err).Info("Failed to watch resource - will retry")
 			} else {
 				objLog.V(2).Info("Successfully watching resource")
+				if flag != nil {
+					ctrlruntime.WatchEstablished(controller, obj)
+				}
 				delete(resourcesToWatch, obj)
 			}
 		}
@@ -854,6 +882,20 @@ func GetKubeControllerMetricsPort(ctx context.Context, client client.Client) (in
 	return kubeControllersMetricsPort, nil
 }
 
+// GetFluentdMetricsPort returns the port fluentd serves Prometheus metrics on, as configured on the LogCollector.
+func GetFluentdMetricsPort(ctx context.Context, client client.Client) (int, error) {
+	logCollector := &operatorv1.LogCollector{}
+	err := client.Get(ctx, DefaultEnterpriseInstanceKey, logCollector)
+	if err != nil && !errors.IsNotFound(err) {
+		return 0, err
+	}
+
+	if logCollector.Spec.MetricsPort != nil {
+		return int(*logCollector.Spec.MetricsPort), nil
+	}
+	return render.FluentdMetricsPort, nil
+}
+
 func GetElasticsearch(ctx context.Context, c client.Client) (*esv1.Elasticsearch, error) {
 	es := esv1.Elasticsearch{}
 	err := c.Get(ctx, client.ObjectKey{Name: render.ElasticsearchName, Namespace: render.ElasticsearchNamespace}, &es)
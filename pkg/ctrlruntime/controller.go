@@ -1,4 +1,4 @@
-// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+// Copyright (c) 2024-2026 Tigera, Inc. All rights reserved.
 
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -22,13 +22,20 @@
 package ctrlruntime
 
 import (
+	"context"
+	"time"
+
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/tigera/operator/pkg/controller/health"
+	"github.com/tigera/operator/pkg/operatormetrics"
 )
 
 // Controller implements and extends the controller.Controller interface. Implementations should store the cache from the
@@ -52,17 +59,53 @@ type Controller interface {
 type controler struct {
 	controller.Controller
 	cach cache.Cache
+	name string
 }
 
+// NewController creates a controller and registers it with the health registry, which records the result of each of
+// its reconciles. The duration and result of each reconcile are also recorded in the reconcile metrics.
 func NewController(name string, mgr manager.Manager, options controller.Options) (Controller, error) {
+	if options.Reconciler != nil {
+		options.Reconciler = &healthReconciler{Reconciler: options.Reconciler, name: name}
+	}
 	c, err := controller.New(name, mgr, options)
 	if err != nil {
 		return nil, err
 	}
+	health.DefaultRegistry.AddController(name)
 
-	return &controler{Controller: c, cach: mgr.GetCache()}, nil
+	return &controler{Controller: c, cach: mgr.GetCache(), name: name}, nil
+}
+
+// WatchPending records in the health registry that the controller is waiting to watch the object, for example
+// because its CRD is not available yet.
+func WatchPending(c Controller, object client.Object) {
+	if c, ok := c.(*controler); ok {
+		health.DefaultRegistry.WatchPending(c.name, object.GetObjectKind().GroupVersionKind().Kind, object.GetName())
+	}
+}
+
+// WatchEstablished records in the health registry that the controller now watches the object.
+func WatchEstablished(c Controller, object client.Object) {
+	if c, ok := c.(*controler); ok {
+		health.DefaultRegistry.WatchEstablished(c.name, object.GetObjectKind().GroupVersionKind().Kind, object.GetName())
+	}
 }
 
 func (c *controler) WatchObject(object client.Object, eventhandler handler.EventHandler, predicates ...predicate.Predicate) error {
 	return c.Watch(source.Kind(c.cach, object, eventhandler, predicates...))
 }
+
+// healthReconciler records the result of each reconcile in the health registry and the reconcile metrics.
+type healthReconciler struct {
+	reconcile.Reconciler
+	name string
+}
+
+func (r *healthReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	start := time.Now()
+	result, err := r.Reconciler.Reconcile(operatormetrics.WithController(ctx, r.name), request)
+	health.DefaultRegistry.ReconcileCompleted(r.name, err)
+	operatormetrics.ObserveReconcile(r.name, start, result, err)
+	return result, err
+}
@@ -20,6 +20,9 @@ import (
 	"net"
 	"os"
 	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const (
@@ -85,3 +88,57 @@ func GetServiceDNSNames(name, namespace, clusterDomain string) []string {
 	}
 	return domains
 }
+
+// ServiceDNSAliases maps a service, as <svc_name>.<ns>, to additional DNS names that the certificate of the service
+// must be valid for, for example names under which the service is reachable through split-horizon DNS.
+type ServiceDNSAliases map[string][]string
+
+// ParseServiceDNSAliases parses ServiceDNSAliases from the data of a ConfigMap. Each key is a service as
+// <svc_name>.<ns> and each value is a comma or whitespace separated list of DNS names. Entries that are not valid are
+// left out of the aliases and returned, by key, with the reason they are not valid.
+func ParseServiceDNSAliases(data map[string]string) (ServiceDNSAliases, map[string]error) {
+	aliases := ServiceDNSAliases{}
+	invalid := map[string]error{}
+services:
+	for service, value := range data {
+		if parts := strings.Split(service, "."); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			invalid[service] = fmt.Errorf("service %q must be of the form <service>.<namespace>", service)
+			continue
+		}
+		var names []string
+		for _, name := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' || r == '\n' || r == '\t' }) {
+			if len(validation.IsDNS1123Subdomain(name)) > 0 && len(validation.IsWildcardDNS1123Subdomain(name)) > 0 {
+				invalid[service] = fmt.Errorf("service %q has an invalid DNS name %q", service, name)
+				continue services
+			}
+			names = append(names, name)
+		}
+		if len(names) > 0 {
+			aliases[service] = names
+		}
+	}
+	return aliases, invalid
+}
+
+// WithAliases returns the given DNS names, as returned by GetServiceDNSNames, extended with the aliases of the
+// services they belong to. Names that are already present are not added again.
+func (a ServiceDNSAliases) WithAliases(dnsNames []string) []string {
+	if len(a) == 0 {
+		return dnsNames
+	}
+	present := map[string]bool{}
+	for _, name := range dnsNames {
+		present[name] = true
+	}
+	// Copy the names so that appending the aliases never writes to the backing array of the caller.
+	result := append([]string{}, dnsNames...)
+	for _, name := range dnsNames {
+		for _, alias := range a[name] {
+			if !present[alias] {
+				present[alias] = true
+				result = append(result, alias)
+			}
+		}
+	}
+	return result
+}
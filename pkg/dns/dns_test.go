@@ -52,4 +52,32 @@ var _ = Describe("Common Tests", func() {
 			Entry("default", "a", "b", "somedomain", []string{"a", "a.b", "a.b.svc", "a.b.svc.somedomain"}),
 		)
 	})
+
+	Context("Service DNS aliases", func() {
+		It("Should add the aliases of the services the names belong to", func() {
+			aliases, invalid := dns.ParseServiceDNSAliases(map[string]string{
+				"a.b": "a.example.com,\n*.a.example.org a.b",
+				"c.d": "c.example.com",
+			})
+			Expect(invalid).To(BeEmpty())
+			names := dns.GetServiceDNSNames("a", "b", dns.DefaultClusterDomain)
+			Expect(aliases.WithAliases(names)).To(Equal(append(names, "a.example.com", "*.a.example.org")))
+			Expect(names).To(HaveLen(4))
+		})
+
+		It("Should return the names unchanged without aliases", func() {
+			var aliases dns.ServiceDNSAliases
+			Expect(aliases.WithAliases([]string{"a"})).To(Equal([]string{"a"}))
+		})
+
+		DescribeTable("Should reject invalid aliases", func(data map[string]string, service string) {
+			aliases, invalid := dns.ParseServiceDNSAliases(data)
+			Expect(invalid).To(HaveKey(service))
+			Expect(aliases).NotTo(HaveKey(service))
+			Expect(aliases).To(HaveKeyWithValue("c.d", []string{"c.example.com"}))
+		},
+			Entry("service without a namespace", map[string]string{"a": "a.example.com", "c.d": "c.example.com"}, "a"),
+			Entry("invalid DNS name", map[string]string{"a.b": "a.example.com A_B", "c.d": "c.example.com"}, "a.b"),
+		)
+	})
 })
@@ -0,0 +1,334 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dryrun renders the objects that the operator would create for a set of custom resources, without
+// connecting to a cluster. It is intended to let users review the effect of an operator upgrade or a change
+// to their custom resources before applying it.
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	apiregv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/certificatemanager"
+	"github.com/tigera/operator/pkg/controller/installation"
+	"github.com/tigera/operator/pkg/controller/k8sapi"
+	"github.com/tigera/operator/pkg/dns"
+	"github.com/tigera/operator/pkg/render"
+	rcertificatemanagement "github.com/tigera/operator/pkg/render/certificatemanagement"
+	"github.com/tigera/operator/pkg/render/kubecontrollers"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
+)
+
+const (
+	// defaultFelixHealthPort mirrors the FelixConfiguration default used by the installation controller.
+	defaultFelixHealthPort = 9099
+
+	// redacted replaces certificate material, and anything derived from it, in the output. Certificates and
+	// keys are generated for each run, so their values are neither stable nor meaningful.
+	redacted = "<redacted>"
+)
+
+// volatileAnnotations are annotation key fragments whose values are derived from generated certificates.
+var volatileAnnotations = []string{"hash.operator.tigera.io/", "certificates.operator.tigera.io/"}
+
+// Input holds the custom resources that drive an offline render.
+type Input struct {
+	Installation *operatorv1.Installation
+	APIServer    *operatorv1.APIServer
+}
+
+// Decode reads a stream of YAML or JSON documents containing an Installation and, optionally, an APIServer.
+// Any other kind of object results in an error so that users are not misled into thinking it was rendered.
+func Decode(r io.Reader) (*Input, error) {
+	scheme := runtime.NewScheme()
+	if err := apis.AddToScheme(scheme, false); err != nil {
+		return nil, err
+	}
+	deserializer := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	in := &Input{}
+	decoder := yaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var raw runtime.RawExtension
+		if err := decoder.Decode(&raw); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("error decoding input document: %w", err)
+		}
+		if len(bytes.TrimSpace(raw.Raw)) == 0 {
+			continue
+		}
+
+		obj, gvk, err := deserializer.Decode(raw.Raw, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error deserializing object: %w", err)
+		}
+
+		switch o := obj.(type) {
+		case *operatorv1.Installation:
+			if in.Installation != nil {
+				return nil, fmt.Errorf("only one Installation may be specified")
+			}
+			in.Installation = o
+		case *operatorv1.APIServer:
+			if in.APIServer != nil {
+				return nil, fmt.Errorf("only one APIServer may be specified")
+			}
+			in.APIServer = o
+		default:
+			return nil, fmt.Errorf("unsupported kind %s: only Installation and APIServer can be rendered offline", gvk.Kind)
+		}
+	}
+
+	if in.Installation == nil {
+		return nil, fmt.Errorf("an Installation must be specified")
+	}
+	return in, nil
+}
+
+// Render returns the objects that the operator would create for the given input. Defaults are applied to a copy
+// of the Installation in the same way the installation controller does, and certificates are generated in memory.
+func Render(in *Input, clusterDomain string) ([]client.Object, error) {
+	scheme := runtime.NewScheme()
+	if err := apis.AddToScheme(scheme, false); err != nil {
+		return nil, err
+	}
+	cli := emptyClusterClient{}
+
+	instance := in.Installation.DeepCopy()
+	if err := installation.MergeAndFillDefaults(instance, nil, nil); err != nil {
+		return nil, fmt.Errorf("error filling Installation defaults: %w", err)
+	}
+	if instance.Spec.Variant.IsEnterprise() {
+		// Enterprise components depend on cluster state (licenses, log storage, managed clusters) that cannot be
+		// inferred from the input alone.
+		return nil, fmt.Errorf("offline rendering is only supported for the %s variant", operatorv1.Calico)
+	}
+
+	certificateManager, err := certificatemanager.Create(cli, &instance.Spec, clusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+	if err != nil {
+		return nil, fmt.Errorf("error creating the certificate manager: %w", err)
+	}
+	typhaNodeTLS, err := installation.GetOrCreateTyphaNodeTLSConfig(cli, certificateManager)
+	if err != nil {
+		return nil, fmt.Errorf("error creating typha/node TLS configuration: %w", err)
+	}
+
+	components := []render.Component{
+		render.Namespaces(&render.NamespaceConfiguration{Installation: &instance.Spec}),
+		rcertificatemanagement.CertificateManagement(&rcertificatemanagement.Config{
+			Namespace:       common.CalicoNamespace,
+			ServiceAccounts: []string{render.CalicoNodeObjectName, render.TyphaServiceAccountName, kubecontrollers.KubeControllerServiceAccount},
+			KeyPairOptions: []rcertificatemanagement.KeyPairOption{
+				rcertificatemanagement.NewKeyPairOption(typhaNodeTLS.NodeSecret, true, true),
+				rcertificatemanagement.NewKeyPairOption(typhaNodeTLS.TyphaSecret, true, true),
+			},
+			TrustedBundle: typhaNodeTLS.TrustedBundle,
+		}),
+		render.Typha(&render.TyphaConfiguration{
+			K8sServiceEp:    k8sapi.Endpoint,
+			Installation:    &instance.Spec,
+			TLS:             typhaNodeTLS,
+			ClusterDomain:   clusterDomain,
+			FelixHealthPort: defaultFelixHealthPort,
+		}),
+		render.Node(&render.NodeConfiguration{
+			K8sServiceEp:    k8sapi.Endpoint,
+			Installation:    &instance.Spec,
+			IPPools:         ipPools(&instance.Spec),
+			TLS:             typhaNodeTLS,
+			ClusterDomain:   clusterDomain,
+			FelixHealthPort: defaultFelixHealthPort,
+		}),
+		render.CSI(&render.CSIConfiguration{
+			Installation: &instance.Spec,
+			OpenShift:    instance.Spec.KubernetesProvider.IsOpenShift(),
+		}),
+		kubecontrollers.NewCalicoKubeControllers(&kubecontrollers.KubeControllersConfiguration{
+			K8sServiceEp:           k8sapi.Endpoint,
+			K8sServiceEpPodNetwork: k8sapi.PodNetworkEndpoint,
+			Installation:           &instance.Spec,
+			ClusterDomain:          clusterDomain,
+			TrustedBundle:          typhaNodeTLS.TrustedBundle,
+			Namespace:              common.CalicoNamespace,
+			BindingNamespaces:      []string{common.CalicoNamespace},
+		}),
+	}
+
+	if in.APIServer != nil {
+		tlsKeyPair, err := certificateManager.GetOrCreateKeyPair(cli, render.CalicoAPIServerTLSSecretName, common.OperatorNamespace(),
+			dns.GetServiceDNSNames(render.APIServerServiceName, render.APIServerNamespace, clusterDomain))
+		if err != nil {
+			return nil, fmt.Errorf("error creating API server TLS key pair: %w", err)
+		}
+		var etcdEndpoints []string
+		if etcd := in.APIServer.Spec.EtcdDatastore; etcd != nil {
+			etcdEndpoints = etcd.Endpoints
+		}
+		apiServer, err := render.APIServer(&render.APIServerConfiguration{
+			K8SServiceEndpoint:           k8sapi.Endpoint,
+			K8SServiceEndpointPodNetwork: k8sapi.PodNetworkEndpoint,
+			Installation:                 &instance.Spec,
+			APIServer:                    &in.APIServer.Spec,
+			OpenShift:                    instance.Spec.KubernetesProvider.IsOpenShift(),
+			TLSKeyPair:                   tlsKeyPair,
+			ClusterDomain:                clusterDomain,
+			RequiresAggregationServer:    true,
+			EtcdEndpoints:                etcdEndpoints,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error rendering APIServer: %w", err)
+		}
+		components = append(components,
+			apiServer,
+			rcertificatemanagement.CertificateManagement(&rcertificatemanagement.Config{
+				Namespace:       render.APIServerNamespace,
+				ServiceAccounts: []string{render.APIServerServiceAccountName},
+				KeyPairOptions:  []rcertificatemanagement.KeyPairOption{rcertificatemanagement.NewKeyPairOption(tlsKeyPair, true, true)},
+			}),
+		)
+	}
+
+	var objs []client.Object
+	for _, c := range components {
+		if err := c.ResolveImages(nil); err != nil {
+			return nil, fmt.Errorf("error resolving images: %w", err)
+		}
+		toCreate, _ := c.Objects()
+		objs = append(objs, toCreate...)
+	}
+
+	// Fill in any missing type information so that the output can be applied or diffed directly.
+	for _, obj := range objs {
+		if obj.GetObjectKind().GroupVersionKind().Kind != "" {
+			continue
+		}
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return nil, err
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+	return objs, nil
+}
+
+// emptyClusterClient stands in for the cluster during an offline render. The renderers only read from it, for
+// example to look up existing certificates, and find nothing, as they would in a new cluster.
+type emptyClusterClient struct {
+	client.Client
+}
+
+func (emptyClusterClient) Get(_ context.Context, key client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+	return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+}
+
+func (emptyClusterClient) List(context.Context, client.ObjectList, ...client.ListOption) error {
+	return nil
+}
+
+// Write prints the given objects as a stream of YAML documents, sorted by kind, namespace and name so that the
+// output is stable across runs. Certificate material is redacted.
+func Write(w io.Writer, objs []client.Object) error {
+	sort.SliceStable(objs, func(i, j int) bool {
+		return sortKey(objs[i]) < sortKey(objs[j])
+	})
+
+	for i, obj := range objs {
+		obj = redact(obj)
+		b, err := sigsyaml.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		if i > 0 {
+			if _, err := fmt.Fprintln(w, "---"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// redact returns a copy of the object with generated certificate material and any hashes of it replaced.
+func redact(obj client.Object) client.Object {
+	obj = obj.DeepCopyObject().(client.Object)
+	obj.SetAnnotations(redactAnnotations(obj.GetAnnotations()))
+
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		for k := range o.Data {
+			o.Data[k] = []byte(redacted)
+		}
+	case *corev1.ConfigMap:
+		if o.Name == certificatemanagement.TrustedCertConfigMapName {
+			for k := range o.Data {
+				o.Data[k] = redacted
+			}
+		}
+	case *apiregv1.APIService:
+		o.Spec.CABundle = []byte(redacted)
+	case *appsv1.Deployment:
+		o.Spec.Template.Annotations = redactAnnotations(o.Spec.Template.Annotations)
+	case *appsv1.DaemonSet:
+		o.Spec.Template.Annotations = redactAnnotations(o.Spec.Template.Annotations)
+	}
+	return obj
+}
+
+func redactAnnotations(annotations map[string]string) map[string]string {
+	for k := range annotations {
+		for _, v := range volatileAnnotations {
+			if strings.Contains(k, v) {
+				annotations[k] = redacted
+			}
+		}
+	}
+	return annotations
+}
+
+func sortKey(obj client.Object) string {
+	return strings.Join([]string{obj.GetObjectKind().GroupVersionKind().Kind, obj.GetNamespace(), obj.GetName()}, "/")
+}
+
+// ipPools returns the IP pools configured on the Installation. Offline, these are the only pools the renderer
+// can know about.
+func ipPools(spec *operatorv1.InstallationSpec) []operatorv1.IPPool {
+	if spec.CalicoNetwork == nil {
+		return nil
+	}
+	return spec.CalicoNetwork.IPPools
+}
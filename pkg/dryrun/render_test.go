@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dryrun_test
+
+import (
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tigera/operator/pkg/dns"
+	"github.com/tigera/operator/pkg/dryrun"
+	rtest "github.com/tigera/operator/pkg/render/common/test"
+)
+
+const installationYAML = `
+apiVersion: operator.tigera.io/v1
+kind: Installation
+metadata:
+  name: default
+spec:
+  calicoNetwork:
+    ipPools:
+    - cidr: 192.168.0.0/16
+`
+
+const apiServerYAML = `
+apiVersion: operator.tigera.io/v1
+kind: APIServer
+metadata:
+  name: default
+`
+
+var _ = Describe("offline rendering", func() {
+	render := func(input string) string {
+		in, err := dryrun.Decode(strings.NewReader(input))
+		Expect(err).NotTo(HaveOccurred())
+		objs, err := dryrun.Render(in, dns.DefaultClusterDomain)
+		Expect(err).NotTo(HaveOccurred())
+		var out bytes.Buffer
+		Expect(dryrun.Write(&out, objs)).To(Succeed())
+		return out.String()
+	}
+
+	It("should render the core components for an Installation", func() {
+		in, err := dryrun.Decode(strings.NewReader(installationYAML))
+		Expect(err).NotTo(HaveOccurred())
+		objs, err := dryrun.Render(in, dns.DefaultClusterDomain)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(rtest.GetResource(objs, "calico-system", "", "", "v1", "Namespace")).NotTo(BeNil())
+		Expect(rtest.GetResource(objs, "calico-node", "calico-system", "apps", "v1", "DaemonSet")).NotTo(BeNil())
+		Expect(rtest.GetResource(objs, "calico-typha", "calico-system", "apps", "v1", "Deployment")).NotTo(BeNil())
+		Expect(rtest.GetResource(objs, "calico-kube-controllers", "calico-system", "apps", "v1", "Deployment")).NotTo(BeNil())
+		Expect(rtest.GetResource(objs, "calico-apiserver", "calico-system", "apps", "v1", "Deployment")).To(BeNil())
+	})
+
+	It("should render the API server when an APIServer is provided", func() {
+		in, err := dryrun.Decode(strings.NewReader(installationYAML + "---" + apiServerYAML))
+		Expect(err).NotTo(HaveOccurred())
+		objs, err := dryrun.Render(in, dns.DefaultClusterDomain)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(rtest.GetResource(objs, "calico-apiserver", "calico-system", "apps", "v1", "Deployment")).NotTo(BeNil())
+	})
+
+	It("should produce the same output on every run", func() {
+		input := installationYAML + "---" + apiServerYAML
+		out := render(input)
+		Expect(out).To(ContainSubstring("kind: DaemonSet"))
+		Expect(out).NotTo(ContainSubstring("BEGIN CERTIFICATE"))
+		Expect(render(input)).To(Equal(out))
+	})
+
+	It("should require an Installation", func() {
+		_, err := dryrun.Decode(strings.NewReader(apiServerYAML))
+		Expect(err).To(MatchError(ContainSubstring("an Installation must be specified")))
+	})
+
+	It("should reject kinds that cannot be rendered offline", func() {
+		_, err := dryrun.Decode(strings.NewReader(installationYAML + `---
+apiVersion: operator.tigera.io/v1
+kind: LogCollector
+metadata:
+  name: tigera-secure
+`))
+		Expect(err).To(MatchError(ContainSubstring("unsupported kind LogCollector")))
+	})
+
+	It("should reject Calico Enterprise installations", func() {
+		in, err := dryrun.Decode(strings.NewReader(installationYAML + "  variant: TigeraSecureEnterprise\n"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = dryrun.Render(in, dns.DefaultClusterDomain)
+		Expect(err).To(HaveOccurred())
+	})
+})
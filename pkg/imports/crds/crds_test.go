@@ -76,4 +76,17 @@ var _ = Describe("test crds pkg", func() {
 	It("installs GatewayAPI CRD with Calico OSS", func() {
 		Expect(getOperatorCRDSource(opv1.Calico)).To(HaveKey(ContainSubstring("gatewayapis")))
 	})
+
+	It("shows the status conditions of operator CRDs as printer columns", func() {
+		crds := convertYamlsToCRDs(getOperatorCRDSource(opv1.CalicoEnterprise))
+		columns := map[string][]string{}
+		for _, crd := range crds {
+			for _, c := range crd.Spec.Versions[0].AdditionalPrinterColumns {
+				columns[crd.Name] = append(columns[crd.Name], c.Name)
+			}
+		}
+		for _, name := range []string{"apiservers.operator.tigera.io", "installations.operator.tigera.io", "logcollectors.operator.tigera.io"} {
+			Expect(columns[name]).To(Equal([]string{"Available", "Progressing", "Degraded", "Observed Generation", "Age"}), name)
+		}
+	})
 })
@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package namespacescope tracks the namespaces the operator is restricted to when it runs without cluster-wide
+// RBAC. The value is set once at startup from the WATCH_NAMESPACES env var and read by the manager cache and the
+// component handler, which renders namespaced Roles in place of ClusterRoles where possible.
+package namespacescope
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+
+	"github.com/tigera/operator/pkg/common"
+)
+
+const envVarName = "WATCH_NAMESPACES"
+
+var (
+	mu         sync.RWMutex
+	namespaces []string
+)
+
+// Set restricts the operator to the given namespaces. The operator namespace is always included. Passing no
+// namespaces lifts the restriction.
+func Set(ns []string) {
+	mu.Lock()
+	defer mu.Unlock()
+	namespaces = nil
+	for _, n := range ns {
+		if n = strings.TrimSpace(n); n != "" {
+			namespaces = append(namespaces, n)
+		}
+	}
+	if len(namespaces) == 0 {
+		return
+	}
+	namespaces = append(namespaces, common.OperatorNamespace())
+	slices.Sort(namespaces)
+	namespaces = slices.Compact(namespaces)
+}
+
+// SetFromEnv restricts the operator to the comma separated namespaces in the WATCH_NAMESPACES env var, if set.
+func SetFromEnv() {
+	Set(strings.Split(os.Getenv(envVarName), ","))
+}
+
+// Restricted returns whether the operator is restricted to a set of namespaces.
+func Restricted() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return len(namespaces) > 0
+}
+
+// Namespaces returns the sorted namespaces the operator is restricted to, or nil if it is not restricted.
+func Namespaces() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return slices.Clone(namespaces)
+}
+
+// Manages returns whether the operator may manage objects in the given namespace. Every namespace is managed
+// when the operator is not restricted.
+func Manages(ns string) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return len(namespaces) == 0 || slices.Contains(namespaces, ns)
+}
+
+// CacheNamespaces returns the namespaces the manager cache should be limited to, or nil if the cache should
+// watch all namespaces.
+func CacheNamespaces() map[string]cache.Config {
+	mu.RLock()
+	defer mu.RUnlock()
+	if len(namespaces) == 0 {
+		return nil
+	}
+	cfg := map[string]cache.Config{}
+	for _, ns := range namespaces {
+		cfg[ns] = cache.Config{}
+	}
+	return cfg
+}
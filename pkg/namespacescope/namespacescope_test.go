@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespacescope
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/tigera/operator/pkg/common"
+)
+
+var _ = Describe("namespacescope", func() {
+	AfterEach(func() {
+		Set(nil)
+	})
+
+	It("should not be restricted by default", func() {
+		Expect(Restricted()).To(BeFalse())
+		Expect(Namespaces()).To(BeNil())
+		Expect(Manages("any-namespace")).To(BeTrue())
+		Expect(CacheNamespaces()).To(BeNil())
+	})
+
+	It("should always include the operator namespace when restricted", func() {
+		Set([]string{" team-b", "team-a", "", "team-b"})
+		Expect(Restricted()).To(BeTrue())
+		Expect(Namespaces()).To(ConsistOf("team-a", "team-b", common.OperatorNamespace()))
+		Expect(Manages("team-a")).To(BeTrue())
+		Expect(Manages(common.OperatorNamespace())).To(BeTrue())
+		Expect(Manages("kube-system")).To(BeFalse())
+		Expect(CacheNamespaces()).To(HaveLen(3))
+	})
+
+	It("should read the namespaces from the env", func() {
+		GinkgoT().Setenv("WATCH_NAMESPACES", "team-a,team-b")
+		SetFromEnv()
+		Expect(Namespaces()).To(ConsistOf("team-a", "team-b", common.OperatorNamespace()))
+
+		GinkgoT().Setenv("WATCH_NAMESPACES", "")
+		SetFromEnv()
+		Expect(Restricted()).To(BeFalse())
+	})
+})
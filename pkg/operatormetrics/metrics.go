@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package operatormetrics defines the Prometheus metrics that record the outcome of the operator's reconciles: how
+// long each reconcile of each controller takes, how often each component becomes degraded and how many objects each
+// component creates, updates and deletes. They are served with the other operator metrics when METRICS_ENABLED is set,
+// so that SREs can alert on controllers that flap.
+package operatormetrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ResultSuccess is the result of a reconcile that returned neither an error nor a requeue.
+	ResultSuccess = "success"
+	// ResultRequeue is the result of a reconcile that asked to be requeued.
+	ResultRequeue = "requeue"
+	// ResultError is the result of a reconcile that returned an error.
+	ResultError = "error"
+
+	OperationCreate = "create"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+var (
+	// ReconcileDuration records the duration of each reconcile, by controller and result.
+	ReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "tigera_operator_reconcile_duration_seconds",
+		Help:    "Duration of the reconciles of each operator controller, by result.",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"controller", "result"})
+
+	// DegradedTransitions counts the transitions of the Degraded condition of each TigeraStatus to true.
+	DegradedTransitions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "tigera_operator_degraded_transitions_total",
+		Help: "Number of times the TigeraStatus of each component has become degraded.",
+	}, []string{"component"})
+
+	// ObjectOperations counts the objects that each controller creates, updates and deletes for each rendered
+	// component.
+	ObjectOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "tigera_operator_object_operations_total",
+		Help: "Number of objects created, updated and deleted by each operator controller, by rendered component.",
+	}, []string{"controller", "component", "operation"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(ReconcileDuration, DegradedTransitions, ObjectOperations)
+}
+
+// Result returns the result label of a reconcile that returned the given result and error.
+func Result(result reconcile.Result, err error) string {
+	switch {
+	case err != nil:
+		return ResultError
+	case !result.IsZero():
+		return ResultRequeue
+	}
+	return ResultSuccess
+}
+
+// ObserveReconcile records the duration of a reconcile of the given controller that started at start.
+func ObserveReconcile(controller string, start time.Time, result reconcile.Result, err error) {
+	ReconcileDuration.WithLabelValues(controller, Result(result, err)).Observe(time.Since(start).Seconds())
+}
+
+type (
+	controllerKey struct{}
+	componentKey  struct{}
+)
+
+// WithController returns a context that records the name of the controller that is reconciling, so that the objects
+// it creates, updates and deletes can be attributed to it.
+func WithController(ctx context.Context, controller string) context.Context {
+	return context.WithValue(ctx, controllerKey{}, controller)
+}
+
+// WithComponent returns a context that records the name of the rendered component whose objects are being created,
+// updated and deleted.
+func WithComponent(ctx context.Context, component string) context.Context {
+	return context.WithValue(ctx, componentKey{}, component)
+}
+
+// RecordObjectOperation counts an object that was created, updated or deleted by the controller and for the
+// component recorded in the context.
+func RecordObjectOperation(ctx context.Context, operation string) {
+	ObjectOperations.WithLabelValues(valueOrUnknown(ctx, controllerKey{}), valueOrUnknown(ctx, componentKey{}), operation).Inc()
+}
+
+func valueOrUnknown(ctx context.Context, key interface{}) string {
+	if v, ok := ctx.Value(key).(string); ok {
+		return v
+	}
+	return "unknown"
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatormetrics_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/tigera/operator/pkg/operatormetrics"
+)
+
+func TestOperatorMetrics(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Operator Metrics Suite")
+}
+
+var _ = Describe("operator metrics", func() {
+	It("should label each reconcile with its result", func() {
+		Expect(operatormetrics.Result(reconcile.Result{}, nil)).To(Equal(operatormetrics.ResultSuccess))
+		Expect(operatormetrics.Result(reconcile.Result{RequeueAfter: time.Minute}, nil)).To(Equal(operatormetrics.ResultRequeue))
+		Expect(operatormetrics.Result(reconcile.Result{RequeueAfter: time.Minute}, errors.New("boom"))).To(Equal(operatormetrics.ResultError))
+
+		operatormetrics.ObserveReconcile("observed-controller", time.Now(), reconcile.Result{}, errors.New("boom"))
+		Expect(testutil.CollectAndCount(operatormetrics.ReconcileDuration, "tigera_operator_reconcile_duration_seconds")).To(BeNumerically(">=", 1))
+	})
+
+	It("should attribute object operations to the controller and component in the context", func() {
+		count := func(controller, component string) float64 {
+			return testutil.ToFloat64(operatormetrics.ObjectOperations.WithLabelValues(controller, component, operatormetrics.OperationCreate))
+		}
+
+		operatormetrics.RecordObjectOperation(context.Background(), operatormetrics.OperationCreate)
+		Expect(count("unknown", "unknown")).To(Equal(1.0))
+
+		ctx := operatormetrics.WithComponent(operatormetrics.WithController(context.Background(), "a-controller"), "render.aComponent")
+		operatormetrics.RecordObjectOperation(ctx, operatormetrics.OperationCreate)
+		Expect(count("a-controller", "render.aComponent")).To(Equal(1.0))
+	})
+})
@@ -22,6 +22,7 @@ import (
 	admregv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 	netv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -30,16 +31,22 @@ import (
 	apiregv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gapi "sigs.k8s.io/gateway-api/apis/v1"
+	gapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 	"github.com/tigera/api/pkg/lib/numorstring"
 
+	routev1 "github.com/openshift/api/route/v1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/components"
 	"github.com/tigera/operator/pkg/controller/k8sapi"
 	"github.com/tigera/operator/pkg/render/common/authentication"
 	rcomp "github.com/tigera/operator/pkg/render/common/components"
+	"github.com/tigera/operator/pkg/render/common/configmap"
 	relasticsearch "github.com/tigera/operator/pkg/render/common/elasticsearch"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 	"github.com/tigera/operator/pkg/render/common/networkpolicy"
@@ -59,6 +66,8 @@ const (
 
 	auditLogsVolumeName   = "calico-audit-logs"
 	auditPolicyVolumeName = "calico-audit-policy"
+
+	apiServerMigrationLabel = "apiserver-migration"
 )
 
 const (
@@ -69,8 +78,22 @@ const (
 	QueryserverNamespace   = "calico-system"
 	QueryserverServiceName = "calico-api"
 
+	// The default roles that users are bound to. Multi-tenant clusters render them as Roles in each tenant namespace
+	// rather than as ClusterRoles.
+	TigeraUIUserRoleName       = "tigera-ui-user"
+	TigeraNetworkAdminRoleName = "tigera-network-admin"
+
+	// The default ClusterRoles aggregate the rules of every ClusterRole with the matching label set to "true", so
+	// that they can be extended without editing the roles the operator renders. The rules the operator grants by
+	// default are rendered in the -default ClusterRoles, which carry the same label.
+	TigeraUIUserAggregationLabel       = "rbac.tigera.io/aggregate-to-tigera-ui-user"
+	TigeraNetworkAdminAggregationLabel = "rbac.tigera.io/aggregate-to-tigera-network-admin"
+	TigeraUIUserDefaultRoleName        = TigeraUIUserRoleName + "-default"
+	TigeraNetworkAdminDefaultRoleName  = TigeraNetworkAdminRoleName + "-default"
+
 	// Use the same API server container name for both OSS and Enterprise.
 	APIServerName                                         = "calico-apiserver"
+	APIServerMigrationName                                = "calico-apiserver-migration"
 	APIServerContainerName                  ContainerName = "calico-apiserver"
 	TigeraAPIServerQueryServerContainerName ContainerName = "tigera-queryserver"
 
@@ -84,6 +107,38 @@ const (
 	L7AdmissionControllerContainerName              ContainerName = "calico-l7-admission-controller"
 	L7AdmissionControllerPort                                     = 6443
 	L7AdmissionControllerPortName                                 = "l7admctrl"
+
+	// APIServerMetricsServiceName is the name of both the metrics Service and the ServiceMonitor
+	// rendered when API server Prometheus metrics are enabled.
+	APIServerMetricsServiceName = "calico-api-metrics"
+
+	// APIServerEtcdTLSSecretName is the name of the secret, in the operator namespace, that holds the
+	// certificates used by the API server to connect to an external etcd datastore.
+	APIServerEtcdTLSSecretName = "calico-apiserver-etcd-tls"
+	APIServerEtcdCACertKey     = "ca.crt"
+
+	apiServerEtcdTLSVolumeName     = "calico-apiserver-etcd-tls"
+	apiServerEtcdTLSMountPath      = "/etc/calico/etcd-tls"
+	apiServerEtcdTLSHashAnnotation = "hash.operator.tigera.io/etcd-tls"
+
+	// APIServerAuditWebhookSecretName is the name of the secret in the API server namespace that holds the kubeconfig
+	// of the audit webhook collector. It is copied from the secret referenced by the APIServer, which has the same
+	// name by default.
+	APIServerAuditWebhookSecretName = "calico-apiserver-audit-webhook"
+	APIServerAuditWebhookConfigKey  = "config"
+
+	auditWebhookVolumeName     = "calico-audit-webhook"
+	auditWebhookMountPath      = "/etc/tigera/audit-webhook"
+	auditWebhookHashAnnotation = "hash.operator.tigera.io/audit-webhook"
+
+	// defaultTerminationGracePeriodSeconds is the Kubernetes default grace period, which the API server pods are
+	// given on top of their preStop sleep and shutdown delay.
+	defaultTerminationGracePeriodSeconds = 30
+
+	// APIServerFlowControlLabel is set on the FlowSchemas and PriorityLevelConfigurations rendered from the
+	// APIServer flow control configuration, so that those no longer configured can be found and removed.
+	APIServerFlowControlLabel      = "operator.tigera.io/apiserver-flow-control"
+	APIServerFlowControlLabelValue = "managed"
 )
 
 var (
@@ -109,9 +164,13 @@ var (
 )
 
 func APIServer(cfg *APIServerConfiguration) (Component, error) {
-	return &apiServerComponent{
+	c := &apiServerComponent{
 		cfg: cfg,
-	}, nil
+	}
+	if err := c.validateExtraVolumes(); err != nil {
+		return nil, err
+	}
+	return c, nil
 }
 
 func APIServerPolicy(cfg *APIServerConfiguration) Component {
@@ -150,6 +209,54 @@ type APIServerConfiguration struct {
 	// When certificate management is enabled, we need a separate init container to create a cert, running
 	// with the same permissions as query server.
 	QueryServerTLSKeyPairCertificateManagementOnly certificatemanagement.KeyPairInterface
+
+	// Whether the Prometheus operator ServiceMonitor CRD is installed in the cluster. The metrics Service
+	// and ServiceMonitor are only rendered if this is true.
+	ServiceMonitorCRDExists bool
+
+	// Whether the Gateway API Gateway and TLSRoute CRDs are installed in the cluster. The query server Gateway
+	// and TLSRoute are only rendered if this is true.
+	GatewayAPICRDExists bool
+
+	// QueryServerRouteHost is the host of the query server OpenShift Route. It is the configured hostname, or the
+	// one OpenShift generated when the Route was created.
+	QueryServerRouteHost string
+
+	// DeleteQueryServerRoute is set when the query server OpenShift Route is not configured, but may have been
+	// rendered from a previous configuration.
+	DeleteQueryServerRoute bool
+
+	// EtcdEndpoints is the list of etcd client URLs. When non-empty, the API server and query server
+	// use an etcdv3 datastore instead of the Kubernetes API.
+	EtcdEndpoints []string
+
+	// EtcdTLSSecret holds the CA and client key pair used to connect to etcd. It is optional, in which case
+	// the connection to etcd is not secured with TLS.
+	EtcdTLSSecret *corev1.Secret
+
+	// AuditWebhookSecret holds the kubeconfig of the audit webhook collector. It is required when the audit logs
+	// are sent to a webhook.
+	AuditWebhookSecret *corev1.Secret
+
+	// HostNetworkMigration is set while the API server moves between the host and pod networks. Since the
+	// host-networked deployment uses the Recreate strategy, a temporary deployment in the new network mode serves
+	// behind the Service until the calico-apiserver deployment has been recreated in that mode.
+	HostNetworkMigration *APIServerHostNetworkMigration
+}
+
+// APIServerHostNetworkMigration describes the progress of a move of the API server between the host and pod networks.
+type APIServerHostNetworkMigration struct {
+	// PreviousHostNetwork is the network mode that the calico-apiserver deployment keeps until the temporary
+	// deployment is available. It is nil once the calico-apiserver deployment can be moved to the new mode.
+	PreviousHostNetwork *bool
+}
+
+// HostNetwork returns true if the API server pods run on the host network.
+func (cfg *APIServerConfiguration) HostNetwork() bool {
+	if cfg.ForceHostNetwork {
+		return true
+	}
+	return HostNetworkRequired(cfg.Installation)
 }
 
 type apiServerComponent struct {
@@ -159,6 +266,9 @@ type apiServerComponent struct {
 	l7AdmissionControllerImage      string
 	l7AdmissionControllerEnvoyImage string
 	dikastesImage                   string
+
+	// hostNetworkOverride renders the API server in the given network mode rather than the configured one.
+	hostNetworkOverride *bool
 }
 
 func (c *apiServerComponent) ResolveImages(is *operatorv1.ImageSet) error {
@@ -170,27 +280,27 @@ func (c *apiServerComponent) ResolveImages(is *operatorv1.ImageSet) error {
 
 	enterprise := c.cfg.Installation.Variant.IsEnterprise()
 	if enterprise || c.cfg.RequiresAggregationServer {
-		c.apiServerImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+		c.apiServerImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 		if err != nil {
 			errMsgs = append(errMsgs, err.Error())
 		}
 	}
 
 	if enterprise {
-		c.queryServerImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+		c.queryServerImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 		if err != nil {
 			errMsgs = append(errMsgs, err.Error())
 		}
 		if c.cfg.IsSidecarInjectionEnabled() {
-			c.l7AdmissionControllerImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+			c.l7AdmissionControllerImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 			if err != nil {
 				errMsgs = append(errMsgs, err.Error())
 			}
-			c.l7AdmissionControllerEnvoyImage, err = components.GetReference(components.ComponentEnvoyProxy, reg, path, prefix, is)
+			c.l7AdmissionControllerEnvoyImage, err = components.GetReference(components.ComponentEnvoyProxy, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 			if err != nil {
 				errMsgs = append(errMsgs, err.Error())
 			}
-			c.dikastesImage, err = components.GetReference(components.ComponentDikastes, reg, path, prefix, is)
+			c.dikastesImage, err = components.GetReference(components.ComponentDikastes, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 			if err != nil {
 				errMsgs = append(errMsgs, err.Error())
 			}
@@ -215,13 +325,21 @@ func (c *apiServerComponent) Objects() ([]client.Object, []client.Object) {
 		c.calicoCustomResourcesClusterRoleBinding(),
 		c.tierGetterClusterRole(),
 		c.kubeControllerMgrTierGetterClusterRoleBinding(),
-		c.delegateAuthClusterRoleBinding(),
 		c.webhookReaderClusterRole(),
 		c.webhookReaderClusterRoleBinding(),
 	}
 
 	objsToDelete := []client.Object{}
 
+	// The delegated auth RBAC is left alone when it is provisioned separately.
+	delegateAuthManaged := c.cfg.APIServer.IsDelegatedAuthManaged()
+	if delegateAuthManaged {
+		globalObjects = append(globalObjects, c.delegateAuthClusterRoleBinding())
+	}
+
+	// Add in the user supplied flow control configuration.
+	globalObjects = append(globalObjects, c.flowControlObjects()...)
+
 	// Namespaced objects common to both Calico and Calico Enterprise.
 	// These objects will be updated when switching between the variants.
 	namespacedObjects := []client.Object{}
@@ -230,19 +348,48 @@ func (c *apiServerComponent) Objects() ([]client.Object, []client.Object) {
 	secrets := secret.CopyToNamespace(APIServerNamespace, c.cfg.PullSecrets...)
 	namespacedObjects = append(namespacedObjects, secret.ToRuntimeObjects(secrets...)...)
 
-	// The deployment and its supporting objects are needed when running the aggregation API server
-	// or when running Enterprise (which always needs the queryserver).
-	if c.cfg.RequiresAggregationServer || c.cfg.Installation.Variant.IsEnterprise() {
+	// Add in the etcd client certificates, or remove them if no longer in use.
+	if c.etcdTLSEnabled() {
+		namespacedObjects = append(namespacedObjects, secret.ToRuntimeObjects(secret.CopyToNamespace(APIServerNamespace, c.cfg.EtcdTLSSecret)...)...)
+	} else {
+		objsToDelete = append(objsToDelete, &corev1.Secret{TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}, ObjectMeta: metav1.ObjectMeta{Name: APIServerEtcdTLSSecretName, Namespace: APIServerNamespace}})
+	}
+
+	// Add in the kubeconfig of the audit webhook collector, or remove it if no longer in use.
+	if c.auditWebhookEnabled() {
+		namespacedObjects = append(namespacedObjects, c.auditWebhookSecret())
+	} else {
+		objsToDelete = append(objsToDelete, &corev1.Secret{TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}, ObjectMeta: metav1.ObjectMeta{Name: APIServerAuditWebhookSecretName, Namespace: APIServerNamespace}})
+	}
+
+	// The deployment and its supporting objects are needed when running the aggregation API server,
+	// the queryserver or the L7 admission controller.
+	if c.cfg.deploymentRequired() {
+		deployment := c.apiServerDeployment()
+		if m := c.cfg.HostNetworkMigration; m != nil && m.PreviousHostNetwork != nil {
+			// Keep the current deployment in its network mode until the migration deployment is available.
+			deployment = c.withHostNetwork(*m.PreviousHostNetwork).apiServerDeployment()
+		}
 		namespacedObjects = append(namespacedObjects,
 			c.apiServerServiceAccount(),
-			c.apiServerDeployment(),
+			deployment,
 			c.apiServerService(),
-			c.apiServerPodDisruptionBudget(),
 		)
+		if c.cfg.Installation.PodDisruptionBudgetsEnabled() {
+			namespacedObjects = append(namespacedObjects, c.apiServerPodDisruptionBudget())
+		} else {
+			objsToDelete = append(objsToDelete, &policyv1.PodDisruptionBudget{TypeMeta: metav1.TypeMeta{Kind: "PodDisruptionBudget", APIVersion: "policy/v1"}, ObjectMeta: metav1.ObjectMeta{Name: APIServerName, Namespace: APIServerNamespace}})
+		}
+		if c.cfg.HostNetworkMigration != nil {
+			namespacedObjects = append(namespacedObjects, c.apiServerMigrationDeployment())
+		} else {
+			objsToDelete = append(objsToDelete, &appsv1.Deployment{TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}, ObjectMeta: metav1.ObjectMeta{Name: APIServerMigrationName, Namespace: APIServerNamespace}})
+		}
 	} else {
 		objsToDelete = append(objsToDelete,
 			&corev1.ServiceAccount{TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"}, ObjectMeta: metav1.ObjectMeta{Name: APIServerServiceAccountName, Namespace: APIServerNamespace}},
 			&appsv1.Deployment{TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}, ObjectMeta: metav1.ObjectMeta{Name: APIServerName, Namespace: APIServerNamespace}},
+			&appsv1.Deployment{TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}, ObjectMeta: metav1.ObjectMeta{Name: APIServerMigrationName, Namespace: APIServerNamespace}},
 			&corev1.Service{TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}, ObjectMeta: metav1.ObjectMeta{Name: APIServerServiceName, Namespace: APIServerNamespace}},
 			&policyv1.PodDisruptionBudget{TypeMeta: metav1.TypeMeta{Kind: "PodDisruptionBudget", APIVersion: "policy/v1"}, ObjectMeta: metav1.ObjectMeta{Name: APIServerName, Namespace: APIServerNamespace}},
 		)
@@ -255,7 +402,14 @@ func (c *apiServerComponent) Objects() ([]client.Object, []client.Object) {
 		c.calicoPolicyPassthruClusterRolebinding(),
 		c.authClusterRole(),
 		c.authClusterRoleBinding(),
-		c.authReaderRoleBinding(),
+	}
+	if delegateAuthManaged {
+		// The API server reads the extension-apiserver-authentication ConfigMap from kube-system, so the RoleBinding
+		// there is kept alongside the one in the configured namespace.
+		aggregationAPIServerObjects = append(aggregationAPIServerObjects, c.authReaderRoleBinding("kube-system"))
+		if ns := c.cfg.APIServer.GetDelegatedAuthNamespace(); ns != "kube-system" {
+			aggregationAPIServerObjects = append(aggregationAPIServerObjects, c.authReaderRoleBinding(ns))
+		}
 	}
 
 	if c.cfg.Installation.Variant.IsEnterprise() {
@@ -285,7 +439,9 @@ func (c *apiServerComponent) Objects() ([]client.Object, []client.Object) {
 		// These resources are only installed in zero-tenant clusters. Multi-tenant clusters don't use the default
 		// RBAC resources.
 		globalEnterpriseObjects = append(globalEnterpriseObjects,
+			aggregatedClusterRole(TigeraUIUserRoleName, TigeraUIUserAggregationLabel),
 			c.tigeraUserClusterRole(),
+			aggregatedClusterRole(TigeraNetworkAdminRoleName, TigeraNetworkAdminAggregationLabel),
 			c.tigeraNetworkAdminClusterRole(),
 		)
 	}
@@ -317,6 +473,10 @@ func (c *apiServerComponent) Objects() ([]client.Object, []client.Object) {
 	if c.cfg.TrustedBundle != nil {
 		namespacedEnterpriseObjects = append(namespacedEnterpriseObjects, c.cfg.TrustedBundle.ConfigMap(QueryserverNamespace))
 	}
+	if c.queryServerOIDCEnabled() {
+		namespacedEnterpriseObjects = append(namespacedEnterpriseObjects, secret.ToRuntimeObjects(c.cfg.KeyValidatorConfig.RequiredSecrets(QueryserverNamespace)...)...)
+		namespacedEnterpriseObjects = append(namespacedEnterpriseObjects, configmap.ToRuntimeObjects(c.cfg.KeyValidatorConfig.RequiredConfigMaps(QueryserverNamespace)...)...)
+	}
 	if c.cfg.IsSidecarInjectionEnabled() {
 		namespacedEnterpriseObjects = append(namespacedEnterpriseObjects, c.sidecarMutatingWebhookConfig())
 	} else {
@@ -359,6 +519,48 @@ func (c *apiServerComponent) Objects() ([]client.Object, []client.Object) {
 		objsToDelete = append(objsToDelete, aggregationAPIServerObjects...)
 	}
 
+	// Expose the API server and query server metrics to Prometheus if requested. We can only create or delete
+	// the ServiceMonitor if its CRD exists.
+	if c.cfg.prometheusMetricsEnabled() {
+		namespacedObjects = append(namespacedObjects, c.metricsService(), c.serviceMonitor())
+		namespacedObjects = append(namespacedObjects, c.metricsReaderObjects()...)
+	} else {
+		objsToDelete = append(objsToDelete, c.metricsService())
+		objsToDelete = append(objsToDelete, c.metricsReaderObjects()...)
+		if c.cfg.ServiceMonitorCRDExists {
+			objsToDelete = append(objsToDelete, c.serviceMonitor())
+		}
+	}
+
+	// Expose the query server through the Gateway API if requested. As with the ServiceMonitor, we can only
+	// create or delete the Gateway and TLSRoute if their CRDs exist.
+	if c.cfg.GatewayAPICRDExists {
+		if c.cfg.queryServerGatewayEnabled() {
+			namespacedObjects = append(namespacedObjects, c.queryServerGateway(), c.queryServerTLSRoute())
+		} else {
+			objsToDelete = append(objsToDelete,
+				&gapi.Gateway{
+					TypeMeta:   metav1.TypeMeta{Kind: "Gateway", APIVersion: gapi.GroupVersion.String()},
+					ObjectMeta: metav1.ObjectMeta{Name: QueryserverServiceName, Namespace: APIServerNamespace},
+				},
+				&gapiv1alpha2.TLSRoute{
+					TypeMeta:   metav1.TypeMeta{Kind: "TLSRoute", APIVersion: gapiv1alpha2.GroupVersion.String()},
+					ObjectMeta: metav1.ObjectMeta{Name: QueryserverServiceName, Namespace: APIServerNamespace},
+				},
+			)
+		}
+	}
+
+	// Expose the query server through an OpenShift Route if requested. The Route API only exists on OpenShift.
+	if c.cfg.queryServerRouteEnabled() {
+		namespacedObjects = append(namespacedObjects, c.queryServerRoute())
+	} else if c.cfg.OpenShift && c.cfg.DeleteQueryServerRoute {
+		objsToDelete = append(objsToDelete, &routev1.Route{
+			TypeMeta:   metav1.TypeMeta{Kind: "Route", APIVersion: routev1.GroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: QueryserverServiceName, Namespace: APIServerNamespace},
+		})
+	}
+
 	// Explicitly delete any renamed/deprecated objects.
 	objsToDelete = append(objsToDelete, c.getDeprecatedResources()...)
 	objsToCreate := append(globalObjects, namespacedObjects...)
@@ -370,6 +572,10 @@ func (c *apiServerComponent) Ready() bool {
 	return true
 }
 
+func (c *apiServerComponent) GarbageCollectionIdentity() string {
+	return "apiserver"
+}
+
 // For legacy reasons we use apiserver: true here instead of the k8s-app: name label,
 // so we need to set it explicitly rather than use the common labeling logic.
 func (c *apiServerComponent) deploymentSelector() *metav1.LabelSelector {
@@ -382,7 +588,7 @@ func (c *apiServerComponent) deploymentSelector() *metav1.LabelSelector {
 
 func (c *apiServerComponent) apiServerPodDisruptionBudget() *policyv1.PodDisruptionBudget {
 	maxUnavailable := intstr.FromInt(1)
-	return &policyv1.PodDisruptionBudget{
+	pdb := &policyv1.PodDisruptionBudget{
 		TypeMeta: metav1.TypeMeta{Kind: "PodDisruptionBudget", APIVersion: "policy/v1"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      APIServerName,
@@ -393,6 +599,10 @@ func (c *apiServerComponent) apiServerPodDisruptionBudget() *policyv1.PodDisrupt
 			Selector:       c.deploymentSelector(),
 		},
 	}
+	if d := c.cfg.APIServer.APIServerDeployment; d != nil && d.Spec != nil {
+		rcomp.ApplyPodDisruptionBudgetOverrides(pdb, d.Spec.PodDisruptionBudget)
+	}
+	return pdb
 }
 
 // apiServiceRegistration creates an API service that registers Tigera Secure APIs (and API server).
@@ -447,17 +657,17 @@ func (c *apiServerComponent) delegateAuthClusterRoleBinding() client.Object {
 	}
 }
 
-// authReaderRoleBinding creates a rolebinding that allows the API server to access the
+// authReaderRoleBinding creates a rolebinding in the given namespace that allows the API server to access the
 // extension-apiserver-authentication configmap. That configmap contains the client CA file that
 // the main API server was configured with.
 //
 // Both Calico and Calico Enterprise, but different names.
-func (c *apiServerComponent) authReaderRoleBinding() client.Object {
+func (c *apiServerComponent) authReaderRoleBinding(namespace string) client.Object {
 	return &rbacv1.RoleBinding{
 		TypeMeta: metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "calico-apiserver-auth-reader",
-			Namespace: "kube-system",
+			Namespace: namespace,
 		},
 		RoleRef: rbacv1.RoleRef{
 			Kind:     "Role",
@@ -506,13 +716,16 @@ func calicoSystemAPIServerPolicy(cfg *APIServerConfiguration) *v3.NetworkPolicy
 			Protocol:    &networkpolicy.TCPProtocol,
 			Destination: DexEntityRule,
 		},
-		{
-			// Allow queryserver to reach Linseed for policy activity enrichment.
+	}...)
+
+	if cfg.queryServerEnabled() {
+		// Allow queryserver to reach Linseed for policy activity enrichment.
+		egressRules = append(egressRules, v3.Rule{
 			Action:      v3.Allow,
 			Protocol:    &networkpolicy.TCPProtocol,
 			Destination: networkpolicy.DefaultHelper().LinseedEntityRule(),
-		},
-	}...)
+		})
+	}
 
 	if cfg.KeyValidatorConfig != nil {
 		if parsedURL, err := url.Parse(cfg.KeyValidatorConfig.Issuer()); err == nil {
@@ -540,11 +753,52 @@ func calicoSystemAPIServerPolicy(cfg *APIServerConfiguration) *v3.NetworkPolicy
 	l7AdmCtrlContainerPort := getContainerPort(cfg, L7AdmissionControllerContainerName).ContainerPort
 
 	// The ports Calico Enterprise API Server and Calico Enterprise Query Server are configured to listen on.
-	ingressPorts := networkpolicy.Ports(443, uint16(apiServerContainerPort), uint16(queryServerContainerPort), 10443)
+	ingressPorts := networkpolicy.Ports(443, uint16(apiServerContainerPort), 10443)
+	metricsPorts := networkpolicy.Ports(uint16(apiServerContainerPort))
+	if cfg.queryServerEnabled() {
+		ingressPorts = networkpolicy.Ports(443, uint16(apiServerContainerPort), uint16(queryServerContainerPort), 10443)
+		metricsPorts = networkpolicy.Ports(uint16(apiServerContainerPort), uint16(queryServerContainerPort))
+	}
 	if cfg.IsSidecarInjectionEnabled() {
 		ingressPorts = append(ingressPorts, numorstring.Port{MinPort: uint16(l7AdmCtrlContainerPort), MaxPort: uint16(l7AdmCtrlContainerPort)})
 	}
 
+	ingressRules := []v3.Rule{
+		{
+			Action:   v3.Allow,
+			Protocol: &networkpolicy.TCPProtocol,
+			// This policy allows Calico Enterprise API Server access from anywhere.
+			Source: v3.EntityRule{
+				Nets: []string{"0.0.0.0/0"},
+			},
+			Destination: v3.EntityRule{
+				Ports: ingressPorts,
+			},
+		},
+		{
+			Action:   v3.Allow,
+			Protocol: &networkpolicy.TCPProtocol,
+			Source: v3.EntityRule{
+				Nets: []string{"::/0"},
+			},
+			Destination: v3.EntityRule{
+				Ports: ingressPorts,
+			},
+		},
+	}
+
+	if cfg.prometheusMetricsEnabled() {
+		// Allow Prometheus to scrape the API server and query server metrics endpoints.
+		ingressRules = append(ingressRules, v3.Rule{
+			Action:   v3.Allow,
+			Protocol: &networkpolicy.TCPProtocol,
+			Source:   networkpolicy.PrometheusSourceEntityRule,
+			Destination: v3.EntityRule{
+				Ports: metricsPorts,
+			},
+		})
+	}
+
 	return &v3.NetworkPolicy{
 		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"},
 		ObjectMeta: metav1.ObjectMeta{
@@ -556,30 +810,8 @@ func calicoSystemAPIServerPolicy(cfg *APIServerConfiguration) *v3.NetworkPolicy
 			Tier:     networkpolicy.CalicoTierName,
 			Selector: networkpolicy.KubernetesAppSelector(APIServerName),
 			Types:    []v3.PolicyType{v3.PolicyTypeIngress, v3.PolicyTypeEgress},
-			Ingress: []v3.Rule{
-				{
-					Action:   v3.Allow,
-					Protocol: &networkpolicy.TCPProtocol,
-					// This policy allows Calico Enterprise API Server access from anywhere.
-					Source: v3.EntityRule{
-						Nets: []string{"0.0.0.0/0"},
-					},
-					Destination: v3.EntityRule{
-						Ports: ingressPorts,
-					},
-				},
-				{
-					Action:   v3.Allow,
-					Protocol: &networkpolicy.TCPProtocol,
-					Source: v3.EntityRule{
-						Nets: []string{"::/0"},
-					},
-					Destination: v3.EntityRule{
-						Ports: ingressPorts,
-					},
-				},
-			},
-			Egress: egressRules,
+			Ingress:  ingressRules,
+			Egress:   egressRules,
 		},
 	}
 }
@@ -683,6 +915,32 @@ func (c *apiServerComponent) calicoCustomResourcesClusterRole() *rbacv1.ClusterR
 			},
 		})
 	}
+	if c.cfg.APIServer.IsPriorityAndFairnessEnabled() {
+		rules = append(rules,
+			rbacv1.PolicyRule{
+				// API priority and fairness configuration.
+				APIGroups: []string{"flowcontrol.apiserver.k8s.io"},
+				Resources: []string{
+					"flowschemas",
+					"prioritylevelconfigurations",
+				},
+				Verbs: []string{
+					"get",
+					"list",
+					"watch",
+				},
+			},
+			rbacv1.PolicyRule{
+				// Allows the API server to report dangling FlowSchemas.
+				APIGroups: []string{"flowcontrol.apiserver.k8s.io"},
+				Resources: []string{
+					"flowschemas/status",
+					"prioritylevelconfigurations/status",
+				},
+				Verbs: []string{"patch"},
+			},
+		)
+	}
 	return &rbacv1.ClusterRole{
 		TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
 		ObjectMeta: metav1.ObjectMeta{
@@ -692,6 +950,30 @@ func (c *apiServerComponent) calicoCustomResourcesClusterRole() *rbacv1.ClusterR
 	}
 }
 
+// flowControlObjects returns the PriorityLevelConfigurations and FlowSchemas configured on the APIServer.
+func (c *apiServerComponent) flowControlObjects() []client.Object {
+	fc := c.cfg.APIServer.FlowControl
+	if fc == nil {
+		return nil
+	}
+	var objs []client.Object
+	for _, plc := range fc.PriorityLevelConfigurations {
+		objs = append(objs, &flowcontrolv1.PriorityLevelConfiguration{
+			TypeMeta:   metav1.TypeMeta{Kind: "PriorityLevelConfiguration", APIVersion: "flowcontrol.apiserver.k8s.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: plc.Name, Labels: map[string]string{APIServerFlowControlLabel: APIServerFlowControlLabelValue}},
+			Spec:       *plc.Spec.DeepCopy(),
+		})
+	}
+	for _, fs := range fc.FlowSchemas {
+		objs = append(objs, &flowcontrolv1.FlowSchema{
+			TypeMeta:   metav1.TypeMeta{Kind: "FlowSchema", APIVersion: "flowcontrol.apiserver.k8s.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: fs.Name, Labels: map[string]string{APIServerFlowControlLabel: APIServerFlowControlLabelValue}},
+			Spec:       *fs.Spec.DeepCopy(),
+		})
+	}
+	return objs
+}
+
 // calicoCustomResourcesClusterRoleBinding creates a clusterrolebinding that applies calicoCustomResourcesClusterRole to
 // the calico-apiserver service account.
 //
@@ -928,8 +1210,9 @@ func (c *apiServerComponent) apiServerService() *corev1.Service {
 			},
 		},
 	}
+	setServiceIPFamilies(s, c.cfg.Installation)
 
-	if c.cfg.Installation.Variant.IsEnterprise() {
+	if c.cfg.queryServerEnabled() {
 		// Add port for queryserver if enterprise.
 		s.Spec.Ports = append(s.Spec.Ports,
 			corev1.ServicePort{
@@ -952,9 +1235,241 @@ func (c *apiServerComponent) apiServerService() *corev1.Service {
 		)
 	}
 
+	rcomp.ApplyServiceOverrides(s, c.cfg.APIServer.APIServerService)
+	return s
+}
+
+// metricsService creates a headless Service that selects the API server pods, so that Prometheus can
+// scrape each API server and query server individually.
+func (c *apiServerComponent) metricsService() *corev1.Service {
+	apiServerPort := getContainerPort(c.cfg, APIServerContainerName).ContainerPort
+	s := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      APIServerMetricsServiceName,
+			Namespace: APIServerNamespace,
+			Labels:    map[string]string{"k8s-app": APIServerMetricsServiceName},
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       APIServerPortName,
+					Port:       apiServerPort,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromInt32(apiServerPort),
+				},
+			},
+			Selector: c.deploymentSelector().MatchLabels,
+		},
+	}
+
+	if c.cfg.queryServerEnabled() {
+		queryServerPort := getContainerPort(c.cfg, TigeraAPIServerQueryServerContainerName).ContainerPort
+		s.Spec.Ports = append(s.Spec.Ports, corev1.ServicePort{
+			Name:       QueryServerPortName,
+			Port:       queryServerPort,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt32(queryServerPort),
+		})
+	}
 	return s
 }
 
+// serviceMonitor creates a ServiceMonitor for the API server and query server metrics endpoints. Both endpoints
+// serve TLS using the API server certificate and authorize requests using the token of the metrics reader
+// service account.
+func (c *apiServerComponent) serviceMonitor() *monitoringv1.ServiceMonitor {
+	endpoint := func(port string) monitoringv1.Endpoint {
+		return monitoringv1.Endpoint{
+			HonorLabels:   true,
+			Interval:      "30s",
+			Port:          port,
+			Path:          "/metrics",
+			Scheme:        ptr.To(monitoringv1.SchemeHTTPS),
+			ScrapeTimeout: "10s",
+			HTTPConfigWithProxyAndTLSFiles: monitoringv1.HTTPConfigWithProxyAndTLSFiles{
+				HTTPConfigWithTLSFiles: monitoringv1.HTTPConfigWithTLSFiles{
+					HTTPConfigWithoutTLS: monitoringv1.HTTPConfigWithoutTLS{
+						Authorization: &monitoringv1.SafeAuthorization{
+							Type: "Bearer",
+							Credentials: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: APIServerMetricsServiceName},
+								Key:                  corev1.ServiceAccountTokenKey,
+							},
+						},
+					},
+					TLSConfig: &monitoringv1.TLSConfig{
+						SafeTLSConfig: monitoringv1.SafeTLSConfig{
+							CA: monitoringv1.SecretOrConfigMap{
+								ConfigMap: &corev1.ConfigMapKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: certificatemanagement.TrustedCertConfigMapName},
+									Key:                  certificatemanagement.TrustedCertConfigMapKeyName,
+								},
+							},
+							ServerName: ptr.To(APIServerServiceName),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	endpoints := []monitoringv1.Endpoint{endpoint(APIServerPortName)}
+	if c.cfg.queryServerEnabled() {
+		endpoints = append(endpoints, endpoint(QueryServerPortName))
+	}
+
+	return &monitoringv1.ServiceMonitor{
+		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: monitoringv1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      APIServerMetricsServiceName,
+			Namespace: APIServerNamespace,
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector:          metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": APIServerMetricsServiceName}},
+			NamespaceSelector: monitoringv1.NamespaceSelector{MatchNames: []string{APIServerNamespace}},
+			Endpoints:         endpoints,
+		},
+	}
+}
+
+// metricsReaderObjects creates the service account that Prometheus scrapes the API server and query server metrics
+// as, along with its token secret and the RBAC that allows it to read the /metrics endpoints.
+func (c *apiServerComponent) metricsReaderObjects() []client.Object {
+	return []client.Object{
+		&corev1.ServiceAccount{
+			TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: APIServerMetricsServiceName, Namespace: APIServerNamespace},
+		},
+		&corev1.Secret{
+			TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      APIServerMetricsServiceName,
+				Namespace: APIServerNamespace,
+				// The annotation below will result in the auto-creation of spec.data.token.
+				Annotations: map[string]string{
+					corev1.ServiceAccountNameKey: APIServerMetricsServiceName,
+				},
+			},
+			Type: corev1.SecretTypeServiceAccountToken,
+		},
+		&rbacv1.ClusterRole{
+			TypeMeta:   metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: APIServerMetricsServiceName},
+			Rules: []rbacv1.PolicyRule{
+				{
+					NonResourceURLs: []string{"/metrics"},
+					Verbs:           []string{"get"},
+				},
+			},
+		},
+		&rbacv1.ClusterRoleBinding{
+			TypeMeta:   metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: APIServerMetricsServiceName},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: "rbac.authorization.k8s.io",
+				Kind:     "ClusterRole",
+				Name:     APIServerMetricsServiceName,
+			},
+			Subjects: []rbacv1.Subject{
+				{
+					Kind:      "ServiceAccount",
+					Name:      APIServerMetricsServiceName,
+					Namespace: APIServerNamespace,
+				},
+			},
+		},
+	}
+}
+
+// queryServerGateway renders a Gateway with a single TLS passthrough listener for the query server.
+func (c *apiServerComponent) queryServerGateway() *gapi.Gateway {
+	gw := c.cfg.APIServer.QueryServerGateway()
+	listener := gapi.Listener{
+		Name:     gapi.SectionName(QueryserverServiceName),
+		Protocol: gapi.TLSProtocolType,
+		Port:     gapi.PortNumber(ptr.Deref(gw.Port, 443)),
+		TLS:      &gapi.ListenerTLSConfig{Mode: ptr.To(gapi.TLSModePassthrough)},
+		AllowedRoutes: &gapi.AllowedRoutes{
+			Namespaces: &gapi.RouteNamespaces{From: ptr.To(gapi.NamespacesFromSame)},
+			Kinds:      []gapi.RouteGroupKind{{Group: ptr.To(gapi.Group(gapi.GroupName)), Kind: "TLSRoute"}},
+		},
+	}
+	if gw.Hostname != "" {
+		listener.Hostname = ptr.To(gapi.Hostname(gw.Hostname))
+	}
+
+	return &gapi.Gateway{
+		TypeMeta: metav1.TypeMeta{Kind: "Gateway", APIVersion: gapi.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      QueryserverServiceName,
+			Namespace: APIServerNamespace,
+		},
+		Spec: gapi.GatewaySpec{
+			GatewayClassName: gapi.ObjectName(gw.GatewayClassName),
+			Listeners:        []gapi.Listener{listener},
+		},
+	}
+}
+
+// queryServerTLSRoute routes TLS connections accepted by the query server Gateway to the calico-api Service without
+// terminating them, so that clients are served the API server certificate.
+func (c *apiServerComponent) queryServerTLSRoute() *gapiv1alpha2.TLSRoute {
+	gw := c.cfg.APIServer.QueryServerGateway()
+	route := &gapiv1alpha2.TLSRoute{
+		TypeMeta: metav1.TypeMeta{Kind: "TLSRoute", APIVersion: gapiv1alpha2.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      QueryserverServiceName,
+			Namespace: APIServerNamespace,
+		},
+		Spec: gapiv1alpha2.TLSRouteSpec{
+			CommonRouteSpec: gapi.CommonRouteSpec{
+				ParentRefs: []gapi.ParentReference{{
+					Name:        gapi.ObjectName(QueryserverServiceName),
+					SectionName: ptr.To(gapi.SectionName(QueryserverServiceName)),
+				}},
+			},
+			Rules: []gapiv1alpha2.TLSRouteRule{{
+				BackendRefs: []gapi.BackendRef{{
+					BackendObjectReference: gapi.BackendObjectReference{
+						Name: gapi.ObjectName(QueryserverServiceName),
+						Port: ptr.To(gapi.PortNumber(QueryServerPort)),
+					},
+				}},
+			}},
+		},
+	}
+	if gw.Hostname != "" {
+		route.Spec.Hostnames = []gapi.Hostname{gapi.Hostname(gw.Hostname)}
+	}
+	return route
+}
+
+// queryServerRoute creates an OpenShift Route that passes TLS connections through to the query server port of the
+// calico-api Service.
+func (c *apiServerComponent) queryServerRoute() *routev1.Route {
+	return &routev1.Route{
+		TypeMeta: metav1.TypeMeta{Kind: "Route", APIVersion: routev1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      QueryserverServiceName,
+			Namespace: APIServerNamespace,
+		},
+		Spec: routev1.RouteSpec{
+			Host: c.cfg.QueryServerRouteHost,
+			To: routev1.RouteTargetReference{
+				Kind: "Service",
+				Name: QueryserverServiceName,
+			},
+			Port: &routev1.RoutePort{TargetPort: intstr.FromString(QueryServerPortName)},
+			TLS: &routev1.TLSConfig{
+				Termination:                   routev1.TLSTerminationPassthrough,
+				InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+			},
+		},
+	}
+}
+
 // apiServer creates a deployment containing the API and query servers.
 func (c *apiServerComponent) apiServerDeployment() *appsv1.Deployment {
 	hostNetwork := c.hostNetwork()
@@ -979,9 +1494,23 @@ func (c *apiServerComponent) apiServerDeployment() *appsv1.Deployment {
 			initContainers = append(initContainers, initContainerAPIServer)
 		}
 
-		initContainerQueryServer := c.cfg.QueryServerTLSKeyPairCertificateManagementOnly.InitContainer(APIServerNamespace, c.queryServerContainer().SecurityContext)
-		annotations[c.cfg.QueryServerTLSKeyPairCertificateManagementOnly.HashAnnotationKey()] = c.cfg.QueryServerTLSKeyPairCertificateManagementOnly.HashAnnotationValue()
-		initContainers = append(initContainers, initContainerQueryServer)
+		if c.cfg.QueryServerTLSKeyPairCertificateManagementOnly != nil {
+			initContainerQueryServer := c.cfg.QueryServerTLSKeyPairCertificateManagementOnly.InitContainer(APIServerNamespace, c.queryServerContainer().SecurityContext)
+			annotations[c.cfg.QueryServerTLSKeyPairCertificateManagementOnly.HashAnnotationKey()] = c.cfg.QueryServerTLSKeyPairCertificateManagementOnly.HashAnnotationValue()
+			initContainers = append(initContainers, initContainerQueryServer)
+		}
+	}
+
+	if c.etcdTLSEnabled() {
+		annotations[apiServerEtcdTLSHashAnnotation] = rmeta.AnnotationHash(c.cfg.EtcdTLSSecret.Data)
+	}
+	if c.auditWebhookEnabled() {
+		annotations[auditWebhookHashAnnotation] = rmeta.AnnotationHash(c.cfg.AuditWebhookSecret.Data)
+	}
+	if c.queryServerOIDCEnabled() {
+		for key, value := range c.cfg.KeyValidatorConfig.RequiredAnnotations() {
+			annotations[key] = value
+		}
 	}
 
 	// Determine which containers to run.
@@ -992,7 +1521,7 @@ func (c *apiServerComponent) apiServerDeployment() *appsv1.Deployment {
 	if c.cfg.IsSidecarInjectionEnabled() {
 		containers = append(containers, c.l7AdmissionControllerContainer())
 	}
-	if c.cfg.Installation.Variant.IsEnterprise() {
+	if c.cfg.queryServerEnabled() {
 		containers = append(containers, c.queryServerContainer())
 	}
 
@@ -1039,6 +1568,10 @@ func (c *apiServerComponent) apiServerDeployment() *appsv1.Deployment {
 		d.Spec.Template.Spec.Affinity = podaffinity.NewPodAntiAffinity(APIServerName, []string{APIServerNamespace, "tigera-system", "calico-apiserver"})
 	}
 
+	if c.cfg.Installation.ManagedPriorityClassesEnabled() {
+		d.Spec.Template.Spec.PriorityClassName = ControlPlanePriorityClassName
+	}
+
 	if c.cfg.Installation.Variant.IsEnterprise() {
 		if c.cfg.TrustedBundle != nil {
 			trustedBundleHashAnnotations := c.cfg.TrustedBundle.HashAnnotations()
@@ -1048,13 +1581,101 @@ func (c *apiServerComponent) apiServerDeployment() *appsv1.Deployment {
 		}
 	}
 
+	preStopSleep := c.preStopSleepSeconds()
+	if preStopSleep > 0 {
+		// Leave room for the preStop sleep and the API server's shutdown delay on top of the default grace period.
+		d.Spec.Template.Spec.TerminationGracePeriodSeconds = ptr.To(int64(defaultTerminationGracePeriodSeconds + 2*preStopSleep))
+	}
+
 	if overrides := c.cfg.APIServer.APIServerDeployment; overrides != nil {
 		rcomp.ApplyDeploymentOverrides(d, overrides)
+		applyExtraVolumes(d, overrides)
+	}
+
+	if preStopSleep > 0 {
+		for i := range d.Spec.Template.Spec.Containers {
+			d.Spec.Template.Spec.Containers[i].Lifecycle = &corev1.Lifecycle{
+				PreStop: &corev1.LifecycleHandler{Sleep: &corev1.SleepAction{Seconds: int64(preStopSleep)}},
+			}
+		}
 	}
 
 	return d
 }
 
+// apiServerMigrationDeployment creates the temporary deployment that runs the API server in the new network mode while
+// the calico-apiserver deployment moves to it. Its pods keep the apiserver label, so that the Service sends them
+// traffic, and carry an extra label so that the deployment only selects its own pods.
+func (c *apiServerComponent) apiServerMigrationDeployment() *appsv1.Deployment {
+	d := c.apiServerDeployment()
+	d.Name = APIServerMigrationName
+	d.Spec.Template.Name = APIServerMigrationName
+	d.Spec.Template.Labels[apiServerMigrationLabel] = "true"
+	d.Spec.Selector.MatchLabels[apiServerMigrationLabel] = "true"
+	return d
+}
+
+// withHostNetwork returns a copy of the component that renders the API server in the given network mode.
+func (c *apiServerComponent) withHostNetwork(hostNetwork bool) *apiServerComponent {
+	cp := *c
+	cp.hostNetworkOverride = &hostNetwork
+	return &cp
+}
+
+// applyExtraVolumes adds the extra volumes and volume mounts from the APIServerDeployment overrides to the deployment.
+func applyExtraVolumes(d *appsv1.Deployment, overrides *operatorv1.APIServerDeployment) {
+	if overrides.Spec == nil || overrides.Spec.Template == nil || overrides.Spec.Template.Spec == nil {
+		return
+	}
+	podSpec := overrides.Spec.Template.Spec
+	d.Spec.Template.Spec.Volumes = append(d.Spec.Template.Spec.Volumes, podSpec.ExtraVolumes...)
+	for _, oc := range podSpec.Containers {
+		for i := range d.Spec.Template.Spec.Containers {
+			if c := &d.Spec.Template.Spec.Containers[i]; c.Name == oc.Name {
+				c.VolumeMounts = append(c.VolumeMounts, oc.ExtraVolumeMounts...)
+			}
+		}
+	}
+}
+
+// validateExtraVolumes checks that the extra volumes and volume mounts from the APIServerDeployment overrides do not
+// conflict with the ones the operator renders, which depend on the rest of the configuration.
+func (c *apiServerComponent) validateExtraVolumes() error {
+	// Extra volume mounts must mount one of the extra volumes, so there is nothing to check without them.
+	o := c.cfg.APIServer.APIServerDeployment
+	if o == nil || o.Spec == nil || o.Spec.Template == nil || o.Spec.Template.Spec == nil || len(o.Spec.Template.Spec.ExtraVolumes) == 0 {
+		return nil
+	}
+
+	podSpec := c.apiServerDeployment().Spec.Template.Spec
+	volumes := map[string]bool{}
+	for _, v := range podSpec.Volumes {
+		if volumes[v.Name] {
+			return fmt.Errorf("APIServer spec.APIServerDeployment extra volume %s conflicts with a volume of the API server pods", v.Name)
+		}
+		volumes[v.Name] = true
+	}
+	for _, container := range podSpec.Containers {
+		paths := map[string]bool{}
+		for _, m := range container.VolumeMounts {
+			if paths[m.MountPath] {
+				return fmt.Errorf("APIServer spec.APIServerDeployment extra volume mount %s of container %s conflicts with a volume mount of the container", m.MountPath, container.Name)
+			}
+			paths[m.MountPath] = true
+		}
+	}
+	return nil
+}
+
+// preStopSleepSeconds returns how long the API server pods sleep in their preStop hook, or zero if they don't.
+func (c *apiServerComponent) preStopSleepSeconds() int32 {
+	if d := c.cfg.APIServer.APIServerDeployment; d != nil && d.Spec != nil && d.Spec.Template != nil &&
+		d.Spec.Template.Spec != nil && d.Spec.Template.Spec.PreStopSleepSeconds != nil {
+		return *d.Spec.Template.Spec.PreStopSleepSeconds
+	}
+	return 0
+}
+
 // apiServer creates a MutatingWebhookConfiguration for sidecars.
 func (c *apiServerComponent) sidecarMutatingWebhookConfig() *admregv1.MutatingWebhookConfiguration {
 	var cacert []byte
@@ -1115,10 +1736,10 @@ func (c *apiServerComponent) sidecarMutatingWebhookConfig() *admregv1.MutatingWe
 }
 
 func (c *apiServerComponent) hostNetwork() bool {
-	if c.cfg.ForceHostNetwork {
-		return true
+	if c.hostNetworkOverride != nil {
+		return *c.hostNetworkOverride
 	}
-	return HostNetworkRequired(c.cfg.Installation)
+	return c.cfg.HostNetwork()
 }
 
 func HostNetworkRequired(installation *operatorv1.InstallationSpec) bool {
@@ -1130,6 +1751,28 @@ func HostNetworkRequired(installation *operatorv1.InstallationSpec) bool {
 		// Calico.
 		return true
 	}
+	if installation.KubernetesProvider.IsAKS() &&
+		installation.CNI != nil &&
+		installation.CNI.Type == operatorv1.PluginCalico &&
+		!overlayEnabled(installation) {
+		// Likewise on AKS clusters that bring their own CNI, the control plane does not run Calico and cannot reach
+		// pod IPs unless the IP pools use an overlay, so the API server and webhooks must come up on the host network.
+		// Pods get routable VNet IPs with the Azure CNI, so it needs no workaround.
+		return true
+	}
+	return false
+}
+
+// overlayEnabled returns true if any of the IP pools in the installation use an encapsulation.
+func overlayEnabled(installation *operatorv1.InstallationSpec) bool {
+	if installation.CalicoNetwork == nil {
+		return false
+	}
+	for _, pool := range installation.CalicoNetwork.IPPools {
+		if pool.Encapsulation != "" && pool.Encapsulation != operatorv1.EncapsulationNone {
+			return true
+		}
+	}
 	return false
 }
 
@@ -1139,15 +1782,22 @@ func (c *apiServerComponent) apiServerContainer() corev1.Container {
 		c.cfg.TLSKeyPair.VolumeMount(c.SupportedOSType()),
 	}
 	if c.cfg.Installation.Variant.IsEnterprise() {
-		volumeMounts = append(volumeMounts,
-			corev1.VolumeMount{Name: auditLogsVolumeName, MountPath: "/var/log/calico/audit"},
-			corev1.VolumeMount{Name: auditPolicyVolumeName, MountPath: "/etc/tigera/audit"},
-		)
+		if c.auditWebhookEnabled() {
+			volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: auditWebhookVolumeName, MountPath: auditWebhookMountPath, ReadOnly: true})
+		} else {
+			auditLogsMount := corev1.VolumeMount{Name: auditLogsVolumeName, MountPath: "/var/log/calico/audit"}
+			if c.sharedAuditLogVolume() {
+				// Each replica writes and rotates its audit logs in its own directory of the shared volume.
+				auditLogsMount.SubPathExpr = "$(POD_NAME)"
+			}
+			volumeMounts = append(volumeMounts, auditLogsMount)
+		}
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: auditPolicyVolumeName, MountPath: "/etc/tigera/audit"})
 	}
 
-	env := []corev1.EnvVar{
-		{Name: "DATASTORE_TYPE", Value: "kubernetes"},
-	}
+	volumeMounts = append(volumeMounts, c.etcdVolumeMounts()...)
+
+	env := c.datastoreEnvVars()
 
 	if c.cfg.MultiTenant {
 		env = append(env, corev1.EnvVar{Name: "MULTI_TENANT_ENABLED", Value: "true"})
@@ -1173,6 +1823,15 @@ func (c *apiServerComponent) apiServerContainer() corev1.Container {
 		env = append(env, corev1.EnvVar{Name: "MULTI_INTERFACE_MODE", Value: c.cfg.Installation.CalicoNetwork.MultiInterfaceMode.Value()})
 	}
 
+	env = append(env, c.cfg.Installation.Proxy.EnvVars()...)
+
+	if c.sharedAuditLogVolume() {
+		env = append(env, corev1.EnvVar{
+			Name:      "POD_NAME",
+			ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}},
+		})
+	}
+
 	apiServerTargetPort := getContainerPort(c.cfg, APIServerContainerName).ContainerPort
 
 	apiServer := corev1.Container{
@@ -1214,12 +1873,29 @@ func (c *apiServerComponent) startUpArgs() []string {
 		fmt.Sprintf("--tls-private-key-file=%s", c.cfg.TLSKeyPair.VolumeMountKeyFilePath()),
 		fmt.Sprintf("--tls-cert-file=%s", c.cfg.TLSKeyPair.VolumeMountCertificateFilePath()),
 	}
+	args = append(args, tlsProfileArgs(c.cfg.Installation)...)
 
-	if c.cfg.Installation.Variant.IsEnterprise() {
+	if c.auditWebhookEnabled() {
+		args = append(args,
+			"--audit-policy-file=/etc/tigera/audit/policy.conf",
+			fmt.Sprintf("--audit-webhook-config-file=%s/%s", auditWebhookMountPath, APIServerAuditWebhookConfigKey),
+		)
+	} else if c.cfg.Installation.Variant.IsEnterprise() {
 		args = append(args,
 			"--audit-policy-file=/etc/tigera/audit/policy.conf",
 			"--audit-log-path=/var/log/calico/audit/tsee-audit.log",
 		)
+		if al := c.cfg.APIServer.AuditLogs; al != nil {
+			if al.MaxAge != nil {
+				args = append(args, fmt.Sprintf("--audit-log-maxage=%d", *al.MaxAge))
+			}
+			if al.MaxBackup != nil {
+				args = append(args, fmt.Sprintf("--audit-log-maxbackup=%d", *al.MaxBackup))
+			}
+			if al.MaxSize != nil {
+				args = append(args, fmt.Sprintf("--audit-log-maxsize=%d", *al.MaxSize))
+			}
+		}
 	}
 
 	if c.cfg.ManagementCluster != nil {
@@ -1234,6 +1910,34 @@ func (c *apiServerComponent) startUpArgs() []string {
 			args = append(args, fmt.Sprintf("--tunnelSecretName=%s", c.cfg.ManagementCluster.Spec.TLS.SecretName))
 		}
 	}
+	if c.cfg.APIServer.RequestTimeout != nil {
+		args = append(args, fmt.Sprintf("--request-timeout=%s", c.cfg.APIServer.RequestTimeout.Duration))
+	}
+	if preStopSleep := c.preStopSleepSeconds(); preStopSleep > 0 {
+		args = append(args, fmt.Sprintf("--shutdown-delay-duration=%ds", preStopSleep))
+	}
+	if c.cfg.APIServer.MaxRequestsInflight != nil {
+		args = append(args, fmt.Sprintf("--max-requests-inflight=%d", *c.cfg.APIServer.MaxRequestsInflight))
+	}
+	if c.cfg.APIServer.MaxMutatingRequestsInflight != nil {
+		args = append(args, fmt.Sprintf("--max-mutating-requests-inflight=%d", *c.cfg.APIServer.MaxMutatingRequestsInflight))
+	}
+	if c.cfg.APIServer.DefaultWatchCacheSize != nil {
+		args = append(args, fmt.Sprintf("--default-watch-cache-size=%d", *c.cfg.APIServer.DefaultWatchCacheSize))
+	}
+	if len(c.cfg.APIServer.WatchCacheSizes) > 0 {
+		var sizes []string
+		for _, wcs := range c.cfg.APIServer.WatchCacheSizes {
+			sizes = append(sizes, fmt.Sprintf("%s#%d", wcs.Resource, wcs.Size))
+		}
+		args = append(args, fmt.Sprintf("--watch-cache-sizes=%s", strings.Join(sizes, ",")))
+	}
+	if c.cfg.APIServer.EtcdDatastore != nil && c.cfg.APIServer.EtcdCompactionInterval != nil {
+		args = append(args, fmt.Sprintf("--etcd-compaction-interval=%s", c.cfg.APIServer.EtcdCompactionInterval.Duration))
+	}
+	if fc := c.cfg.APIServer.FlowControl; fc != nil && fc.PriorityAndFairness != nil {
+		args = append(args, fmt.Sprintf("--enable-priority-and-fairness=%t", *fc.PriorityAndFairness == operatorv1.PriorityAndFairnessEnabled))
+	}
 	if c.cfg.KubernetesVersion != nil && c.cfg.KubernetesVersion.Major < 2 && c.cfg.KubernetesVersion.Minor < 30 {
 		// Disable this API as it is not available by default. If we don't, the server fails to start, due to trying to
 		// establish watches for unavailable APIs.
@@ -1252,12 +1956,13 @@ func (c *apiServerComponent) queryServerContainer() corev1.Container {
 	} else {
 		tlsSecret = c.cfg.TLSKeyPair
 	}
-	env := []corev1.EnvVar{
-		{Name: "DATASTORE_TYPE", Value: "kubernetes"},
+	env := c.datastoreEnvVars()
+	env = append(env, []corev1.EnvVar{
 		{Name: "LISTEN_ADDR", Value: fmt.Sprintf(":%d", queryServerTargetPort)},
 		{Name: "TLS_CERT", Value: fmt.Sprintf("/%s/tls.crt", tlsSecret.GetName())},
 		{Name: "TLS_KEY", Value: fmt.Sprintf("/%s/tls.key", tlsSecret.GetName())},
-	}
+	}...)
+	env = append(env, tlsProfileEnvVars(c.cfg.Installation)...)
 	if c.cfg.TrustedBundle != nil {
 		env = append(env, corev1.EnvVar{Name: "TRUSTED_BUNDLE_PATH", Value: c.cfg.TrustedBundle.MountPath()})
 	}
@@ -1272,10 +1977,12 @@ func (c *apiServerComponent) queryServerContainer() corev1.Container {
 		env = append(env, corev1.EnvVar{Name: "MULTI_INTERFACE_MODE", Value: c.cfg.Installation.CalicoNetwork.MultiInterfaceMode.Value()})
 	}
 
-	if c.cfg.KeyValidatorConfig != nil {
+	if c.queryServerOIDCEnabled() {
 		env = append(env, c.cfg.KeyValidatorConfig.RequiredEnv("")...)
 	}
 
+	env = append(env, c.cfg.Installation.Proxy.EnvVars()...)
+
 	linseedURL := relasticsearch.LinseedEndpoint(c.SupportedOSType(), c.cfg.ClusterDomain, ElasticsearchNamespace, c.cfg.ManagementClusterConnection != nil, false)
 	env = append(env,
 		corev1.EnvVar{Name: "LINSEED_URL", Value: linseedURL},
@@ -1292,15 +1999,7 @@ func (c *apiServerComponent) queryServerContainer() corev1.Container {
 		env = append(env, corev1.EnvVar{Name: "LINSEED_CA", Value: c.cfg.TrustedBundle.MountPath()})
 	}
 
-	// set LogLEVEL for queryserver container
-	if logging := c.cfg.APIServer.Logging; logging != nil &&
-		logging.QueryServerLogging != nil && logging.QueryServerLogging.LogSeverity != nil {
-		env = append(env,
-			corev1.EnvVar{Name: "LOGLEVEL", Value: strings.ToLower(string(*logging.QueryServerLogging.LogSeverity))})
-	} else {
-		// set default LOGLEVEL to info when not set by the user
-		env = append(env, corev1.EnvVar{Name: "LOGLEVEL", Value: "info"})
-	}
+	env = append(env, queryServerSettingsEnvVars(c.cfg.APIServer)...)
 
 	volumeMounts := []corev1.VolumeMount{
 		tlsSecret.VolumeMount(c.SupportedOSType()),
@@ -1314,6 +2013,10 @@ func (c *apiServerComponent) queryServerContainer() corev1.Container {
 			MountPath: LinseedVolumeMountPath,
 		})
 	}
+	volumeMounts = append(volumeMounts, c.etcdVolumeMounts()...)
+	if c.queryServerOIDCEnabled() {
+		volumeMounts = append(volumeMounts, c.cfg.KeyValidatorConfig.RequiredVolumeMounts()...)
+	}
 
 	container := corev1.Container{
 		Name:    string(TigeraAPIServerQueryServerContainerName),
@@ -1336,6 +2039,34 @@ func (c *apiServerComponent) queryServerContainer() corev1.Container {
 	return container
 }
 
+// queryServerSettingsEnvVars returns the env vars for the log level and tuning of the query server in the APIServer spec.
+func queryServerSettingsEnvVars(spec *operatorv1.APIServerSpec) []corev1.EnvVar {
+	var env []corev1.EnvVar
+
+	// set LogLEVEL for queryserver container
+	if logging := spec.Logging; logging != nil &&
+		logging.QueryServerLogging != nil && logging.QueryServerLogging.LogSeverity != nil {
+		env = append(env,
+			corev1.EnvVar{Name: "LOGLEVEL", Value: strings.ToLower(string(*logging.QueryServerLogging.LogSeverity))})
+	} else {
+		// set default LOGLEVEL to info when not set by the user
+		env = append(env, corev1.EnvVar{Name: "LOGLEVEL", Value: "info"})
+	}
+
+	if qs := spec.QueryServer; qs != nil {
+		if qs.CacheRefreshInterval != nil {
+			env = append(env, corev1.EnvVar{Name: "CACHE_REFRESH_INTERVAL", Value: qs.CacheRefreshInterval.Duration.String()})
+		}
+		if qs.MaxPageSize != nil {
+			env = append(env, corev1.EnvVar{Name: "MAX_PAGE_SIZE", Value: fmt.Sprintf("%d", *qs.MaxPageSize)})
+		}
+		if qs.Workers != nil {
+			env = append(env, corev1.EnvVar{Name: "WORKERS", Value: fmt.Sprintf("%d", *qs.Workers)})
+		}
+	}
+	return env
+}
+
 func (c *apiServerComponent) externalLinseedRoleBinding() *rbacv1.RoleBinding {
 	return &rbacv1.RoleBinding{
 		TypeMeta: metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
@@ -1358,6 +2089,32 @@ func (c *apiServerComponent) externalLinseedRoleBinding() *rbacv1.RoleBinding {
 	}
 }
 
+// sharedAuditLogVolume returns true if the replicas of the API server write audit logs to the same volume.
+func (c *apiServerComponent) sharedAuditLogVolume() bool {
+	return c.cfg.APIServer.GetAuditLogStorage() == operatorv1.APIServerAuditLogStoragePersistentVolumeClaim
+}
+
+// auditLogsVolumeSource returns the volume the API server writes audit logs to.
+func (c *apiServerComponent) auditLogsVolumeSource() corev1.VolumeSource {
+	switch c.cfg.APIServer.GetAuditLogStorage() {
+	case operatorv1.APIServerAuditLogStorageEmptyDir:
+		return corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+	case operatorv1.APIServerAuditLogStoragePersistentVolumeClaim:
+		return corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: c.cfg.APIServer.AuditLogs.PersistentVolumeClaimName,
+			},
+		}
+	default:
+		return corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: "/var/log/calico/audit",
+				Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+			},
+		}
+	}
+}
+
 // apiServerVolumes creates the volumes used by the API server deployment.
 func (c *apiServerComponent) apiServerVolumes() []corev1.Volume {
 	volumes := []corev1.Volume{
@@ -1370,16 +2127,20 @@ func (c *apiServerComponent) apiServerVolumes() []corev1.Volume {
 	if c.cfg.Installation.Variant.IsEnterprise() && c.cfg.RequiresAggregationServer {
 		// Only include these volumes if we're running the aggregation API server, since audit logging is done through the
 		// main API server otherwise.
-		volumes = append(volumes,
-			corev1.Volume{
-				Name: auditLogsVolumeName,
+		if c.auditWebhookEnabled() {
+			volumes = append(volumes, corev1.Volume{
+				Name: auditWebhookVolumeName,
 				VolumeSource: corev1.VolumeSource{
-					HostPath: &corev1.HostPathVolumeSource{
-						Path: "/var/log/calico/audit",
-						Type: ptr.To(corev1.HostPathDirectoryOrCreate),
-					},
+					Secret: &corev1.SecretVolumeSource{SecretName: APIServerAuditWebhookSecretName},
 				},
-			},
+			})
+		} else {
+			volumes = append(volumes, corev1.Volume{
+				Name:         auditLogsVolumeName,
+				VolumeSource: c.auditLogsVolumeSource(),
+			})
+		}
+		volumes = append(volumes,
 			corev1.Volume{
 				Name: auditPolicyVolumeName,
 				VolumeSource: corev1.VolumeSource{
@@ -1416,9 +2177,82 @@ func (c *apiServerComponent) apiServerVolumes() []corev1.Volume {
 		})
 	}
 
+	if c.etcdTLSEnabled() {
+		volumes = append(volumes, corev1.Volume{
+			Name: apiServerEtcdTLSVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: APIServerEtcdTLSSecretName},
+			},
+		})
+	}
+
+	if c.queryServerOIDCEnabled() {
+		volumes = append(volumes, c.cfg.KeyValidatorConfig.RequiredVolumes()...)
+	}
+
 	return volumes
 }
 
+// queryServerOIDCEnabled returns true if the query server validates OIDC tokens itself, so that it can be accessed
+// directly rather than only through the Kubernetes API server.
+func (c *apiServerComponent) queryServerOIDCEnabled() bool {
+	return c.cfg.queryServerEnabled() && c.cfg.KeyValidatorConfig != nil
+}
+
+// auditWebhookEnabled returns true if the API server sends its audit logs to a webhook rather than writing them to a file.
+func (c *apiServerComponent) auditWebhookEnabled() bool {
+	return c.cfg.Installation.Variant.IsEnterprise() && c.cfg.APIServer.GetAuditLogStorage() == operatorv1.APIServerAuditLogStorageWebhook &&
+		c.cfg.AuditWebhookSecret != nil
+}
+
+// auditWebhookSecret returns the copy of the referenced audit webhook kubeconfig in the API server namespace.
+func (c *apiServerComponent) auditWebhookSecret() *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: APIServerAuditWebhookSecretName, Namespace: APIServerNamespace},
+		Data:       map[string][]byte{APIServerAuditWebhookConfigKey: c.cfg.AuditWebhookSecret.Data[APIServerAuditWebhookConfigKey]},
+	}
+}
+
+// etcdEnabled returns true if the API server should use an etcdv3 datastore rather than the Kubernetes API.
+func (c *apiServerComponent) etcdEnabled() bool {
+	return len(c.cfg.EtcdEndpoints) > 0
+}
+
+// etcdTLSEnabled returns true if the connection to etcd is secured with the certificates in EtcdTLSSecret.
+func (c *apiServerComponent) etcdTLSEnabled() bool {
+	return c.etcdEnabled() && c.cfg.EtcdTLSSecret != nil
+}
+
+// datastoreEnvVars returns the environment variables that select and configure the datastore used by
+// the API server and query server.
+func (c *apiServerComponent) datastoreEnvVars() []corev1.EnvVar {
+	if !c.etcdEnabled() {
+		return []corev1.EnvVar{{Name: "DATASTORE_TYPE", Value: "kubernetes"}}
+	}
+
+	env := []corev1.EnvVar{
+		{Name: "DATASTORE_TYPE", Value: "etcdv3"},
+		{Name: "ETCD_ENDPOINTS", Value: strings.Join(c.cfg.EtcdEndpoints, ",")},
+	}
+	if c.etcdTLSEnabled() {
+		env = append(env,
+			corev1.EnvVar{Name: "ETCD_CA_CERT_FILE", Value: fmt.Sprintf("%s/%s", apiServerEtcdTLSMountPath, APIServerEtcdCACertKey)},
+			corev1.EnvVar{Name: "ETCD_CERT_FILE", Value: fmt.Sprintf("%s/%s", apiServerEtcdTLSMountPath, corev1.TLSCertKey)},
+			corev1.EnvVar{Name: "ETCD_KEY_FILE", Value: fmt.Sprintf("%s/%s", apiServerEtcdTLSMountPath, corev1.TLSPrivateKeyKey)},
+		)
+	}
+	return env
+}
+
+// etcdVolumeMounts returns the volume mounts for the etcd client certificates, if any.
+func (c *apiServerComponent) etcdVolumeMounts() []corev1.VolumeMount {
+	if !c.etcdTLSEnabled() {
+		return nil
+	}
+	return []corev1.VolumeMount{{Name: apiServerEtcdTLSVolumeName, MountPath: apiServerEtcdTLSMountPath, ReadOnly: true}}
+}
+
 // tolerations creates the tolerations used by the API server deployment.
 func (c *apiServerComponent) tolerations() []corev1.Toleration {
 	if c.hostNetwork() {
@@ -1632,10 +2466,41 @@ func (c *apiServerComponent) kubeControllerManagerUISettingsGroupGetterClusterRo
 	}
 }
 
-// tigeraUserClusterRole returns a cluster role for a default Calico Enterprise user.
+// aggregatedClusterRole returns a cluster role that aggregates the rules of the cluster roles with the given label.
+// Its rules are filled in by the Kubernetes controller manager.
+//
+// Calico Enterprise only
+func aggregatedClusterRole(name, label string) *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		AggregationRule: &rbacv1.AggregationRule{
+			ClusterRoleSelectors: []metav1.LabelSelector{
+				{MatchLabels: map[string]string{label: "true"}},
+			},
+		},
+	}
+}
+
+// tigeraUserClusterRole returns a cluster role for a default Calico Enterprise user, which is aggregated into the
+// tigera-ui-user cluster role.
 //
 // Calico Enterprise only
 func (c *apiServerComponent) tigeraUserClusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   TigeraUIUserDefaultRoleName,
+			Labels: map[string]string{TigeraUIUserAggregationLabel: "true"},
+		},
+		Rules: tigeraUIUserRules(c.cfg.ManagementClusterConnection != nil, c.cfg.queryServerEnabled()),
+	}
+}
+
+// tigeraUIUserRules returns the rules granted to a default Calico Enterprise user.
+func tigeraUIUserRules(managedCluster, queryServerEnabled bool) []rbacv1.PolicyRule {
 	rules := []rbacv1.PolicyRule{
 		// List requests that the Tigera manager needs.
 		{
@@ -1707,12 +2572,10 @@ func (c *apiServerComponent) tigeraUserClusterRole() *rbacv1.ClusterRole {
 		},
 		// Access to statistics.
 		{
-			APIGroups: []string{""},
-			Resources: []string{"services/proxy"},
-			ResourceNames: []string{
-				"https:calico-api:8080", "calico-node-prometheus:9090",
-			},
-			Verbs: []string{"get", "create"},
+			APIGroups:     []string{""},
+			Resources:     []string{"services/proxy"},
+			ResourceNames: statisticsProxyResourceNames(queryServerEnabled),
+			Verbs:         []string{"get", "create"},
 		},
 		// Access to policies in all tiers
 		{
@@ -1810,7 +2673,7 @@ func (c *apiServerComponent) tigeraUserClusterRole() *rbacv1.ClusterRole {
 	}
 
 	// Privileges for lma.tigera.io have no effect on managed clusters.
-	if c.cfg.ManagementClusterConnection == nil {
+	if !managedCluster {
 		// Access to flow logs, audit logs, and statistics.
 		// Access to log into Kibana for oidc users.
 		rules = append(rules, rbacv1.PolicyRule{
@@ -1823,19 +2686,26 @@ func (c *apiServerComponent) tigeraUserClusterRole() *rbacv1.ClusterRole {
 		})
 	}
 
+	return rules
+}
+
+// tigeraNetworkAdminClusterRole returns a cluster role for a Tigera Secure manager network admin, which is aggregated
+// into the tigera-network-admin cluster role.
+//
+// Calico Enterprise only
+func (c *apiServerComponent) tigeraNetworkAdminClusterRole() *rbacv1.ClusterRole {
 	return &rbacv1.ClusterRole{
 		TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "tigera-ui-user",
+			Name:   TigeraNetworkAdminDefaultRoleName,
+			Labels: map[string]string{TigeraNetworkAdminAggregationLabel: "true"},
 		},
-		Rules: rules,
+		Rules: tigeraNetworkAdminRules(c.cfg.ManagementClusterConnection != nil, c.cfg.queryServerEnabled()),
 	}
 }
 
-// tigeraNetworkAdminClusterRole returns a cluster role for a Tigera Secure manager network admin.
-//
-// Calico Enterprise only
-func (c *apiServerComponent) tigeraNetworkAdminClusterRole() *rbacv1.ClusterRole {
+// tigeraNetworkAdminRules returns the rules granted to a Calico Enterprise network admin.
+func tigeraNetworkAdminRules(managedCluster, queryServerEnabled bool) []rbacv1.PolicyRule {
 	rules := []rbacv1.PolicyRule{
 		// Full access to all network policies
 		{
@@ -1909,12 +2779,10 @@ func (c *apiServerComponent) tigeraNetworkAdminClusterRole() *rbacv1.ClusterRole
 		},
 		// Access to statistics.
 		{
-			APIGroups: []string{""},
-			Resources: []string{"services/proxy"},
-			ResourceNames: []string{
-				"https:calico-api:8080", "calico-node-prometheus:9090",
-			},
-			Verbs: []string{"get", "create"},
+			APIGroups:     []string{""},
+			Resources:     []string{"services/proxy"},
+			ResourceNames: statisticsProxyResourceNames(queryServerEnabled),
+			Verbs:         []string{"get", "create"},
 		},
 		// Manage globalreport configuration, view report generation status, and list reports in the Tigera Secure manager.
 		{
@@ -2026,7 +2894,7 @@ func (c *apiServerComponent) tigeraNetworkAdminClusterRole() *rbacv1.ClusterRole
 	}
 
 	// Privileges for lma.tigera.io have no effect on managed clusters.
-	if c.cfg.ManagementClusterConnection == nil {
+	if !managedCluster {
 		// Access to flow logs, audit logs, and statistics.
 		// Elasticsearch superuser access once logged into Kibana.
 		rules = append(rules, rbacv1.PolicyRule{
@@ -2039,13 +2907,7 @@ func (c *apiServerComponent) tigeraNetworkAdminClusterRole() *rbacv1.ClusterRole
 		})
 	}
 
-	return &rbacv1.ClusterRole{
-		TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
-		ObjectMeta: metav1.ObjectMeta{
-			Name: "tigera-network-admin",
-		},
-		Rules: rules,
-	}
+	return rules
 }
 
 // calicoPolicyPassthruClusterRole creates a clusterrole that is used to control the RBAC
@@ -2290,6 +3152,39 @@ func (cfg *APIServerConfiguration) IsSidecarInjectionEnabled() bool {
 		*cfg.ApplicationLayer.Spec.SidecarInjection == operatorv1.SidecarEnabled
 }
 
+// queryServerEnabled returns true if the queryserver container should run in the API server deployment.
+func (cfg *APIServerConfiguration) queryServerEnabled() bool {
+	return cfg.Installation.Variant.IsEnterprise() && cfg.APIServer.IsQueryServerEnabled()
+}
+
+// queryServerGatewayEnabled returns true if the query server should be exposed through the Gateway API.
+func (cfg *APIServerConfiguration) queryServerGatewayEnabled() bool {
+	return cfg.queryServerEnabled() && cfg.APIServer.QueryServerGateway() != nil
+}
+
+// queryServerRouteEnabled returns true if the query server should be exposed through an OpenShift Route.
+func (cfg *APIServerConfiguration) queryServerRouteEnabled() bool {
+	return cfg.OpenShift && cfg.queryServerEnabled() && cfg.APIServer.QueryServerRoute() != nil
+}
+
+// deploymentRequired returns true if the API server deployment has at least one container to run.
+func (cfg *APIServerConfiguration) deploymentRequired() bool {
+	return cfg.RequiresAggregationServer || cfg.queryServerEnabled() || cfg.IsSidecarInjectionEnabled()
+}
+
+// prometheusMetricsEnabled returns true if the API server metrics Service and ServiceMonitor should be rendered.
+func (cfg *APIServerConfiguration) prometheusMetricsEnabled() bool {
+	return cfg.deploymentRequired() && cfg.ServiceMonitorCRDExists && cfg.APIServer.IsPrometheusMetricsEnabled()
+}
+
+// statisticsProxyResourceNames returns the services that users may proxy to for statistics.
+func statisticsProxyResourceNames(queryServerEnabled bool) []string {
+	if !queryServerEnabled {
+		return []string{"calico-node-prometheus:9090"}
+	}
+	return []string{"https:calico-api:8080", "calico-node-prometheus:9090"}
+}
+
 func (c *apiServerComponent) l7AdmissionControllerContainer() corev1.Container {
 	volumeMounts := []corev1.VolumeMount{
 		c.cfg.TLSKeyPair.VolumeMount(c.SupportedOSType()),
@@ -0,0 +1,442 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"fmt"
+	"net/url"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/components"
+	"github.com/tigera/operator/pkg/controller/k8sapi"
+	"github.com/tigera/operator/pkg/render/common/authentication"
+	rcomp "github.com/tigera/operator/pkg/render/common/components"
+	relasticsearch "github.com/tigera/operator/pkg/render/common/elasticsearch"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/networkpolicy"
+	"github.com/tigera/operator/pkg/render/common/secret"
+	"github.com/tigera/operator/pkg/render/common/securitycontext"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
+)
+
+const (
+	// TenantAPIServerTLSSecretName is the name of the key pair the API server of a tenant serves with. It is issued
+	// by the tenant's CA.
+	TenantAPIServerTLSSecretName = "calico-apiserver-tenant-tls"
+
+	// TenantAPIServerClusterRoleName is the name of the ClusterRole and ClusterRoleBinding of the tenant API servers.
+	// They only grant read access to cluster-scoped resources, which a Role cannot grant.
+	TenantAPIServerClusterRoleName = "calico-apiserver-tenant"
+
+	// TenantAPIServerRoleName is the name of the Role and RoleBinding in the namespace of a tenant that grant its API
+	// server access to the namespaced resources of the tenant.
+	TenantAPIServerRoleName = "calico-apiserver-tenant"
+
+	TenantAPIServerManagedClustersAccessRoleBindingName = "calico-apiserver-managed-cluster-access"
+	TenantAPIServerPolicyName                           = networkpolicy.CalicoComponentPolicyPrefix + "tenant-apiserver-access"
+)
+
+// TenantAPIServerConfiguration contains the information needed to render the API server of a tenant in a
+// multi-tenant management cluster. The tenant API server runs the query server only, since the aggregation API
+// server serves the cluster-wide projectcalico.org/v3 API and is rendered by the APIServer component.
+type TenantAPIServerConfiguration struct {
+	Tenant             *operatorv1.Tenant
+	Installation       *operatorv1.InstallationSpec
+	APIServer          *operatorv1.APIServerSpec
+	K8SServiceEndpoint k8sapi.ServiceEndpoint
+	TLSKeyPair         certificatemanagement.KeyPairInterface
+	TrustedBundle      certificatemanagement.TrustedBundleRO
+	PullSecrets        []*corev1.Secret
+	KeyValidatorConfig authentication.KeyValidatorConfig
+	ClusterDomain      string
+	OpenShift          bool
+}
+
+// TenantAPIServer renders the API server of a tenant in the tenant's namespace. If the tenant does not run its own
+// API server, its objects are deleted instead.
+func TenantAPIServer(cfg *TenantAPIServerConfiguration) Component {
+	return &tenantAPIServerComponent{cfg: cfg}
+}
+
+type tenantAPIServerComponent struct {
+	cfg              *TenantAPIServerConfiguration
+	queryServerImage string
+}
+
+func (c *tenantAPIServerComponent) ResolveImages(is *operatorv1.ImageSet) error {
+	reg := c.cfg.Installation.Registry
+	path := c.cfg.Installation.ImagePath
+	prefix := c.cfg.Installation.ImagePrefix
+	var err error
+	c.queryServerImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
+	return err
+}
+
+func (c *tenantAPIServerComponent) SupportedOSType() rmeta.OSType {
+	return rmeta.OSTypeLinux
+}
+
+func (c *tenantAPIServerComponent) Objects() ([]client.Object, []client.Object) {
+	namespaced := []client.Object{
+		c.serviceAccount(),
+		c.role(),
+		c.roleBinding(),
+		c.managedClustersAccessRoleBinding(),
+		c.deployment(),
+		c.service(),
+	}
+	if !c.cfg.Tenant.APIServerEnabled() {
+		return nil, namespaced
+	}
+	toCreate := secret.ToRuntimeObjects(secret.CopyToNamespace(c.cfg.Tenant.Namespace, c.cfg.PullSecrets...)...)
+	return append(toCreate, namespaced...), nil
+}
+
+func (c *tenantAPIServerComponent) Ready() bool {
+	return true
+}
+
+// containerPort returns the port the query server listens on, which may be overridden in the tenant's deployment.
+func (c *tenantAPIServerComponent) containerPort() int32 {
+	cfg := &APIServerConfiguration{APIServer: &operatorv1.APIServerSpec{APIServerDeployment: c.cfg.Tenant.Spec.APIServerDeployment}}
+	return getContainerPort(cfg, TigeraAPIServerQueryServerContainerName).ContainerPort
+}
+
+// TenantAPIServerClusterRBAC renders the ClusterRole and ClusterRoleBinding shared by the tenant API servers, binding
+// the service accounts in the given namespaces of the tenants that run their own API server. Both are deleted once no
+// tenant runs its own API server.
+func TenantAPIServerClusterRBAC(bindingNamespaces []string) Component {
+	return &tenantAPIServerClusterRBACComponent{bindingNamespaces: bindingNamespaces}
+}
+
+type tenantAPIServerClusterRBACComponent struct {
+	bindingNamespaces []string
+}
+
+func (c *tenantAPIServerClusterRBACComponent) ResolveImages(is *operatorv1.ImageSet) error {
+	return nil
+}
+
+func (c *tenantAPIServerClusterRBACComponent) SupportedOSType() rmeta.OSType {
+	return rmeta.OSTypeAny
+}
+
+func (c *tenantAPIServerClusterRBACComponent) Objects() ([]client.Object, []client.Object) {
+	objs := []client.Object{c.clusterRole(), c.clusterRoleBinding()}
+	if len(c.bindingNamespaces) == 0 {
+		return nil, objs
+	}
+	return objs, nil
+}
+
+func (c *tenantAPIServerClusterRBACComponent) Ready() bool {
+	return true
+}
+
+func (c *tenantAPIServerComponent) serviceAccount() *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: APIServerServiceAccountName, Namespace: c.cfg.Tenant.Namespace},
+	}
+}
+
+// clusterRole grants the tenant API servers the read access to cluster-scoped resources that the query server needs
+// to evaluate the permissions of users. Unlike the cluster API server, tenant API servers cannot modify Calico
+// resources.
+func (c *tenantAPIServerClusterRBACComponent) clusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: TenantAPIServerClusterRoleName},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{"projectcalico.org"},
+				Resources: []string{"tiers", "uisettingsgroups"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"rbac.authorization.k8s.io"},
+				Resources: []string{"clusterroles", "clusterrolebindings"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+		},
+	}
+}
+
+func (c *tenantAPIServerClusterRBACComponent) clusterRoleBinding() *rbacv1.ClusterRoleBinding {
+	return rcomp.ClusterRoleBinding(TenantAPIServerClusterRoleName, TenantAPIServerClusterRoleName, APIServerServiceAccountName, c.bindingNamespaces)
+}
+
+// role grants the API server of a tenant read access to the namespaced resources of the tenant only, so that it cannot
+// read the managed clusters or RBAC of other tenants.
+func (c *tenantAPIServerComponent) role() *rbacv1.Role {
+	return &rbacv1.Role{
+		TypeMeta:   metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: TenantAPIServerRoleName, Namespace: c.cfg.Tenant.Namespace},
+		Rules: []rbacv1.PolicyRule{
+			{
+				// Read access to Linseed policy activity data for queryserver enrichment.
+				APIGroups: []string{"linseed.tigera.io"},
+				Resources: []string{"policyactivity"},
+				Verbs:     []string{"get"},
+			},
+			{
+				APIGroups: []string{"projectcalico.org"},
+				Resources: []string{"managedclusters"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"rbac.authorization.k8s.io"},
+				Resources: []string{"roles", "rolebindings"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+		},
+	}
+}
+
+func (c *tenantAPIServerComponent) roleBinding() *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		TypeMeta:   metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: TenantAPIServerRoleName, Namespace: c.cfg.Tenant.Namespace},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "Role",
+			Name:     TenantAPIServerRoleName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      APIServerServiceAccountName,
+				Namespace: c.cfg.Tenant.Namespace,
+			},
+		},
+	}
+}
+
+// managedClustersAccessRoleBinding allows the tenant API server to impersonate the canonical service account in the
+// tenant's managed clusters.
+func (c *tenantAPIServerComponent) managedClustersAccessRoleBinding() *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		TypeMeta:   metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: TenantAPIServerManagedClustersAccessRoleBindingName, Namespace: c.cfg.Tenant.Namespace},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     MultiTenantManagedClustersAccessClusterRoleName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      APIServerServiceAccountName,
+				Namespace: c.cfg.Tenant.Namespace,
+			},
+		},
+	}
+}
+
+func (c *tenantAPIServerComponent) deployment() *appsv1.Deployment {
+	labels := map[string]string{"k8s-app": APIServerName}
+	d := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: APIServerName, Namespace: c.cfg.Tenant.Namespace},
+	}
+	if !c.cfg.Tenant.APIServerEnabled() {
+		return d
+	}
+
+	annotations := c.cfg.TrustedBundle.HashAnnotations()
+	annotations[c.cfg.TLSKeyPair.HashAnnotationKey()] = c.cfg.TLSKeyPair.HashAnnotationValue()
+
+	replicas := c.cfg.Installation.ControlPlaneReplicas
+	if c.cfg.Tenant.Spec.ControlPlaneReplicas != nil {
+		replicas = c.cfg.Tenant.Spec.ControlPlaneReplicas
+	}
+
+	var initContainers []corev1.Container
+	if c.cfg.TLSKeyPair.UseCertificateManagement() {
+		initContainers = append(initContainers, c.cfg.TLSKeyPair.InitContainer(c.cfg.Tenant.Namespace, securitycontext.NewNonRootContext()))
+	}
+
+	d.Spec = appsv1.DeploymentSpec{
+		Replicas: replicas,
+		Selector: &metav1.LabelSelector{MatchLabels: labels},
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      labels,
+				Annotations: annotations,
+			},
+			Spec: corev1.PodSpec{
+				NodeSelector:       c.cfg.Installation.ControlPlaneNodeSelector,
+				ServiceAccountName: APIServerServiceAccountName,
+				Tolerations:        append(c.cfg.Installation.ControlPlaneTolerations, rmeta.TolerateControlPlane...),
+				ImagePullSecrets:   secret.GetReferenceList(c.cfg.PullSecrets),
+				InitContainers:     initContainers,
+				Containers:         []corev1.Container{c.queryServerContainer()},
+				Volumes:            []corev1.Volume{c.cfg.TLSKeyPair.Volume(), c.cfg.TrustedBundle.Volume()},
+			},
+		},
+	}
+	if c.cfg.Installation.ManagedPriorityClassesEnabled() {
+		d.Spec.Template.Spec.PriorityClassName = ControlPlanePriorityClassName
+	}
+
+	rcomp.ApplyDeploymentOverrides(d, c.cfg.Tenant.Spec.APIServerDeployment)
+	return d
+}
+
+func (c *tenantAPIServerComponent) queryServerContainer() corev1.Container {
+	port := c.containerPort()
+	keyPair := c.cfg.TLSKeyPair
+
+	env := []corev1.EnvVar{
+		{Name: "LISTEN_ADDR", Value: fmt.Sprintf(":%d", port)},
+		{Name: "TLS_CERT", Value: keyPair.VolumeMountCertificateFilePath()},
+		{Name: "TLS_KEY", Value: keyPair.VolumeMountKeyFilePath()},
+		{Name: "TRUSTED_BUNDLE_PATH", Value: c.cfg.TrustedBundle.MountPath()},
+		{Name: "TENANT_ID", Value: c.cfg.Tenant.Spec.ID},
+		{Name: "TENANT_NAMESPACE", Value: c.cfg.Tenant.Namespace},
+	}
+	env = append(env, c.cfg.K8SServiceEndpoint.EnvVars()...)
+	if c.cfg.KeyValidatorConfig != nil {
+		env = append(env, c.cfg.KeyValidatorConfig.RequiredEnv("")...)
+	}
+	env = append(env, c.cfg.Installation.Proxy.EnvVars()...)
+	env = append(env,
+		corev1.EnvVar{Name: "LINSEED_URL", Value: relasticsearch.LinseedEndpoint(c.SupportedOSType(), c.cfg.ClusterDomain, LinseedNamespace(c.cfg.Tenant), false, false)},
+		corev1.EnvVar{Name: "LINSEED_CLIENT_CERT", Value: keyPair.VolumeMountCertificateFilePath()},
+		corev1.EnvVar{Name: "LINSEED_CLIENT_KEY", Value: keyPair.VolumeMountKeyFilePath()},
+		corev1.EnvVar{Name: "LINSEED_CA", Value: c.cfg.TrustedBundle.MountPath()},
+	)
+	env = append(env, queryServerSettingsEnvVars(c.cfg.APIServer)...)
+
+	return corev1.Container{
+		Name:    string(TigeraAPIServerQueryServerContainerName),
+		Image:   c.queryServerImage,
+		Command: []string{components.CalicoBinaryPath, "component", "queryserver"},
+		Env:     env,
+		LivenessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/version",
+					Port:   intstr.FromInt32(port),
+					Scheme: corev1.URISchemeHTTPS,
+				},
+			},
+			InitialDelaySeconds: 90,
+		},
+		SecurityContext: securitycontext.NewNonRootContext(),
+		VolumeMounts:    append([]corev1.VolumeMount{keyPair.VolumeMount(c.SupportedOSType())}, c.cfg.TrustedBundle.VolumeMounts(c.SupportedOSType())...),
+	}
+}
+
+func (c *tenantAPIServerComponent) service() *corev1.Service {
+	s := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      QueryserverServiceName,
+			Namespace: c.cfg.Tenant.Namespace,
+			Labels:    map[string]string{"k8s-app": QueryserverServiceName},
+		},
+	}
+	if !c.cfg.Tenant.APIServerEnabled() {
+		return s
+	}
+	s.Spec = corev1.ServiceSpec{
+		Ports: []corev1.ServicePort{
+			{
+				Name:       QueryServerPortName,
+				Port:       QueryServerPort,
+				Protocol:   corev1.ProtocolTCP,
+				TargetPort: intstr.FromInt32(c.containerPort()),
+			},
+		},
+		Selector: map[string]string{"k8s-app": APIServerName},
+	}
+	setServiceIPFamilies(s, c.cfg.Installation)
+	return s
+}
+
+// TenantAPIServerPolicy renders the policy of a tenant API server, which only accepts connections from the tenant's
+// manager.
+func TenantAPIServerPolicy(cfg *TenantAPIServerConfiguration) Component {
+	policy := tenantAPIServerPolicy(cfg)
+	if !cfg.Tenant.APIServerEnabled() {
+		return NewDeletionPassthrough(policy)
+	}
+	return NewPassthrough([]client.Object{policy}, nil)
+}
+
+func tenantAPIServerPolicy(cfg *TenantAPIServerConfiguration) *v3.NetworkPolicy {
+	policy := &v3.NetworkPolicy{
+		TypeMeta:   metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"},
+		ObjectMeta: metav1.ObjectMeta{Name: TenantAPIServerPolicyName, Namespace: cfg.Tenant.Namespace},
+	}
+	if !cfg.Tenant.APIServerEnabled() {
+		return policy
+	}
+
+	helper := networkpolicy.Helper(true, cfg.Tenant.Namespace)
+	egressRules := networkpolicy.AppendDNSEgressRules([]v3.Rule{}, cfg.OpenShift)
+	egressRules = append(egressRules,
+		v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: networkpolicy.KubeAPIServerEntityRule,
+		},
+		v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: helper.LinseedEntityRule(),
+		},
+	)
+	if cfg.KeyValidatorConfig != nil {
+		if parsedURL, err := url.Parse(cfg.KeyValidatorConfig.Issuer()); err == nil {
+			egressRules = append(egressRules, networkpolicy.GetOIDCEgressRule(parsedURL))
+		}
+	}
+	if r, err := cfg.K8SServiceEndpoint.DestinationEntityRule(); r != nil && err == nil {
+		egressRules = append(egressRules, v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: *r,
+		})
+	}
+
+	port := (&tenantAPIServerComponent{cfg: cfg}).containerPort()
+	policy.Spec = v3.NetworkPolicySpec{
+		Order:    &networkpolicy.HighPrecedenceOrder,
+		Tier:     networkpolicy.CalicoTierName,
+		Selector: networkpolicy.KubernetesAppSelector(APIServerName),
+		Types:    []v3.PolicyType{v3.PolicyTypeIngress, v3.PolicyTypeEgress},
+		Ingress: []v3.Rule{
+			{
+				Action:      v3.Allow,
+				Protocol:    &networkpolicy.TCPProtocol,
+				Source:      helper.ManagerSourceEntityRule(),
+				Destination: v3.EntityRule{Ports: networkpolicy.Ports(uint16(port))},
+			},
+		},
+		Egress: egressRules,
+	}
+	return policy
+}
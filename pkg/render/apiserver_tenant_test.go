@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/certificatemanager"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/dns"
+	"github.com/tigera/operator/pkg/render"
+	rtest "github.com/tigera/operator/pkg/render/common/test"
+)
+
+var _ = Describe("Tenant API server rendering tests", func() {
+	const tenantNamespace = "tenant-a"
+	var cfg *render.TenantAPIServerConfiguration
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme, false)).NotTo(HaveOccurred())
+		cli := ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		certificateManager, err := certificatemanager.Create(cli, nil, dns.DefaultClusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+		Expect(err).NotTo(HaveOccurred())
+		keyPair, err := certificateManager.GetOrCreateKeyPair(cli, render.TenantAPIServerTLSSecretName, tenantNamespace, []string{"calico-api"})
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg = &render.TenantAPIServerConfiguration{
+			Tenant: &operatorv1.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: tenantNamespace},
+				Spec: operatorv1.TenantSpec{
+					ID:                   "tenant-a-id",
+					ControlPlaneReplicas: ptr.To[int32](2),
+					APIServerDeployment:  &operatorv1.APIServerDeployment{},
+				},
+			},
+			Installation:  &operatorv1.InstallationSpec{Variant: operatorv1.CalicoEnterprise, Registry: "testregistry.com/"},
+			APIServer:     &operatorv1.APIServerSpec{},
+			TLSKeyPair:    keyPair,
+			TrustedBundle: certificateManager.CreateTrustedBundle(),
+			ClusterDomain: dns.DefaultClusterDomain,
+		}
+	})
+
+	It("should render the query server of a tenant in its namespace", func() {
+		component := render.TenantAPIServer(cfg)
+		Expect(component.ResolveImages(nil)).To(Succeed())
+		toCreate, toDelete := component.Objects()
+		Expect(toDelete).To(BeEmpty())
+
+		rtest.ExpectResources(toCreate, []client.Object{
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver", Namespace: tenantNamespace}, TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"}},
+			&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: render.TenantAPIServerRoleName, Namespace: tenantNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: render.TenantAPIServerRoleName, Namespace: tenantNamespace}, TypeMeta: metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: render.TenantAPIServerManagedClustersAccessRoleBindingName, Namespace: tenantNamespace}, TypeMeta: metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver", Namespace: tenantNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "calico-api", Namespace: tenantNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
+		})
+
+		By("only granting access to the namespaced resources of the tenant itself")
+		role := rtest.GetResource(toCreate, render.TenantAPIServerRoleName, tenantNamespace, "rbac.authorization.k8s.io", "v1", "Role").(*rbacv1.Role)
+		Expect(role.Rules).To(ContainElement(rbacv1.PolicyRule{
+			APIGroups: []string{"projectcalico.org"},
+			Resources: []string{"managedclusters"},
+			Verbs:     []string{"get", "list", "watch"},
+		}))
+		rb := rtest.GetResource(toCreate, render.TenantAPIServerRoleName, tenantNamespace, "rbac.authorization.k8s.io", "v1", "RoleBinding").(*rbacv1.RoleBinding)
+		Expect(rb.Subjects).To(ConsistOf(rbacv1.Subject{Kind: "ServiceAccount", Name: "calico-apiserver", Namespace: tenantNamespace}))
+
+		d := rtest.GetResource(toCreate, "calico-apiserver", tenantNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(*d.Spec.Replicas).To(Equal(int32(2)))
+		Expect(d.Spec.Template.Spec.ServiceAccountName).To(Equal("calico-apiserver"))
+		Expect(d.Spec.Template.Spec.Containers).To(HaveLen(1))
+		c := d.Spec.Template.Spec.Containers[0]
+		Expect(c.Name).To(Equal("tigera-queryserver"))
+		Expect(c.Env).To(ContainElements(
+			corev1.EnvVar{Name: "LISTEN_ADDR", Value: ":8080"},
+			corev1.EnvVar{Name: "TENANT_ID", Value: "tenant-a-id"},
+			corev1.EnvVar{Name: "TENANT_NAMESPACE", Value: tenantNamespace},
+			corev1.EnvVar{Name: "LINSEED_URL", Value: "https://tigera-linseed.tenant-a.svc"},
+			corev1.EnvVar{Name: "TLS_CERT", Value: "/calico-apiserver-tenant-tls/tls.crt"},
+		))
+		Expect(d.Spec.Template.Annotations).To(HaveKey("tenant-a.hash.operator.tigera.io/calico-apiserver-tenant-tls"))
+
+		svc := rtest.GetResource(toCreate, "calico-api", tenantNamespace, "", "v1", "Service").(*corev1.Service)
+		Expect(svc.Spec.Selector).To(Equal(map[string]string{"k8s-app": "calico-apiserver"}))
+		Expect(svc.Spec.Ports).To(HaveLen(1))
+		Expect(svc.Spec.Ports[0].Port).To(Equal(int32(render.QueryServerPort)))
+	})
+
+	It("should apply the tenant's deployment overrides to the query server", func() {
+		cfg.Tenant.Spec.APIServerDeployment = &operatorv1.APIServerDeployment{
+			Spec: &operatorv1.APIServerDeploymentSpec{
+				Template: &operatorv1.APIServerDeploymentPodTemplateSpec{
+					Spec: &operatorv1.APIServerDeploymentPodSpec{
+						NodeSelector: map[string]string{"tenant": "a"},
+					},
+				},
+			},
+		}
+		toCreate, _ := render.TenantAPIServer(cfg).Objects()
+		d := rtest.GetResource(toCreate, "calico-apiserver", tenantNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"tenant": "a"}))
+	})
+
+	It("should delete the query server of a tenant without an API server deployment", func() {
+		cfg.Tenant.Spec.APIServerDeployment = nil
+		cfg.TLSKeyPair = nil
+		cfg.TrustedBundle = nil
+		toCreate, toDelete := render.TenantAPIServer(cfg).Objects()
+
+		Expect(toCreate).To(BeEmpty())
+		rtest.ExpectResources(toDelete, []client.Object{
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver", Namespace: tenantNamespace}, TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"}},
+			&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: render.TenantAPIServerRoleName, Namespace: tenantNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: render.TenantAPIServerRoleName, Namespace: tenantNamespace}, TypeMeta: metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: render.TenantAPIServerManagedClustersAccessRoleBindingName, Namespace: tenantNamespace}, TypeMeta: metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver", Namespace: tenantNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "calico-api", Namespace: tenantNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
+		})
+	})
+
+	It("should bind the shared ClusterRole to the API servers of the tenants that run one", func() {
+		toCreate, toDelete := render.TenantAPIServerClusterRBAC([]string{tenantNamespace, "tenant-b"}).Objects()
+		Expect(toDelete).To(BeEmpty())
+
+		crb := rtest.GetResource(toCreate, render.TenantAPIServerClusterRoleName, "", "rbac.authorization.k8s.io", "v1", "ClusterRoleBinding").(*rbacv1.ClusterRoleBinding)
+		Expect(crb.Subjects).To(ConsistOf(
+			rbacv1.Subject{Kind: "ServiceAccount", Name: "calico-apiserver", Namespace: tenantNamespace},
+			rbacv1.Subject{Kind: "ServiceAccount", Name: "calico-apiserver", Namespace: "tenant-b"},
+		))
+		cr := rtest.GetResource(toCreate, render.TenantAPIServerClusterRoleName, "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole)
+		for _, rule := range cr.Rules {
+			Expect(rule.Resources).NotTo(ContainElement(BeElementOf("managedclusters", "roles", "rolebindings", "policyactivity")))
+		}
+
+		By("deleting them once no tenant runs its own API server")
+		toCreate, toDelete = render.TenantAPIServerClusterRBAC(nil).Objects()
+		Expect(toCreate).To(BeEmpty())
+		rtest.ExpectResources(toDelete, []client.Object{
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: render.TenantAPIServerClusterRoleName}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: render.TenantAPIServerClusterRoleName}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
+		})
+	})
+
+	It("should only allow the tenant's manager to reach the query server", func() {
+		toCreate, _ := render.TenantAPIServerPolicy(cfg).Objects()
+		policy := rtest.GetResource(toCreate, render.TenantAPIServerPolicyName, tenantNamespace, "projectcalico.org", "v3", "NetworkPolicy").(*v3.NetworkPolicy)
+		Expect(policy.Spec.Ingress).To(HaveLen(1))
+		Expect(policy.Spec.Ingress[0].Source.NamespaceSelector).To(ContainSubstring(tenantNamespace))
+		Expect(policy.Spec.Ingress[0].Source.Selector).To(ContainSubstring(render.ManagerDeploymentName))
+	})
+})
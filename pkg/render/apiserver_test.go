@@ -19,6 +19,8 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"time"
 
@@ -26,9 +28,12 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gstruct"
 
+	routev1 "github.com/openshift/api/route/v1"
 	"github.com/openshift/library-go/pkg/crypto"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
 	calicov3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	"github.com/tigera/api/pkg/lib/numorstring"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/apis"
@@ -39,6 +44,7 @@ import (
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
 	"github.com/tigera/operator/pkg/dns"
 	"github.com/tigera/operator/pkg/render"
+	tigerakvc "github.com/tigera/operator/pkg/render/common/authentication/tigera/key_validator_config"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 	"github.com/tigera/operator/pkg/render/common/networkpolicy"
 	"github.com/tigera/operator/pkg/render/common/podaffinity"
@@ -49,15 +55,19 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	apiregv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gapi "sigs.k8s.io/gateway-api/apis/v1"
+	gapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
 var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
@@ -136,7 +146,9 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 		}
@@ -330,9 +342,21 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 		Expect(d.Spec.Template.Spec.Volumes[3].ConfigMap.Name).To(Equal("tigera-ca-bundle"))
 
 		clusterRole := rtest.GetResource(resources, "tigera-network-admin", "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole)
+		Expect(clusterRole.Rules).To(BeEmpty())
+		Expect(clusterRole.AggregationRule.ClusterRoleSelectors).To(ConsistOf(metav1.LabelSelector{
+			MatchLabels: map[string]string{"rbac.tigera.io/aggregate-to-tigera-network-admin": "true"},
+		}))
+		clusterRole = rtest.GetResource(resources, "tigera-network-admin-default", "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole)
+		Expect(clusterRole.Labels).To(HaveKeyWithValue("rbac.tigera.io/aggregate-to-tigera-network-admin", "true"))
 		Expect(clusterRole.Rules).To(ConsistOf(networkAdminPolicyRules))
 
 		clusterRole = rtest.GetResource(resources, "tigera-ui-user", "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole)
+		Expect(clusterRole.Rules).To(BeEmpty())
+		Expect(clusterRole.AggregationRule.ClusterRoleSelectors).To(ConsistOf(metav1.LabelSelector{
+			MatchLabels: map[string]string{"rbac.tigera.io/aggregate-to-tigera-ui-user": "true"},
+		}))
+		clusterRole = rtest.GetResource(resources, "tigera-ui-user-default", "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole)
+		Expect(clusterRole.Labels).To(HaveKeyWithValue("rbac.tigera.io/aggregate-to-tigera-ui-user", "true"))
 		Expect(clusterRole.Rules).To(ConsistOf(uiUserPolicyRules))
 
 		clusterRoleBinding := rtest.GetResource(resources, "calico-extension-apiserver-auth-access", "", "rbac.authorization.k8s.io", "v1", "ClusterRoleBinding").(*rbacv1.ClusterRoleBinding)
@@ -405,7 +429,9 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "calico-api", Namespace: "calico-system"}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
 			&policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver", Namespace: "calico-system"}, TypeMeta: metav1.TypeMeta{Kind: "PodDisruptionBudget", APIVersion: "policy/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 		}
@@ -448,6 +474,172 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 		Expect(servicePort.TargetPort.IntValue()).To(Equal(6443))
 	})
 
+	It("should render the proxy configuration when provided", func() {
+		cfg.Installation.Proxy = &operatorv1.Proxy{
+			HTTPSProxy: "https://proxy.example.com:3128",
+			NoProxy:    ".svc,10.96.0.0/12",
+		}
+		component, err := render.APIServer(cfg)
+		Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+		resources, _ := component.Objects()
+
+		deploy, ok := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(ok).To(BeTrue())
+		Expect(deploy.Spec.Template.Spec.Containers).To(HaveLen(2))
+		for _, container := range deploy.Spec.Template.Spec.Containers {
+			Expect(container.Env).To(ContainElements(
+				corev1.EnvVar{Name: "HTTPS_PROXY", Value: "https://proxy.example.com:3128"},
+				corev1.EnvVar{Name: "https_proxy", Value: "https://proxy.example.com:3128"},
+				corev1.EnvVar{Name: "NO_PROXY", Value: ".svc,10.96.0.0/12"},
+				corev1.EnvVar{Name: "no_proxy", Value: ".svc,10.96.0.0/12"},
+			), container.Name)
+			Expect(container.Env).NotTo(ContainElement(HaveField("Name", "HTTP_PROXY")), container.Name)
+		}
+	})
+
+	It("should render the PodDisruptionBudget overrides", func() {
+		cfg.APIServer.APIServerDeployment = &operatorv1.APIServerDeployment{
+			Spec: &operatorv1.APIServerDeploymentSpec{
+				PodDisruptionBudget: &operatorv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromInt(0))},
+			},
+		}
+		component, err := render.APIServer(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		resources, _ := component.Objects()
+
+		pdb := rtest.GetResource(resources, "calico-apiserver", "calico-system", "policy", "v1", "PodDisruptionBudget").(*policyv1.PodDisruptionBudget)
+		Expect(pdb.Spec.MaxUnavailable).To(Equal(ptr.To(intstr.FromInt(0))))
+		Expect(pdb.Spec.MinAvailable).To(BeNil())
+	})
+
+	It("should delete the PodDisruptionBudget when PodDisruptionBudgets are disabled", func() {
+		cfg.Installation.PodDisruptionBudgets = ptr.To(operatorv1.PodDisruptionBudgetsDisabled)
+		component, err := render.APIServer(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		resources, toDelete := component.Objects()
+
+		Expect(rtest.GetResource(resources, "calico-apiserver", "calico-system", "policy", "v1", "PodDisruptionBudget")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, "calico-apiserver", "calico-system", "policy", "v1", "PodDisruptionBudget")).NotTo(BeNil())
+	})
+
+	It("should apply the TLS security profile to the API server and the query server", func() {
+		cfg.Installation.Variant = operatorv1.CalicoEnterprise
+		cfg.Installation.TLSSecurityProfile = &operatorv1.TLSSecurityProfile{
+			Type: operatorv1.TLSProfileCustomType,
+			Custom: &operatorv1.CustomTLSProfile{
+				MinTLSVersion: operatorv1.VersionTLS13,
+				Ciphers:       []operatorv1.TLSCipher{operatorv1.TLS_AES_256_GCM_SHA384, operatorv1.TLS_AES_128_GCM_SHA256},
+			},
+		}
+		component, err := render.APIServer(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+			"--tls-min-version=VersionTLS13",
+			"--tls-cipher-suites=TLS_AES_256_GCM_SHA384,TLS_AES_128_GCM_SHA256",
+		))
+		Expect(d.Spec.Template.Spec.Containers[1].Env).To(ContainElements(
+			corev1.EnvVar{Name: "TLS_MIN_VERSION", Value: "1.3"},
+			corev1.EnvVar{Name: "TLS_CIPHER_SUITES", Value: "TLS_AES_256_GCM_SHA384,TLS_AES_128_GCM_SHA256"},
+		))
+	})
+
+	Context("audit logs", func() {
+		getAuditLogsVolume := func(d *appsv1.Deployment) *corev1.Volume {
+			for i := range d.Spec.Template.Spec.Volumes {
+				if d.Spec.Template.Spec.Volumes[i].Name == "calico-audit-logs" {
+					return &d.Spec.Template.Spec.Volumes[i]
+				}
+			}
+			return nil
+		}
+
+		It("should write audit logs to an emptyDir volume with the configured rotation", func() {
+			cfg.APIServer.AuditLogs = &operatorv1.APIServerAuditLogs{
+				Storage:   ptr.To(operatorv1.APIServerAuditLogStorageEmptyDir),
+				MaxAge:    ptr.To(int32(7)),
+				MaxBackup: ptr.To(int32(3)),
+				MaxSize:   ptr.To(int32(100)),
+			}
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			volume := getAuditLogsVolume(d)
+			Expect(volume).NotTo(BeNil())
+			Expect(volume.VolumeSource).To(Equal(corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}))
+			Expect(d.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+				"--audit-log-path=/var/log/calico/audit/tsee-audit.log",
+				"--audit-log-maxage=7",
+				"--audit-log-maxbackup=3",
+				"--audit-log-maxsize=100",
+			))
+		})
+
+		It("should write audit logs to the configured PersistentVolumeClaim", func() {
+			cfg.APIServer.AuditLogs = &operatorv1.APIServerAuditLogs{
+				Storage:                   ptr.To(operatorv1.APIServerAuditLogStoragePersistentVolumeClaim),
+				PersistentVolumeClaimName: "apiserver-audit-logs",
+			}
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			volume := getAuditLogsVolume(d)
+			Expect(volume).NotTo(BeNil())
+			Expect(volume.VolumeSource.HostPath).To(BeNil())
+			Expect(volume.VolumeSource.PersistentVolumeClaim).To(Equal(&corev1.PersistentVolumeClaimVolumeSource{ClaimName: "apiserver-audit-logs"}))
+			Expect(d.Spec.Template.Spec.Containers[0].Args).NotTo(ContainElement(HavePrefix("--audit-log-max")))
+
+			// The replicas share the claim, so each writes to its own directory.
+			Expect(d.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--audit-log-path=/var/log/calico/audit/tsee-audit.log"))
+			Expect(d.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:        "calico-audit-logs",
+				MountPath:   "/var/log/calico/audit",
+				SubPathExpr: "$(POD_NAME)",
+			}))
+			Expect(d.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+				Name:      "POD_NAME",
+				ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}},
+			}))
+		})
+
+		It("should send audit logs to the configured webhook", func() {
+			cfg.APIServer.AuditLogs = &operatorv1.APIServerAuditLogs{Storage: ptr.To(operatorv1.APIServerAuditLogStorageWebhook)}
+			cfg.AuditWebhookSecret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver-audit-webhook", Namespace: "tigera-operator"},
+				Data:       map[string][]byte{"config": []byte("kubeconfig")},
+			}
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, toDelete := component.Objects()
+
+			s := rtest.GetResource(resources, "calico-apiserver-audit-webhook", "calico-system", "", "v1", "Secret").(*corev1.Secret)
+			Expect(s.Data).To(Equal(map[string][]byte{"config": []byte("kubeconfig")}))
+			Expect(rtest.GetResource(toDelete, "calico-apiserver-audit-webhook", "calico-system", "", "v1", "Secret")).To(BeNil())
+
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(getAuditLogsVolume(d)).To(BeNil())
+			Expect(d.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name:         "calico-audit-webhook",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "calico-apiserver-audit-webhook"}},
+			}))
+			Expect(d.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name: "calico-audit-webhook", MountPath: "/etc/tigera/audit-webhook", ReadOnly: true,
+			}))
+			Expect(d.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+				"--audit-policy-file=/etc/tigera/audit/policy.conf",
+				"--audit-webhook-config-file=/etc/tigera/audit-webhook/config",
+			))
+			Expect(d.Spec.Template.Spec.Containers[0].Args).NotTo(ContainElement(HavePrefix("--audit-log-path")))
+			Expect(d.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/audit-webhook"))
+		})
+	})
+
 	It("should render log severity when provided", func() {
 		errorLog := operatorv1.LogSeverityError
 		debugLog := operatorv1.LogSeverityDebug
@@ -487,6 +679,50 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 		Expect(deploy.Spec.Template.Spec.Affinity).To(Equal(podaffinity.NewPodAntiAffinity("calico-apiserver", []string{"calico-system", "tigera-system", "calico-apiserver"})))
 	})
 
+	It("should render the query server cache and pagination tuning when provided", func() {
+		cfg.APIServer.QueryServer = &operatorv1.APIServerQueryServer{
+			CacheRefreshInterval: &metav1.Duration{Duration: 2 * time.Minute},
+			MaxPageSize:          ptr.To[int32](500),
+			Workers:              ptr.To[int32](8),
+		}
+		component, err := render.APIServer(cfg)
+		Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+		resources, _ := component.Objects()
+
+		deploy := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		queryserver := test.GetContainer(deploy.Spec.Template.Spec.Containers, "tigera-queryserver")
+		Expect(queryserver).NotTo(BeNil())
+		Expect(queryserver.Env).To(ContainElements(
+			corev1.EnvVar{Name: "CACHE_REFRESH_INTERVAL", Value: "2m0s"},
+			corev1.EnvVar{Name: "MAX_PAGE_SIZE", Value: "500"},
+			corev1.EnvVar{Name: "WORKERS", Value: "8"},
+		))
+	})
+
+	It("should render the auth reader RoleBinding in the configured delegated auth namespace", func() {
+		cfg.APIServer.DelegatedAuth = &operatorv1.APIServerDelegatedAuth{Namespace: "host-system"}
+		component, err := render.APIServer(cfg)
+		Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+		toCreate, toDelete := component.Objects()
+
+		Expect(rtest.GetResource(toCreate, "calico-apiserver-auth-reader", "host-system", "rbac.authorization.k8s.io", "v1", "RoleBinding")).NotTo(BeNil())
+		Expect(rtest.GetResource(toCreate, "calico-apiserver-auth-reader", "kube-system", "rbac.authorization.k8s.io", "v1", "RoleBinding")).NotTo(BeNil())
+		Expect(rtest.GetResource(toDelete, "calico-apiserver-auth-reader", "kube-system", "rbac.authorization.k8s.io", "v1", "RoleBinding")).To(BeNil())
+		Expect(rtest.GetResource(toCreate, "calico-apiserver-delegate-auth", "", "rbac.authorization.k8s.io", "v1", "ClusterRoleBinding")).NotTo(BeNil())
+	})
+
+	It("should leave the delegated auth RBAC alone when it is unmanaged", func() {
+		cfg.APIServer.DelegatedAuth = &operatorv1.APIServerDelegatedAuth{Management: ptr.To(operatorv1.DelegatedAuthUnmanaged)}
+		component, err := render.APIServer(cfg)
+		Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+		toCreate, toDelete := component.Objects()
+
+		for _, objs := range [][]client.Object{toCreate, toDelete} {
+			Expect(rtest.GetResource(objs, "calico-apiserver-auth-reader", "kube-system", "rbac.authorization.k8s.io", "v1", "RoleBinding")).To(BeNil())
+			Expect(rtest.GetResource(objs, "calico-apiserver-delegate-auth", "", "rbac.authorization.k8s.io", "v1", "ClusterRoleBinding")).To(BeNil())
+		}
+	})
+
 	It("should render SecurityContextConstrains properly when provider is OpenShift", func() {
 		cfg.Installation.KubernetesProvider = operatorv1.ProviderOpenShift
 		cfg.Installation.Variant = operatorv1.CalicoEnterprise
@@ -535,7 +771,9 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 		}
@@ -580,7 +818,9 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 		}
@@ -648,7 +888,9 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 		}
@@ -709,7 +951,9 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 		}
@@ -877,7 +1121,9 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: render.ManagedClustersWatchClusterRoleName}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
@@ -943,7 +1189,9 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user-default"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin-default"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: render.ManagedClustersWatchClusterRoleName}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-webhook-reader"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver-webhook-reader"}},
@@ -1041,7 +1289,9 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-uisettingsgroup-getter"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-ui-user-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-network-admin-default"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "calico-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
 			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "calico-apiserver-webhook-reader"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 		}
@@ -1124,6 +1374,551 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 		Expect(tokenVol.Secret.SecretName).To(Equal("calico-apiserver-tigera-linseed-token"))
 	})
 
+	It("should render the FIPS images for the API server and query server when FIPS mode is enabled", func() {
+		cfg.Installation.FIPSMode = ptr.To(operatorv1.FIPSModeEnabled)
+
+		component, err := render.APIServer(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.Containers).To(HaveLen(2))
+		for _, c := range d.Spec.Template.Spec.Containers {
+			Expect(c.Image).To(Equal(
+				fmt.Sprintf("testregistry.com/%s%s:%s", components.TigeraImagePath, components.ComponentTigeraCalicoFIPS.Image, components.ComponentTigeraCalicoFIPS.Version),
+			))
+		}
+	})
+
+	Context("query server Gateway", func() {
+		BeforeEach(func() {
+			apiserver.QueryServer = &operatorv1.APIServerQueryServer{
+				Gateway: &operatorv1.QueryServerGateway{GatewayClassName: "example", Hostname: "calico-api.example.com"},
+			}
+			cfg.GatewayAPICRDExists = true
+		})
+
+		It("should render a TLS passthrough Gateway and TLSRoute for the query server", func() {
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			gw, ok := rtest.GetResource(resources, "calico-api", "calico-system", "gateway.networking.k8s.io", "v1", "Gateway").(*gapi.Gateway)
+			Expect(ok).To(BeTrue())
+			Expect(gw.Spec.GatewayClassName).To(Equal(gapi.ObjectName("example")))
+			Expect(gw.Spec.Listeners).To(HaveLen(1))
+			listener := gw.Spec.Listeners[0]
+			Expect(listener.Protocol).To(Equal(gapi.TLSProtocolType))
+			Expect(listener.Port).To(Equal(gapi.PortNumber(443)))
+			Expect(*listener.Hostname).To(Equal(gapi.Hostname("calico-api.example.com")))
+			Expect(*listener.TLS.Mode).To(Equal(gapi.TLSModePassthrough))
+
+			route, ok := rtest.GetResource(resources, "calico-api", "calico-system", "gateway.networking.k8s.io", "v1alpha2", "TLSRoute").(*gapiv1alpha2.TLSRoute)
+			Expect(ok).To(BeTrue())
+			Expect(route.Spec.ParentRefs).To(HaveLen(1))
+			Expect(route.Spec.ParentRefs[0].Name).To(Equal(gapi.ObjectName("calico-api")))
+			Expect(route.Spec.Hostnames).To(ConsistOf(gapi.Hostname("calico-api.example.com")))
+			Expect(route.Spec.Rules).To(HaveLen(1))
+			Expect(route.Spec.Rules[0].BackendRefs).To(HaveLen(1))
+			backend := route.Spec.Rules[0].BackendRefs[0]
+			Expect(backend.Name).To(Equal(gapi.ObjectName("calico-api")))
+			Expect(*backend.Port).To(Equal(gapi.PortNumber(8080)))
+		})
+
+		It("should use the configured Gateway port", func() {
+			apiserver.QueryServer.Gateway.Port = ptr.To[int32](8443)
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			gw, ok := rtest.GetResource(resources, "calico-api", "calico-system", "gateway.networking.k8s.io", "v1", "Gateway").(*gapi.Gateway)
+			Expect(ok).To(BeTrue())
+			Expect(gw.Spec.Listeners[0].Port).To(Equal(gapi.PortNumber(8443)))
+		})
+
+		It("should not render the Gateway if the Gateway API CRDs do not exist", func() {
+			cfg.GatewayAPICRDExists = false
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, toDelete := component.Objects()
+
+			Expect(rtest.GetResource(resources, "calico-api", "calico-system", "gateway.networking.k8s.io", "v1", "Gateway")).To(BeNil())
+			Expect(rtest.GetResource(resources, "calico-api", "calico-system", "gateway.networking.k8s.io", "v1alpha2", "TLSRoute")).To(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-api", "calico-system", "gateway.networking.k8s.io", "v1", "Gateway")).To(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-api", "calico-system", "gateway.networking.k8s.io", "v1alpha2", "TLSRoute")).To(BeNil())
+		})
+
+		It("should delete the Gateway and TLSRoute when not configured", func() {
+			apiserver.QueryServer = nil
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, toDelete := component.Objects()
+
+			Expect(rtest.GetResource(resources, "calico-api", "calico-system", "gateway.networking.k8s.io", "v1", "Gateway")).To(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-api", "calico-system", "gateway.networking.k8s.io", "v1", "Gateway")).NotTo(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-api", "calico-system", "gateway.networking.k8s.io", "v1alpha2", "TLSRoute")).NotTo(BeNil())
+		})
+	})
+
+	Context("query server OpenShift Route", func() {
+		BeforeEach(func() {
+			apiserver.QueryServer = &operatorv1.APIServerQueryServer{
+				Route: &operatorv1.QueryServerRoute{Hostname: "calico-api.apps.example.com"},
+			}
+			cfg.QueryServerRouteHost = "calico-api.apps.example.com"
+			cfg.OpenShift = true
+		})
+
+		It("should render a passthrough Route for the query server", func() {
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			route, ok := rtest.GetResource(resources, "calico-api", "calico-system", "route.openshift.io", "v1", "Route").(*routev1.Route)
+			Expect(ok).To(BeTrue())
+			Expect(route.Spec.Host).To(Equal("calico-api.apps.example.com"))
+			Expect(route.Spec.To.Kind).To(Equal("Service"))
+			Expect(route.Spec.To.Name).To(Equal("calico-api"))
+			Expect(route.Spec.Port.TargetPort).To(Equal(intstr.FromString("queryserver")))
+			Expect(route.Spec.TLS.Termination).To(Equal(routev1.TLSTerminationPassthrough))
+		})
+
+		It("should not render or delete the Route when not on OpenShift", func() {
+			cfg.OpenShift = false
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, toDelete := component.Objects()
+
+			Expect(rtest.GetResource(resources, "calico-api", "calico-system", "route.openshift.io", "v1", "Route")).To(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-api", "calico-system", "route.openshift.io", "v1", "Route")).To(BeNil())
+		})
+
+		It("should delete the Route when no longer configured", func() {
+			apiserver.QueryServer = nil
+			cfg.QueryServerRouteHost = ""
+			cfg.DeleteQueryServerRoute = true
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, toDelete := component.Objects()
+
+			Expect(rtest.GetResource(resources, "calico-api", "calico-system", "route.openshift.io", "v1", "Route")).To(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-api", "calico-system", "route.openshift.io", "v1", "Route")).NotTo(BeNil())
+		})
+
+		It("should not delete the Route when it was not rendered before", func() {
+			apiserver.QueryServer = nil
+			cfg.QueryServerRouteHost = ""
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			_, toDelete := component.Objects()
+
+			Expect(rtest.GetResource(toDelete, "calico-api", "calico-system", "route.openshift.io", "v1", "Route")).To(BeNil())
+		})
+	})
+
+	Context("Prometheus metrics", func() {
+		BeforeEach(func() {
+			apiserver.PrometheusMetrics = ptr.To(operatorv1.PrometheusMetricsEnabled)
+			cfg.ServiceMonitorCRDExists = true
+		})
+
+		It("should render a metrics Service and ServiceMonitor for the API server and query server", func() {
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			svc, ok := rtest.GetResource(resources, "calico-api-metrics", "calico-system", "", "v1", "Service").(*corev1.Service)
+			Expect(ok).To(BeTrue())
+			Expect(svc.Spec.ClusterIP).To(Equal(corev1.ClusterIPNone))
+			Expect(svc.Spec.Selector).To(Equal(map[string]string{"apiserver": "true"}))
+			Expect(svc.Spec.Ports).To(HaveLen(2))
+			Expect(svc.Spec.Ports[0].Name).To(Equal("apiserver"))
+			Expect(svc.Spec.Ports[0].Port).To(Equal(int32(5443)))
+			Expect(svc.Spec.Ports[1].Name).To(Equal("queryserver"))
+			Expect(svc.Spec.Ports[1].Port).To(Equal(int32(8080)))
+
+			sm, ok := rtest.GetResource(resources, "calico-api-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor").(*monitoringv1.ServiceMonitor)
+			Expect(ok).To(BeTrue())
+			Expect(sm.Spec.Selector.MatchLabels).To(Equal(map[string]string{"k8s-app": "calico-api-metrics"}))
+			Expect(sm.Spec.NamespaceSelector.MatchNames).To(ConsistOf("calico-system"))
+			Expect(sm.Spec.Endpoints).To(HaveLen(2))
+			Expect(sm.Spec.Endpoints[0].Port).To(Equal("apiserver"))
+			Expect(sm.Spec.Endpoints[1].Port).To(Equal("queryserver"))
+			for _, ep := range sm.Spec.Endpoints {
+				Expect(*ep.Scheme).To(Equal(monitoringv1.SchemeHTTPS))
+				Expect(*ep.TLSConfig.ServerName).To(Equal("calico-api"))
+				Expect(ep.TLSConfig.CA.ConfigMap.Name).To(Equal("tigera-ca-bundle"))
+				Expect(ep.BearerTokenFile).To(BeEmpty())
+				Expect(ep.Authorization.Credentials.Name).To(Equal("calico-api-metrics"))
+				Expect(ep.Authorization.Credentials.Key).To(Equal("token"))
+			}
+
+			// Prometheus scrapes the metrics with the token of a service account that may read them.
+			Expect(rtest.GetResource(resources, "calico-api-metrics", "calico-system", "", "v1", "ServiceAccount")).NotTo(BeNil())
+			secret := rtest.GetResource(resources, "calico-api-metrics", "calico-system", "", "v1", "Secret").(*corev1.Secret)
+			Expect(secret.Type).To(Equal(corev1.SecretTypeServiceAccountToken))
+			Expect(secret.Annotations).To(HaveKeyWithValue("kubernetes.io/service-account.name", "calico-api-metrics"))
+			cr := rtest.GetResource(resources, "calico-api-metrics", "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole)
+			Expect(cr.Rules).To(ConsistOf(rbacv1.PolicyRule{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}}))
+			crb := rtest.GetResource(resources, "calico-api-metrics", "", "rbac.authorization.k8s.io", "v1", "ClusterRoleBinding").(*rbacv1.ClusterRoleBinding)
+			Expect(crb.Subjects).To(ConsistOf(rbacv1.Subject{Kind: "ServiceAccount", Name: "calico-api-metrics", Namespace: "calico-system"}))
+		})
+
+		It("should allow Prometheus to scrape the metrics ports", func() {
+			component := render.APIServerPolicy(cfg)
+			resources, _ := component.Objects()
+
+			policy := testutils.GetCalicoSystemPolicyFromResources(types.NamespacedName{Name: "calico-system.apiserver-access", Namespace: "calico-system"}, resources)
+			Expect(policy.Spec.Ingress).To(ContainElement(calicov3.Rule{
+				Action:   calicov3.Allow,
+				Protocol: &networkpolicy.TCPProtocol,
+				Source:   networkpolicy.PrometheusSourceEntityRule,
+				Destination: calicov3.EntityRule{
+					Ports: networkpolicy.Ports(5443, 8080),
+				},
+			}))
+		})
+
+		It("should not render the ServiceMonitor if its CRD does not exist", func() {
+			cfg.ServiceMonitorCRDExists = false
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, toDelete := component.Objects()
+
+			Expect(rtest.GetResource(resources, "calico-api-metrics", "calico-system", "", "v1", "Service")).To(BeNil())
+			Expect(rtest.GetResource(resources, "calico-api-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor")).To(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-api-metrics", "calico-system", "", "v1", "Service")).NotTo(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-api-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor")).To(BeNil())
+		})
+
+		It("should delete the metrics Service and ServiceMonitor when disabled", func() {
+			apiserver.PrometheusMetrics = ptr.To(operatorv1.PrometheusMetricsDisabled)
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, toDelete := component.Objects()
+
+			Expect(rtest.GetResource(resources, "calico-api-metrics", "calico-system", "", "v1", "Service")).To(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-api-metrics", "calico-system", "", "v1", "Service")).NotTo(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-api-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor")).NotTo(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-api-metrics", "calico-system", "", "v1", "Secret")).NotTo(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-api-metrics", "", "rbac.authorization.k8s.io", "v1", "ClusterRoleBinding")).NotTo(BeNil())
+		})
+	})
+
+	Context("flow control", func() {
+		It("should render the flow control resources, flag and RBAC", func() {
+			apiserver.FlowControl = &operatorv1.APIServerFlowControl{
+				PriorityAndFairness: ptr.To(operatorv1.PriorityAndFairnessEnabled),
+				PriorityLevelConfigurations: []operatorv1.APIServerPriorityLevelConfiguration{
+					{Name: "calico-workload", Spec: flowcontrolv1.PriorityLevelConfigurationSpec{Type: flowcontrolv1.PriorityLevelEnablementLimited}},
+				},
+				FlowSchemas: []operatorv1.APIServerFlowSchema{
+					{Name: "calico-policies", Spec: flowcontrolv1.FlowSchemaSpec{
+						PriorityLevelConfiguration: flowcontrolv1.PriorityLevelConfigurationReference{Name: "calico-workload"},
+					}},
+				},
+			}
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			plc := rtest.GetResource(resources, "calico-workload", "", "flowcontrol.apiserver.k8s.io", "v1", "PriorityLevelConfiguration").(*flowcontrolv1.PriorityLevelConfiguration)
+			Expect(plc.Labels).To(HaveKeyWithValue("operator.tigera.io/apiserver-flow-control", "managed"))
+			Expect(plc.Spec.Type).To(Equal(flowcontrolv1.PriorityLevelEnablementLimited))
+			fs := rtest.GetResource(resources, "calico-policies", "", "flowcontrol.apiserver.k8s.io", "v1", "FlowSchema").(*flowcontrolv1.FlowSchema)
+			Expect(fs.Labels).To(HaveKeyWithValue("operator.tigera.io/apiserver-flow-control", "managed"))
+			Expect(fs.Spec.PriorityLevelConfiguration.Name).To(Equal("calico-workload"))
+
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--enable-priority-and-fairness=true"))
+
+			role := rtest.GetResource(resources, "calico-crds", "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole)
+			Expect(role.Rules).To(ContainElement(rbacv1.PolicyRule{
+				APIGroups: []string{"flowcontrol.apiserver.k8s.io"},
+				Resources: []string{"flowschemas", "prioritylevelconfigurations"},
+				Verbs:     []string{"get", "list", "watch"},
+			}))
+		})
+
+		It("should disable priority and fairness without granting flow control RBAC", func() {
+			apiserver.FlowControl = &operatorv1.APIServerFlowControl{
+				PriorityAndFairness: ptr.To(operatorv1.PriorityAndFairnessDisabled),
+			}
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--enable-priority-and-fairness=false"))
+
+			role := rtest.GetResource(resources, "calico-crds", "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole)
+			for _, rule := range role.Rules {
+				Expect(rule.APIGroups).NotTo(ContainElement("flowcontrol.apiserver.k8s.io"))
+			}
+		})
+	})
+
+	Context("query server OIDC", func() {
+		var issuer *httptest.Server
+
+		BeforeEach(func() {
+			issuer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/.well-known/openid-configuration":
+					_, _ = fmt.Fprintf(w, `{"issuer": "http://%s", "jwks_uri": "http://%s/keys"}`, r.Host, r.Host)
+				case "/keys":
+					_, _ = w.Write([]byte(`{"keys": []}`))
+				}
+			}))
+		})
+
+		AfterEach(func() {
+			issuer.Close()
+		})
+
+		It("should configure the query server to validate Dex tokens", func() {
+			cfg.KeyValidatorConfig = render.NewDexKeyValidatorConfig(&operatorv1.Authentication{
+				Spec: operatorv1.AuthenticationSpec{
+					ManagerDomain: "https://127.0.0.1",
+					OIDC:          &operatorv1.AuthenticationOIDC{IssuerURL: "https://accounts.google.com", UsernameClaim: "email"},
+				},
+			}, dns.DefaultClusterDomain)
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			queryserver := test.GetContainer(d.Spec.Template.Spec.Containers, "tigera-queryserver")
+			Expect(queryserver).NotTo(BeNil())
+			Expect(queryserver.Env).To(ContainElements(
+				corev1.EnvVar{Name: "OIDC_AUTH_ENABLED", Value: "true"},
+				corev1.EnvVar{Name: "OIDC_AUTH_ISSUER", Value: "https://127.0.0.1/dex"},
+				corev1.EnvVar{Name: "OIDC_AUTH_USERNAME_CLAIM", Value: "email"},
+			))
+			Expect(d.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/tigera-dex-auth"))
+		})
+
+		It("should mount the well-known configuration of the issuer into the query server", func() {
+			cfg.KeyValidatorConfig, err = tigerakvc.New(issuer.URL, "client-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			cm := rtest.GetResource(resources, tigerakvc.StaticWellKnownJWKSConfigMapName, "calico-system", "", "v1", "ConfigMap").(*corev1.ConfigMap)
+			Expect(cm.Data).To(HaveKeyWithValue("keys", `{"keys": []}`))
+
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/tigera-auth-jwks"))
+			Expect(d.Spec.Template.Spec.Volumes).To(ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+				"Name": Equal(tigerakvc.StaticWellKnownJWKSConfigMapName),
+			})))
+			queryserver := test.GetContainer(d.Spec.Template.Spec.Containers, "tigera-queryserver")
+			Expect(queryserver.Env).To(ContainElements(
+				corev1.EnvVar{Name: "OIDC_AUTH_ISSUER", Value: issuer.URL},
+				corev1.EnvVar{Name: "OIDC_AUTH_JWKSURL", Value: issuer.URL + "/keys"},
+			))
+			Expect(queryserver.VolumeMounts).To(ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+				"Name": Equal(tigerakvc.StaticWellKnownJWKSConfigMapName),
+			})))
+
+			// Only the query server validates tokens, not the aggregation API server.
+			apiServer := test.GetContainer(d.Spec.Template.Spec.Containers, "calico-apiserver")
+			Expect(apiServer.VolumeMounts).NotTo(ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+				"Name": Equal(tigerakvc.StaticWellKnownJWKSConfigMapName),
+			})))
+		})
+
+		It("should not render the configuration when the query server is disabled", func() {
+			apiserver.QueryServer = &operatorv1.APIServerQueryServer{Enabled: ptr.To(false)}
+			cfg.KeyValidatorConfig, err = tigerakvc.New(issuer.URL, "client-id")
+			Expect(err).NotTo(HaveOccurred())
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			Expect(rtest.GetResource(resources, tigerakvc.StaticWellKnownJWKSConfigMapName, "calico-system", "", "v1", "ConfigMap")).To(BeNil())
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Annotations).NotTo(HaveKey("hash.operator.tigera.io/tigera-auth-jwks"))
+			Expect(d.Spec.Template.Spec.Volumes).NotTo(ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+				"Name": Equal(tigerakvc.StaticWellKnownJWKSConfigMapName),
+			})))
+		})
+	})
+
+	Context("query server disabled", func() {
+		BeforeEach(func() {
+			apiserver.QueryServer = &operatorv1.APIServerQueryServer{Enabled: ptr.To(false)}
+		})
+
+		It("should not render the query server container or service port", func() {
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Spec.Containers).To(HaveLen(1))
+			Expect(d.Spec.Template.Spec.Containers[0].Name).To(Equal("calico-apiserver"))
+
+			svc := rtest.GetResource(resources, "calico-api", "calico-system", "", "v1", "Service").(*corev1.Service)
+			Expect(svc.Spec.Ports).To(HaveLen(1))
+			Expect(svc.Spec.Ports[0].Name).To(Equal("apiserver"))
+
+			for _, name := range []string{"tigera-ui-user", "tigera-network-admin"} {
+				role := rtest.GetResource(resources, name, "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole)
+				for _, rule := range role.Rules {
+					Expect(rule.ResourceNames).NotTo(ContainElement("https:calico-api:8080"))
+				}
+			}
+		})
+
+		It("should not render the query server metrics endpoint", func() {
+			apiserver.PrometheusMetrics = ptr.To(operatorv1.PrometheusMetricsEnabled)
+			cfg.ServiceMonitorCRDExists = true
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			svc := rtest.GetResource(resources, "calico-api-metrics", "calico-system", "", "v1", "Service").(*corev1.Service)
+			Expect(svc.Spec.Ports).To(HaveLen(1))
+			Expect(svc.Spec.Ports[0].Name).To(Equal("apiserver"))
+
+			sm := rtest.GetResource(resources, "calico-api-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor").(*monitoringv1.ServiceMonitor)
+			Expect(sm.Spec.Endpoints).To(HaveLen(1))
+			Expect(sm.Spec.Endpoints[0].Port).To(Equal("apiserver"))
+		})
+
+		It("should not allow traffic to or from the query server in the policy", func() {
+			component := render.APIServerPolicy(cfg)
+			resources, _ := component.Objects()
+
+			policy := testutils.GetCalicoSystemPolicyFromResources(types.NamespacedName{Name: "calico-system.apiserver-access", Namespace: "calico-system"}, resources)
+			for _, rule := range policy.Spec.Ingress {
+				Expect(rule.Destination.Ports).NotTo(ContainElement(numorstring.SinglePort(8080)))
+			}
+			for _, rule := range policy.Spec.Egress {
+				Expect(rule.Destination).NotTo(Equal(networkpolicy.DefaultHelper().LinseedEntityRule()))
+			}
+		})
+
+		It("should remove the deployment when the aggregation API server is not required", func() {
+			cfg.RequiresAggregationServer = false
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, toDelete := component.Objects()
+
+			Expect(rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment")).To(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-apiserver", "calico-system", "apps", "v1", "Deployment")).NotTo(BeNil())
+		})
+	})
+
+	Context("IP families", func() {
+		It("should render an IPv6 single-stack service for IPv6-only clusters", func() {
+			cfg.Installation.CalicoNetwork = &operatorv1.CalicoNetworkSpec{
+				IPPools: []operatorv1.IPPool{{CIDR: "fd00::/64"}},
+			}
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			svc := rtest.GetResource(resources, "calico-api", "calico-system", "", "v1", "Service").(*corev1.Service)
+			Expect(svc.Spec.IPFamilies).To(Equal([]corev1.IPFamily{corev1.IPv6Protocol}))
+			Expect(*svc.Spec.IPFamilyPolicy).To(Equal(corev1.IPFamilyPolicySingleStack))
+		})
+
+		It("should render a dual-stack service for dual-stack clusters", func() {
+			cfg.Installation.CalicoNetwork = &operatorv1.CalicoNetworkSpec{
+				IPPools: []operatorv1.IPPool{{CIDR: "192.168.0.0/16"}, {CIDR: "fd00::/64"}},
+			}
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			svc := rtest.GetResource(resources, "calico-api", "calico-system", "", "v1", "Service").(*corev1.Service)
+			Expect(svc.Spec.IPFamilies).To(BeEmpty())
+			Expect(*svc.Spec.IPFamilyPolicy).To(Equal(corev1.IPFamilyPolicyPreferDualStack))
+		})
+	})
+
+	Context("etcd datastore", func() {
+		BeforeEach(func() {
+			cfg.EtcdEndpoints = []string{"https://10.0.0.1:2379", "https://10.0.0.2:2379"}
+			cfg.EtcdTLSSecret = &corev1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: render.APIServerEtcdTLSSecretName, Namespace: common.OperatorNamespace()},
+				Data: map[string][]byte{
+					"ca.crt":  []byte("ca"),
+					"tls.crt": []byte("cert"),
+					"tls.key": []byte("key"),
+				},
+			}
+		})
+
+		It("should configure the API server to use etcd", func() {
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+
+			Expect(rtest.GetResource(resources, "calico-apiserver-etcd-tls", "calico-system", "", "v1", "Secret")).NotTo(BeNil())
+
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/etcd-tls"))
+			Expect(d.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "calico-apiserver-etcd-tls",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: "calico-apiserver-etcd-tls"},
+				},
+			}))
+
+			container := d.Spec.Template.Spec.Containers[0]
+			Expect(container.Env).To(ContainElements(
+				corev1.EnvVar{Name: "DATASTORE_TYPE", Value: "etcdv3"},
+				corev1.EnvVar{Name: "ETCD_ENDPOINTS", Value: "https://10.0.0.1:2379,https://10.0.0.2:2379"},
+				corev1.EnvVar{Name: "ETCD_CA_CERT_FILE", Value: "/etc/calico/etcd-tls/ca.crt"},
+				corev1.EnvVar{Name: "ETCD_CERT_FILE", Value: "/etc/calico/etcd-tls/tls.crt"},
+				corev1.EnvVar{Name: "ETCD_KEY_FILE", Value: "/etc/calico/etcd-tls/tls.key"},
+			))
+			Expect(container.Env).NotTo(ContainElement(corev1.EnvVar{Name: "DATASTORE_TYPE", Value: "kubernetes"}))
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name: "calico-apiserver-etcd-tls", MountPath: "/etc/calico/etcd-tls", ReadOnly: true,
+			}))
+		})
+
+		It("should connect to etcd without TLS if no secret is provided", func() {
+			cfg.EtcdTLSSecret = nil
+
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, toDelete := component.Objects()
+
+			Expect(rtest.GetResource(resources, "calico-apiserver-etcd-tls", "calico-system", "", "v1", "Secret")).To(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-apiserver-etcd-tls", "calico-system", "", "v1", "Secret")).NotTo(BeNil())
+
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := d.Spec.Template.Spec.Containers[0]
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "DATASTORE_TYPE", Value: "etcdv3"}))
+			for _, e := range container.Env {
+				Expect(e.Name).NotTo(HaveSuffix("_FILE"))
+			}
+		})
+	})
+
 	Context("calico-system rendering", func() {
 		policyName := types.NamespacedName{Name: "calico-system.apiserver-access", Namespace: "calico-system"}
 
@@ -1405,6 +2200,78 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 			Expect(d.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("custom-node-selector", "value"))
 		})
 
+		It("should override the probe timings when specified", func() {
+			cfg.APIServer.APIServerDeployment = &operatorv1.APIServerDeployment{
+				Spec: &operatorv1.APIServerDeploymentSpec{
+					Template: &operatorv1.APIServerDeploymentPodTemplateSpec{
+						Spec: &operatorv1.APIServerDeploymentPodSpec{
+							Containers: []operatorv1.APIServerDeploymentContainer{
+								{
+									Name: "calico-apiserver",
+									ReadinessProbe: &operatorv1.ProbeOverride{
+										InitialDelaySeconds: ptr.To(int32(30)),
+										PeriodSeconds:       ptr.To(int32(20)),
+										TimeoutSeconds:      ptr.To(int32(10)),
+										FailureThreshold:    ptr.To(int32(6)),
+									},
+								},
+								{
+									Name: "tigera-queryserver",
+									LivenessProbe: &operatorv1.ProbeOverride{
+										InitialDelaySeconds: ptr.To(int32(180)),
+										TimeoutSeconds:      ptr.To(int32(15)),
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			component, err := render.APIServer(cfg)
+			Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+			Expect(component.ResolveImages(nil)).To(BeNil())
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+
+			apiserver := test.GetContainer(d.Spec.Template.Spec.Containers, "calico-apiserver")
+			Expect(apiserver).NotTo(BeNil())
+			Expect(apiserver.ReadinessProbe.HTTPGet.Path).To(Equal("/readyz"))
+			Expect(apiserver.ReadinessProbe.InitialDelaySeconds).To(BeEquivalentTo(30))
+			Expect(apiserver.ReadinessProbe.PeriodSeconds).To(BeEquivalentTo(20))
+			Expect(apiserver.ReadinessProbe.TimeoutSeconds).To(BeEquivalentTo(10))
+			Expect(apiserver.ReadinessProbe.FailureThreshold).To(BeEquivalentTo(6))
+
+			queryserver := test.GetContainer(d.Spec.Template.Spec.Containers, "tigera-queryserver")
+			Expect(queryserver).NotTo(BeNil())
+			Expect(queryserver.LivenessProbe.HTTPGet.Path).To(Equal("/version"))
+			Expect(queryserver.LivenessProbe.InitialDelaySeconds).To(BeEquivalentTo(180))
+			Expect(queryserver.LivenessProbe.TimeoutSeconds).To(BeEquivalentTo(15))
+		})
+
+		It("should use the control plane PriorityClass when the operator manages PriorityClasses", func() {
+			cfg.Installation.ManagedPriorityClasses = ptr.To(operatorv1.ManagedPriorityClassesEnabled)
+			component, err := render.APIServer(cfg)
+			Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+			resources, _ := component.Objects()
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Spec.PriorityClassName).To(Equal(render.ControlPlanePriorityClassName))
+
+			By("letting the APIServer deployment overrides take precedence")
+			cfg.APIServer.APIServerDeployment = &operatorv1.APIServerDeployment{
+				Spec: &operatorv1.APIServerDeploymentSpec{
+					Template: &operatorv1.APIServerDeploymentPodTemplateSpec{
+						Spec: &operatorv1.APIServerDeploymentPodSpec{PriorityClassName: "custom"},
+					},
+				},
+			}
+			component, err = render.APIServer(cfg)
+			Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+			resources, _ = component.Objects()
+			d = rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Spec.PriorityClassName).To(Equal("custom"))
+		})
+
 		It("should override ControlPlaneTolerations when specified", func() {
 			cfg.Installation.ControlPlaneTolerations = rmeta.TolerateControlPlane
 
@@ -1452,6 +2319,159 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 				"--enable-validating-admission-policy=false",
 			}))
 		})
+
+		It("should pass request timeout and inflight limits to the API server", func() {
+			apiserver.RequestTimeout = &metav1.Duration{Duration: 90 * time.Second}
+			apiserver.MaxRequestsInflight = ptr.To[int32](800)
+			apiserver.MaxMutatingRequestsInflight = ptr.To[int32](0)
+			component, err := render.APIServer(cfg)
+			Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+			resources, _ := component.Objects()
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+				"--request-timeout=1m30s",
+				"--max-requests-inflight=800",
+				"--max-mutating-requests-inflight=0",
+			))
+		})
+
+		It("should pass watch cache sizes and the etcd compaction interval to the API server", func() {
+			apiserver.DefaultWatchCacheSize = ptr.To[int32](500)
+			apiserver.WatchCacheSizes = []operatorv1.APIServerWatchCacheSize{
+				{Resource: "networkpolicies.projectcalico.org", Size: 5000},
+				{Resource: "globalnetworksets.projectcalico.org", Size: 0},
+			}
+			apiserver.EtcdDatastore = &operatorv1.APIServerEtcdDatastore{Endpoints: []string{"https://etcd:2379"}}
+			apiserver.EtcdCompactionInterval = &metav1.Duration{Duration: 10 * time.Minute}
+			component, err := render.APIServer(cfg)
+			Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+			resources, _ := component.Objects()
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+				"--default-watch-cache-size=500",
+				"--watch-cache-sizes=networkpolicies.projectcalico.org#5000,globalnetworksets.projectcalico.org#0",
+				"--etcd-compaction-interval=10m0s",
+			))
+		})
+
+		It("should delay the API server shutdown by the preStop sleep", func() {
+			apiserver.APIServerDeployment = &operatorv1.APIServerDeployment{
+				Spec: &operatorv1.APIServerDeploymentSpec{
+					Template: &operatorv1.APIServerDeploymentPodTemplateSpec{
+						Spec: &operatorv1.APIServerDeploymentPodSpec{PreStopSleepSeconds: ptr.To[int32](15)},
+					},
+				},
+			}
+			component, err := render.APIServer(cfg)
+			Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+			resources, _ := component.Objects()
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(*d.Spec.Template.Spec.TerminationGracePeriodSeconds).To(BeEquivalentTo(60))
+			Expect(d.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--shutdown-delay-duration=15s"))
+			for _, c := range d.Spec.Template.Spec.Containers {
+				Expect(c.Lifecycle.PreStop.Sleep.Seconds).To(BeEquivalentTo(15))
+			}
+
+			apiserver.APIServerDeployment.Spec.Template.Spec.TerminationGracePeriodSeconds = ptr.To[int64](120)
+			component, err = render.APIServer(cfg)
+			Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+			resources, _ = component.Objects()
+			d = rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(*d.Spec.Template.Spec.TerminationGracePeriodSeconds).To(BeEquivalentTo(120))
+		})
+
+		It("should add the extra volumes and volume mounts to the API server pods", func() {
+			caBundle := corev1.Volume{
+				Name:         "corporate-ca",
+				VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "corporate-ca"}}},
+			}
+			caMount := corev1.VolumeMount{Name: "corporate-ca", MountPath: "/etc/pki/corporate", ReadOnly: true}
+			apiserver.APIServerDeployment = &operatorv1.APIServerDeployment{
+				Spec: &operatorv1.APIServerDeploymentSpec{
+					Template: &operatorv1.APIServerDeploymentPodTemplateSpec{
+						Spec: &operatorv1.APIServerDeploymentPodSpec{
+							ExtraVolumes: []corev1.Volume{caBundle},
+							Containers: []operatorv1.APIServerDeploymentContainer{{
+								Name:              "calico-apiserver",
+								ExtraVolumeMounts: []corev1.VolumeMount{caMount},
+							}},
+						},
+					},
+				},
+			}
+			component, err := render.APIServer(cfg)
+			Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+			resources, _ := component.Objects()
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Spec.Volumes).To(ContainElement(caBundle))
+			for _, c := range d.Spec.Template.Spec.Containers {
+				if c.Name == "calico-apiserver" {
+					Expect(c.VolumeMounts).To(ContainElement(caMount))
+				} else {
+					Expect(c.VolumeMounts).NotTo(ContainElement(caMount))
+				}
+			}
+		})
+
+		It("should reject extra volumes and volume mounts that conflict with the rendered ones", func() {
+			component, err := render.APIServer(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			resources, _ := component.Objects()
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			renderedVolume := d.Spec.Template.Spec.Volumes[0].Name
+			renderedPath := d.Spec.Template.Spec.Containers[0].VolumeMounts[0].MountPath
+
+			podSpec := &operatorv1.APIServerDeploymentPodSpec{
+				ExtraVolumes: []corev1.Volume{{Name: renderedVolume, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}},
+			}
+			apiserver.APIServerDeployment = &operatorv1.APIServerDeployment{
+				Spec: &operatorv1.APIServerDeploymentSpec{Template: &operatorv1.APIServerDeploymentPodTemplateSpec{Spec: podSpec}},
+			}
+			_, err = render.APIServer(cfg)
+			Expect(err).To(MatchError(ContainSubstring("extra volume " + renderedVolume + " conflicts")))
+
+			podSpec.ExtraVolumes[0].Name = "extra"
+			podSpec.Containers = []operatorv1.APIServerDeploymentContainer{{
+				Name:              "calico-apiserver",
+				ExtraVolumeMounts: []corev1.VolumeMount{{Name: "extra", MountPath: renderedPath}},
+			}}
+			_, err = render.APIServer(cfg)
+			Expect(err).To(MatchError(ContainSubstring("extra volume mount " + renderedPath + " of container calico-apiserver conflicts")))
+		})
+
+		It("should render a migration deployment while the API server moves to the host network", func() {
+			cfg.ForceHostNetwork = true
+			cfg.HostNetworkMigration = &render.APIServerHostNetworkMigration{PreviousHostNetwork: ptr.To(false)}
+			component, err := render.APIServer(cfg)
+			Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+			resources, toDelete := component.Objects()
+			Expect(rtest.GetResource(toDelete, "calico-apiserver-migration", "calico-system", "apps", "v1", "Deployment")).To(BeNil())
+
+			d := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Spec.HostNetwork).To(BeFalse())
+			Expect(d.Spec.Strategy.Type).To(Equal(appsv1.RollingUpdateDeploymentStrategyType))
+
+			m := rtest.GetResource(resources, "calico-apiserver-migration", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(m.Spec.Template.Spec.HostNetwork).To(BeTrue())
+			Expect(m.Spec.Template.Spec.DNSPolicy).To(Equal(corev1.DNSClusterFirstWithHostNet))
+			Expect(m.Spec.Selector.MatchLabels).To(Equal(map[string]string{"apiserver": "true", "apiserver-migration": "true"}))
+			Expect(m.Spec.Template.Labels).To(HaveKeyWithValue("apiserver", "true"))
+			Expect(m.Spec.Template.Labels).To(HaveKeyWithValue("apiserver-migration", "true"))
+
+			By("moving the API server deployment once the migration deployment is available")
+			cfg.HostNetworkMigration.PreviousHostNetwork = nil
+			resources, _ = component.Objects()
+			d = rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Spec.HostNetwork).To(BeTrue())
+			Expect(d.Spec.Selector.MatchLabels).To(Equal(map[string]string{"apiserver": "true"}))
+			Expect(rtest.GetResource(resources, "calico-apiserver-migration", "calico-system", "apps", "v1", "Deployment")).NotTo(BeNil())
+
+			By("removing the migration deployment once the migration is done")
+			cfg.HostNetworkMigration = nil
+			resources, toDelete = component.Objects()
+			Expect(rtest.GetResource(resources, "calico-apiserver-migration", "calico-system", "apps", "v1", "Deployment")).To(BeNil())
+			Expect(rtest.GetResource(toDelete, "calico-apiserver-migration", "calico-system", "apps", "v1", "Deployment")).NotTo(BeNil())
+		})
 	})
 })
 
@@ -2212,6 +3232,58 @@ var _ = Describe("API server rendering tests (Calico)", func() {
 		Expect(deploy.Spec.Template.Spec.HostNetwork).To(BeTrue())
 	})
 
+	It("should render host networked with the Recreate strategy on AKS with Calico CNI and no overlay", func() {
+		cfg.Installation.KubernetesProvider = operatorv1.ProviderAKS
+		cfg.Installation.CNI = &operatorv1.CNISpec{
+			Type: operatorv1.PluginCalico,
+		}
+		cfg.Installation.CalicoNetwork = &operatorv1.CalicoNetworkSpec{
+			IPPools: []operatorv1.IPPool{{CIDR: "192.168.0.0/16", Encapsulation: operatorv1.EncapsulationNone}},
+		}
+
+		component, err := render.APIServer(cfg)
+		Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+		resources, _ := component.Objects()
+
+		deploy, ok := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(ok).To(BeTrue())
+		Expect(deploy.Spec.Template.Spec.HostNetwork).To(BeTrue())
+		Expect(deploy.Spec.Strategy.Type).To(Equal(appsv1.RecreateDeploymentStrategyType))
+	})
+
+	It("should not render host networked on AKS with Calico CNI and an overlay", func() {
+		cfg.Installation.KubernetesProvider = operatorv1.ProviderAKS
+		cfg.Installation.CNI = &operatorv1.CNISpec{
+			Type: operatorv1.PluginCalico,
+		}
+		cfg.Installation.CalicoNetwork = &operatorv1.CalicoNetworkSpec{
+			IPPools: []operatorv1.IPPool{{CIDR: "192.168.0.0/16", Encapsulation: operatorv1.EncapsulationVXLAN}},
+		}
+
+		component, err := render.APIServer(cfg)
+		Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+		resources, _ := component.Objects()
+
+		deploy, ok := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(ok).To(BeTrue())
+		Expect(deploy.Spec.Template.Spec.HostNetwork).To(BeFalse())
+	})
+
+	It("should not render host networked on AKS with the Azure CNI", func() {
+		cfg.Installation.KubernetesProvider = operatorv1.ProviderAKS
+		cfg.Installation.CNI = &operatorv1.CNISpec{
+			Type: operatorv1.PluginAzureVNET,
+		}
+
+		component, err := render.APIServer(cfg)
+		Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+		resources, _ := component.Objects()
+
+		deploy, ok := rtest.GetResource(resources, "calico-apiserver", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(ok).To(BeTrue())
+		Expect(deploy.Spec.Template.Spec.HostNetwork).To(BeFalse())
+	})
+
 	Context("With APIServer Deployment overrides", func() {
 		rr1 := corev1.ResourceRequirements{
 			Limits: corev1.ResourceList{
@@ -2297,6 +3369,8 @@ var _ = Describe("API server rendering tests (Calico)", func() {
 							Affinity:          affinity,
 							Tolerations:       []corev1.Toleration{toleration},
 							PriorityClassName: priorityclassname,
+							RuntimeClassName:  ptr.To("runc"),
+							ImagePullPolicy:   ptr.To(corev1.PullAlways),
 						},
 					},
 				},
@@ -2363,6 +3437,10 @@ var _ = Describe("API server rendering tests (Calico)", func() {
 			Expect(d.Spec.Template.Spec.Tolerations).To(HaveLen(1))
 			Expect(d.Spec.Template.Spec.Tolerations[0]).To(Equal(toleration))
 			Expect(d.Spec.Template.Spec.PriorityClassName).To(Equal(priorityclassname))
+			Expect(d.Spec.Template.Spec.RuntimeClassName).To(Equal(ptr.To("runc")))
+			for _, c := range append(d.Spec.Template.Spec.Containers, d.Spec.Template.Spec.InitContainers...) {
+				Expect(c.ImagePullPolicy).To(Equal(corev1.PullAlways), "container %s", c.Name)
+			}
 
 			svc := rtest.GetResource(resources, "calico-api", "calico-system", "", "v1", "Service").(*corev1.Service)
 			Expect(svc).NotTo(BeNil())
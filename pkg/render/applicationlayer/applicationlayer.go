@@ -127,17 +127,17 @@ func (c *component) ResolveImages(is *operatorv1.ImageSet) error {
 	var err error
 	var errMsgs []string
 
-	c.config.proxyImage, err = components.GetReference(components.ComponentEnvoyProxy, reg, path, prefix, is)
+	c.config.proxyImage, err = components.GetReference(components.ComponentEnvoyProxy, reg, path, prefix, is, c.config.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
-	c.config.collectorImage, err = components.GetReference(components.CombinedCalicoImage(c.config.Installation), reg, path, prefix, is)
+	c.config.collectorImage, err = components.GetReference(components.CombinedCalicoImage(c.config.Installation), reg, path, prefix, is, c.config.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
-	c.config.dikastesImage, err = components.GetReference(components.ComponentDikastes, reg, path, prefix, is)
+	c.config.dikastesImage, err = components.GetReference(components.ComponentDikastes, reg, path, prefix, is, c.config.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
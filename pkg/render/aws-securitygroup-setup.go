@@ -53,7 +53,7 @@ func (c *awsSGSetupComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	path := c.cfg.Installation.ImagePath
 	prefix := c.cfg.Installation.ImagePrefix
 	var err error
-	c.image, err = components.GetReference(components.ComponentOperatorInit, reg, path, prefix, is)
+	c.image, err = components.GetReference(components.ComponentOperatorInit, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	return err
 }
 
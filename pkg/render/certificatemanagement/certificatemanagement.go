@@ -97,6 +97,18 @@ func (c component) Objects() (objsToCreate, objsToDelete []client.Object) {
 				objsToDelete = append(objsToDelete, keyPair.Secret(c.cfg.Namespace))
 			}
 			needsCSRRoleAndBinding = true
+		} else if keyPair.UseCertManager() {
+			// cert-manager writes the issued key pair to the source of truth namespace. Until it has done so, the operator
+			// signed key pair that is used in the meantime is rendered there.
+			if keyPairCreator.renderInTruthNamespace {
+				objsToCreate = append(objsToCreate, keyPair.Certificate(c.cfg.TruthNamespace))
+				if keyPair.GetIssuer() != nil {
+					objsToCreate = append(objsToCreate, keyPair.Secret(c.cfg.TruthNamespace))
+				}
+			}
+			if keyPairCreator.renderInAppNamespace {
+				objsToCreate = append(objsToCreate, keyPair.Secret(c.cfg.Namespace))
+			}
 		} else {
 			if keyPairCreator.renderInTruthNamespace && (!keyPair.BYO() || keyPair.GetName() == certificatemanagement.CASecretName || keyPair.GetName() == certificatemanagement.TenantCASecretName) {
 				objsToCreate = append(objsToCreate, keyPair.Secret(c.cfg.TruthNamespace))
@@ -23,10 +23,12 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	operator "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/render/common/securitycontext"
 	batchv1 "k8s.io/api/batch/v1"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -144,6 +146,22 @@ func GetHostNetwork(overrides any) *bool {
 	return value.Interface().(*bool)
 }
 
+func GetRuntimeClassName(overrides any) *string {
+	value := getField(overrides, "Spec", "Template", "Spec", "RuntimeClassName")
+	if !value.IsValid() || value.IsNil() {
+		return nil
+	}
+	return value.Interface().(*string)
+}
+
+func GetImagePullPolicy(overrides any) *corev1.PullPolicy {
+	value := getField(overrides, "Spec", "Template", "Spec", "ImagePullPolicy")
+	if !value.IsValid() || value.IsNil() {
+		return nil
+	}
+	return value.Interface().(*corev1.PullPolicy)
+}
+
 func GetDNSPolicy(overrides any) (corev1.DNSPolicy, bool) {
 	value := getField(overrides, "Spec", "Template", "Spec", "DNSPolicy")
 
@@ -165,11 +183,13 @@ func GetDNSConfig(overrides any) *corev1.PodDNSConfig {
 // containerOverride holds override values extracted from a container override struct,
 // including probe timing overrides that can't be represented in corev1.Container.
 type containerOverride struct {
-	Name           string
-	Resources      *corev1.ResourceRequirements
-	Ports          []corev1.ContainerPort
-	ReadinessProbe *operator.ProbeOverride
-	LivenessProbe  *operator.ProbeOverride
+	Name            string
+	Resources       *corev1.ResourceRequirements
+	Ports           []corev1.ContainerPort
+	ReadinessProbe  *operator.ProbeOverride
+	LivenessProbe   *operator.ProbeOverride
+	SeccompProfile  *corev1.SeccompProfile
+	AppArmorProfile string
 }
 
 // GetContainerOverrides returns the full container overrides including probe timing.
@@ -198,8 +218,15 @@ func valueToContainerOverrides(value reflect.Value) []containerOverride {
 		if lp := v.FieldByName("LivenessProbe"); lp.IsValid() && !lp.IsNil() {
 			co.LivenessProbe = lp.Interface().(*operator.ProbeOverride)
 		}
+		if sp := v.FieldByName("SeccompProfile"); sp.IsValid() && !sp.IsNil() {
+			co.SeccompProfile = sp.Interface().(*corev1.SeccompProfile)
+		}
+		if ap := v.FieldByName("AppArmorProfile"); ap.IsValid() {
+			co.AppArmorProfile = ap.String()
+		}
 
-		if co.Resources != nil || co.Ports != nil || co.ReadinessProbe != nil || co.LivenessProbe != nil {
+		if co.Resources != nil || co.Ports != nil || co.ReadinessProbe != nil || co.LivenessProbe != nil ||
+			co.SeccompProfile != nil || co.AppArmorProfile != "" {
 			cs = append(cs, co)
 		}
 	}
@@ -397,10 +424,12 @@ func applyReplicatedPodResourceOverrides(r *replicatedPodResource, overrides any
 	}
 
 	// If `overrides` has a Spec.Template.Spec.Containers field, and it includes containers with
-	// the same name as those in `r.podTemplateSpec.Spec.Containers`, resources, ports, and
-	// probe timing overrides are applied to the corresponding container.
+	// the same name as those in `r.podTemplateSpec.Spec.Containers`, resources, ports, probe timing
+	// and seccomp profile overrides are applied to the corresponding container. AppArmor profiles are
+	// applied as annotations on `r.podTemplateSpec`.
 	if cos := GetContainerOverrides(overrides); cos != nil {
 		mergeContainerOverrides(r.podTemplateSpec.Spec.Containers, cos)
+		applyAppArmorOverrides(r.podTemplateSpec, cos)
 	}
 
 	// If `overrides` has a Spec.Template.Spec.Affinity field, and it's non-nil, it sets
@@ -440,6 +469,12 @@ func applyReplicatedPodResourceOverrides(r *replicatedPodResource, overrides any
 		r.podTemplateSpec.Spec.HostNetwork = *hostNetwork
 	}
 
+	// If `overrides` has a Spec.Template.Spec.RuntimeClassName field, and it's non-nil, it sets
+	// `r.podTemplateSpec.Spec.RuntimeClassName`.
+	if runtimeClassName := GetRuntimeClassName(overrides); runtimeClassName != nil {
+		r.podTemplateSpec.Spec.RuntimeClassName = runtimeClassName
+	}
+
 	// If `overrides` has a Spec.Template.Spec.DNSPolicy field, and it's non-empty, it sets
 	// `r.podTemplateSpec.Spec.DNSPolicy`.
 	if dnsPolicy, ok := GetDNSPolicy(overrides); ok {
@@ -452,6 +487,17 @@ func applyReplicatedPodResourceOverrides(r *replicatedPodResource, overrides any
 		r.podTemplateSpec.Spec.DNSConfig = dnsConfig
 	}
 
+	// If `overrides` has a Spec.Template.Spec.ImagePullPolicy field, and it's non-nil, it sets
+	// the pull policy of all the containers and init containers of `r.podTemplateSpec`.
+	if pullPolicy := GetImagePullPolicy(overrides); pullPolicy != nil {
+		for i := range r.podTemplateSpec.Spec.Containers {
+			r.podTemplateSpec.Spec.Containers[i].ImagePullPolicy = *pullPolicy
+		}
+		for i := range r.podTemplateSpec.Spec.InitContainers {
+			r.podTemplateSpec.Spec.InitContainers[i].ImagePullPolicy = *pullPolicy
+		}
+	}
+
 	return r
 }
 
@@ -597,6 +643,44 @@ func ApplyKibanaOverrides(k *kbv1.Kibana, overrides any) {
 	k.Spec.PodTemplate = *r.podTemplateSpec
 }
 
+// ApplyPodDisruptionBudgetOverrides replaces the disruption limits of the given PodDisruptionBudget with those of the
+// overrides, if any.
+func ApplyPodDisruptionBudgetOverrides(pdb *policyv1.PodDisruptionBudget, overrides *operator.PodDisruptionBudgetSpec) {
+	if overrides == nil || (overrides.MinAvailable == nil && overrides.MaxUnavailable == nil) {
+		return
+	}
+	pdb.Spec.MinAvailable = overrides.MinAvailable
+	pdb.Spec.MaxUnavailable = overrides.MaxUnavailable
+}
+
+// ApplyServiceOverrides sets the type, annotations, traffic policies and session affinity of the given Service from
+// the overrides, if any.
+func ApplyServiceOverrides(svc *corev1.Service, overrides *operator.ServiceOverrides) {
+	if overrides == nil {
+		return
+	}
+	if overrides.Type != nil {
+		svc.Spec.Type = *overrides.Type
+	}
+	if len(overrides.Annotations) > 0 {
+		if svc.Annotations == nil {
+			svc.Annotations = map[string]string{}
+		}
+		for k, v := range overrides.Annotations {
+			svc.Annotations[k] = v
+		}
+	}
+	if overrides.ExternalTrafficPolicy != nil {
+		svc.Spec.ExternalTrafficPolicy = *overrides.ExternalTrafficPolicy
+	}
+	if overrides.InternalTrafficPolicy != nil {
+		svc.Spec.InternalTrafficPolicy = overrides.InternalTrafficPolicy
+	}
+	if overrides.SessionAffinity != nil {
+		svc.Spec.SessionAffinity = *overrides.SessionAffinity
+	}
+}
+
 // ApplyPrometheusOverrides applies the overrides to the given Prometheus.
 // Note: overrides must not be nil pointer.
 func ApplyPrometheusOverrides(prom *monitoringv1.Prometheus, overrides *operator.Prometheus) {
@@ -667,6 +751,25 @@ func mergeContainerOverrides(current []corev1.Container, overrides []containerOv
 		if co.LivenessProbe != nil && current[i].LivenessProbe != nil {
 			applyProbeOverride(current[i].LivenessProbe, co.LivenessProbe)
 		}
+		if co.SeccompProfile != nil {
+			current[i].SecurityContext = securitycontext.WithSeccompProfile(current[i].SecurityContext, co.SeccompProfile)
+		}
+	}
+}
+
+// applyAppArmorOverrides annotates the pod template with the AppArmor profile of each overridden container that
+// exists in the template.
+func applyAppArmorOverrides(template *corev1.PodTemplateSpec, overrides []containerOverride) {
+	for _, co := range overrides {
+		if co.AppArmorProfile == "" {
+			continue
+		}
+		for _, c := range template.Spec.Containers {
+			if c.Name == co.Name {
+				template.Annotations = common.MapExistsOrInitialize(template.Annotations)
+				template.Annotations[securitycontext.AppArmorAnnotation(c.Name)] = co.AppArmorProfile
+			}
+		}
 	}
 }
 
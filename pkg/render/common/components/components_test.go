@@ -152,7 +152,9 @@ var _ = Describe("Common components render tests", func() {
 				Expect(unhandledFields).To(BeEmpty())
 			}
 		},
-		Entry("APIServerDeployment", &v1.APIServerDeployment{}, false),
+		// The PodDisruptionBudget is applied to its own resource rather than to the Deployment, and the preStop
+		// sleep and extra volumes are applied by the API server render code since they depend on its containers.
+		Entry("APIServerDeployment", &v1.APIServerDeployment{}, false, "Spec.PodDisruptionBudget", "Spec.Template.Spec.PreStopSleepSeconds", "Spec.Template.Spec.ExtraVolumes"),
 		Entry("CalicoKubeControllersDeployment", &v1.CalicoKubeControllersDeployment{}, false),
 		Entry("CalicoWebhooksDeployment", &v1.CalicoWebhooksDeployment{}, false),
 		Entry("CalicoNodeDaemonSet", &v1.CalicoNodeDaemonSet{}, false),
@@ -196,7 +198,9 @@ var _ = Describe("Common components render tests", func() {
 		Entry("ManagerDeployment", &v1.ManagerDeployment{}, false),
 		Entry("PacketCaptureAPIDeployment", &v1.PacketCaptureAPIDeployment{}, false),
 		Entry("PolicyRecommendationDeployment", &v1.PolicyRecommendationDeployment{}, false),
-		Entry("TyphaDeployment", &v1.TyphaDeployment{}, false),
+		// The typha pools are rendered as Deployments of their own rather than applied as overrides, and the
+		// PodDisruptionBudget is applied to its own resource.
+		Entry("TyphaDeployment", &v1.TyphaDeployment{}, false, "Spec.Pools", "Spec.PodDisruptionBudget"),
 
 		// This last entry checks that the code above really does identify when a
 		// structure has unhandled fields.  To do this we can use any available structure
@@ -1180,6 +1184,46 @@ var _ = Describe("Common components render tests", func() {
 				Expect(*result.Spec.Template.Spec.TerminationGracePeriodSeconds).To(Equal(int64(3)))
 			}),
 
+		Entry("runtimeClassName",
+			defaultedDeployment,
+			func() *v1.TyphaDeployment {
+				return &v1.TyphaDeployment{
+					Spec: &v1.TyphaDeploymentSpec{
+						Template: &v1.TyphaDeploymentPodTemplateSpec{
+							Spec: &v1.TyphaDeploymentPodSpec{
+								RuntimeClassName: ptr.To("runc"),
+							},
+						},
+					},
+				}
+			},
+			func(result appsv1.Deployment) {
+				Expect(result.Spec.Template.Spec.RuntimeClassName).To(Equal(ptr.To("runc")))
+			}),
+
+		Entry("imagePullPolicy",
+			defaultedDeployment,
+			func() *v1.TyphaDeployment {
+				return &v1.TyphaDeployment{
+					Spec: &v1.TyphaDeploymentSpec{
+						Template: &v1.TyphaDeploymentPodTemplateSpec{
+							Spec: &v1.TyphaDeploymentPodSpec{
+								ImagePullPolicy: ptr.To(corev1.PullAlways),
+							},
+						},
+					},
+				}
+			},
+			func(result appsv1.Deployment) {
+				Expect(result.Spec.Template.Spec.Containers).NotTo(BeEmpty())
+				for _, c := range result.Spec.Template.Spec.Containers {
+					Expect(c.ImagePullPolicy).To(Equal(corev1.PullAlways))
+				}
+				for _, c := range result.Spec.Template.Spec.InitContainers {
+					Expect(c.ImagePullPolicy).To(Equal(corev1.PullAlways))
+				}
+			}),
+
 		Entry("strategy",
 			defaultedDeployment,
 			func() *v1.TyphaDeployment {
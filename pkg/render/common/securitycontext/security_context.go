@@ -19,6 +19,9 @@ import (
 	"k8s.io/utils/ptr"
 )
 
+// AppArmorAnnotationPrefix is the prefix of the pod annotation that sets the AppArmor profile of a container.
+const AppArmorAnnotationPrefix = "container.apparmor.security.beta.kubernetes.io/"
+
 var (
 	// It is recommended to choose UID and GID that don't collide with existing system users and groups.
 	// Non-system UID and GID range is normally from 1000 to 60000 (Debian derived systems define this
@@ -86,3 +89,18 @@ func NewNonRootPodContext() *corev1.PodSecurityContext {
 		},
 	}
 }
+
+// WithSeccompProfile returns the given container security context with its seccomp profile replaced. A new
+// security context is returned if sc is nil.
+func WithSeccompProfile(sc *corev1.SecurityContext, profile *corev1.SeccompProfile) *corev1.SecurityContext {
+	if sc == nil {
+		sc = &corev1.SecurityContext{}
+	}
+	sc.SeccompProfile = profile.DeepCopy()
+	return sc
+}
+
+// AppArmorAnnotation returns the pod annotation key that sets the AppArmor profile for the named container.
+func AppArmorAnnotation(containerName string) string {
+	return AppArmorAnnotationPrefix + containerName
+}
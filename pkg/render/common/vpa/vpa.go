@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpa
+
+import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	vpav1 "github.com/tigera/operator/pkg/apis/vpa/v1"
+)
+
+// VerticalPodAutoscaler returns a VerticalPodAutoscaler named after the given apps/v1 workload, which sets the
+// resource requests of all of its containers in the configured update mode. Limits are left alone so that they
+// keep acting as a ceiling.
+func VerticalPodAutoscaler(kind, name, namespace string, cfg *operatorv1.VerticalPodAutoscaling) *vpav1.VerticalPodAutoscaler {
+	return &vpav1.VerticalPodAutoscaler{
+		TypeMeta:   metav1.TypeMeta{Kind: vpav1.VerticalPodAutoscalerKind, APIVersion: vpav1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: vpav1.VerticalPodAutoscalerSpec{
+			TargetRef: &autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       kind,
+				Name:       name,
+			},
+			UpdatePolicy: &vpav1.PodUpdatePolicy{
+				UpdateMode: ptr.To(string(cfg.GetUpdateMode())),
+			},
+			ResourcePolicy: &vpav1.PodResourcePolicy{
+				ContainerPolicies: []vpav1.ContainerResourcePolicy{{
+					ContainerName:    vpav1.ContainerPolicyAllContainers,
+					ControlledValues: ptr.To(vpav1.ControlledValuesRequestsOnly),
+				}},
+			},
+		},
+	}
+}
+
+// ToDelete returns a VerticalPodAutoscaler named after the given workload, for deletion.
+func ToDelete(name, namespace string) *vpav1.VerticalPodAutoscaler {
+	return &vpav1.VerticalPodAutoscaler{
+		TypeMeta:   metav1.TypeMeta{Kind: vpav1.VerticalPodAutoscalerKind, APIVersion: vpav1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+}
@@ -45,6 +45,10 @@ import (
 	"github.com/tigera/operator/pkg/tls/certkeyusage"
 )
 
+// CompliancePodSecurityStandard is the least privileged pod security standard that the compliance components run
+// under. The benchmarker reads the configuration files of the host.
+const CompliancePodSecurityStandard PodSecurityStandard = PSSPrivileged
+
 const (
 	ComplianceNamespace                                       = "tigera-compliance"
 	ComplianceServiceName                                     = "compliance"
@@ -133,29 +137,29 @@ func (c *complianceComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	path := c.cfg.Installation.ImagePath
 	prefix := c.cfg.Installation.ImagePrefix
 	var err error
-	c.benchmarkerImage, err = components.GetReference(components.ComponentComplianceBenchmarker, reg, path, prefix, is)
+	c.benchmarkerImage, err = components.GetReference(components.ComponentComplianceBenchmarker, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 
 	errMsgs := []string{}
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
-	c.snapshotterImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+	c.snapshotterImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
-	c.serverImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+	c.serverImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
-	c.controllerImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+	c.controllerImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
-	c.reporterImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+	c.reporterImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
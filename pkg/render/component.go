@@ -39,3 +39,33 @@ type Component interface {
 	// that create pods. Return OSTypeAny means that no node selector should be set for the "kubernetes.io/os" label.
 	SupportedOSType() rmeta.OSType
 }
+
+// GarbageCollectionComponentLabel is set by the component handler on the objects of a GarbageCollectedComponent to the
+// identity of the component.
+const GarbageCollectionComponentLabel = "operator.tigera.io/gc-component"
+
+// GarbageCollectedComponent is a Component whose stale objects are garbage collected by the component handler. The
+// objects the component renders are labeled with its identity, and objects labeled with its identity that are no
+// longer rendered are deleted. This removes objects that were dropped or renamed in a release without listing them in
+// objsToDelete forever.
+//
+// Since every labeled object that is not rendered is deleted, a component may only opt in if it renders all of its
+// objects in a single call of Objects, and if the objects it shares with other components, such as the pull secrets
+// copied into calico-system, are not rendered by another garbage collected component. The components that do not opt
+// in are:
+//   - components rendered once per tenant, whose objects in the tenant namespaces would need an identity per tenant
+//     while they share their cluster-scoped objects;
+//   - components that render objects on behalf of other components, such as the pass-through, setup, namespace,
+//     certificate management and tier components;
+//   - components rendered once per custom resource, such as egress gateways, which only render part of the objects of
+//     their kind in a namespace;
+//   - components that copy the pull secrets into calico-system alongside the API server, such as goldmane and
+//     whisker.
+type GarbageCollectedComponent interface {
+	Component
+
+	// GarbageCollectionIdentity returns the identity of the component, which must be unique across all components. An
+	// empty identity opts the component out of garbage collection, for components that only meet the requirements
+	// above in some configurations.
+	GarbageCollectionIdentity() string
+}
@@ -377,7 +377,7 @@ func (c *csiComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	path := c.cfg.Installation.ImagePath
 	prefix := c.cfg.Installation.ImagePrefix
 	var err error
-	c.csiImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+	c.csiImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	if err != nil {
 		return err
 	}
@@ -409,6 +409,10 @@ func (c *csiComponent) Ready() bool {
 	return true
 }
 
+func (c *csiComponent) GarbageCollectionIdentity() string {
+	return "csi-node-driver"
+}
+
 func (c *csiComponent) SupportedOSType() rmeta.OSType {
 	return rmeta.OSTypeLinux
 }
@@ -53,6 +53,9 @@ const (
 	DexClientId      = "tigera-manager"
 	DexPolicyName    = networkpolicy.CalicoComponentPolicyPrefix + "dex"
 
+	// DexPodSecurityStandard is the least privileged pod security standard that Dex runs under.
+	DexPodSecurityStandard PodSecurityStandard = PSSRestricted
+
 	// TigeraCAPublicSecretName holds a copy of the operator's CA certificate (from tigera-ca-private)
 	// in calico-system. It exists so that an OpenShift Ingress fronting the manager can reference it
 	// via the destination-CA-certificate annotation on a reencrypt route. Only rendered when the
@@ -104,7 +107,7 @@ func (c *dexComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	path := c.cfg.Installation.ImagePath
 	prefix := c.cfg.Installation.ImagePrefix
 	var err error
-	c.image, err = components.GetReference(components.ComponentDex, reg, path, prefix, is)
+	c.image, err = components.GetReference(components.ComponentDex, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 
 	var errMsgs []string
 	if err != nil {
@@ -131,7 +134,7 @@ func (*dexComponent) SupportedOSType() rmeta.OSType {
 func (c *dexComponent) Objects() ([]client.Object, []client.Object) {
 
 	objs := []client.Object{
-		CreateNamespace(DexObjectName, c.cfg.Installation.KubernetesProvider, PSSRestricted, c.cfg.Installation.Azure),
+		CreateNamespace(DexObjectName, c.cfg.Installation, DexPodSecurityStandard),
 		c.calicoSystemNetworkPolicy(c.cfg.Installation.Variant),
 		networkpolicy.CalicoSystemDefaultDeny(DexNamespace),
 		CreateOperatorSecretsRoleBinding(DexNamespace),
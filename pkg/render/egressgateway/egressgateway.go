@@ -92,7 +92,7 @@ func (c *component) ResolveImages(is *operatorv1.ImageSet) error {
 	}
 
 	var err error
-	c.config.egwImage, err = components.GetReference(components.ComponentEgressGateway, reg, path, prefix, is)
+	c.config.egwImage, err = components.GetReference(components.ComponentEgressGateway, reg, path, prefix, is, c.config.Installation.ComponentImages...)
 	return err
 }
 
@@ -17,28 +17,37 @@ package render
 import (
 	"crypto/x509"
 	"fmt"
+	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/components"
 	rcomponents "github.com/tigera/operator/pkg/render/common/components"
 	relasticsearch "github.com/tigera/operator/pkg/render/common/elasticsearch"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 	"github.com/tigera/operator/pkg/render/common/networkpolicy"
+	"github.com/tigera/operator/pkg/render/common/podaffinity"
 	"github.com/tigera/operator/pkg/render/common/resourcequota"
 	"github.com/tigera/operator/pkg/render/common/secret"
 	"github.com/tigera/operator/pkg/render/common/securitycontext"
 	"github.com/tigera/operator/pkg/render/common/securitycontextconstraints"
+	"github.com/tigera/operator/pkg/render/common/vpa"
 	"github.com/tigera/operator/pkg/tls/certificatemanagement"
 	"github.com/tigera/operator/pkg/tls/certkeyusage"
 	"github.com/tigera/operator/pkg/url"
@@ -46,14 +55,78 @@ import (
 
 type ForwardingDestination string
 
+// LogCollectorPodSecurityStandard is the least privileged pod security standard that fluentd runs under. It reads
+// the logs from the host.
+const LogCollectorPodSecurityStandard PodSecurityStandard = PSSPrivileged
+
 const (
 	LogCollectorNamespace      = "tigera-fluentd"
 	FluentdFilterConfigMapName = "fluentd-filters"
 	FluentdFilterFlowName      = "flow"
 	FluentdFilterDNSName       = "dns"
+
+	// FluentdAdditionalOutputsConfigMapName is the name of the ConfigMap containing user supplied fluentd <match>
+	// sections. Each key holds a fluentd configuration file and must end in .conf.
+	FluentdAdditionalOutputsConfigMapName = "fluentd-additional-outputs"
+
+	// FluentdAdditionalOutputsCopyConfigMapName is the name of the ConfigMap with the fluentd <store> sections that
+	// copy the logs to the user supplied outputs, one file per fluentd tag.
+	FluentdAdditionalOutputsCopyConfigMapName = "fluentd-additional-outputs-copy"
+
+	// FluentdS3OutputsConfigMapName is the name of the ConfigMap with the fluentd <store> sections of the S3
+	// destinations, one file per fluentd tag.
+	FluentdS3OutputsConfigMapName = "fluentd-s3-outputs"
+
+	// FluentdSyslogOutputsConfigMapName is the name of the ConfigMap with the fluentd <store> sections of the Syslog
+	// destinations, one file per fluentd tag.
+	FluentdSyslogOutputsConfigMapName = "fluentd-syslog-outputs"
+
+	// FluentdSyslogDestinationCAsConfigMapName is the name of the ConfigMap with the CA certificates of the Syslog
+	// destinations that have their own, one file per destination.
+	FluentdSyslogDestinationCAsConfigMapName = "fluentd-syslog-destination-cas"
+
+	// FluentdClientCAConfigMapName is the name of the ConfigMap with the CA certificate that the client certificates
+	// presented to the fluentd inputs are verified against.
+	FluentdClientCAConfigMapName = "fluentd-client-ca"
+
+	// FluentdLogSourcesConfigMapName is the name of the ConfigMap with the fluentd <source> sections that tail the
+	// container logs registered with LogSources, one file per LogSource.
+	FluentdLogSourcesConfigMapName = "fluentd-log-sources"
+
+	// LogExportsSecretName is the name of the secret with the credentials of the LogExports that have their own,
+	// keyed by LogExport name.
+	LogExportsSecretName = "log-export-credentials"
+
+	// LogExportName is the k8s-app label of the Jobs that run the LogExports.
+	LogExportName = "log-export"
+
+	// LogExportLabel is set to the name of the LogExport on its CronJob and Jobs.
+	LogExportLabel = "operator.tigera.io/log-export"
+
+	// FluentdForwardInputConfigMapName is the name of the ConfigMap with the fluentd <source> section of the forward
+	// input the aggregator Deployment receives logs on.
+	FluentdForwardInputConfigMapName = "fluentd-forward-input"
+
+	// FluentdForwardInputTLSSecretName is the name of the secret containing the key pair the forward input of the
+	// aggregator Deployment serves with.
+	FluentdForwardInputTLSSecretName = "tigera-fluentd-forward-input-tls"
+
+	// FluentdForwarderLabel must be set on the pods that forward logs to the aggregator Deployment. The policy of the
+	// aggregator only allows these pods to reach its forward input.
+	FluentdForwarderLabel = "logcollector.operator.tigera.io/forwarder"
+
+	// S3DestinationsSecretName is the name of the secret with the credentials of the S3 destinations that have their
+	// own, keyed by destination name.
+	S3DestinationsSecretName = "log-collector-s3-destination-credentials"
+
 	S3FluentdSecretName        = "log-collector-s3-credentials"
 	S3KeyIdName                = "key-id"
 	S3KeySecretName            = "key-secret"
+	GCSFluentdSecretName       = "log-collector-gcs-credentials"
+	GCSKeyFileName             = "key.json"
+	AzureBlobFluentdSecretName = "log-collector-azure-blob-credentials"
+	AzureBlobAccessKeyName     = "access-key"
+	AzureBlobSASTokenName      = "sas-token"
 
 	// FluentdPrometheusTLSSecretName is the name of the secret containing the key pair fluentd presents to identify itself.
 	// Somewhat confusingly, this is named the prometheus TLS key pair because that was the first
@@ -62,13 +135,45 @@ const (
 	FluentdMetricsService                    = "fluentd-metrics"
 	FluentdMetricsServiceWindows             = "fluentd-metrics-windows"
 	FluentdInputService                      = "fluentd-http-input"
+	FluentdDeadLetterReplayName              = "fluentd-dead-letter-replay"
+	FluentdPipelineCanaryName                = "fluentd-pipeline-canary"
 	FluentdMetricsPortName                   = "fluentd-metrics-port"
 	FluentdMetricsPort                       = 9081
 	FluentdInputPortName                     = "fluentd-http-input-port"
 	FluentdInputPort                         = 9880
+	FluentdForwardInputService               = "fluentd-forward-input"
+	FluentdForwardInputPortName              = "fluentd-forward-port"
+	FluentdForwardInputPort                  = 24224
 	FluentdPolicyName                        = networkpolicy.CalicoComponentPolicyPrefix + "allow-fluentd-node"
+	FluentdNonClusterHostNetworkPolicyName   = networkpolicy.CalicoComponentPolicyPrefix + "fluentd-noncluster-host-access"
 	filterHashAnnotation                     = "hash.operator.tigera.io/fluentd-filters"
+	additionalOutputsHashAnnotation          = "hash.operator.tigera.io/fluentd-additional-outputs"
+	additionalOutputsVolumeName              = "fluentd-additional-outputs"
+	additionalOutputsMountDir                = "/etc/fluentd/outputs.d/"
+	additionalOutputsLabel                   = "@ADDITIONAL_OUTPUTS"
+	additionalOutputsCopyHashAnnotation      = "hash.operator.tigera.io/fluentd-additional-outputs-copy"
+	additionalOutputsCopyMountDir            = "/etc/fluentd/additional-outputs-copy.d/"
+	s3OutputsHashAnnotation                  = "hash.operator.tigera.io/fluentd-s3-outputs"
+	s3OutputsMountDir                        = "/etc/fluentd/s3-outputs.d/"
+	s3DestinationsCredentialHashAnnotation   = "hash.operator.tigera.io/s3-destination-credentials"
+	syslogOutputsHashAnnotation              = "hash.operator.tigera.io/fluentd-syslog-outputs"
+	syslogOutputsMountDir                    = "/etc/fluentd/syslog-outputs.d/"
+	logSourcesHashAnnotation                 = "hash.operator.tigera.io/fluentd-log-sources"
+	logSourcesVolumeName                     = "fluentd-log-sources"
+	logSourcesMountDir                       = "/etc/fluentd/sources.d/"
+	forwardInputHashAnnotation               = "hash.operator.tigera.io/fluentd-forward-input"
+	forwardInputVolumeName                   = "fluentd-forward-input"
+	forwardInputMountDir                     = "/etc/fluentd/forward.d/"
+	deadLetterQueueVolumeName                = "dead-letter-queue"
+	deadLetterQueueMountDir                  = "/var/lib/fluentd/dead-letter"
+	fluentdStateVolumeName                   = "fluentd-state"
+	fluentdStateMountDir                     = "/var/log/calico/fluentd"
+	fluentdMetricsUpstreamPort               = 9082
 	s3CredentialHashAnnotation               = "hash.operator.tigera.io/s3-credentials"
+	gcsCredentialHashAnnotation              = "hash.operator.tigera.io/gcs-credentials"
+	gcsCredentialVolumeName                  = "gcs-credentials"
+	gcsCredentialMountDir                    = "/etc/fluentd/gcs/"
+	azureBlobCredentialHashAnnotation        = "hash.operator.tigera.io/azure-blob-credentials"
 	splunkCredentialHashAnnotation           = "hash.operator.tigera.io/splunk-credentials"
 	eksCloudwatchLogCredentialHashAnnotation = "hash.operator.tigera.io/eks-cloudwatch-log-credentials"
 	fluentdDefaultFlush                      = "5s"
@@ -83,6 +188,25 @@ const (
 	SysLogPublicCertKey                      = "ca-bundle.crt"
 	SysLogPublicCAPath                       = SysLogPublicCADir + SysLogPublicCertKey
 	SyslogCAConfigMapName                    = "syslog-ca"
+	SyslogClientTLSSecretName                = "logcollector-syslog-client-tls"
+	syslogClientTLSHashAnnotation            = "hash.operator.tigera.io/syslog-client-tls"
+	syslogClientTLSVolumeName                = "syslog-client-tls"
+	syslogClientTLSMountDir                  = "/etc/fluentd/syslog-client-tls/"
+	syslogDestinationCAsHashAnnotation       = "hash.operator.tigera.io/syslog-destination-cas"
+	syslogDestinationCAsMountDir             = "/etc/fluentd/syslog-destination-cas/"
+	clientCAHashAnnotation                   = "hash.operator.tigera.io/fluentd-client-ca"
+	clientCAMountDir                         = "/etc/fluentd/client-ca/"
+	LokiFluentdCredentialSecretName          = "logcollector-loki-credentials"
+	LokiFluentdSecretUsernameKey             = "username"
+	LokiFluentdSecretPasswordKey             = "password"
+	LokiFluentdSecretTokenKey                = "token"
+	LokiCAConfigMapName                      = "loki-ca"
+	lokiCredentialHashAnnotation             = "hash.operator.tigera.io/loki-credentials"
+	OTLPHeadersSecretName                    = "logcollector-otlp-headers"
+	OTLPCAConfigMapName                      = "otlp-ca"
+	otlpHeadersHashAnnotation                = "hash.operator.tigera.io/otlp-headers"
+	otlpHeadersVolumeName                    = "otlp-headers"
+	otlpHeadersMountDir                      = "/etc/fluentd/otlp-headers/"
 
 	// Constants for Linseed token volume mounting in managed clusters.
 	LinseedTokenVolumeName = "linseed-token"
@@ -92,30 +216,71 @@ const (
 	LinseedVolumeMountPath = "/var/run/secrets/tigera.io/linseed/"
 	LinseedTokenPath       = "/var/run/secrets/tigera.io/linseed/token"
 
+	// The directories the kubelet writes container logs to. The files in the first link to the second.
+	varLogContainersPath = "/var/log/containers"
+	varLogPodsPath       = "/var/log/pods"
+
 	fluentdName        = "tigera-fluentd"
 	fluentdWindowsName = "tigera-fluentd-windows"
 
 	FluentdNodeName        = "fluentd-node"
 	fluentdNodeWindowsName = "fluentd-node-windows"
 
+	// FluentdAuditName is the name of the Deployment that forwards audit logs when the LogCollector uses the
+	// AuditDeployment collector type.
+	FluentdAuditName = "fluentd-audit"
+
+	// FluentdAggregatorName is the name of the Deployment that receives the logs forwarded to it when the LogCollector
+	// uses the Aggregator collector type.
+	FluentdAggregatorName = "fluentd-aggregator"
+
 	EKSLogForwarderName          = "eks-log-forwarder"
 	EKSLogForwarderTLSSecretName = "tigera-eks-log-forwarder-tls"
 
+	// eksLogForwarderWindowsName is the name of the forwarder that used to be rendered alongside the Linux one on
+	// clusters with Windows nodes. Both read the same CloudWatch log group, so it is now only cleaned up.
+	eksLogForwarderWindowsName = "eks-log-forwarder-windows"
+
 	PacketCaptureAPIRole        = "packetcapture-api-role"
 	PacketCaptureAPIRoleBinding = "packetcapture-api-role-binding"
 
-	ForwardingDestinationS3     ForwardingDestination = "S3"
-	ForwardingDestinationSyslog ForwardingDestination = "Syslog"
-	ForwardingDestinationSplunk ForwardingDestination = "Splunk"
+	ForwardingDestinationS3        ForwardingDestination = "S3"
+	ForwardingDestinationGCS       ForwardingDestination = "GCS"
+	ForwardingDestinationAzureBlob ForwardingDestination = "AzureBlob"
+	ForwardingDestinationSyslog    ForwardingDestination = "Syslog"
+	ForwardingDestinationSplunk    ForwardingDestination = "Splunk"
+	ForwardingDestinationLoki      ForwardingDestination = "Loki"
+	ForwardingDestinationOTLP      ForwardingDestination = "OTLP"
+
+	ForwardingDestinationSecurityLake ForwardingDestination = "SecurityLake"
+
+	// AWSRoleARNAnnotation is the service account annotation used by IAM roles for service accounts (IRSA)
+	// on EKS to inject credentials for the given role.
+	AWSRoleARNAnnotation = "eks.amazonaws.com/role-arn"
 )
 
 var FluentdSourceEntityRule = v3.EntityRule{
 	NamespaceSelector: fmt.Sprintf("name == '%s'", LogCollectorNamespace),
-	Selector:          networkpolicy.KubernetesAppSelector(FluentdNodeName, fluentdNodeWindowsName),
+	Selector:          networkpolicy.KubernetesAppSelector(fluentdAppNames...),
 }
 
+// fluentdAppNames are the k8s-app labels of the pods that run fluentd with the outputs of the log collector.
+var fluentdAppNames = []string{FluentdNodeName, fluentdNodeWindowsName, FluentdDeadLetterReplayName, FluentdPipelineCanaryName}
+
 var EKSLogForwarderEntityRule = networkpolicy.CreateSourceEntityRule(LogCollectorNamespace, EKSLogForwarderName)
 
+const (
+	// FluentdInputTLSSecretName is the name of the secret containing the key pair the fluentd input service for
+	// non-cluster hosts serves with.
+	FluentdInputTLSSecretName = "tigera-fluentd-http-input-tls"
+	// FluentdInputClientTLSSecretName is the name non-cluster hosts give the CSRs for their fluentd input client
+	// certificates, suffixed with their hostname.
+	FluentdInputClientTLSSecretName = "tigera-fluentd-http-input-client-tls"
+	// FluentdInputClientCommonName is the common name of the client certificates non-cluster hosts present to the
+	// fluentd input service.
+	FluentdInputClientCommonName = "fluentd-http-input-client"
+)
+
 // Register secret/certs that need Server and Client Key usage
 func init() {
 	certkeyusage.SetCertKeyUsage(FluentdPrometheusTLSSecretName, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth})
@@ -132,13 +297,44 @@ type S3Credential struct {
 	KeySecret []byte
 }
 
+// GCSCredential holds the JSON key of the Google Cloud service account fluentd uses to write to GCS.
+type GCSCredential struct {
+	KeyFile []byte
+}
+
+// AzureBlobCredential holds either the storage account access key or the shared access signature fluentd
+// uses to write to Azure Blob Storage.
+type AzureBlobCredential struct {
+	AccessKey []byte
+	SASToken  []byte
+}
+
 type SplunkCredential struct {
 	Token []byte
 }
 
+// LokiCredential holds either the basic auth or bearer token credentials fluentd uses to authenticate to Loki.
+type LokiCredential struct {
+	Username []byte
+	Password []byte
+	Token    []byte
+}
+
+// OTLPHeaders holds the additional headers fluentd sends to the OpenTelemetry collector, keyed by header name.
+type OTLPHeaders struct {
+	Headers map[string][]byte
+}
+
+// SyslogClientCredential is the key pair fluentd presents when the Syslog server requires mutual TLS.
+type SyslogClientCredential struct {
+	Cert []byte
+	Key  []byte
+}
+
 func Fluentd(cfg *FluentdConfiguration) Component {
 	return &fluentdComponent{
 		cfg:          cfg,
+		filters:      fluentdFilters(cfg),
 		probeTimeout: 10,
 		probePeriod:  60,
 	}
@@ -151,14 +347,35 @@ type EksCloudwatchLogConfig struct {
 	GroupName     string
 	StreamPrefix  string
 	FetchInterval int32
+	// RoleARN is set when the forwarder authenticates with IAM roles for service accounts, in which case AwsId and
+	// AwsKey are not used.
+	RoleARN                   string
+	ServiceAccountAnnotations map[string]string
+	// Scheduling and sizing of the eks-log-forwarder deployment. Any field left unset keeps its default.
+	NodeSelector map[string]string
+	Tolerations  []corev1.Toleration
+	Resources    *corev1.ResourceRequirements
+	Replicas     *int32
 }
 
 // FluentdConfiguration contains all the config information needed to render the component.
 type FluentdConfiguration struct {
-	LogCollector   *operatorv1.LogCollector
-	S3Credential   *S3Credential
-	SplkCredential *SplunkCredential
-	Filters        *FluentdFilters
+	LogCollector        *operatorv1.LogCollector
+	S3Credential        *S3Credential
+	GCSCredential       *GCSCredential
+	AzureBlobCredential *AzureBlobCredential
+	SplkCredential      *SplunkCredential
+	LokiCredential      *LokiCredential
+	OTLPHeaders         *OTLPHeaders
+	Filters             *FluentdFilters
+	// AdditionalOutputs holds user supplied fluentd configuration files, keyed by file name, each containing only
+	// <match> sections. They receive a copy of the logs of every tag that the operator managed outputs export.
+	AdditionalOutputs map[string]string
+	// S3DestinationCredentials holds the credentials of the S3 destinations that have their own, keyed by
+	// destination name. The other destinations use S3Credential.
+	S3DestinationCredentials map[string]*S3Credential
+	// LogSources holds the accepted LogSources, whose container logs are tailed by the Linux fluentd DaemonSet.
+	LogSources []operatorv1.LogSource
 	// ESClusterConfig is only populated for when EKSConfig
 	// is also defined
 	ESClusterConfig *relasticsearch.ClusterConfig
@@ -179,6 +396,13 @@ type FluentdConfiguration struct {
 	// Whether to use User provided certificate or not.
 	UseSyslogCertificate bool
 
+	// SyslogClientCredential is set when fluentd must authenticate to the Syslog server with a client certificate.
+	SyslogClientCredential *SyslogClientCredential
+
+	// SyslogDestinationCAs holds the CA certificates of the Syslog destinations that have their own, keyed by
+	// destination name. Each destination only trusts its own CA, so they are not part of TrustedBundle.
+	SyslogDestinationCAs map[string]certificatemanagement.CertificateInterface
+
 	// EKSLogForwarderKeyPair contains the certificate presented by EKS LogForwarder when communicating with Linseed
 	EKSLogForwarderKeyPair certificatemanagement.KeyPairInterface
 
@@ -186,15 +410,44 @@ type FluentdConfiguration struct {
 
 	NonClusterHost *operatorv1.NonClusterHost
 
+	// InputKeyPair is the key pair the fluentd input service for non-cluster hosts serves with. Only set when
+	// NonClusterHost is.
+	InputKeyPair certificatemanagement.KeyPairInterface
+
+	// ClientCA is the operator CA, which the client certificates presented to the fluentd inputs must be signed by.
+	// Unlike TrustedBundle, it does not include the system root certificates.
+	ClientCA certificatemanagement.CertificateInterface
+
+	// ForwardInputKeyPair is the key pair the forward input of the aggregator Deployment serves with. Only set when
+	// the LogCollector uses the Aggregator collector type.
+	ForwardInputKeyPair certificatemanagement.KeyPairInterface
+
+	// LogExports are the scheduled log exports that a CronJob is rendered for. Only rendered on Linux.
+	LogExports []operatorv1.LogExport
+
+	// LogExportCredentials are the credentials of the LogExports that have their own, keyed by LogExport name.
+	LogExportCredentials map[string]*S3Credential
+
+	// StaleLogExports are the names of the LogExports that no longer exist, whose CronJobs are deleted.
+	StaleLogExports []string
+
 	// LicenseExpired indicates the license has expired and fluentd DaemonSet should be removed.
 	LicenseExpired bool
+
+	// Whether the VerticalPodAutoscaler CRD is installed in the cluster. VerticalPodAutoscalers for the fluentd
+	// workloads are only rendered if this is true.
+	VPACRDExists bool
 }
 
 type fluentdComponent struct {
-	cfg          *FluentdConfiguration
-	image        string
-	probeTimeout int32
-	probePeriod  int32
+	cfg *FluentdConfiguration
+	// filters are the filters of the fluentd-filters ConfigMap combined with those generated from the LogCollector.
+	filters            *FluentdFilters
+	image              string
+	logExportImage     string
+	kubeRBACProxyImage string
+	probeTimeout       int32
+	probePeriod        int32
 }
 
 func (c *fluentdComponent) ResolveImages(is *operatorv1.ImageSet) error {
@@ -202,20 +455,64 @@ func (c *fluentdComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	path := c.cfg.Installation.ImagePath
 	prefix := c.cfg.Installation.ImagePrefix
 
+	fips := operatorv1.IsFIPSModeEnabled(c.cfg.Installation.FIPSMode)
 	if c.cfg.OSType == rmeta.OSTypeWindows {
+		// There is no FIPS build of the Windows fluentd image.
+		if fips {
+			return fmt.Errorf("FIPS mode is not supported for %s: no FIPS image is available for %s", fluentdWindowsName, components.ComponentFluentdWindows.Image)
+		}
 		var err error
-		c.image, err = components.GetReference(components.ComponentFluentdWindows, reg, path, prefix, is)
+		c.image, err = components.GetReference(components.ComponentFluentdWindows, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 		return err
 	}
 
+	image := components.ComponentFluentd
+	if fips {
+		image = components.ComponentFluentdFIPS
+	}
 	var err error
-	c.image, err = components.GetReference(components.ComponentFluentd, reg, path, prefix, is)
+	c.image, err = components.GetReference(image, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	if err != nil {
 		return err
 	}
+
+	if len(c.cfg.LogExports) > 0 {
+		// There is no FIPS build of the log exporter image.
+		if fips {
+			return fmt.Errorf("FIPS mode is not supported for LogExports: no FIPS image is available for %s", components.ComponentLogExporter.Image)
+		}
+		c.logExportImage, err = components.GetReference(components.ComponentLogExporter, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.metricsAuthEnabled() {
+		// There is no FIPS build of the kube-rbac-proxy image.
+		if fips {
+			return fmt.Errorf("FIPS mode is not supported for LogCollector MetricsAuth: no FIPS image is available for %s", components.ComponentKubeRBACProxy.Image)
+		}
+		c.kubeRBACProxyImage, err = components.GetReference(kubeRBACProxyImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
+	}
 	return err
 }
 
+// metricsAuthEnabled returns true if the metrics of the Linux fluentd pods are served through kube-rbac-proxy. There
+// is no Windows build of kube-rbac-proxy.
+func (c *fluentdComponent) metricsAuthEnabled() bool {
+	return c.cfg.OSType == rmeta.OSTypeLinux && c.cfg.LogCollector != nil && c.cfg.LogCollector.Spec.MetricsAuthEnabled()
+}
+
+// containers returns the containers of a fluentd pod: fluentd, followed by the kube-rbac-proxy that serves its
+// metrics when the LogCollector MetricsAuth is TokenReview.
+func (c *fluentdComponent) containers() []corev1.Container {
+	containers := []corev1.Container{c.container()}
+	if c.metricsAuthEnabled() {
+		containers = append(containers, kubeRBACProxyContainer(c.kubeRBACProxyImage, c.metricsPort(), fluentdMetricsUpstreamPort, c.cfg.FluentdKeyPair, c.cfg.Installation))
+	}
+	return containers
+}
+
 func (c *fluentdComponent) SupportedOSType() rmeta.OSType {
 	return c.cfg.OSType
 }
@@ -301,20 +598,65 @@ func (c *fluentdComponent) Objects() ([]client.Object, []client.Object) {
 	if c.cfg.S3Credential != nil {
 		objs = append(objs, c.s3CredentialSecret())
 	}
+	if len(c.cfg.S3DestinationCredentials) > 0 {
+		objs = append(objs, c.s3DestinationsCredentialSecret())
+	}
+	for _, o := range c.destinationOutputs() {
+		objs = append(objs, o.configMap())
+	}
+	if c.cfg.GCSCredential != nil {
+		objs = append(objs, c.gcsCredentialSecret())
+	}
+	if c.cfg.AzureBlobCredential != nil {
+		objs = append(objs, c.azureBlobCredentialSecret())
+	}
 	if c.cfg.SplkCredential != nil {
 		objs = append(objs, secret.ToRuntimeObjects(secret.CopyToNamespace(LogCollectorNamespace, c.splunkCredentialSecret()...)...)...)
 	}
-	if c.cfg.Filters != nil {
+	if c.cfg.SyslogClientCredential != nil {
+		objs = append(objs, c.syslogClientTLSSecret())
+	}
+	if len(c.cfg.SyslogDestinationCAs) > 0 {
+		objs = append(objs, c.syslogDestinationCAsConfigMap())
+	}
+	if c.clientCAEnabled() {
+		objs = append(objs, c.clientCAConfigMap())
+	}
+	if c.cfg.LokiCredential != nil {
+		objs = append(objs, c.lokiCredentialSecret())
+	}
+	if c.cfg.OTLPHeaders != nil {
+		objs = append(objs, c.otlpHeadersSecret())
+	}
+	if c.filters != nil {
 		objs = append(objs, c.filtersConfigMap())
 	}
+	if len(c.cfg.AdditionalOutputs) > 0 {
+		objs = append(objs, c.additionalOutputsConfigMap())
+	}
+	if c.logSourcesEnabled() {
+		objs = append(objs, c.logSourcesConfigMap())
+	}
 	if c.cfg.EKSConfig != nil && c.cfg.OSType == rmeta.OSTypeLinux {
 		objs = append(objs,
 			c.eksLogForwarderClusterRole(),
 			c.eksLogForwarderClusterRoleBinding())
 
-		objs = append(objs, c.eksLogForwarderServiceAccount(),
-			c.eksLogForwarderSecret(),
-			c.eksLogForwarderDeployment())
+		objs = append(objs, c.eksLogForwarderServiceAccount())
+		if c.cfg.EKSConfig.RoleARN == "" {
+			objs = append(objs, c.eksLogForwarderSecret())
+		} else {
+			toDelete = append(toDelete, c.eksLogForwarderSecret())
+		}
+		objs = append(objs, c.eksLogForwarderDeployment())
+	}
+	if c.cfg.OSType == rmeta.OSTypeWindows {
+		// The forwarder only runs on Linux. Remove the Windows one rendered by previous versions, which read the same
+		// CloudWatch log group and duplicated every event.
+		toDelete = append(toDelete, &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: eksLogForwarderWindowsName, Namespace: LogCollectorNamespace},
+		})
 	}
 
 	// Add in the cluster role and binding.
@@ -335,21 +677,138 @@ func (c *fluentdComponent) Objects() ([]client.Object, []client.Object) {
 		objs = append(objs, c.packetCaptureApiRole(), c.packetCaptureApiRoleBinding())
 	}
 
-	if c.cfg.LicenseExpired {
+	if c.cfg.LicenseExpired || c.auditDeploymentEnabled() || c.aggregatorEnabled() {
 		toDelete = append(toDelete, c.daemonset())
 	} else {
 		objs = append(objs, c.daemonset())
 	}
+	if c.cfg.OSType == rmeta.OSTypeLinux {
+		if c.auditDeploymentEnabled() && !c.cfg.LicenseExpired {
+			objs = append(objs, c.auditDeployment())
+		} else {
+			toDelete = append(toDelete, c.auditDeployment())
+		}
+		if c.aggregatorEnabled() && !c.cfg.LicenseExpired {
+			objs = append(objs, c.forwardInputConfigMap(), c.forwardInputService(), c.aggregatorDeployment())
+		} else {
+			toDelete = append(toDelete, c.forwardInputConfigMap(), c.forwardInputService(), c.aggregatorDeployment())
+		}
+	}
+	if c.cfg.VPACRDExists {
+		vpas, vpasToDelete := c.verticalPodAutoscalers()
+		objs = append(objs, vpas...)
+		toDelete = append(toDelete, vpasToDelete...)
+	}
+
+	if c.cfg.OSType == rmeta.OSTypeLinux {
+		if c.deadLetterQueueEnabled() {
+			objs = append(objs, c.deadLetterReplayCronJob())
+		} else {
+			toDelete = append(toDelete, &batchv1.CronJob{
+				TypeMeta:   metav1.TypeMeta{Kind: "CronJob", APIVersion: "batch/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: FluentdDeadLetterReplayName, Namespace: LogCollectorNamespace},
+			})
+		}
+	}
+
+	if c.cfg.OSType == rmeta.OSTypeLinux {
+		if c.pipelineCanaryEnabled() {
+			objs = append(objs, c.pipelineCanaryCronJob())
+		} else {
+			toDelete = append(toDelete, &batchv1.CronJob{
+				TypeMeta:   metav1.TypeMeta{Kind: "CronJob", APIVersion: "batch/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: FluentdPipelineCanaryName, Namespace: LogCollectorNamespace},
+			})
+		}
+	}
+
+	if c.cfg.OSType == rmeta.OSTypeLinux {
+		if len(c.cfg.LogExportCredentials) > 0 {
+			objs = append(objs, c.logExportsCredentialSecret())
+		} else {
+			toDelete = append(toDelete, c.logExportsCredentialSecret())
+		}
+		for _, le := range c.cfg.LogExports {
+			if c.cfg.LicenseExpired {
+				toDelete = append(toDelete, c.logExportCronJob(le))
+			} else {
+				objs = append(objs, c.logExportCronJob(le))
+			}
+		}
+		for _, name := range c.cfg.StaleLogExports {
+			toDelete = append(toDelete, &batchv1.CronJob{
+				TypeMeta:   metav1.TypeMeta{Kind: "CronJob", APIVersion: "batch/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: LogExportCronJobName(name), Namespace: LogCollectorNamespace},
+			})
+		}
+	}
 
 	if c.cfg.NonClusterHost != nil && c.cfg.OSType == rmeta.OSTypeLinux {
 		objs = append(objs, c.nonClusterHostInputService())
 	}
+	if c.cfg.OSType == rmeta.OSTypeLinux {
+		if c.cfg.NonClusterHost != nil && len(c.cfg.NonClusterHost.Spec.SourceCIDRs) > 0 {
+			objs = append(objs, c.nonClusterHostCalicoSystemPolicy())
+		} else {
+			toDelete = append(toDelete, c.nonClusterHostCalicoSystemPolicy())
+		}
+	}
 
 	return objs, toDelete
 }
 
+// inputTLSEnabled returns whether the fluentd input service for non-cluster hosts serves with its own key pair. The
+// input service only selects the Linux fluentd pods.
+func (c *fluentdComponent) inputTLSEnabled() bool {
+	return c.cfg.InputKeyPair != nil && c.cfg.NonClusterHost != nil && c.cfg.OSType == rmeta.OSTypeLinux
+}
+
+// inputTLSEnvVars configures the TLS of the fluentd input service for non-cluster hosts. When client certificates are
+// required, hosts must present a certificate signed by the operator CA.
+func (c *fluentdComponent) inputTLSEnvVars() []corev1.EnvVar {
+	if !c.inputTLSEnabled() {
+		return nil
+	}
+	envs := []corev1.EnvVar{
+		{Name: "INPUT_TLS_KEY_PATH", Value: c.cfg.InputKeyPair.VolumeMountKeyFilePath()},
+		{Name: "INPUT_TLS_CRT_PATH", Value: c.cfg.InputKeyPair.VolumeMountCertificateFilePath()},
+	}
+	if c.cfg.NonClusterHost.LogInputClientCertificateRequired() {
+		envs = append(envs,
+			corev1.EnvVar{Name: "INPUT_TLS_CLIENT_AUTH", Value: "true"},
+			corev1.EnvVar{Name: "INPUT_TLS_CA_PATH", Value: c.clientCAPath()},
+		)
+	}
+	return envs
+}
+
+// clientCAEnabled returns whether a fluentd input verifies the client certificates against the operator CA.
+func (c *fluentdComponent) clientCAEnabled() bool {
+	if c.cfg.ClientCA == nil {
+		return false
+	}
+	return (c.inputTLSEnabled() && c.cfg.NonClusterHost.LogInputClientCertificateRequired()) || c.forwardInputTLSEnabled()
+}
+
+func (c *fluentdComponent) clientCAPath() string {
+	return c.path(clientCAMountDir + corev1.ServiceAccountRootCAKey)
+}
+
+// clientCAConfigMap holds the operator CA only. The trusted bundle cannot be used to verify client certificates, as
+// it includes the system root certificates, which would accept a certificate of any public CA.
+func (c *fluentdComponent) clientCAConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FluentdClientCAConfigMapName,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: map[string]string{corev1.ServiceAccountRootCAKey: string(c.cfg.ClientCA.GetCertificatePEM())},
+	}
+}
+
 func (c *fluentdComponent) nonClusterHostInputService() *corev1.Service {
-	return &corev1.Service{
+	svc := &corev1.Service{
 		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      FluentdInputService,
@@ -372,6 +831,72 @@ func (c *fluentdComponent) nonClusterHostInputService() *corev1.Service {
 			},
 		},
 	}
+	rcomponents.ApplyServiceOverrides(svc, c.cfg.LogCollector.Spec.InputService)
+	return svc
+}
+
+// forwardInputTLSEnabled returns whether the forward input of the aggregator Deployment serves with its own key pair.
+func (c *fluentdComponent) forwardInputTLSEnabled() bool {
+	return c.aggregatorEnabled() && c.cfg.ForwardInputKeyPair != nil && c.cfg.OSType == rmeta.OSTypeLinux
+}
+
+// forwardInput returns the fluentd configuration file of the forward input that the aggregator Deployment receives
+// the logs of the forwarders on. Forwarders must connect with TLS, trust the operator CA and present a client
+// certificate signed by it.
+func (c *fluentdComponent) forwardInput() map[string]string {
+	var b strings.Builder
+	b.WriteString("<source>\n")
+	b.WriteString("  @type forward\n")
+	b.WriteString("  @id forward-input\n")
+	fmt.Fprintf(&b, "  port %d\n", FluentdForwardInputPort)
+	b.WriteString("  bind 0.0.0.0\n")
+	if c.forwardInputTLSEnabled() {
+		b.WriteString("  <transport tls>\n")
+		fmt.Fprintf(&b, "    cert_path %s\n", c.cfg.ForwardInputKeyPair.VolumeMountCertificateFilePath())
+		fmt.Fprintf(&b, "    private_key_path %s\n", c.cfg.ForwardInputKeyPair.VolumeMountKeyFilePath())
+		if c.clientCAEnabled() {
+			b.WriteString("    client_cert_auth true\n")
+			fmt.Fprintf(&b, "    ca_path %s\n", c.clientCAPath())
+		}
+		b.WriteString("  </transport>\n")
+	}
+	b.WriteString("</source>\n")
+	return map[string]string{"forward.conf": b.String()}
+}
+
+func (c *fluentdComponent) forwardInputConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FluentdForwardInputConfigMapName,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: c.forwardInput(),
+	}
+}
+
+// forwardInputService load-balances the forwarders across the aggregator pods. It selects them by their Deployment
+// name, as they share the fluentd-node k8s-app label with the metrics service.
+func (c *fluentdComponent) forwardInputService() *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FluentdForwardInputService,
+			Namespace: LogCollectorNamespace,
+			Labels:    map[string]string{"k8s-app": FluentdNodeName},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app.kubernetes.io/name": FluentdAggregatorName},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       FluentdForwardInputPortName,
+					Port:       int32(FluentdForwardInputPort),
+					TargetPort: intstr.FromInt(FluentdForwardInputPort),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		},
+	}
 }
 
 func (c *fluentdComponent) externalLinseedRoleBinding() *rbacv1.RoleBinding {
@@ -439,8 +964,129 @@ func (c *fluentdComponent) s3CredentialSecret() *corev1.Secret {
 	}
 }
 
+func (c *fluentdComponent) gcsCredentialSecret() *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GCSFluentdSecretName,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: map[string][]byte{
+			GCSKeyFileName: c.cfg.GCSCredential.KeyFile,
+		},
+	}
+}
+
+func (c *fluentdComponent) azureBlobCredentialSecret() *corev1.Secret {
+	data := map[string][]byte{}
+	if len(c.cfg.AzureBlobCredential.SASToken) > 0 {
+		data[AzureBlobSASTokenName] = c.cfg.AzureBlobCredential.SASToken
+	} else {
+		data[AzureBlobAccessKeyName] = c.cfg.AzureBlobCredential.AccessKey
+	}
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      AzureBlobFluentdSecretName,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: data,
+	}
+}
+
+// fluentdFilters returns the filters of the fluentd-filters ConfigMap, with the flow log filters generated from the
+// LogCollector filters appended to the user's own, or nil if there are no filters at all.
+func fluentdFilters(cfg *FluentdConfiguration) *FluentdFilters {
+	var generated string
+	if cfg.LogCollector != nil {
+		generated = flowLogFilters(cfg.LogCollector.Spec.Filters)
+	}
+	if generated == "" {
+		return cfg.Filters
+	}
+
+	filters := &FluentdFilters{Flow: generated}
+	if cfg.Filters != nil {
+		filters.DNS = cfg.Filters.DNS
+		if cfg.Filters.Flow != "" {
+			filters.Flow = strings.TrimRight(cfg.Filters.Flow, "\n") + "\n" + generated
+		}
+	}
+	return filters
+}
+
+// flowLogFilters renders the LogCollector filters as fluentd grep filters on the flow logs. A flow log is dropped if
+// its source or destination namespace is excluded, or if its source or destination pod has every label of an
+// excluded pod selector. The labels of flow logs are rendered as a list of key=value strings, which the patterns
+// match against.
+func flowLogFilters(filters *operatorv1.LogCollectorFilters) string {
+	if filters == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	if len(filters.ExcludedNamespaces) > 0 {
+		namespaces := make([]string, len(filters.ExcludedNamespaces))
+		for i, ns := range filters.ExcludedNamespaces {
+			namespaces[i] = regexp.QuoteMeta(ns)
+		}
+		pattern := fmt.Sprintf("/^(%s)$/", strings.Join(namespaces, "|"))
+		b.WriteString("<filter flows>\n  @type grep\n")
+		for _, key := range []string{"source_namespace", "dest_namespace"} {
+			fmt.Fprintf(&b, "  <exclude>\n    key %s\n    pattern %s\n  </exclude>\n", key, pattern)
+		}
+		b.WriteString("</filter>\n")
+	}
+
+	for _, selector := range filters.ExcludedPodSelectors {
+		patterns := labelSelectorPatterns(selector)
+		if len(patterns) == 0 {
+			continue
+		}
+		for _, key := range []string{"$.source_labels.labels", "$.dest_labels.labels"} {
+			b.WriteString("<filter flows>\n  @type grep\n  <and>\n")
+			for _, pattern := range patterns {
+				fmt.Fprintf(&b, "    <exclude>\n      key %s\n      pattern %s\n    </exclude>\n", key, pattern)
+			}
+			b.WriteString("  </and>\n</filter>\n")
+		}
+	}
+	return b.String()
+}
+
+// labelSelectorPatterns returns a pattern per requirement of the selector, in a stable order. Only the In and Exists
+// operators can be expressed, and validation rejects selectors that use the others.
+func labelSelectorPatterns(selector metav1.LabelSelector) []string {
+	quote := func(s string) string {
+		return strings.ReplaceAll(regexp.QuoteMeta(s), "/", "\\/")
+	}
+
+	var patterns []string
+	keys := make([]string, 0, len(selector.MatchLabels))
+	for k := range selector.MatchLabels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		patterns = append(patterns, fmt.Sprintf(`/"%s=%s"/`, quote(k), quote(selector.MatchLabels[k])))
+	}
+	for _, req := range selector.MatchExpressions {
+		switch req.Operator {
+		case metav1.LabelSelectorOpIn:
+			values := make([]string, len(req.Values))
+			for i, v := range req.Values {
+				values[i] = quote(v)
+			}
+			patterns = append(patterns, fmt.Sprintf(`/"%s=(%s)"/`, quote(req.Key), strings.Join(values, "|")))
+		case metav1.LabelSelectorOpExists:
+			patterns = append(patterns, fmt.Sprintf(`/"%s=/`, quote(req.Key)))
+		}
+	}
+	return patterns
+}
+
 func (c *fluentdComponent) filtersConfigMap() *corev1.ConfigMap {
-	if c.cfg.Filters == nil {
+	if c.filters == nil {
 		return nil
 	}
 	return &corev1.ConfigMap{
@@ -450,70 +1096,474 @@ func (c *fluentdComponent) filtersConfigMap() *corev1.ConfigMap {
 			Namespace: LogCollectorNamespace,
 		},
 		Data: map[string]string{
-			FluentdFilterFlowName: c.cfg.Filters.Flow,
-			FluentdFilterDNSName:  c.cfg.Filters.DNS,
+			FluentdFilterFlowName: c.filters.Flow,
+			FluentdFilterDNSName:  c.filters.DNS,
 		},
 	}
 }
 
-func (c *fluentdComponent) splunkCredentialSecret() []*corev1.Secret {
-	if c.cfg.SplkCredential == nil {
-		return nil
+// additionalOutputsConfigMap returns the ConfigMap with the user supplied outputs. Each file is wrapped in a <label>
+// section, so that its <match> sections only receive the copies of the logs that the additional outputs store of
+// each tag relabels, rather than competing with the operator managed outputs for the logs.
+func (c *fluentdComponent) additionalOutputsConfigMap() *corev1.ConfigMap {
+	data := map[string]string{}
+	for name, config := range c.cfg.AdditionalOutputs {
+		data[name] = fmt.Sprintf("<label %s>\n%s\n</label>\n", additionalOutputsLabel, strings.TrimRight(config, "\n"))
 	}
-	return []*corev1.Secret{
-		{
-			TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      SplunkFluentdTokenSecretName,
-				Namespace: LogCollectorNamespace,
-			},
-			Data: map[string][]byte{
-				SplunkFluentdSecretTokenKey: c.cfg.SplkCredential.Token,
-			},
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FluentdAdditionalOutputsConfigMapName,
+			Namespace: LogCollectorNamespace,
 		},
+		Data: data,
 	}
 }
 
-func (c *fluentdComponent) fluentdServiceAccount() *corev1.ServiceAccount {
-	return &corev1.ServiceAccount{
-		TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
-		ObjectMeta: metav1.ObjectMeta{Name: c.fluentdNodeName(), Namespace: LogCollectorNamespace},
+// additionalOutputsStores returns the fluentd <store> section that copies the logs of every tag exported by the
+// operator managed outputs to the label of the user supplied outputs.
+func (c *fluentdComponent) additionalOutputsStores() []destinationStore {
+	var tags []string
+	for _, t := range syslogLogTypeTags {
+		tags = append(tags, t...)
+	}
+	slices.Sort(tags)
+	tags = slices.Compact(tags)
+	return []destinationStore{{tags: tags, config: func(string) string {
+		return "  @type relabel\n" + fmt.Sprintf("  @label %s\n", additionalOutputsLabel)
+	}}}
+}
+
+// logSources returns the fluentd configuration file of each LogSource, keyed by file name. Each file holds a single
+// <source> section that tails the logs of the matching containers in the namespace of the LogSource.
+func (c *fluentdComponent) logSources() map[string]string {
+	sources := map[string]string{}
+	for _, ls := range c.cfg.LogSources {
+		// Neither namespaces nor names can hold an underscore, so it keeps the ids of different LogSources apart.
+		id := fmt.Sprintf("logsource_%s_%s", ls.Namespace, ls.Name)
+		parser := ls.Spec.Parser
+
+		// kubelet names the container log files <pod>_<namespace>_<container>-<id>.log.
+		var b strings.Builder
+		b.WriteString("<source>\n")
+		b.WriteString("  @type tail\n")
+		fmt.Fprintf(&b, "  @id %s\n", id)
+		fmt.Fprintf(&b, "  path %s/%s*_%s_%s-*.log\n", varLogContainersPath, ls.Spec.PodNamePrefix, ls.Namespace, ls.Spec.ContainerName)
+		fmt.Fprintf(&b, "  pos_file /var/log/calico/fluentd/%s.pos\n", id)
+		fmt.Fprintf(&b, "  tag logsource.%s.%s\n", ls.Namespace, ls.Name)
+		b.WriteString("  read_from_head true\n")
+		b.WriteString("  <parse>\n")
+		b.WriteString("    @type cri\n")
+		b.WriteString("    <parse>\n")
+		fmt.Fprintf(&b, "      @type %s\n", strings.ToLower(string(parser.Type)))
+		if parser.Type == operatorv1.LogParserTypeRegexp {
+			fmt.Fprintf(&b, "      expression /%s/\n", parser.Expression)
+		}
+		if parser.TimeKey != "" {
+			fmt.Fprintf(&b, "      time_key %s\n", parser.TimeKey)
+		}
+		if parser.TimeFormat != "" {
+			fmt.Fprintf(&b, "      time_format %s\n", parser.TimeFormat)
+		}
+		b.WriteString("    </parse>\n")
+		b.WriteString("  </parse>\n")
+		b.WriteString("</source>\n")
+		sources[fmt.Sprintf("%s_%s.conf", ls.Namespace, ls.Name)] = b.String()
 	}
+	return sources
 }
 
-// packetCaptureApiRole creates a role in the tigera-fluentd namespace to allow pod/exec
-// only from fluentd pods. This is being used by the PacketCapture API and created
-// by the operator after the namespace tigera-fluentd is created.
-func (c *fluentdComponent) packetCaptureApiRole() *rbacv1.Role {
-	return &rbacv1.Role{
-		TypeMeta: metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"},
+func (c *fluentdComponent) logSourcesConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      PacketCaptureAPIRole,
+			Name:      FluentdLogSourcesConfigMapName,
 			Namespace: LogCollectorNamespace,
 		},
-		Rules: []rbacv1.PolicyRule{
-			{
-				APIGroups: []string{""},
-				Resources: []string{"pods/exec"},
-				Verbs:     []string{"create"},
-			},
-			{
-				APIGroups: []string{""},
-				Resources: []string{"pods"},
-				Verbs:     []string{"list"},
-			},
-		},
+		Data: c.logSources(),
 	}
 }
 
-// packetCaptureApiRoleBinding creates a role binding within the tigera-fluentd namespace between the pod/exec role
-// the service account tigera-manager. This is being used by the PacketCapture API and created
-// by the operator after the namespace tigera-fluentd is created
-func (c *fluentdComponent) packetCaptureApiRoleBinding() *rbacv1.RoleBinding {
-	return &rbacv1.RoleBinding{
-		TypeMeta: metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+// s3Destinations returns the S3 destinations that logs are exported to by log type.
+func (c *fluentdComponent) s3Destinations() []operatorv1.S3DestinationSpec {
+	if c.cfg.LogCollector == nil || c.cfg.LogCollector.Spec.AdditionalStores == nil || c.cfg.LogCollector.Spec.AdditionalStores.S3 == nil {
+		return nil
+	}
+	return c.cfg.LogCollector.Spec.AdditionalStores.S3.Destinations
+}
+
+func (c *fluentdComponent) s3DestinationsCredentialSecret() *corev1.Secret {
+	data := map[string][]byte{}
+	for name, cred := range c.cfg.S3DestinationCredentials {
+		data[s3DestinationCredentialKey(name, S3KeyIdName)] = cred.KeyId
+		data[s3DestinationCredentialKey(name, S3KeySecretName)] = cred.KeySecret
+	}
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      PacketCaptureAPIRoleBinding,
+			Name:      S3DestinationsSecretName,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: data,
+	}
+}
+
+func s3DestinationCredentialKey(destination, key string) string {
+	return fmt.Sprintf("%s-%s", destination, key)
+}
+
+// s3DestinationEnvPrefix returns the prefix of the environment variables holding the credentials of the destination.
+func s3DestinationEnvPrefix(destination string) string {
+	return "S3_" + strings.ToUpper(strings.ReplaceAll(destination, "-", "_"))
+}
+
+// s3DestinationCredentialEnvVars returns the environment variables with the credentials of the destination, which its
+// <match> section reads. Destinations without their own credentials use the credentials of the S3 store.
+func (c *fluentdComponent) s3DestinationCredentialEnvVars(d operatorv1.S3DestinationSpec) []corev1.EnvVar {
+	prefix := s3DestinationEnvPrefix(d.Name)
+	if _, ok := c.cfg.S3DestinationCredentials[d.Name]; ok {
+		return []corev1.EnvVar{
+			{Name: prefix + "_AWS_KEY_ID", ValueFrom: secret.GetEnvVarSource(S3DestinationsSecretName, s3DestinationCredentialKey(d.Name, S3KeyIdName), false)},
+			{Name: prefix + "_AWS_SECRET_KEY", ValueFrom: secret.GetEnvVarSource(S3DestinationsSecretName, s3DestinationCredentialKey(d.Name, S3KeySecretName), false)},
+		}
+	}
+	return []corev1.EnvVar{
+		secretEnvVar(prefix+"_AWS_KEY_ID", S3FluentdSecretName, S3KeyIdName),
+		secretEnvVar(prefix+"_AWS_SECRET_KEY", S3FluentdSecretName, S3KeySecretName),
+	}
+}
+
+// s3LogTypeTags maps the log types of S3 destinations to the fluentd tags of their logs.
+var s3LogTypeTags = map[operatorv1.S3LogType][]string{
+	operatorv1.S3LogAudit: {"tigera.calico.ee_audit", "tigera.calico.kube_audit"},
+	operatorv1.S3LogDNS:   {"tigera.calico.dns"},
+	operatorv1.S3LogFlows: {"tigera.calico.flows"},
+	operatorv1.S3LogL7:    {"tigera.calico.l7"},
+}
+
+// s3Stores returns the fluentd <store> section of each S3 destination, which writes the logs it receives to the
+// bucket of the destination.
+func (c *fluentdComponent) s3Stores() []destinationStore {
+	var stores []destinationStore
+	for _, d := range c.s3Destinations() {
+		var tags []string
+		for _, t := range d.LogTypes {
+			tags = append(tags, s3LogTypeTags[t]...)
+		}
+		prefix := s3DestinationEnvPrefix(d.Name)
+
+		var b strings.Builder
+		b.WriteString("  @type s3\n")
+		fmt.Fprintf(&b, "  aws_key_id \"#{ENV['%s_AWS_KEY_ID']}\"\n", prefix)
+		fmt.Fprintf(&b, "  aws_sec_key \"#{ENV['%s_AWS_SECRET_KEY']}\"\n", prefix)
+		fmt.Fprintf(&b, "  s3_region %s\n", d.Region)
+		fmt.Fprintf(&b, "  s3_bucket %s\n", d.BucketName)
+		if d.BucketPath != "" {
+			fmt.Fprintf(&b, "  path %s\n", d.BucketPath)
+		}
+		config := b.String()
+		stores = append(stores, destinationStore{tags: tags, config: func(tag string) string {
+			// Fluentd does not allow outputs to share a buffer path, and the destination has an output per tag.
+			return config +
+				"  <buffer tag,time>\n" +
+				"    @type file\n" +
+				fmt.Sprintf("    path %s\n", c.path("/var/log/calico/fluentd/s3-"+d.Name+"-"+tag)) +
+				"  </buffer>\n"
+		}})
+	}
+	return stores
+}
+
+// syslogDestinations returns the Syslog destinations that logs are exported to in addition to the Syslog store. The
+// Syslog store itself, the default destination, is exported to by the syslog output of the image that the SYSLOG_*
+// environment variables configure, so that upgrading does not change how its logs are buffered and sent.
+func (c *fluentdComponent) syslogDestinations() []operatorv1.SyslogDestinationSpec {
+	if c.cfg.LogCollector == nil || c.cfg.LogCollector.Spec.AdditionalStores == nil || c.cfg.LogCollector.Spec.AdditionalStores.Syslog == nil {
+		return nil
+	}
+	return c.cfg.LogCollector.Spec.AdditionalStores.Syslog.Destinations
+}
+
+// syslogLogTypeTags maps the log types of Syslog destinations to the fluentd tags of their logs.
+var syslogLogTypeTags = map[operatorv1.SyslogLogType][]string{
+	operatorv1.SyslogLogAudit:     {"tigera.calico.ee_audit", "tigera.calico.kube_audit"},
+	operatorv1.SyslogLogDNS:       {"tigera.calico.dns"},
+	operatorv1.SyslogLogFlows:     {"tigera.calico.flows"},
+	operatorv1.SyslogLogL7:        {"tigera.calico.l7"},
+	operatorv1.SyslogLogIDSEvents: {"tigera_secure_ee_events"},
+	operatorv1.SyslogLogBGP:       {"tigera.calico.bird", "tigera.calico.bird6"},
+}
+
+// syslogStores returns the fluentd <store> section of each Syslog destination, which sends the logs it receives to
+// the server of the destination.
+func (c *fluentdComponent) syslogStores() []destinationStore {
+	var stores []destinationStore
+	for _, d := range c.syslogDestinations() {
+		var tags []string
+		for _, t := range d.LogTypes {
+			// The BGP logs can only be exported when fluentd tails them.
+			if t == operatorv1.SyslogLogBGP && !c.cfg.LogCollector.Spec.Collection.BGPEnabled() {
+				continue
+			}
+			tags = append(tags, syslogLogTypeTags[t]...)
+		}
+		// ValidateLogCollector rejects the destinations whose endpoint does not parse.
+		proto, host, port, _ := url.ParseEndpoint(d.Endpoint)
+
+		var b strings.Builder
+		b.WriteString("  @type remote_syslog\n")
+		fmt.Fprintf(&b, "  host %s\n", host)
+		fmt.Fprintf(&b, "  port %s\n", port)
+		fmt.Fprintf(&b, "  protocol %s\n", proto)
+		b.WriteString("  hostname \"#{ENV['NODENAME']}\"\n")
+		if d.PacketSize != nil {
+			fmt.Fprintf(&b, "  packet_size %d\n", *d.PacketSize)
+		}
+		if d.Encryption == operatorv1.EncryptionTLS {
+			b.WriteString("  tls true\n")
+			// Verify the certificate of the server with OpenSSL::SSL::VERIFY_PEER(1).
+			b.WriteString("  verify_mode 1\n")
+			fmt.Fprintf(&b, "  ca_file %s\n", c.syslogDestinationCAFile(d))
+			if c.cfg.SyslogClientCredential != nil {
+				fmt.Fprintf(&b, "  client_cert %s\n", c.path(syslogClientTLSMountDir+corev1.TLSCertKey))
+				fmt.Fprintf(&b, "  client_cert_key %s\n", c.path(syslogClientTLSMountDir+corev1.TLSPrivateKeyKey))
+			}
+		}
+		b.WriteString("  <format>\n")
+		b.WriteString("    @type json\n")
+		b.WriteString("  </format>\n")
+		config := b.String()
+		stores = append(stores, destinationStore{tags: tags, config: func(tag string) string {
+			// As for the S3 destinations, each tag's output needs a buffer path of its own.
+			return config +
+				"  <buffer>\n" +
+				"    @type file\n" +
+				fmt.Sprintf("    path %s\n", c.path("/var/log/calico/fluentd/syslog-"+d.Name+"-"+tag)) +
+				fmt.Sprintf("    flush_interval %s\n", fluentdDefaultFlush) +
+				"  </buffer>\n"
+		}})
+	}
+	return stores
+}
+
+// syslogDestinationCAFile returns the file with the CA certificates that the certificate of the Syslog server of
+// the destination is verified with.
+func (c *fluentdComponent) syslogDestinationCAFile(d operatorv1.SyslogDestinationSpec) string {
+	if _, ok := c.cfg.SyslogDestinationCAs[d.Name]; ok {
+		return c.path(syslogDestinationCAsMountDir + syslogDestinationCAKey(d.Name))
+	}
+	if d.CAConfigMapName == "" && c.cfg.UseSyslogCertificate {
+		return c.cfg.TrustedBundle.MountPath()
+	}
+	return SysLogPublicCAPath
+}
+
+func syslogDestinationCAKey(destination string) string {
+	return destination + ".crt"
+}
+
+// syslogDestinationCAsConfigMap holds the CA certificate of each Syslog destination that has its own, so that
+// fluentd verifies the server of a destination with its CA only.
+func (c *fluentdComponent) syslogDestinationCAsConfigMap() *corev1.ConfigMap {
+	data := map[string]string{}
+	for name, ca := range c.cfg.SyslogDestinationCAs {
+		data[syslogDestinationCAKey(name)] = string(ca.GetCertificatePEM())
+	}
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FluentdSyslogDestinationCAsConfigMapName,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: data,
+	}
+}
+
+// destinationStore is the fluentd <store> section of a destination of an additional store, without the enclosing
+// <store> tags, along with the tags of the logs that the destination receives.
+type destinationStore struct {
+	tags []string
+	// config returns the section of the output of the given tag.
+	config func(tag string) string
+}
+
+// destinationOutputs are the fluentd outputs of the destinations of an additional store. They are mounted from a
+// ConfigMap with one file per fluentd tag, named <tag>.conf, that holds a <store> section for each destination that
+// receives the logs with the tag. The image includes the file of a tag in the @type copy <match> section of the tag,
+// so that each destination gets a copy of the logs next to Linseed and the other stores. A <match> section per
+// destination would instead consume the logs of the first destination whose tags match.
+type destinationOutputs struct {
+	// name is the name of both the ConfigMap and its volume.
+	name           string
+	mountDir       string
+	hashAnnotation string
+	envVar         string
+	files          map[string]string
+}
+
+// newDestinationOutputs groups the <store> sections of the destinations of an additional store by tag.
+func newDestinationOutputs(name, mountDir, hashAnnotation, envVar string, stores []destinationStore) destinationOutputs {
+	sections := map[string][]string{}
+	for _, store := range stores {
+		for _, tag := range store.tags {
+			// A failing destination must not keep the logs from the other stores of the tag.
+			sections[tag] = append(sections[tag], "<store ignore_error>\n"+store.config(tag)+"</store>\n")
+		}
+	}
+	files := map[string]string{}
+	for tag, stores := range sections {
+		files[tag+".conf"] = strings.Join(stores, "")
+	}
+	return destinationOutputs{name: name, mountDir: mountDir, hashAnnotation: hashAnnotation, envVar: envVar, files: files}
+}
+
+// destinationOutputs returns the outputs of the additional stores that export logs to destinations of their own.
+func (c *fluentdComponent) destinationOutputs() []destinationOutputs {
+	var outputs []destinationOutputs
+	if len(c.s3Destinations()) > 0 {
+		outputs = append(outputs, newDestinationOutputs(FluentdS3OutputsConfigMapName, c.path(s3OutputsMountDir), s3OutputsHashAnnotation, "FLUENTD_S3_OUTPUTS_DIR", c.s3Stores()))
+	}
+	if len(c.syslogDestinations()) > 0 {
+		outputs = append(outputs, newDestinationOutputs(FluentdSyslogOutputsConfigMapName, c.path(syslogOutputsMountDir), syslogOutputsHashAnnotation, "FLUENTD_SYSLOG_OUTPUTS_DIR", c.syslogStores()))
+	}
+	if len(c.cfg.AdditionalOutputs) > 0 {
+		outputs = append(outputs, newDestinationOutputs(FluentdAdditionalOutputsCopyConfigMapName, c.path(additionalOutputsCopyMountDir), additionalOutputsCopyHashAnnotation, "FLUENTD_ADDITIONAL_OUTPUTS_COPY_DIR", c.additionalOutputsStores()))
+	}
+	return outputs
+}
+
+func (o destinationOutputs) configMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      o.name,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: o.files,
+	}
+}
+
+func (o destinationOutputs) volume() corev1.Volume {
+	return corev1.Volume{
+		Name: o.name,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: o.name},
+			},
+		},
+	}
+}
+
+func (o destinationOutputs) volumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{Name: o.name, MountPath: o.mountDir, ReadOnly: true}
+}
+
+func (c *fluentdComponent) splunkCredentialSecret() []*corev1.Secret {
+	if c.cfg.SplkCredential == nil {
+		return nil
+	}
+	return []*corev1.Secret{
+		{
+			TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      SplunkFluentdTokenSecretName,
+				Namespace: LogCollectorNamespace,
+			},
+			Data: map[string][]byte{
+				SplunkFluentdSecretTokenKey: c.cfg.SplkCredential.Token,
+			},
+		},
+	}
+}
+
+func (c *fluentdComponent) syslogClientTLSSecret() *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SyslogClientTLSSecretName,
+			Namespace: LogCollectorNamespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       c.cfg.SyslogClientCredential.Cert,
+			corev1.TLSPrivateKeyKey: c.cfg.SyslogClientCredential.Key,
+		},
+	}
+}
+
+func (c *fluentdComponent) lokiCredentialSecret() *corev1.Secret {
+	data := map[string][]byte{}
+	if len(c.cfg.LokiCredential.Token) > 0 {
+		data[LokiFluentdSecretTokenKey] = c.cfg.LokiCredential.Token
+	} else {
+		data[LokiFluentdSecretUsernameKey] = c.cfg.LokiCredential.Username
+		data[LokiFluentdSecretPasswordKey] = c.cfg.LokiCredential.Password
+	}
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LokiFluentdCredentialSecretName,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: data,
+	}
+}
+
+func (c *fluentdComponent) otlpHeadersSecret() *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OTLPHeadersSecretName,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: c.cfg.OTLPHeaders.Headers,
+	}
+}
+
+func (c *fluentdComponent) fluentdServiceAccount() *corev1.ServiceAccount {
+	sa := &corev1.ServiceAccount{
+		TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: c.fluentdNodeName(), Namespace: LogCollectorNamespace},
+	}
+	if stores := c.cfg.LogCollector.Spec.AdditionalStores; stores != nil && stores.SecurityLake != nil && stores.SecurityLake.RoleARN != "" {
+		sa.Annotations = map[string]string{AWSRoleARNAnnotation: stores.SecurityLake.RoleARN}
+	}
+	return sa
+}
+
+// packetCaptureApiRole creates a role in the tigera-fluentd namespace to allow pod/exec
+// only from fluentd pods. This is being used by the PacketCapture API and created
+// by the operator after the namespace tigera-fluentd is created.
+func (c *fluentdComponent) packetCaptureApiRole() *rbacv1.Role {
+	return &rbacv1.Role{
+		TypeMeta: metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PacketCaptureAPIRole,
+			Namespace: LogCollectorNamespace,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods/exec"},
+				Verbs:     []string{"create"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods"},
+				Verbs:     []string{"list"},
+			},
+		},
+	}
+}
+
+// packetCaptureApiRoleBinding creates a role binding within the tigera-fluentd namespace between the pod/exec role
+// the service account tigera-manager. This is being used by the PacketCapture API and created
+// by the operator after the namespace tigera-fluentd is created
+func (c *fluentdComponent) packetCaptureApiRoleBinding() *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PacketCaptureAPIRoleBinding,
 			Namespace: LogCollectorNamespace,
 		},
 		RoleRef: rbacv1.RoleRef{
@@ -532,42 +1582,98 @@ func (c *fluentdComponent) packetCaptureApiRoleBinding() *rbacv1.RoleBinding {
 }
 
 // managerDeployment creates a deployment for the Tigera Secure manager component.
-func (c *fluentdComponent) daemonset() *appsv1.DaemonSet {
-	var terminationGracePeriod int64 = 0
-	// The rationale for this setting is that while there is no need for fluentd to be available, we want to avoid
-	// potentially negative consequences of an immediate roll-out on huge clusters.
-	maxUnavailable := intstr.FromInt(10)
-
+// podAnnotations returns the hash annotations that roll the fluentd pods when their configuration changes.
+func (c *fluentdComponent) podAnnotations() map[string]string {
 	annots := c.cfg.TrustedBundle.HashAnnotations()
 
 	if c.cfg.FluentdKeyPair != nil {
 		annots[c.cfg.FluentdKeyPair.HashAnnotationKey()] = c.cfg.FluentdKeyPair.HashAnnotationValue()
 	}
+	if c.inputTLSEnabled() {
+		annots[c.cfg.InputKeyPair.HashAnnotationKey()] = c.cfg.InputKeyPair.HashAnnotationValue()
+	}
+	if c.forwardInputTLSEnabled() {
+		annots[c.cfg.ForwardInputKeyPair.HashAnnotationKey()] = c.cfg.ForwardInputKeyPair.HashAnnotationValue()
+	}
 	if c.cfg.S3Credential != nil {
 		annots[s3CredentialHashAnnotation] = rmeta.AnnotationHash(c.cfg.S3Credential)
 	}
+	if len(c.cfg.S3DestinationCredentials) > 0 {
+		annots[s3DestinationsCredentialHashAnnotation] = rmeta.AnnotationHash(c.cfg.S3DestinationCredentials)
+	}
+	for _, o := range c.destinationOutputs() {
+		annots[o.hashAnnotation] = rmeta.AnnotationHash(o.files)
+	}
+	if c.cfg.GCSCredential != nil {
+		annots[gcsCredentialHashAnnotation] = rmeta.AnnotationHash(c.cfg.GCSCredential)
+	}
+	if c.cfg.AzureBlobCredential != nil {
+		annots[azureBlobCredentialHashAnnotation] = rmeta.AnnotationHash(c.cfg.AzureBlobCredential)
+	}
 	if c.cfg.SplkCredential != nil {
 		annots[splunkCredentialHashAnnotation] = rmeta.AnnotationHash(c.cfg.SplkCredential)
 	}
-	if c.cfg.Filters != nil {
-		annots[filterHashAnnotation] = rmeta.AnnotationHash(c.cfg.Filters)
+	if c.cfg.SyslogClientCredential != nil {
+		annots[syslogClientTLSHashAnnotation] = rmeta.AnnotationHash(c.cfg.SyslogClientCredential)
+	}
+	if len(c.cfg.SyslogDestinationCAs) > 0 {
+		annots[syslogDestinationCAsHashAnnotation] = rmeta.AnnotationHash(c.syslogDestinationCAsConfigMap().Data)
+	}
+	if c.clientCAEnabled() {
+		annots[clientCAHashAnnotation] = rmeta.AnnotationHash(c.clientCAConfigMap().Data)
+	}
+	if c.cfg.LokiCredential != nil {
+		annots[lokiCredentialHashAnnotation] = rmeta.AnnotationHash(c.cfg.LokiCredential)
 	}
+	if c.cfg.OTLPHeaders != nil {
+		annots[otlpHeadersHashAnnotation] = rmeta.AnnotationHash(c.cfg.OTLPHeaders)
+	}
+	if c.filters != nil {
+		annots[filterHashAnnotation] = rmeta.AnnotationHash(c.filters)
+	}
+	if len(c.cfg.AdditionalOutputs) > 0 {
+		annots[additionalOutputsHashAnnotation] = rmeta.AnnotationHash(c.cfg.AdditionalOutputs)
+	}
+	if c.logSourcesEnabled() {
+		annots[logSourcesHashAnnotation] = rmeta.AnnotationHash(c.logSources())
+	}
+	if c.aggregatorEnabled() && c.cfg.OSType == rmeta.OSTypeLinux {
+		annots[forwardInputHashAnnotation] = rmeta.AnnotationHash(c.forwardInput())
+	}
+	return annots
+}
+
+func (c *fluentdComponent) initContainers() []corev1.Container {
 	var initContainers []corev1.Container
 	if c.cfg.FluentdKeyPair != nil && c.cfg.FluentdKeyPair.UseCertificateManagement() {
 		initContainers = append(initContainers, c.cfg.FluentdKeyPair.InitContainer(LogCollectorNamespace, c.container().SecurityContext))
 	}
+	if c.inputTLSEnabled() && c.cfg.InputKeyPair.UseCertificateManagement() {
+		initContainers = append(initContainers, c.cfg.InputKeyPair.InitContainer(LogCollectorNamespace, c.container().SecurityContext))
+	}
+	if c.forwardInputTLSEnabled() && c.cfg.ForwardInputKeyPair.UseCertificateManagement() {
+		initContainers = append(initContainers, c.cfg.ForwardInputKeyPair.InitContainer(LogCollectorNamespace, c.container().SecurityContext))
+	}
+	return initContainers
+}
+
+func (c *fluentdComponent) daemonset() *appsv1.DaemonSet {
+	var terminationGracePeriod int64 = 0
+	// The rationale for this setting is that while there is no need for fluentd to be available, we want to avoid
+	// potentially negative consequences of an immediate roll-out on huge clusters.
+	maxUnavailable := intstr.FromInt(10)
 
 	podTemplate := &corev1.PodTemplateSpec{
 		ObjectMeta: metav1.ObjectMeta{
-			Annotations: annots,
+			Annotations: c.podAnnotations(),
 		},
 		Spec: corev1.PodSpec{
 			NodeSelector:                  map[string]string{},
 			Tolerations:                   rmeta.TolerateAll,
 			ImagePullSecrets:              secret.GetReferenceList(c.cfg.PullSecrets),
 			TerminationGracePeriodSeconds: &terminationGracePeriod,
-			InitContainers:                initContainers,
-			Containers:                    []corev1.Container{c.container()},
+			InitContainers:                c.initContainers(),
+			Containers:                    c.containers(),
 			Volumes:                       c.volumes(),
 			ServiceAccountName:            c.fluentdNodeName(),
 		},
@@ -588,15 +1694,455 @@ func (c *fluentdComponent) daemonset() *appsv1.DaemonSet {
 			},
 		},
 	}
+	setDataPlanePriorityClass(c.cfg.Installation, &ds.Spec.Template)
 	if c.cfg.LogCollector != nil {
 		if overrides := c.cfg.LogCollector.Spec.FluentdDaemonSet; overrides != nil {
 			rcomponents.ApplyDaemonSetOverrides(ds, overrides)
 		}
 	}
-	setNodeCriticalPod(&(ds.Spec.Template))
 	return ds
 }
 
+// verticalPodAutoscalers returns the VerticalPodAutoscalers of the fluentd workloads that are rendered when the
+// Installation enables vertical pod autoscaling, and those to delete otherwise.
+func (c *fluentdComponent) verticalPodAutoscalers() ([]client.Object, []client.Object) {
+	var objs, toDelete []client.Object
+	vpaCfg := c.cfg.Installation.VerticalPodAutoscaling
+	running := vpaCfg != nil && !c.cfg.LicenseExpired
+
+	if running && !c.auditDeploymentEnabled() && !c.aggregatorEnabled() {
+		objs = append(objs, vpa.VerticalPodAutoscaler("DaemonSet", c.fluentdNodeName(), LogCollectorNamespace, vpaCfg))
+	} else {
+		toDelete = append(toDelete, vpa.ToDelete(c.fluentdNodeName(), LogCollectorNamespace))
+	}
+	if c.cfg.OSType == rmeta.OSTypeLinux {
+		if running && c.auditDeploymentEnabled() {
+			objs = append(objs, vpa.VerticalPodAutoscaler("Deployment", FluentdAuditName, LogCollectorNamespace, vpaCfg))
+		} else {
+			toDelete = append(toDelete, vpa.ToDelete(FluentdAuditName, LogCollectorNamespace))
+		}
+		if running && c.aggregatorEnabled() {
+			objs = append(objs, vpa.VerticalPodAutoscaler("Deployment", FluentdAggregatorName, LogCollectorNamespace, vpaCfg))
+		} else {
+			toDelete = append(toDelete, vpa.ToDelete(FluentdAggregatorName, LogCollectorNamespace))
+		}
+	}
+	return objs, toDelete
+}
+
+// auditDeployment creates a Deployment that runs fluentd alongside the API server pods so that it can tail the
+// audit logs they write to the host, without running fluentd on every node. The pods keep the fluentd-node
+// k8s-app label and service account so that the metrics service, network policy and Linseed access apply as
+// they do for the DaemonSet.
+func (c *fluentdComponent) auditDeployment() *appsv1.Deployment {
+	var terminationGracePeriod int64 = 0
+	labels := map[string]string{
+		"k8s-app":                FluentdNodeName,
+		"app.kubernetes.io/name": FluentdAuditName,
+	}
+
+	// Two pods on the same node would both tail, and so forward, the audit logs of that node.
+	affinity := &corev1.Affinity{
+		PodAffinity: &corev1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"apiserver": "true"}},
+				Namespaces:    []string{APIServerNamespace},
+				TopologyKey:   corev1.LabelHostname,
+			}},
+		},
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": FluentdAuditName}},
+				Namespaces:    []string{LogCollectorNamespace},
+				TopologyKey:   corev1.LabelHostname,
+			}},
+		},
+	}
+
+	d := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FluentdAuditName,
+			Namespace: LogCollectorNamespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: c.cfg.Installation.ControlPlaneReplicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: c.podAnnotations(),
+				},
+				Spec: corev1.PodSpec{
+					NodeSelector:                  c.cfg.Installation.ControlPlaneNodeSelector,
+					Tolerations:                   rmeta.TolerateAll,
+					Affinity:                      affinity,
+					ImagePullSecrets:              secret.GetReferenceList(c.cfg.PullSecrets),
+					TerminationGracePeriodSeconds: &terminationGracePeriod,
+					InitContainers:                c.initContainers(),
+					Containers:                    c.containers(),
+					Volumes:                       c.volumes(),
+					ServiceAccountName:            FluentdNodeName,
+				},
+			},
+		},
+	}
+	// Keep the priority of the DaemonSet this replaces, which is also what the GKE resource quota allows.
+	setDataPlanePriorityClass(c.cfg.Installation, &d.Spec.Template)
+	return d
+}
+
+// aggregatorDeployment creates a Deployment that runs a centralized fluentd which only consumes the logs forwarded to
+// its forward input, so unlike the DaemonSet and the audit deployment it does not mount any host paths. The pods keep
+// the fluentd-node k8s-app label and service account so that the metrics service, network policy and Linseed access
+// apply as they do for the DaemonSet.
+func (c *fluentdComponent) aggregatorDeployment() *appsv1.Deployment {
+	var terminationGracePeriod int64 = 0
+	labels := map[string]string{
+		"k8s-app":                FluentdNodeName,
+		"app.kubernetes.io/name": FluentdAggregatorName,
+	}
+
+	d := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FluentdAggregatorName,
+			Namespace: LogCollectorNamespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: c.cfg.Installation.ControlPlaneReplicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: c.podAnnotations(),
+				},
+				Spec: corev1.PodSpec{
+					NodeSelector:                  c.cfg.Installation.ControlPlaneNodeSelector,
+					Tolerations:                   c.cfg.Installation.ControlPlaneTolerations,
+					Affinity:                      podaffinity.NewPodAntiAffinity(FluentdAggregatorName, []string{LogCollectorNamespace}),
+					ImagePullSecrets:              secret.GetReferenceList(c.cfg.PullSecrets),
+					TerminationGracePeriodSeconds: &terminationGracePeriod,
+					InitContainers:                c.initContainers(),
+					Containers:                    c.containers(),
+					Volumes:                       c.volumes(),
+					ServiceAccountName:            FluentdNodeName,
+				},
+			},
+		},
+	}
+	setDataPlanePriorityClass(c.cfg.Installation, &d.Spec.Template)
+	return d
+}
+
+// operatorLogsEnabled returns true if fluentd collects the logs of the operator. The operator only runs on Linux
+// nodes, and only the fluentd DaemonSet runs on every node.
+func (c *fluentdComponent) operatorLogsEnabled() bool {
+	return c.cfg.OSType == rmeta.OSTypeLinux && c.cfg.LogCollector != nil &&
+		c.cfg.LogCollector.Spec.OperatorLogsEnabled() && !c.auditDeploymentEnabled() && !c.aggregatorEnabled()
+}
+
+// logSourcesEnabled returns true if fluentd tails the container logs registered with LogSources. Like the operator
+// logs, they are only collected by the Linux fluentd DaemonSet.
+func (c *fluentdComponent) logSourcesEnabled() bool {
+	return c.cfg.OSType == rmeta.OSTypeLinux && len(c.cfg.LogSources) > 0 && !c.auditDeploymentEnabled() &&
+		!c.aggregatorEnabled()
+}
+
+// containerLogsEnabled returns true if fluentd reads the container logs written by kubelet on each node.
+func (c *fluentdComponent) containerLogsEnabled() bool {
+	return c.operatorLogsEnabled() || c.logSourcesEnabled()
+}
+
+// deadLetterQueueEnabled returns true if fluentd writes the chunks it fails to flush to a PersistentVolumeClaim.
+func (c *fluentdComponent) deadLetterQueueEnabled() bool {
+	return c.cfg.OSType == rmeta.OSTypeLinux && c.cfg.LogCollector != nil && c.cfg.LogCollector.Spec.DeadLetterQueue != nil
+}
+
+// stateVolumeEnabled returns true if fluentd keeps its position files and buffers on a volume of its own rather than
+// in the var-log-calico host path. The aggregator keeps its state on an emptyDir already.
+func (c *fluentdComponent) stateVolumeEnabled() bool {
+	return c.cfg.OSType == rmeta.OSTypeLinux && c.cfg.LogCollector != nil && c.cfg.LogCollector.Spec.FluentdState != nil &&
+		!c.aggregatorEnabled()
+}
+
+// stateVolumeSource returns the volume that fluentd keeps its position files and buffers on.
+func (c *fluentdComponent) stateVolumeSource() corev1.VolumeSource {
+	state := c.cfg.LogCollector.Spec.FluentdState
+	switch state.Type {
+	case operatorv1.FluentdStateVolumeEmptyDir:
+		return corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+	case operatorv1.FluentdStateVolumePersistentVolumeClaim:
+		return corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: state.PersistentVolumeClaimName},
+		}
+	default:
+		path := state.HostPath
+		if path == "" {
+			path = fluentdStateMountDir
+		}
+		dirOrCreate := corev1.HostPathDirectoryOrCreate
+		return corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: path, Type: &dirOrCreate}}
+	}
+}
+
+// deadLetterReplayCronJob creates a suspended CronJob that is only used as a template for the Jobs that replay the
+// dead letter queue, so that users can replay it on demand with kubectl create job --from=cronjob/<name>. The
+// replay pods run fluentd with the same outputs, reading the chunks of every node from the dead letter queue.
+func (c *fluentdComponent) deadLetterReplayCronJob() *batchv1.CronJob {
+	container := c.container()
+	container.Name = FluentdDeadLetterReplayName
+	container.Env = append(container.Env, corev1.EnvVar{Name: "DEAD_LETTER_QUEUE_REPLAY", Value: "true"})
+	for i := range container.VolumeMounts {
+		// Replay the chunks of every node rather than those of the node the pod runs on.
+		if container.VolumeMounts[i].Name == deadLetterQueueVolumeName {
+			container.VolumeMounts[i].SubPathExpr = ""
+		}
+	}
+
+	return &batchv1.CronJob{
+		TypeMeta: metav1.TypeMeta{Kind: "CronJob", APIVersion: "batch/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FluentdDeadLetterReplayName,
+			Namespace: LogCollectorNamespace,
+		},
+		Spec: batchv1.CronJobSpec{
+			// The schedule is required, but never used since the CronJob is suspended.
+			Schedule:          "@yearly",
+			Suspend:           ptr.To(true),
+			ConcurrencyPolicy: batchv1.ForbidConcurrent,
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					BackoffLimit: ptr.To[int32](3),
+					Template:     c.jobPodTemplate(container, corev1.RestartPolicyOnFailure),
+				},
+			},
+		},
+	}
+}
+
+// jobPodTemplate returns the pod template of the Jobs that run fluentd once with the outputs of the log collector in
+// the given container. The pods carry the name of the container as their k8s-app label, which the fluentd network
+// policy selects alongside the DaemonSet, and keep the fluentd-node service account for Linseed access. They run on
+// the control plane nodes next to a fluentd-node pod, so their host path volumes are replaced by emptyDirs and the
+// FluentdState volume is left out: they neither read the logs of the node nor share the position files and buffers
+// of that pod.
+func (c *fluentdComponent) jobPodTemplate(container corev1.Container, restartPolicy corev1.RestartPolicy) corev1.PodTemplateSpec {
+	container.StartupProbe = nil
+	container.LivenessProbe = nil
+	container.ReadinessProbe = nil
+	container.Ports = nil
+	var volumeMounts []corev1.VolumeMount
+	for _, m := range container.VolumeMounts {
+		if m.Name != fluentdStateVolumeName {
+			volumeMounts = append(volumeMounts, m)
+		}
+	}
+	container.VolumeMounts = volumeMounts
+
+	var volumes []corev1.Volume
+	for _, v := range c.volumes() {
+		if v.Name == fluentdStateVolumeName {
+			continue
+		}
+		if v.HostPath != nil {
+			v.VolumeSource = corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+		}
+		volumes = append(volumes, v)
+	}
+
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      map[string]string{"k8s-app": container.Name},
+			Annotations: c.podAnnotations(),
+		},
+		Spec: corev1.PodSpec{
+			NodeSelector:       c.cfg.Installation.ControlPlaneNodeSelector,
+			Tolerations:        append(c.cfg.Installation.ControlPlaneTolerations, rmeta.TolerateControlPlane...),
+			ImagePullSecrets:   secret.GetReferenceList(c.cfg.PullSecrets),
+			RestartPolicy:      restartPolicy,
+			InitContainers:     c.initContainers(),
+			Containers:         []corev1.Container{container},
+			Volumes:            volumes,
+			ServiceAccountName: FluentdNodeName,
+		},
+	}
+}
+
+// pipelineCanaryEnabled returns true if the log pipeline self-test runs.
+func (c *fluentdComponent) pipelineCanaryEnabled() bool {
+	return c.cfg.OSType == rmeta.OSTypeLinux && c.cfg.LogCollector != nil && c.cfg.LogCollector.Spec.PipelineCanary != nil &&
+		!c.cfg.LicenseExpired
+}
+
+// pipelineCanaryCronJob creates the CronJob that periodically tests the log pipeline end to end. Each run starts
+// fluentd with the same outputs, injects a synthetic flow log record and exits successfully only once the record has
+// been read back from Linseed and the S3 bucket, or accepted by the Syslog server, as configured. The Jobs carry the
+// canary k8s-app label so that the log collector controller can find the latest run and publish its result as the
+// PipelineHealthy condition.
+func (c *fluentdComponent) pipelineCanaryCronJob() *batchv1.CronJob {
+	container := c.container()
+	container.Name = FluentdPipelineCanaryName
+	container.Env = append(container.Env, corev1.EnvVar{Name: "PIPELINE_CANARY", Value: "true"})
+
+	return &batchv1.CronJob{
+		TypeMeta: metav1.TypeMeta{Kind: "CronJob", APIVersion: "batch/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FluentdPipelineCanaryName,
+			Namespace: LogCollectorNamespace,
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule:                   c.cfg.LogCollector.Spec.PipelineCanary.GetSchedule(),
+			ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+			SuccessfulJobsHistoryLimit: ptr.To[int32](1),
+			FailedJobsHistoryLimit:     ptr.To[int32](1),
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"k8s-app": FluentdPipelineCanaryName},
+				},
+				Spec: batchv1.JobSpec{
+					// A failed run is reported rather than retried; the next scheduled run tests the pipeline again.
+					BackoffLimit:          ptr.To[int32](0),
+					ActiveDeadlineSeconds: ptr.To[int64](600),
+					Template:              c.jobPodTemplate(container, corev1.RestartPolicyNever),
+				},
+			},
+		},
+	}
+}
+
+// LogExportCronJobName returns the name of the CronJob that runs the LogExport with the given name.
+func LogExportCronJobName(name string) string {
+	return LogExportName + "-" + name
+}
+
+func (c *fluentdComponent) logExportsCredentialSecret() *corev1.Secret {
+	data := map[string][]byte{}
+	for name, cred := range c.cfg.LogExportCredentials {
+		data[s3DestinationCredentialKey(name, S3KeyIdName)] = cred.KeyId
+		data[s3DestinationCredentialKey(name, S3KeySecretName)] = cred.KeySecret
+	}
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LogExportsSecretName,
+			Namespace: LogCollectorNamespace,
+		},
+		Data: data,
+	}
+}
+
+// logExportCronJob creates the CronJob that runs a LogExport. Each run queries Linseed for the logs of the time window
+// that ends when the run starts and writes them to the S3 bucket of the LogExport. The Jobs carry the LogExport label
+// so that the log collector controller can find the latest run and publish its result in the LogExport status. The
+// pods keep the fluentd-node k8s-app label and service account so that the network policy and Linseed access apply as
+// they do for the DaemonSet.
+func (c *fluentdComponent) logExportCronJob(le operatorv1.LogExport) *batchv1.CronJob {
+	query := le.Spec.Query
+	s3 := le.Spec.Destination.S3
+
+	envs := []corev1.EnvVar{
+		{Name: "LINSEED_ENDPOINT", Value: c.linseedEndpoint()},
+		{Name: "LINSEED_CA_PATH", Value: c.trustedBundlePath()},
+		{Name: "LINSEED_TOKEN", Value: GetLinseedTokenPath(c.cfg.ManagedCluster)},
+		{Name: "TLS_KEY_PATH", Value: c.keyPath()},
+		{Name: "TLS_CRT_PATH", Value: c.certPath()},
+		{Name: "EXPORT_NAME", Value: le.Name},
+		{Name: "EXPORT_LOG_TYPE", Value: string(query.LogType)},
+		{Name: "EXPORT_TIME_WINDOW", Value: query.GetTimeWindow().String()},
+		{Name: "EXPORT_SELECTOR", Value: query.Selector},
+		{Name: "AWS_REGION", Value: s3.Region},
+		{Name: "S3_BUCKET_NAME", Value: s3.BucketName},
+		{Name: "S3_BUCKET_PATH", Value: s3.BucketPath},
+	}
+	if _, ok := c.cfg.LogExportCredentials[le.Name]; ok {
+		envs = append(envs,
+			corev1.EnvVar{Name: "AWS_KEY_ID", ValueFrom: secret.GetEnvVarSource(LogExportsSecretName, s3DestinationCredentialKey(le.Name, S3KeyIdName), false)},
+			corev1.EnvVar{Name: "AWS_SECRET_KEY", ValueFrom: secret.GetEnvVarSource(LogExportsSecretName, s3DestinationCredentialKey(le.Name, S3KeySecretName), false)},
+		)
+	} else {
+		envs = append(envs, secretEnvVar("AWS_KEY_ID", S3FluentdSecretName, S3KeyIdName), secretEnvVar("AWS_SECRET_KEY", S3FluentdSecretName, S3KeySecretName))
+	}
+	envs = append(envs, c.tenantIDEnvVars()...)
+	envs = append(envs, c.cfg.Installation.Proxy.EnvVars()...)
+
+	volumeMounts := c.cfg.TrustedBundle.VolumeMounts(c.SupportedOSType())
+	volumes := []corev1.Volume{trustedBundleVolume(c.cfg.TrustedBundle)}
+	var initContainers []corev1.Container
+	if c.cfg.FluentdKeyPair != nil {
+		volumeMounts = append(volumeMounts, c.cfg.FluentdKeyPair.VolumeMount(c.SupportedOSType()))
+		volumes = append(volumes, c.cfg.FluentdKeyPair.Volume())
+		if c.cfg.FluentdKeyPair.UseCertificateManagement() {
+			initContainers = append(initContainers, c.cfg.FluentdKeyPair.InitContainer(LogCollectorNamespace, securitycontext.NewNonRootContext()))
+		}
+	}
+	if c.cfg.ManagedCluster {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: LinseedTokenVolumeName, MountPath: LinseedVolumeMountPath})
+		volumes = append(volumes, corev1.Volume{
+			Name: LinseedTokenVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: fmt.Sprintf(LinseedTokenSecret, FluentdNodeName),
+					Items:      []corev1.KeyToPath{{Key: LinseedTokenKey, Path: LinseedTokenSubPath}},
+				},
+			},
+		})
+	}
+
+	return &batchv1.CronJob{
+		TypeMeta: metav1.TypeMeta{Kind: "CronJob", APIVersion: "batch/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      LogExportCronJobName(le.Name),
+			Namespace: LogCollectorNamespace,
+			Labels:    map[string]string{LogExportLabel: le.Name},
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule:                   le.Spec.Schedule,
+			ConcurrencyPolicy:          batchv1.ForbidConcurrent,
+			SuccessfulJobsHistoryLimit: ptr.To[int32](1),
+			FailedJobsHistoryLimit:     ptr.To[int32](1),
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"k8s-app": LogExportName, LogExportLabel: le.Name},
+				},
+				Spec: batchv1.JobSpec{
+					BackoffLimit:          ptr.To[int32](2),
+					ActiveDeadlineSeconds: ptr.To[int64](3600),
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"k8s-app": FluentdNodeName},
+						},
+						Spec: corev1.PodSpec{
+							NodeSelector:     c.cfg.Installation.ControlPlaneNodeSelector,
+							Tolerations:      append(c.cfg.Installation.ControlPlaneTolerations, rmeta.TolerateControlPlane...),
+							ImagePullSecrets: secret.GetReferenceList(c.cfg.PullSecrets),
+							RestartPolicy:    corev1.RestartPolicyNever,
+							InitContainers:   initContainers,
+							Containers: []corev1.Container{{
+								Name:            LogExportName,
+								Image:           c.logExportImage,
+								Env:             envs,
+								SecurityContext: securitycontext.NewNonRootContext(),
+								VolumeMounts:    volumeMounts,
+							}},
+							Volumes:            volumes,
+							ServiceAccountName: FluentdNodeName,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *fluentdComponent) auditDeploymentEnabled() bool {
+	return c.cfg.LogCollector != nil && c.cfg.LogCollector.Spec.GetCollectorType() == operatorv1.LogCollectorTypeAuditDeployment
+}
+
+func (c *fluentdComponent) aggregatorEnabled() bool {
+	return c.cfg.LogCollector != nil && c.cfg.LogCollector.Spec.GetCollectorType() == operatorv1.LogCollectorTypeAggregator
+}
+
 // container creates the fluentd container.
 func (c *fluentdComponent) container() corev1.Container {
 	// Determine environment to pass to the CNI init container.
@@ -605,8 +2151,8 @@ func (c *fluentdComponent) container() corev1.Container {
 		{MountPath: c.path("/var/log/calico"), Name: "var-log-calico"},
 		{MountPath: c.path("/etc/fluentd/elastic"), Name: certificatemanagement.TrustedCertConfigMapName},
 	}
-	if c.cfg.Filters != nil {
-		if c.cfg.Filters.Flow != "" {
+	if c.filters != nil {
+		if c.filters.Flow != "" {
 			volumeMounts = append(volumeMounts,
 				corev1.VolumeMount{
 					Name:      "fluentd-filters",
@@ -614,7 +2160,7 @@ func (c *fluentdComponent) container() corev1.Container {
 					SubPath:   FluentdFilterFlowName,
 				})
 		}
-		if c.cfg.Filters.DNS != "" {
+		if c.filters.DNS != "" {
 			volumeMounts = append(volumeMounts,
 				corev1.VolumeMount{
 					Name:      "fluentd-filters",
@@ -623,12 +2169,118 @@ func (c *fluentdComponent) container() corev1.Container {
 				})
 		}
 	}
+	if len(c.cfg.AdditionalOutputs) > 0 {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      additionalOutputsVolumeName,
+				MountPath: c.path(additionalOutputsMountDir),
+				ReadOnly:  true,
+			})
+	}
+	for _, o := range c.destinationOutputs() {
+		volumeMounts = append(volumeMounts, o.volumeMount())
+	}
 
 	volumeMounts = append(volumeMounts, c.cfg.TrustedBundle.VolumeMounts(c.SupportedOSType())...)
 
 	if c.cfg.FluentdKeyPair != nil {
 		volumeMounts = append(volumeMounts, c.cfg.FluentdKeyPair.VolumeMount(c.SupportedOSType()))
 	}
+	if c.inputTLSEnabled() {
+		volumeMounts = append(volumeMounts, c.cfg.InputKeyPair.VolumeMount(c.SupportedOSType()))
+	}
+	if c.forwardInputTLSEnabled() {
+		volumeMounts = append(volumeMounts, c.cfg.ForwardInputKeyPair.VolumeMount(c.SupportedOSType()))
+	}
+
+	if c.cfg.GCSCredential != nil {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      gcsCredentialVolumeName,
+				MountPath: c.path(gcsCredentialMountDir),
+				ReadOnly:  true,
+			})
+	}
+
+	if c.cfg.SyslogClientCredential != nil {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      syslogClientTLSVolumeName,
+				MountPath: c.path(syslogClientTLSMountDir),
+				ReadOnly:  true,
+			})
+	}
+	if len(c.cfg.SyslogDestinationCAs) > 0 {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      FluentdSyslogDestinationCAsConfigMapName,
+				MountPath: c.path(syslogDestinationCAsMountDir),
+				ReadOnly:  true,
+			})
+	}
+	if c.clientCAEnabled() {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      FluentdClientCAConfigMapName,
+				MountPath: c.path(clientCAMountDir),
+				ReadOnly:  true,
+			})
+	}
+
+	if c.cfg.OTLPHeaders != nil {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      otlpHeadersVolumeName,
+				MountPath: c.path(otlpHeadersMountDir),
+				ReadOnly:  true,
+			})
+	}
+
+	if c.logSourcesEnabled() {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      logSourcesVolumeName,
+				MountPath: logSourcesMountDir,
+				ReadOnly:  true,
+			})
+	}
+
+	if c.aggregatorEnabled() && c.cfg.OSType == rmeta.OSTypeLinux {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      forwardInputVolumeName,
+				MountPath: forwardInputMountDir,
+				ReadOnly:  true,
+			})
+	}
+
+	if c.containerLogsEnabled() {
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{Name: "var-log-containers", MountPath: varLogContainersPath, ReadOnly: true},
+			corev1.VolumeMount{Name: "var-log-pods", MountPath: varLogPodsPath, ReadOnly: true},
+		)
+	}
+
+	if c.stateVolumeEnabled() {
+		// Mounted over the directory of the var-log-calico volume that fluentd keeps its state in, so that fluentd
+		// does not need to write to the host path that calico-node writes the logs to.
+		stateMount := corev1.VolumeMount{Name: fluentdStateVolumeName, MountPath: c.path(fluentdStateMountDir)}
+		if c.cfg.LogCollector.Spec.FluentdState.Type == operatorv1.FluentdStateVolumePersistentVolumeClaim {
+			// Each node keeps its state in its own directory of the shared volume.
+			stateMount.SubPathExpr = "$(NODENAME)"
+		}
+		volumeMounts = append(volumeMounts, stateMount)
+	}
+
+	if c.deadLetterQueueEnabled() {
+		// Each node writes the chunks it fails to flush to its own directory of the shared volume.
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:        deadLetterQueueVolumeName,
+				MountPath:   deadLetterQueueMountDir,
+				SubPathExpr: "$(NODENAME)",
+			})
+	}
 
 	if c.cfg.ManagedCluster {
 		volumeMounts = append(volumeMounts,
@@ -638,7 +2290,7 @@ func (c *fluentdComponent) container() corev1.Container {
 			})
 	}
 
-	return corev1.Container{
+	container := corev1.Container{
 		Name:  "fluentd",
 		Image: c.image,
 		Env:   envs,
@@ -648,11 +2300,23 @@ func (c *fluentdComponent) container() corev1.Container {
 		StartupProbe:    c.startup(),
 		LivenessProbe:   c.liveness(),
 		ReadinessProbe:  c.readiness(),
-		Ports: []corev1.ContainerPort{{
+	}
+	// When kube-rbac-proxy serves the metrics, it exposes the metrics port instead.
+	if !c.metricsAuthEnabled() {
+		container.Ports = []corev1.ContainerPort{{
 			Name:          "metrics-port",
-			ContainerPort: FluentdMetricsPort,
-		}},
+			ContainerPort: c.metricsPort(),
+		}}
 	}
+	return container
+}
+
+// metricsPort returns the port fluentd serves Prometheus metrics on.
+func (c *fluentdComponent) metricsPort() int32 {
+	if c.cfg.LogCollector != nil && c.cfg.LogCollector.Spec.MetricsPort != nil {
+		return *c.cfg.LogCollector.Spec.MetricsPort
+	}
+	return FluentdMetricsPort
 }
 
 func (c *fluentdComponent) metricsService() *corev1.Service {
@@ -673,8 +2337,8 @@ func (c *fluentdComponent) metricsService() *corev1.Service {
 			Ports: []corev1.ServicePort{
 				{
 					Name:       FluentdMetricsPortName,
-					Port:       int32(FluentdMetricsPort),
-					TargetPort: intstr.FromInt(FluentdMetricsPort),
+					Port:       c.metricsPort(),
+					TargetPort: intstr.FromInt32(c.metricsPort()),
 					Protocol:   corev1.ProtocolTCP,
 				},
 			},
@@ -685,9 +2349,7 @@ func (c *fluentdComponent) metricsService() *corev1.Service {
 func (c *fluentdComponent) envvars() []corev1.EnvVar {
 	envs := []corev1.EnvVar{
 		{Name: "LINSEED_ENABLED", Value: "true"},
-		// Determine the namespace in which Linseed is running. For managed and standalone clusters, this is always the elasticsearch
-		// namespace. For multi-tenant management clusters, this may vary.
-		{Name: "LINSEED_ENDPOINT", Value: relasticsearch.LinseedEndpoint(c.SupportedOSType(), c.cfg.ClusterDomain, LinseedNamespace(c.cfg.Tenant), c.cfg.ManagedCluster, true)},
+		{Name: "LINSEED_ENDPOINT", Value: c.linseedEndpoint()},
 		{Name: "LINSEED_CA_PATH", Value: c.trustedBundlePath()},
 		{Name: "TLS_KEY_PATH", Value: c.keyPath()},
 		{Name: "TLS_CRT_PATH", Value: c.certPath()},
@@ -698,55 +2360,119 @@ func (c *fluentdComponent) envvars() []corev1.EnvVar {
 		{Name: "NODENAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"}}},
 		{Name: "LINSEED_TOKEN", Value: c.path(GetLinseedTokenPath(c.cfg.ManagedCluster))},
 	}
+	envs = append(envs, c.linseedFailoverEnvVars()...)
+	envs = append(envs, c.inputTLSEnvVars()...)
+	envs = append(envs, tlsProfileEnvVars(c.cfg.Installation)...)
+
+	// Turn off the inputs of the log types that aren't collected.
+	collection := c.cfg.LogCollector.Spec.Collection
+	for _, input := range []struct {
+		env     string
+		enabled bool
+	}{
+		// The audit deployment only runs on the API server nodes, so forwarding the flow and DNS logs of those
+		// nodes alone would give an incomplete picture.
+		{"FLOW_LOGS_ENABLED", collection.FlowsEnabled() && !c.auditDeploymentEnabled()},
+		{"DNS_LOGS_ENABLED", collection.DNSEnabled() && !c.auditDeploymentEnabled()},
+		{"L7_LOGS_ENABLED", collection.L7Enabled()},
+		{"AUDIT_LOGS_ENABLED", collection.AuditEnabled()},
+		{"BGP_LOGS_ENABLED", collection.BGPEnabled()},
+		{"RUNTIME_LOGS_ENABLED", collection.RuntimeEnabled()},
+	} {
+		if !input.enabled {
+			envs = append(envs, corev1.EnvVar{Name: input.env, Value: "false"})
+		}
+	}
+
+	if c.deadLetterQueueEnabled() {
+		envs = append(envs,
+			corev1.EnvVar{Name: "DEAD_LETTER_QUEUE_ENABLED", Value: "true"},
+			corev1.EnvVar{Name: "DEAD_LETTER_QUEUE_PATH", Value: deadLetterQueueMountDir},
+		)
+	}
+
+	if c.operatorLogsEnabled() {
+		// The logs of the operator pod, which kubelet names <pod>_<namespace>_<container>-<id>.log.
+		operatorLogs := fmt.Sprintf("%s/%s-*_%s_*.log", varLogContainersPath, common.OperatorName(), common.OperatorNamespace())
+		envs = append(envs,
+			corev1.EnvVar{Name: "OPERATOR_LOGS_ENABLED", Value: "true"},
+			corev1.EnvVar{Name: "OPERATOR_LOG_FILE", Value: operatorLogs},
+			corev1.EnvVar{Name: "OPERATOR_LOG_TAG", Value: "operator"},
+		)
+	}
+
+	envs = append(envs, c.tenantIDEnvVars()...)
 
-	if c.cfg.Tenant != nil && c.cfg.ExternalElastic {
-		envs = append(envs, corev1.EnvVar{Name: "TENANT_ID", Value: c.cfg.Tenant.Spec.ID})
+	if c.metricsAuthEnabled() {
+		// Only kube-rbac-proxy, which serves the metrics port over TLS, can reach the metrics on the loopback
+		// interface.
+		envs = append(envs,
+			corev1.EnvVar{Name: "FLUENTD_PROMETHEUS_BIND", Value: "127.0.0.1"},
+			corev1.EnvVar{Name: "FLUENTD_PROMETHEUS_PORT", Value: fmt.Sprintf("%d", fluentdMetricsUpstreamPort)},
+			corev1.EnvVar{Name: "FLUENTD_PROMETHEUS_TLS", Value: "false"},
+		)
+	} else if c.cfg.LogCollector.Spec.MetricsPort != nil {
+		envs = append(envs, corev1.EnvVar{Name: "FLUENTD_PROMETHEUS_PORT", Value: fmt.Sprintf("%d", c.metricsPort())})
 	}
 
 	if c.cfg.LogCollector.Spec.AdditionalStores != nil {
 		s3 := c.cfg.LogCollector.Spec.AdditionalStores.S3
-		if s3 != nil {
+		if s3 != nil && s3.BucketName != "" {
 			envs = append(envs,
-				corev1.EnvVar{
-					Name: "AWS_KEY_ID",
-					ValueFrom: &corev1.EnvVarSource{
-						SecretKeyRef: &corev1.SecretKeySelector{
-							LocalObjectReference: corev1.LocalObjectReference{
-								Name: S3FluentdSecretName,
-							},
-							Key: S3KeyIdName,
-						},
-					},
-				},
-				corev1.EnvVar{
-					Name: "AWS_SECRET_KEY",
-					ValueFrom: &corev1.EnvVarSource{
-						SecretKeyRef: &corev1.SecretKeySelector{
-							LocalObjectReference: corev1.LocalObjectReference{
-								Name: S3FluentdSecretName,
-							},
-							Key: S3KeySecretName,
-						},
-					},
-				},
+				secretEnvVar("AWS_KEY_ID", S3FluentdSecretName, S3KeyIdName),
+				secretEnvVar("AWS_SECRET_KEY", S3FluentdSecretName, S3KeySecretName),
 				corev1.EnvVar{Name: "S3_STORAGE", Value: "true"},
 				corev1.EnvVar{Name: "S3_BUCKET_NAME", Value: s3.BucketName},
 				corev1.EnvVar{Name: "AWS_REGION", Value: s3.Region},
 				corev1.EnvVar{Name: "S3_BUCKET_PATH", Value: s3.BucketPath},
-				corev1.EnvVar{Name: "S3_FLUSH_INTERVAL", Value: fluentdDefaultFlush},
 			)
+			envs = append(envs, bufferEnvVars("S3", s3.Buffer)...)
 
 			hostScopeEnvVars := envVarsForHostScope(s3.HostScope, ForwardingDestinationS3)
 			envs = append(envs, hostScopeEnvVars...)
 		}
+		gcs := c.cfg.LogCollector.Spec.AdditionalStores.GCS
+		if gcs != nil {
+			envs = append(envs,
+				corev1.EnvVar{Name: "GCS_STORAGE", Value: "true"},
+				corev1.EnvVar{Name: "GCS_PROJECT_ID", Value: gcs.ProjectID},
+				corev1.EnvVar{Name: "GCS_BUCKET_NAME", Value: gcs.BucketName},
+				corev1.EnvVar{Name: "GCS_BUCKET_PATH", Value: gcs.BucketPath},
+				corev1.EnvVar{Name: "GCS_KEYFILE", Value: c.path(gcsCredentialMountDir + GCSKeyFileName)},
+			)
+			envs = append(envs, bufferEnvVars("GCS", gcs.Buffer)...)
+
+			hostScopeEnvVars := envVarsForHostScope(gcs.HostScope, ForwardingDestinationGCS)
+			envs = append(envs, hostScopeEnvVars...)
+		}
+		azureBlob := c.cfg.LogCollector.Spec.AdditionalStores.AzureBlob
+		if azureBlob != nil {
+			envs = append(envs,
+				corev1.EnvVar{Name: "AZURE_BLOB_STORAGE", Value: "true"},
+				corev1.EnvVar{Name: "AZURE_STORAGE_ACCOUNT", Value: azureBlob.StorageAccount},
+				corev1.EnvVar{Name: "AZURE_STORAGE_CONTAINER", Value: azureBlob.Container},
+				corev1.EnvVar{Name: "AZURE_STORAGE_PATH", Value: azureBlob.Path},
+			)
+			envs = append(envs, bufferEnvVars("AZURE", azureBlob.Buffer)...)
+			if cred := c.cfg.AzureBlobCredential; cred != nil {
+				if len(cred.SASToken) > 0 {
+					envs = append(envs, secretEnvVar("AZURE_STORAGE_SAS_TOKEN", AzureBlobFluentdSecretName, AzureBlobSASTokenName))
+				} else {
+					envs = append(envs, secretEnvVar("AZURE_STORAGE_ACCESS_KEY", AzureBlobFluentdSecretName, AzureBlobAccessKeyName))
+				}
+			}
+
+			hostScopeEnvVars := envVarsForHostScope(azureBlob.HostScope, ForwardingDestinationAzureBlob)
+			envs = append(envs, hostScopeEnvVars...)
+		}
+		// A Syslog store without an endpoint only exports to its destinations.
 		syslog := c.cfg.LogCollector.Spec.AdditionalStores.Syslog
-		if syslog != nil {
+		if syslog != nil && syslog.Endpoint != "" {
 			proto, host, port, _ := url.ParseEndpoint(syslog.Endpoint)
 			envs = append(envs,
 				corev1.EnvVar{Name: "SYSLOG_HOST", Value: host},
 				corev1.EnvVar{Name: "SYSLOG_PORT", Value: port},
 				corev1.EnvVar{Name: "SYSLOG_PROTOCOL", Value: proto},
-				corev1.EnvVar{Name: "SYSLOG_FLUSH_INTERVAL", Value: fluentdDefaultFlush},
 				corev1.EnvVar{
 					Name: "SYSLOG_HOSTNAME",
 					ValueFrom: &corev1.EnvVarSource{
@@ -756,6 +2482,7 @@ func (c *fluentdComponent) envvars() []corev1.EnvVar {
 					},
 				},
 			)
+			envs = append(envs, bufferEnvVars("SYSLOG", syslog.Buffer)...)
 			if syslog.PacketSize != nil {
 				envs = append(envs,
 					corev1.EnvVar{
@@ -787,6 +2514,13 @@ func (c *fluentdComponent) envvars() []corev1.EnvVar {
 						envs = append(envs,
 							corev1.EnvVar{Name: "SYSLOG_IDS_EVENT_LOG", Value: "true"},
 						)
+					case operatorv1.SyslogLogBGP:
+						// The BGP logs can only be exported when fluentd tails them.
+						if c.cfg.LogCollector.Spec.Collection.BGPEnabled() {
+							envs = append(envs,
+								corev1.EnvVar{Name: "SYSLOG_BGP_LOG", Value: "true"},
+							)
+						}
 					}
 				}
 			}
@@ -808,6 +2542,12 @@ func (c *fluentdComponent) envvars() []corev1.EnvVar {
 						corev1.EnvVar{Name: "SYSLOG_CA_FILE", Value: SysLogPublicCAPath},
 					)
 				}
+				if c.cfg.SyslogClientCredential != nil {
+					envs = append(envs,
+						corev1.EnvVar{Name: "SYSLOG_CLIENT_CERT_FILE", Value: c.path(syslogClientTLSMountDir + corev1.TLSCertKey)},
+						corev1.EnvVar{Name: "SYSLOG_CLIENT_KEY_FILE", Value: c.path(syslogClientTLSMountDir + corev1.TLSPrivateKeyKey)},
+					)
+				}
 			}
 
 			hostScopeEnvVars := envVarsForHostScope(syslog.HostScope, ForwardingDestinationSyslog)
@@ -834,30 +2574,167 @@ func (c *fluentdComponent) envvars() []corev1.EnvVar {
 				corev1.EnvVar{Name: "SPLUNK_HEC_HOST", Value: host},
 				corev1.EnvVar{Name: "SPLUNK_HEC_PORT", Value: port},
 				corev1.EnvVar{Name: "SPLUNK_PROTOCOL", Value: proto},
-				corev1.EnvVar{Name: "SPLUNK_FLUSH_INTERVAL", Value: fluentdDefaultFlush},
 			)
+			envs = append(envs, bufferEnvVars("SPLUNK", splunk.Buffer)...)
+			envs = append(envs, splunkHECEnvVars(splunk)...)
 
 			hostScopeEnvVars := envVarsForHostScope(splunk.HostScope, ForwardingDestinationSplunk)
 			envs = append(envs, hostScopeEnvVars...)
 		}
+		loki := c.cfg.LogCollector.Spec.AdditionalStores.Loki
+		if loki != nil {
+			envs = append(envs,
+				corev1.EnvVar{Name: "LOKI_URL", Value: loki.URL},
+				corev1.EnvVar{Name: "LOKI_FLOW_LOG", Value: "true"},
+				corev1.EnvVar{Name: "LOKI_AUDIT_LOG", Value: "true"},
+				corev1.EnvVar{Name: "LOKI_DNS_LOG", Value: "true"},
+				corev1.EnvVar{Name: "LOKI_CA_FILE", Value: c.trustedBundlePath()},
+			)
+			envs = append(envs, bufferEnvVars("LOKI", loki.Buffer)...)
+			if loki.TenantID != "" {
+				envs = append(envs, corev1.EnvVar{Name: "LOKI_TENANT_ID", Value: loki.TenantID})
+			}
+			if cred := c.cfg.LokiCredential; cred != nil {
+				if len(cred.Token) > 0 {
+					envs = append(envs, secretEnvVar("LOKI_BEARER_TOKEN", LokiFluentdCredentialSecretName, LokiFluentdSecretTokenKey))
+				} else {
+					envs = append(envs,
+						secretEnvVar("LOKI_USERNAME", LokiFluentdCredentialSecretName, LokiFluentdSecretUsernameKey),
+						secretEnvVar("LOKI_PASSWORD", LokiFluentdCredentialSecretName, LokiFluentdSecretPasswordKey),
+					)
+				}
+			}
+
+			hostScopeEnvVars := envVarsForHostScope(loki.HostScope, ForwardingDestinationLoki)
+			envs = append(envs, hostScopeEnvVars...)
+		}
+		otlp := c.cfg.LogCollector.Spec.AdditionalStores.OTLP
+		if otlp != nil {
+			protocol := otlp.Protocol
+			if protocol == "" {
+				protocol = operatorv1.OTLPProtocolGRPC
+			}
+			envs = append(envs,
+				corev1.EnvVar{Name: "OTLP_ENDPOINT", Value: otlp.Endpoint},
+				corev1.EnvVar{Name: "OTLP_PROTOCOL", Value: strings.ToLower(string(protocol))},
+				corev1.EnvVar{Name: "OTLP_FLOW_LOG", Value: "true"},
+				corev1.EnvVar{Name: "OTLP_AUDIT_LOG", Value: "true"},
+				corev1.EnvVar{Name: "OTLP_DNS_LOG", Value: "true"},
+				corev1.EnvVar{Name: "OTLP_CA_FILE", Value: c.trustedBundlePath()},
+			)
+			envs = append(envs, bufferEnvVars("OTLP", otlp.Buffer)...)
+			if c.cfg.OTLPHeaders != nil {
+				envs = append(envs, corev1.EnvVar{Name: "OTLP_HEADERS_DIR", Value: c.path(otlpHeadersMountDir)})
+			}
+
+			hostScopeEnvVars := envVarsForHostScope(otlp.HostScope, ForwardingDestinationOTLP)
+			envs = append(envs, hostScopeEnvVars...)
+		}
+		securityLake := c.cfg.LogCollector.Spec.AdditionalStores.SecurityLake
+		if securityLake != nil {
+			envs = append(envs,
+				corev1.EnvVar{Name: "SECURITY_LAKE_STORAGE", Value: "true"},
+				corev1.EnvVar{Name: "SECURITY_LAKE_REGION", Value: securityLake.Region},
+				corev1.EnvVar{Name: "SECURITY_LAKE_BUCKET_NAME", Value: securityLake.BucketName},
+				corev1.EnvVar{Name: "SECURITY_LAKE_BUCKET_PATH", Value: securityLakeBucketPath(securityLake)},
+			)
+			envs = append(envs, bufferEnvVars("SECURITY_LAKE", securityLake.Buffer)...)
+			logTypes := securityLake.LogTypes
+			if len(logTypes) == 0 {
+				logTypes = []operatorv1.SecurityLakeLogType{operatorv1.SecurityLakeLogFlows, operatorv1.SecurityLakeLogAudit}
+			}
+			for _, t := range logTypes {
+				switch t {
+				case operatorv1.SecurityLakeLogFlows:
+					envs = append(envs, corev1.EnvVar{Name: "SECURITY_LAKE_FLOW_LOG", Value: "true"})
+				case operatorv1.SecurityLakeLogAudit:
+					envs = append(envs, corev1.EnvVar{Name: "SECURITY_LAKE_AUDIT_LOG", Value: "true"})
+				}
+			}
+			// With IRSA, the credentials are injected by EKS based on the service account annotation. Otherwise, the
+			// static credentials are shared with the S3 store.
+			if s3 := c.cfg.LogCollector.Spec.AdditionalStores.S3; securityLake.RoleARN == "" && (s3 == nil || s3.BucketName == "") {
+				envs = append(envs,
+					secretEnvVar("AWS_KEY_ID", S3FluentdSecretName, S3KeyIdName),
+					secretEnvVar("AWS_SECRET_KEY", S3FluentdSecretName, S3KeySecretName),
+				)
+			}
+
+			hostScopeEnvVars := envVarsForHostScope(securityLake.HostScope, ForwardingDestinationSecurityLake)
+			envs = append(envs, hostScopeEnvVars...)
+		}
 	}
 
-	if c.cfg.Filters != nil {
-		if c.cfg.Filters.Flow != "" {
+	if c.filters != nil {
+		if c.filters.Flow != "" {
 			envs = append(envs,
 				corev1.EnvVar{Name: "FLUENTD_FLOW_FILTERS", Value: "true"})
 		}
-		if c.cfg.Filters.DNS != "" {
+		if c.filters.DNS != "" {
 			envs = append(envs,
 				corev1.EnvVar{Name: "FLUENTD_DNS_FILTERS", Value: "true"})
 		}
 	}
 
+	if len(c.cfg.AdditionalOutputs) > 0 {
+		envs = append(envs,
+			corev1.EnvVar{Name: "FLUENTD_ADDITIONAL_OUTPUTS_DIR", Value: c.path(additionalOutputsMountDir)})
+	}
+	for _, o := range c.destinationOutputs() {
+		envs = append(envs, corev1.EnvVar{Name: o.envVar, Value: o.mountDir})
+	}
+	for _, d := range c.s3Destinations() {
+		envs = append(envs, c.s3DestinationCredentialEnvVars(d)...)
+	}
+	if c.logSourcesEnabled() {
+		envs = append(envs, corev1.EnvVar{Name: "FLUENTD_LOG_SOURCES_DIR", Value: logSourcesMountDir})
+	}
+	if c.aggregatorEnabled() && c.cfg.OSType == rmeta.OSTypeLinux {
+		// The aggregator has no node logs to tail, it only receives the logs sent to its forward input.
+		envs = append(envs,
+			corev1.EnvVar{Name: "NODE_INPUTS_ENABLED", Value: "false"},
+			corev1.EnvVar{Name: "FLUENTD_FORWARD_INPUT_DIR", Value: forwardInputMountDir},
+		)
+	}
+
 	envs = append(envs, corev1.EnvVar{Name: "CA_CRT_PATH", Value: c.trustedBundlePath()})
+	envs = append(envs, c.cfg.Installation.Proxy.EnvVars()...)
 
 	return envs
 }
 
+// tenantIDEnvVars returns the TENANT_ID env var that fluentd sends with the logs of the tenant. Linseed expects the
+// ID of its tenant whether Elasticsearch is internal or external, as does a Linseed endpoint set on the Tenant.
+func (c *fluentdComponent) tenantIDEnvVars() []corev1.EnvVar {
+	if c.cfg.Tenant == nil {
+		return nil
+	}
+	return []corev1.EnvVar{{Name: "TENANT_ID", Value: c.cfg.Tenant.Spec.ID}}
+}
+
+// linseedEndpoint returns the URL that fluentd sends logs to. Multi-tenant management clusters may override it on the
+// Tenant, otherwise it is derived from the namespace in which Linseed is running. For managed and standalone clusters,
+// this is always the elasticsearch namespace. For multi-tenant management clusters, this may vary.
+func (c *fluentdComponent) linseedEndpoint() string {
+	if c.cfg.Tenant.MultiTenant() && c.cfg.Tenant.Spec.LogCollector != nil && c.cfg.Tenant.Spec.LogCollector.LinseedEndpoint != "" {
+		return c.cfg.Tenant.Spec.LogCollector.LinseedEndpoint
+	}
+	return relasticsearch.LinseedEndpoint(c.SupportedOSType(), c.cfg.ClusterDomain, LinseedNamespace(c.cfg.Tenant), c.cfg.ManagedCluster, true)
+}
+
+// linseedFailoverEnvVars configures the Linseed output of managed clusters as a failover pair, so that logs are shipped
+// to the secondary endpoint while the management cluster behind Guardian is unreachable.
+func (c *fluentdComponent) linseedFailoverEnvVars() []corev1.EnvVar {
+	failover := c.cfg.LogCollector.Spec.LinseedFailover
+	if !c.cfg.ManagedCluster || failover == nil {
+		return nil
+	}
+	return []corev1.EnvVar{
+		{Name: "LINSEED_SECONDARY_ENDPOINT", Value: failover.SecondaryEndpoint},
+		{Name: "LINSEED_RETRY_PRIMARY_INTERVAL", Value: fmt.Sprintf("%ds", int64(failover.GetRetryPrimaryInterval().Seconds()))},
+	}
+}
+
 func (c *fluentdComponent) trustedBundlePath() string {
 	if c.cfg.OSType == rmeta.OSTypeWindows {
 		return certificatemanagement.TrustedCertBundleMountPathWindows
@@ -923,18 +2800,23 @@ func (c *fluentdComponent) readiness() *corev1.Probe {
 func (c *fluentdComponent) volumes() []corev1.Volume {
 	dirOrCreate := corev1.HostPathDirectoryOrCreate
 
+	varLogCalico := corev1.VolumeSource{
+		HostPath: &corev1.HostPathVolumeSource{
+			Path: c.volumeHostPath(),
+			Type: &dirOrCreate,
+		},
+	}
+	if c.aggregatorEnabled() {
+		// The aggregator only keeps its buffers and position files here, which do not need to outlive the pod.
+		varLogCalico = corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+	}
 	volumes := []corev1.Volume{
 		{
-			Name: "var-log-calico",
-			VolumeSource: corev1.VolumeSource{
-				HostPath: &corev1.HostPathVolumeSource{
-					Path: c.volumeHostPath(),
-					Type: &dirOrCreate,
-				},
-			},
+			Name:         "var-log-calico",
+			VolumeSource: varLogCalico,
 		},
 	}
-	if c.cfg.Filters != nil {
+	if c.filters != nil {
 		volumes = append(volumes,
 			corev1.Volume{
 				Name: "fluentd-filters",
@@ -947,9 +2829,142 @@ func (c *fluentdComponent) volumes() []corev1.Volume {
 				},
 			})
 	}
+	if len(c.cfg.AdditionalOutputs) > 0 {
+		volumes = append(volumes,
+			corev1.Volume{
+				Name: additionalOutputsVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: FluentdAdditionalOutputsConfigMapName,
+						},
+					},
+				},
+			})
+	}
+	for _, o := range c.destinationOutputs() {
+		volumes = append(volumes, o.volume())
+	}
 	if c.cfg.FluentdKeyPair != nil {
 		volumes = append(volumes, c.cfg.FluentdKeyPair.Volume())
 	}
+	if c.inputTLSEnabled() {
+		volumes = append(volumes, c.cfg.InputKeyPair.Volume())
+	}
+	if c.forwardInputTLSEnabled() {
+		volumes = append(volumes, c.cfg.ForwardInputKeyPair.Volume())
+	}
+	if c.cfg.GCSCredential != nil {
+		volumes = append(volumes,
+			corev1.Volume{
+				Name: gcsCredentialVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: GCSFluentdSecretName,
+					},
+				},
+			})
+	}
+	if c.cfg.SyslogClientCredential != nil {
+		volumes = append(volumes,
+			corev1.Volume{
+				Name: syslogClientTLSVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: SyslogClientTLSSecretName,
+					},
+				},
+			})
+	}
+	if len(c.cfg.SyslogDestinationCAs) > 0 {
+		volumes = append(volumes,
+			corev1.Volume{
+				Name: FluentdSyslogDestinationCAsConfigMapName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: FluentdSyslogDestinationCAsConfigMapName,
+						},
+					},
+				},
+			})
+	}
+	if c.clientCAEnabled() {
+		volumes = append(volumes,
+			corev1.Volume{
+				Name: FluentdClientCAConfigMapName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: FluentdClientCAConfigMapName,
+						},
+					},
+				},
+			})
+	}
+	if c.cfg.OTLPHeaders != nil {
+		volumes = append(volumes,
+			corev1.Volume{
+				Name: otlpHeadersVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: OTLPHeadersSecretName,
+					},
+				},
+			})
+	}
+	if c.logSourcesEnabled() {
+		volumes = append(volumes,
+			corev1.Volume{
+				Name: logSourcesVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: FluentdLogSourcesConfigMapName,
+						},
+					},
+				},
+			})
+	}
+	if c.aggregatorEnabled() && c.cfg.OSType == rmeta.OSTypeLinux {
+		volumes = append(volumes,
+			corev1.Volume{
+				Name: forwardInputVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: FluentdForwardInputConfigMapName,
+						},
+					},
+				},
+			})
+	}
+	if c.containerLogsEnabled() {
+		volumes = append(volumes,
+			corev1.Volume{
+				Name:         "var-log-containers",
+				VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: varLogContainersPath}},
+			},
+			corev1.Volume{
+				Name:         "var-log-pods",
+				VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: varLogPodsPath}},
+			},
+		)
+	}
+	if c.stateVolumeEnabled() {
+		volumes = append(volumes, corev1.Volume{Name: fluentdStateVolumeName, VolumeSource: c.stateVolumeSource()})
+	}
+	if c.deadLetterQueueEnabled() {
+		volumes = append(volumes,
+			corev1.Volume{
+				Name: deadLetterQueueVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: c.cfg.LogCollector.Spec.DeadLetterQueue.PersistentVolumeClaimName,
+					},
+				},
+			})
+	}
 	if c.cfg.ManagedCluster {
 		volumes = append(volumes,
 			corev1.Volume{
@@ -1023,14 +3038,27 @@ func (c *fluentdComponent) fluentdClusterRole() *rbacv1.ClusterRole {
 			ResourceNames: []string{securitycontextconstraints.Privileged},
 		})
 	}
+	if c.metricsAuthEnabled() {
+		role.Rules = append(role.Rules, kubeRBACProxyRules()...)
+	}
 	return role
 }
 
 func (c *fluentdComponent) eksLogForwarderServiceAccount() *corev1.ServiceAccount {
-	return &corev1.ServiceAccount{
+	sa := &corev1.ServiceAccount{
 		TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{Name: EKSLogForwarderName, Namespace: LogCollectorNamespace},
 	}
+	if len(c.cfg.EKSConfig.ServiceAccountAnnotations) > 0 || c.cfg.EKSConfig.RoleARN != "" {
+		sa.Annotations = map[string]string{}
+		for k, v := range c.cfg.EKSConfig.ServiceAccountAnnotations {
+			sa.Annotations[k] = v
+		}
+		if c.cfg.EKSConfig.RoleARN != "" {
+			sa.Annotations[AWSRoleARNAnnotation] = c.cfg.EKSConfig.RoleARN
+		}
+	}
+	return sa
 }
 
 func (c *fluentdComponent) eksLogForwarderSecret() *corev1.Secret {
@@ -1065,27 +3093,44 @@ func (c *fluentdComponent) eksLogForwarderDeployment() *appsv1.Deployment {
 		{Name: "EKS_CLOUDWATCH_LOG_STREAM_PREFIX", Value: c.cfg.EKSConfig.StreamPrefix},
 		{Name: "EKS_CLOUDWATCH_LOG_FETCH_INTERVAL", Value: fmt.Sprintf("%d", c.cfg.EKSConfig.FetchInterval)},
 		{Name: "AWS_REGION", Value: c.cfg.EKSConfig.AwsRegion},
-		{Name: "AWS_ACCESS_KEY_ID", ValueFrom: secret.GetEnvVarSource(EksLogForwarderSecret, EksLogForwarderAwsId, false)},
-		{Name: "AWS_SECRET_ACCESS_KEY", ValueFrom: secret.GetEnvVarSource(EksLogForwarderSecret, EksLogForwarderAwsKey, false)},
+	}
+	// With IRSA, the credentials are injected by EKS based on the service account annotation.
+	if c.cfg.EKSConfig.RoleARN == "" {
+		envVars = append(envVars,
+			corev1.EnvVar{Name: "AWS_ACCESS_KEY_ID", ValueFrom: secret.GetEnvVarSource(EksLogForwarderSecret, EksLogForwarderAwsId, false)},
+			corev1.EnvVar{Name: "AWS_SECRET_ACCESS_KEY", ValueFrom: secret.GetEnvVarSource(EksLogForwarderSecret, EksLogForwarderAwsKey, false)},
+		)
+	}
+	envVars = append(envVars, []corev1.EnvVar{
 		{Name: "LINSEED_ENABLED", Value: "true"},
-		// Determine the namespace in which Linseed is running. For managed and standalone clusters, this is always the elasticsearch
-		// namespace. For multi-tenant management clusters, this may vary.
-		{Name: "LINSEED_ENDPOINT", Value: relasticsearch.LinseedEndpoint(c.SupportedOSType(), c.cfg.ClusterDomain, LinseedNamespace(c.cfg.Tenant), c.cfg.ManagedCluster, true)},
+		{Name: "LINSEED_ENDPOINT", Value: c.linseedEndpoint()},
 		{Name: "LINSEED_CA_PATH", Value: c.trustedBundlePath()},
 		{Name: "TLS_CRT_PATH", Value: c.cfg.EKSLogForwarderKeyPair.VolumeMountCertificateFilePath()},
 		{Name: "TLS_KEY_PATH", Value: c.cfg.EKSLogForwarderKeyPair.VolumeMountKeyFilePath()},
 		{Name: "LINSEED_TOKEN", Value: c.path(GetLinseedTokenPath(c.cfg.ManagedCluster))},
-	}
-	if c.cfg.Tenant != nil && c.cfg.ExternalElastic {
-		envVars = append(envVars, corev1.EnvVar{Name: "TENANT_ID", Value: c.cfg.Tenant.Spec.ID})
-	}
+	}...)
+	envVars = append(envVars, c.linseedFailoverEnvVars()...)
+	envVars = append(envVars, c.tenantIDEnvVars()...)
+	// The forwarder reads from CloudWatch, outside the cluster.
+	envVars = append(envVars, c.cfg.Installation.Proxy.EnvVars()...)
 
 	var eksLogForwarderReplicas int32 = 1
+	if c.cfg.EKSConfig.Replicas != nil {
+		eksLogForwarderReplicas = *c.cfg.EKSConfig.Replicas
+	}
 
 	tolerations := c.cfg.Installation.ControlPlaneTolerations
 	if c.cfg.Installation.KubernetesProvider.IsGKE() {
 		tolerations = append(tolerations, rmeta.TolerateGKEARM64NoSchedule)
 	}
+	if c.cfg.EKSConfig.Tolerations != nil {
+		tolerations = c.cfg.EKSConfig.Tolerations
+	}
+
+	var resources corev1.ResourceRequirements
+	if c.cfg.EKSConfig.Resources != nil {
+		resources = *c.cfg.EKSConfig.Resources
+	}
 
 	d := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
@@ -1116,6 +3161,7 @@ func (c *fluentdComponent) eksLogForwarderDeployment() *appsv1.Deployment {
 					Annotations: annots,
 				},
 				Spec: corev1.PodSpec{
+					NodeSelector:       c.cfg.EKSConfig.NodeSelector,
 					Tolerations:        tolerations,
 					ServiceAccountName: EKSLogForwarderName,
 					ImagePullSecrets:   secret.GetReferenceList(c.cfg.PullSecrets),
@@ -1131,6 +3177,7 @@ func (c *fluentdComponent) eksLogForwarderDeployment() *appsv1.Deployment {
 						Name:            EKSLogForwarderName,
 						Image:           c.image,
 						Env:             envVars,
+						Resources:       resources,
 						SecurityContext: c.securityContext(false),
 						VolumeMounts:    c.eksLogForwarderVolumeMounts(),
 					}},
@@ -1299,12 +3346,13 @@ func (c *fluentdComponent) calicoSystemPolicy() *v3.NetworkPolicy {
 				NotPorts:          networkpolicy.Ports(5554),
 			},
 		})
+		// In multi-tenant management clusters, Linseed runs in the namespace of the tenant.
 		egressRules = append(egressRules, v3.Rule{
 			Action:   v3.Deny,
 			Protocol: &networkpolicy.TCPProtocol,
 			Source:   v3.EntityRule{},
 			Destination: v3.EntityRule{
-				NamespaceSelector: fmt.Sprintf("projectcalico.org/name == '%s'", ElasticsearchNamespace),
+				NamespaceSelector: fmt.Sprintf("projectcalico.org/name == '%s'", LinseedNamespace(c.cfg.Tenant)),
 				Selector:          networkpolicy.KubernetesAppSelector("tigera-linseed"),
 				NotPorts:          networkpolicy.Ports(8444),
 			},
@@ -1321,7 +3369,7 @@ func (c *fluentdComponent) calicoSystemPolicy() *v3.NetworkPolicy {
 			Protocol: &networkpolicy.TCPProtocol,
 			Source:   networkpolicy.PrometheusSourceEntityRule,
 			Destination: v3.EntityRule{
-				Ports: networkpolicy.Ports(FluentdMetricsPort),
+				Ports: networkpolicy.Ports(uint16(c.metricsPort())),
 			},
 		},
 	}
@@ -1336,6 +3384,20 @@ func (c *fluentdComponent) calicoSystemPolicy() *v3.NetworkPolicy {
 			},
 		})
 	}
+	if c.aggregatorEnabled() {
+		// Forwarders may run in any namespace, but only the pods labelled as forwarders may reach the forward input.
+		ingressRules = append(ingressRules, v3.Rule{
+			Action:   v3.Allow,
+			Protocol: &networkpolicy.TCPProtocol,
+			Source: v3.EntityRule{
+				Selector:          fmt.Sprintf("has(%s)", FluentdForwarderLabel),
+				NamespaceSelector: "all()",
+			},
+			Destination: v3.EntityRule{
+				Ports: networkpolicy.Ports(FluentdForwardInputPort),
+			},
+		})
+	}
 
 	return &v3.NetworkPolicy{
 		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"},
@@ -1346,7 +3408,7 @@ func (c *fluentdComponent) calicoSystemPolicy() *v3.NetworkPolicy {
 		Spec: v3.NetworkPolicySpec{
 			Order:                  &networkpolicy.HighPrecedenceOrder,
 			Tier:                   networkpolicy.CalicoTierName,
-			Selector:               networkpolicy.KubernetesAppSelector(FluentdNodeName, fluentdNodeWindowsName),
+			Selector:               networkpolicy.KubernetesAppSelector(fluentdAppNames...),
 			ServiceAccountSelector: "",
 			Types:                  []v3.PolicyType{v3.PolicyTypeIngress, v3.PolicyTypeEgress},
 			Ingress:                ingressRules,
@@ -1355,6 +3417,175 @@ func (c *fluentdComponent) calicoSystemPolicy() *v3.NetworkPolicy {
 	}
 }
 
+// nonClusterHostCalicoSystemPolicy allows the non-cluster host CIDRs published in the NonClusterHost spec to reach
+// the fluentd input service directly.
+func (c *fluentdComponent) nonClusterHostCalicoSystemPolicy() *v3.NetworkPolicy {
+	var nets []string
+	if c.cfg.NonClusterHost != nil {
+		nets = c.cfg.NonClusterHost.Spec.SourceCIDRs
+	}
+
+	return &v3.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FluentdNonClusterHostNetworkPolicyName,
+			Namespace: LogCollectorNamespace,
+		},
+		Spec: v3.NetworkPolicySpec{
+			Order:    &networkpolicy.HighPrecedenceOrder,
+			Tier:     networkpolicy.CalicoTierName,
+			Selector: networkpolicy.KubernetesAppSelector(FluentdNodeName),
+			Types:    []v3.PolicyType{v3.PolicyTypeIngress},
+			Ingress: []v3.Rule{
+				{
+					Action:   v3.Allow,
+					Protocol: &networkpolicy.TCPProtocol,
+					Source:   v3.EntityRule{Nets: nets},
+					Destination: v3.EntityRule{
+						Ports: networkpolicy.Ports(FluentdInputPort),
+					},
+				},
+			},
+		},
+	}
+}
+
+// secretEnvVar returns an env var sourced from the given key of the given credential secret.
+func secretEnvVar(name, secretName, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+			},
+		},
+	}
+}
+
+// securityLakeBucketPath returns the object prefix that Security Lake expects for custom sources. The eventDay
+// partition is filled in by fluentd from the time of each chunk.
+func securityLakeBucketPath(sl *operatorv1.SecurityLakeStoreSpec) string {
+	return fmt.Sprintf("ext/%s/region=%s/accountId=%s/eventDay=%%Y%%m%%d/", sl.SourceName, sl.Region, sl.AccountID)
+}
+
+// bufferEnvVars returns the env vars that tune the buffer of the fluentd output with the given env var prefix.
+// Once the buffer is tuned, its unset fields are rendered with their defaults. Untuned buffers keep the env vars
+// they had before buffer tuning existed, so that upgrading the operator does not restart every fluentd pod.
+func bufferEnvVars(prefix string, buffer *operatorv1.FluentdBufferSpec) []corev1.EnvVar {
+	if buffer == nil {
+		return []corev1.EnvVar{{Name: prefix + "_FLUSH_INTERVAL", Value: fluentdDefaultFlush}}
+	}
+	flushInterval := fluentdDefaultFlush
+	if buffer.FlushInterval != nil {
+		flushInterval = fmt.Sprintf("%ds", int64(buffer.FlushInterval.Seconds()))
+	}
+
+	// A default size never conflicts with the size that is set: the default chunk size does not exceed the total
+	// size, and the default total size is not smaller than the chunk size.
+	chunkLimitSize := fluentdDefaultChunkLimitSize.Value()
+	totalLimitSize := fluentdDefaultTotalLimitSize.Value()
+	switch {
+	case buffer.ChunkLimitSize != nil && buffer.TotalLimitSize != nil:
+		chunkLimitSize, totalLimitSize = buffer.ChunkLimitSize.Value(), buffer.TotalLimitSize.Value()
+	case buffer.ChunkLimitSize != nil:
+		chunkLimitSize = buffer.ChunkLimitSize.Value()
+		totalLimitSize = max(totalLimitSize, chunkLimitSize)
+	case buffer.TotalLimitSize != nil:
+		totalLimitSize = buffer.TotalLimitSize.Value()
+		chunkLimitSize = min(chunkLimitSize, totalLimitSize)
+	}
+	overflowAction := buffer.OverflowAction
+	if overflowAction == "" {
+		overflowAction = operatorv1.FluentdBufferOverflowThrowException
+	}
+
+	// Fluentd reads sizes as a number of bytes.
+	envs := []corev1.EnvVar{
+		{Name: prefix + "_FLUSH_INTERVAL", Value: flushInterval},
+		{Name: prefix + "_CHUNK_LIMIT_SIZE", Value: fmt.Sprintf("%d", chunkLimitSize)},
+		{Name: prefix + "_TOTAL_LIMIT_SIZE", Value: fmt.Sprintf("%d", totalLimitSize)},
+	}
+	if buffer.RetryMaxTimes != nil {
+		envs = append(envs, corev1.EnvVar{Name: prefix + "_RETRY_MAX_TIMES", Value: fmt.Sprintf("%d", *buffer.RetryMaxTimes)})
+	}
+	if action, ok := fluentdOverflowActions[overflowAction]; ok {
+		envs = append(envs, corev1.EnvVar{Name: prefix + "_OVERFLOW_ACTION", Value: action})
+	}
+	return envs
+}
+
+// splunkLogTypePrefixes maps the Splunk log types to the prefix of the env vars that configure their HEC output.
+var splunkLogTypePrefixes = map[operatorv1.SplunkLogType]string{
+	operatorv1.SplunkLogFlows: "SPLUNK_FLOW",
+	operatorv1.SplunkLogAudit: "SPLUNK_AUDIT",
+	operatorv1.SplunkLogDNS:   "SPLUNK_DNS",
+}
+
+// splunkHECEnvVars returns the env vars that set the index, source, sourcetype and fields of the HEC output of each
+// log type. Log type settings take precedence over those of the store.
+func splunkHECEnvVars(splunk *operatorv1.SplunkStoreSpec) []corev1.EnvVar {
+	var envs []corev1.EnvVar
+	for _, logType := range []operatorv1.SplunkLogType{operatorv1.SplunkLogFlows, operatorv1.SplunkLogAudit, operatorv1.SplunkLogDNS} {
+		index, source, sourceType := splunk.Index, splunk.Source, splunk.SourceType
+		fields := map[string]string{}
+		for name, key := range splunk.Fields {
+			fields[name] = key
+		}
+		for _, lt := range splunk.LogTypes {
+			if lt.Type != logType {
+				continue
+			}
+			if lt.Index != "" {
+				index = lt.Index
+			}
+			if lt.Source != "" {
+				source = lt.Source
+			}
+			if lt.SourceType != "" {
+				sourceType = lt.SourceType
+			}
+			for name, key := range lt.Fields {
+				fields[name] = key
+			}
+		}
+
+		prefix := splunkLogTypePrefixes[logType]
+		if index != "" {
+			envs = append(envs, corev1.EnvVar{Name: prefix + "_INDEX", Value: index})
+		}
+		if source != "" {
+			envs = append(envs, corev1.EnvVar{Name: prefix + "_SOURCE", Value: source})
+		}
+		if sourceType != "" {
+			envs = append(envs, corev1.EnvVar{Name: prefix + "_SOURCETYPE", Value: sourceType})
+		}
+		if len(fields) != 0 {
+			// Fluentd reads hashes as comma separated name:key pairs. Sort them so that the DaemonSet is stable.
+			var pairs []string
+			for name, key := range fields {
+				pairs = append(pairs, name+":"+key)
+			}
+			sort.Strings(pairs)
+			envs = append(envs, corev1.EnvVar{Name: prefix + "_FIELDS", Value: strings.Join(pairs, ",")})
+		}
+	}
+	return envs
+}
+
+// The default sizes of the buffer of each fluentd output.
+var (
+	fluentdDefaultChunkLimitSize = resource.MustParse("8Mi")
+	fluentdDefaultTotalLimitSize = resource.MustParse("512Mi")
+)
+
+// fluentdOverflowActions maps the buffer overflow actions of the API to their fluentd names.
+var fluentdOverflowActions = map[operatorv1.FluentdBufferOverflowAction]string{
+	operatorv1.FluentdBufferOverflowThrowException:  "throw_exception",
+	operatorv1.FluentdBufferOverflowBlock:           "block",
+	operatorv1.FluentdBufferOverflowDropOldestChunk: "drop_oldest_chunk",
+}
+
 func envVarsForHostScope(hostScope *operatorv1.HostScope, destination ForwardingDestination) []corev1.EnvVar {
 	var forwardClusterLogs, forwardNonClusterLogs bool
 	if hostScope == nil || *hostScope != operatorv1.HostScopeNonClusterOnly {
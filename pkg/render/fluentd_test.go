@@ -17,6 +17,7 @@ package render_test
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -24,19 +25,23 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/apis"
+	vpav1 "github.com/tigera/operator/pkg/apis/vpa/v1"
 	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/components"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
 	"github.com/tigera/operator/pkg/dns"
@@ -281,6 +286,499 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 		Expect(initContainer.Resources).To(Equal(fluentdResources))
 	})
 
+	It("should render with FluentdDaemonSet scheduling and metadata overrides", func() {
+		toleration := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "logging", Effect: corev1.TaintEffectNoSchedule}
+		affinity := &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key:      "logging",
+							Operator: corev1.NodeSelectorOpExists,
+						}},
+					}},
+				},
+			},
+		}
+		cfg.LogCollector = &operatorv1.LogCollector{
+			Spec: operatorv1.LogCollectorSpec{
+				FluentdDaemonSet: &operatorv1.FluentdDaemonSet{
+					Metadata: &operatorv1.Metadata{Labels: map[string]string{"top-level": "label"}},
+					Spec: &operatorv1.FluentdDaemonSetSpec{
+						MinReadySeconds: ptr.To[int32](5),
+						Template: &operatorv1.FluentdDaemonSetPodTemplateSpec{
+							Metadata: &operatorv1.Metadata{Annotations: map[string]string{"pod": "annotation"}},
+							Spec: &operatorv1.FluentdDaemonSetPodSpec{
+								Affinity:          affinity,
+								NodeSelector:      map[string]string{"logging": "true"},
+								Tolerations:       []corev1.Toleration{toleration},
+								PriorityClassName: "logging-priority",
+								RuntimeClassName:  ptr.To("runc"),
+								ImagePullPolicy:   ptr.To(corev1.PullIfNotPresent),
+							},
+						},
+					},
+				},
+			},
+		}
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Labels).To(HaveKeyWithValue("top-level", "label"))
+		Expect(ds.Spec.MinReadySeconds).To(BeEquivalentTo(5))
+		Expect(ds.Spec.Template.Annotations).To(HaveKeyWithValue("pod", "annotation"))
+		Expect(ds.Spec.Template.Spec.Affinity).To(Equal(affinity))
+		Expect(ds.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("logging", "true"))
+		Expect(ds.Spec.Template.Spec.Tolerations).To(ConsistOf(toleration))
+		Expect(ds.Spec.Template.Spec.PriorityClassName).To(Equal("logging-priority"))
+		Expect(ds.Spec.Template.Spec.RuntimeClassName).To(Equal(ptr.To("runc")))
+		for _, c := range append(ds.Spec.Template.Spec.Containers, ds.Spec.Template.Spec.InitContainers...) {
+			Expect(c.ImagePullPolicy).To(Equal(corev1.PullIfNotPresent), "container %s", c.Name)
+		}
+	})
+
+	It("should use the data plane PriorityClass when the operator manages PriorityClasses", func() {
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.PriorityClassName).To(Equal(render.NodePriorityClassName))
+
+		cfg.Installation.ManagedPriorityClasses = ptr.To(operatorv1.ManagedPriorityClassesEnabled)
+		component = render.Fluentd(cfg)
+		resources, _ = component.Objects()
+		ds = rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.PriorityClassName).To(Equal(render.DataPlanePriorityClassName))
+	})
+
+	It("should apply the TLS security profile", func() {
+		cfg.Installation.TLSSecurityProfile = &operatorv1.TLSSecurityProfile{Type: operatorv1.TLSProfileModernType}
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "TLS_MIN_VERSION", Value: "1.3"},
+			corev1.EnvVar{Name: "TLS_CIPHER_SUITES", Value: "TLS_AES_128_GCM_SHA256,TLS_AES_256_GCM_SHA384,TLS_CHACHA20_POLY1305_SHA256"},
+		))
+	})
+
+	It("should only disable the collection of the log types that are switched off", func() {
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		for _, env := range ds.Spec.Template.Spec.Containers[0].Env {
+			Expect(env.Name).NotTo(HaveSuffix("_LOGS_ENABLED"))
+		}
+
+		cfg.LogCollector.Spec.Collection = &operatorv1.LogCollection{
+			Flows:   ptr.To(true),
+			DNS:     ptr.To(false),
+			L7:      ptr.To(false),
+			BGP:     ptr.To(false),
+			Runtime: ptr.To(false),
+		}
+		component = render.Fluentd(cfg)
+		resources, _ = component.Objects()
+		ds = rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+		Expect(envs).To(ContainElements(
+			corev1.EnvVar{Name: "DNS_LOGS_ENABLED", Value: "false"},
+			corev1.EnvVar{Name: "L7_LOGS_ENABLED", Value: "false"},
+			corev1.EnvVar{Name: "BGP_LOGS_ENABLED", Value: "false"},
+			corev1.EnvVar{Name: "RUNTIME_LOGS_ENABLED", Value: "false"},
+		))
+		for _, env := range envs {
+			Expect(env.Name).NotTo(BeElementOf("FLOW_LOGS_ENABLED", "AUDIT_LOGS_ENABLED"))
+		}
+	})
+
+	It("should collect the operator logs when enabled", func() {
+		cfg.LogCollector.Spec.OperatorLogs = ptr.To(operatorv1.OperatorLogsEnabled)
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "OPERATOR_LOGS_ENABLED", Value: "true"},
+			corev1.EnvVar{Name: "OPERATOR_LOG_FILE", Value: "/var/log/containers/tigera-operator-*_tigera-operator_*.log"},
+			corev1.EnvVar{Name: "OPERATOR_LOG_TAG", Value: "operator"},
+		))
+		Expect(ds.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElements(
+			corev1.VolumeMount{Name: "var-log-containers", MountPath: "/var/log/containers", ReadOnly: true},
+			corev1.VolumeMount{Name: "var-log-pods", MountPath: "/var/log/pods", ReadOnly: true},
+		))
+		Expect(ds.Spec.Template.Spec.Volumes).To(ContainElements(
+			corev1.Volume{Name: "var-log-containers", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/log/containers"}}},
+			corev1.Volume{Name: "var-log-pods", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/log/pods"}}},
+		))
+
+		By("not collecting them on Windows nodes, where the operator does not run")
+		cfg.OSType = rmeta.OSTypeWindows
+		component = render.Fluentd(cfg)
+		resources, _ = component.Objects()
+		ds = rtest.GetResource(resources, "fluentd-node-windows", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		for _, env := range ds.Spec.Template.Spec.Containers[0].Env {
+			Expect(env.Name).NotTo(HavePrefix("OPERATOR_LOG"))
+		}
+		for _, v := range ds.Spec.Template.Spec.Volumes {
+			Expect(v.Name).NotTo(BeElementOf("var-log-containers", "var-log-pods"))
+		}
+	})
+
+	It("should tail the container logs registered with LogSources", func() {
+		cfg.LogSources = []operatorv1.LogSource{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "shop"},
+				Spec: operatorv1.LogSourceSpec{
+					ContainerName: "checkout",
+					PodNamePrefix: "checkout-",
+					Parser: operatorv1.LogParserSpec{
+						Type:       operatorv1.LogParserTypeRegexp,
+						Expression: `^(?<level>\w+) (?<message>.*)$`,
+						TimeKey:    "ts",
+					},
+				},
+			},
+		}
+		resources, _ := render.Fluentd(cfg).Objects()
+
+		cm := rtest.GetResource(resources, render.FluentdLogSourcesConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(cm.Data).To(HaveLen(1))
+		Expect(cm.Data).To(HaveKeyWithValue("shop_checkout.conf", `<source>
+  @type tail
+  @id logsource_shop_checkout
+  path /var/log/containers/checkout-*_shop_checkout-*.log
+  pos_file /var/log/calico/fluentd/logsource_shop_checkout.pos
+  tag logsource.shop.checkout
+  read_from_head true
+  <parse>
+    @type cri
+    <parse>
+      @type regexp
+      expression /^(?<level>\w+) (?<message>.*)$/
+      time_key ts
+    </parse>
+  </parse>
+</source>
+`))
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/fluentd-log-sources"))
+		container := ds.Spec.Template.Spec.Containers[0]
+		Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "FLUENTD_LOG_SOURCES_DIR", Value: "/etc/fluentd/sources.d/"}))
+		Expect(container.VolumeMounts).To(ContainElements(
+			corev1.VolumeMount{Name: "fluentd-log-sources", MountPath: "/etc/fluentd/sources.d/", ReadOnly: true},
+			corev1.VolumeMount{Name: "var-log-containers", MountPath: "/var/log/containers", ReadOnly: true},
+			corev1.VolumeMount{Name: "var-log-pods", MountPath: "/var/log/pods", ReadOnly: true},
+		))
+
+		By("not collecting them on Windows nodes")
+		cfg.OSType = rmeta.OSTypeWindows
+		resources, _ = render.Fluentd(cfg).Objects()
+		Expect(rtest.GetResource(resources, render.FluentdLogSourcesConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap")).To(BeNil())
+		ds = rtest.GetResource(resources, "fluentd-node-windows", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		for _, v := range ds.Spec.Template.Spec.Volumes {
+			Expect(v.Name).NotTo(BeElementOf("fluentd-log-sources", "var-log-containers", "var-log-pods"))
+		}
+	})
+
+	It("should give LogSources with dashes in their namespace and name distinct ids", func() {
+		spec := operatorv1.LogSourceSpec{ContainerName: "app", Parser: operatorv1.LogParserSpec{Type: operatorv1.LogParserTypeJSON}}
+		cfg.LogSources = []operatorv1.LogSource{
+			{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "a-b"}, Spec: spec},
+			{ObjectMeta: metav1.ObjectMeta{Name: "b-c", Namespace: "a"}, Spec: spec},
+		}
+		resources, _ := render.Fluentd(cfg).Objects()
+
+		cm := rtest.GetResource(resources, render.FluentdLogSourcesConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(cm.Data).To(HaveLen(2))
+		Expect(cm.Data["a-b_c.conf"]).To(ContainSubstring("@id logsource_a-b_c\n"))
+		Expect(cm.Data["a_b-c.conf"]).To(ContainSubstring("@id logsource_a_b-c\n"))
+	})
+
+	It("should configure a secondary Linseed endpoint on managed clusters", func() {
+		cfg.LogCollector.Spec.LinseedFailover = &operatorv1.LinseedFailover{SecondaryEndpoint: "https://voltron.standby.example.com:9443"}
+		resources, _ := render.Fluentd(cfg).Objects()
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		for _, env := range ds.Spec.Template.Spec.Containers[0].Env {
+			Expect(env.Name).NotTo(HavePrefix("LINSEED_SECONDARY"))
+		}
+
+		cfg.ManagedCluster = true
+		resources, _ = render.Fluentd(cfg).Objects()
+		ds = rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "LINSEED_ENDPOINT", Value: "https://tigera-linseed"},
+			corev1.EnvVar{Name: "LINSEED_SECONDARY_ENDPOINT", Value: "https://voltron.standby.example.com:9443"},
+			corev1.EnvVar{Name: "LINSEED_RETRY_PRIMARY_INTERVAL", Value: "300s"},
+		))
+
+		cfg.LogCollector.Spec.LinseedFailover.RetryPrimaryInterval = &metav1.Duration{Duration: time.Minute}
+		resources, _ = render.Fluentd(cfg).Objects()
+		ds = rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "LINSEED_RETRY_PRIMARY_INTERVAL", Value: "60s"}))
+	})
+
+	It("should write failed chunks to the dead letter queue and render the replay CronJob", func() {
+		cfg.LogCollector.Spec.DeadLetterQueue = &operatorv1.FluentdDeadLetterQueue{PersistentVolumeClaimName: "fluentd-dlq"}
+		resources, toDelete := render.Fluentd(cfg).Objects()
+		Expect(rtest.GetResource(toDelete, "fluentd-dead-letter-replay", "tigera-fluentd", "batch", "v1", "CronJob")).To(BeNil())
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "DEAD_LETTER_QUEUE_ENABLED", Value: "true"},
+			corev1.EnvVar{Name: "DEAD_LETTER_QUEUE_PATH", Value: "/var/lib/fluentd/dead-letter"},
+		))
+		Expect(ds.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(
+			corev1.VolumeMount{Name: "dead-letter-queue", MountPath: "/var/lib/fluentd/dead-letter", SubPathExpr: "$(NODENAME)"},
+		))
+		dlqVolume := corev1.Volume{
+			Name:         "dead-letter-queue",
+			VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "fluentd-dlq"}},
+		}
+		Expect(ds.Spec.Template.Spec.Volumes).To(ContainElement(dlqVolume))
+
+		cj := rtest.GetResource(resources, "fluentd-dead-letter-replay", "tigera-fluentd", "batch", "v1", "CronJob").(*batchv1.CronJob)
+		Expect(*cj.Spec.Suspend).To(BeTrue())
+		podSpec := cj.Spec.JobTemplate.Spec.Template.Spec
+		Expect(cj.Spec.JobTemplate.Spec.Template.Labels).To(HaveKeyWithValue("k8s-app", "fluentd-dead-letter-replay"))
+		Expect(podSpec.ServiceAccountName).To(Equal("fluentd-node"))
+		Expect(podSpec.RestartPolicy).To(Equal(corev1.RestartPolicyOnFailure))
+		Expect(podSpec.Volumes).To(ContainElement(dlqVolume))
+		Expect(podSpec.Containers).To(HaveLen(1))
+		Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "DEAD_LETTER_QUEUE_REPLAY", Value: "true"}))
+		Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(
+			corev1.VolumeMount{Name: "dead-letter-queue", MountPath: "/var/lib/fluentd/dead-letter"},
+		))
+		Expect(podSpec.Containers[0].LivenessProbe).To(BeNil())
+
+		By("keeping the replay state apart from the fluentd-node pods")
+		cfg.LogCollector.Spec.FluentdState = &operatorv1.FluentdState{Type: operatorv1.FluentdStateVolumeHostPath}
+		resources, _ = render.Fluentd(cfg).Objects()
+		cj = rtest.GetResource(resources, "fluentd-dead-letter-replay", "tigera-fluentd", "batch", "v1", "CronJob").(*batchv1.CronJob)
+		podSpec = cj.Spec.JobTemplate.Spec.Template.Spec
+		Expect(podSpec.Volumes).To(ContainElement(corev1.Volume{
+			Name:         "var-log-calico",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		}))
+		Expect(podSpec.Volumes).NotTo(ContainElement(HaveField("Name", "fluentd-state")))
+		Expect(podSpec.Containers[0].VolumeMounts).NotTo(ContainElement(HaveField("Name", "fluentd-state")))
+		for _, v := range podSpec.Volumes {
+			Expect(v.HostPath).To(BeNil())
+		}
+
+		By("deleting the replay CronJob when the dead letter queue is not configured")
+		cfg.LogCollector.Spec.DeadLetterQueue = nil
+		resources, toDelete = render.Fluentd(cfg).Objects()
+		Expect(rtest.GetResource(resources, "fluentd-dead-letter-replay", "tigera-fluentd", "batch", "v1", "CronJob")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, "fluentd-dead-letter-replay", "tigera-fluentd", "batch", "v1", "CronJob")).NotTo(BeNil())
+	})
+
+	It("should keep the fluentd state on the configured volume", func() {
+		resources, _ := render.Fluentd(cfg).Objects()
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		for _, m := range ds.Spec.Template.Spec.Containers[0].VolumeMounts {
+			Expect(m.Name).NotTo(Equal("fluentd-state"))
+		}
+
+		dirOrCreate := corev1.HostPathDirectoryOrCreate
+		for _, tc := range []struct {
+			state  operatorv1.FluentdState
+			source corev1.VolumeSource
+			mount  corev1.VolumeMount
+		}{
+			{
+				state:  operatorv1.FluentdState{Type: operatorv1.FluentdStateVolumeHostPath},
+				source: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/log/calico/fluentd", Type: &dirOrCreate}},
+				mount:  corev1.VolumeMount{Name: "fluentd-state", MountPath: "/var/log/calico/fluentd"},
+			},
+			{
+				state:  operatorv1.FluentdState{Type: operatorv1.FluentdStateVolumeHostPath, HostPath: "/var/lib/calico-fluentd"},
+				source: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/lib/calico-fluentd", Type: &dirOrCreate}},
+				mount:  corev1.VolumeMount{Name: "fluentd-state", MountPath: "/var/log/calico/fluentd"},
+			},
+			{
+				state:  operatorv1.FluentdState{Type: operatorv1.FluentdStateVolumeEmptyDir},
+				source: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+				mount:  corev1.VolumeMount{Name: "fluentd-state", MountPath: "/var/log/calico/fluentd"},
+			},
+			{
+				state:  operatorv1.FluentdState{Type: operatorv1.FluentdStateVolumePersistentVolumeClaim, PersistentVolumeClaimName: "fluentd-state"},
+				source: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "fluentd-state"}},
+				mount:  corev1.VolumeMount{Name: "fluentd-state", MountPath: "/var/log/calico/fluentd", SubPathExpr: "$(NODENAME)"},
+			},
+		} {
+			cfg.LogCollector.Spec.FluentdState = &tc.state
+			resources, _ = render.Fluentd(cfg).Objects()
+			ds = rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+			Expect(ds.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{Name: "fluentd-state", VolumeSource: tc.source}))
+			Expect(ds.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(tc.mount))
+
+			// The logs written by calico-node are still read from the host.
+			Expect(ds.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name:         "var-log-calico",
+				VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/log/calico", Type: &dirOrCreate}},
+			}))
+		}
+	})
+
+	It("should render the log pipeline canary CronJob", func() {
+		cfg.LogCollector.Spec.PipelineCanary = &operatorv1.LogPipelineCanary{}
+		resources, toDelete := render.Fluentd(cfg).Objects()
+		Expect(rtest.GetResource(toDelete, "fluentd-pipeline-canary", "tigera-fluentd", "batch", "v1", "CronJob")).To(BeNil())
+
+		cj := rtest.GetResource(resources, "fluentd-pipeline-canary", "tigera-fluentd", "batch", "v1", "CronJob").(*batchv1.CronJob)
+		Expect(cj.Spec.Schedule).To(Equal("*/15 * * * *"))
+		Expect(cj.Spec.Suspend).To(BeNil())
+		Expect(cj.Spec.JobTemplate.Labels).To(HaveKeyWithValue("k8s-app", "fluentd-pipeline-canary"))
+		Expect(*cj.Spec.JobTemplate.Spec.BackoffLimit).To(BeZero())
+		podSpec := cj.Spec.JobTemplate.Spec.Template.Spec
+		Expect(cj.Spec.JobTemplate.Spec.Template.Labels).To(HaveKeyWithValue("k8s-app", "fluentd-pipeline-canary"))
+		Expect(podSpec.ServiceAccountName).To(Equal("fluentd-node"))
+		Expect(podSpec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+		Expect(podSpec.Containers).To(HaveLen(1))
+		Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "PIPELINE_CANARY", Value: "true"}))
+		Expect(podSpec.Containers[0].LivenessProbe).To(BeNil())
+		Expect(podSpec.Volumes).To(ContainElement(corev1.Volume{
+			Name:         "var-log-calico",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		}))
+
+		By("using the configured schedule")
+		cfg.LogCollector.Spec.PipelineCanary.Schedule = "@hourly"
+		resources, _ = render.Fluentd(cfg).Objects()
+		cj = rtest.GetResource(resources, "fluentd-pipeline-canary", "tigera-fluentd", "batch", "v1", "CronJob").(*batchv1.CronJob)
+		Expect(cj.Spec.Schedule).To(Equal("@hourly"))
+
+		By("deleting the CronJob when the canary is not configured")
+		cfg.LogCollector.Spec.PipelineCanary = nil
+		resources, toDelete = render.Fluentd(cfg).Objects()
+		Expect(rtest.GetResource(resources, "fluentd-pipeline-canary", "tigera-fluentd", "batch", "v1", "CronJob")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, "fluentd-pipeline-canary", "tigera-fluentd", "batch", "v1", "CronJob")).NotTo(BeNil())
+	})
+
+	It("should render a CronJob for each LogExport", func() {
+		cfg.LogExports = []operatorv1.LogExport{{
+			ObjectMeta: metav1.ObjectMeta{Name: "daily-flows"},
+			Spec: operatorv1.LogExportSpec{
+				Schedule: "0 0 * * *",
+				Query: operatorv1.LogExportQuery{
+					LogType:    operatorv1.LogExportLogFlows,
+					TimeWindow: &metav1.Duration{Duration: time.Hour},
+					Selector:   "source_namespace = 'default'",
+				},
+				Destination: operatorv1.LogExportDestination{
+					S3: &operatorv1.LogExportS3Destination{
+						Region:                "us-west-1",
+						BucketName:            "reports",
+						BucketPath:            "flows",
+						CredentialsSecretName: "export-creds",
+					},
+				},
+			},
+		}}
+		cfg.LogExportCredentials = map[string]*render.S3Credential{"daily-flows": {KeyId: []byte("id"), KeySecret: []byte("secret")}}
+		cfg.StaleLogExports = []string{"removed"}
+		component := render.Fluentd(cfg)
+		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+		resources, toDelete := component.Objects()
+
+		secret := rtest.GetResource(resources, render.LogExportsSecretName, render.LogCollectorNamespace, "", "v1", "Secret").(*corev1.Secret)
+		Expect(secret.Data).To(HaveKeyWithValue("daily-flows-key-id", []byte("id")))
+		Expect(secret.Data).To(HaveKeyWithValue("daily-flows-key-secret", []byte("secret")))
+
+		cj := rtest.GetResource(resources, "log-export-daily-flows", render.LogCollectorNamespace, "batch", "v1", "CronJob").(*batchv1.CronJob)
+		Expect(cj.Spec.Schedule).To(Equal("0 0 * * *"))
+		Expect(cj.Spec.ConcurrencyPolicy).To(Equal(batchv1.ForbidConcurrent))
+		Expect(cj.Labels).To(HaveKeyWithValue(render.LogExportLabel, "daily-flows"))
+		Expect(cj.Spec.JobTemplate.Labels).To(HaveKeyWithValue("k8s-app", render.LogExportName))
+		Expect(cj.Spec.JobTemplate.Labels).To(HaveKeyWithValue(render.LogExportLabel, "daily-flows"))
+		podSpec := cj.Spec.JobTemplate.Spec.Template.Spec
+		Expect(podSpec.ServiceAccountName).To(Equal("fluentd-node"))
+		Expect(podSpec.Containers).To(HaveLen(1))
+		Expect(podSpec.Containers[0].Image).To(ContainSubstring("log-exporter"))
+		Expect(podSpec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "EXPORT_NAME", Value: "daily-flows"},
+			corev1.EnvVar{Name: "EXPORT_LOG_TYPE", Value: "Flows"},
+			corev1.EnvVar{Name: "EXPORT_TIME_WINDOW", Value: "1h0m0s"},
+			corev1.EnvVar{Name: "EXPORT_SELECTOR", Value: "source_namespace = 'default'"},
+			corev1.EnvVar{Name: "AWS_REGION", Value: "us-west-1"},
+			corev1.EnvVar{Name: "S3_BUCKET_NAME", Value: "reports"},
+			corev1.EnvVar{Name: "S3_BUCKET_PATH", Value: "flows"},
+		))
+
+		Expect(rtest.GetResource(toDelete, "log-export-removed", render.LogCollectorNamespace, "batch", "v1", "CronJob")).NotTo(BeNil())
+
+		By("deleting the credentials secret when no LogExport has its own credentials")
+		cfg.LogExportCredentials = nil
+		_, toDelete = render.Fluentd(cfg).Objects()
+		Expect(rtest.GetResource(toDelete, render.LogExportsSecretName, render.LogCollectorNamespace, "", "v1", "Secret")).NotTo(BeNil())
+
+		By("rejecting FIPS mode")
+		cfg.Installation.FIPSMode = ptr.To(operatorv1.FIPSModeEnabled)
+		Expect(render.Fluentd(cfg).ResolveImages(nil)).To(HaveOccurred())
+	})
+
+	It("should serve metrics through kube-rbac-proxy", func() {
+		cfg.LogCollector.Spec.MetricsAuth = ptr.To(operatorv1.MetricsAuthTokenReview)
+		component := render.Fluentd(cfg)
+		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+		resources, _ := component.Objects()
+
+		ds := rtest.GetResource(resources, render.FluentdNodeName, render.LogCollectorNamespace, "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(2))
+		fluentd := ds.Spec.Template.Spec.Containers[0]
+		Expect(fluentd.Ports).To(BeEmpty())
+		Expect(fluentd.Env).To(ContainElements(
+			corev1.EnvVar{Name: "FLUENTD_PROMETHEUS_BIND", Value: "127.0.0.1"},
+			corev1.EnvVar{Name: "FLUENTD_PROMETHEUS_PORT", Value: "9082"},
+			corev1.EnvVar{Name: "FLUENTD_PROMETHEUS_TLS", Value: "false"},
+		))
+		proxy := ds.Spec.Template.Spec.Containers[1]
+		Expect(proxy.Name).To(Equal("kube-rbac-proxy"))
+		Expect(proxy.Image).To(ContainSubstring("kube-rbac-proxy"))
+		Expect(proxy.Args).To(ContainElements(
+			"--secure-listen-address=:9081",
+			"--upstream=http://127.0.0.1:9082/",
+			"--tls-cert-file=/tigera-fluentd-prometheus-tls/tls.crt",
+		))
+
+		role := rtest.GetResource(resources, "tigera-fluentd", "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole)
+		Expect(role.Rules).To(ContainElement(rbacv1.PolicyRule{APIGroups: []string{"authentication.k8s.io"}, Resources: []string{"tokenreviews"}, Verbs: []string{"create"}}))
+
+		By("not adding the proxy to the Windows pods")
+		cfg.OSType = rmeta.OSTypeWindows
+		resources, _ = render.Fluentd(cfg).Objects()
+		ds = rtest.GetResource(resources, "fluentd-node-windows", render.LogCollectorNamespace, "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+
+		By("rejecting FIPS mode")
+		cfg.OSType = rmeta.OSTypeLinux
+		cfg.Installation.FIPSMode = ptr.To(operatorv1.FIPSModeEnabled)
+		Expect(render.Fluentd(cfg).ResolveImages(nil)).To(HaveOccurred())
+	})
+
+	It("should serve metrics on the configured port", func() {
+		cfg.LogCollector.Spec.MetricsPort = ptr.To(int32(9090))
+		resources, _ := render.Fluentd(cfg).Objects()
+
+		ds := rtest.GetResource(resources, render.FluentdNodeName, render.LogCollectorNamespace, "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		container := ds.Spec.Template.Spec.Containers[0]
+		Expect(container.Ports).To(Equal([]corev1.ContainerPort{{Name: "metrics-port", ContainerPort: 9090}}))
+		Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "FLUENTD_PROMETHEUS_PORT", Value: "9090"}))
+
+		svc := rtest.GetResource(resources, render.FluentdMetricsService, render.LogCollectorNamespace, "", "v1", "Service").(*corev1.Service)
+		Expect(svc.Spec.Ports).To(Equal([]corev1.ServicePort{{
+			Name:       render.FluentdMetricsPortName,
+			Port:       9090,
+			TargetPort: intstr.FromInt32(9090),
+			Protocol:   corev1.ProtocolTCP,
+		}}))
+
+		policy := testutils.GetCalicoSystemPolicyFromResources(types.NamespacedName{Name: render.FluentdPolicyName, Namespace: render.LogCollectorNamespace}, resources)
+		Expect(policy.Spec.Ingress[0].Destination.Ports).To(Equal(networkpolicy.Ports(9090)))
+	})
+
 	It("should render with a configuration for a managed cluster", func() {
 		expectedResources := []client.Object{
 			&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdPolicyName, Namespace: render.LogCollectorNamespace}},
@@ -295,6 +793,14 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 
 		expectedDeleteResources := []client.Object{
 			&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-tigera.allow-fluentd-node", Namespace: render.LogCollectorNamespace}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdAuditName, Namespace: render.LogCollectorNamespace}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdForwardInputConfigMapName, Namespace: render.LogCollectorNamespace}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdForwardInputService, Namespace: render.LogCollectorNamespace}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdAggregatorName, Namespace: render.LogCollectorNamespace}},
+			&batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdDeadLetterReplayName, Namespace: render.LogCollectorNamespace}},
+			&batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdPipelineCanaryName, Namespace: render.LogCollectorNamespace}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: render.LogExportsSecretName, Namespace: render.LogCollectorNamespace}},
+			&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdNonClusterHostNetworkPolicyName, Namespace: render.LogCollectorNamespace}},
 		}
 
 		// Should render the correct resources.
@@ -397,6 +903,14 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 
 		expectedDeleteResources := []client.Object{
 			&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-tigera.allow-fluentd-node", Namespace: render.LogCollectorNamespace}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdAuditName, Namespace: render.LogCollectorNamespace}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdForwardInputConfigMapName, Namespace: render.LogCollectorNamespace}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdForwardInputService, Namespace: render.LogCollectorNamespace}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdAggregatorName, Namespace: render.LogCollectorNamespace}},
+			&batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdDeadLetterReplayName, Namespace: render.LogCollectorNamespace}},
+			&batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdPipelineCanaryName, Namespace: render.LogCollectorNamespace}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: render.LogExportsSecretName, Namespace: render.LogCollectorNamespace}},
+			&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdNonClusterHostNetworkPolicyName, Namespace: render.LogCollectorNamespace}},
 		}
 
 		pc := &operatorv1.PacketCaptureAPI{
@@ -668,111 +1182,457 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 		}
 	})
 
-	It("should render with Syslog configuration", func() {
-		expectedResources := []client.Object{
-			&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdPolicyName, Namespace: render.LogCollectorNamespace}, TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"}},
-			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdMetricsService, Namespace: render.LogCollectorNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
-			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
-			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
-			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"}},
-			&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"}},
+	It("should render the S3 destinations as separate outputs with their own credentials", func() {
+		cfg.S3Credential = &render.S3Credential{
+			KeyId:     []byte("IdForTheKey"),
+			KeySecret: []byte("SecretForTheKey"),
+		}
+		cfg.S3DestinationCredentials = map[string]*render.S3Credential{
+			"audit": {KeyId: []byte("AuditKeyId"), KeySecret: []byte("AuditKeySecret")},
 		}
-
-		var ps int32 = 180
 		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
-			Syslog: &operatorv1.SyslogStoreSpec{
-				Endpoint:   "tcp://1.2.3.4:80",
-				PacketSize: &ps,
-				LogTypes: []operatorv1.SyslogLogType{
-					operatorv1.SyslogLogDNS,
-					operatorv1.SyslogLogFlows,
-					operatorv1.SyslogLogIDSEvents,
+			S3: &operatorv1.S3StoreSpec{
+				Destinations: []operatorv1.S3DestinationSpec{
+					{
+						Name:       "flows",
+						Region:     "us-east-1",
+						BucketName: "flows-archive",
+						BucketPath: "flows",
+						LogTypes:   []operatorv1.S3LogType{operatorv1.S3LogFlows, operatorv1.S3LogDNS},
+					},
+					{
+						Name:                  "audit",
+						Region:                "eu-west-1",
+						BucketName:            "audit-archive",
+						LogTypes:              []operatorv1.S3LogType{operatorv1.S3LogAudit},
+						CredentialsSecretName: "audit-archive-credentials",
+					},
 				},
 			},
 		}
+
 		component := render.Fluentd(cfg)
 		resources, _ := component.Objects()
-		rtest.ExpectResources(resources, expectedResources)
 
-		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
-		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
-		Expect(ds.Spec.Template.Spec.Volumes).To(HaveLen(3))
-		envs := ds.Spec.Template.Spec.Containers[0].Env
+		creds := rtest.GetResource(resources, render.S3DestinationsSecretName, render.LogCollectorNamespace, "", "v1", "Secret").(*corev1.Secret)
+		Expect(creds.Data).To(Equal(map[string][]byte{
+			"audit-key-id":     []byte("AuditKeyId"),
+			"audit-key-secret": []byte("AuditKeySecret"),
+		}))
 
-		expectedEnvs := []struct {
-			name       string
-			val        string
-			secretName string
-			secretKey  string
-		}{
-			{"SYSLOG_HOST", "1.2.3.4", "", ""},
-			{"SYSLOG_PORT", "80", "", ""},
-			{"SYSLOG_PROTOCOL", "tcp", "", ""},
-			{"SYSLOG_FLUSH_INTERVAL", "5s", "", ""},
-			{"SYSLOG_PACKET_SIZE", "180", "", ""},
-			{"SYSLOG_DNS_LOG", "true", "", ""},
-			{"SYSLOG_FLOW_LOG", "true", "", ""},
-			{"SYSLOG_IDS_EVENT_LOG", "true", "", ""},
-		}
-		for _, expected := range expectedEnvs {
-			if expected.val != "" {
-				Expect(envs).To(ContainElement(corev1.EnvVar{Name: expected.name, Value: expected.val}))
-			} else {
-				Expect(envs).To(ContainElement(corev1.EnvVar{
-					Name: expected.name,
-					ValueFrom: &corev1.EnvVarSource{
-						SecretKeyRef: &corev1.SecretKeySelector{
-							LocalObjectReference: corev1.LocalObjectReference{Name: expected.secretName},
-							Key:                  expected.secretKey,
-						},
-					},
-				}))
-			}
+		cm := rtest.GetResource(resources, render.FluentdS3OutputsConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(cm.Data).To(HaveLen(4))
+		flowsStore := func(tag string) string {
+			return `<store ignore_error>
+  @type s3
+  aws_key_id "#{ENV['S3_FLOWS_AWS_KEY_ID']}"
+  aws_sec_key "#{ENV['S3_FLOWS_AWS_SECRET_KEY']}"
+  s3_region us-east-1
+  s3_bucket flows-archive
+  path flows
+  <buffer tag,time>
+    @type file
+    path /var/log/calico/fluentd/s3-flows-` + tag + `
+  </buffer>
+</store>
+`
 		}
+		Expect(cm.Data["tigera.calico.flows.conf"]).To(Equal(flowsStore("tigera.calico.flows")))
+		Expect(cm.Data["tigera.calico.dns.conf"]).To(Equal(flowsStore("tigera.calico.dns")))
+		Expect(cm.Data["tigera.calico.ee_audit.conf"]).To(ContainSubstring("  s3_bucket audit-archive\n"))
+		Expect(cm.Data["tigera.calico.ee_audit.conf"]).To(ContainSubstring("    path /var/log/calico/fluentd/s3-audit-tigera.calico.ee_audit\n"))
+		Expect(cm.Data["tigera.calico.kube_audit.conf"]).To(ContainSubstring("    path /var/log/calico/fluentd/s3-audit-tigera.calico.kube_audit\n"))
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/fluentd-s3-outputs"))
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/s3-destination-credentials"))
+		container := ds.Spec.Template.Spec.Containers[0]
+		Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name:      "fluentd-s3-outputs",
+			MountPath: "/etc/fluentd/s3-outputs.d/",
+			ReadOnly:  true,
+		}))
+		envs := container.Env
+		Expect(envs).To(ContainElement(corev1.EnvVar{Name: "FLUENTD_S3_OUTPUTS_DIR", Value: "/etc/fluentd/s3-outputs.d/"}))
 		Expect(envs).To(ContainElement(corev1.EnvVar{
-			Name: "SYSLOG_HOSTNAME",
-			ValueFrom: &corev1.EnvVarSource{
-				FieldRef: &corev1.ObjectFieldSelector{
-					FieldPath: "spec.nodeName",
-				},
-			},
+			Name: "S3_FLOWS_AWS_KEY_ID",
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "log-collector-s3-credentials"},
+				Key:                  "key-id",
+			}},
+		}))
+		Expect(envs).To(ContainElement(corev1.EnvVar{
+			Name: "S3_AUDIT_AWS_SECRET_KEY",
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: render.S3DestinationsSecretName},
+				Key:                  "audit-key-secret",
+			}},
 		}))
+		// Without a bucket of its own, the S3 store only exports to its destinations.
+		for _, env := range envs {
+			Expect(env.Name).NotTo(Equal("S3_STORAGE"))
+		}
 	})
 
-	It("should render with Syslog configuration with TLS and user's corporate CA", func() {
-		cfg.UseSyslogCertificate = true
-		var ps int32 = 180
+	It("should render the buffer tuning of an additional store", func() {
+		cfg.S3Credential = &render.S3Credential{
+			KeyId:     []byte("IdForTheKey"),
+			KeySecret: []byte("SecretForTheKey"),
+		}
+		chunkLimit := resource.MustParse("8Mi")
+		totalLimit := resource.MustParse("1Gi")
 		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
-			Syslog: &operatorv1.SyslogStoreSpec{
-				Endpoint:   "tcp://1.2.3.4:80",
-				Encryption: operatorv1.EncryptionTLS,
-				PacketSize: &ps,
-				LogTypes: []operatorv1.SyslogLogType{
-					operatorv1.SyslogLogDNS,
-					operatorv1.SyslogLogFlows,
-					operatorv1.SyslogLogIDSEvents,
+			S3: &operatorv1.S3StoreSpec{
+				Region:     "anyplace",
+				BucketName: "thebucket",
+				BucketPath: "bucketpath",
+				Buffer: &operatorv1.FluentdBufferSpec{
+					ChunkLimitSize: &chunkLimit,
+					TotalLimitSize: &totalLimit,
+					FlushInterval:  &metav1.Duration{Duration: 30 * time.Second},
+					RetryMaxTimes:  ptr.To[int32](3),
+					OverflowAction: operatorv1.FluentdBufferOverflowDropOldestChunk,
 				},
 			},
 		}
+
 		component := render.Fluentd(cfg)
 		resources, _ := component.Objects()
-
 		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
-		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
-		Expect(ds.Spec.Template.Spec.Volumes).To(HaveLen(3))
-
-		var volnames []string
-		for _, vol := range ds.Spec.Template.Spec.Volumes {
-			volnames = append(volnames, vol.Name)
-		}
-		Expect(volnames).To(ContainElement("tigera-ca-bundle"))
-
 		envs := ds.Spec.Template.Spec.Containers[0].Env
+		Expect(envs).To(ContainElements(
+			corev1.EnvVar{Name: "S3_FLUSH_INTERVAL", Value: "30s"},
+			corev1.EnvVar{Name: "S3_CHUNK_LIMIT_SIZE", Value: "8388608"},
+			corev1.EnvVar{Name: "S3_TOTAL_LIMIT_SIZE", Value: "1073741824"},
+			corev1.EnvVar{Name: "S3_RETRY_MAX_TIMES", Value: "3"},
+			corev1.EnvVar{Name: "S3_OVERFLOW_ACTION", Value: "drop_oldest_chunk"},
+		))
+	})
 
-		Expect(envs).To(ContainElements([]corev1.EnvVar{
-			{Name: "SYSLOG_HOST", Value: "1.2.3.4", ValueFrom: nil},
-			{Name: "SYSLOG_PORT", Value: "80", ValueFrom: nil},
-			{Name: "SYSLOG_PROTOCOL", Value: "tcp", ValueFrom: nil},
+	It("should only render the default buffer tuning of an additional store once its buffer is tuned", func() {
+		cfg.S3Credential = &render.S3Credential{
+			KeyId:     []byte("IdForTheKey"),
+			KeySecret: []byte("SecretForTheKey"),
+		}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			S3: &operatorv1.S3StoreSpec{
+				Region:     "anyplace",
+				BucketName: "thebucket",
+				BucketPath: "bucketpath",
+			},
+		}
+
+		// Untuned buffers keep the env vars they had before buffer tuning existed, so upgrades do not restart fluentd.
+		resources, _ := render.Fluentd(cfg).Objects()
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "S3_FLUSH_INTERVAL", Value: "5s"}))
+		for _, env := range ds.Spec.Template.Spec.Containers[0].Env {
+			Expect(env.Name).NotTo(BeElementOf("S3_CHUNK_LIMIT_SIZE", "S3_TOTAL_LIMIT_SIZE", "S3_OVERFLOW_ACTION"))
+		}
+
+		cfg.LogCollector.Spec.AdditionalStores.S3.Buffer = &operatorv1.FluentdBufferSpec{FlushInterval: &metav1.Duration{Duration: 10 * time.Second}}
+		resources, _ = render.Fluentd(cfg).Objects()
+		ds = rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "S3_FLUSH_INTERVAL", Value: "10s"},
+			corev1.EnvVar{Name: "S3_CHUNK_LIMIT_SIZE", Value: "8388608"},
+			corev1.EnvVar{Name: "S3_TOTAL_LIMIT_SIZE", Value: "536870912"},
+			corev1.EnvVar{Name: "S3_OVERFLOW_ACTION", Value: "throw_exception"},
+		))
+
+		// A default size does not conflict with the size that is set.
+		totalLimit := resource.MustParse("4Mi")
+		cfg.LogCollector.Spec.AdditionalStores.S3.Buffer = &operatorv1.FluentdBufferSpec{TotalLimitSize: &totalLimit}
+		resources, _ = render.Fluentd(cfg).Objects()
+		ds = rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "S3_CHUNK_LIMIT_SIZE", Value: "4194304"},
+			corev1.EnvVar{Name: "S3_TOTAL_LIMIT_SIZE", Value: "4194304"},
+		))
+
+		chunkLimit := resource.MustParse("1Gi")
+		cfg.LogCollector.Spec.AdditionalStores.S3.Buffer = &operatorv1.FluentdBufferSpec{ChunkLimitSize: &chunkLimit}
+		resources, _ = render.Fluentd(cfg).Objects()
+		ds = rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "S3_CHUNK_LIMIT_SIZE", Value: "1073741824"},
+			corev1.EnvVar{Name: "S3_TOTAL_LIMIT_SIZE", Value: "1073741824"},
+		))
+	})
+
+	It("should render with GCS configuration", func() {
+		cfg.GCSCredential = &render.GCSCredential{
+			KeyFile: []byte(`{"type": "service_account"}`),
+		}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			GCS: &operatorv1.GCSStoreSpec{
+				ProjectID:  "theproject",
+				BucketName: "thebucket",
+				BucketPath: "bucketpath",
+			},
+		}
+
+		expectedResources := []client.Object{
+			&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdPolicyName, Namespace: render.LogCollectorNamespace}, TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdMetricsService, Namespace: render.LogCollectorNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "log-collector-gcs-credentials", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"}},
+			&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"}},
+		}
+
+		// Should render the correct resources.
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		rtest.ExpectResources(resources, expectedResources)
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/gcs-credentials"))
+		Expect(ds.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+			Name: "gcs-credentials",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: "log-collector-gcs-credentials"},
+			},
+		}))
+
+		container := ds.Spec.Template.Spec.Containers[0]
+		Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name:      "gcs-credentials",
+			MountPath: "/etc/fluentd/gcs/",
+			ReadOnly:  true,
+		}))
+		Expect(container.Env).To(ContainElements(
+			corev1.EnvVar{Name: "GCS_STORAGE", Value: "true"},
+			corev1.EnvVar{Name: "GCS_PROJECT_ID", Value: "theproject"},
+			corev1.EnvVar{Name: "GCS_BUCKET_NAME", Value: "thebucket"},
+			corev1.EnvVar{Name: "GCS_BUCKET_PATH", Value: "bucketpath"},
+			corev1.EnvVar{Name: "GCS_KEYFILE", Value: "/etc/fluentd/gcs/key.json"},
+			corev1.EnvVar{Name: "GCS_FLUSH_INTERVAL", Value: "5s"},
+			corev1.EnvVar{Name: "FORWARD_CLUSTER_LOGS_TO_GCS", Value: "true"},
+			corev1.EnvVar{Name: "FORWARD_NON_CLUSTER_LOGS_TO_GCS", Value: "true"},
+		))
+	})
+
+	It("should render with Azure Blob configuration", func() {
+		cfg.AzureBlobCredential = &render.AzureBlobCredential{
+			AccessKey: []byte("accesskey"),
+		}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			AzureBlob: &operatorv1.AzureBlobStoreSpec{
+				StorageAccount: "theaccount",
+				Container:      "thecontainer",
+				Path:           "containerpath",
+			},
+		}
+
+		expectedResources := []client.Object{
+			&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdPolicyName, Namespace: render.LogCollectorNamespace}, TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdMetricsService, Namespace: render.LogCollectorNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "log-collector-azure-blob-credentials", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"}},
+			&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"}},
+		}
+
+		// Should render the correct resources.
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		rtest.ExpectResources(resources, expectedResources)
+
+		secret := rtest.GetResource(resources, "log-collector-azure-blob-credentials", "tigera-fluentd", "", "v1", "Secret").(*corev1.Secret)
+		Expect(secret.Data).To(Equal(map[string][]byte{"access-key": []byte("accesskey")}))
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/azure-blob-credentials"))
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "AZURE_BLOB_STORAGE", Value: "true"},
+			corev1.EnvVar{Name: "AZURE_STORAGE_ACCOUNT", Value: "theaccount"},
+			corev1.EnvVar{Name: "AZURE_STORAGE_CONTAINER", Value: "thecontainer"},
+			corev1.EnvVar{Name: "AZURE_STORAGE_PATH", Value: "containerpath"},
+			corev1.EnvVar{Name: "AZURE_FLUSH_INTERVAL", Value: "5s"},
+			corev1.EnvVar{
+				Name: "AZURE_STORAGE_ACCESS_KEY",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "log-collector-azure-blob-credentials"},
+						Key:                  "access-key",
+					},
+				},
+			},
+			corev1.EnvVar{Name: "FORWARD_CLUSTER_LOGS_TO_AZUREBLOB", Value: "true"},
+			corev1.EnvVar{Name: "FORWARD_NON_CLUSTER_LOGS_TO_AZUREBLOB", Value: "true"},
+		))
+	})
+
+	It("should render with an Azure Blob shared access signature", func() {
+		cfg.AzureBlobCredential = &render.AzureBlobCredential{
+			SASToken: []byte("sastoken"),
+		}
+		scope := operatorv1.HostScopeNonClusterOnly
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			AzureBlob: &operatorv1.AzureBlobStoreSpec{
+				StorageAccount: "theaccount",
+				Container:      "thecontainer",
+				HostScope:      &scope,
+			},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		secret := rtest.GetResource(resources, "log-collector-azure-blob-credentials", "tigera-fluentd", "", "v1", "Secret").(*corev1.Secret)
+		Expect(secret.Data).To(Equal(map[string][]byte{"sas-token": []byte("sastoken")}))
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+		Expect(envs).To(ContainElements(
+			corev1.EnvVar{
+				Name: "AZURE_STORAGE_SAS_TOKEN",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "log-collector-azure-blob-credentials"},
+						Key:                  "sas-token",
+					},
+				},
+			},
+			corev1.EnvVar{Name: "FORWARD_CLUSTER_LOGS_TO_AZUREBLOB", Value: "false"},
+			corev1.EnvVar{Name: "FORWARD_NON_CLUSTER_LOGS_TO_AZUREBLOB", Value: "true"},
+		))
+		for _, env := range envs {
+			Expect(env.Name).NotTo(Equal("AZURE_STORAGE_ACCESS_KEY"))
+		}
+	})
+
+	It("should render with Syslog configuration", func() {
+		expectedResources := []client.Object{
+			&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdPolicyName, Namespace: render.LogCollectorNamespace}, TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdMetricsService, Namespace: render.LogCollectorNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"}},
+			&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"}},
+		}
+
+		var ps int32 = 180
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			Syslog: &operatorv1.SyslogStoreSpec{
+				Endpoint:   "tcp://1.2.3.4:80",
+				PacketSize: &ps,
+				LogTypes: []operatorv1.SyslogLogType{
+					operatorv1.SyslogLogDNS,
+					operatorv1.SyslogLogFlows,
+					operatorv1.SyslogLogIDSEvents,
+				},
+			},
+		}
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		rtest.ExpectResources(resources, expectedResources)
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+		Expect(ds.Spec.Template.Spec.Volumes).To(HaveLen(3))
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+
+		expectedEnvs := []struct {
+			name       string
+			val        string
+			secretName string
+			secretKey  string
+		}{
+			{"SYSLOG_HOST", "1.2.3.4", "", ""},
+			{"SYSLOG_PORT", "80", "", ""},
+			{"SYSLOG_PROTOCOL", "tcp", "", ""},
+			{"SYSLOG_FLUSH_INTERVAL", "5s", "", ""},
+			{"SYSLOG_PACKET_SIZE", "180", "", ""},
+			{"SYSLOG_DNS_LOG", "true", "", ""},
+			{"SYSLOG_FLOW_LOG", "true", "", ""},
+			{"SYSLOG_IDS_EVENT_LOG", "true", "", ""},
+		}
+		for _, expected := range expectedEnvs {
+			if expected.val != "" {
+				Expect(envs).To(ContainElement(corev1.EnvVar{Name: expected.name, Value: expected.val}))
+			} else {
+				Expect(envs).To(ContainElement(corev1.EnvVar{
+					Name: expected.name,
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: expected.secretName},
+							Key:                  expected.secretKey,
+						},
+					},
+				}))
+			}
+		}
+		Expect(envs).To(ContainElement(corev1.EnvVar{
+			Name: "SYSLOG_HOSTNAME",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "spec.nodeName",
+				},
+			},
+		}))
+	})
+
+	It("should export the BGP logs to Syslog only when they are collected", func() {
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			Syslog: &operatorv1.SyslogStoreSpec{
+				Endpoint: "tcp://1.2.3.4:80",
+				LogTypes: []operatorv1.SyslogLogType{operatorv1.SyslogLogFlows, operatorv1.SyslogLogBGP},
+			},
+		}
+		resources, _ := render.Fluentd(cfg).Objects()
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+		Expect(envs).To(ContainElement(corev1.EnvVar{Name: "SYSLOG_FLOW_LOG", Value: "true"}))
+		Expect(envs).To(ContainElement(corev1.EnvVar{Name: "SYSLOG_BGP_LOG", Value: "true"}))
+
+		cfg.LogCollector.Spec.Collection = &operatorv1.LogCollection{BGP: ptr.To(false)}
+		resources, _ = render.Fluentd(cfg).Objects()
+		ds = rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		envs = ds.Spec.Template.Spec.Containers[0].Env
+		Expect(envs).To(ContainElement(corev1.EnvVar{Name: "BGP_LOGS_ENABLED", Value: "false"}))
+		Expect(envs).NotTo(ContainElement(HaveField("Name", "SYSLOG_BGP_LOG")))
+	})
+
+	It("should render with Syslog configuration with TLS and user's corporate CA", func() {
+		cfg.UseSyslogCertificate = true
+		var ps int32 = 180
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			Syslog: &operatorv1.SyslogStoreSpec{
+				Endpoint:   "tcp://1.2.3.4:80",
+				Encryption: operatorv1.EncryptionTLS,
+				PacketSize: &ps,
+				LogTypes: []operatorv1.SyslogLogType{
+					operatorv1.SyslogLogDNS,
+					operatorv1.SyslogLogFlows,
+					operatorv1.SyslogLogIDSEvents,
+				},
+			},
+		}
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+		Expect(ds.Spec.Template.Spec.Volumes).To(HaveLen(3))
+
+		var volnames []string
+		for _, vol := range ds.Spec.Template.Spec.Volumes {
+			volnames = append(volnames, vol.Name)
+		}
+		Expect(volnames).To(ContainElement("tigera-ca-bundle"))
+
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+
+		Expect(envs).To(ContainElements([]corev1.EnvVar{
+			{Name: "SYSLOG_HOST", Value: "1.2.3.4", ValueFrom: nil},
+			{Name: "SYSLOG_PORT", Value: "80", ValueFrom: nil},
+			{Name: "SYSLOG_PROTOCOL", Value: "tcp", ValueFrom: nil},
 			{Name: "SYSLOG_FLUSH_INTERVAL", Value: "5s", ValueFrom: nil},
 			{Name: "SYSLOG_PACKET_SIZE", Value: "180", ValueFrom: nil},
 			{Name: "SYSLOG_DNS_LOG", Value: "true", ValueFrom: nil},
@@ -784,105 +1644,511 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 		}))
 	})
 
-	It("should render with Syslog configuration with TLS and Internet CA", func() {
-		cfg.UseSyslogCertificate = false
-		var ps int32 = 180
+	It("should render with Syslog configuration with TLS and Internet CA", func() {
+		cfg.UseSyslogCertificate = false
+		var ps int32 = 180
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			Syslog: &operatorv1.SyslogStoreSpec{
+				Endpoint:   "tcp://1.2.3.4:80",
+				Encryption: operatorv1.EncryptionTLS,
+				PacketSize: &ps,
+				LogTypes: []operatorv1.SyslogLogType{
+					operatorv1.SyslogLogDNS,
+					operatorv1.SyslogLogFlows,
+					operatorv1.SyslogLogIDSEvents,
+				},
+			},
+		}
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+		Expect(ds.Spec.Template.Spec.Volumes).To(HaveLen(3))
+
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+
+		Expect(envs).To(ContainElements([]corev1.EnvVar{
+			{Name: "SYSLOG_HOST", Value: "1.2.3.4", ValueFrom: nil},
+			{Name: "SYSLOG_PORT", Value: "80", ValueFrom: nil},
+			{Name: "SYSLOG_PROTOCOL", Value: "tcp", ValueFrom: nil},
+			{Name: "SYSLOG_FLUSH_INTERVAL", Value: "5s", ValueFrom: nil},
+			{Name: "SYSLOG_PACKET_SIZE", Value: "180", ValueFrom: nil},
+			{Name: "SYSLOG_DNS_LOG", Value: "true", ValueFrom: nil},
+			{Name: "SYSLOG_FLOW_LOG", Value: "true", ValueFrom: nil},
+			{Name: "SYSLOG_IDS_EVENT_LOG", Value: "true", ValueFrom: nil},
+			{Name: "SYSLOG_TLS", Value: "true", ValueFrom: nil},
+			{Name: "SYSLOG_VERIFY_MODE", Value: "1", ValueFrom: nil},
+			{Name: "SYSLOG_CA_FILE", Value: render.SysLogPublicCAPath, ValueFrom: nil},
+		}))
+	})
+
+	It("should render with Syslog configuration with TLS and a client certificate", func() {
+		cfg.SyslogClientCredential = &render.SyslogClientCredential{
+			Cert: []byte("ClientCert"),
+			Key:  []byte("ClientKey"),
+		}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			Syslog: &operatorv1.SyslogStoreSpec{
+				Endpoint:   "tcp://1.2.3.4:80",
+				Encryption: operatorv1.EncryptionTLS,
+				LogTypes: []operatorv1.SyslogLogType{
+					operatorv1.SyslogLogFlows,
+				},
+			},
+		}
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		secret := rtest.GetResource(resources, render.SyslogClientTLSSecretName, render.LogCollectorNamespace, "", "v1", "Secret").(*corev1.Secret)
+		Expect(secret.Type).To(Equal(corev1.SecretTypeTLS))
+		Expect(secret.Data).To(Equal(map[string][]byte{
+			corev1.TLSCertKey:       []byte("ClientCert"),
+			corev1.TLSPrivateKeyKey: []byte("ClientKey"),
+		}))
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/syslog-client-tls"))
+		Expect(ds.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+			Name: "syslog-client-tls",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: render.SyslogClientTLSSecretName},
+			},
+		}))
+
+		container := ds.Spec.Template.Spec.Containers[0]
+		Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name:      "syslog-client-tls",
+			MountPath: "/etc/fluentd/syslog-client-tls/",
+			ReadOnly:  true,
+		}))
+		Expect(container.Env).To(ContainElements([]corev1.EnvVar{
+			{Name: "SYSLOG_TLS", Value: "true"},
+			{Name: "SYSLOG_CLIENT_CERT_FILE", Value: "/etc/fluentd/syslog-client-tls/tls.crt"},
+			{Name: "SYSLOG_CLIENT_KEY_FILE", Value: "/etc/fluentd/syslog-client-tls/tls.key"},
+		}))
+	})
+
+	It("should copy the logs of a tag to every destination that exports them", func() {
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			S3: &operatorv1.S3StoreSpec{
+				Destinations: []operatorv1.S3DestinationSpec{
+					{Name: "primary", Region: "us-east-1", BucketName: "primary", LogTypes: []operatorv1.S3LogType{operatorv1.S3LogFlows}},
+					{Name: "backup", Region: "us-west-2", BucketName: "backup", LogTypes: []operatorv1.S3LogType{operatorv1.S3LogFlows, operatorv1.S3LogDNS}},
+				},
+			},
+		}
+
+		resources, _ := render.Fluentd(cfg).Objects()
+		cm := rtest.GetResource(resources, render.FluentdS3OutputsConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(cm.Data).To(HaveLen(2))
+		Expect(strings.Count(cm.Data["tigera.calico.flows.conf"], "<store ignore_error>\n")).To(Equal(2))
+		Expect(cm.Data["tigera.calico.flows.conf"]).To(ContainSubstring("  s3_bucket primary\n"))
+		Expect(cm.Data["tigera.calico.flows.conf"]).To(ContainSubstring("  s3_bucket backup\n"))
+		Expect(cm.Data["tigera.calico.dns.conf"]).NotTo(ContainSubstring("  s3_bucket primary\n"))
+		Expect(cm.Data["tigera.calico.flows.conf"]).NotTo(ContainSubstring("<match"))
+	})
+
+	It("should render the Syslog destinations as separate outputs", func() {
+		var ps int32 = 2048
+		cfg.SyslogDestinationCAs = map[string]certificatemanagement.CertificateInterface{
+			"audit": certificatemanagement.NewCertificate("audit-syslog-ca", "tigera-operator", []byte("AuditCA"), nil),
+		}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			Syslog: &operatorv1.SyslogStoreSpec{
+				Destinations: []operatorv1.SyslogDestinationSpec{
+					{
+						Name:       "flows",
+						Endpoint:   "udp://1.2.3.4:514",
+						PacketSize: &ps,
+						LogTypes:   []operatorv1.SyslogLogType{operatorv1.SyslogLogFlows, operatorv1.SyslogLogDNS},
+						Encryption: operatorv1.EncryptionNone,
+					},
+					{
+						Name:            "audit",
+						Endpoint:        "tcp://5.6.7.8:6514",
+						LogTypes:        []operatorv1.SyslogLogType{operatorv1.SyslogLogAudit},
+						Encryption:      operatorv1.EncryptionTLS,
+						CAConfigMapName: "audit-syslog-ca",
+					},
+				},
+			},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		cm := rtest.GetResource(resources, render.FluentdSyslogOutputsConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(cm.Data).To(HaveLen(4))
+		Expect(cm.Data["tigera.calico.flows.conf"]).To(Equal(`<store ignore_error>
+  @type remote_syslog
+  host 1.2.3.4
+  port 514
+  protocol udp
+  hostname "#{ENV['NODENAME']}"
+  packet_size 2048
+  <format>
+    @type json
+  </format>
+  <buffer>
+    @type file
+    path /var/log/calico/fluentd/syslog-flows-tigera.calico.flows
+    flush_interval 5s
+  </buffer>
+</store>
+`))
+		Expect(cm.Data["tigera.calico.dns.conf"]).To(Equal(strings.Replace(cm.Data["tigera.calico.flows.conf"], "syslog-flows-tigera.calico.flows", "syslog-flows-tigera.calico.dns", 1)))
+		Expect(cm.Data["tigera.calico.ee_audit.conf"]).To(ContainSubstring("  tls true\n  verify_mode 1\n  ca_file /etc/fluentd/syslog-destination-cas/audit.crt\n"))
+		Expect(cm.Data["tigera.calico.ee_audit.conf"]).To(ContainSubstring("    path /var/log/calico/fluentd/syslog-audit-tigera.calico.ee_audit\n"))
+		Expect(cm.Data["tigera.calico.kube_audit.conf"]).To(ContainSubstring("    path /var/log/calico/fluentd/syslog-audit-tigera.calico.kube_audit\n"))
+
+		cas := rtest.GetResource(resources, render.FluentdSyslogDestinationCAsConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(cas.Data).To(Equal(map[string]string{"audit.crt": "AuditCA"}))
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/fluentd-syslog-outputs"))
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/syslog-destination-cas"))
+		container := ds.Spec.Template.Spec.Containers[0]
+		Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name:      "fluentd-syslog-outputs",
+			MountPath: "/etc/fluentd/syslog-outputs.d/",
+			ReadOnly:  true,
+		}))
+		Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name:      render.FluentdSyslogDestinationCAsConfigMapName,
+			MountPath: "/etc/fluentd/syslog-destination-cas/",
+			ReadOnly:  true,
+		}))
+		Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "FLUENTD_SYSLOG_OUTPUTS_DIR", Value: "/etc/fluentd/syslog-outputs.d/"}))
+		// Without an endpoint of its own, the Syslog store only exports to its destinations.
+		Expect(container.Env).NotTo(ContainElement(HaveField("Name", "SYSLOG_HOST")))
+
+		By("verifying the certificate of a destination without a CA of its own with the public CAs")
+		cfg.SyslogDestinationCAs = nil
+		resources, _ = render.Fluentd(cfg).Objects()
+		cm = rtest.GetResource(resources, render.FluentdSyslogOutputsConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(cm.Data["tigera.calico.ee_audit.conf"]).To(ContainSubstring("  ca_file " + render.SysLogPublicCAPath + "\n"))
+		Expect(rtest.GetResource(resources, render.FluentdSyslogDestinationCAsConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap")).To(BeNil())
+	})
+
+	It("should render with splunk configuration", func() {
+		cfg.SplkCredential = &render.SplunkCredential{
+			Token: []byte("TokenForHEC"),
+		}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			Splunk: &operatorv1.SplunkStoreSpec{
+				Endpoint: "https://1.2.3.4:8088",
+			},
+		}
+
+		expectedResources := []client.Object{
+			&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdPolicyName, Namespace: render.LogCollectorNamespace}, TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdMetricsService, Namespace: render.LogCollectorNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "logcollector-splunk-credentials", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"}},
+			&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"}},
+		}
+
+		// Should render the correct resources.
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		rtest.ExpectResources(resources, expectedResources)
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+		Expect(ds.Spec.Template.Spec.Volumes).To(HaveLen(3))
+
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+
+		expectedEnvs := []struct {
+			name       string
+			val        string
+			secretName string
+			secretKey  string
+		}{
+			{"SPLUNK_FLOW_LOG", "true", "", ""},
+			{"SPLUNK_AUDIT_LOG", "true", "", ""},
+			{"SPLUNK_DNS_LOG", "true", "", ""},
+			{"SPLUNK_HEC_HOST", "1.2.3.4", "", ""},
+			{"SPLUNK_HEC_PORT", "8088", "", ""},
+			{"SPLUNK_PROTOCOL", "https", "", ""},
+			{"SPLUNK_FLUSH_INTERVAL", "5s", "", ""},
+			{"SPLUNK_HEC_TOKEN", "", "logcollector-splunk-credentials", "token"},
+		}
+		for _, expected := range expectedEnvs {
+			if expected.val != "" {
+				Expect(envs).To(ContainElement(corev1.EnvVar{Name: expected.name, Value: expected.val}))
+			} else {
+				Expect(envs).To(ContainElement(corev1.EnvVar{
+					Name: expected.name,
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: expected.secretName},
+							Key:                  expected.secretKey,
+						},
+					},
+				}))
+			}
+		}
+	})
+
+	It("should render the splunk index, source, sourcetype and fields of each log type", func() {
+		cfg.SplkCredential = &render.SplunkCredential{
+			Token: []byte("TokenForHEC"),
+		}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			Splunk: &operatorv1.SplunkStoreSpec{
+				Endpoint:   "https://1.2.3.4:8088",
+				Index:      "calico",
+				SourceType: "calico:log",
+				Fields:     map[string]string{"cluster": "cluster_name", "host": "host"},
+				LogTypes: []operatorv1.SplunkLogTypeSpec{
+					{
+						Type:       operatorv1.SplunkLogFlows,
+						Index:      "calico-flows",
+						SourceType: "calico:flow",
+						Fields:     map[string]string{"host": "source_name"},
+					},
+					{
+						Type:   operatorv1.SplunkLogDNS,
+						Source: "calico-dns",
+					},
+				},
+			},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+		Expect(envs).To(ContainElements(
+			corev1.EnvVar{Name: "SPLUNK_FLOW_INDEX", Value: "calico-flows"},
+			corev1.EnvVar{Name: "SPLUNK_FLOW_SOURCETYPE", Value: "calico:flow"},
+			corev1.EnvVar{Name: "SPLUNK_FLOW_FIELDS", Value: "cluster:cluster_name,host:source_name"},
+			corev1.EnvVar{Name: "SPLUNK_AUDIT_INDEX", Value: "calico"},
+			corev1.EnvVar{Name: "SPLUNK_AUDIT_SOURCETYPE", Value: "calico:log"},
+			corev1.EnvVar{Name: "SPLUNK_AUDIT_FIELDS", Value: "cluster:cluster_name,host:host"},
+			corev1.EnvVar{Name: "SPLUNK_DNS_INDEX", Value: "calico"},
+			corev1.EnvVar{Name: "SPLUNK_DNS_SOURCE", Value: "calico-dns"},
+			corev1.EnvVar{Name: "SPLUNK_DNS_SOURCETYPE", Value: "calico:log"},
+		))
+		for _, env := range envs {
+			Expect(env.Name).NotTo(BeElementOf("SPLUNK_FLOW_SOURCE", "SPLUNK_AUDIT_SOURCE"))
+		}
+	})
+
+	It("should render with Loki configuration", func() {
+		cfg.LokiCredential = &render.LokiCredential{
+			Username: []byte("user"),
+			Password: []byte("pass"),
+		}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			Loki: &operatorv1.LokiStoreSpec{
+				URL:      "https://loki.example.com:3100",
+				TenantID: "tenant-a",
+			},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		secret := rtest.GetResource(resources, "logcollector-loki-credentials", "tigera-fluentd", "", "v1", "Secret").(*corev1.Secret)
+		Expect(secret.Data).To(Equal(map[string][]byte{"username": []byte("user"), "password": []byte("pass")}))
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/loki-credentials"))
+
+		secretRef := func(name, key string) corev1.EnvVar {
+			return corev1.EnvVar{
+				Name: name,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "logcollector-loki-credentials"},
+						Key:                  key,
+					},
+				},
+			}
+		}
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+		Expect(envs).To(ContainElements(
+			corev1.EnvVar{Name: "LOKI_URL", Value: "https://loki.example.com:3100"},
+			corev1.EnvVar{Name: "LOKI_TENANT_ID", Value: "tenant-a"},
+			corev1.EnvVar{Name: "LOKI_FLOW_LOG", Value: "true"},
+			corev1.EnvVar{Name: "LOKI_AUDIT_LOG", Value: "true"},
+			corev1.EnvVar{Name: "LOKI_DNS_LOG", Value: "true"},
+			corev1.EnvVar{Name: "LOKI_CA_FILE", Value: cfg.TrustedBundle.MountPath()},
+			corev1.EnvVar{Name: "LOKI_FLUSH_INTERVAL", Value: "5s"},
+			corev1.EnvVar{Name: "FORWARD_CLUSTER_LOGS_TO_LOKI", Value: "true"},
+			corev1.EnvVar{Name: "FORWARD_NON_CLUSTER_LOGS_TO_LOKI", Value: "true"},
+			secretRef("LOKI_USERNAME", "username"),
+			secretRef("LOKI_PASSWORD", "password"),
+		))
+		Expect(envs).NotTo(ContainElement(secretRef("LOKI_BEARER_TOKEN", "token")))
+	})
+
+	It("should render with Loki configuration using a bearer token", func() {
+		cfg.LokiCredential = &render.LokiCredential{Token: []byte("token")}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			Loki: &operatorv1.LokiStoreSpec{URL: "https://loki.example.com:3100"},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		secret := rtest.GetResource(resources, "logcollector-loki-credentials", "tigera-fluentd", "", "v1", "Secret").(*corev1.Secret)
+		Expect(secret.Data).To(Equal(map[string][]byte{"token": []byte("token")}))
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+		Expect(envs).To(ContainElement(corev1.EnvVar{
+			Name: "LOKI_BEARER_TOKEN",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "logcollector-loki-credentials"},
+					Key:                  "token",
+				},
+			},
+		}))
+		for _, e := range envs {
+			Expect(e.Name).NotTo(BeElementOf("LOKI_USERNAME", "LOKI_PASSWORD", "LOKI_TENANT_ID"))
+		}
+	})
+
+	It("should render with Amazon Security Lake configuration using an IAM role for the service account", func() {
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			SecurityLake: &operatorv1.SecurityLakeStoreSpec{
+				Region:     "us-east-1",
+				BucketName: "aws-security-data-lake-us-east-1-abc",
+				SourceName: "calico",
+				AccountID:  "123456789012",
+				RoleARN:    "arn:aws:iam::123456789012:role/calico-security-lake",
+				LogTypes:   []operatorv1.SecurityLakeLogType{operatorv1.SecurityLakeLogFlows},
+			},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		sa := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "", "v1", "ServiceAccount").(*corev1.ServiceAccount)
+		Expect(sa.Annotations).To(Equal(map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/calico-security-lake"}))
+		Expect(rtest.GetResource(resources, "log-collector-s3-credentials", "tigera-fluentd", "", "v1", "Secret")).To(BeNil())
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		envs := ds.Spec.Template.Spec.Containers[0].Env
+		Expect(envs).To(ContainElements(
+			corev1.EnvVar{Name: "SECURITY_LAKE_STORAGE", Value: "true"},
+			corev1.EnvVar{Name: "SECURITY_LAKE_REGION", Value: "us-east-1"},
+			corev1.EnvVar{Name: "SECURITY_LAKE_BUCKET_NAME", Value: "aws-security-data-lake-us-east-1-abc"},
+			corev1.EnvVar{Name: "SECURITY_LAKE_BUCKET_PATH", Value: "ext/calico/region=us-east-1/accountId=123456789012/eventDay=%Y%m%d/"},
+			corev1.EnvVar{Name: "SECURITY_LAKE_FLUSH_INTERVAL", Value: "5s"},
+			corev1.EnvVar{Name: "SECURITY_LAKE_FLOW_LOG", Value: "true"},
+			corev1.EnvVar{Name: "FORWARD_CLUSTER_LOGS_TO_SECURITYLAKE", Value: "true"},
+			corev1.EnvVar{Name: "FORWARD_NON_CLUSTER_LOGS_TO_SECURITYLAKE", Value: "true"},
+		))
+		for _, env := range envs {
+			Expect(env.Name).NotTo(BeElementOf("SECURITY_LAKE_AUDIT_LOG", "AWS_KEY_ID", "AWS_SECRET_KEY"))
+		}
+	})
+
+	It("should render with Amazon Security Lake configuration using static credentials", func() {
+		cfg.S3Credential = &render.S3Credential{
+			KeyId:     []byte("IdForTheKey"),
+			KeySecret: []byte("SecretForTheKey"),
+		}
+		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
+			SecurityLake: &operatorv1.SecurityLakeStoreSpec{
+				Region:     "us-east-1",
+				BucketName: "aws-security-data-lake-us-east-1-abc",
+				SourceName: "calico",
+				AccountID:  "123456789012",
+			},
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		sa := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "", "v1", "ServiceAccount").(*corev1.ServiceAccount)
+		Expect(sa.Annotations).To(BeEmpty())
+		Expect(rtest.GetResource(resources, "log-collector-s3-credentials", "tigera-fluentd", "", "v1", "Secret")).NotTo(BeNil())
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "SECURITY_LAKE_FLOW_LOG", Value: "true"},
+			corev1.EnvVar{Name: "SECURITY_LAKE_AUDIT_LOG", Value: "true"},
+			corev1.EnvVar{
+				Name: "AWS_KEY_ID",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "log-collector-s3-credentials"},
+						Key:                  "key-id",
+					},
+				},
+			},
+		))
+	})
+
+	It("should render with OTLP configuration", func() {
+		cfg.OTLPHeaders = &render.OTLPHeaders{Headers: map[string][]byte{"Authorization": []byte("Bearer token")}}
 		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
-			Syslog: &operatorv1.SyslogStoreSpec{
-				Endpoint:   "tcp://1.2.3.4:80",
-				Encryption: operatorv1.EncryptionTLS,
-				PacketSize: &ps,
-				LogTypes: []operatorv1.SyslogLogType{
-					operatorv1.SyslogLogDNS,
-					operatorv1.SyslogLogFlows,
-					operatorv1.SyslogLogIDSEvents,
-				},
+			OTLP: &operatorv1.OTLPStoreSpec{
+				Endpoint: "https://otel-collector.example.com:4318",
+				Protocol: operatorv1.OTLPProtocolHTTP,
 			},
 		}
+
 		component := render.Fluentd(cfg)
 		resources, _ := component.Objects()
 
+		secret := rtest.GetResource(resources, "logcollector-otlp-headers", "tigera-fluentd", "", "v1", "Secret").(*corev1.Secret)
+		Expect(secret.Data).To(Equal(map[string][]byte{"Authorization": []byte("Bearer token")}))
+
 		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
-		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
-		Expect(ds.Spec.Template.Spec.Volumes).To(HaveLen(3))
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/otlp-headers"))
+		Expect(ds.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+			Name: "otlp-headers",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: "logcollector-otlp-headers"},
+			},
+		}))
+		Expect(ds.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name:      "otlp-headers",
+			MountPath: "/etc/fluentd/otlp-headers/",
+			ReadOnly:  true,
+		}))
 
 		envs := ds.Spec.Template.Spec.Containers[0].Env
-
-		Expect(envs).To(ContainElements([]corev1.EnvVar{
-			{Name: "SYSLOG_HOST", Value: "1.2.3.4", ValueFrom: nil},
-			{Name: "SYSLOG_PORT", Value: "80", ValueFrom: nil},
-			{Name: "SYSLOG_PROTOCOL", Value: "tcp", ValueFrom: nil},
-			{Name: "SYSLOG_FLUSH_INTERVAL", Value: "5s", ValueFrom: nil},
-			{Name: "SYSLOG_PACKET_SIZE", Value: "180", ValueFrom: nil},
-			{Name: "SYSLOG_DNS_LOG", Value: "true", ValueFrom: nil},
-			{Name: "SYSLOG_FLOW_LOG", Value: "true", ValueFrom: nil},
-			{Name: "SYSLOG_IDS_EVENT_LOG", Value: "true", ValueFrom: nil},
-			{Name: "SYSLOG_TLS", Value: "true", ValueFrom: nil},
-			{Name: "SYSLOG_VERIFY_MODE", Value: "1", ValueFrom: nil},
-			{Name: "SYSLOG_CA_FILE", Value: render.SysLogPublicCAPath, ValueFrom: nil},
-		}))
+		Expect(envs).To(ContainElements(
+			corev1.EnvVar{Name: "OTLP_ENDPOINT", Value: "https://otel-collector.example.com:4318"},
+			corev1.EnvVar{Name: "OTLP_PROTOCOL", Value: "http"},
+			corev1.EnvVar{Name: "OTLP_FLOW_LOG", Value: "true"},
+			corev1.EnvVar{Name: "OTLP_AUDIT_LOG", Value: "true"},
+			corev1.EnvVar{Name: "OTLP_DNS_LOG", Value: "true"},
+			corev1.EnvVar{Name: "OTLP_CA_FILE", Value: cfg.TrustedBundle.MountPath()},
+			corev1.EnvVar{Name: "OTLP_FLUSH_INTERVAL", Value: "5s"},
+			corev1.EnvVar{Name: "OTLP_HEADERS_DIR", Value: "/etc/fluentd/otlp-headers/"},
+			corev1.EnvVar{Name: "FORWARD_CLUSTER_LOGS_TO_OTLP", Value: "true"},
+			corev1.EnvVar{Name: "FORWARD_NON_CLUSTER_LOGS_TO_OTLP", Value: "true"},
+		))
 	})
 
-	It("should render with splunk configuration", func() {
-		cfg.SplkCredential = &render.SplunkCredential{
-			Token: []byte("TokenForHEC"),
-		}
+	It("should render with OTLP configuration using the default protocol and no headers", func() {
 		cfg.LogCollector.Spec.AdditionalStores = &operatorv1.AdditionalLogStoreSpec{
-			Splunk: &operatorv1.SplunkStoreSpec{
-				Endpoint: "https://1.2.3.4:8088",
-			},
-		}
-
-		expectedResources := []client.Object{
-			&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdPolicyName, Namespace: render.LogCollectorNamespace}, TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"}},
-			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.FluentdMetricsService, Namespace: render.LogCollectorNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
-			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "logcollector-splunk-credentials", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}},
-			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"}},
-			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
-			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"}},
-			&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "fluentd-node", Namespace: "tigera-fluentd"}, TypeMeta: metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"}},
+			OTLP: &operatorv1.OTLPStoreSpec{Endpoint: "https://otel-collector.example.com:4317"},
 		}
 
-		// Should render the correct resources.
 		component := render.Fluentd(cfg)
 		resources, _ := component.Objects()
-		rtest.ExpectResources(resources, expectedResources)
 
-		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
-		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
-		Expect(ds.Spec.Template.Spec.Volumes).To(HaveLen(3))
+		Expect(rtest.GetResource(resources, "logcollector-otlp-headers", "tigera-fluentd", "", "v1", "Secret")).To(BeNil())
 
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Annotations).NotTo(HaveKey("hash.operator.tigera.io/otlp-headers"))
 		envs := ds.Spec.Template.Spec.Containers[0].Env
-
-		expectedEnvs := []struct {
-			name       string
-			val        string
-			secretName string
-			secretKey  string
-		}{
-			{"SPLUNK_FLOW_LOG", "true", "", ""},
-			{"SPLUNK_AUDIT_LOG", "true", "", ""},
-			{"SPLUNK_DNS_LOG", "true", "", ""},
-			{"SPLUNK_HEC_HOST", "1.2.3.4", "", ""},
-			{"SPLUNK_HEC_PORT", "8088", "", ""},
-			{"SPLUNK_PROTOCOL", "https", "", ""},
-			{"SPLUNK_FLUSH_INTERVAL", "5s", "", ""},
-			{"SPLUNK_HEC_TOKEN", "", "logcollector-splunk-credentials", "token"},
-		}
-		for _, expected := range expectedEnvs {
-			if expected.val != "" {
-				Expect(envs).To(ContainElement(corev1.EnvVar{Name: expected.name, Value: expected.val}))
-			} else {
-				Expect(envs).To(ContainElement(corev1.EnvVar{
-					Name: expected.name,
-					ValueFrom: &corev1.EnvVarSource{
-						SecretKeyRef: &corev1.SecretKeySelector{
-							LocalObjectReference: corev1.LocalObjectReference{Name: expected.secretName},
-							Key:                  expected.secretKey,
-						},
-					},
-				}))
-			}
+		Expect(envs).To(ContainElement(corev1.EnvVar{Name: "OTLP_PROTOCOL", Value: "grpc"}))
+		for _, e := range envs {
+			Expect(e.Name).NotTo(Equal("OTLP_HEADERS_DIR"))
 		}
 	})
 
@@ -915,6 +2181,198 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 		Expect(envs).ToNot(ContainElement(corev1.EnvVar{Name: "FLUENTD_DNS_FILTERS", Value: "true"}))
 	})
 
+	It("should render the LogCollector filters as flow log filters", func() {
+		cfg.Filters = &render.FluentdFilters{Flow: "flow-filter\n", DNS: "dns-filter"}
+		cfg.LogCollector.Spec.Filters = &operatorv1.LogCollectorFilters{
+			ExcludedNamespaces: []string{"ci", "batch"},
+			ExcludedPodSelectors: []metav1.LabelSelector{{
+				MatchLabels: map[string]string{"example.com/tier": "batch"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"loadgen", "soak"}},
+					{Key: "noisy", Operator: metav1.LabelSelectorOpExists},
+				},
+			}},
+		}
+
+		resources, _ := render.Fluentd(cfg).Objects()
+		cm := rtest.GetResource(resources, "fluentd-filters", "tigera-fluentd", "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(cm.Data["dns"]).To(Equal("dns-filter"))
+		Expect(cm.Data["flow"]).To(Equal(`flow-filter
+<filter flows>
+  @type grep
+  <exclude>
+    key source_namespace
+    pattern /^(ci|batch)$/
+  </exclude>
+  <exclude>
+    key dest_namespace
+    pattern /^(ci|batch)$/
+  </exclude>
+</filter>
+<filter flows>
+  @type grep
+  <and>
+    <exclude>
+      key $.source_labels.labels
+      pattern /"example\.com\/tier=batch"/
+    </exclude>
+    <exclude>
+      key $.source_labels.labels
+      pattern /"app=(loadgen|soak)"/
+    </exclude>
+    <exclude>
+      key $.source_labels.labels
+      pattern /"noisy=/
+    </exclude>
+  </and>
+</filter>
+<filter flows>
+  @type grep
+  <and>
+    <exclude>
+      key $.dest_labels.labels
+      pattern /"example\.com\/tier=batch"/
+    </exclude>
+    <exclude>
+      key $.dest_labels.labels
+      pattern /"app=(loadgen|soak)"/
+    </exclude>
+    <exclude>
+      key $.dest_labels.labels
+      pattern /"noisy=/
+    </exclude>
+  </and>
+</filter>
+`))
+		Expect(cfg.Filters.Flow).To(Equal("flow-filter\n"))
+
+		By("rendering the filters without a fluentd-filters ConfigMap")
+		cfg.Filters = nil
+		resources, _ = render.Fluentd(cfg).Objects()
+		cm = rtest.GetResource(resources, "fluentd-filters", "tigera-fluentd", "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(cm.Data["flow"]).To(HavePrefix("<filter flows>"))
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "FLUENTD_FLOW_FILTERS", Value: "true"}))
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).NotTo(ContainElement(corev1.EnvVar{Name: "FLUENTD_DNS_FILTERS", Value: "true"}))
+	})
+
+	It("should render with additional outputs", func() {
+		cfg.AdditionalOutputs = map[string]string{
+			"kafka.conf": "<match tigera.calico.flows>\n  @type kafka2\n</match>",
+		}
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		By("wrapping the outputs in a label so that they do not consume the logs of the operator managed outputs")
+		cm := rtest.GetResource(resources, render.FluentdAdditionalOutputsConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(cm.Data).To(Equal(map[string]string{
+			"kafka.conf": "<label @ADDITIONAL_OUTPUTS>\n<match tigera.calico.flows>\n  @type kafka2\n</match>\n</label>\n",
+		}))
+
+		By("copying the logs of every tag to the label")
+		copyCM := rtest.GetResource(resources, render.FluentdAdditionalOutputsCopyConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(copyCM.Data).To(HaveKey("tigera.calico.flows.conf"))
+		Expect(copyCM.Data).To(HaveKey("tigera.calico.kube_audit.conf"))
+		Expect(copyCM.Data["tigera.calico.dns.conf"]).To(Equal("<store ignore_error>\n  @type relabel\n  @label @ADDITIONAL_OUTPUTS\n</store>\n"))
+
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/fluentd-additional-outputs"))
+		Expect(ds.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+			Name: "fluentd-additional-outputs",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: render.FluentdAdditionalOutputsConfigMapName},
+				},
+			},
+		}))
+		container := ds.Spec.Template.Spec.Containers[0]
+		Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+			Name:      "fluentd-additional-outputs",
+			MountPath: "/etc/fluentd/outputs.d/",
+			ReadOnly:  true,
+		}))
+		Expect(container.Env).To(ContainElements(
+			corev1.EnvVar{Name: "FLUENTD_ADDITIONAL_OUTPUTS_DIR", Value: "/etc/fluentd/outputs.d/"},
+			corev1.EnvVar{Name: "FLUENTD_ADDITIONAL_OUTPUTS_COPY_DIR", Value: "/etc/fluentd/additional-outputs-copy.d/"},
+		))
+	})
+
+	It("should render the proxy configuration on fluentd and the EKS log forwarder", func() {
+		cfg.EKSConfig = setupEKSCloudwatchLogConfig()
+		cfg.ESClusterConfig = relasticsearch.NewClusterConfig("clusterTestName", 1, 1, 1)
+		cfg.Installation = &operatorv1.InstallationSpec{
+			KubernetesProvider: operatorv1.ProviderEKS,
+			Proxy: &operatorv1.Proxy{
+				HTTPProxy:  "http://proxy.example.com:3128",
+				HTTPSProxy: "http://proxy.example.com:3128",
+				NoProxy:    ".svc",
+			},
+		}
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		proxyEnvs := []corev1.EnvVar{
+			{Name: "HTTP_PROXY", Value: "http://proxy.example.com:3128"},
+			{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+			{Name: "NO_PROXY", Value: ".svc"},
+		}
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(proxyEnvs))
+		deploy := rtest.GetResource(resources, "eks-log-forwarder", "tigera-fluentd", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(deploy.Spec.Template.Spec.Containers[0].Env).To(ContainElements(proxyEnvs))
+	})
+
+	It("should render the EKS Cloudwatch Log forwarder with IAM roles for service accounts", func() {
+		cfg.EKSConfig = setupEKSCloudwatchLogConfig()
+		cfg.EKSConfig.AwsId, cfg.EKSConfig.AwsKey = nil, nil
+		cfg.EKSConfig.RoleARN = "arn:aws:iam::123456789012:role/eks-log-forwarder"
+		cfg.EKSConfig.ServiceAccountAnnotations = map[string]string{"eks.amazonaws.com/sts-regional-endpoints": "true"}
+		cfg.ESClusterConfig = relasticsearch.NewClusterConfig("clusterTestName", 1, 1, 1)
+		cfg.Installation = &operatorv1.InstallationSpec{KubernetesProvider: operatorv1.ProviderEKS}
+		component := render.Fluentd(cfg)
+		resources, toDelete := component.Objects()
+
+		sa := rtest.GetResource(resources, "eks-log-forwarder", "tigera-fluentd", "", "v1", "ServiceAccount").(*corev1.ServiceAccount)
+		Expect(sa.Annotations).To(Equal(map[string]string{
+			"eks.amazonaws.com/role-arn":               "arn:aws:iam::123456789012:role/eks-log-forwarder",
+			"eks.amazonaws.com/sts-regional-endpoints": "true",
+		}))
+		Expect(rtest.GetResource(resources, render.EksLogForwarderSecret, "tigera-fluentd", "", "v1", "Secret")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, render.EksLogForwarderSecret, "tigera-fluentd", "", "v1", "Secret")).NotTo(BeNil())
+
+		deploy := rtest.GetResource(resources, "eks-log-forwarder", "tigera-fluentd", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		for _, env := range deploy.Spec.Template.Spec.Containers[0].Env {
+			Expect(env.Name).NotTo(BeElementOf("AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY"))
+		}
+		Expect(deploy.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "AWS_REGION", Value: "us-west-1"}))
+	})
+
+	It("should render the EKS Cloudwatch Log forwarder with the configured scheduling and resources", func() {
+		cfg.EKSConfig = setupEKSCloudwatchLogConfig()
+		infra := corev1.Toleration{Key: "node-role.kubernetes.io/infra", Operator: corev1.TolerationOpExists}
+		resourceRequirements := &corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+		}
+		cfg.EKSConfig.NodeSelector = map[string]string{"node-role.kubernetes.io/infra": ""}
+		cfg.EKSConfig.Tolerations = []corev1.Toleration{infra}
+		cfg.EKSConfig.Resources = resourceRequirements
+		cfg.EKSConfig.Replicas = ptr.To(int32(0))
+		cfg.ESClusterConfig = relasticsearch.NewClusterConfig("clusterTestName", 1, 1, 1)
+		cfg.Installation = &operatorv1.InstallationSpec{
+			KubernetesProvider:      operatorv1.ProviderEKS,
+			ControlPlaneTolerations: []corev1.Toleration{{Key: "foo", Operator: corev1.TolerationOpExists}},
+		}
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+
+		deploy := rtest.GetResource(resources, "eks-log-forwarder", "tigera-fluentd", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(*deploy.Spec.Replicas).To(Equal(int32(0)))
+		Expect(deploy.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"node-role.kubernetes.io/infra": ""}))
+		Expect(deploy.Spec.Template.Spec.Tolerations).To(ConsistOf(infra))
+		Expect(deploy.Spec.Template.Spec.Containers[0].Resources).To(Equal(*resourceRequirements))
+	})
+
 	It("should render with EKS Cloudwatch Log", func() {
 		expectedResources := getExpectedResourcesForEKS(false)
 		cfg.EKSConfig = setupEKSCloudwatchLogConfig()
@@ -1021,6 +2479,21 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 		Expect(envs).To(Equal(expectedEnvVars))
 	})
 
+	It("should not render the EKS Cloudwatch Log forwarder on Windows", func() {
+		cfg.EKSConfig = setupEKSCloudwatchLogConfig()
+		cfg.OSType = rmeta.OSTypeWindows
+		cfg.Installation.KubernetesProvider = operatorv1.ProviderEKS
+		component := render.Fluentd(cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, toDelete := component.Objects()
+
+		// The Linux forwarder is the only one reading the CloudWatch log group.
+		Expect(rtest.GetResource(resources, "eks-log-forwarder", "tigera-fluentd", "", "v1", "ServiceAccount")).To(BeNil())
+		Expect(rtest.GetResource(resources, "eks-log-forwarder", "tigera-fluentd", "apps", "v1", "Deployment")).To(BeNil())
+		Expect(rtest.GetResource(resources, "eks-log-forwarder-windows", "tigera-fluentd", "apps", "v1", "Deployment")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, "eks-log-forwarder-windows", "tigera-fluentd", "apps", "v1", "Deployment")).NotTo(BeNil())
+	})
+
 	It("should render EKS Cloudwatch Log toleration on GKE", func() {
 		cfg.EKSConfig = setupEKSCloudwatchLogConfig()
 		cfg.ESClusterConfig = relasticsearch.NewClusterConfig("clusterTestName", 1, 1, 1)
@@ -1126,6 +2599,32 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 		Expect(envs).To(ContainElement(corev1.EnvVar{Name: "LINSEED_TOKEN", Value: "/var/run/secrets/kubernetes.io/serviceaccount/token"}))
 	})
 
+	It("should send logs to the tenant's Linseed endpoint in multi-tenant management clusters", func() {
+		tenant := &operatorv1.Tenant{}
+		tenant.Name = "default"
+		tenant.Namespace = "tenant-namespace"
+		tenant.Spec.ID = "test-tenant-id"
+		cfg.Tenant = tenant
+
+		component := render.Fluentd(cfg)
+		resources, _ := component.Objects()
+		ds := rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "LINSEED_ENDPOINT", Value: "https://tigera-linseed.tenant-namespace.svc"}))
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "TENANT_ID", Value: "test-tenant-id"}))
+
+		// Egress to the tenant's Linseed is limited to its port.
+		policy := testutils.GetCalicoSystemPolicyFromResources(types.NamespacedName{Name: render.FluentdPolicyName, Namespace: render.LogCollectorNamespace}, resources)
+		Expect(policy).NotTo(BeNil())
+		Expect(policy.Spec.Egress).To(ContainElement(HaveField("Destination.NamespaceSelector", "projectcalico.org/name == 'tenant-namespace'")))
+
+		tenant.Spec.LogCollector = &operatorv1.TenantLogCollectorSpec{LinseedEndpoint: "https://linseed.tenant.example.com"}
+		component = render.Fluentd(cfg)
+		resources, _ = component.Objects()
+		ds = rtest.GetResource(resources, "fluentd-node", "tigera-fluentd", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "LINSEED_ENDPOINT", Value: "https://linseed.tenant.example.com"}))
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "TENANT_ID", Value: "test-tenant-id"}))
+	})
+
 	It("should render with EKS Cloudwatch Log for managed cluster with linseed token volume", func() {
 		expectedResources := getExpectedResourcesForEKS(true)
 
@@ -1315,6 +2814,109 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 				},
 			}))
 		})
+
+		It("should render the non-cluster-host access policy only when source CIDRs are configured", func() {
+			nonClusterHostPolicyName := types.NamespacedName{Name: render.FluentdNonClusterHostNetworkPolicyName, Namespace: render.LogCollectorNamespace}
+			cfg.NonClusterHost = &operatorv1.NonClusterHost{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tigera-secure",
+				},
+				Spec: operatorv1.NonClusterHostSpec{
+					Endpoint: "https://1.2.3.4:5678",
+				},
+			}
+			resources, toDelete := render.Fluentd(cfg).Objects()
+			Expect(testutils.GetCalicoSystemPolicyFromResources(nonClusterHostPolicyName, resources)).To(BeNil())
+			Expect(testutils.GetCalicoSystemPolicyFromResources(nonClusterHostPolicyName, toDelete)).NotTo(BeNil())
+
+			cfg.NonClusterHost.Spec.SourceCIDRs = []string{"10.10.0.0/16", "fd00::/64"}
+			resources, _ = render.Fluentd(cfg).Objects()
+			policy := testutils.GetCalicoSystemPolicyFromResources(nonClusterHostPolicyName, resources)
+			Expect(policy).NotTo(BeNil())
+			Expect(policy.Spec.Tier).To(Equal(networkpolicy.CalicoTierName))
+			Expect(policy.Spec.Selector).To(Equal(networkpolicy.KubernetesAppSelector(render.FluentdNodeName)))
+			Expect(policy.Spec.Types).To(Equal([]v3.PolicyType{v3.PolicyTypeIngress}))
+			Expect(policy.Spec.Egress).To(BeEmpty())
+			Expect(policy.Spec.Ingress).To(Equal([]v3.Rule{{
+				Action:   v3.Allow,
+				Protocol: &networkpolicy.TCPProtocol,
+				Source:   v3.EntityRule{Nets: []string{"10.10.0.0/16", "fd00::/64"}},
+				Destination: v3.EntityRule{
+					Ports: networkpolicy.Ports(render.FluentdInputPort),
+				},
+			}}))
+		})
+
+		It("should serve the non-cluster-host input with its own key pair and require client certificates when configured", func() {
+			certificateManager, err := certificatemanager.Create(cli, nil, clusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+			Expect(err).NotTo(HaveOccurred())
+			cfg.InputKeyPair, err = certificateManager.GetOrCreateKeyPair(cli, render.FluentdInputTLSSecretName, common.OperatorNamespace(), []string{render.FluentdInputService})
+			Expect(err).NotTo(HaveOccurred())
+			cfg.NonClusterHost = &operatorv1.NonClusterHost{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+				Spec:       operatorv1.NonClusterHostSpec{Endpoint: "https://1.2.3.4:5678"},
+			}
+
+			resources, _ := render.Fluentd(cfg).Objects()
+			ds := rtest.GetResource(resources, "fluentd-node", render.LogCollectorNamespace, "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+			container := ds.Spec.Template.Spec.Containers[0]
+			Expect(ds.Spec.Template.Annotations).To(HaveKey("tigera-operator.hash.operator.tigera.io/tigera-fluentd-http-input-tls"))
+			Expect(ds.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", render.FluentdInputTLSSecretName)))
+			Expect(container.VolumeMounts).To(ContainElement(HaveField("Name", render.FluentdInputTLSSecretName)))
+			Expect(container.Env).To(ContainElements(
+				corev1.EnvVar{Name: "INPUT_TLS_KEY_PATH", Value: "/tigera-fluentd-http-input-tls/tls.key"},
+				corev1.EnvVar{Name: "INPUT_TLS_CRT_PATH", Value: "/tigera-fluentd-http-input-tls/tls.crt"},
+			))
+			Expect(container.Env).NotTo(ContainElement(HaveField("Name", "INPUT_TLS_CLIENT_AUTH")))
+
+			Expect(rtest.GetResource(resources, render.FluentdClientCAConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap")).To(BeNil())
+
+			clientCertificate := operatorv1.LogInputClientAuthClientCertificate
+			cfg.NonClusterHost.Spec.LogInputClientAuth = &clientCertificate
+			cfg.ClientCA = certificateManager.KeyPair()
+			resources, _ = render.Fluentd(cfg).Objects()
+			ds = rtest.GetResource(resources, "fluentd-node", render.LogCollectorNamespace, "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+			Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+				corev1.EnvVar{Name: "INPUT_TLS_CLIENT_AUTH", Value: "true"},
+				corev1.EnvVar{Name: "INPUT_TLS_CA_PATH", Value: "/etc/fluentd/client-ca/ca.crt"},
+			))
+			Expect(ds.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/fluentd-client-ca"))
+			Expect(ds.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      render.FluentdClientCAConfigMapName,
+				MountPath: "/etc/fluentd/client-ca/",
+				ReadOnly:  true,
+			}))
+			// Only the operator CA is trusted, not the system root certificates of the trusted bundle.
+			clientCA := rtest.GetResource(resources, render.FluentdClientCAConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+			Expect(clientCA.Data).To(Equal(map[string]string{"ca.crt": string(certificateManager.KeyPair().GetCertificatePEM())}))
+
+			// The Windows fluentd pods are not selected by the input service.
+			cfg.OSType = rmeta.OSTypeWindows
+			resources, _ = render.Fluentd(cfg).Objects()
+			ds = rtest.GetResource(resources, "fluentd-node-windows", render.LogCollectorNamespace, "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+			Expect(ds.Spec.Template.Spec.Containers[0].Env).NotTo(ContainElement(HaveField("Name", "INPUT_TLS_KEY_PATH")))
+		})
+	})
+
+	It("should render the FIPS image when FIPS mode is enabled", func() {
+		cfg.Installation.FIPSMode = ptr.To(operatorv1.FIPSModeEnabled)
+		component := render.Fluentd(cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		ds := rtest.GetResource(resources, "fluentd-node", render.LogCollectorNamespace, "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Image).To(Equal(
+			fmt.Sprintf("%s%s%s:%s", components.TigeraRegistry, components.TigeraImagePath, components.ComponentFluentdFIPS.Image, components.ComponentFluentdFIPS.Version),
+		))
+	})
+
+	It("should reject FIPS mode for fluentd on Windows", func() {
+		cfg.Installation.FIPSMode = ptr.To(operatorv1.FIPSModeEnabled)
+		cfg.OSType = rmeta.OSTypeWindows
+		component := render.Fluentd(cfg)
+		err := component.ResolveImages(nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("no FIPS image is available for fluentd-windows"))
 	})
 
 	It("should move DaemonSet to toDelete when LicenseExpired is true", func() {
@@ -1356,6 +2958,188 @@ var _ = Describe("Tigera Secure Fluentd rendering tests", func() {
 		}
 		Expect(found).To(BeTrue(), "Expected fluentd-node DaemonSet to be in toCreate")
 	})
+
+	It("should render an audit-only Deployment instead of the DaemonSet for the AuditDeployment collector type", func() {
+		cfg.LogCollector.Spec.CollectorType = ptr.To(operatorv1.LogCollectorTypeAuditDeployment)
+		cfg.Installation.ControlPlaneReplicas = ptr.To(int32(2))
+		cfg.Installation.ControlPlaneNodeSelector = map[string]string{"role": "control-plane"}
+		component := render.Fluentd(cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		toCreate, toDelete := component.Objects()
+
+		Expect(rtest.GetResource(toCreate, "fluentd-node", render.LogCollectorNamespace, "apps", "v1", "DaemonSet")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, "fluentd-node", render.LogCollectorNamespace, "apps", "v1", "DaemonSet")).NotTo(BeNil())
+
+		d := rtest.GetResource(toCreate, render.FluentdAuditName, render.LogCollectorNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(*d.Spec.Replicas).To(Equal(int32(2)))
+		labels := map[string]string{"k8s-app": render.FluentdNodeName, "app.kubernetes.io/name": render.FluentdAuditName}
+		Expect(d.Spec.Selector.MatchLabels).To(Equal(labels))
+		Expect(d.Spec.Template.Labels).To(Equal(labels))
+		Expect(d.Spec.Template.Spec.ServiceAccountName).To(Equal(render.FluentdNodeName))
+		Expect(d.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"role": "control-plane"}))
+		Expect(d.Spec.Template.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(ConsistOf(corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"apiserver": "true"}},
+			Namespaces:    []string{render.APIServerNamespace},
+			TopologyKey:   corev1.LabelHostname,
+		}))
+		Expect(d.Spec.Template.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(ConsistOf(corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": render.FluentdAuditName}},
+			Namespaces:    []string{render.LogCollectorNamespace},
+			TopologyKey:   corev1.LabelHostname,
+		}))
+		Expect(d.Spec.Template.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(BeEmpty())
+
+		Expect(d.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+			Name: "var-log-calico",
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: "/var/log/calico",
+					Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+				},
+			},
+		}))
+		envs := d.Spec.Template.Spec.Containers[0].Env
+		Expect(envs).To(ContainElements(
+			corev1.EnvVar{Name: "FLOW_LOGS_ENABLED", Value: "false"},
+			corev1.EnvVar{Name: "DNS_LOGS_ENABLED", Value: "false"},
+		))
+	})
+
+	It("should delete the audit-only Deployment for the default collector type", func() {
+		component := render.Fluentd(cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		toCreate, toDelete := component.Objects()
+
+		Expect(rtest.GetResource(toCreate, "fluentd-node", render.LogCollectorNamespace, "apps", "v1", "DaemonSet")).NotTo(BeNil())
+		Expect(rtest.GetResource(toCreate, render.FluentdAuditName, render.LogCollectorNamespace, "apps", "v1", "Deployment")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, render.FluentdAuditName, render.LogCollectorNamespace, "apps", "v1", "Deployment")).NotTo(BeNil())
+
+		ds := rtest.GetResource(toCreate, "fluentd-node", render.LogCollectorNamespace, "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+		Expect(ds.Spec.Template.Spec.Containers[0].Env).NotTo(ContainElement(corev1.EnvVar{Name: "FLOW_LOGS_ENABLED", Value: "false"}))
+	})
+
+	It("should delete the Windows DaemonSet for the AuditDeployment collector type", func() {
+		cfg.LogCollector.Spec.CollectorType = ptr.To(operatorv1.LogCollectorTypeAuditDeployment)
+		cfg.OSType = rmeta.OSTypeWindows
+		component := render.Fluentd(cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		toCreate, toDelete := component.Objects()
+
+		Expect(rtest.GetResource(toCreate, "fluentd-node-windows", render.LogCollectorNamespace, "apps", "v1", "DaemonSet")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, "fluentd-node-windows", render.LogCollectorNamespace, "apps", "v1", "DaemonSet")).NotTo(BeNil())
+		Expect(rtest.GetResource(toCreate, render.FluentdAuditName, render.LogCollectorNamespace, "apps", "v1", "Deployment")).To(BeNil())
+	})
+
+	It("should render an aggregator Deployment with a TLS forward input for the Aggregator collector type", func() {
+		certificateManager, err := certificatemanager.Create(cli, nil, clusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+		Expect(err).NotTo(HaveOccurred())
+		cfg.ForwardInputKeyPair, err = certificateManager.GetOrCreateKeyPair(cli, render.FluentdForwardInputTLSSecretName, common.OperatorNamespace(), []string{render.FluentdForwardInputService})
+		Expect(err).NotTo(HaveOccurred())
+		cfg.ClientCA = certificateManager.KeyPair()
+		cfg.LogCollector.Spec.CollectorType = ptr.To(operatorv1.LogCollectorTypeAggregator)
+		cfg.Installation.ControlPlaneReplicas = ptr.To(int32(2))
+		component := render.Fluentd(cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		toCreate, toDelete := component.Objects()
+
+		Expect(rtest.GetResource(toCreate, "fluentd-node", render.LogCollectorNamespace, "apps", "v1", "DaemonSet")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, "fluentd-node", render.LogCollectorNamespace, "apps", "v1", "DaemonSet")).NotTo(BeNil())
+		Expect(rtest.GetResource(toDelete, render.FluentdAuditName, render.LogCollectorNamespace, "apps", "v1", "Deployment")).NotTo(BeNil())
+
+		d := rtest.GetResource(toCreate, render.FluentdAggregatorName, render.LogCollectorNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(*d.Spec.Replicas).To(Equal(int32(2)))
+		Expect(d.Spec.Template.Labels).To(HaveKeyWithValue("k8s-app", render.FluentdNodeName))
+		Expect(d.Spec.Template.Spec.ServiceAccountName).To(Equal(render.FluentdNodeName))
+		Expect(d.Spec.Template.Spec.Affinity.PodAffinity).To(BeNil())
+		Expect(d.Spec.Template.Annotations).To(HaveKey("tigera-operator.hash.operator.tigera.io/tigera-fluentd-forward-input-tls"))
+		for _, v := range d.Spec.Template.Spec.Volumes {
+			Expect(v.HostPath).To(BeNil(), "Expected no hostPath volumes, found %s", v.Name)
+		}
+		Expect(d.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", render.FluentdForwardInputTLSSecretName)))
+
+		container := d.Spec.Template.Spec.Containers[0]
+		Expect(container.VolumeMounts).To(ContainElements(
+			corev1.VolumeMount{
+				Name:      "fluentd-forward-input",
+				MountPath: "/etc/fluentd/forward.d/",
+				ReadOnly:  true,
+			},
+			corev1.VolumeMount{
+				Name:      render.FluentdClientCAConfigMapName,
+				MountPath: "/etc/fluentd/client-ca/",
+				ReadOnly:  true,
+			},
+		))
+		Expect(container.Env).To(ContainElements(
+			corev1.EnvVar{Name: "NODE_INPUTS_ENABLED", Value: "false"},
+			corev1.EnvVar{Name: "FLUENTD_FORWARD_INPUT_DIR", Value: "/etc/fluentd/forward.d/"},
+		))
+
+		cm := rtest.GetResource(toCreate, render.FluentdForwardInputConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+		Expect(cm.Data).To(Equal(map[string]string{"forward.conf": `<source>
+  @type forward
+  @id forward-input
+  port 24224
+  bind 0.0.0.0
+  <transport tls>
+    cert_path /tigera-fluentd-forward-input-tls/tls.crt
+    private_key_path /tigera-fluentd-forward-input-tls/tls.key
+    client_cert_auth true
+    ca_path /etc/fluentd/client-ca/ca.crt
+  </transport>
+</source>
+`}))
+
+		svc := rtest.GetResource(toCreate, render.FluentdForwardInputService, render.LogCollectorNamespace, "", "v1", "Service").(*corev1.Service)
+		Expect(svc.Spec.Selector).To(Equal(map[string]string{"app.kubernetes.io/name": render.FluentdAggregatorName}))
+		Expect(svc.Spec.Ports).To(ConsistOf(HaveField("Port", int32(render.FluentdForwardInputPort))))
+
+		policy := rtest.GetResource(toCreate, render.FluentdPolicyName, render.LogCollectorNamespace, "projectcalico.org", "v3", "NetworkPolicy").(*v3.NetworkPolicy)
+		Expect(policy.Spec.Ingress).To(ContainElement(v3.Rule{
+			Action:   v3.Allow,
+			Protocol: &networkpolicy.TCPProtocol,
+			Source: v3.EntityRule{
+				Selector:          "has(logcollector.operator.tigera.io/forwarder)",
+				NamespaceSelector: "all()",
+			},
+			Destination: v3.EntityRule{
+				Ports: networkpolicy.Ports(render.FluentdForwardInputPort),
+			},
+		}))
+	})
+
+	It("should delete the aggregator Deployment for the default collector type", func() {
+		toCreate, toDelete := render.Fluentd(cfg).Objects()
+
+		Expect(rtest.GetResource(toCreate, render.FluentdAggregatorName, render.LogCollectorNamespace, "apps", "v1", "Deployment")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, render.FluentdAggregatorName, render.LogCollectorNamespace, "apps", "v1", "Deployment")).NotTo(BeNil())
+		Expect(rtest.GetResource(toDelete, render.FluentdForwardInputService, render.LogCollectorNamespace, "", "v1", "Service")).NotTo(BeNil())
+		Expect(rtest.GetResource(toDelete, render.FluentdForwardInputConfigMapName, render.LogCollectorNamespace, "", "v1", "ConfigMap")).NotTo(BeNil())
+	})
+
+	It("should render a VerticalPodAutoscaler for the fluentd workload when the CRD exists", func() {
+		cfg.VPACRDExists = true
+		cfg.Installation.VerticalPodAutoscaling = &operatorv1.VerticalPodAutoscaling{}
+		toCreate, toDelete := render.Fluentd(cfg).Objects()
+
+		v := rtest.GetResource(toCreate, "fluentd-node", render.LogCollectorNamespace, "autoscaling.k8s.io", "v1", "VerticalPodAutoscaler").(*vpav1.VerticalPodAutoscaler)
+		Expect(v.Spec.TargetRef.Kind).To(Equal("DaemonSet"))
+		Expect(v.Spec.TargetRef.Name).To(Equal("fluentd-node"))
+		Expect(*v.Spec.UpdatePolicy.UpdateMode).To(Equal("Initial"))
+		Expect(rtest.GetResource(toDelete, render.FluentdAuditName, render.LogCollectorNamespace, "autoscaling.k8s.io", "v1", "VerticalPodAutoscaler")).NotTo(BeNil())
+
+		// The autoscaler follows the workload that is rendered for the collector type.
+		cfg.LogCollector.Spec.CollectorType = ptr.To(operatorv1.LogCollectorTypeAuditDeployment)
+		toCreate, toDelete = render.Fluentd(cfg).Objects()
+		v = rtest.GetResource(toCreate, render.FluentdAuditName, render.LogCollectorNamespace, "autoscaling.k8s.io", "v1", "VerticalPodAutoscaler").(*vpav1.VerticalPodAutoscaler)
+		Expect(v.Spec.TargetRef.Kind).To(Equal("Deployment"))
+		Expect(rtest.GetResource(toDelete, "fluentd-node", render.LogCollectorNamespace, "autoscaling.k8s.io", "v1", "VerticalPodAutoscaler")).NotTo(BeNil())
+
+		cfg.VPACRDExists = false
+		toCreate, toDelete = render.Fluentd(cfg).Objects()
+		Expect(rtest.GetResource(toCreate, render.FluentdAuditName, render.LogCollectorNamespace, "autoscaling.k8s.io", "v1", "VerticalPodAutoscaler")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, "fluentd-node", render.LogCollectorNamespace, "autoscaling.k8s.io", "v1", "VerticalPodAutoscaler")).To(BeNil())
+	})
 })
 
 func setupEKSCloudwatchLogConfig() *render.EksCloudwatchLogConfig {
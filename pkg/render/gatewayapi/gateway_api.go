@@ -92,6 +92,13 @@ const (
 	EnvoyGatewayDeploymentContainerName = "envoy-gateway"
 	EnvoyGatewayJobContainerName        = "envoy-gateway-certgen"
 	wafFilterName                       = "waf-http-filter"
+
+	// GatewayNamespace is the namespace that the gateway controller and the gateways run in.
+	GatewayNamespace = "tigera-gateway"
+
+	// GatewayPodSecurityStandard is the least privileged pod security standard that the gateways run under. They
+	// write their logs to a HostPath volume.
+	GatewayPodSecurityStandard render.PodSecurityStandard = render.PSSPrivileged
 )
 
 var (
@@ -437,36 +444,36 @@ func (pr *gatewayAPIImplementationComponent) ResolveImages(is *operatorv1.ImageS
 
 	var err error
 	if pr.cfg.Installation.Variant.IsEnterprise() {
-		pr.envoyGatewayImage, err = components.GetReference(components.ComponentGatewayAPIEnvoyGateway, reg, path, prefix, is)
+		pr.envoyGatewayImage, err = components.GetReference(components.ComponentGatewayAPIEnvoyGateway, reg, path, prefix, is, pr.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
-		pr.envoyProxyImage, err = components.GetReference(components.ComponentGatewayAPIEnvoyProxy, reg, path, prefix, is)
+		pr.envoyProxyImage, err = components.GetReference(components.ComponentGatewayAPIEnvoyProxy, reg, path, prefix, is, pr.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
-		pr.envoyRatelimitImage, err = components.GetReference(components.ComponentGatewayAPIEnvoyRatelimit, reg, path, prefix, is)
+		pr.envoyRatelimitImage, err = components.GetReference(components.ComponentGatewayAPIEnvoyRatelimit, reg, path, prefix, is, pr.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
-		pr.wafHTTPFilterImage, err = components.GetReference(components.CombinedCalicoImage(pr.cfg.Installation), reg, path, prefix, is)
+		pr.wafHTTPFilterImage, err = components.GetReference(components.CombinedCalicoImage(pr.cfg.Installation), reg, path, prefix, is, pr.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
-		pr.L7LogCollectorImage, err = components.GetReference(components.ComponentGatewayL7Collector, reg, path, prefix, is)
+		pr.L7LogCollectorImage, err = components.GetReference(components.ComponentGatewayL7Collector, reg, path, prefix, is, pr.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
 	} else {
-		pr.envoyGatewayImage, err = components.GetReference(components.ComponentCalicoEnvoyGateway, reg, path, prefix, is)
+		pr.envoyGatewayImage, err = components.GetReference(components.ComponentCalicoEnvoyGateway, reg, path, prefix, is, pr.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
-		pr.envoyProxyImage, err = components.GetReference(components.ComponentCalicoEnvoyProxy, reg, path, prefix, is)
+		pr.envoyProxyImage, err = components.GetReference(components.ComponentCalicoEnvoyProxy, reg, path, prefix, is, pr.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
-		pr.envoyRatelimitImage, err = components.GetReference(components.ComponentCalicoEnvoyRatelimit, reg, path, prefix, is)
+		pr.envoyRatelimitImage, err = components.GetReference(components.ComponentCalicoEnvoyRatelimit, reg, path, prefix, is, pr.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
@@ -488,12 +495,7 @@ func (pr *gatewayAPIImplementationComponent) Objects() ([]client.Object, []clien
 	// First create the namespace.  We take the name from the read resources, but otherwise
 	// follow our own pattern for namespace creation.
 	objs := []client.Object{
-		render.CreateNamespace(
-			resources.namespace.Name,
-			pr.cfg.Installation.KubernetesProvider,
-			render.PSSPrivileged, // Needed for HostPath volume to write logs to
-			pr.cfg.Installation.Azure,
-		),
+		render.CreateNamespace(resources.namespace.Name, pr.cfg.Installation, GatewayPodSecurityStandard),
 	}
 
 	// Create role binding to allow creating secrets in our namespace.
@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	sigstorev1beta1 "github.com/tigera/operator/pkg/apis/sigstore/v1beta1"
+	"github.com/tigera/operator/pkg/components"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+)
+
+const (
+	ImageVerificationPolicyName = "tigera-images"
+
+	imageVerificationAuthorityName = "tigera-signing-key"
+)
+
+// ImageVerification renders the sigstore ClusterImagePolicy that requires the images of the components of the
+// installed variant to be signed with the key from Installation.Spec.ImageVerification. The policy is deleted when
+// verification is not enabled.
+func ImageVerification(installation *operatorv1.InstallationSpec) Component {
+	return &imageVerificationComponent{installation: installation}
+}
+
+type imageVerificationComponent struct {
+	installation *operatorv1.InstallationSpec
+	images       []string
+}
+
+func (c *imageVerificationComponent) ResolveImages(is *operatorv1.ImageSet) error {
+	images := components.CalicoImages
+	if c.installation.Variant.IsEnterprise() {
+		images = components.EnterpriseImages
+	}
+
+	// The policy matches image repositories rather than references, so that it does not need to change when the
+	// digests of a release do. References are parsed so that registries are normalized the same way the
+	// policy-controller normalizes the images of the pods it admits.
+	repos := map[string]bool{}
+	for _, img := range images {
+		ref, err := components.GetReference(img, c.installation.Registry, c.installation.ImagePath, c.installation.ImagePrefix, nil, c.installation.ComponentImages...)
+		if err != nil {
+			return err
+		}
+		parsed, err := name.ParseReference(ref)
+		if err != nil {
+			return fmt.Errorf("unable to parse image reference %s: %w", ref, err)
+		}
+		repos[parsed.Context().Name()] = true
+	}
+
+	c.images = make([]string, 0, len(repos))
+	for repo := range repos {
+		c.images = append(c.images, repo)
+	}
+	sort.Strings(c.images)
+	return nil
+}
+
+func (c *imageVerificationComponent) SupportedOSType() rmeta.OSType {
+	return rmeta.OSTypeAny
+}
+
+func (c *imageVerificationComponent) Objects() ([]client.Object, []client.Object) {
+	if c.installation.ImageVerification == nil {
+		return nil, []client.Object{&sigstorev1beta1.ClusterImagePolicy{
+			TypeMeta:   metav1.TypeMeta{Kind: sigstorev1beta1.ClusterImagePolicyKind, APIVersion: sigstorev1beta1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{Name: ImageVerificationPolicyName},
+		}}
+	}
+	return []client.Object{c.clusterImagePolicy()}, nil
+}
+
+func (c *imageVerificationComponent) Ready() bool {
+	return true
+}
+
+func (c *imageVerificationComponent) clusterImagePolicy() *sigstorev1beta1.ClusterImagePolicy {
+	var patterns []sigstorev1beta1.ImagePattern
+	for _, img := range c.images {
+		patterns = append(patterns, sigstorev1beta1.ImagePattern{Glob: img})
+	}
+
+	mode := sigstorev1beta1.ModeEnforce
+	if c.installation.ImageVerification.VerificationMode() == operatorv1.ImageVerificationModeWarn {
+		mode = sigstorev1beta1.ModeWarn
+	}
+
+	return &sigstorev1beta1.ClusterImagePolicy{
+		TypeMeta:   metav1.TypeMeta{Kind: sigstorev1beta1.ClusterImagePolicyKind, APIVersion: sigstorev1beta1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: ImageVerificationPolicyName},
+		Spec: sigstorev1beta1.ClusterImagePolicySpec{
+			Images: patterns,
+			Authorities: []sigstorev1beta1.Authority{{
+				Name: imageVerificationAuthorityName,
+				Key:  &sigstorev1beta1.KeyReference{Data: c.installation.ImageVerification.PublicKey},
+			}},
+			Mode: mode,
+		},
+	}
+}
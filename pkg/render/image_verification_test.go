@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	sigstorev1beta1 "github.com/tigera/operator/pkg/apis/sigstore/v1beta1"
+	"github.com/tigera/operator/pkg/components"
+	"github.com/tigera/operator/pkg/render"
+	rtest "github.com/tigera/operator/pkg/render/common/test"
+)
+
+var _ = Describe("Image verification rendering tests", func() {
+	const publicKey = "-----BEGIN PUBLIC KEY-----\nkey\n-----END PUBLIC KEY-----\n"
+
+	var installation *operatorv1.InstallationSpec
+
+	BeforeEach(func() {
+		installation = &operatorv1.InstallationSpec{
+			Variant:           operatorv1.Calico,
+			Registry:          "registry.example.com/",
+			ImageVerification: &operatorv1.ImageVerification{PublicKey: publicKey},
+		}
+	})
+
+	renderObjects := func() ([]client.Object, []client.Object) {
+		component := render.ImageVerification(installation)
+		Expect(component.ResolveImages(nil)).To(Succeed())
+		return component.Objects()
+	}
+
+	It("should render a ClusterImagePolicy for the images of the variant", func() {
+		toCreate, toDelete := renderObjects()
+		Expect(toDelete).To(BeEmpty())
+		rtest.ExpectResources(toCreate, []client.Object{
+			&sigstorev1beta1.ClusterImagePolicy{ObjectMeta: metav1.ObjectMeta{Name: "tigera-images"}},
+		})
+
+		policy := rtest.GetResource(toCreate, "tigera-images", "", "policy.sigstore.dev", "v1beta1", "ClusterImagePolicy").(*sigstorev1beta1.ClusterImagePolicy)
+		Expect(policy.Spec.Mode).To(Equal("enforce"))
+		Expect(policy.Spec.Authorities).To(HaveLen(1))
+		Expect(policy.Spec.Authorities[0].Key.Data).To(Equal(publicKey))
+		Expect(policy.Spec.Images).To(ContainElement(sigstorev1beta1.ImagePattern{Glob: "registry.example.com/calico/node"}))
+		Expect(policy.Spec.Images).NotTo(ContainElement(sigstorev1beta1.ImagePattern{Glob: "registry.example.com/tigera/node"}))
+		for _, p := range policy.Spec.Images {
+			Expect(p.Glob).NotTo(ContainSubstring(components.ComponentCalicoNode.Version))
+		}
+	})
+
+	It("should match the enterprise images for the enterprise variant", func() {
+		installation.Variant = operatorv1.CalicoEnterprise
+		toCreate, _ := renderObjects()
+		policy := rtest.GetResource(toCreate, "tigera-images", "", "policy.sigstore.dev", "v1beta1", "ClusterImagePolicy").(*sigstorev1beta1.ClusterImagePolicy)
+		Expect(policy.Spec.Images).To(ContainElement(sigstorev1beta1.ImagePattern{Glob: "registry.example.com/tigera/node"}))
+	})
+
+	It("should honor component image overrides", func() {
+		installation.ComponentImages = []operatorv1.ComponentImage{{Image: "calico/node", Registry: "mirror.example.com/"}}
+		toCreate, _ := renderObjects()
+		policy := rtest.GetResource(toCreate, "tigera-images", "", "policy.sigstore.dev", "v1beta1", "ClusterImagePolicy").(*sigstorev1beta1.ClusterImagePolicy)
+		Expect(policy.Spec.Images).To(ContainElement(sigstorev1beta1.ImagePattern{Glob: "mirror.example.com/calico/node"}))
+		Expect(policy.Spec.Images).NotTo(ContainElement(sigstorev1beta1.ImagePattern{Glob: "registry.example.com/calico/node"}))
+	})
+
+	It("should normalize Docker Hub images", func() {
+		installation.Registry = "docker.io/"
+		toCreate, _ := renderObjects()
+		policy := rtest.GetResource(toCreate, "tigera-images", "", "policy.sigstore.dev", "v1beta1", "ClusterImagePolicy").(*sigstorev1beta1.ClusterImagePolicy)
+		Expect(policy.Spec.Images).To(ContainElement(sigstorev1beta1.ImagePattern{Glob: "index.docker.io/calico/node"}))
+	})
+
+	It("should warn instead of enforcing when configured to", func() {
+		installation.ImageVerification.Mode = ptr.To(operatorv1.ImageVerificationModeWarn)
+		toCreate, _ := renderObjects()
+		policy := rtest.GetResource(toCreate, "tigera-images", "", "policy.sigstore.dev", "v1beta1", "ClusterImagePolicy").(*sigstorev1beta1.ClusterImagePolicy)
+		Expect(policy.Spec.Mode).To(Equal("warn"))
+	})
+
+	It("should delete the ClusterImagePolicy when verification is disabled", func() {
+		installation.ImageVerification = nil
+		toCreate, toDelete := renderObjects()
+		Expect(toCreate).To(BeEmpty())
+		rtest.ExpectResources(toDelete, []client.Object{
+			&sigstorev1beta1.ClusterImagePolicy{ObjectMeta: metav1.ObjectMeta{Name: "tigera-images"}},
+		})
+	})
+})
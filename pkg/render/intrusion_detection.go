@@ -132,12 +132,12 @@ func (c *intrusionDetectionComponent) ResolveImages(is *operatorv1.ImageSet) err
 	var errMsgs []string
 	var err error
 
-	c.controllerImage, err = components.GetReference(components.ComponentIntrusionDetectionController, reg, path, prefix, is)
+	c.controllerImage, err = components.GetReference(components.ComponentIntrusionDetectionController, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
-	c.webhooksProcessorImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+	c.webhooksProcessorImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
@@ -757,6 +757,9 @@ func (c *intrusionDetectionComponent) intrusionDetectionControllerContainer() co
 		sc = securitycontext.NewRootContext(c.cfg.OpenShift)
 	}
 
+	// The controller downloads threat feeds from outside the cluster.
+	envs = append(envs, c.cfg.Installation.Proxy.EnvVars()...)
+
 	if c.cfg.ManagedCluster {
 		volumeMounts = append(volumeMounts,
 			corev1.VolumeMount{
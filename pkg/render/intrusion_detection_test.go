@@ -370,6 +370,18 @@ var _ = Describe("Intrusion Detection rendering tests", func() {
 			}))
 	})
 
+	It("should render the proxy configuration on the controller", func() {
+		cfg.Installation.Proxy = &operatorv1.Proxy{HTTPSProxy: "https://proxy.example.com:3128"}
+
+		component := render.IntrusionDetection(cfg)
+		resources, _ := component.Objects()
+
+		deploy := rtest.GetResource(resources, "intrusion-detection-controller", render.IntrusionDetectionNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(deploy.Spec.Template.Spec.Containers[0].Env).To(ContainElement(
+			corev1.EnvVar{Name: "HTTPS_PROXY", Value: "https://proxy.example.com:3128"},
+		))
+	})
+
 	It("should disable GlobalAlert controller when cluster is managed", func() {
 		cfg.OpenShift = false
 		cfg.ManagedCluster = managedCluster
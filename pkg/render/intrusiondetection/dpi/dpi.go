@@ -40,8 +40,13 @@ import (
 )
 
 const (
-	DeepPacketInspectionNamespace            = "tigera-dpi"
-	DeepPacketInspectionName                 = "tigera-dpi"
+	DeepPacketInspectionNamespace = "tigera-dpi"
+	DeepPacketInspectionName      = "tigera-dpi"
+
+	// DeepPacketInspectionPodSecurityStandard is the least privileged pod security standard that deep packet
+	// inspection runs under. It inspects the traffic of the host network.
+	DeepPacketInspectionPodSecurityStandard render.PodSecurityStandard = render.PSSPrivileged
+
 	DeepPacketInspectionPolicyName           = networkpolicy.CalicoComponentPolicyPrefix + DeepPacketInspectionName
 	DefaultMemoryLimit                       = "1Gi"
 	DefaultMemoryRequest                     = "100Mi"
@@ -84,7 +89,8 @@ func (d *dpiComponent) ResolveImages(is *operatorv1.ImageSet) error {
 		d.cfg.Installation.Registry,
 		d.cfg.Installation.ImagePath,
 		d.cfg.Installation.ImagePrefix,
-		is)
+		is,
+		d.cfg.Installation.ComponentImages...)
 	if err != nil {
 		return err
 	}
@@ -105,9 +111,9 @@ func (d *dpiComponent) Objects() (objsToCreate, objsToDelete []client.Object) {
 	}
 
 	if d.cfg.HasNoLicense {
-		toDelete = append(toDelete, render.CreateNamespace(DeepPacketInspectionNamespace, d.cfg.Installation.KubernetesProvider, render.PSSPrivileged, d.cfg.Installation.Azure))
+		toDelete = append(toDelete, render.CreateNamespace(DeepPacketInspectionNamespace, d.cfg.Installation, DeepPacketInspectionPodSecurityStandard))
 	} else {
-		toCreate = append(toCreate, render.CreateNamespace(DeepPacketInspectionNamespace, d.cfg.Installation.KubernetesProvider, render.PSSPrivileged, d.cfg.Installation.Azure))
+		toCreate = append(toCreate, render.CreateNamespace(DeepPacketInspectionNamespace, d.cfg.Installation, DeepPacketInspectionPodSecurityStandard))
 		toCreate = append(toCreate, render.CreateOperatorSecretsRoleBinding(DeepPacketInspectionNamespace))
 	}
 
@@ -195,36 +195,36 @@ func (c *IstioComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	prefix := c.cfg.Installation.ImagePrefix
 
 	if c.cfg.Installation.Variant.IsEnterprise() {
-		c.IstioPilotImage, err = components.GetReference(components.ComponentIstioPilot, reg, path, prefix, is)
+		c.IstioPilotImage, err = components.GetReference(components.ComponentIstioPilot, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
-		c.IstioInstallCNIImage, err = components.GetReference(components.ComponentIstioInstallCNI, reg, path, prefix, is)
+		c.IstioInstallCNIImage, err = components.GetReference(components.ComponentIstioInstallCNI, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
-		c.IstioZTunnelImage, err = components.GetReference(components.ComponentIstioZTunnel, reg, path, prefix, is)
+		c.IstioZTunnelImage, err = components.GetReference(components.ComponentIstioZTunnel, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
-		c.IstioProxyv2Image, err = components.GetReference(components.ComponentIstioProxyv2, reg, path, prefix, is)
+		c.IstioProxyv2Image, err = components.GetReference(components.ComponentIstioProxyv2, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
 	} else {
-		c.IstioPilotImage, err = components.GetReference(components.ComponentCalicoIstioPilot, reg, path, prefix, is)
+		c.IstioPilotImage, err = components.GetReference(components.ComponentCalicoIstioPilot, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
-		c.IstioInstallCNIImage, err = components.GetReference(components.ComponentCalicoIstioInstallCNI, reg, path, prefix, is)
+		c.IstioInstallCNIImage, err = components.GetReference(components.ComponentCalicoIstioInstallCNI, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
-		c.IstioZTunnelImage, err = components.GetReference(components.ComponentCalicoIstioZTunnel, reg, path, prefix, is)
+		c.IstioZTunnelImage, err = components.GetReference(components.ComponentCalicoIstioZTunnel, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
-		c.IstioProxyv2Image, err = components.GetReference(components.ComponentCalicoIstioProxyv2, reg, path, prefix, is)
+		c.IstioProxyv2Image, err = components.GetReference(components.ComponentCalicoIstioProxyv2, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 		if err != nil {
 			return err
 		}
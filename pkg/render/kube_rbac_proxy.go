@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/components"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/securitycontext"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
+)
+
+const (
+	// KubeRBACProxyContainerName is the name of the sidecar that serves the metrics of a component once clients
+	// must authenticate to scrape them.
+	KubeRBACProxyContainerName = "kube-rbac-proxy"
+
+	// kubeRBACProxyMetricsPath is the only path the proxy forwards, and the non-resource URL that clients must be
+	// allowed to get.
+	kubeRBACProxyMetricsPath = "/metrics"
+)
+
+// kubeRBACProxyImage returns the kube-rbac-proxy image of the variant of the installation.
+func kubeRBACProxyImage(installation *operatorv1.InstallationSpec) components.Component {
+	if installation.Variant.IsEnterprise() {
+		return components.ComponentKubeRBACProxy
+	}
+	return components.ComponentCalicoKubeRBACProxy
+}
+
+// kubeRBACProxyContainer returns a kube-rbac-proxy container that serves the metrics a component serves on the
+// loopback upstream port on the given port instead. Each request must carry a bearer token that the Kubernetes API
+// server authenticates with a TokenReview, and whose user is authorized by a SubjectAccessReview to get the
+// /metrics non-resource URL. If keyPair is nil, the proxy serves with a self-signed certificate.
+func kubeRBACProxyContainer(image string, port, upstreamPort int32, keyPair certificatemanagement.KeyPairInterface, installation *operatorv1.InstallationSpec) corev1.Container {
+	args := []string{
+		fmt.Sprintf("--secure-listen-address=:%d", port),
+		fmt.Sprintf("--upstream=http://127.0.0.1:%d/", upstreamPort),
+		fmt.Sprintf("--allow-paths=%s", kubeRBACProxyMetricsPath),
+	}
+	var mounts []corev1.VolumeMount
+	if keyPair != nil {
+		args = append(args,
+			fmt.Sprintf("--tls-cert-file=%s", keyPair.VolumeMountCertificateFilePath()),
+			fmt.Sprintf("--tls-private-key-file=%s", keyPair.VolumeMountKeyFilePath()),
+		)
+		mounts = append(mounts, keyPair.VolumeMount(rmeta.OSTypeLinux))
+	}
+	args = append(args, tlsProfileArgs(installation)...)
+
+	return corev1.Container{
+		Name:  KubeRBACProxyContainerName,
+		Image: image,
+		Args:  args,
+		Ports: []corev1.ContainerPort{{
+			Name:          "metrics-proxy",
+			ContainerPort: port,
+			Protocol:      corev1.ProtocolTCP,
+		}},
+		VolumeMounts:    mounts,
+		SecurityContext: securitycontext.NewNonRootContext(),
+	}
+}
+
+// kubeRBACProxyRules returns the rules the service account of a pod with a kube-rbac-proxy sidecar needs to
+// authenticate and authorize the requests for its metrics.
+func kubeRBACProxyRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"authentication.k8s.io"},
+			Resources: []string{"tokenreviews"},
+			Verbs:     []string{"create"},
+		},
+		{
+			APIGroups: []string{"authorization.k8s.io"},
+			Resources: []string{"subjectaccessreviews"},
+			Verbs:     []string{"create"},
+		},
+	}
+}
@@ -241,7 +241,7 @@ func (c *kubeControllersComponent) ResolveImages(is *operatorv1.ImageSet) error
 	path := c.cfg.Installation.ImagePath
 	prefix := c.cfg.Installation.ImagePrefix
 	var err error
-	c.image, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+	c.image, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	return err
 }
 
@@ -302,6 +302,15 @@ func (c *kubeControllersComponent) Ready() bool {
 	return true
 }
 
+// GarbageCollectionIdentity opts in calico-kube-controllers only. es-calico-kube-controllers is rendered once per
+// tenant in multi-tenant management clusters.
+func (c *kubeControllersComponent) GarbageCollectionIdentity() string {
+	if c.kubeControllerName != KubeController {
+		return ""
+	}
+	return "kube-controllers"
+}
+
 func kubeControllersRoleCommonRules(cfg *KubeControllersConfiguration) []rbacv1.PolicyRule {
 	rules := []rbacv1.PolicyRule{
 		{
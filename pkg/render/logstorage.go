@@ -54,6 +54,14 @@ const (
 	ElasticsearchObjectName = "tigera-elasticsearch"
 	ElasticsearchNamespace  = ElasticsearchObjectName
 
+	// ElasticsearchPodSecurityStandard is the least privileged pod security standard that Elasticsearch runs under.
+	// Its init containers tune the kernel settings of the node.
+	ElasticsearchPodSecurityStandard PodSecurityStandard = PSSPrivileged
+
+	// ExternalElasticsearchPodSecurityStandard is the pod security standard that the components of the
+	// Elasticsearch namespace run under when an external Elasticsearch is used.
+	ExternalElasticsearchPodSecurityStandard PodSecurityStandard = PSSRestricted
+
 	// TigeraLinseedSecret is the name of the secret that holds the TLS key pair mounted into Linseed.
 	// The secret contains server key and certificate.
 	TigeraLinseedSecret = "tigera-secure-linseed-cert"
@@ -184,7 +192,7 @@ func (es *elasticsearchComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	prefix := es.cfg.Installation.ImagePrefix
 
 	var err error
-	es.esImage, err = components.GetReference(components.ComponentElasticsearch, reg, path, prefix, is)
+	es.esImage, err = components.GetReference(components.ComponentElasticsearch, reg, path, prefix, is, es.cfg.Installation.ComponentImages...)
 
 	errMsgs := make([]string, 0)
 	if err != nil {
@@ -225,7 +233,7 @@ func (es *elasticsearchComponent) Objects() ([]client.Object, []client.Object) {
 	}
 
 	// Elasticsearch CRs
-	toCreate = append(toCreate, CreateNamespace(ElasticsearchNamespace, es.cfg.Installation.KubernetesProvider, PSSPrivileged, es.cfg.Installation.Azure))
+	toCreate = append(toCreate, CreateNamespace(ElasticsearchNamespace, es.cfg.Installation, ElasticsearchPodSecurityStandard))
 	toCreate = append(toCreate, es.elasticsearchCalicoSystemPolicy())
 	toCreate = append(toCreate, es.elasticsearchInternalCalicoSystemPolicy())
 	toCreate = append(toCreate, networkpolicy.CalicoSystemDefaultDeny(ElasticsearchNamespace))
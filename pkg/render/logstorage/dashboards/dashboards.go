@@ -105,7 +105,7 @@ func (d *dashboards) ResolveImages(is *operatorv1.ImageSet) error {
 	errMsgs := []string{}
 
 	// Calculate the image(s) to use for Dashboards, given user registry configuration.
-	d.image, err = components.GetReference(components.ComponentElasticTseeInstaller, reg, path, prefix, is)
+	d.image, err = components.GetReference(components.ComponentElasticTseeInstaller, reg, path, prefix, is, d.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
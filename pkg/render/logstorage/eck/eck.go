@@ -44,6 +44,9 @@ const (
 	LicenseConfigMapName = "elastic-licensing"
 	OperatorPolicyName   = networkpolicy.CalicoComponentPolicyPrefix + "elastic-operator-access"
 	EnterpriseTrial      = "eck-trial-license"
+
+	// OperatorPodSecurityStandard is the least privileged pod security standard that the ECK operator runs under.
+	OperatorPodSecurityStandard render.PodSecurityStandard = render.PSSRestricted
 )
 
 // ECK renders the components necessary for eck operator
@@ -76,7 +79,7 @@ func (e *eck) ResolveImages(is *operatorv1.ImageSet) error {
 	errMsgs := make([]string, 0)
 
 	var err error
-	e.esOperatorImage, err = components.GetReference(components.ComponentElasticsearchOperator, reg, path, prefix, is)
+	e.esOperatorImage, err = components.GetReference(components.ComponentElasticsearchOperator, reg, path, prefix, is, e.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
@@ -95,7 +98,7 @@ func (e *eck) Objects() ([]client.Object, []client.Object) {
 	var toCreate, toDelete []client.Object
 
 	toCreate = append(toCreate,
-		render.CreateNamespace(OperatorNamespace, e.cfg.Installation.KubernetesProvider, render.PSSRestricted, e.cfg.Installation.Azure),
+		render.CreateNamespace(OperatorNamespace, e.cfg.Installation, OperatorPodSecurityStandard),
 		e.operatorCalicoSystemPolicy(),
 	)
 	// allow-tigera Tier was renamed to calico-system
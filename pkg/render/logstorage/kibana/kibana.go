@@ -58,6 +58,10 @@ const (
 	PolicyName   = networkpolicy.CalicoComponentPolicyPrefix + "kibana-access"
 	Port         = 5601
 
+	// PodSecurityStandard is the least privileged pod security standard that Kibana runs under. The init
+	// containers that ECK adds to the Kibana pods do not meet the restricted level.
+	PodSecurityStandard render.PodSecurityStandard = render.PSSBaseline
+
 	TLSAnnotationHash = "hash.operator.tigera.io/kb-secrets"
 
 	TimeFilter         = "_g=(time:(from:now-24h,to:now))"
@@ -107,7 +111,7 @@ func (k *kibana) ResolveImages(is *operatorv1.ImageSet) error {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
-	k.kibanaImage, err = components.GetReference(components.ComponentKibana, reg, path, prefix, is)
+	k.kibanaImage, err = components.GetReference(components.ComponentKibana, reg, path, prefix, is, k.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
@@ -149,7 +153,7 @@ func (k *kibana) Objects() ([]client.Object, []client.Object) {
 		// - securityContext.capabilities.drop=["ALL"]
 		// - securityContext.runAsNonRoot=true
 		// - securityContext.seccompProfile.type to "RuntimeDefault" or "Localhost"
-		toCreate = append(toCreate, render.CreateNamespace(Namespace, k.cfg.Installation.KubernetesProvider, render.PSSBaseline, k.cfg.Installation.Azure))
+		toCreate = append(toCreate, render.CreateNamespace(Namespace, k.cfg.Installation, PodSecurityStandard))
 		toCreate = append(toCreate, k.calicoSystemPolicy())
 		toCreate = append(toCreate, networkpolicy.CalicoSystemDefaultDeny(Namespace))
 		toCreate = append(toCreate, render.CreateOperatorSecretsRoleBinding(Namespace))
@@ -137,7 +137,7 @@ func (l *linseed) ResolveImages(is *operatorv1.ImageSet) error {
 	errMsgs := []string{}
 
 	// Calculate the image(s) to use for Linseed, given user registry configuration.
-	l.linseedImage, err = components.GetReference(components.CombinedCalicoImage(l.cfg.Installation), reg, path, prefix, is)
+	l.linseedImage, err = components.GetReference(components.CombinedCalicoImage(l.cfg.Installation), reg, path, prefix, is, l.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
@@ -375,6 +375,8 @@ func (l *linseed) linseedDeployment() *appsv1.Deployment {
 		)
 	}
 
+	envVars = append(envVars, l.indexLifecycleEnvVars()...)
+
 	replicas := l.cfg.Installation.ControlPlaneReplicas
 	if l.cfg.Tenant != nil {
 		// Always set the expected tenant ID when a tenant is configured, regardless of
@@ -524,6 +526,43 @@ func (l *linseed) linseedDeployment() *appsv1.Deployment {
 	return &d
 }
 
+// indexLifecycleEnvVars configures the ILM policy Linseed applies to the indices it creates. Indices are deleted
+// once they are older than the retention period of their log type.
+func (l *linseed) indexLifecycleEnvVars() []corev1.EnvVar {
+	if l.cfg.LogStorage == nil || l.cfg.LogStorage.Spec.Indices == nil || l.cfg.LogStorage.Spec.Indices.Lifecycle == nil {
+		return nil
+	}
+
+	lifecycle := l.cfg.LogStorage.Spec.Indices.Lifecycle
+	envVars := []corev1.EnvVar{{Name: "ELASTIC_ILM_ENABLED", Value: "true"}}
+	if lifecycle.RolloverMaxAge != nil {
+		// Elasticsearch time units do not include Go's compound durations, so always use seconds.
+		envVars = append(envVars, corev1.EnvVar{Name: "ELASTIC_ILM_ROLLOVER_MAX_AGE", Value: fmt.Sprintf("%ds", int64(lifecycle.RolloverMaxAge.Seconds()))})
+	}
+	if lifecycle.RolloverMaxSize != nil {
+		envVars = append(envVars, corev1.EnvVar{Name: "ELASTIC_ILM_ROLLOVER_MAX_SIZE", Value: fmt.Sprintf("%db", lifecycle.RolloverMaxSize.Value())})
+	}
+
+	if retention := l.cfg.LogStorage.Spec.Retention; retention != nil {
+		for _, r := range []struct {
+			name string
+			days *int32
+		}{
+			{"ELASTIC_FLOWS_INDEX_RETENTION", retention.Flows},
+			{"ELASTIC_DNS_INDEX_RETENTION", retention.DNSLogs},
+			{"ELASTIC_BGP_INDEX_RETENTION", retention.BGPLogs},
+			{"ELASTIC_AUDIT_INDEX_RETENTION", retention.AuditReports},
+			{"ELASTIC_SNAPSHOTS_INDEX_RETENTION", retention.Snapshots},
+			{"ELASTIC_COMPLIANCE_REPORTS_INDEX_RETENTION", retention.ComplianceReports},
+		} {
+			if r.days != nil {
+				envVars = append(envVars, corev1.EnvVar{Name: r.name, Value: fmt.Sprintf("%dd", *r.days)})
+			}
+		}
+	}
+	return envVars
+}
+
 func (l *linseed) linseedServiceAccount() *corev1.ServiceAccount {
 	return &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
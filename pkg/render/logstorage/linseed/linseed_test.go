@@ -17,6 +17,7 @@ package linseed
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -303,6 +304,39 @@ var _ = Describe("Linseed rendering tests", func() {
 			Expect(d.Spec.Template.Spec.Tolerations).To(ConsistOf(t))
 		})
 
+		It("should render the index lifecycle policy from the LogStorage", func() {
+			size := resource.MustParse("50Gi")
+			cfg.LogStorage = &operatorv1.LogStorage{
+				Spec: operatorv1.LogStorageSpec{
+					Indices: &operatorv1.Indices{Lifecycle: &operatorv1.IndexLifecycle{
+						RolloverMaxAge:  &metav1.Duration{Duration: 12 * time.Hour},
+						RolloverMaxSize: &size,
+					}},
+					Retention: &operatorv1.Retention{Flows: ptr.To[int32](8), DNSLogs: ptr.To[int32](4)},
+				},
+			}
+
+			resources, _ := Linseed(cfg).Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue(), "Deployment not found")
+			envs := d.Spec.Template.Spec.Containers[0].Env
+			Expect(envs).To(ContainElements(
+				corev1.EnvVar{Name: "ELASTIC_ILM_ENABLED", Value: "true"},
+				corev1.EnvVar{Name: "ELASTIC_ILM_ROLLOVER_MAX_AGE", Value: "43200s"},
+				corev1.EnvVar{Name: "ELASTIC_ILM_ROLLOVER_MAX_SIZE", Value: "53687091200b"},
+				corev1.EnvVar{Name: "ELASTIC_FLOWS_INDEX_RETENTION", Value: "8d"},
+				corev1.EnvVar{Name: "ELASTIC_DNS_INDEX_RETENTION", Value: "4d"},
+			))
+			Expect(envs).NotTo(ContainElement(HaveField("Name", "ELASTIC_BGP_INDEX_RETENTION")))
+		})
+
+		It("should not render an index lifecycle policy by default", func() {
+			resources, _ := Linseed(cfg).Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue(), "Deployment not found")
+			Expect(d.Spec.Template.Spec.Containers[0].Env).NotTo(ContainElement(HaveField("Name", "ELASTIC_ILM_ENABLED")))
+		})
+
 		It("should render deployment with resource requests and limits", func() {
 			secret, err := certificatemanagement.CreateSelfSignedSecret("", "", "", nil)
 			Expect(err).NotTo(HaveOccurred())
@@ -17,6 +17,7 @@ package render
 import (
 	"crypto/x509"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -57,6 +58,11 @@ const (
 	LegacyManagerDeploymentName = "tigera-manager"
 	ManagerNamespace            = common.CalicoNamespace
 	LegacyManagerNamespace      = "tigera-manager"
+
+	// LegacyManagerPodSecurityStandard is the pod security standard of the legacy manager namespace, which only
+	// holds a service that points at the manager.
+	LegacyManagerPodSecurityStandard PodSecurityStandard = PSSRestricted
+
 	ManagerServiceAccount       = "calico-manager"
 	LegacyManagerServiceAccount = "tigera-manager"
 
@@ -114,6 +120,10 @@ const (
 	// certificates. When the secret is present the manager controller wires it into the
 	// Voltron deployment. It is managed out-of-band; the operator only consumes it.
 	VoltronAdditionalTunnelSecretName = "calico-management-additional-cluster-connection"
+
+	// VoltronNextTunnelSecretName is the name of the secret in the truth namespace that holds the replacement
+	// tunnel CA while the tunnel CA is being rotated. See ManagementClusterSpec.TunnelCARotationInterval.
+	VoltronNextTunnelSecretName = VoltronTunnelSecretName + "-next"
 )
 
 // Manager returns a component for rendering namespaced manager resources.
@@ -142,8 +152,8 @@ func Manager(cfg *ManagerConfiguration) (Component, error) {
 	tlsAnnotations[cfg.InternalTLSKeyPair.HashAnnotationKey()] = cfg.InternalTLSKeyPair.HashAnnotationValue()
 	if cfg.ManagementCluster != nil {
 		tlsAnnotations[cfg.TunnelServerCert.HashAnnotationKey()] = cfg.TunnelServerCert.HashAnnotationValue()
-		if cfg.AdditionalTunnelServerCert != nil {
-			tlsAnnotations[cfg.AdditionalTunnelServerCert.HashAnnotationKey()] = cfg.AdditionalTunnelServerCert.HashAnnotationValue()
+		for _, kp := range cfg.additionalTunnelServerCerts() {
+			tlsAnnotations[kp.HashAnnotationKey()] = kp.HashAnnotationValue()
 		}
 	}
 
@@ -182,6 +192,11 @@ type ManagerConfiguration struct {
 	// Voltron container so Voltron can serve TLS from it.
 	AdditionalTunnelServerCert certificatemanagement.KeyPairInterface
 
+	// NextTunnelServerCert is the replacement tunnel CA while the tunnel CA is being rotated. Like the
+	// AdditionalTunnelServerCert, it is mounted into the Voltron container so Voltron accepts connections
+	// from managed clusters that have moved to it.
+	NextTunnelServerCert certificatemanagement.KeyPairInterface
+
 	// TLS KeyPair used by both Voltron and ui-apis, presented by each as part of the mTLS handshake with
 	// other services within the cluster. This is used in both management and standalone clusters.
 	InternalTLSKeyPair certificatemanagement.KeyPairInterface
@@ -219,6 +234,17 @@ type ManagerConfiguration struct {
 	CACertCommonName string
 }
 
+// additionalTunnelServerCerts returns the tunnel CAs that Voltron serves in addition to the TunnelServerCert.
+func (cfg *ManagerConfiguration) additionalTunnelServerCerts() []certificatemanagement.KeyPairInterface {
+	var kps []certificatemanagement.KeyPairInterface
+	for _, kp := range []certificatemanagement.KeyPairInterface{cfg.AdditionalTunnelServerCert, cfg.NextTunnelServerCert} {
+		if kp != nil {
+			kps = append(kps, kp)
+		}
+	}
+	return kps
+}
+
 type managerComponent struct {
 	cfg            *ManagerConfiguration
 	tlsSecrets     []*corev1.Secret
@@ -233,18 +259,18 @@ func (c *managerComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	path := c.cfg.Installation.ImagePath
 	prefix := c.cfg.Installation.ImagePrefix
 	var err error
-	c.managerImage, err = components.GetReference(components.ComponentManager, reg, path, prefix, is)
+	c.managerImage, err = components.GetReference(components.ComponentManager, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	errMsgs := []string{}
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
-	c.voltronImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+	c.voltronImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
-	c.uiAPIsImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+	c.uiAPIsImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
@@ -288,6 +314,7 @@ func (c *managerComponent) Objects() ([]client.Object, []client.Object) {
 	objsToCreate = append(objsToCreate, c.managedClustersUpdateRBAC()...)
 	if c.cfg.Tenant.MultiTenant() {
 		objsToCreate = append(objsToCreate, c.multiTenantManagedClustersAccess()...)
+		objsToCreate = append(objsToCreate, c.tenantUserRoles()...)
 	}
 
 	objsToCreate = append(objsToCreate,
@@ -424,8 +451,8 @@ func (c *managerComponent) managerVolumes() []corev1.Volume {
 			c.cfg.TunnelServerCert.Volume(),
 			c.cfg.VoltronLinseedKeyPair.Volume(),
 		)
-		if c.cfg.AdditionalTunnelServerCert != nil {
-			v = append(v, c.cfg.AdditionalTunnelServerCert.Volume())
+		for _, kp := range c.cfg.additionalTunnelServerCerts() {
+			v = append(v, kp.Volume())
 		}
 	}
 	if c.cfg.KeyValidatorConfig != nil {
@@ -595,7 +622,7 @@ func (c *managerComponent) voltronContainer() corev1.Container {
 		env = append(env, corev1.EnvVar{Name: "VOLTRON_USE_HTTPS_CERT_ON_TUNNEL", Value: strconv.FormatBool(c.cfg.ManagementCluster.Spec.TLS != nil && c.cfg.ManagementCluster.Spec.TLS.SecretName == ManagerTLSSecretName)})
 		env = append(env, corev1.EnvVar{Name: "VOLTRON_LINSEED_SERVER_KEY", Value: linseedKeyPath})
 		env = append(env, corev1.EnvVar{Name: "VOLTRON_LINSEED_SERVER_CERT", Value: linseedCertPath})
-		if c.cfg.AdditionalTunnelServerCert != nil {
+		if len(c.cfg.additionalTunnelServerCerts()) > 0 {
 			// Voltron scans a single parent directory for additional cert/key pairs. Each
 			// cert/key pair is mounted into its own subdirectory so multiple can coexist.
 			// The tls.crt from each pair is also used as an additional CA to verify
@@ -624,10 +651,10 @@ func (c *managerComponent) voltronContainer() corev1.Container {
 		if c.cfg.ManagementCluster != nil {
 			mounts = append(mounts, c.cfg.TunnelServerCert.VolumeMount(c.SupportedOSType()))
 			mounts = append(mounts, c.cfg.VoltronLinseedKeyPair.VolumeMount(c.SupportedOSType()))
-			if c.cfg.AdditionalTunnelServerCert != nil {
+			for _, kp := range c.cfg.additionalTunnelServerCerts() {
 				mounts = append(mounts, corev1.VolumeMount{
-					Name:      c.cfg.AdditionalTunnelServerCert.GetName(),
-					MountPath: fmt.Sprintf("/additional-tunnel-certificates/%s", c.cfg.AdditionalTunnelServerCert.GetName()),
+					Name:      kp.GetName(),
+					MountPath: fmt.Sprintf("/additional-tunnel-certificates/%s", kp.GetName()),
 					ReadOnly:  true,
 				})
 			}
@@ -835,7 +862,7 @@ func (c *managerComponent) managerService() *corev1.Service {
 }
 
 func (c *managerComponent) managerLegacyNamespace() *corev1.Namespace {
-	return CreateNamespace(LegacyManagerNamespace, c.cfg.Installation.KubernetesProvider, PSSRestricted, c.cfg.Installation.Azure)
+	return CreateNamespace(LegacyManagerNamespace, c.cfg.Installation, LegacyManagerPodSecurityStandard)
 }
 
 // managerExternalNameService acts as a safety net for migration of manager service from legacy namespace (tigera-manager)
@@ -1309,6 +1336,86 @@ func (c *managerComponent) managerCalicoSystemNetworkPolicy() *v3.NetworkPolicy
 	}
 }
 
+// tenantUserRoles returns Roles in the tenant namespace that grant the same capabilities as the tigera-ui-user and
+// tigera-network-admin ClusterRoles, which are not installed in multi-tenant clusters, on the namespaced resources.
+// Tenant admins bind their users to these Roles rather than granting them cluster-wide access.
+func (c *managerComponent) tenantUserRoles() []client.Object {
+	return []client.Object{
+		&rbacv1.Role{
+			TypeMeta:   metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: TigeraUIUserRoleName, Namespace: c.cfg.Namespace},
+			Rules:      namespacedRules(tigeraUIUserRules(false, true)),
+		},
+		&rbacv1.Role{
+			TypeMeta:   metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: TigeraNetworkAdminRoleName, Namespace: c.cfg.Namespace},
+			Rules:      namespacedRules(tigeraNetworkAdminRules(false, true)),
+		},
+	}
+}
+
+// clusterScopedResources are the cluster-scoped resources of the tigera-ui-user and tigera-network-admin rules,
+// keyed by API group. ManagedClusters are namespaced in multi-tenant clusters.
+var clusterScopedResources = map[string][]string{
+	"": {"namespaces"},
+	"projectcalico.org": {
+		"tiers",
+		"globalnetworkpolicies",
+		"tier.globalnetworkpolicies",
+		"stagedglobalnetworkpolicies",
+		"tier.stagedglobalnetworkpolicies",
+		"globalnetworksets",
+		"policyrecommendationscopes",
+		"globalreports",
+		"globalreports/status",
+		"globalreporttypes",
+		"clusterinformations",
+		"hostendpoints",
+		"alertexceptions",
+		"globalalerts",
+		"globalalerts/status",
+		"globalalerttemplates",
+		"globalthreatfeeds",
+		"globalthreatfeeds/status",
+		"securityeventwebhooks",
+		"uisettingsgroups",
+		"uisettingsgroups/data",
+		"felixconfigurations",
+	},
+	"crd.projectcalico.org": {"securityeventwebhooks"},
+	"policy.networking.k8s.io": {
+		"clusternetworkpolicies",
+		"adminnetworkpolicies",
+		"baselineadminnetworkpolicies",
+	},
+	"operator.tigera.io": {"applicationlayers", "packetcaptureapis", "compliances", "intrusiondetections"},
+}
+
+// namespacedRules returns the given rules without their cluster-scoped resources, which a Role cannot grant.
+func namespacedRules(rules []rbacv1.PolicyRule) []rbacv1.PolicyRule {
+	var namespaced []rbacv1.PolicyRule
+	for _, rule := range rules {
+		var resources []string
+		for _, resource := range rule.Resources {
+			clusterScoped := false
+			for _, group := range rule.APIGroups {
+				if slices.Contains(clusterScopedResources[group], resource) {
+					clusterScoped = true
+				}
+			}
+			if !clusterScoped {
+				resources = append(resources, resource)
+			}
+		}
+		if len(resources) == 0 {
+			continue
+		}
+		rule.Resources = resources
+		namespaced = append(namespaced, rule)
+	}
+	return namespaced
+}
+
 func (c *managerComponent) multiTenantManagedClustersAccess() []client.Object {
 	var objects []client.Object
 
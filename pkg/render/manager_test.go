@@ -1260,12 +1260,25 @@ var _ = Describe("Tigera Secure Manager rendering tests", func() {
 				&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: render.ManagerManagedClustersUpdateRBACName, Namespace: tenantANamespace}, TypeMeta: metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"}},
 				&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: render.ManagerManagedClustersUpdateRBACName, Namespace: tenantANamespace}, TypeMeta: metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 				&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: render.ManagerMultiTenantManagedClustersAccessClusterRoleBindingName, Namespace: tenantANamespace}, TypeMeta: metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
+				&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: render.TigeraUIUserRoleName, Namespace: tenantANamespace}, TypeMeta: metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"}},
+				&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: render.TigeraNetworkAdminRoleName, Namespace: tenantANamespace}, TypeMeta: metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"}},
 				&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.ManagerServiceName, Namespace: tenantANamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
 				&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.LegacyManagerServiceName, Namespace: tenantANamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
 				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: render.ManagerDeploymentName, Namespace: tenantANamespace}, TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}},
 			}
 			rtest.ExpectResources(tenantAResourcesToCreate, expectedTenantAResources)
 
+			// The tenant Roles only grant access to namespaced resources.
+			for _, name := range []string{render.TigeraUIUserRoleName, render.TigeraNetworkAdminRoleName} {
+				role := rtest.GetResource(tenantAResourcesToCreate, name, tenantANamespace, "rbac.authorization.k8s.io", "v1", "Role").(*rbacv1.Role)
+				var resources []string
+				for _, rule := range role.Rules {
+					resources = append(resources, rule.Resources...)
+				}
+				Expect(resources).To(ContainElements("networkpolicies", "tier.networkpolicies", "managedclusters", "pods"))
+				Expect(resources).NotTo(ContainElement(BeElementOf("tiers", "globalnetworkpolicies", "namespaces", "clusternetworkpolicies", "applicationlayers")))
+			}
+
 			expectedTenantAResourcesToDelete := []client.Object{
 				&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-tigera.manager-access", Namespace: tenantANamespace}, TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"}},
 				&v3.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-tigera.default-deny", Namespace: tenantANamespace}, TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"}},
@@ -1310,6 +1323,8 @@ var _ = Describe("Tigera Secure Manager rendering tests", func() {
 				&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: render.ManagerManagedClustersUpdateRBACName, Namespace: tenantBNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"}},
 				&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: render.ManagerManagedClustersUpdateRBACName, Namespace: tenantBNamespace}, TypeMeta: metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
 				&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: render.ManagerMultiTenantManagedClustersAccessClusterRoleBindingName, Namespace: tenantBNamespace}, TypeMeta: metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}},
+				&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: render.TigeraUIUserRoleName, Namespace: tenantBNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"}},
+				&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: render.TigeraNetworkAdminRoleName, Namespace: tenantBNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"}},
 				&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.ManagerServiceName, Namespace: tenantBNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
 				&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.LegacyManagerServiceName, Namespace: tenantBNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
 				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: render.ManagerDeploymentName, Namespace: tenantBNamespace}, TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}},
@@ -1427,6 +1442,37 @@ var _ = Describe("Tigera Secure Manager rendering tests", func() {
 				}))
 		})
 
+		It("should render the default user roles in the tenant namespace", func() {
+			resources, _ := renderObjects(renderConfig{
+				installation:            installation,
+				compliance:              compliance,
+				complianceFeatureActive: true,
+				ns:                      tenantANamespace,
+				tenant: &operatorv1.Tenant{
+					ObjectMeta: metav1.ObjectMeta{Name: "tenantA", Namespace: tenantANamespace},
+					Spec:       operatorv1.TenantSpec{ID: "tenant-a"},
+				},
+			})
+
+			uiUser := rtest.GetResource(resources, "tigera-ui-user", tenantANamespace, "rbac.authorization.k8s.io", "v1", "Role").(*rbacv1.Role)
+			Expect(uiUser.Rules).To(ContainElement(rbacv1.PolicyRule{
+				APIGroups:     []string{"lma.tigera.io"},
+				Resources:     []string{"*"},
+				ResourceNames: []string{"flows", "audit*", "l7", "events", "dns", "waf", "kibana_login", "recommendations"},
+				Verbs:         []string{"get"},
+			}))
+			networkAdmin := rtest.GetResource(resources, "tigera-network-admin", tenantANamespace, "rbac.authorization.k8s.io", "v1", "Role").(*rbacv1.Role)
+			Expect(networkAdmin.Rules).To(ContainElement(rbacv1.PolicyRule{
+				APIGroups:     []string{"lma.tigera.io"},
+				Resources:     []string{"*"},
+				ResourceNames: []string{"flows", "audit*", "l7", "events", "dns", "waf", "kibana_login", "elasticsearch_superuser", "recommendations"},
+				Verbs:         []string{"get"},
+			}))
+			for _, rule := range append(uiUser.Rules, networkAdmin.Rules...) {
+				Expect(rule.NonResourceURLs).To(BeEmpty())
+			}
+		})
+
 		It("should render multi-tenant environment variables", func() {
 			tenant := &operatorv1.Tenant{
 				ObjectMeta: metav1.ObjectMeta{
@@ -18,6 +18,7 @@ import (
 	"crypto/x509"
 	_ "embed"
 	"fmt"
+	"slices"
 	"strings"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -32,6 +33,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	"github.com/tigera/api/pkg/lib/numorstring"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
@@ -59,7 +61,12 @@ const (
 	CalicoPrometheusOperator       = "calico-prometheus-operator"
 	CalicoPrometheusOperatorSecret = "calico-prometheus-operator-secret"
 
-	TigeraPrometheusObjectName  = "tigera-prometheus"
+	TigeraPrometheusObjectName = "tigera-prometheus"
+
+	// PodSecurityStandard is the least privileged pod security standard that the Prometheus components run under.
+	// The containers that the Prometheus operator adds to the pods it manages do not meet the restricted level.
+	PodSecurityStandard render.PodSecurityStandard = render.PSSBaseline
+
 	TigeraPrometheusRule        = "calico"
 	TigeraPrometheusRole        = "tigera-prometheus-role"
 	TigeraPrometheusRoleBinding = "tigera-prometheus-role-binding"
@@ -98,6 +105,11 @@ const (
 	OperatorMetricsServiceName = "tigera-operator-metrics"
 	OperatorMetricsPortName    = "tigera-operator-metrics-port"
 	OperatorMetricsPort        = 9484
+
+	// The operator serves the health of its controllers on a separate plain HTTP port when HEALTH_ENABLED is set.
+	OperatorHealthServiceName = "tigera-operator-health"
+	OperatorHealthPortName    = "tigera-operator-health-port"
+	OperatorHealthPort        = 9485
 )
 
 var alertmanagerSelector = fmt.Sprintf(
@@ -168,11 +180,18 @@ type Config struct {
 	FelixPrometheusMetricsEnabled bool
 	LicenseExpired                bool
 
+	// FelixMetricsPort and FluentdMetricsPort are the ports that Felix and fluentd serve Prometheus metrics on.
+	FelixMetricsPort   int
+	FluentdMetricsPort int
+
 	// Operator metrics fields.
 	OperatorMetricsEnabled bool
 	OperatorNamespace      string
 	OperatorName           string
 	OperatorTLSSecret      certificatemanagement.KeyPairInterface
+
+	// OperatorHealthEnabled is true when the operator serves the health of its controllers.
+	OperatorHealthEnabled bool
 }
 
 type monitorComponent struct {
@@ -190,17 +209,17 @@ func (mc *monitorComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	errMsgs := []string{}
 	var err error
 
-	mc.alertmanagerImage, err = components.GetReference(components.ComponentPrometheusAlertmanager, reg, path, prefix, is)
+	mc.alertmanagerImage, err = components.GetReference(components.ComponentPrometheusAlertmanager, reg, path, prefix, is, mc.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
-	mc.prometheusImage, err = components.GetReference(components.ComponentPrometheus, reg, path, prefix, is)
+	mc.prometheusImage, err = components.GetReference(components.ComponentPrometheus, reg, path, prefix, is, mc.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
 
-	mc.prometheusServiceImage, err = components.GetReference(components.CombinedCalicoImage(mc.cfg.Installation), reg, path, prefix, is)
+	mc.prometheusServiceImage, err = components.GetReference(components.CombinedCalicoImage(mc.cfg.Installation), reg, path, prefix, is, mc.cfg.Installation.ComponentImages...)
 	if err != nil {
 		errMsgs = append(errMsgs, err.Error())
 	}
@@ -223,7 +242,7 @@ func (mc *monitorComponent) Objects() ([]client.Object, []client.Object) {
 		// - securityContext.capabilities.drop=["ALL"]
 		// - securityContext.runAsNonRoot=true
 		// - securityContext.seccompProfile.type to "RuntimeDefault" or "Localhost"
-		render.CreateNamespace(common.TigeraPrometheusNamespace, mc.cfg.Installation.KubernetesProvider, render.PSSBaseline, mc.cfg.Installation.Azure),
+		render.CreateNamespace(common.TigeraPrometheusNamespace, mc.cfg.Installation, PodSecurityStandard),
 	}
 
 	toCreate = append(toCreate, render.CreateOperatorSecretsRoleBinding(common.TigeraPrometheusNamespace))
@@ -317,6 +336,17 @@ func (mc *monitorComponent) Objects() ([]client.Object, []client.Object) {
 		toDelete = append(toDelete, mc.serviceOperatorMetrics(), mc.serviceMonitorOperator())
 	}
 
+	if mc.cfg.OperatorHealthEnabled {
+		toCreate = append(toCreate, mc.serviceOperatorHealth())
+		if mc.cfg.LicenseExpired {
+			toDelete = append(toDelete, mc.serviceMonitorOperatorHealth())
+		} else {
+			toCreate = append(toCreate, mc.serviceMonitorOperatorHealth())
+		}
+	} else {
+		toDelete = append(toDelete, mc.serviceOperatorHealth(), mc.serviceMonitorOperatorHealth())
+	}
+
 	if mc.cfg.Installation.TyphaMetricsPort != nil {
 		toCreate = append(toCreate, mc.typhaServiceMonitor())
 	} else {
@@ -979,6 +1009,22 @@ func (mc *monitorComponent) prometheusRule() *monitoringv1.PrometheusRule {
 		)
 	}
 
+	if mc.cfg.OperatorHealthEnabled {
+		forDuration15m := monitoringv1.Duration("15m")
+		rules = append(rules,
+			monitoringv1.Rule{
+				Alert:  "OperatorControllerNotReady",
+				Expr:   intstr.FromString("tigera_operator_controller_ready == 0"),
+				For:    &forDuration15m,
+				Labels: map[string]string{"severity": "warning"},
+				Annotations: map[string]string{
+					"summary":     "Operator controller {{ $labels.controller }} is not ready",
+					"description": "Operator controller {{ $labels.controller }} has been waiting for a watch or failing to reconcile for more than 15 minutes.",
+				},
+			},
+		)
+	}
+
 	return &monitoringv1.PrometheusRule{
 		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.PrometheusRuleKind, APIVersion: MonitoringAPIVersion},
 		ObjectMeta: metav1.ObjectMeta{
@@ -1148,6 +1194,9 @@ func (mc *monitorComponent) serviceMonitorFluentd() *monitoringv1.ServiceMonitor
 					Interval:      "5s",
 					Port:          render.FluentdMetricsPortName,
 					ScrapeTimeout: "5s",
+					// Fluentd ignores the token, unless the LogCollector MetricsAuth puts kube-rbac-proxy in front
+					// of its metrics.
+					BearerTokenFile: bearerTokenFile,
 					HTTPConfigWithProxyAndTLSFiles: monitoringv1.HTTPConfigWithProxyAndTLSFiles{
 						HTTPConfigWithTLSFiles: monitoringv1.HTTPConfigWithTLSFiles{
 							TLSConfig: mc.tlsConfig(render.FluentdPrometheusTLSSecretName),
@@ -1394,6 +1443,19 @@ func calicoSystemAlertmanagerMeshPolicy(cfg *Config) *v3.NetworkPolicy {
 }
 
 // Creates a network policy to allow traffic to access the Prometheus (TCP port 9095).
+// metricsPorts returns the given ports sorted and without duplicates, as the default ports of some of the components
+// are the same. Unset ports are skipped.
+func metricsPorts(ports ...int) []numorstring.Port {
+	var set []uint16
+	for _, p := range ports {
+		if p != 0 {
+			set = append(set, uint16(p))
+		}
+	}
+	slices.Sort(set)
+	return networkpolicy.Ports(slices.Compact(set)...)
+}
+
 func calicoSystemPrometheusPolicy(cfg *Config) *v3.NetworkPolicy {
 	egressRules := []v3.Rule{}
 	egressRules = networkpolicy.AppendDNSEgressRules(egressRules, cfg.OpenShift)
@@ -1407,8 +1469,8 @@ func calicoSystemPrometheusPolicy(cfg *Config) *v3.NetworkPolicy {
 			Action:   v3.Allow,
 			Protocol: &networkpolicy.TCPProtocol,
 			Destination: v3.EntityRule{
-				// Egress access for Felix metrics
-				Ports: networkpolicy.Ports(9081, 9091),
+				// Egress access for Elasticsearch, Felix and fluentd metrics
+				Ports: metricsPorts(esmetrics.ElasticsearchMetricsPort, cfg.FelixMetricsPort, cfg.FluentdMetricsPort),
 			},
 		},
 		{
@@ -1461,6 +1523,14 @@ func calicoSystemPrometheusPolicy(cfg *Config) *v3.NetworkPolicy {
 		})
 	}
 
+	if cfg.OperatorHealthEnabled {
+		egressRules = append(egressRules, v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: networkpolicy.CreateServiceSelectorEntityRule(cfg.OperatorNamespace, OperatorHealthServiceName),
+		})
+	}
+
 	typhaMetricsPort := cfg.Installation.TyphaMetricsPort
 	if typhaMetricsPort != nil {
 		egressRules = append(egressRules, v3.Rule{
@@ -1761,6 +1831,30 @@ func (mc *monitorComponent) externalServiceMonitor() (client.Object, bool) {
 }
 
 func (mc *monitorComponent) typhaServiceMonitor() client.Object {
+	endpoint := monitoringv1.Endpoint{
+		HonorLabels:   true,
+		Interval:      "5s",
+		Port:          render.TyphaMetricsName,
+		ScrapeTimeout: "5s",
+		RelabelConfigs: []monitoringv1.RelabelConfig{
+			{
+				TargetLabel: "__scheme__",
+				Replacement: ptr.To("http"),
+			},
+		},
+	}
+	if mc.cfg.Installation.TyphaMetricsTLSEnabled() {
+		endpoint.RelabelConfigs[0].Replacement = ptr.To("https")
+		endpoint.HTTPConfigWithProxyAndTLSFiles = monitoringv1.HTTPConfigWithProxyAndTLSFiles{
+			HTTPConfigWithTLSFiles: monitoringv1.HTTPConfigWithTLSFiles{
+				TLSConfig: mc.tlsConfig(render.TyphaMetricsName),
+			},
+		}
+	}
+	if mc.cfg.Installation.TyphaMetricsAuthEnabled() {
+		// kube-rbac-proxy authorizes the scrapes with the token of the Prometheus service account.
+		endpoint.BearerTokenFile = bearerTokenFile
+	}
 	return &monitoringv1.ServiceMonitor{
 		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: MonitoringAPIVersion},
 		ObjectMeta: metav1.ObjectMeta{
@@ -1768,20 +1862,7 @@ func (mc *monitorComponent) typhaServiceMonitor() client.Object {
 			Namespace: TigeraPrometheusObjectName,
 		},
 		Spec: monitoringv1.ServiceMonitorSpec{
-			Endpoints: []monitoringv1.Endpoint{
-				{
-					HonorLabels:   true,
-					Interval:      "5s",
-					Port:          render.TyphaMetricsName,
-					ScrapeTimeout: "5s",
-					RelabelConfigs: []monitoringv1.RelabelConfig{
-						{
-							TargetLabel: "__scheme__",
-							Replacement: ptr.To("http"),
-						},
-					},
-				},
-			},
+			Endpoints: []monitoringv1.Endpoint{endpoint},
 			NamespaceSelector: monitoringv1.NamespaceSelector{
 				MatchNames: []string{common.CalicoNamespace},
 			},
@@ -1822,6 +1903,63 @@ func (mc *monitorComponent) serviceOperatorMetrics() *corev1.Service {
 	}
 }
 
+// serviceOperatorHealth creates a Service for the operator's controller health endpoint in the operator namespace.
+func (mc *monitorComponent) serviceOperatorHealth() *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OperatorHealthServiceName,
+			Namespace: mc.cfg.OperatorNamespace,
+			Labels: map[string]string{
+				"k8s-app": mc.cfg.OperatorName,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       OperatorHealthPortName,
+					Port:       int32(OperatorHealthPort),
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromInt(OperatorHealthPort),
+				},
+			},
+			Selector: map[string]string{
+				"k8s-app": mc.cfg.OperatorName,
+			},
+		},
+	}
+}
+
+// serviceMonitorOperatorHealth creates a ServiceMonitor that scrapes the controller health metrics of the operator.
+func (mc *monitorComponent) serviceMonitorOperatorHealth() *monitoringv1.ServiceMonitor {
+	return &monitoringv1.ServiceMonitor{
+		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: MonitoringAPIVersion},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OperatorHealthServiceName,
+			Namespace: common.TigeraPrometheusNamespace,
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"k8s-app": mc.cfg.OperatorName,
+				},
+			},
+			NamespaceSelector: monitoringv1.NamespaceSelector{
+				MatchNames: []string{mc.cfg.OperatorNamespace},
+			},
+			Endpoints: []monitoringv1.Endpoint{
+				{
+					HonorLabels:   true,
+					Interval:      "30s",
+					Port:          OperatorHealthPortName,
+					ScrapeTimeout: "5s",
+				},
+			},
+		},
+	}
+}
+
 // serviceMonitorOperator creates a ServiceMonitor for the operator's metrics endpoint.
 func (mc *monitorComponent) serviceMonitorOperator() *monitoringv1.ServiceMonitor {
 	return &monitoringv1.ServiceMonitor{
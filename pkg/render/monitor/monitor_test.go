@@ -43,6 +43,7 @@ import (
 	"github.com/tigera/operator/pkg/dns"
 	"github.com/tigera/operator/pkg/render"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/networkpolicy"
 	rtest "github.com/tigera/operator/pkg/render/common/test"
 	"github.com/tigera/operator/pkg/render/monitor"
 	"github.com/tigera/operator/pkg/render/testutils"
@@ -108,6 +109,8 @@ var _ = Describe("monitor rendering tests", func() {
 			AlertmanagerConfigSecret: defaultAlertmanagerConfigSecret,
 			ClusterDomain:            "example.org",
 			TrustedCertBundle:        bundle,
+			FelixMetricsPort:         9091,
+			FluentdMetricsPort:       9081,
 		}
 	})
 
@@ -120,7 +123,7 @@ var _ = Describe("monitor rendering tests", func() {
 		expectedResources := expectedBaseResources()
 		rtest.ExpectResources(toCreate, expectedResources)
 
-		Expect(toDelete).To(HaveLen(5))
+		Expect(toDelete).To(HaveLen(7))
 
 		// Check the namespace.
 		namespace := rtest.GetResource(toCreate, "tigera-prometheus", "", "", "v1", "Namespace").(*corev1.Namespace)
@@ -177,7 +180,7 @@ var _ = Describe("monitor rendering tests", func() {
 		component := monitor.Monitor(cfg)
 		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
 		toCreate, toDelete := component.Objects()
-		Expect(toDelete).To(HaveLen(5))
+		Expect(toDelete).To(HaveLen(7))
 
 		// Prometheus
 		prometheusObj, ok := rtest.GetResource(toCreate, monitor.CalicoNodePrometheus, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", monitoringv1.PrometheusesKind).(*monitoringv1.Prometheus)
@@ -679,7 +682,7 @@ var _ = Describe("monitor rendering tests", func() {
 		expectedResources := expectedBaseResources()
 		rtest.ExpectResources(toCreate, expectedResources)
 
-		Expect(toDelete).To(HaveLen(5))
+		Expect(toDelete).To(HaveLen(7))
 
 		// Prometheus
 		prometheusObj, ok := rtest.GetResource(toCreate, monitor.CalicoNodePrometheus, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", monitoringv1.PrometheusesKind).(*monitoringv1.Prometheus)
@@ -839,6 +842,22 @@ var _ = Describe("monitor rendering tests", func() {
 
 			Expect(len(zeroedPolicy.Spec.Egress)).To(Equal(len(baselinePolicy.Spec.Egress) - 1))
 		})
+
+		It("prometheus policy should allow egress to the configured Felix and fluentd metrics ports", func() {
+			cfg.FelixMetricsPort = 9191
+			cfg.FluentdMetricsPort = 24231
+			component := monitor.MonitorPolicy(cfg)
+			resourcesToCreate, _ := component.Objects()
+			policy := testutils.GetCalicoSystemPolicyFromResources(types.NamespacedName{Name: "calico-system.prometheus", Namespace: "tigera-prometheus"}, resourcesToCreate)
+
+			Expect(policy.Spec.Egress).To(ContainElement(v3.Rule{
+				Action:   v3.Allow,
+				Protocol: &networkpolicy.TCPProtocol,
+				Destination: v3.EntityRule{
+					Ports: networkpolicy.Ports(9081, 9191, 24231),
+				},
+			}))
+		})
 	})
 
 	It("Should render external prometheus resources with service monitor", func() {
@@ -869,7 +888,7 @@ var _ = Describe("monitor rendering tests", func() {
 		)
 
 		rtest.ExpectResources(toCreate, expectedResources)
-		Expect(toDelete).To(HaveLen(5))
+		Expect(toDelete).To(HaveLen(7))
 	})
 
 	It("Should render external prometheus resources with service monitor and custom token", func() {
@@ -895,7 +914,7 @@ var _ = Describe("monitor rendering tests", func() {
 		)
 
 		rtest.ExpectResources(toCreate, expectedResources)
-		Expect(toDelete).To(HaveLen(5))
+		Expect(toDelete).To(HaveLen(7))
 	})
 
 	It("Should render external prometheus resources without service monitor", func() {
@@ -911,7 +930,7 @@ var _ = Describe("monitor rendering tests", func() {
 		)
 
 		rtest.ExpectResources(toCreate, expectedResources)
-		Expect(toDelete).To(HaveLen(5))
+		Expect(toDelete).To(HaveLen(7))
 	})
 
 	It("Should render typha service monitor if typha metrics are enabled", func() {
@@ -925,7 +944,7 @@ var _ = Describe("monitor rendering tests", func() {
 		)
 
 		rtest.ExpectResources(toCreate, expectedResources)
-		Expect(toDelete).To(HaveLen(4))
+		Expect(toDelete).To(HaveLen(6))
 		sm := rtest.GetResource(toCreate, "calico-typha-metrics", "tigera-prometheus", "monitoring.coreos.com", "v1", "ServiceMonitor").(*monitoringv1.ServiceMonitor)
 		Expect(sm).To(Equal(&monitoringv1.ServiceMonitor{
 			TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: "monitoring.coreos.com/v1"},
@@ -960,6 +979,33 @@ var _ = Describe("monitor rendering tests", func() {
 		}))
 	})
 
+	It("Should scrape typha metrics over TLS if enabled", func() {
+		cfg.Installation.TyphaMetricsPort = ptr.To(int32(9093))
+		cfg.Installation.TyphaMetricsTLS = ptr.To(operatorv1.TyphaMetricsTLSEnabled)
+		component := monitor.Monitor(cfg)
+		toCreate, _ := component.Objects()
+		sm := rtest.GetResource(toCreate, "calico-typha-metrics", "tigera-prometheus", "monitoring.coreos.com", "v1", "ServiceMonitor").(*monitoringv1.ServiceMonitor)
+		Expect(sm.Spec.Endpoints).To(HaveLen(1))
+		Expect(*sm.Spec.Endpoints[0].RelabelConfigs[0].Replacement).To(Equal("https"))
+		Expect(sm.Spec.Endpoints[0].TLSConfig).NotTo(BeNil())
+		Expect(*sm.Spec.Endpoints[0].TLSConfig.ServerName).To(Equal("calico-typha-metrics"))
+		Expect(sm.Spec.Endpoints[0].TLSConfig.CAFile).To(Equal("/etc/pki/tls/certs/tigera-ca-bundle.crt"))
+	})
+
+	It("Should scrape typha metrics with a bearer token if they require authorization", func() {
+		cfg.Installation.TyphaMetricsPort = ptr.To(int32(9093))
+		component := monitor.Monitor(cfg)
+		toCreate, _ := component.Objects()
+		sm := rtest.GetResource(toCreate, "calico-typha-metrics", "tigera-prometheus", "monitoring.coreos.com", "v1", "ServiceMonitor").(*monitoringv1.ServiceMonitor)
+		Expect(sm.Spec.Endpoints[0].BearerTokenFile).To(BeEmpty())
+
+		cfg.Installation.TyphaMetricsAuth = ptr.To(operatorv1.MetricsAuthTokenReview)
+		toCreate, _ = monitor.Monitor(cfg).Objects()
+		sm = rtest.GetResource(toCreate, "calico-typha-metrics", "tigera-prometheus", "monitoring.coreos.com", "v1", "ServiceMonitor").(*monitoringv1.ServiceMonitor)
+		Expect(*sm.Spec.Endpoints[0].RelabelConfigs[0].Replacement).To(Equal("https"))
+		Expect(sm.Spec.Endpoints[0].BearerTokenFile).To(Equal("/var/run/secrets/kubernetes.io/serviceaccount/token"))
+	})
+
 	It("Should render serviceMonitor with felix endpoint if FelixPrometheusMetricsEnabled", func() {
 		cfg.FelixPrometheusMetricsEnabled = true
 		component := monitor.Monitor(cfg)
@@ -1064,8 +1110,8 @@ var _ = Describe("monitor rendering tests", func() {
 		serviceMonitor := sm.(*monitoringv1.ServiceMonitor)
 		Expect(serviceMonitor.Spec.Endpoints[0].Port).To(Equal(monitor.OperatorMetricsPortName))
 
-		// Neither should be in toDelete (only PodMonitor, Deployment, typhaServiceMonitor).
-		Expect(toDelete).To(HaveLen(3))
+		// Neither should be in toDelete (only PodMonitor, Deployment, typhaServiceMonitor and the operator health Service and ServiceMonitor).
+		Expect(toDelete).To(HaveLen(5))
 	})
 
 	It("Should include operator alert rules in PrometheusRule when OperatorMetricsEnabled is true", func() {
@@ -1144,6 +1190,43 @@ var _ = Describe("monitor rendering tests", func() {
 		Expect(rules[8].Annotations["description"]).To(Equal("Component {{ $labels.component }} has been in a progressing state for more than 30 minutes."))
 	})
 
+	It("Should create operator health Service, ServiceMonitor and alert rule when OperatorHealthEnabled is true", func() {
+		cfg.OperatorHealthEnabled = true
+		cfg.OperatorNamespace = "tigera-operator"
+		cfg.OperatorName = "tigera-operator"
+		component := monitor.Monitor(cfg)
+		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+		toCreate, toDelete := component.Objects()
+
+		service := rtest.GetResource(toCreate, monitor.OperatorHealthServiceName, "tigera-operator", "", "v1", "Service").(*corev1.Service)
+		Expect(service.Spec.Ports[0].Port).To(Equal(int32(monitor.OperatorHealthPort)))
+		Expect(service.Spec.Selector["k8s-app"]).To(Equal("tigera-operator"))
+
+		serviceMonitor := rtest.GetResource(toCreate, monitor.OperatorHealthServiceName, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", "ServiceMonitor").(*monitoringv1.ServiceMonitor)
+		Expect(serviceMonitor.Spec.Endpoints).To(HaveLen(1))
+		Expect(serviceMonitor.Spec.Endpoints[0].Port).To(Equal(monitor.OperatorHealthPortName))
+		Expect(serviceMonitor.Spec.NamespaceSelector.MatchNames).To(ConsistOf("tigera-operator"))
+
+		for _, obj := range toDelete {
+			Expect(obj.GetName()).NotTo(Equal(monitor.OperatorHealthServiceName))
+		}
+
+		rule := rtest.GetResource(toCreate, monitor.TigeraPrometheusRule, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", "PrometheusRule").(*monitoringv1.PrometheusRule)
+		var alerts []string
+		for _, r := range rule.Spec.Groups[0].Rules {
+			alerts = append(alerts, r.Alert)
+		}
+		Expect(alerts).To(ContainElement("OperatorControllerNotReady"))
+
+		toCreate, _ = monitor.MonitorPolicy(cfg).Objects()
+		policy := rtest.GetResource(toCreate, monitor.PrometheusPolicyName, common.TigeraPrometheusNamespace, "projectcalico.org", "v3", "NetworkPolicy").(*v3.NetworkPolicy)
+		Expect(policy.Spec.Egress).To(ContainElement(v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: networkpolicy.CreateServiceSelectorEntityRule("tigera-operator", monitor.OperatorHealthServiceName),
+		}))
+	})
+
 	It("Should delete operator metrics resources when OperatorMetricsEnabled is false", func() {
 		cfg.OperatorMetricsEnabled = false
 		cfg.OperatorNamespace = "tigera-operator"
@@ -15,6 +15,9 @@
 package render
 
 import (
+	"fmt"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -53,7 +56,7 @@ func (c *namespaceComponent) SupportedOSType() rmeta.OSType {
 
 func (c *namespaceComponent) Objects() ([]client.Object, []client.Object) {
 	ns := []client.Object{
-		CreateNamespace(common.CalicoNamespace, c.cfg.Installation.KubernetesProvider, PSSPrivileged, c.cfg.Installation.Azure),
+		CreateNamespace(common.CalicoNamespace, c.cfg.Installation, CalicoSystemPodSecurityStandard),
 		CreateOperatorSecretsRoleBinding(common.CalicoNamespace),
 	}
 
@@ -86,7 +89,48 @@ const (
 	PSSRestricted = "restricted"
 )
 
-func CreateNamespace(name string, provider operatorv1.Provider, pss PodSecurityStandard, azure *operatorv1.Azure) *corev1.Namespace {
+// CalicoSystemPodSecurityStandard is the least privileged pod security standard that the components in the
+// calico-system namespace run under. calico-node and the CSI driver need host access.
+const CalicoSystemPodSecurityStandard PodSecurityStandard = PSSPrivileged
+
+// pssRestrictiveness orders the pod security standards from least to most restrictive.
+var pssRestrictiveness = map[PodSecurityStandard]int{
+	PSSPrivileged: 0,
+	PSSBaseline:   1,
+	PSSRestricted: 2,
+}
+
+// ResolvePodSecurityStandard returns the pod security standard to enforce on the given namespace. The namespace is
+// labeled with the default level, unless the Installation forces a level on it. A forced level must not be more
+// restrictive than the required level, the least privileged level that the components of the namespace can run
+// under; otherwise the default level is returned along with an error.
+func ResolvePodSecurityStandard(installation *operatorv1.InstallationSpec, namespace string, def, required PodSecurityStandard) (PodSecurityStandard, error) {
+	if installation == nil {
+		return def, nil
+	}
+	for _, nss := range installation.NamespacePodSecurityStandards {
+		if nss.Namespace != namespace {
+			continue
+		}
+		forced := PodSecurityStandard(strings.ToLower(string(nss.Level)))
+		if pssRestrictiveness[forced] > pssRestrictiveness[required] {
+			return def, fmt.Errorf("pod security standard %q is forced on namespace %s, but its components need %q", forced, namespace, required)
+		}
+		return forced, nil
+	}
+	return def, nil
+}
+
+// CreateNamespace returns the given operator-managed namespace, whose components need the required pod security
+// standard. The namespace enforces the level resolved by ResolvePodSecurityStandard, with the required level as the
+// default. A forced level that is too restrictive is reported when validating the Installation, or by the controller
+// of the namespace, so the required level is enforced in that case.
+func CreateNamespace(name string, installation *operatorv1.InstallationSpec, required PodSecurityStandard) *corev1.Namespace {
+	pss, _ := ResolvePodSecurityStandard(installation, name, required, required)
+	return newNamespace(name, installation.KubernetesProvider, pss, installation.Azure)
+}
+
+func newNamespace(name string, provider operatorv1.Provider, pss PodSecurityStandard, azure *operatorv1.Azure) *corev1.Namespace {
 	ns := &corev1.Namespace{
 		TypeMeta: metav1.TypeMeta{Kind: "Namespace", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
@@ -134,4 +134,78 @@ var _ = Describe("Namespace rendering tests", func() {
 		Expect(meta.GetLabels()).NotTo(ContainElement("control-plane"))
 		rtest.ExpectResourceTypeAndObjectMetadata(resources[1], "tigera-operator-secrets", "calico-system", "rbac.authorization.k8s.io", "v1", "RoleBinding")
 	})
+
+	It("should render the pod security standard forced by the Installation", func() {
+		cfg.Installation.NamespacePodSecurityStandards = []operatorv1.NamespacePodSecurityStandard{
+			{Namespace: "tigera-fluentd", Level: operatorv1.PodSecurityStandardBaseline},
+		}
+		setUpCfg := &render.SetUpConfiguration{
+			Installation:    cfg.Installation,
+			Namespace:       "tigera-fluentd",
+			PSS:             render.PSSRestricted,
+			CreateNamespace: true,
+		}
+		pss, err := setUpCfg.PodSecurityStandard()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pss).To(Equal(render.PodSecurityStandard(render.PSSBaseline)))
+
+		resources, _ := render.NewSetup(setUpCfg).Objects()
+		namespace := rtest.GetResource(resources, "tigera-fluentd", "", "", "v1", "Namespace").(*corev1.Namespace)
+		Expect(namespace.Labels["pod-security.kubernetes.io/enforce"]).To(Equal("baseline"))
+	})
+
+	It("should reject a forced pod security standard that is too restrictive for the namespace", func() {
+		cfg.Installation.NamespacePodSecurityStandards = []operatorv1.NamespacePodSecurityStandard{
+			{Namespace: "tigera-fluentd", Level: operatorv1.PodSecurityStandardRestricted},
+		}
+		setUpCfg := &render.SetUpConfiguration{
+			Installation:    cfg.Installation,
+			Namespace:       "tigera-fluentd",
+			PSS:             render.PSSBaseline,
+			CreateNamespace: true,
+		}
+		_, err := setUpCfg.PodSecurityStandard()
+		Expect(err).To(HaveOccurred())
+
+		resources, _ := render.NewSetup(setUpCfg).Objects()
+		namespace := rtest.GetResource(resources, "tigera-fluentd", "", "", "v1", "Namespace").(*corev1.Namespace)
+		Expect(namespace.Labels["pod-security.kubernetes.io/enforce"]).To(Equal("baseline"))
+	})
+
+	It("should enforce the pod security standard forced on the calico-system namespace", func() {
+		cfg.Installation.NamespacePodSecurityStandards = []operatorv1.NamespacePodSecurityStandard{
+			{Namespace: "calico-system", Level: operatorv1.PodSecurityStandardPrivileged},
+			{Namespace: "tigera-dex", Level: operatorv1.PodSecurityStandardRestricted},
+		}
+		resources, _ := render.Namespaces(cfg).Objects()
+		namespace := rtest.GetResource(resources, "calico-system", "", "", "v1", "Namespace").(*corev1.Namespace)
+		Expect(namespace.Labels["pod-security.kubernetes.io/enforce"]).To(Equal("privileged"))
+
+		// A level that is too restrictive for the components of the namespace is not enforced.
+		cfg.Installation.NamespacePodSecurityStandards[0].Level = operatorv1.PodSecurityStandardBaseline
+		resources, _ = render.Namespaces(cfg).Objects()
+		namespace = rtest.GetResource(resources, "calico-system", "", "", "v1", "Namespace").(*corev1.Namespace)
+		Expect(namespace.Labels["pod-security.kubernetes.io/enforce"]).To(Equal("privileged"))
+	})
+
+	It("should allow forcing a level between the default and the required pod security standards", func() {
+		cfg.Installation.NamespacePodSecurityStandards = []operatorv1.NamespacePodSecurityStandard{
+			{Namespace: "tigera-elasticsearch", Level: operatorv1.PodSecurityStandardRestricted},
+		}
+		setUpCfg := &render.SetUpConfiguration{
+			Installation:    cfg.Installation,
+			Namespace:       "tigera-elasticsearch",
+			PSS:             render.PSSBaseline,
+			RequiredPSS:     render.PSSRestricted,
+			CreateNamespace: true,
+		}
+		pss, err := setUpCfg.PodSecurityStandard()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pss).To(Equal(render.PodSecurityStandard(render.PSSRestricted)))
+
+		cfg.Installation.NamespacePodSecurityStandards = nil
+		resources, _ := render.NewSetup(setUpCfg).Objects()
+		namespace := rtest.GetResource(resources, "tigera-elasticsearch", "", "", "v1", "Namespace").(*corev1.Namespace)
+		Expect(namespace.Labels["pod-security.kubernetes.io/enforce"]).To(Equal("baseline"))
+	})
 })
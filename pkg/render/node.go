@@ -28,6 +28,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
@@ -184,16 +185,16 @@ func (c *nodeComponent) ResolveImages(is *operatorv1.ImageSet) error {
 		return imageName
 	}
 
-	combinedRef := appendIfErr(components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is))
+	combinedRef := appendIfErr(components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...))
 	c.cniImage = combinedRef
 	c.flexvolImage = combinedRef
 	switch {
 	case c.cfg.Installation.Variant.IsEnterprise():
-		c.nodeImage = appendIfErr(components.GetReference(components.ComponentTigeraNode, reg, path, prefix, is))
+		c.nodeImage = appendIfErr(components.GetReference(components.ComponentTigeraNode, reg, path, prefix, is, c.cfg.Installation.ComponentImages...))
 	case operatorv1.IsFIPSModeEnabled(c.cfg.Installation.FIPSMode):
-		c.nodeImage = appendIfErr(components.GetReference(components.ComponentCalicoNodeFIPS, reg, path, prefix, is))
+		c.nodeImage = appendIfErr(components.GetReference(components.ComponentCalicoNodeFIPS, reg, path, prefix, is, c.cfg.Installation.ComponentImages...))
 	default:
-		c.nodeImage = appendIfErr(components.GetReference(components.ComponentCalicoNode, reg, path, prefix, is))
+		c.nodeImage = appendIfErr(components.GetReference(components.ComponentCalicoNode, reg, path, prefix, is, c.cfg.Installation.ComponentImages...))
 	}
 
 	if len(errMsgs) != 0 {
@@ -269,6 +270,10 @@ func (c *nodeComponent) Ready() bool {
 	return true
 }
 
+func (c *nodeComponent) GarbageCollectionIdentity() string {
+	return "node"
+}
+
 // CNIPluginFinalizedObjects returns a list of objects that use the CNIFinalizer that should be
 // removed only after the CNI plugin is removed.
 func CNIPluginFinalizedObjects() []client.Object {
@@ -1879,6 +1884,46 @@ func GetIPv6Pool(pools []operatorv1.IPPool) *operatorv1.IPPool {
 	return nil
 }
 
+// isIPv6Only returns true if the installation only has IPv6 IP pools.
+func isIPv6Only(instance *operatorv1.InstallationSpec) bool {
+	if instance.CalicoNetwork == nil {
+		return false
+	}
+	pools := instance.CalicoNetwork.IPPools
+	return GetIPv6Pool(pools) != nil && GetIPv4Pool(pools) == nil
+}
+
+// isDualStack returns true if the installation has both IPv4 and IPv6 IP pools.
+func isDualStack(instance *operatorv1.InstallationSpec) bool {
+	if instance.CalicoNetwork == nil {
+		return false
+	}
+	pools := instance.CalicoNetwork.IPPools
+	return GetIPv6Pool(pools) != nil && GetIPv4Pool(pools) != nil
+}
+
+// setServiceIPFamilies configures the IP families of the given Service to match the installation's IP pools.
+// IPv4-only installations are left to the cluster defaults. For dual-stack installations we only set the
+// policy, so that the cluster's primary IP family stays first and existing Services can be updated in place.
+func setServiceIPFamilies(svc *corev1.Service, instance *operatorv1.InstallationSpec) {
+	switch {
+	case isIPv6Only(instance):
+		svc.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv6Protocol}
+		svc.Spec.IPFamilyPolicy = ptr.To(corev1.IPFamilyPolicySingleStack)
+	case isDualStack(instance):
+		svc.Spec.IPFamilyPolicy = ptr.To(corev1.IPFamilyPolicyPreferDualStack)
+	}
+}
+
+// wildcardHost returns the address a component should bind to in order to listen on all interfaces.
+func wildcardHost(instance *operatorv1.InstallationSpec) string {
+	if isIPv6Only(instance) || isDualStack(instance) {
+		// On Linux, binding to :: also accepts IPv4 connections.
+		return "::"
+	}
+	return "0.0.0.0"
+}
+
 // bgpEnabled returns true if the given Installation enables BGP, false otherwise.
 func bgpEnabled(instance *operatorv1.InstallationSpec) bool {
 	return instance.CalicoNetwork != nil &&
@@ -199,7 +199,12 @@ func (c *nonClusterHostComponent) clusterRole() *rbacv1.ClusterRole {
 		},
 	}...)
 
-	// For non-cluster host to request a operator signed certificate.
+	// For non-cluster host to request a operator signed certificate. The fluentd input client certificate is only
+	// granted when the fluentd input service requires client certificates.
+	commonNames := []string{render.TyphaCommonName + render.TyphaNonClusterHostSuffix}
+	if c.cfg.NonClusterHost.LogInputClientAuth != nil && *c.cfg.NonClusterHost.LogInputClientAuth == operatorv1.LogInputClientAuthClientCertificate {
+		commonNames = append(commonNames, render.FluentdInputClientCommonName)
+	}
 	rules = append(rules, []rbacv1.PolicyRule{
 		{
 			APIGroups: []string{"certificates.k8s.io"},
@@ -210,7 +215,7 @@ func (c *nonClusterHostComponent) clusterRole() *rbacv1.ClusterRole {
 			APIGroups:     []string{"certificates.tigera.io"},
 			Resources:     []string{"certificatesigningrequests/common-name"},
 			Verbs:         []string{"create"},
-			ResourceNames: []string{render.TyphaCommonName + render.TyphaNonClusterHostSuffix},
+			ResourceNames: commonNames,
 		},
 	}...)
 
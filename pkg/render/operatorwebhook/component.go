@@ -0,0 +1,170 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatorwebhook
+
+import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/render"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
+)
+
+const (
+	// ServiceName is the name of the Service that fronts the operator's admission webhook.
+	ServiceName = "tigera-operator-webhook"
+
+	// TLSSecretName is the name of the keypair served by the operator's admission webhook. It lives in the
+	// operator namespace and is read directly by the webhook server.
+	TLSSecretName = "tigera-operator-webhook-tls"
+
+	// ConfigurationName is the name of the ValidatingWebhookConfiguration for the operator's custom resources.
+	ConfigurationName = "operator.tigera.io"
+
+	// Port is the port the operator's webhook server listens on.
+	Port = 9443
+
+	// The paths the validating webhooks for each of the operator's custom resources are served on.
+	InstallationPath = "/validate-installation"
+	APIServerPath    = "/validate-apiserver"
+	LogCollectorPath = "/validate-logcollector"
+	LogSourcePath    = "/validate-logsource"
+	TenantPath       = "/validate-tenant"
+)
+
+// Configuration contains all the config information needed to render the component.
+type Configuration struct {
+	// Enabled is true when the operator serves the admission webhook. When false, the objects are removed.
+	Enabled bool
+
+	// OperatorName and OperatorNamespace identify the operator pods that serve the webhook.
+	OperatorName      string
+	OperatorNamespace string
+
+	// KeyPair is the keypair served by the webhook. Its certificate is used as the CA bundle of the webhooks.
+	KeyPair certificatemanagement.KeyPairInterface
+}
+
+// OperatorWebhook renders the Service and ValidatingWebhookConfiguration that route admission requests for the
+// operator's own custom resources to the operator.
+func OperatorWebhook(cfg *Configuration) render.Component {
+	return &component{cfg: cfg}
+}
+
+type component struct {
+	cfg *Configuration
+}
+
+func (c *component) ResolveImages(is *operatorv1.ImageSet) error {
+	return nil
+}
+
+func (c *component) SupportedOSType() rmeta.OSType {
+	return rmeta.OSTypeAny
+}
+
+func (c *component) Objects() ([]client.Object, []client.Object) {
+	objs := []client.Object{c.service(), c.validatingWebhookConfiguration()}
+	if !c.cfg.Enabled {
+		return nil, objs
+	}
+	return objs, nil
+}
+
+func (c *component) Ready() bool {
+	return true
+}
+
+func (c *component) service() *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServiceName,
+			Namespace: c.cfg.OperatorNamespace,
+			Labels:    map[string]string{"k8s-app": c.cfg.OperatorName},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "webhook",
+					Port:       443,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromInt32(Port),
+				},
+			},
+			Selector: map[string]string{"k8s-app": c.cfg.OperatorName},
+		},
+	}
+}
+
+func (c *component) validatingWebhookConfiguration() *admissionregistrationv1.ValidatingWebhookConfiguration {
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		TypeMeta:   metav1.TypeMeta{Kind: "ValidatingWebhookConfiguration", APIVersion: "admissionregistration.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigurationName},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			c.webhook("installations", InstallationPath, admissionregistrationv1.ClusterScope),
+			c.webhook("apiservers", APIServerPath, admissionregistrationv1.ClusterScope),
+			c.webhook("logcollectors", LogCollectorPath, admissionregistrationv1.ClusterScope),
+			c.webhook("logsources", LogSourcePath, admissionregistrationv1.NamespacedScope),
+			c.webhook("tenants", TenantPath, admissionregistrationv1.NamespacedScope),
+		},
+	}
+}
+
+// webhook returns a validating webhook for creates and updates of the given operator resource. Failures to reach the
+// operator are ignored so that a broken operator never blocks the changes needed to fix it; the controllers still
+// validate each resource and report errors through TigeraStatus.
+func (c *component) webhook(resource, path string, scope admissionregistrationv1.ScopeType) admissionregistrationv1.ValidatingWebhook {
+	var caBundle []byte
+	if c.cfg.KeyPair != nil {
+		caBundle = c.cfg.KeyPair.GetCertificatePEM()
+	}
+	return admissionregistrationv1.ValidatingWebhook{
+		Name: resource + "." + ConfigurationName,
+		Rules: []admissionregistrationv1.RuleWithOperations{
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Create,
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   []string{operatorv1.GroupVersion.Group},
+					APIVersions: []string{operatorv1.GroupVersion.Version},
+					Resources:   []string{resource},
+					Scope:       ptr.To(scope),
+				},
+			},
+		},
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			Service: &admissionregistrationv1.ServiceReference{
+				Namespace: c.cfg.OperatorNamespace,
+				Name:      ServiceName,
+				Path:      ptr.To(path),
+			},
+			CABundle: caBundle,
+		},
+		AdmissionReviewVersions: []string{"v1"},
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+		TimeoutSeconds:          ptr.To(int32(5)),
+		FailurePolicy:           ptr.To(admissionregistrationv1.Ignore),
+	}
+}
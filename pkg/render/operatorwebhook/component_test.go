@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatorwebhook_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/tigera/operator/pkg/apis"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/certificatemanager"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/dns"
+	rtest "github.com/tigera/operator/pkg/render/common/test"
+	"github.com/tigera/operator/pkg/render/operatorwebhook"
+)
+
+var _ = Describe("Operator webhook rendering tests", func() {
+	var cfg *operatorwebhook.Configuration
+
+	expectedResources := []client.Object{
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: operatorwebhook.ServiceName, Namespace: "tigera-operator"}, TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: operatorwebhook.ConfigurationName}, TypeMeta: metav1.TypeMeta{Kind: "ValidatingWebhookConfiguration", APIVersion: "admissionregistration.k8s.io/v1"}},
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme, false)).NotTo(HaveOccurred())
+		cli := ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+
+		certificateManager, err := certificatemanager.Create(cli, nil, dns.DefaultClusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+		Expect(err).NotTo(HaveOccurred())
+		kp, err := certificateManager.GetOrCreateKeyPair(cli, operatorwebhook.TLSSecretName, common.OperatorNamespace(),
+			dns.GetServiceDNSNames(operatorwebhook.ServiceName, common.OperatorNamespace(), dns.DefaultClusterDomain))
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg = &operatorwebhook.Configuration{
+			Enabled:           true,
+			OperatorName:      "tigera-operator",
+			OperatorNamespace: "tigera-operator",
+			KeyPair:           kp,
+		}
+	})
+
+	It("should render the webhook service and configuration", func() {
+		toCreate, toDelete := operatorwebhook.OperatorWebhook(cfg).Objects()
+		rtest.ExpectResources(toCreate, expectedResources)
+		Expect(toDelete).To(BeEmpty())
+
+		svc := rtest.GetResource(toCreate, operatorwebhook.ServiceName, "tigera-operator", "", "v1", "Service").(*corev1.Service)
+		Expect(svc.Spec.Selector).To(Equal(map[string]string{"k8s-app": "tigera-operator"}))
+		Expect(svc.Spec.Ports).To(HaveLen(1))
+		Expect(svc.Spec.Ports[0].TargetPort.IntValue()).To(Equal(operatorwebhook.Port))
+
+		vwc := rtest.GetResource(toCreate, operatorwebhook.ConfigurationName, "", "admissionregistration.k8s.io", "v1", "ValidatingWebhookConfiguration").(*admissionregistrationv1.ValidatingWebhookConfiguration)
+		paths := map[string]string{}
+		for _, w := range vwc.Webhooks {
+			Expect(w.Rules).To(HaveLen(1))
+			Expect(w.Rules[0].APIGroups).To(Equal([]string{"operator.tigera.io"}))
+			Expect(w.Rules[0].Resources).To(HaveLen(1))
+			Expect(w.ClientConfig.Service.Name).To(Equal(operatorwebhook.ServiceName))
+			Expect(w.ClientConfig.Service.Namespace).To(Equal("tigera-operator"))
+			Expect(w.ClientConfig.CABundle).To(Equal(cfg.KeyPair.GetCertificatePEM()))
+			// A broken operator must not block the changes needed to fix it.
+			Expect(*w.FailurePolicy).To(Equal(admissionregistrationv1.Ignore))
+			paths[w.Rules[0].Resources[0]] = *w.ClientConfig.Service.Path
+		}
+		Expect(paths).To(Equal(map[string]string{
+			"installations": operatorwebhook.InstallationPath,
+			"apiservers":    operatorwebhook.APIServerPath,
+			"logcollectors": operatorwebhook.LogCollectorPath,
+			"logsources":    operatorwebhook.LogSourcePath,
+			"tenants":       operatorwebhook.TenantPath,
+		}))
+	})
+
+	It("should remove the webhook service and configuration when disabled", func() {
+		cfg.Enabled = false
+		toCreate, toDelete := operatorwebhook.OperatorWebhook(cfg).Objects()
+		Expect(toCreate).To(BeEmpty())
+		rtest.ExpectResources(toDelete, expectedResources)
+	})
+})
@@ -48,9 +48,13 @@ const (
 	PacketCaptureClusterRoleBindingName = PacketCaptureName
 	PacketCaptureDeploymentName         = PacketCaptureName
 	PacketCaptureServiceName            = PacketCaptureName
-	PacketCapturePolicyName             = networkpolicy.CalicoComponentPolicyPrefix + PacketCaptureName
-	PacketCapturePort                   = 8444
-	PacketCaptureServerCert             = "tigera-packetcapture-server-tls"
+
+	// PacketCapturePodSecurityStandard is the least privileged pod security standard that the packet capture API
+	// runs under.
+	PacketCapturePodSecurityStandard PodSecurityStandard = PSSRestricted
+	PacketCapturePolicyName                              = networkpolicy.CalicoComponentPolicyPrefix + PacketCaptureName
+	PacketCapturePort                                    = 8444
+	PacketCaptureServerCert                              = "tigera-packetcapture-server-tls"
 )
 
 var (
@@ -99,7 +103,7 @@ func (pc *packetCaptureApiComponent) ResolveImages(is *operatorv1.ImageSet) erro
 	prefix := pc.cfg.Installation.ImagePrefix
 
 	var err error
-	pc.image, err = components.GetReference(components.CombinedCalicoImage(pc.cfg.Installation), reg, path, prefix, is)
+	pc.image, err = components.GetReference(components.CombinedCalicoImage(pc.cfg.Installation), reg, path, prefix, is, pc.cfg.Installation.ComponentImages...)
 	if err != nil {
 		return err
 	}
@@ -112,7 +116,7 @@ func (pc *packetCaptureApiComponent) SupportedOSType() rmeta.OSType {
 
 func (pc *packetCaptureApiComponent) Objects() ([]client.Object, []client.Object) {
 	objs := []client.Object{
-		CreateNamespace(PacketCaptureNamespace, pc.cfg.Installation.KubernetesProvider, PSSRestricted, pc.cfg.Installation.Azure),
+		CreateNamespace(PacketCaptureNamespace, pc.cfg.Installation, PacketCapturePodSecurityStandard),
 	}
 
 	objs = append(objs, CreateOperatorSecretsRoleBinding(PacketCaptureNamespace))
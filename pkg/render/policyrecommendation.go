@@ -97,7 +97,7 @@ func (pr *policyRecommendationComponent) ResolveImages(is *operatorv1.ImageSet)
 	prefix := pr.cfg.Installation.ImagePrefix
 
 	var err error
-	pr.image, err = components.GetReference(components.CombinedCalicoImage(pr.cfg.Installation), reg, path, prefix, is)
+	pr.image, err = components.GetReference(components.CombinedCalicoImage(pr.cfg.Installation), reg, path, prefix, is, pr.cfg.Installation.ComponentImages...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+)
+
+const (
+	DataPlanePriorityClassName    = "calico-data-plane"
+	ControlPlanePriorityClassName = "calico-control-plane"
+
+	// The highest priority that can be given to a PriorityClass that isn't built into Kubernetes. As with the
+	// system-node-critical and system-cluster-critical classes, data plane pods are ranked above control plane pods.
+	dataPlanePriority    = int32(1000000000)
+	controlPlanePriority = dataPlanePriority - 1000
+)
+
+// PriorityClasses renders the PriorityClasses that the operator assigns to its components when
+// Installation.Spec.ManagedPriorityClasses is enabled. They are deleted when it is not.
+func PriorityClasses(installation *operatorv1.InstallationSpec) Component {
+	return &priorityClassesComponent{installation: installation}
+}
+
+type priorityClassesComponent struct {
+	installation *operatorv1.InstallationSpec
+}
+
+func (c *priorityClassesComponent) ResolveImages(is *operatorv1.ImageSet) error {
+	return nil
+}
+
+func (c *priorityClassesComponent) SupportedOSType() rmeta.OSType {
+	return rmeta.OSTypeAny
+}
+
+func (c *priorityClassesComponent) Objects() ([]client.Object, []client.Object) {
+	objs := []client.Object{
+		priorityClass(DataPlanePriorityClassName, dataPlanePriority, "Used by Calico components that provide networking and policy to the pods on a node."),
+		priorityClass(ControlPlanePriorityClassName, controlPlanePriority, "Used by Calico components that serve the cluster as a whole."),
+	}
+	if c.installation.ManagedPriorityClassesEnabled() {
+		return objs, nil
+	}
+	return nil, objs
+}
+
+func (c *priorityClassesComponent) Ready() bool {
+	return true
+}
+
+func priorityClass(name string, value int32, description string) *schedulingv1.PriorityClass {
+	return &schedulingv1.PriorityClass{
+		TypeMeta:    metav1.TypeMeta{Kind: "PriorityClass", APIVersion: "scheduling.k8s.io/v1"},
+		ObjectMeta:  metav1.ObjectMeta{Name: name},
+		Value:       value,
+		Description: description,
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/render"
+	rtest "github.com/tigera/operator/pkg/render/common/test"
+)
+
+var _ = Describe("PriorityClass rendering tests", func() {
+	expectedResources := []client.Object{
+		&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: render.DataPlanePriorityClassName}},
+		&schedulingv1.PriorityClass{ObjectMeta: metav1.ObjectMeta{Name: render.ControlPlanePriorityClassName}},
+	}
+
+	It("should render the PriorityClasses when they are managed by the operator", func() {
+		component := render.PriorityClasses(&operatorv1.InstallationSpec{ManagedPriorityClasses: ptr.To(operatorv1.ManagedPriorityClassesEnabled)})
+		toCreate, toDelete := component.Objects()
+		rtest.ExpectResources(toCreate, expectedResources)
+		Expect(toDelete).To(BeEmpty())
+
+		dataPlane := rtest.GetResource(toCreate, render.DataPlanePriorityClassName, "", "scheduling.k8s.io", "v1", "PriorityClass").(*schedulingv1.PriorityClass)
+		controlPlane := rtest.GetResource(toCreate, render.ControlPlanePriorityClassName, "", "scheduling.k8s.io", "v1", "PriorityClass").(*schedulingv1.PriorityClass)
+		// PriorityClasses that aren't built into Kubernetes can't be given a higher value.
+		Expect(dataPlane.Value).To(BeNumerically("<=", 1000000000))
+		Expect(controlPlane.Value).To(BeNumerically("<", dataPlane.Value))
+	})
+
+	It("should delete the PriorityClasses when they are not managed by the operator", func() {
+		for _, mode := range []*operatorv1.ManagedPriorityClassesType{nil, ptr.To(operatorv1.ManagedPriorityClassesDisabled)} {
+			toCreate, toDelete := render.PriorityClasses(&operatorv1.InstallationSpec{ManagedPriorityClasses: mode}).Objects()
+			Expect(toCreate).To(BeEmpty())
+			rtest.ExpectResources(toDelete, expectedResources)
+		}
+	})
+})
@@ -0,0 +1,432 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusagent
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/components"
+	"github.com/tigera/operator/pkg/render"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/secret"
+	"github.com/tigera/operator/pkg/render/common/securitycontext"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
+)
+
+// The names of the objects rendered for the Prometheus agent that remote-writes the calico/node and
+// calico/typha metrics.
+const (
+	PrometheusAgentName      = "calico-prometheus-agent"
+	PrometheusAgentNamespace = common.CalicoNamespace
+
+	// The copies of the user provided secrets, in the namespace of the agent.
+	TLSSecretName         = PrometheusAgentName + "-tls"
+	BasicAuthSecretName   = PrometheusAgentName + "-basic-auth"
+	BearerTokenSecretName = PrometheusAgentName + "-bearer-token"
+
+	configFileName   = "prometheus.yml"
+	configVolumeName = "config"
+	configMountPath  = "/etc/prometheus"
+	dataVolumeName   = "data"
+	dataMountPath    = "/prometheus"
+	secretsMountPath = "/etc/prometheus/secrets"
+
+	felixJobName = "felix"
+	typhaJobName = "typha"
+)
+
+// Configuration contains all the config information needed to render the component.
+type Configuration struct {
+	Installation  *operatorv1.InstallationSpec
+	PullSecrets   []*corev1.Secret
+	TrustedBundle certificatemanagement.TrustedBundleRO
+	ClusterDomain string
+
+	// The user provided secrets referenced by MetricsRemoteWrite, read from the operator namespace.
+	TLSSecret         *corev1.Secret
+	BasicAuthSecret   *corev1.Secret
+	BearerTokenSecret *corev1.Secret
+}
+
+// PrometheusAgent renders the Prometheus agent when Installation.Spec.MetricsRemoteWrite is set, and removes it
+// otherwise.
+func PrometheusAgent(cfg *Configuration) (render.Component, error) {
+	config, err := prometheusConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &component{cfg: cfg, config: config}, nil
+}
+
+type component struct {
+	cfg   *Configuration
+	image string
+
+	// config is the prometheus.yml of the agent.
+	config string
+}
+
+func (c *component) ResolveImages(is *operatorv1.ImageSet) error {
+	reg := c.cfg.Installation.Registry
+	path := c.cfg.Installation.ImagePath
+	prefix := c.cfg.Installation.ImagePrefix
+
+	image := components.ComponentCalicoPrometheus
+	if c.cfg.Installation.Variant.IsEnterprise() {
+		image = components.ComponentPrometheus
+	}
+
+	var err error
+	c.image, err = components.GetReference(image, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
+	return err
+}
+
+func (c *component) SupportedOSType() rmeta.OSType {
+	return rmeta.OSTypeLinux
+}
+
+func (c *component) Objects() ([]client.Object, []client.Object) {
+	objs := []client.Object{
+		c.serviceAccount(),
+		c.role(),
+		c.roleBinding(),
+		c.configMap(),
+	}
+	secrets := []client.Object{
+		c.secretCopy(TLSSecretName, c.cfg.TLSSecret),
+		c.secretCopy(BasicAuthSecretName, c.cfg.BasicAuthSecret),
+		c.secretCopy(BearerTokenSecretName, c.cfg.BearerTokenSecret),
+	}
+
+	metricsReader := []client.Object{c.metricsReaderClusterRole(), c.metricsReaderClusterRoleBinding()}
+
+	if c.cfg.Installation.MetricsRemoteWrite == nil {
+		return nil, append(append(append(objs, secrets...), metricsReader...), c.deployment())
+	}
+
+	var toCreate, toDelete []client.Object
+	toCreate = append(toCreate, secret.ToRuntimeObjects(secret.CopyToNamespace(PrometheusAgentNamespace, c.cfg.PullSecrets...)...)...)
+	toCreate = append(toCreate, objs...)
+	for i, src := range []*corev1.Secret{c.cfg.TLSSecret, c.cfg.BasicAuthSecret, c.cfg.BearerTokenSecret} {
+		if src != nil {
+			toCreate = append(toCreate, secrets[i])
+		} else {
+			toDelete = append(toDelete, secrets[i])
+		}
+	}
+	if c.cfg.Installation.TyphaMetricsAuthEnabled() {
+		toCreate = append(toCreate, metricsReader...)
+	} else {
+		toDelete = append(toDelete, metricsReader...)
+	}
+	toCreate = append(toCreate, c.deployment())
+
+	return toCreate, toDelete
+}
+
+func (c *component) Ready() bool {
+	return true
+}
+
+func (c *component) serviceAccount() *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: PrometheusAgentName, Namespace: PrometheusAgentNamespace},
+	}
+}
+
+// role allows the agent to discover the calico/node and calico/typha pods it scrapes.
+func (c *component) role() *rbacv1.Role {
+	return &rbacv1.Role{
+		TypeMeta:   metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: PrometheusAgentName, Namespace: PrometheusAgentNamespace},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+		},
+	}
+}
+
+func (c *component) roleBinding() *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		TypeMeta:   metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: PrometheusAgentName, Namespace: PrometheusAgentNamespace},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "Role",
+			Name:     PrometheusAgentName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      PrometheusAgentName,
+				Namespace: PrometheusAgentNamespace,
+			},
+		},
+	}
+}
+
+// metricsReaderClusterRole allows the agent to scrape the typha metrics once kube-rbac-proxy authorizes the
+// scrapes, see Installation.TyphaMetricsAuth.
+func (c *component) metricsReaderClusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: PrometheusAgentName},
+		Rules: []rbacv1.PolicyRule{
+			{
+				NonResourceURLs: []string{"/metrics"},
+				Verbs:           []string{"get"},
+			},
+		},
+	}
+}
+
+func (c *component) metricsReaderClusterRoleBinding() *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: PrometheusAgentName},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     PrometheusAgentName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      PrometheusAgentName,
+				Namespace: PrometheusAgentNamespace,
+			},
+		},
+	}
+}
+
+// secretCopy returns a copy of the given user provided secret in the namespace of the agent. The copies have fixed
+// names so that they can be removed once they are no longer referenced.
+func (c *component) secretCopy(name string, src *corev1.Secret) *corev1.Secret {
+	s := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: PrometheusAgentNamespace},
+	}
+	if src != nil {
+		s.Data = src.Data
+	}
+	return s
+}
+
+func (c *component) configMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: PrometheusAgentName, Namespace: PrometheusAgentNamespace},
+		Data:       map[string]string{configFileName: c.config},
+	}
+}
+
+// prometheusConfig returns the prometheus.yml of the agent.
+func prometheusConfig(cfg *Configuration) (string, error) {
+	rw := cfg.Installation.MetricsRemoteWrite
+	if rw == nil {
+		return "", nil
+	}
+
+	var scrapeConfigs []map[string]interface{}
+	if cfg.Installation.NodeMetricsPort != nil {
+		scrapeConfigs = append(scrapeConfigs, scrapeConfig(felixJobName, render.CalicoNodeObjectName, *cfg.Installation.NodeMetricsPort))
+	}
+	if cfg.Installation.TyphaMetricsPort != nil {
+		typha := scrapeConfig(typhaJobName, render.TyphaK8sAppName, *cfg.Installation.TyphaMetricsPort)
+		if cfg.Installation.TyphaMetricsTLSEnabled() {
+			typha["scheme"] = "https"
+			typha["tls_config"] = map[string]interface{}{
+				"ca_file":     cfg.TrustedBundle.MountPath(),
+				"server_name": fmt.Sprintf("%s.%s.svc", render.TyphaMetricsName, common.CalicoNamespace),
+			}
+		}
+		if cfg.Installation.TyphaMetricsAuthEnabled() {
+			typha["authorization"] = map[string]interface{}{
+				"credentials_file": "/var/run/secrets/kubernetes.io/serviceaccount/token",
+			}
+		}
+		scrapeConfigs = append(scrapeConfigs, typha)
+	}
+
+	remoteWrite := map[string]interface{}{"url": rw.URL}
+	if s := cfg.TLSSecret; s != nil {
+		tlsConfig := map[string]interface{}{}
+		if _, ok := s.Data[corev1.ServiceAccountRootCAKey]; ok {
+			tlsConfig["ca_file"] = secretFilePath(TLSSecretName, corev1.ServiceAccountRootCAKey)
+		}
+		if _, ok := s.Data[corev1.TLSCertKey]; ok {
+			tlsConfig["cert_file"] = secretFilePath(TLSSecretName, corev1.TLSCertKey)
+			tlsConfig["key_file"] = secretFilePath(TLSSecretName, corev1.TLSPrivateKeyKey)
+		}
+		remoteWrite["tls_config"] = tlsConfig
+	}
+	if cfg.BasicAuthSecret != nil {
+		remoteWrite["basic_auth"] = map[string]interface{}{
+			"username_file": secretFilePath(BasicAuthSecretName, "username"),
+			"password_file": secretFilePath(BasicAuthSecretName, "password"),
+		}
+	}
+	if cfg.BearerTokenSecret != nil {
+		remoteWrite["authorization"] = map[string]interface{}{
+			"credentials_file": secretFilePath(BearerTokenSecretName, "token"),
+		}
+	}
+
+	bytes, err := yaml.Marshal(map[string]interface{}{
+		"global": map[string]interface{}{
+			"scrape_interval": rw.GetScrapeInterval().String(),
+		},
+		"scrape_configs": scrapeConfigs,
+		"remote_write":   []map[string]interface{}{remoteWrite},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the Prometheus agent configuration: %w", err)
+	}
+	return string(bytes), nil
+}
+
+// scrapeConfig returns a scrape job for the pods in the calico-system namespace with the given k8s-app label,
+// scraping the given port of the pod IP. Both calico/node and calico/typha are host networked.
+func scrapeConfig(job, app string, port int32) map[string]interface{} {
+	return map[string]interface{}{
+		"job_name": job,
+		"kubernetes_sd_configs": []map[string]interface{}{
+			{
+				"role":       "pod",
+				"namespaces": map[string]interface{}{"names": []string{common.CalicoNamespace}},
+			},
+		},
+		"relabel_configs": []map[string]interface{}{
+			{
+				"source_labels": []string{"__meta_kubernetes_pod_label_k8s_app"},
+				"regex":         app,
+				"action":        "keep",
+			},
+			{
+				"source_labels": []string{"__meta_kubernetes_pod_ip"},
+				"target_label":  "__address__",
+				"replacement":   fmt.Sprintf("$1:%d", port),
+			},
+			{
+				"source_labels": []string{"__meta_kubernetes_pod_node_name"},
+				"target_label":  "node",
+			},
+		},
+	}
+}
+
+func secretFilePath(secretName, key string) string {
+	return filepath.Join(secretsMountPath, secretName, key)
+}
+
+func (c *component) deployment() *appsv1.Deployment {
+	volumes := []corev1.Volume{
+		{
+			Name: configVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: PrometheusAgentName},
+				},
+			},
+		},
+		{
+			Name:         dataVolumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		},
+	}
+	mounts := []corev1.VolumeMount{
+		{Name: configVolumeName, MountPath: configMountPath, ReadOnly: true},
+		{Name: dataVolumeName, MountPath: dataMountPath},
+	}
+
+	annotations := map[string]string{}
+	if c.cfg.TrustedBundle != nil {
+		volumes = append(volumes, c.cfg.TrustedBundle.Volume())
+		mounts = append(mounts, c.cfg.TrustedBundle.VolumeMounts(c.SupportedOSType())...)
+		annotations = c.cfg.TrustedBundle.HashAnnotations()
+	}
+	annotations[PrometheusAgentName+"-config-hash"] = rmeta.AnnotationHash(c.config)
+
+	for _, s := range []struct {
+		name string
+		src  *corev1.Secret
+	}{
+		{TLSSecretName, c.cfg.TLSSecret},
+		{BasicAuthSecretName, c.cfg.BasicAuthSecret},
+		{BearerTokenSecretName, c.cfg.BearerTokenSecret},
+	} {
+		if s.src == nil {
+			continue
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name:         s.name,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: s.name}},
+		})
+		mounts = append(mounts, corev1.VolumeMount{Name: s.name, MountPath: filepath.Join(secretsMountPath, s.name), ReadOnly: true})
+		annotations[fmt.Sprintf("hash.operator.tigera.io/%s", s.name)] = rmeta.AnnotationHash(s.src.Data)
+	}
+
+	container := corev1.Container{
+		Name:  PrometheusAgentName,
+		Image: c.image,
+		Args: []string{
+			"--agent",
+			fmt.Sprintf("--config.file=%s", filepath.Join(configMountPath, configFileName)),
+			fmt.Sprintf("--storage.agent.path=%s", dataMountPath),
+		},
+		SecurityContext: securitycontext.NewNonRootContext(),
+		VolumeMounts:    mounts,
+	}
+
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PrometheusAgentName,
+			Namespace: PrometheusAgentNamespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(1)),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        PrometheusAgentName,
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					NodeSelector:       c.cfg.Installation.ControlPlaneNodeSelector,
+					ServiceAccountName: PrometheusAgentName,
+					Tolerations:        append(c.cfg.Installation.ControlPlaneTolerations, rmeta.TolerateCriticalAddonsAndControlPlane...),
+					ImagePullSecrets:   secret.GetReferenceList(c.cfg.PullSecrets),
+					Containers:         []corev1.Container{container},
+					Volumes:            volumes,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusagent_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/render"
+	rtest "github.com/tigera/operator/pkg/render/common/test"
+	"github.com/tigera/operator/pkg/render/prometheusagent"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
+)
+
+var _ = Describe("Prometheus agent rendering", func() {
+	var cfg *prometheusagent.Configuration
+
+	BeforeEach(func() {
+		cfg = &prometheusagent.Configuration{
+			Installation: &operatorv1.InstallationSpec{
+				Variant:          operatorv1.Calico,
+				NodeMetricsPort:  ptr.To(int32(9091)),
+				TyphaMetricsPort: ptr.To(int32(9093)),
+				MetricsRemoteWrite: &operatorv1.MetricsRemoteWrite{
+					URL: "https://metrics.example.com/api/v1/write",
+				},
+			},
+			TrustedBundle: certificatemanagement.CreateTrustedBundle(nil),
+			ClusterDomain: "cluster.local",
+		}
+	})
+
+	// agent returns the agent rendered from cfg.
+	agent := func() render.Component {
+		component, err := prometheusagent.PrometheusAgent(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		return component
+	}
+
+	// config returns the parsed prometheus.yml of the rendered agent.
+	config := func(objs []client.Object) map[string]interface{} {
+		cm, err := rtest.GetResourceOfType[*corev1.ConfigMap](objs, prometheusagent.PrometheusAgentName, common.CalicoNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		parsed := map[string]interface{}{}
+		Expect(yaml.Unmarshal([]byte(cm.Data["prometheus.yml"]), &parsed)).To(Succeed())
+		return parsed
+	}
+
+	It("should render the agent when metricsRemoteWrite is set", func() {
+		component, err := prometheusagent.PrometheusAgent(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(component.ResolveImages(nil)).To(Succeed())
+		toCreate, toDelete := component.Objects()
+
+		ns := common.CalicoNamespace
+		rtest.ExpectResources(toCreate, []client.Object{
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.PrometheusAgentName, Namespace: ns}},
+			&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.PrometheusAgentName, Namespace: ns}},
+			&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.PrometheusAgentName, Namespace: ns}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.PrometheusAgentName, Namespace: ns}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.PrometheusAgentName, Namespace: ns}},
+		})
+		rtest.ExpectResources(toDelete, []client.Object{
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.TLSSecretName, Namespace: ns}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.BasicAuthSecretName, Namespace: ns}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.BearerTokenSecretName, Namespace: ns}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.PrometheusAgentName}},
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.PrometheusAgentName}},
+		})
+
+		deploy, err := rtest.GetResourceOfType[*appsv1.Deployment](toCreate, prometheusagent.PrometheusAgentName, ns)
+		Expect(err).NotTo(HaveOccurred())
+		container := deploy.Spec.Template.Spec.Containers[0]
+		Expect(container.Image).To(ContainSubstring("prometheus"))
+		Expect(container.Args).To(ContainElement("--agent"))
+		Expect(container.Args).To(ContainElement("--config.file=/etc/prometheus/prometheus.yml"))
+
+		parsed := config(toCreate)
+		Expect(parsed["global"]).To(HaveKeyWithValue("scrape_interval", "30s"))
+		Expect(parsed["scrape_configs"]).To(HaveLen(2))
+		Expect(parsed["remote_write"]).To(ConsistOf(
+			HaveKeyWithValue("url", "https://metrics.example.com/api/v1/write"),
+		))
+	})
+
+	It("should only scrape typha when only the typha metrics port is set", func() {
+		cfg.Installation.NodeMetricsPort = nil
+		cfg.Installation.TyphaMetricsTLS = ptr.To(operatorv1.TyphaMetricsTLSEnabled)
+		toCreate, _ := agent().Objects()
+
+		scrapeConfigs := config(toCreate)["scrape_configs"].([]interface{})
+		Expect(scrapeConfigs).To(HaveLen(1))
+		typha := scrapeConfigs[0].(map[interface{}]interface{})
+		Expect(typha).To(HaveKeyWithValue("job_name", "typha"))
+		Expect(typha).To(HaveKeyWithValue("scheme", "https"))
+		Expect(typha["tls_config"]).To(HaveKeyWithValue("server_name", "calico-typha-metrics.calico-system.svc"))
+	})
+
+	It("should authenticate to typha when its metrics require authorization", func() {
+		cfg.Installation.NodeMetricsPort = nil
+		cfg.Installation.TyphaMetricsAuth = ptr.To(operatorv1.MetricsAuthTokenReview)
+		toCreate, _ := agent().Objects()
+
+		role, err := rtest.GetResourceOfType[*rbacv1.ClusterRole](toCreate, prometheusagent.PrometheusAgentName, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(role.Rules).To(ConsistOf(rbacv1.PolicyRule{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}}))
+		_, err = rtest.GetResourceOfType[*rbacv1.ClusterRoleBinding](toCreate, prometheusagent.PrometheusAgentName, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		typha := config(toCreate)["scrape_configs"].([]interface{})[0].(map[interface{}]interface{})
+		Expect(typha).To(HaveKeyWithValue("scheme", "https"))
+		Expect(typha["authorization"]).To(Equal(map[interface{}]interface{}{
+			"credentials_file": "/var/run/secrets/kubernetes.io/serviceaccount/token",
+		}))
+	})
+
+	It("should copy and mount the credential secrets", func() {
+		cfg.Installation.MetricsRemoteWrite.ScrapeInterval = &metav1.Duration{Duration: 15 * time.Second}
+		cfg.TLSSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "remote-write-tls", Namespace: common.OperatorNamespace()},
+			Data:       map[string][]byte{"ca.crt": []byte("ca")},
+		}
+		cfg.BearerTokenSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "remote-write-token", Namespace: common.OperatorNamespace()},
+			Data:       map[string][]byte{"token": []byte("secret-token")},
+		}
+		toCreate, toDelete := agent().Objects()
+
+		tlsSecret, err := rtest.GetResourceOfType[*corev1.Secret](toCreate, prometheusagent.TLSSecretName, common.CalicoNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tlsSecret.Data).To(Equal(cfg.TLSSecret.Data))
+		token, err := rtest.GetResourceOfType[*corev1.Secret](toCreate, prometheusagent.BearerTokenSecretName, common.CalicoNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token.Data).To(Equal(cfg.BearerTokenSecret.Data))
+		rtest.ExpectResources(toDelete, []client.Object{
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.BasicAuthSecretName, Namespace: common.CalicoNamespace}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.PrometheusAgentName}},
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: prometheusagent.PrometheusAgentName}},
+		})
+
+		deploy, err := rtest.GetResourceOfType[*appsv1.Deployment](toCreate, prometheusagent.PrometheusAgentName, common.CalicoNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		mounts := deploy.Spec.Template.Spec.Containers[0].VolumeMounts
+		rtest.ExpectVolumeMount(mounts, prometheusagent.TLSSecretName, "/etc/prometheus/secrets/"+prometheusagent.TLSSecretName)
+		rtest.ExpectVolumeMount(mounts, prometheusagent.BearerTokenSecretName, "/etc/prometheus/secrets/"+prometheusagent.BearerTokenSecretName)
+
+		parsed := config(toCreate)
+		Expect(parsed["global"]).To(HaveKeyWithValue("scrape_interval", "15s"))
+		remoteWrite := parsed["remote_write"].([]interface{})[0].(map[interface{}]interface{})
+		Expect(remoteWrite["tls_config"]).To(Equal(map[interface{}]interface{}{
+			"ca_file": "/etc/prometheus/secrets/calico-prometheus-agent-tls/ca.crt",
+		}))
+		Expect(remoteWrite["authorization"]).To(Equal(map[interface{}]interface{}{
+			"credentials_file": "/etc/prometheus/secrets/calico-prometheus-agent-bearer-token/token",
+		}))
+		Expect(remoteWrite).NotTo(HaveKey("basic_auth"))
+	})
+
+	It("should delete the agent when metricsRemoteWrite is not set", func() {
+		cfg.Installation.MetricsRemoteWrite = nil
+		toCreate, toDelete := agent().Objects()
+		Expect(toCreate).To(BeEmpty())
+		Expect(toDelete).To(HaveLen(10))
+		Expect(rtest.GetResource(toDelete, prometheusagent.PrometheusAgentName, common.CalicoNamespace, "apps", "v1", "Deployment")).NotTo(BeNil())
+	})
+})
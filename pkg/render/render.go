@@ -17,6 +17,8 @@ package render
 import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
 )
 
 var (
@@ -44,3 +46,23 @@ func setNodeCriticalPod(t *corev1.PodTemplateSpec) {
 func SetClusterCriticalPod(t *corev1.PodTemplateSpec) {
 	t.Spec.PriorityClassName = ClusterPriorityClassName
 }
+
+// setDataPlanePriorityClass gives the pod the operator's data plane PriorityClass if the operator manages
+// PriorityClasses, and makes it node critical otherwise.
+func setDataPlanePriorityClass(installation *operatorv1.InstallationSpec, t *corev1.PodTemplateSpec) {
+	if installation.ManagedPriorityClassesEnabled() {
+		t.Spec.PriorityClassName = DataPlanePriorityClassName
+		return
+	}
+	setNodeCriticalPod(t)
+}
+
+// setControlPlanePriorityClass gives the pod the operator's control plane PriorityClass if the operator manages
+// PriorityClasses, and makes it cluster critical otherwise.
+func setControlPlanePriorityClass(installation *operatorv1.InstallationSpec, t *corev1.PodTemplateSpec) {
+	if installation.ManagedPriorityClassesEnabled() {
+		t.Spec.PriorityClassName = ControlPlanePriorityClassName
+		return
+	}
+	SetClusterCriticalPod(t)
+}
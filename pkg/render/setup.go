@@ -29,9 +29,26 @@ type SetUpConfiguration struct {
 	Namespace    string
 	PSS          PodSecurityStandard
 
+	// RequiredPSS is the least privileged pod security standard that the components of the namespace can run
+	// under, if it is more restrictive than PSS, the level enforced by default.
+	RequiredPSS PodSecurityStandard
+
 	CreateNamespace bool
 }
 
+// PodSecurityStandard returns the pod security standard to enforce on the namespace. It returns an error if the
+// Installation forces a level on the namespace that is more restrictive than the components in it need.
+func (c *SetUpConfiguration) PodSecurityStandard() (PodSecurityStandard, error) {
+	if !c.CreateNamespace {
+		return c.PSS, nil
+	}
+	required := c.RequiredPSS
+	if required == "" {
+		required = c.PSS
+	}
+	return ResolvePodSecurityStandard(c.Installation, c.Namespace, c.PSS, required)
+}
+
 func NewSetup(cfg *SetUpConfiguration) Component {
 	return &SetUpComponent{cfg: cfg}
 }
@@ -54,7 +71,10 @@ func (p *SetUpComponent) ResolveImages(is *operatorv1.ImageSet) error {
 // rendering.
 func (p *SetUpComponent) Objects() (objsToCreate []client.Object, objsToDelete []client.Object) {
 	if p.cfg.CreateNamespace {
-		objsToCreate = append(objsToCreate, CreateNamespace(p.cfg.Namespace, p.cfg.Installation.KubernetesProvider, p.cfg.PSS, p.cfg.Installation.Azure))
+		// If the forced level is too restrictive, the controller is expected to have reported it rather than render
+		// this component, so fall back to the default level.
+		pss, _ := p.cfg.PodSecurityStandard()
+		objsToCreate = append(objsToCreate, newNamespace(p.cfg.Namespace, p.cfg.Installation.KubernetesProvider, pss, p.cfg.Installation.Azure))
 	}
 
 	objsToCreate = append(objsToCreate, CreateOperatorSecretsRoleBinding(p.cfg.Namespace))
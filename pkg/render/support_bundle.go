@@ -0,0 +1,139 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/components"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/secret"
+	"github.com/tigera/operator/pkg/render/common/securitycontext"
+)
+
+const (
+	// SupportBundleLabel is set on the collection Job of a SupportBundle, with the name of the SupportBundle as its
+	// value.
+	SupportBundleLabel = "operator.tigera.io/support-bundle"
+
+	// SupportBundleMountPath is where the persistent volume claim that a SupportBundle is written to is mounted.
+	SupportBundleMountPath = "/support-bundle"
+
+	supportBundleVolumeName = "support-bundle"
+)
+
+// SupportBundleJobName returns the name of the Job that collects the SupportBundle with the given name.
+func SupportBundleJobName(name string) string {
+	return "support-bundle-" + name
+}
+
+// SupportBundleConfiguration contains all the config information needed to render the collection Job of a
+// SupportBundle.
+type SupportBundleConfiguration struct {
+	SupportBundle *operatorv1.SupportBundle
+	Installation  *operatorv1.InstallationSpec
+	PullSecrets   []*corev1.Secret
+}
+
+func SupportBundle(cfg *SupportBundleConfiguration) Component {
+	return &supportBundleComponent{cfg: cfg}
+}
+
+type supportBundleComponent struct {
+	cfg   *SupportBundleConfiguration
+	image string
+}
+
+func (c *supportBundleComponent) SupportedOSType() rmeta.OSType {
+	return rmeta.OSTypeLinux
+}
+
+func (c *supportBundleComponent) ResolveImages(is *operatorv1.ImageSet) error {
+	reg := c.cfg.Installation.Registry
+	path := c.cfg.Installation.ImagePath
+	prefix := c.cfg.Installation.ImagePrefix
+	var err error
+	c.image, err = components.GetReference(components.ComponentOperatorInit, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
+	return err
+}
+
+func (c *supportBundleComponent) Objects() ([]client.Object, []client.Object) {
+	return []client.Object{c.job()}, nil
+}
+
+func (c *supportBundleComponent) Ready() bool {
+	return true
+}
+
+// job returns the Job that collects the bundle. It runs the operator image with the service account of the operator,
+// which can already read everything that goes into the bundle.
+func (c *supportBundleComponent) job() *batchv1.Job {
+	bundle := c.cfg.SupportBundle
+	name := SupportBundleJobName(bundle.Name)
+	labels := map[string]string{SupportBundleLabel: bundle.Name}
+
+	container := corev1.Container{
+		Name:  "support-bundle",
+		Image: c.image,
+		Args:  []string{"--collect-support-bundle=" + bundle.Name},
+		Env: []corev1.EnvVar{
+			{Name: "OPERATOR_NAMESPACE", Value: common.OperatorNamespace()},
+		},
+		SecurityContext: securitycontext.NewNonRootContext(),
+	}
+	var volumes []corev1.Volume
+	if pvc := bundle.Spec.Destination.PersistentVolumeClaim; pvc != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: supportBundleVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvc.ClaimName},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      supportBundleVolumeName,
+			MountPath: SupportBundleMountPath,
+		})
+	}
+
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{Kind: "Job", APIVersion: "batch/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: common.OperatorNamespace(),
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To(int32(2)),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ImagePullSecrets:   secret.GetReferenceList(c.cfg.PullSecrets),
+					ServiceAccountName: common.OperatorServiceAccount(),
+					NodeSelector:       c.cfg.Installation.ControlPlaneNodeSelector,
+					Tolerations:        c.cfg.Installation.ControlPlaneTolerations,
+					Containers:         []corev1.Container{container},
+					Volumes:            volumes,
+				},
+			},
+		},
+	}
+}
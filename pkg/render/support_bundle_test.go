@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/render"
+	rtest "github.com/tigera/operator/pkg/render/common/test"
+)
+
+var _ = Describe("support bundle rendering tests", func() {
+	var cfg *render.SupportBundleConfiguration
+
+	BeforeEach(func() {
+		cfg = &render.SupportBundleConfiguration{
+			SupportBundle: &operatorv1.SupportBundle{
+				ObjectMeta: metav1.ObjectMeta{Name: "case-1234"},
+				Spec: operatorv1.SupportBundleSpec{
+					Destination: operatorv1.SupportBundleDestination{
+						S3: &operatorv1.SupportBundleS3Destination{Region: "us-west-2", BucketName: "support", CredentialsSecretName: "creds"},
+					},
+				},
+			},
+			Installation: &operatorv1.InstallationSpec{
+				ControlPlaneNodeSelector: map[string]string{"role": "control"},
+			},
+		}
+	})
+
+	It("should render a Job that runs the operator image to collect the bundle", func() {
+		component := render.SupportBundle(cfg)
+		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+		toCreate, toDelete := component.Objects()
+		Expect(toCreate).To(HaveLen(1))
+		Expect(toDelete).To(BeNil())
+
+		job := rtest.GetResource(toCreate, "support-bundle-case-1234", "tigera-operator", "batch", "v1", "Job").(*batchv1.Job)
+		Expect(job.Labels).To(Equal(map[string]string{render.SupportBundleLabel: "case-1234"}))
+		Expect(*job.Spec.BackoffLimit).To(Equal(int32(2)))
+		podSpec := job.Spec.Template.Spec
+		Expect(podSpec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+		Expect(podSpec.NodeSelector).To(Equal(map[string]string{"role": "control"}))
+		Expect(podSpec.Volumes).To(BeEmpty())
+		Expect(podSpec.Containers).To(HaveLen(1))
+		container := podSpec.Containers[0]
+		Expect(container.Image).To(ContainSubstring("tigera/operator:"))
+		Expect(container.Args).To(ConsistOf("--collect-support-bundle=case-1234"))
+		Expect(container.VolumeMounts).To(BeEmpty())
+		Expect(*container.SecurityContext.RunAsNonRoot).To(BeTrue())
+	})
+})
@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+)
+
+// tlsProfileEnvVars returns the env vars that apply the TLS security profile of the installation to the TLS servers
+// of a component, or nil if no profile is configured.
+func tlsProfileEnvVars(installation *operatorv1.InstallationSpec) []corev1.EnvVar {
+	if installation == nil || installation.TLSSecurityProfile == nil {
+		return nil
+	}
+	profile := installation.TLSSecurityProfile
+	envs := []corev1.EnvVar{{Name: "TLS_MIN_VERSION", Value: profile.MinTLSVersion().Number()}}
+	if ciphers := profile.CipherNames(); ciphers != "" {
+		envs = append(envs, corev1.EnvVar{Name: "TLS_CIPHER_SUITES", Value: ciphers})
+	}
+	return envs
+}
+
+// tlsProfileArgs returns the kube-apiserver style arguments that apply the TLS security profile of the installation,
+// or nil if no profile is configured.
+func tlsProfileArgs(installation *operatorv1.InstallationSpec) []string {
+	if installation == nil || installation.TLSSecurityProfile == nil {
+		return nil
+	}
+	profile := installation.TLSSecurityProfile
+	args := []string{fmt.Sprintf("--tls-min-version=%s", profile.MinTLSVersion())}
+	if ciphers := profile.CipherNames(); ciphers != "" {
+		args = append(args, fmt.Sprintf("--tls-cipher-suites=%s", ciphers))
+	}
+	return args
+}
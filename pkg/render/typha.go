@@ -16,6 +16,7 @@ package render
 
 import (
 	"fmt"
+	"maps"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -23,8 +24,10 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
@@ -37,6 +40,7 @@ import (
 	"github.com/tigera/operator/pkg/render/common/networkpolicy"
 	"github.com/tigera/operator/pkg/render/common/securitycontext"
 	"github.com/tigera/operator/pkg/render/common/securitycontextconstraints"
+	"github.com/tigera/operator/pkg/render/common/vpa"
 	"github.com/tigera/operator/pkg/tls/certificatemanagement"
 )
 
@@ -49,11 +53,22 @@ const (
 	TyphaPort               int32 = 5473
 	TyphaMetricsName              = "calico-typha-metrics"
 
+	// TyphaPrometheusTLSServerSecret is the key pair typha serves prometheus metrics with when
+	// Installation.TyphaMetricsTLS is enabled.
+	TyphaPrometheusTLSServerSecret = "calico-typha-prometheus-server-tls"
+
 	TyphaContainerName = "calico-typha"
 
+	// TyphaMetricsReaderName is the name of the ClusterRole and ClusterRoleBinding that allow the operator to scrape
+	// the typha metrics when Installation.TyphaMetricsAuth is TokenReview.
+	TyphaMetricsReaderName = "calico-typha-metrics-reader"
+
 	TyphaNonClusterHostSuffix            = "-noncluster-host"
 	TyphaNonClusterHostNetworkPolicyName = networkpolicy.CalicoComponentPolicyPrefix + "typha-noncluster-host-access"
 
+	// TyphaPoolLabelName is the label that identifies the pool that a typha Deployment, Service or pod belongs to.
+	TyphaPoolLabelName = "operator.tigera.io/typha-pool"
+
 	defaultTyphaTerminationGracePeriod = 300
 	shutdownTimeoutEnvVar              = "TYPHA_SHUTDOWNTIMEOUTSECS"
 )
@@ -79,6 +94,26 @@ type TyphaConfiguration struct {
 	// The health port that Felix is bound to. We configure Typha to bind to the port
 	// that is one less.
 	FelixHealthPort int
+
+	// The key pair typha serves prometheus metrics with, or that kube-rbac-proxy serves them with when
+	// Installation.TyphaMetricsAuth is TokenReview. Nil unless Installation.TyphaMetricsTLS is enabled.
+	PrometheusServerTLS certificatemanagement.KeyPairInterface
+
+	// Whether the Prometheus operator ServiceMonitor CRD is installed in the cluster. The typha
+	// ServiceMonitor is only rendered if this is true.
+	ServiceMonitorCRDExists bool
+
+	// Whether the VerticalPodAutoscaler CRD is installed in the cluster. VerticalPodAutoscalers for the typha
+	// Deployments are only rendered if this is true.
+	VPACRDExists bool
+
+	// Whether the operator has paused the typha Deployment rollout because too many client connections to
+	// up-level typha pods were dropped. See TyphaConfiguration.RolloutPauseThresholdPercent on the Installation.
+	RolloutPaused bool
+
+	// The names of typha pools that exist in the cluster but are no longer present in the TyphaDeployment
+	// overrides. Their Deployments and Services are deleted.
+	StaleTyphaPools []string
 }
 
 // Typha creates the typha daemonset and other resources for the daemonset to operate normally.
@@ -91,7 +126,8 @@ type typhaComponent struct {
 	cfg *TyphaConfiguration
 
 	// Generated internal config, built from the given configuration.
-	typhaImage string
+	typhaImage         string
+	kubeRBACProxyImage string
 }
 
 func (c *typhaComponent) ResolveImages(is *operatorv1.ImageSet) error {
@@ -99,7 +135,13 @@ func (c *typhaComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	path := c.cfg.Installation.ImagePath
 	prefix := c.cfg.Installation.ImagePrefix
 	var err error
-	c.typhaImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+	c.typhaImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
+	if err != nil {
+		return err
+	}
+	if c.cfg.Installation.TyphaMetricsAuthEnabled() {
+		c.kubeRBACProxyImage, err = components.GetReference(kubeRBACProxyImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
+	}
 	return err
 }
 
@@ -112,17 +154,87 @@ func (c *typhaComponent) Objects() ([]client.Object, []client.Object) {
 		c.typhaServiceAccount(),
 		c.typhaRole(),
 		c.typhaRoleBinding(),
-		c.typhaPodDisruptionBudget(),
+	}
+	var objsToDelete []client.Object
+	if c.cfg.Installation.PodDisruptionBudgetsEnabled() {
+		objs = append(objs, c.typhaPodDisruptionBudget())
+	} else {
+		objsToDelete = append(objsToDelete, &policyv1.PodDisruptionBudget{TypeMeta: metav1.TypeMeta{Kind: "PodDisruptionBudget", APIVersion: "policy/v1"}, ObjectMeta: metav1.ObjectMeta{Name: common.TyphaDeploymentName, Namespace: common.CalicoNamespace}})
 	}
 	objs = append(objs, c.typhaServices()...)
 
 	// Add deployment last, as it may depend on the creation of previous objects in the list.
-	objs = append(objs, c.typhaDeployment()...)
+	deployments := c.typhaDeployment()
+	objs = append(objs, deployments...)
 	if c.cfg.Installation.TyphaMetricsPort != nil {
 		objs = append(objs, c.typhaPrometheusService())
 	}
+	if c.cfg.Installation.TyphaMetricsAuthEnabled() {
+		objs = append(objs, c.typhaMetricsReaderClusterRole(), c.typhaMetricsReaderClusterRoleBinding())
+	} else {
+		objsToDelete = append(objsToDelete, c.typhaMetricsReaderClusterRole(), c.typhaMetricsReaderClusterRoleBinding())
+	}
+
+	for _, name := range c.cfg.StaleTyphaPools {
+		objsToDelete = append(objsToDelete,
+			&appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: TyphaPoolName(name), Namespace: common.CalicoNamespace},
+			},
+			&corev1.Service{
+				TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: TyphaPoolName(name), Namespace: common.CalicoNamespace},
+			},
+		)
+	}
+	// Let the Prometheus operator scrape typha metrics, as it ignores the prometheus.io annotations. For Calico
+	// Enterprise, the monitor controller renders its own typha ServiceMonitor.
+	if c.cfg.ServiceMonitorCRDExists {
+		if c.cfg.Installation.TyphaMetricsPort != nil && !c.cfg.Installation.Variant.IsEnterprise() {
+			objs = append(objs, c.typhaServiceMonitor())
+		} else {
+			objsToDelete = append(objsToDelete, c.typhaServiceMonitor())
+		}
+	}
+	if c.cfg.VPACRDExists {
+		for _, d := range deployments {
+			if vpaCfg := c.cfg.Installation.VerticalPodAutoscaling; vpaCfg != nil {
+				objs = append(objs, vpa.VerticalPodAutoscaler("Deployment", d.GetName(), common.CalicoNamespace, vpaCfg))
+			} else {
+				objsToDelete = append(objsToDelete, vpa.ToDelete(d.GetName(), common.CalicoNamespace))
+			}
+		}
+		if c.cfg.NonClusterHost == nil {
+			objsToDelete = append(objsToDelete, vpa.ToDelete(common.TyphaDeploymentName+TyphaNonClusterHostSuffix, common.CalicoNamespace))
+		}
+		for _, name := range c.cfg.StaleTyphaPools {
+			objsToDelete = append(objsToDelete, vpa.ToDelete(TyphaPoolName(name), common.CalicoNamespace))
+		}
+	}
+
+	return objs, objsToDelete
+}
+
+// TyphaPoolName returns the name of the Deployment and Service for the named typha pool.
+func TyphaPoolName(pool string) string {
+	return TyphaK8sAppName + "-" + pool
+}
+
+// typhaPoolSelector returns the labels that select the pods of the named typha pool. Pool pods keep the
+// calico-typha app label so that the calico-typha Service, which Felix uses to discover typha, selects them.
+func typhaPoolSelector(pool string) map[string]string {
+	return map[string]string{
+		AppLabelName:       TyphaK8sAppName,
+		TyphaPoolLabelName: pool,
+	}
+}
 
-	return objs, nil
+// typhaPools returns the pools configured in the TyphaDeployment overrides.
+func (c *typhaComponent) typhaPools() []operatorv1.TyphaDeploymentPool {
+	if td := c.cfg.Installation.TyphaDeployment; td != nil && td.Spec != nil {
+		return td.Spec.Pools
+	}
+	return nil
 }
 
 func NewTyphaNonClusterHostPolicy(cfg *TyphaConfiguration) Component {
@@ -137,7 +249,7 @@ func NewTyphaNonClusterHostPolicy(cfg *TyphaConfiguration) Component {
 
 func (c *typhaComponent) typhaPodDisruptionBudget() *policyv1.PodDisruptionBudget {
 	maxUnavailable := intstr.FromInt(1)
-	return &policyv1.PodDisruptionBudget{
+	pdb := &policyv1.PodDisruptionBudget{
 		TypeMeta: metav1.TypeMeta{Kind: "PodDisruptionBudget", APIVersion: "policy/v1"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      common.TyphaDeploymentName,
@@ -152,12 +264,20 @@ func (c *typhaComponent) typhaPodDisruptionBudget() *policyv1.PodDisruptionBudge
 			},
 		},
 	}
+	if td := c.cfg.Installation.TyphaDeployment; td != nil && td.Spec != nil {
+		rcomp.ApplyPodDisruptionBudgetOverrides(pdb, td.Spec.PodDisruptionBudget)
+	}
+	return pdb
 }
 
 func (c *typhaComponent) Ready() bool {
 	return true
 }
 
+func (c *typhaComponent) GarbageCollectionIdentity() string {
+	return "typha"
+}
+
 // typhaServiceAccount creates the typha's service account.
 func (c *typhaComponent) typhaServiceAccount() *corev1.ServiceAccount {
 	return &corev1.ServiceAccount{
@@ -194,186 +314,259 @@ func (c *typhaComponent) typhaRoleBinding() *rbacv1.ClusterRoleBinding {
 
 // typhaRole creates the clusterrole containing policy rules that allow the typha deployment to operate normally.
 func (c *typhaComponent) typhaRole() *rbacv1.ClusterRole {
-	role := &rbacv1.ClusterRole{
+	return &rbacv1.ClusterRole{
 		TypeMeta: metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:   "calico-typha",
 			Labels: map[string]string{},
 		},
+		Rules: newTyphaRulesBuilder(c.cfg.Installation).Rules(),
+	}
+}
 
+// typhaMetricsReaderClusterRole allows the operator to scrape the typha metrics through the kube-rbac-proxy, which the
+// typha upgrade monitor relies on to pause typha rollouts.
+func (c *typhaComponent) typhaMetricsReaderClusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: TyphaMetricsReaderName},
 		Rules: []rbacv1.PolicyRule{
 			{
-				// Calico uses endpoint slices for service-based network policy rules.
-				APIGroups: []string{"discovery.k8s.io"},
-				Resources: []string{"endpointslices"},
-				Verbs:     []string{"list", "watch"},
-			},
-			{
-				// The CNI plugin needs to get pods, nodes, namespaces.
-				APIGroups: []string{""},
-				Resources: []string{"pods", "nodes", "namespaces"},
-				Verbs:     []string{"get"},
-			},
-			{
-				// Used to discover Typha endpoints and service IPs for advertisement.
-				APIGroups: []string{""},
-				Resources: []string{"endpoints", "services"},
-				Verbs:     []string{"watch", "list", "get"},
-			},
-			{
-				// Some information is stored on the node status.
-				APIGroups: []string{""},
-				Resources: []string{"nodes/status"},
-				Verbs:     []string{"patch", "update"},
-			},
-			{
-				// For enforcing network policies.
-				APIGroups: []string{"networking.k8s.io"},
-				Resources: []string{"networkpolicies"},
-				Verbs:     []string{"watch", "list"},
-			},
-			{
-				// For enforcing k8s cluster network policies.
-				APIGroups: []string{"policy.networking.k8s.io"},
-				Resources: []string{
-					"clusternetworkpolicies",
-					"adminnetworkpolicies",
-					"baselineadminnetworkpolicies",
-				},
-				Verbs: []string{"watch", "list"},
-			},
-			{
-				// Metadata from these are used in conjunction with network policy.
-				APIGroups: []string{""},
-				Resources: []string{"pods", "namespaces", "serviceaccounts"},
-				Verbs:     []string{"watch", "list"},
-			},
-			{
-				// Calico patches the allocated IP onto the pod.
-				APIGroups: []string{""},
-				Resources: []string{"pods/status"},
-				Verbs:     []string{"patch"},
-			},
-			{
-				// For monitoring Calico-specific configuration.
-				APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
-				Resources: []string{
-					"bgpconfigurations",
-					"bgppeers",
-					"bgpfilters",
-					"blockaffinities",
-					"caliconodestatuses",
-					"clusterinformations",
-					"felixconfigurations",
-					"globalnetworkpolicies",
-					"stagedglobalnetworkpolicies",
-					"networkpolicies",
-					"stagedkubernetesnetworkpolicies",
-					"stagednetworkpolicies",
-					"globalnetworksets",
-					"hostendpoints",
-					"ipamblocks",
-					"ippools",
-					"ipreservations",
-					"networksets",
-					"tiers",
-				},
-				Verbs: []string{"get", "list", "watch"},
-			},
-			{
-				// For migration code in calico/node startup only. Remove when the migration
-				// code is removed from node.
-				APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
-				Resources: []string{
-					"globalbgpconfigs",
-					"globalfelixconfigs",
-				},
-				Verbs: []string{"get", "list", "watch"},
+				NonResourceURLs: []string{"/metrics"},
+				Verbs:           []string{"get"},
 			},
+		},
+	}
+}
+
+func (c *typhaComponent) typhaMetricsReaderClusterRoleBinding() *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: TyphaMetricsReaderName},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     TyphaMetricsReaderName,
+		},
+		Subjects: []rbacv1.Subject{
 			{
-				// Calico creates some configuration on startup.
-				APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
-				Resources: []string{
-					"clusterinformations",
-					"felixconfigurations",
-					"ippools",
-				},
-				Verbs: []string{"create", "update"},
+				Kind:      "ServiceAccount",
+				Name:      common.OperatorServiceAccount(),
+				Namespace: common.OperatorNamespace(),
 			},
-			{
-				// Calico creates some tiers on startup.
-				APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
-				Resources: []string{
-					"tiers",
-				},
-				Verbs: []string{"create"},
+		},
+	}
+}
+
+// typhaRulesBuilder builds the policy rules of the typha ClusterRole. Typha always gets read access to every resource
+// that its syncers watch, since it cannot get in sync without it. In the Minimal RBAC mode, the write access that the
+// ClusterRole otherwise shares with calico/node, for IPAM and the status of pods and nodes, is left out.
+type typhaRulesBuilder struct {
+	installation *operatorv1.InstallationSpec
+}
+
+func newTyphaRulesBuilder(installation *operatorv1.InstallationSpec) *typhaRulesBuilder {
+	return &typhaRulesBuilder{installation: installation}
+}
+
+// Rules returns the policy rules for the Installation.
+func (b *typhaRulesBuilder) Rules() []rbacv1.PolicyRule {
+	rules := b.coreRules()
+	if b.installation.Variant.IsEnterprise() {
+		rules = append(rules, b.enterpriseRules()...)
+	}
+	if b.installation.KubernetesProvider.IsOpenShift() {
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups:     []string{"security.openshift.io"},
+			Resources:     []string{"securitycontextconstraints"},
+			Verbs:         []string{"use"},
+			ResourceNames: []string{securitycontextconstraints.NonRootV2},
+		})
+	}
+	if b.installation.TyphaMetricsAuthEnabled() {
+		rules = append(rules, kubeRBACProxyRules()...)
+	}
+	return rules
+}
+
+// coreRules returns the rules for the Calico resources.
+func (b *typhaRulesBuilder) coreRules() []rbacv1.PolicyRule {
+	// Typha only reads the IPAM resources, the write access is for the calico/node and CNI plugin code paths.
+	ipamVerbs := []string{"get", "list"}
+	if !b.installation.MinimalRBACEnabled() {
+		ipamVerbs = append(ipamVerbs, "create", "update", "delete")
+	}
+
+	rules := []rbacv1.PolicyRule{
+		{
+			// Calico uses endpoint slices for service-based network policy rules.
+			APIGroups: []string{"discovery.k8s.io"},
+			Resources: []string{"endpointslices"},
+			Verbs:     []string{"list", "watch"},
+		},
+		{
+			// The CNI plugin needs to get pods, nodes, namespaces.
+			APIGroups: []string{""},
+			Resources: []string{"pods", "nodes", "namespaces"},
+			Verbs:     []string{"get"},
+		},
+		{
+			// Used to discover Typha endpoints and service IPs for advertisement.
+			APIGroups: []string{""},
+			Resources: []string{"endpoints", "services"},
+			Verbs:     []string{"watch", "list", "get"},
+		},
+		{
+			// For enforcing network policies.
+			APIGroups: []string{"networking.k8s.io"},
+			Resources: []string{"networkpolicies"},
+			Verbs:     []string{"watch", "list"},
+		},
+		{
+			// For enforcing k8s cluster network policies.
+			APIGroups: []string{"policy.networking.k8s.io"},
+			Resources: []string{
+				"clusternetworkpolicies",
+				"adminnetworkpolicies",
+				"baselineadminnetworkpolicies",
 			},
-			{
-				// Calico monitors nodes for some networking configuration.
-				APIGroups: []string{""},
-				Resources: []string{"nodes"},
-				Verbs:     []string{"get", "list", "watch"},
+			Verbs: []string{"watch", "list"},
+		},
+		{
+			// Metadata from these are used in conjunction with network policy.
+			APIGroups: []string{""},
+			Resources: []string{"pods", "namespaces", "serviceaccounts"},
+			Verbs:     []string{"watch", "list"},
+		},
+		{
+			// For monitoring Calico-specific configuration.
+			APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
+			Resources: []string{
+				"bgpconfigurations",
+				"bgppeers",
+				"bgpfilters",
+				"blockaffinities",
+				"caliconodestatuses",
+				"clusterinformations",
+				"felixconfigurations",
+				"globalnetworkpolicies",
+				"stagedglobalnetworkpolicies",
+				"networkpolicies",
+				"stagedkubernetesnetworkpolicies",
+				"stagednetworkpolicies",
+				"globalnetworksets",
+				"hostendpoints",
+				"ipamblocks",
+				"ippools",
+				"ipreservations",
+				"networksets",
+				"tiers",
 			},
-			{
-				// Most IPAM resources need full CRUD permissions so we can allocate and
-				// release IP addresses for pods.
-				APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
-				Resources: []string{
-					"blockaffinities",
-					"ipamblocks",
-					"ipamhandles",
-				},
-				Verbs: []string{"get", "list", "create", "update", "delete"},
+			Verbs: []string{"get", "list", "watch"},
+		},
+		{
+			// For migration code in calico/node startup only. Remove when the migration
+			// code is removed from node.
+			APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
+			Resources: []string{
+				"globalbgpconfigs",
+				"globalfelixconfigs",
 			},
-			{
-				// But, we only need to be able to query for IPAM config.
-				APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
-				Resources: []string{"ipamconfigurations"},
-				Verbs:     []string{"get"},
+			Verbs: []string{"get", "list", "watch"},
+		},
+		{
+			// Calico creates some configuration on startup.
+			APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
+			Resources: []string{
+				"clusterinformations",
+				"felixconfigurations",
+				"ippools",
 			},
-			{
-				// confd (and in some cases, felix) watches block affinities for route aggregation.
-				APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
-				Resources: []string{"blockaffinities"},
-				Verbs:     []string{"watch"},
+			Verbs: []string{"create", "update"},
+		},
+		{
+			// Calico creates some tiers on startup.
+			APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
+			Resources: []string{
+				"tiers",
 			},
-			{
-				// For monitoring KubeVirt live migration.
-				APIGroups: []string{"kubevirt.io"},
-				Resources: []string{"virtualmachineinstancemigrations"},
-				Verbs:     []string{"get", "list", "watch"},
+			Verbs: []string{"create"},
+		},
+		{
+			// Calico monitors nodes for some networking configuration.
+			APIGroups: []string{""},
+			Resources: []string{"nodes"},
+			Verbs:     []string{"get", "list", "watch"},
+		},
+		{
+			// Most IPAM resources need full CRUD permissions so we can allocate and
+			// release IP addresses for pods.
+			APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
+			Resources: []string{
+				"blockaffinities",
+				"ipamblocks",
+				"ipamhandles",
 			},
+			Verbs: ipamVerbs,
+		},
+		{
+			// But, we only need to be able to query for IPAM config.
+			APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
+			Resources: []string{"ipamconfigurations"},
+			Verbs:     []string{"get"},
+		},
+		{
+			// confd (and in some cases, felix) watches block affinities for route aggregation.
+			APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
+			Resources: []string{"blockaffinities"},
+			Verbs:     []string{"watch"},
+		},
+		{
+			// For monitoring KubeVirt live migration.
+			APIGroups: []string{"kubevirt.io"},
+			Resources: []string{"virtualmachineinstancemigrations"},
+			Verbs:     []string{"get", "list", "watch"},
 		},
 	}
-	if c.cfg.Installation.Variant.IsEnterprise() {
-		extraRules := []rbacv1.PolicyRule{
-			{
-				// Tigera Secure needs to be able to read licenses, and config.
-				APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
-				Resources: []string{
-					"bfdconfigurations",
-					"deeppacketinspections",
-					"egressgatewaypolicies",
-					"externalnetworks",
-					"licensekeys",
-					"networks",
-					"packetcaptures",
-					"remoteclusterconfigurations",
-				},
-				Verbs: []string{"get", "list", "watch"},
-			},
-		}
-		role.Rules = append(role.Rules, extraRules...)
+	if b.installation.MinimalRBACEnabled() {
+		return rules
 	}
-	if c.cfg.Installation.KubernetesProvider.IsOpenShift() {
-		role.Rules = append(role.Rules, rbacv1.PolicyRule{
-			APIGroups:     []string{"security.openshift.io"},
-			Resources:     []string{"securitycontextconstraints"},
-			Verbs:         []string{"use"},
-			ResourceNames: []string{securitycontextconstraints.NonRootV2},
-		})
+
+	return append(rules,
+		rbacv1.PolicyRule{
+			// Some information is stored on the node status.
+			APIGroups: []string{""},
+			Resources: []string{"nodes/status"},
+			Verbs:     []string{"patch", "update"},
+		},
+		rbacv1.PolicyRule{
+			// Calico patches the allocated IP onto the pod.
+			APIGroups: []string{""},
+			Resources: []string{"pods/status"},
+			Verbs:     []string{"patch"},
+		},
+	)
+}
+
+// enterpriseRules returns the rules for the Calico Enterprise resources.
+func (b *typhaRulesBuilder) enterpriseRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			// Tigera Secure needs to be able to read licenses, and config.
+			APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
+			Resources: []string{
+				"bfdconfigurations",
+				"deeppacketinspections",
+				"egressgatewaypolicies",
+				"externalnetworks",
+				"licensekeys",
+				"networks",
+				"packetcaptures",
+				"remoteclusterconfigurations",
+			},
+			Verbs: []string{"get", "list", "watch"},
+		},
 	}
-	return role
 }
 
 // typhaDeployment creates the typha deployment.
@@ -404,6 +597,12 @@ func (c *typhaComponent) typhaDeployment() []client.Object {
 	if c.cfg.TLS.TyphaSecret.UseCertificateManagement() {
 		initContainers = append(initContainers, c.cfg.TLS.TyphaSecret.InitContainer(common.CalicoNamespace, typhaContainer.SecurityContext))
 	}
+	if c.cfg.PrometheusServerTLS != nil {
+		annotations[c.cfg.PrometheusServerTLS.HashAnnotationKey()] = c.cfg.PrometheusServerTLS.HashAnnotationValue()
+		if c.cfg.PrometheusServerTLS.UseCertificateManagement() {
+			initContainers = append(initContainers, c.cfg.PrometheusServerTLS.InitContainer(common.CalicoNamespace, typhaContainer.SecurityContext))
+		}
+	}
 
 	// Include annotation for prometheus scraping configuration.
 	if c.cfg.Installation.TyphaMetricsPort != nil {
@@ -435,6 +634,7 @@ func (c *typhaComponent) typhaDeployment() []client.Object {
 				},
 			},
 			RevisionHistoryLimit: &revisionHistoryLimit,
+			Paused:               c.cfg.RolloutPaused,
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Annotations: annotations,
@@ -447,13 +647,13 @@ func (c *typhaComponent) typhaDeployment() []client.Object {
 					TerminationGracePeriodSeconds: &terminationGracePeriod,
 					HostNetwork:                   true,
 					InitContainers:                initContainers,
-					Containers:                    []corev1.Container{typhaContainer},
+					Containers:                    c.typhaContainers(typhaContainer),
 					Volumes:                       c.volumes(),
 				},
 			},
 		},
 	}
-	SetClusterCriticalPod(&deploy.Spec.Template)
+	setControlPlanePriorityClass(c.cfg.Installation, &deploy.Spec.Template)
 	if c.cfg.MigrateNamespaces {
 		migration.SetTyphaAntiAffinity(deploy)
 	}
@@ -462,10 +662,27 @@ func (c *typhaComponent) typhaDeployment() []client.Object {
 		rcomp.ApplyDeploymentOverrides(deploy, overrides)
 	}
 
+	// A topology spread constraint without a label selector does not count any pods, so default it to the typha
+	// pods. The constraints are copied from the overrides, so update them on a copy.
+	if tscs := deploy.Spec.Template.Spec.TopologySpreadConstraints; tscs != nil {
+		deploy.Spec.Template.Spec.TopologySpreadConstraints = make([]corev1.TopologySpreadConstraint, len(tscs))
+		for i, tsc := range tscs {
+			if tsc.LabelSelector == nil {
+				tsc.LabelSelector = &metav1.LabelSelector{MatchLabels: map[string]string{AppLabelName: TyphaK8sAppName}}
+			}
+			deploy.Spec.Template.Spec.TopologySpreadConstraints[i] = tsc
+		}
+	}
+
 	// ApplyDeploymentOverrides patches some fields that have consistency requirements elsewhere in the spec.
 	// fix up the other places.
 	c.applyPostOverrideFixUps(deploy)
 
+	objs := []client.Object{deploy}
+	for _, pool := range c.typhaPools() {
+		objs = append(objs, c.typhaPoolDeployment(deploy, pool))
+	}
+
 	if c.cfg.NonClusterHost != nil {
 		// Create a separate deployment to handle non-cluster host requests.
 		deployNonClusterHost := deploy.DeepCopy()
@@ -476,15 +693,54 @@ func (c *typhaComponent) typhaDeployment() []client.Object {
 		// Remove the affinity and use pod network
 		deployNonClusterHost.Spec.Template.Spec.Affinity = nil
 		deployNonClusterHost.Spec.Template.Spec.HostNetwork = false
+		// The rollout monitor only watches the main typha Deployment.
+		deployNonClusterHost.Spec.Paused = false
 		// Tune Typha container and volumes for NonClusterHost deployment.
-		deployNonClusterHost.Spec.Template.Spec.Containers = []corev1.Container{c.typhaContainerNonClusterHost()}
+		deployNonClusterHost.Spec.Template.Spec.Containers = c.typhaContainers(c.typhaContainerNonClusterHost())
 		deployNonClusterHost.Spec.Template.Spec.Volumes = c.volumeNonClusterHost()
-		return []client.Object{deploy, deployNonClusterHost}
+		// The replacement container carries the default shutdown timeout, so re-apply the fix ups.
+		c.applyPostOverrideFixUps(deployNonClusterHost)
+		objs = append(objs, deployNonClusterHost)
 	}
 
-	return []client.Object{deploy}
+	return objs
+}
+
+// typhaPoolDeployment creates the Deployment for a typha pool from the main typha Deployment, replacing its
+// scheduling constraints with those of the pool.
+func (c *typhaComponent) typhaPoolDeployment(deploy *appsv1.Deployment, pool operatorv1.TyphaDeploymentPool) *appsv1.Deployment {
+	d := deploy.DeepCopy()
+	d.Name = TyphaPoolName(pool.Name)
+	if d.Labels == nil {
+		d.Labels = map[string]string{}
+	}
+	d.Labels[TyphaPoolLabelName] = pool.Name
+	d.Spec.Selector = &metav1.LabelSelector{MatchLabels: typhaPoolSelector(pool.Name)}
+	if d.Spec.Template.Labels == nil {
+		d.Spec.Template.Labels = map[string]string{}
+	}
+	maps.Copy(d.Spec.Template.Labels, typhaPoolSelector(pool.Name))
+
+	// Pools are not managed by the typha autoscaler, so the replica count is set explicitly.
+	replicas := pool.GetReplicas()
+	d.Spec.Replicas = &replicas
+	// The rollout monitor only watches the main typha Deployment.
+	d.Spec.Paused = false
+
+	if pool.NodeSelector != nil {
+		d.Spec.Template.Spec.NodeSelector = pool.NodeSelector
+	}
+	if pool.Tolerations != nil {
+		d.Spec.Template.Spec.Tolerations = pool.Tolerations
+	}
+	if pool.Affinity != nil {
+		d.Spec.Template.Spec.Affinity = pool.Affinity
+	}
+	return d
 }
 
+// applyPostOverrideFixUps updates the parts of a typha Deployment that are derived from fields the TyphaDeployment
+// overrides may change. It must be called on each typha Deployment once its containers are final.
 func (c *typhaComponent) applyPostOverrideFixUps(d *appsv1.Deployment) {
 	// The deployment overrides may update the termination grace period and typha needs to know what the grace
 	// period is in order to calculate its shutdown disconnection rate.  Copy that over to an env var.
@@ -504,7 +760,8 @@ func (c *typhaComponent) applyPostOverrideFixUps(d *appsv1.Deployment) {
 	}
 
 	// If the termination grace period has been set to a very high value, make sure the Deployment's progress
-	// deadline takes account of that.
+	// deadline takes account of that. This matters most when the rollout isn't allowed to surge, since each
+	// replacement pod then waits for a back-level pod to finish shedding its load.
 	minProgressDeadline := int32(terminationGracePeriod * 120 / 100)
 	if minProgressDeadline < 600 {
 		// 600 is the Kubernetes default so let's not go below that.
@@ -517,32 +774,48 @@ func (c *typhaComponent) applyPostOverrideFixUps(d *appsv1.Deployment) {
 
 // volumes creates the typha's volumes.
 func (c *typhaComponent) volumes() []corev1.Volume {
-	return []corev1.Volume{
+	volumes := []corev1.Volume{
 		c.cfg.TLS.TrustedBundle.Volume(),
 		c.cfg.TLS.TyphaSecret.Volume(),
 	}
+	if c.cfg.PrometheusServerTLS != nil {
+		volumes = append(volumes, c.cfg.PrometheusServerTLS.Volume())
+	}
+	return volumes
 }
 
 func (c *typhaComponent) volumeNonClusterHost() []corev1.Volume {
-	return []corev1.Volume{
+	volumes := []corev1.Volume{
 		c.cfg.TLS.TrustedBundle.Volume(),
 		c.cfg.TLS.TyphaSecretNonClusterHost.Volume(),
 	}
+	if c.cfg.PrometheusServerTLS != nil {
+		volumes = append(volumes, c.cfg.PrometheusServerTLS.Volume())
+	}
+	return volumes
 }
 
 // typhaVolumeMounts creates the typha's volume mounts.
 func (c *typhaComponent) typhaVolumeMounts() []corev1.VolumeMount {
-	return append(
+	mounts := append(
 		c.cfg.TLS.TrustedBundle.VolumeMounts(c.SupportedOSType()),
 		c.cfg.TLS.TyphaSecret.VolumeMount(c.SupportedOSType()),
 	)
+	if c.cfg.PrometheusServerTLS != nil {
+		mounts = append(mounts, c.cfg.PrometheusServerTLS.VolumeMount(c.SupportedOSType()))
+	}
+	return mounts
 }
 
 func (c *typhaComponent) typhaVolumeMountsNonClusterHost() []corev1.VolumeMount {
-	return append(
+	mounts := append(
 		c.cfg.TLS.TrustedBundle.VolumeMounts(c.SupportedOSType()),
 		c.cfg.TLS.TyphaSecretNonClusterHost.VolumeMount(c.SupportedOSType()),
 	)
+	if c.cfg.PrometheusServerTLS != nil {
+		mounts = append(mounts, c.cfg.PrometheusServerTLS.VolumeMount(c.SupportedOSType()))
+	}
+	return mounts
 }
 
 func (c *typhaComponent) typhaPorts() []corev1.ContainerPort {
@@ -555,6 +828,16 @@ func (c *typhaComponent) typhaPorts() []corev1.ContainerPort {
 	}
 }
 
+// typhaContainers returns the containers of a typha pod: the given typha container, followed by the kube-rbac-proxy
+// that serves its metrics when Installation.TyphaMetricsAuth is TokenReview.
+func (c *typhaComponent) typhaContainers(typha corev1.Container) []corev1.Container {
+	containers := []corev1.Container{typha}
+	if c.cfg.Installation.TyphaMetricsAuthEnabled() {
+		containers = append(containers, kubeRBACProxyContainer(c.kubeRBACProxyImage, *c.cfg.Installation.TyphaMetricsPort, c.cfg.Installation.GetTyphaMetricsUpstreamPort(), c.cfg.PrometheusServerTLS, c.cfg.Installation))
+	}
+	return containers
+}
+
 // typhaContainer creates the main typha container.
 func (c *typhaComponent) typhaContainer() corev1.Container {
 	lp, rp := c.livenessReadinessProbes("localhost")
@@ -591,7 +874,7 @@ func (c *typhaComponent) typhaEnvVars(typhaSecret certificatemanagement.KeyPairI
 		{Name: "TYPHA_LOGSEVERITYSCREEN", Value: "info"},
 		{Name: "TYPHA_LOGFILEPATH", Value: "none"},
 		{Name: "TYPHA_LOGSEVERITYSYS", Value: "none"},
-		{Name: "TYPHA_CONNECTIONREBALANCINGMODE", Value: "kubernetes"},
+		{Name: "TYPHA_CONNECTIONREBALANCINGMODE", Value: c.connectionRebalancingMode()},
 		{Name: "TYPHA_DATASTORETYPE", Value: "kubernetes"},
 		{Name: "TYPHA_HEALTHENABLED", Value: "true"},
 		{Name: "TYPHA_HEALTHPORT", Value: fmt.Sprintf("%d", typhaHealthPort(c.cfg))},
@@ -601,6 +884,7 @@ func (c *typhaComponent) typhaEnvVars(typhaSecret certificatemanagement.KeyPairI
 		{Name: "TYPHA_SERVERKEYFILE", Value: typhaSecret.VolumeMountKeyFilePath()},
 		{Name: shutdownTimeoutEnvVar, Value: fmt.Sprint(defaultTyphaTerminationGracePeriod)}, // May get overridden later.
 	}
+	typhaEnv = append(typhaEnv, tlsProfileEnvVars(c.cfg.Installation)...)
 	// We need at least the CN or URISAN set, we depend on the validation
 	// done by the core_controller that the Secret will have one.
 	if c.cfg.TLS.TyphaCommonName != "" {
@@ -639,17 +923,56 @@ func (c *typhaComponent) typhaEnvVars(typhaSecret certificatemanagement.KeyPairI
 
 	typhaEnv = append(typhaEnv, c.cfg.K8sServiceEp.EnvVars()...)
 
-	if c.cfg.Installation.TyphaMetricsPort != nil {
+	if c.cfg.Installation.TyphaMetricsAuthEnabled() {
+		// Only kube-rbac-proxy, which serves the metrics port, can reach the metrics on the loopback interface.
+		typhaEnv = append(typhaEnv,
+			corev1.EnvVar{Name: "TYPHA_PROMETHEUSMETRICSENABLED", Value: "true"},
+			corev1.EnvVar{Name: "TYPHA_PROMETHEUSMETRICSHOST", Value: "127.0.0.1"},
+			corev1.EnvVar{Name: "TYPHA_PROMETHEUSMETRICSPORT", Value: fmt.Sprintf("%d", c.cfg.Installation.GetTyphaMetricsUpstreamPort())},
+		)
+	} else if c.cfg.Installation.TyphaMetricsPort != nil {
 		// If a typha metrics port was given, then enable typha prometheus metrics and set the port.
 		typhaEnv = append(typhaEnv,
 			corev1.EnvVar{Name: "TYPHA_PROMETHEUSMETRICSENABLED", Value: "true"},
 			corev1.EnvVar{Name: "TYPHA_PROMETHEUSMETRICSPORT", Value: fmt.Sprintf("%d", *c.cfg.Installation.TyphaMetricsPort)},
 		)
+		if c.cfg.PrometheusServerTLS != nil {
+			typhaEnv = append(typhaEnv,
+				corev1.EnvVar{Name: "TYPHA_PROMETHEUSMETRICSCERTFILE", Value: c.cfg.PrometheusServerTLS.VolumeMountCertificateFilePath()},
+				corev1.EnvVar{Name: "TYPHA_PROMETHEUSMETRICSKEYFILE", Value: c.cfg.PrometheusServerTLS.VolumeMountKeyFilePath()},
+			)
+		}
+	}
+
+	if tc := c.cfg.Installation.TyphaConfiguration; tc != nil {
+		if tc.MaxConnectionsUpperLimit != nil {
+			typhaEnv = append(typhaEnv, corev1.EnvVar{Name: "TYPHA_MAXCONNECTIONSUPPERLIMIT", Value: fmt.Sprintf("%d", *tc.MaxConnectionsUpperLimit)})
+		}
+		if tc.ConnectionRebalancingIntervalSeconds != nil {
+			typhaEnv = append(typhaEnv, corev1.EnvVar{Name: "TYPHA_K8SSERVICEPOLLINTERVALSECS", Value: fmt.Sprintf("%d", *tc.ConnectionRebalancingIntervalSeconds)})
+		}
+		if tc.ConnectionDropIntervalSeconds != nil {
+			typhaEnv = append(typhaEnv, corev1.EnvVar{Name: "TYPHA_CONNECTIONDROPINTERVALSECS", Value: fmt.Sprintf("%d", *tc.ConnectionDropIntervalSeconds)})
+		}
+		if tc.ShutdownMaxDropIntervalSeconds != nil {
+			// Typha's config parameter is ShutdownConnectionDropIntervalMaxSecs.
+			typhaEnv = append(typhaEnv, corev1.EnvVar{Name: "TYPHA_SHUTDOWNCONNECTIONDROPINTERVALMAXSECS", Value: fmt.Sprintf("%d", *tc.ShutdownMaxDropIntervalSeconds)})
+		}
 	}
 
 	return typhaEnv
 }
 
+// connectionRebalancingMode returns the value of TYPHA_CONNECTIONREBALANCINGMODE. Typha rebalances
+// connections using the Kubernetes API unless explicitly disabled.
+func (c *typhaComponent) connectionRebalancingMode() string {
+	if tc := c.cfg.Installation.TyphaConfiguration; tc != nil && tc.ConnectionRebalancingMode != nil &&
+		*tc.ConnectionRebalancingMode == operatorv1.TyphaConnectionRebalancingModeNone {
+		return "none"
+	}
+	return "kubernetes"
+}
+
 func replaceOrAppendEnvVar(envVars []corev1.EnvVar, key, value string) []corev1.EnvVar {
 	found := false
 	for i := range envVars {
@@ -673,7 +996,7 @@ func (c *typhaComponent) typhaEnvVarsNonClusterHost() []corev1.EnvVar {
 	envVars = replaceOrAppendEnvVar(envVars, "TYPHA_CLIENTURISAN", c.cfg.TLS.NodeNonClusterHostURISAN)
 
 	// Tell the health aggregator to listen on all interfaces.
-	envVars = append(envVars, corev1.EnvVar{Name: "TYPHA_HEALTHHOST", Value: "0.0.0.0"})
+	envVars = append(envVars, corev1.EnvVar{Name: "TYPHA_HEALTHHOST", Value: wildcardHost(c.cfg.Installation)})
 	return envVars
 }
 
@@ -734,15 +1057,26 @@ func (c *typhaComponent) typhaServices() []client.Object {
 			},
 		},
 	}
+	setServiceIPFamilies(svc, c.cfg.Installation)
+	rcomp.ApplyServiceOverrides(svc, c.cfg.Installation.TyphaService)
+
+	objs := []client.Object{svc}
+	for _, pool := range c.typhaPools() {
+		svcPool := svc.DeepCopy()
+		svcPool.Name = TyphaPoolName(pool.Name)
+		svcPool.Labels[TyphaPoolLabelName] = pool.Name
+		svcPool.Spec.Selector = typhaPoolSelector(pool.Name)
+		objs = append(objs, svcPool)
+	}
 
 	if c.cfg.NonClusterHost != nil {
 		svcNonClusterHost := svc.DeepCopy()
 		svcNonClusterHost.Name += TyphaNonClusterHostSuffix
 		svcNonClusterHost.Labels[AppLabelName] += TyphaNonClusterHostSuffix
 		svcNonClusterHost.Spec.Selector[AppLabelName] += TyphaNonClusterHostSuffix
-		return []client.Object{svc, svcNonClusterHost}
+		objs = append(objs, svcNonClusterHost)
 	}
-	return []client.Object{svc}
+	return objs
 }
 
 // affinity sets the user-specified typha affinity if specified.
@@ -760,6 +1094,11 @@ func (c *typhaComponent) affinity() (aff *corev1.Affinity) {
 		}
 
 	}
+	// If the user's topology spread constraints already balance typha across zones, they take the place of
+	// the default zone anti-affinity.
+	if c.spreadAcrossZones() {
+		return aff
+	}
 	if aff == nil {
 		aff = &corev1.Affinity{}
 	}
@@ -777,7 +1116,7 @@ func (c *typhaComponent) affinity() (aff *corev1.Affinity) {
 							},
 						},
 					},
-					TopologyKey: "topology.kubernetes.io/zone",
+					TopologyKey: corev1.LabelTopologyZone,
 				},
 			},
 		},
@@ -785,10 +1124,20 @@ func (c *typhaComponent) affinity() (aff *corev1.Affinity) {
 	return aff
 }
 
+// spreadAcrossZones returns true if the user-specified typha topology spread constraints spread typha across zones.
+func (c *typhaComponent) spreadAcrossZones() bool {
+	for _, tsc := range rcomp.GetTopologySpreadConstraints(c.cfg.Installation.TyphaDeployment) {
+		if tsc.TopologyKey == corev1.LabelTopologyZone {
+			return true
+		}
+	}
+	return false
+}
+
 // typhaPrometheusService service for scraping typha metrics.
 func (c *typhaComponent) typhaPrometheusService() *corev1.Service {
 	port := c.cfg.Installation.TyphaMetricsPort
-	return &corev1.Service{
+	svc := &corev1.Service{
 		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      TyphaMetricsName,
@@ -811,6 +1160,51 @@ func (c *typhaComponent) typhaPrometheusService() *corev1.Service {
 			},
 		},
 	}
+	setServiceIPFamilies(svc, c.cfg.Installation)
+	return svc
+}
+
+// typhaServiceMonitor creates a ServiceMonitor that scrapes the typha metrics service.
+func (c *typhaComponent) typhaServiceMonitor() *monitoringv1.ServiceMonitor {
+	endpoint := monitoringv1.Endpoint{
+		HonorLabels:   true,
+		Interval:      "30s",
+		Port:          TyphaMetricsName,
+		Path:          "/metrics",
+		Scheme:        ptr.To(monitoringv1.SchemeHTTP),
+		ScrapeTimeout: "10s",
+	}
+	if c.cfg.PrometheusServerTLS != nil {
+		// Verify typha's certificate against the CA bundle that the operator maintains in the same namespace.
+		endpoint.Scheme = ptr.To(monitoringv1.SchemeHTTPS)
+		endpoint.TLSConfig = &monitoringv1.TLSConfig{
+			SafeTLSConfig: monitoringv1.SafeTLSConfig{
+				CA: monitoringv1.SecretOrConfigMap{
+					ConfigMap: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: certificatemanagement.TrustedCertConfigMapName},
+						Key:                  certificatemanagement.TrustedCertConfigMapKeyName,
+					},
+				},
+				ServerName: ptr.To(TyphaMetricsName),
+			},
+		}
+	}
+	if c.cfg.Installation.TyphaMetricsAuthEnabled() {
+		// kube-rbac-proxy authorizes the scrapes with the token of the Prometheus service account.
+		endpoint.BearerTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	}
+	return &monitoringv1.ServiceMonitor{
+		TypeMeta: metav1.TypeMeta{Kind: monitoringv1.ServiceMonitorsKind, APIVersion: monitoringv1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TyphaMetricsName,
+			Namespace: common.CalicoNamespace,
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector:          metav1.LabelSelector{MatchLabels: map[string]string{AppLabelName: TyphaMetricsName}},
+			NamespaceSelector: monitoringv1.NamespaceSelector{MatchNames: []string{common.CalicoNamespace}},
+			Endpoints:         []monitoringv1.Endpoint{endpoint},
+		},
+	}
 }
 
 func typhaNonClusterHostCalicoSystemPolicy(cfg *TyphaConfiguration) *v3.NetworkPolicy {
@@ -834,6 +1228,18 @@ func typhaNonClusterHostCalicoSystemPolicy(cfg *TyphaConfiguration) *v3.NetworkP
 		},
 	}
 
+	// Only expose the non-cluster host typha's metrics once they are served over TLS, and only to Prometheus.
+	if cfg.PrometheusServerTLS != nil {
+		ingressRules = append(ingressRules, v3.Rule{
+			Action:   v3.Allow,
+			Protocol: &networkpolicy.TCPProtocol,
+			Source:   networkpolicy.PrometheusSourceEntityRule,
+			Destination: v3.EntityRule{
+				Ports: networkpolicy.Ports(uint16(*cfg.Installation.TyphaMetricsPort)),
+			},
+		})
+	}
+
 	if r, err := cfg.K8sServiceEp.DestinationEntityRule(); r != nil && err == nil {
 		egressRules = append(egressRules, v3.Rule{
 			Action:      v3.Allow,
@@ -22,7 +22,9 @@ import (
 	"github.com/onsi/gomega/gstruct"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,14 +33,19 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/apis"
+	vpav1 "github.com/tigera/operator/pkg/apis/vpa/v1"
 	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/components"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
 	"github.com/tigera/operator/pkg/controller/k8sapi"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
 	"github.com/tigera/operator/pkg/render"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/networkpolicy"
 	rtest "github.com/tigera/operator/pkg/render/common/test"
 )
 
@@ -116,6 +123,45 @@ var _ = Describe("Typha rendering tests", func() {
 		}))
 	})
 
+	It("should drop the write access typha does not use in the Minimal RBAC mode", func() {
+		typhaRules := func() []rbacv1.PolicyRule {
+			resources, _ := render.Typha(&cfg).Objects()
+			return rtest.GetResource(resources, "calico-typha", "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole).Rules
+		}
+		ipamRule := func(verbs ...string) rbacv1.PolicyRule {
+			return rbacv1.PolicyRule{
+				APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
+				Resources: []string{"blockaffinities", "ipamblocks", "ipamhandles"},
+				Verbs:     verbs,
+			}
+		}
+		nodeStatusRule := rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"nodes/status"}, Verbs: []string{"patch", "update"}}
+		podStatusRule := rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods/status"}, Verbs: []string{"patch"}}
+		enterpriseRule := rbacv1.PolicyRule{
+			APIGroups: []string{"projectcalico.org", "crd.projectcalico.org"},
+			Resources: []string{
+				"bfdconfigurations",
+				"deeppacketinspections",
+				"egressgatewaypolicies",
+				"externalnetworks",
+				"licensekeys",
+				"networks",
+				"packetcaptures",
+				"remoteclusterconfigurations",
+			},
+			Verbs: []string{"get", "list", "watch"},
+		}
+		cfg.Installation.Variant = operatorv1.CalicoEnterprise
+		Expect(typhaRules()).To(ContainElements(ipamRule("get", "list", "create", "update", "delete"), nodeStatusRule, podStatusRule, enterpriseRule))
+
+		By("keeping read access to every resource the syncers watch")
+		cfg.Installation.RBACMode = ptr.To(operatorv1.RBACModeMinimal)
+		rules := typhaRules()
+		Expect(rules).To(ContainElements(ipamRule("get", "list"), enterpriseRule))
+		Expect(rules).NotTo(ContainElement(nodeStatusRule))
+		Expect(rules).NotTo(ContainElement(podStatusRule))
+	})
+
 	It("should render all resources for a default configuration", func() {
 		expectedResources := []struct {
 			name    string
@@ -199,6 +245,173 @@ var _ = Describe("Typha rendering tests", func() {
 		Expect(d.Spec.Template.Spec.Containers[0].ReadinessProbe.ProbeHandler.HTTPGet.Host).To(BeEmpty())
 	})
 
+	It("should apply the termination grace period override to the non-cluster host Typha deployment", func() {
+		cfg.TLS.NodeNonClusterHostCommonName = "typha-client-noncluster-host"
+		installation.TyphaDeployment = &operatorv1.TyphaDeployment{
+			Spec: &operatorv1.TyphaDeploymentSpec{
+				Template: &operatorv1.TyphaDeploymentPodTemplateSpec{
+					Spec: &operatorv1.TyphaDeploymentPodSpec{
+						TerminationGracePeriodSeconds: ptr.To(int64(700)),
+					},
+				},
+			},
+		}
+		component := render.Typha(&cfg)
+		resources, _ := component.Objects()
+
+		for _, name := range []string{"calico-typha", "calico-typha-noncluster-host"} {
+			d := rtest.GetResource(resources, name, "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(*d.Spec.Template.Spec.TerminationGracePeriodSeconds).To(Equal(int64(700)), name)
+			Expect(*d.Spec.ProgressDeadlineSeconds).To(Equal(int32(700*120/100)), name)
+			Expect(d.Spec.Template.Spec.Containers[0].Env).To(ContainElement(
+				corev1.EnvVar{Name: "TYPHA_SHUTDOWNTIMEOUTSECS", Value: "700"}), name)
+		}
+	})
+
+	It("should pause only the main Typha deployment rollout when requested", func() {
+		cfg.TLS.NodeNonClusterHostCommonName = "typha-client-noncluster-host"
+		cfg.RolloutPaused = true
+		component := render.Typha(&cfg)
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Paused).To(BeTrue())
+		d = rtest.GetResource(resources, "calico-typha-noncluster-host", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Paused).To(BeFalse())
+	})
+
+	It("should render a Deployment and Service for each typha pool", func() {
+		installation.TyphaDeployment = &operatorv1.TyphaDeployment{
+			Spec: &operatorv1.TyphaDeploymentSpec{
+				Pools: []operatorv1.TyphaDeploymentPool{
+					{
+						Name:         "infra",
+						Replicas:     ptr.To(int32(2)),
+						NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+						Tolerations:  []corev1.Toleration{{Key: "infra", Operator: corev1.TolerationOpExists}},
+					},
+					{Name: "general"},
+				},
+			},
+		}
+		cfg.RolloutPaused = true
+		component := render.Typha(&cfg)
+		resources, _ := component.Objects()
+
+		mainDeploy := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(mainDeploy.Spec.Replicas).To(BeNil())
+
+		d := rtest.GetResource(resources, "calico-typha-infra", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(*d.Spec.Replicas).To(Equal(int32(2)))
+		Expect(d.Spec.Paused).To(BeFalse())
+		Expect(d.Labels).To(HaveKeyWithValue("operator.tigera.io/typha-pool", "infra"))
+		Expect(d.Spec.Selector.MatchLabels).To(Equal(map[string]string{
+			"k8s-app":                       "calico-typha",
+			"operator.tigera.io/typha-pool": "infra",
+		}))
+		Expect(d.Spec.Template.Labels).To(HaveKeyWithValue("k8s-app", "calico-typha"))
+		Expect(d.Spec.Template.Labels).To(HaveKeyWithValue("operator.tigera.io/typha-pool", "infra"))
+		Expect(d.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"node-role.kubernetes.io/infra": ""}))
+		Expect(d.Spec.Template.Spec.Tolerations).To(ConsistOf(corev1.Toleration{Key: "infra", Operator: corev1.TolerationOpExists}))
+		Expect(d.Spec.Template.Spec.Affinity).To(Equal(mainDeploy.Spec.Template.Spec.Affinity))
+
+		d = rtest.GetResource(resources, "calico-typha-general", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(*d.Spec.Replicas).To(Equal(int32(1)))
+		Expect(d.Spec.Template.Spec.Tolerations).To(Equal(mainDeploy.Spec.Template.Spec.Tolerations))
+
+		svc := rtest.GetResource(resources, "calico-typha-infra", "calico-system", "", "v1", "Service").(*corev1.Service)
+		Expect(svc.Spec.Selector).To(Equal(map[string]string{
+			"k8s-app":                       "calico-typha",
+			"operator.tigera.io/typha-pool": "infra",
+		}))
+		// The calico-typha Service used by Felix selects the pods of every pool.
+		svc = rtest.GetResource(resources, "calico-typha", "calico-system", "", "v1", "Service").(*corev1.Service)
+		Expect(svc.Spec.Selector).To(Equal(map[string]string{"k8s-app": "calico-typha"}))
+	})
+
+	It("should delete the Deployment and Service of stale typha pools", func() {
+		cfg.StaleTyphaPools = []string{"old"}
+		component := render.Typha(&cfg)
+		resources, toDelete := component.Objects()
+
+		Expect(rtest.GetResource(resources, "calico-typha-old", "calico-system", "apps", "v1", "Deployment")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, "calico-typha-old", "calico-system", "apps", "v1", "Deployment")).NotTo(BeNil())
+		Expect(rtest.GetResource(toDelete, "calico-typha-old", "calico-system", "", "v1", "Service")).NotTo(BeNil())
+	})
+
+	It("should delete the PodDisruptionBudget when PodDisruptionBudgets are disabled", func() {
+		installation.PodDisruptionBudgets = ptr.To(operatorv1.PodDisruptionBudgetsDisabled)
+		component := render.Typha(&cfg)
+		resources, toDelete := component.Objects()
+
+		Expect(rtest.GetResource(resources, "calico-typha", "calico-system", "policy", "v1", "PodDisruptionBudget")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, "calico-typha", "calico-system", "policy", "v1", "PodDisruptionBudget")).NotTo(BeNil())
+	})
+
+	It("should apply the TLS security profile", func() {
+		installation.TLSSecurityProfile = &operatorv1.TLSSecurityProfile{Type: operatorv1.TLSProfileIntermediateType}
+		component := render.Typha(&cfg)
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "TLS_MIN_VERSION", Value: "1.2"},
+			corev1.EnvVar{Name: "TLS_CIPHER_SUITES", Value: "TLS_AES_128_GCM_SHA256,TLS_AES_256_GCM_SHA384,TLS_CHACHA20_POLY1305_SHA256," +
+				"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384," +
+				"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256"},
+		))
+	})
+
+	It("should render IPv6 single-stack services and health host for IPv6-only clusters", func() {
+		var typhaMetricsPort int32 = 1234
+		installation.TyphaMetricsPort = &typhaMetricsPort
+		installation.CalicoNetwork = &operatorv1.CalicoNetworkSpec{
+			IPPools: []operatorv1.IPPool{{CIDR: "fd00::/64"}},
+		}
+		cfg.TLS.NodeNonClusterHostCommonName = "typha-client-noncluster-host"
+		component := render.Typha(&cfg)
+		resources, _ := component.Objects()
+
+		for _, name := range []string{"calico-typha", "calico-typha-noncluster-host", "calico-typha-metrics"} {
+			svc := rtest.GetResource(resources, name, "calico-system", "", "v1", "Service").(*corev1.Service)
+			Expect(svc.Spec.IPFamilies).To(Equal([]corev1.IPFamily{corev1.IPv6Protocol}))
+			Expect(*svc.Spec.IPFamilyPolicy).To(Equal(corev1.IPFamilyPolicySingleStack))
+		}
+
+		d := rtest.GetResource(resources, "calico-typha-noncluster-host", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "TYPHA_HEALTHHOST", Value: "::"}))
+	})
+
+	It("should render dual-stack services for dual-stack clusters", func() {
+		installation.CalicoNetwork = &operatorv1.CalicoNetworkSpec{
+			IPPools: []operatorv1.IPPool{{CIDR: "192.168.0.0/16"}, {CIDR: "fd00::/64"}},
+		}
+		cfg.TLS.NodeNonClusterHostCommonName = "typha-client-noncluster-host"
+		component := render.Typha(&cfg)
+		resources, _ := component.Objects()
+
+		for _, name := range []string{"calico-typha", "calico-typha-noncluster-host"} {
+			svc := rtest.GetResource(resources, name, "calico-system", "", "v1", "Service").(*corev1.Service)
+			Expect(svc.Spec.IPFamilies).To(BeEmpty())
+			Expect(*svc.Spec.IPFamilyPolicy).To(Equal(corev1.IPFamilyPolicyPreferDualStack))
+		}
+
+		d := rtest.GetResource(resources, "calico-typha-noncluster-host", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "TYPHA_HEALTHHOST", Value: "::"}))
+	})
+
+	It("should leave service IP families unset for IPv4-only clusters", func() {
+		installation.CalicoNetwork = &operatorv1.CalicoNetworkSpec{
+			IPPools: []operatorv1.IPPool{{CIDR: "192.168.0.0/16"}},
+		}
+		component := render.Typha(&cfg)
+		resources, _ := component.Objects()
+
+		svc := rtest.GetResource(resources, "calico-typha", "calico-system", "", "v1", "Service").(*corev1.Service)
+		Expect(svc.Spec.IPFamilies).To(BeEmpty())
+		Expect(svc.Spec.IPFamilyPolicy).To(BeNil())
+	})
+
 	It("should use custom client common name when specified for non-cluster host Typha deployment", func() {
 		cfg.TLS.NodeNonClusterHostCommonName = "custom-nch-cn"
 		component := render.Typha(&cfg)
@@ -250,6 +463,37 @@ var _ = Describe("Typha rendering tests", func() {
 		Expect(tc.Image).To(ContainSubstring("-fips"))
 	})
 
+	It("should render the FIPS image when FIPS mode is enabled (Enterprise)", func() {
+		cfg.Installation.Variant = operatorv1.CalicoEnterprise
+		cfg.Installation.FIPSMode = ptr.To(operatorv1.FIPSModeEnabled)
+		component := render.Typha(&cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.Containers[0].Image).To(Equal(fmt.Sprintf("%s/%s%s:%s",
+			registry, components.TigeraImagePath, components.ComponentTigeraCalicoFIPS.Image, components.ComponentTigeraCalicoFIPS.Version)))
+	})
+
+	It("should make typha cluster critical by default", func() {
+		component := render.Typha(&cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.PriorityClassName).To(Equal(render.ClusterPriorityClassName))
+	})
+
+	It("should use the control plane PriorityClass when the operator manages PriorityClasses", func() {
+		cfg.Installation.ManagedPriorityClasses = ptr.To(operatorv1.ManagedPriorityClassesEnabled)
+		component := render.Typha(&cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.PriorityClassName).To(Equal(render.ControlPlanePriorityClassName))
+	})
+
 	It("should include updates needed for migration of core components from kube-system namespace", func() {
 		expectedResources := []struct {
 			name    string
@@ -440,6 +684,62 @@ var _ = Describe("Typha rendering tests", func() {
 		Expect(paa[0]).To(Equal(expected))
 	})
 
+	It("should replace the zone affinity with a zone topology spread constraint", func() {
+		cfg.Installation.TyphaDeployment = &operatorv1.TyphaDeployment{
+			Spec: &operatorv1.TyphaDeploymentSpec{
+				Template: &operatorv1.TyphaDeploymentPodTemplateSpec{
+					Spec: &operatorv1.TyphaDeploymentPodSpec{
+						TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+							MaxSkew:           1,
+							TopologyKey:       "topology.kubernetes.io/zone",
+							WhenUnsatisfiable: corev1.ScheduleAnyway,
+						}},
+					},
+				},
+			},
+		}
+		component := render.Typha(&cfg)
+		resources, _ := component.Objects()
+		d := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+
+		Expect(d.Spec.Template.Spec.Affinity).To(BeNil())
+		Expect(d.Spec.Template.Spec.TopologySpreadConstraints).To(ConsistOf(corev1.TopologySpreadConstraint{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "calico-typha"}},
+		}))
+		// The overrides are left untouched.
+		Expect(cfg.Installation.TyphaDeployment.Spec.Template.Spec.TopologySpreadConstraints[0].LabelSelector).To(BeNil())
+	})
+
+	It("should keep the zone affinity alongside other topology spread constraints", func() {
+		selector := &metav1.LabelSelector{MatchLabels: map[string]string{"custom": "label"}}
+		cfg.Installation.TyphaDeployment = &operatorv1.TyphaDeployment{
+			Spec: &operatorv1.TyphaDeploymentSpec{
+				Template: &operatorv1.TyphaDeploymentPodTemplateSpec{
+					Spec: &operatorv1.TyphaDeploymentPodSpec{
+						TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+							MaxSkew:           1,
+							TopologyKey:       "kubernetes.io/hostname",
+							WhenUnsatisfiable: corev1.DoNotSchedule,
+							LabelSelector:     selector,
+						}},
+					},
+				},
+			},
+		}
+		component := render.Typha(&cfg)
+		resources, _ := component.Objects()
+		d := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+
+		paa := d.Spec.Template.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+		Expect(paa).To(HaveLen(1))
+		Expect(paa[0].PodAffinityTerm.TopologyKey).To(Equal("topology.kubernetes.io/zone"))
+		Expect(d.Spec.Template.Spec.TopologySpreadConstraints).To(HaveLen(1))
+		Expect(d.Spec.Template.Spec.TopologySpreadConstraints[0].LabelSelector).To(Equal(selector))
+	})
+
 	It("should render all resources when certificate management is enabled", func() {
 		cfg.Installation.CertificateManagement = &operatorv1.CertificateManagement{SignerName: "a.b/c", CACert: cfg.TLS.TyphaSecret.GetCertificatePEM()}
 		certificateManager, err := certificatemanager.Create(cli, cfg.Installation, clusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
@@ -483,6 +783,31 @@ var _ = Describe("Typha rendering tests", func() {
 		rtest.ExpectEnv(deploy.Spec.Template.Spec.InitContainers[0].Env, "SIGNER", "a.b/c")
 	})
 
+	It("should render connection tuning env vars from TyphaConfiguration", func() {
+		installation.TyphaConfiguration = &operatorv1.TyphaConfiguration{
+			MaxConnectionsUpperLimit:             ptr.To[int32](20000),
+			ConnectionRebalancingMode:            ptr.To(operatorv1.TyphaConnectionRebalancingModeNone),
+			ConnectionRebalancingIntervalSeconds: ptr.To[int32](60),
+			ConnectionDropIntervalSeconds:        ptr.To[int32](2),
+			ShutdownMaxDropIntervalSeconds:       ptr.To[int32](5),
+		}
+		component := render.Typha(&cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		env := d.Spec.Template.Spec.Containers[0].Env
+		// Typha ignores unknown env vars, so pin the names to those of its config parameters.
+		Expect(env).To(ContainElements(
+			corev1.EnvVar{Name: "TYPHA_MAXCONNECTIONSUPPERLIMIT", Value: "20000"},
+			corev1.EnvVar{Name: "TYPHA_CONNECTIONREBALANCINGMODE", Value: "none"},
+			corev1.EnvVar{Name: "TYPHA_K8SSERVICEPOLLINTERVALSECS", Value: "60"},
+			corev1.EnvVar{Name: "TYPHA_CONNECTIONDROPINTERVALSECS", Value: "2"},
+			corev1.EnvVar{Name: "TYPHA_SHUTDOWNCONNECTIONDROPINTERVALMAXSECS", Value: "5"},
+		))
+		Expect(env).NotTo(ContainElement(corev1.EnvVar{Name: "TYPHA_CONNECTIONREBALANCINGMODE", Value: "kubernetes"}))
+	})
+
 	It("should not enable prometheus metrics if TyphaMetricsPort is nil", func() {
 		installation.Variant = operatorv1.CalicoEnterprise
 		installation.TyphaMetricsPort = nil
@@ -545,6 +870,214 @@ var _ = Describe("Typha rendering tests", func() {
 		}))
 	})
 
+	It("should render a ServiceMonitor for typha metrics when the CRD exists", func() {
+		var typhaMetricsPort int32 = 9093
+		installation.TyphaMetricsPort = &typhaMetricsPort
+		cfg.ServiceMonitorCRDExists = true
+		component := render.Typha(&cfg)
+		resources, toDelete := component.Objects()
+		Expect(rtest.GetResource(toDelete, "calico-typha-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor")).To(BeNil())
+
+		sm := rtest.GetResource(resources, "calico-typha-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor").(*monitoringv1.ServiceMonitor)
+		Expect(sm.Spec.Selector.MatchLabels).To(Equal(map[string]string{"k8s-app": "calico-typha-metrics"}))
+		Expect(sm.Spec.NamespaceSelector.MatchNames).To(ConsistOf("calico-system"))
+		Expect(sm.Spec.Endpoints).To(HaveLen(1))
+		Expect(sm.Spec.Endpoints[0].Port).To(Equal("calico-typha-metrics"))
+	})
+
+	It("should render VerticalPodAutoscalers for the typha Deployments when the CRD exists", func() {
+		cfg.VPACRDExists = true
+		installation.VerticalPodAutoscaling = &operatorv1.VerticalPodAutoscaling{UpdateMode: ptr.To(operatorv1.VPAUpdateModeRecreate)}
+		installation.TyphaDeployment = &operatorv1.TyphaDeployment{
+			Spec: &operatorv1.TyphaDeploymentSpec{Pools: []operatorv1.TyphaDeploymentPool{{Name: "east"}}},
+		}
+		cfg.StaleTyphaPools = []string{"west"}
+		resources, toDelete := render.Typha(&cfg).Objects()
+
+		for _, name := range []string{"calico-typha", "calico-typha-east"} {
+			v := rtest.GetResource(resources, name, "calico-system", "autoscaling.k8s.io", "v1", "VerticalPodAutoscaler").(*vpav1.VerticalPodAutoscaler)
+			Expect(*v.Spec.TargetRef).To(Equal(autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: name}))
+			Expect(*v.Spec.UpdatePolicy.UpdateMode).To(Equal("Recreate"))
+			Expect(v.Spec.ResourcePolicy.ContainerPolicies).To(ConsistOf(vpav1.ContainerResourcePolicy{
+				ContainerName:    "*",
+				ControlledValues: ptr.To("RequestsOnly"),
+			}))
+		}
+		Expect(rtest.GetResource(toDelete, "calico-typha-west", "calico-system", "autoscaling.k8s.io", "v1", "VerticalPodAutoscaler")).NotTo(BeNil())
+
+		installation.VerticalPodAutoscaling = nil
+		resources, toDelete = render.Typha(&cfg).Objects()
+		Expect(rtest.GetResource(resources, "calico-typha", "calico-system", "autoscaling.k8s.io", "v1", "VerticalPodAutoscaler")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, "calico-typha", "calico-system", "autoscaling.k8s.io", "v1", "VerticalPodAutoscaler")).NotTo(BeNil())
+
+		cfg.VPACRDExists = false
+		installation.VerticalPodAutoscaling = &operatorv1.VerticalPodAutoscaling{}
+		resources, _ = render.Typha(&cfg).Objects()
+		Expect(rtest.GetResource(resources, "calico-typha", "calico-system", "autoscaling.k8s.io", "v1", "VerticalPodAutoscaler")).To(BeNil())
+	})
+
+	It("should render the typha PodDisruptionBudget overrides", func() {
+		resources, _ := render.Typha(&cfg).Objects()
+		pdb := rtest.GetResource(resources, "calico-typha", "calico-system", "policy", "v1", "PodDisruptionBudget").(*policyv1.PodDisruptionBudget)
+		Expect(pdb.Spec.MaxUnavailable).To(Equal(ptr.To(intstr.FromInt(1))))
+		Expect(pdb.Spec.MinAvailable).To(BeNil())
+
+		installation.TyphaDeployment = &operatorv1.TyphaDeployment{
+			Spec: &operatorv1.TyphaDeploymentSpec{
+				PodDisruptionBudget: &operatorv1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromString("50%"))},
+			},
+		}
+		resources, _ = render.Typha(&cfg).Objects()
+		pdb = rtest.GetResource(resources, "calico-typha", "calico-system", "policy", "v1", "PodDisruptionBudget").(*policyv1.PodDisruptionBudget)
+		Expect(pdb.Spec.MinAvailable).To(Equal(ptr.To(intstr.FromString("50%"))))
+		Expect(pdb.Spec.MaxUnavailable).To(BeNil())
+	})
+
+	It("should serve typha metrics over TLS", func() {
+		var typhaMetricsPort int32 = 9093
+		installation.TyphaMetricsPort = &typhaMetricsPort
+		installation.TyphaMetricsTLS = ptr.To(operatorv1.TyphaMetricsTLSEnabled)
+		certificateManager, err := certificatemanager.Create(cli, nil, clusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+		Expect(err).NotTo(HaveOccurred())
+		prometheusTLS, err := certificateManager.GetOrCreateKeyPair(cli, render.TyphaPrometheusTLSServerSecret, common.OperatorNamespace(), []string{"calico-typha-metrics"})
+		Expect(err).NotTo(HaveOccurred())
+		cfg.PrometheusServerTLS = prometheusTLS
+		cfg.ServiceMonitorCRDExists = true
+		component := render.Typha(&cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		for _, name := range []string{"calico-typha", "calico-typha-noncluster-host"} {
+			d := rtest.GetResource(resources, name, "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(d.Spec.Template.Spec.Volumes).To(ContainElement(prometheusTLS.Volume()))
+			Expect(d.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(prometheusTLS.VolumeMount(rmeta.OSTypeLinux)))
+			Expect(d.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+				corev1.EnvVar{Name: "TYPHA_PROMETHEUSMETRICSCERTFILE", Value: "/calico-typha-prometheus-server-tls/tls.crt"},
+				corev1.EnvVar{Name: "TYPHA_PROMETHEUSMETRICSKEYFILE", Value: "/calico-typha-prometheus-server-tls/tls.key"},
+			))
+		}
+		d := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Annotations).To(HaveKey(prometheusTLS.HashAnnotationKey()))
+
+		sm := rtest.GetResource(resources, "calico-typha-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor").(*monitoringv1.ServiceMonitor)
+		Expect(*sm.Spec.Endpoints[0].Scheme).To(Equal(monitoringv1.SchemeHTTPS))
+		Expect(sm.Spec.Endpoints[0].TLSConfig.CA.ConfigMap.Name).To(Equal("tigera-ca-bundle"))
+		Expect(*sm.Spec.Endpoints[0].TLSConfig.ServerName).To(Equal("calico-typha-metrics"))
+
+		policies, _ := render.NewTyphaNonClusterHostPolicy(&cfg).Objects()
+		policy := rtest.GetResource(policies, "calico-system.typha-noncluster-host-access", "calico-system", "projectcalico.org", "v3", "NetworkPolicy").(*v3.NetworkPolicy)
+		Expect(policy.Spec.Ingress).To(ContainElement(v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Source:      networkpolicy.PrometheusSourceEntityRule,
+			Destination: v3.EntityRule{Ports: networkpolicy.Ports(9093)},
+		}))
+	})
+
+	It("should serve typha metrics through kube-rbac-proxy", func() {
+		installation.TyphaMetricsPort = ptr.To(int32(9093))
+		installation.TyphaMetricsAuth = ptr.To(operatorv1.MetricsAuthTokenReview)
+		certificateManager, err := certificatemanager.Create(cli, nil, clusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+		Expect(err).NotTo(HaveOccurred())
+		prometheusTLS, err := certificateManager.GetOrCreateKeyPair(cli, render.TyphaPrometheusTLSServerSecret, common.OperatorNamespace(), []string{"calico-typha-metrics"})
+		Expect(err).NotTo(HaveOccurred())
+		cfg.PrometheusServerTLS = prometheusTLS
+		cfg.ServiceMonitorCRDExists = true
+		component := render.Typha(&cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.Containers).To(HaveLen(2))
+		typha := d.Spec.Template.Spec.Containers[0]
+		Expect(typha.Env).To(ContainElements(
+			corev1.EnvVar{Name: "TYPHA_PROMETHEUSMETRICSHOST", Value: "127.0.0.1"},
+			corev1.EnvVar{Name: "TYPHA_PROMETHEUSMETRICSPORT", Value: "9096"},
+		))
+		Expect(typha.Env).NotTo(ContainElement(HaveField("Name", "TYPHA_PROMETHEUSMETRICSCERTFILE")))
+
+		proxy := d.Spec.Template.Spec.Containers[1]
+		Expect(proxy.Name).To(Equal("kube-rbac-proxy"))
+		Expect(proxy.Image).To(ContainSubstring("kube-rbac-proxy"))
+		Expect(proxy.Args).To(ConsistOf(
+			"--secure-listen-address=:9093",
+			"--upstream=http://127.0.0.1:9096/",
+			"--allow-paths=/metrics",
+			"--tls-cert-file=/calico-typha-prometheus-server-tls/tls.crt",
+			"--tls-private-key-file=/calico-typha-prometheus-server-tls/tls.key",
+		))
+		Expect(proxy.VolumeMounts).To(ConsistOf(prometheusTLS.VolumeMount(rmeta.OSTypeLinux)))
+		Expect(proxy.Ports).To(ConsistOf(corev1.ContainerPort{Name: "metrics-proxy", ContainerPort: 9093, Protocol: corev1.ProtocolTCP}))
+
+		role := rtest.GetResource(resources, "calico-typha", "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole)
+		Expect(role.Rules).To(ContainElements(
+			rbacv1.PolicyRule{APIGroups: []string{"authentication.k8s.io"}, Resources: []string{"tokenreviews"}, Verbs: []string{"create"}},
+			rbacv1.PolicyRule{APIGroups: []string{"authorization.k8s.io"}, Resources: []string{"subjectaccessreviews"}, Verbs: []string{"create"}},
+		))
+
+		sm := rtest.GetResource(resources, "calico-typha-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor").(*monitoringv1.ServiceMonitor)
+		Expect(*sm.Spec.Endpoints[0].Scheme).To(Equal(monitoringv1.SchemeHTTPS))
+		Expect(sm.Spec.Endpoints[0].BearerTokenFile).To(Equal("/var/run/secrets/kubernetes.io/serviceaccount/token"))
+
+		By("allowing the operator to scrape the metrics")
+		reader := rtest.GetResource(resources, "calico-typha-metrics-reader", "", "rbac.authorization.k8s.io", "v1", "ClusterRole").(*rbacv1.ClusterRole)
+		Expect(reader.Rules).To(ConsistOf(rbacv1.PolicyRule{NonResourceURLs: []string{"/metrics"}, Verbs: []string{"get"}}))
+		binding := rtest.GetResource(resources, "calico-typha-metrics-reader", "", "rbac.authorization.k8s.io", "v1", "ClusterRoleBinding").(*rbacv1.ClusterRoleBinding)
+		Expect(binding.Subjects).To(ConsistOf(rbacv1.Subject{Kind: "ServiceAccount", Name: "tigera-operator", Namespace: "tigera-operator"}))
+
+		By("serving the metrics on the configured upstream port")
+		installation.TyphaMetricsUpstreamPort = ptr.To(int32(9196))
+		resources, _ = component.Objects()
+		d = rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "TYPHA_PROMETHEUSMETRICSPORT", Value: "9196"}))
+		Expect(d.Spec.Template.Spec.Containers[1].Args).To(ContainElement("--upstream=http://127.0.0.1:9196/"))
+	})
+
+	It("should not render a ServiceMonitor for typha metrics when the CRD does not exist", func() {
+		var typhaMetricsPort int32 = 9093
+		installation.TyphaMetricsPort = &typhaMetricsPort
+		component := render.Typha(&cfg)
+		resources, toDelete := component.Objects()
+		Expect(rtest.GetResource(toDelete, "calico-typha-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor")).To(BeNil())
+		Expect(rtest.GetResource(resources, "calico-typha-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor")).To(BeNil())
+	})
+
+	It("should delete the typha ServiceMonitor when metrics are disabled or rendered by the monitor controller", func() {
+		cfg.ServiceMonitorCRDExists = true
+		component := render.Typha(&cfg)
+		_, toDelete := component.Objects()
+		Expect(rtest.GetResource(toDelete, "calico-typha-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor")).NotTo(BeNil())
+
+		var typhaMetricsPort int32 = 9093
+		installation.TyphaMetricsPort = &typhaMetricsPort
+		installation.Variant = operatorv1.CalicoEnterprise
+		component = render.Typha(&cfg)
+		resources, toDelete := component.Objects()
+		Expect(rtest.GetResource(resources, "calico-typha-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor")).To(BeNil())
+		Expect(rtest.GetResource(toDelete, "calico-typha-metrics", "calico-system", "monitoring.coreos.com", "v1", "ServiceMonitor")).NotTo(BeNil())
+	})
+
+	It("should apply the typha Service overrides to every typha Service", func() {
+		installation.TyphaService = &operatorv1.ServiceOverrides{
+			Type:                  ptr.To(corev1.ServiceTypeLoadBalancer),
+			Annotations:           map[string]string{"service.beta.kubernetes.io/aws-load-balancer-internal": "true"},
+			ExternalTrafficPolicy: ptr.To(corev1.ServiceExternalTrafficPolicyLocal),
+			InternalTrafficPolicy: ptr.To(corev1.ServiceInternalTrafficPolicyLocal),
+			SessionAffinity:       ptr.To(corev1.ServiceAffinityClientIP),
+		}
+		cfg.NonClusterHost = &operatorv1.NonClusterHost{}
+		component := render.Typha(&cfg)
+		resources, _ := component.Objects()
+		for _, name := range []string{"calico-typha", "calico-typha-noncluster-host"} {
+			svc := rtest.GetResource(resources, name, "calico-system", "", "v1", "Service").(*corev1.Service)
+			Expect(svc.Spec.Type).To(Equal(corev1.ServiceTypeLoadBalancer))
+			Expect(svc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-internal", "true"))
+			Expect(svc.Spec.ExternalTrafficPolicy).To(Equal(corev1.ServiceExternalTrafficPolicyLocal))
+			Expect(*svc.Spec.InternalTrafficPolicy).To(Equal(corev1.ServiceInternalTrafficPolicyLocal))
+			Expect(svc.Spec.SessionAffinity).To(Equal(corev1.ServiceAffinityClientIP))
+		}
+	})
+
 	Context("With typha deployment overrides", func() {
 		rr1 := corev1.ResourceRequirements{
 			Limits: corev1.ResourceList{
@@ -626,8 +1159,9 @@ var _ = Describe("Typha rendering tests", func() {
 									MaxSkew: 1,
 								},
 							},
-							Affinity:    affinity,
-							Tolerations: []corev1.Toleration{toleration},
+							Affinity:        affinity,
+							Tolerations:     []corev1.Toleration{toleration},
+							ImagePullPolicy: ptr.To(corev1.PullNever),
 						},
 					},
 				},
@@ -674,6 +1208,7 @@ var _ = Describe("Typha rendering tests", func() {
 			Expect(d.Spec.Template.Spec.Containers).To(HaveLen(1))
 			Expect(d.Spec.Template.Spec.Containers[0].Name).To(Equal("calico-typha"))
 			Expect(d.Spec.Template.Spec.Containers[0].Resources).To(Equal(rr1))
+			Expect(d.Spec.Template.Spec.Containers[0].ImagePullPolicy).To(Equal(corev1.PullNever))
 
 			Expect(d.Spec.Template.Spec.NodeSelector).To(HaveLen(1))
 			Expect(d.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("custom-node-selector", "value"))
@@ -698,6 +1233,79 @@ var _ = Describe("Typha rendering tests", func() {
 			Expect(found).To(BeTrue(), "Typha deployment was missing TYPHA_SHUTDOWNTIMEOUTSECS env var")
 		})
 
+		It("should override the probe timings when specified", func() {
+			installation.TyphaDeployment = &operatorv1.TyphaDeployment{
+				Spec: &operatorv1.TyphaDeploymentSpec{
+					Template: &operatorv1.TyphaDeploymentPodTemplateSpec{
+						Spec: &operatorv1.TyphaDeploymentPodSpec{
+							Containers: []operatorv1.TyphaDeploymentContainer{
+								{
+									Name: "calico-typha",
+									LivenessProbe: &operatorv1.ProbeOverride{
+										InitialDelaySeconds: ptr.To(int32(60)),
+										FailureThreshold:    ptr.To(int32(10)),
+									},
+									ReadinessProbe: &operatorv1.ProbeOverride{
+										PeriodSeconds:  ptr.To(int32(30)),
+										TimeoutSeconds: ptr.To(int32(20)),
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			component := render.Typha(&cfg)
+			Expect(component.ResolveImages(nil)).To(BeNil())
+			resources, _ := component.Objects()
+			d := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+
+			container := d.Spec.Template.Spec.Containers[0]
+			Expect(container.LivenessProbe.HTTPGet).NotTo(BeNil())
+			Expect(container.LivenessProbe.InitialDelaySeconds).To(BeEquivalentTo(60))
+			Expect(container.LivenessProbe.FailureThreshold).To(BeEquivalentTo(10))
+			Expect(container.LivenessProbe.TimeoutSeconds).To(BeEquivalentTo(10))
+			Expect(container.ReadinessProbe.HTTPGet).NotTo(BeNil())
+			Expect(container.ReadinessProbe.PeriodSeconds).To(BeEquivalentTo(30))
+			Expect(container.ReadinessProbe.TimeoutSeconds).To(BeEquivalentTo(20))
+		})
+
+		It("should override the seccomp and AppArmor profiles when specified", func() {
+			installation.TyphaDeployment = &operatorv1.TyphaDeployment{
+				Spec: &operatorv1.TyphaDeploymentSpec{
+					Template: &operatorv1.TyphaDeploymentPodTemplateSpec{
+						Spec: &operatorv1.TyphaDeploymentPodSpec{
+							Containers: []operatorv1.TyphaDeploymentContainer{
+								{
+									Name: "calico-typha",
+									SeccompProfile: &corev1.SeccompProfile{
+										Type:             corev1.SeccompProfileTypeLocalhost,
+										LocalhostProfile: ptr.To("profiles/typha.json"),
+									},
+									AppArmorProfile: "localhost/typha",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			component := render.Typha(&cfg)
+			Expect(component.ResolveImages(nil)).To(BeNil())
+			resources, _ := component.Objects()
+			d := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+
+			container := d.Spec.Template.Spec.Containers[0]
+			Expect(container.SecurityContext.SeccompProfile).To(Equal(&corev1.SeccompProfile{
+				Type:             corev1.SeccompProfileTypeLocalhost,
+				LocalhostProfile: ptr.To("profiles/typha.json"),
+			}))
+			// The rest of the security context is left unchanged.
+			Expect(*container.SecurityContext.RunAsNonRoot).To(BeTrue())
+			Expect(d.Spec.Template.Annotations).To(HaveKeyWithValue("container.apparmor.security.beta.kubernetes.io/calico-typha", "localhost/typha"))
+		})
+
 		It("should override ComponentResources", func() {
 			installation.ComponentResources = []operatorv1.ComponentResource{
 				{
@@ -104,11 +104,11 @@ func (c *Component) ResolveImages(is *operatorv1.ImageSet) error {
 
 	var err error
 
-	c.whiskerImage, err = components.GetReference(components.ComponentCalicoWhisker, reg, path, prefix, is)
+	c.whiskerImage, err = components.GetReference(components.ComponentCalicoWhisker, reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	if err != nil {
 		return err
 	}
-	c.whiskerBackendImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is)
+	c.whiskerBackendImage, err = components.GetReference(components.CombinedCalicoImage(c.cfg.Installation), reg, path, prefix, is, c.cfg.Installation.ComponentImages...)
 	return err
 }
 
@@ -78,11 +78,11 @@ func (c *windowsComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	}
 
 	if c.cfg.Installation.Variant.IsEnterprise() {
-		c.cniImage = appendIfErr(components.GetReference(components.ComponentTigeraCNIWindows, reg, path, prefix, is))
-		c.nodeImage = appendIfErr(components.GetReference(components.ComponentTigeraNodeWindows, reg, path, prefix, is))
+		c.cniImage = appendIfErr(components.GetReference(components.ComponentTigeraCNIWindows, reg, path, prefix, is, c.cfg.Installation.ComponentImages...))
+		c.nodeImage = appendIfErr(components.GetReference(components.ComponentTigeraNodeWindows, reg, path, prefix, is, c.cfg.Installation.ComponentImages...))
 	} else {
-		c.cniImage = appendIfErr(components.GetReference(components.ComponentCalicoCNIWindows, reg, path, prefix, is))
-		c.nodeImage = appendIfErr(components.GetReference(components.ComponentCalicoNodeWindows, reg, path, prefix, is))
+		c.cniImage = appendIfErr(components.GetReference(components.ComponentCalicoCNIWindows, reg, path, prefix, is, c.cfg.Installation.ComponentImages...))
+		c.nodeImage = appendIfErr(components.GetReference(components.ComponentCalicoNodeWindows, reg, path, prefix, is, c.cfg.Installation.ComponentImages...))
 	}
 
 	if len(errMsgs) != 0 {
@@ -135,6 +135,10 @@ func (c *windowsComponent) Ready() bool {
 	return true
 }
 
+func (c *windowsComponent) GarbageCollectionIdentity() string {
+	return "node-windows"
+}
+
 // nodeMetricsService creates a Service which exposes two endpoints on calico/node for
 // reporting Prometheus metrics (for policy enforcement activity and BGP stats).
 // This service is used internally by Calico Enterprise and is separate from general
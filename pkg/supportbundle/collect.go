@@ -0,0 +1,317 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package supportbundle collects the information that Tigera support needs to troubleshoot an installation into a
+// gzipped tarball, and writes it to the destination of a SupportBundle. It is run by the collection Job of each
+// SupportBundle, using the operator image and service account.
+package supportbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/version"
+)
+
+var log = logf.Log.WithName("support_bundle")
+
+// FileName returns the name of the file that the given SupportBundle is written to.
+func FileName(bundle *operatorv1.SupportBundle) string {
+	return bundle.Name + ".tar.gz"
+}
+
+// Location returns where the given SupportBundle is written, in the form reported on its status.
+func Location(bundle *operatorv1.SupportBundle) string {
+	dest := bundle.Spec.Destination
+	switch {
+	case dest.S3 != nil:
+		return fmt.Sprintf("s3://%s/%s", dest.S3.BucketName, s3Key(dest.S3, bundle))
+	case dest.PersistentVolumeClaim != nil:
+		return fmt.Sprintf("pvc://%s/%s", dest.PersistentVolumeClaim.ClaimName, FileName(bundle))
+	}
+	return ""
+}
+
+// Run collects the SupportBundle with the given name and writes it to its destination.
+func Run(ctx context.Context, cli client.Client, cs kubernetes.Interface, name string) error {
+	bundle := &operatorv1.SupportBundle{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: name}, bundle); err != nil {
+		return fmt.Errorf("failed to get SupportBundle %s: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := Collect(ctx, cli, cs, bundle, &buf); err != nil {
+		return err
+	}
+	log.Info("Collected support bundle", "size", buf.Len())
+	return write(ctx, cli, bundle, buf.Bytes())
+}
+
+// Collect writes the support bundle as a gzipped tarball to w. Failures to collect individual parts of the bundle do
+// not fail the collection, they are recorded in the errors.txt file of the bundle instead.
+func Collect(ctx context.Context, cli client.Client, cs kubernetes.Interface, bundle *operatorv1.SupportBundle, w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	c := &collector{
+		client:    cli,
+		clientset: cs,
+		bundle:    bundle,
+		tw:        tar.NewWriter(gz),
+		root:      bundle.Name,
+		now:       time.Now(),
+	}
+
+	steps := []func(context.Context) error{
+		c.collectVersion,
+		c.collectTigeraStatuses,
+		c.collectDiffs,
+		c.collectPodLogs,
+		c.collectCertificates,
+	}
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return err
+		}
+	}
+	if len(c.errs) > 0 {
+		if err := c.add("errors.txt", []byte(strings.Join(c.errs, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+
+	if err := c.tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+type collector struct {
+	client    client.Client
+	clientset kubernetes.Interface
+	bundle    *operatorv1.SupportBundle
+	tw        *tar.Writer
+	root      string
+	now       time.Time
+
+	// namespaces are the namespaces of the operator and its components, read on first use.
+	namespaces []string
+
+	// errs are the failures to collect parts of the bundle.
+	errs []string
+}
+
+// add writes a file to the bundle. Only failures to write the tarball itself are returned.
+func (c *collector) add(name string, data []byte) error {
+	hdr := &tar.Header{
+		Name:    path.Join(c.root, name),
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: c.now,
+	}
+	if err := c.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := c.tw.Write(data)
+	return err
+}
+
+// recordError records a failure to collect part of the bundle.
+func (c *collector) recordError(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	log.Info("Failed to collect part of the support bundle", "error", msg)
+	c.errs = append(c.errs, msg)
+}
+
+func (c *collector) collectVersion(_ context.Context) error {
+	return c.add("version.txt", []byte(fmt.Sprintf("Operator: %s\nCollected: %s\n", version.VERSION, c.now.UTC().Format(time.RFC3339))))
+}
+
+func (c *collector) collectTigeraStatuses(ctx context.Context) error {
+	statuses := &operatorv1.TigeraStatusList{}
+	if err := c.client.List(ctx, statuses); err != nil {
+		c.recordError("failed to list TigeraStatuses: %v", err)
+		return nil
+	}
+	b, err := sigsyaml.Marshal(statuses)
+	if err != nil {
+		c.recordError("failed to marshal TigeraStatuses: %v", err)
+		return nil
+	}
+	return c.add("tigerastatus.yaml", b)
+}
+
+// componentNamespaces returns the namespace of the operator and the namespaces of its components, which are those
+// prefixed with calico- or tigera-.
+func (c *collector) componentNamespaces(ctx context.Context) ([]string, error) {
+	if c.namespaces != nil {
+		return c.namespaces, nil
+	}
+	list := &corev1.NamespaceList{}
+	if err := c.client.List(ctx, list); err != nil {
+		return nil, err
+	}
+	namespaces := []string{common.OperatorNamespace()}
+	for _, ns := range list.Items {
+		if ns.Name == common.OperatorNamespace() {
+			continue
+		}
+		if strings.HasPrefix(ns.Name, "calico-") || strings.HasPrefix(ns.Name, "tigera-") {
+			namespaces = append(namespaces, ns.Name)
+		}
+	}
+	sort.Strings(namespaces[1:])
+	c.namespaces = namespaces
+	return namespaces, nil
+}
+
+// collectPodLogs collects the tail of the logs of each container of the pods in the operator and component
+// namespaces. The logs of the previous instance of a container are collected as well if it has restarted.
+func (c *collector) collectPodLogs(ctx context.Context) error {
+	namespaces, err := c.componentNamespaces(ctx)
+	if err != nil {
+		c.recordError("failed to list namespaces: %v", err)
+		return nil
+	}
+	tailLines := c.bundle.Spec.GetPodLogTailLines()
+	for _, ns := range namespaces {
+		pods := &corev1.PodList{}
+		if err := c.client.List(ctx, pods, client.InNamespace(ns)); err != nil {
+			c.recordError("failed to list pods in %s: %v", ns, err)
+			continue
+		}
+		for _, pod := range pods.Items {
+			for _, cs := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+				if err := c.collectContainerLogs(ctx, &pod, cs.Name, tailLines, false); err != nil {
+					return err
+				}
+				if cs.RestartCount > 0 {
+					if err := c.collectContainerLogs(ctx, &pod, cs.Name, tailLines, true); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (c *collector) collectContainerLogs(ctx context.Context, pod *corev1.Pod, container string, tailLines int64, previous bool) error {
+	name := container + ".log"
+	if previous {
+		name = container + ".previous.log"
+	}
+	req := c.clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: container,
+		TailLines: &tailLines,
+		Previous:  previous,
+	})
+	logs, err := req.DoRaw(ctx)
+	if err != nil {
+		c.recordError("failed to get the logs of %s/%s/%s: %v", pod.Namespace, pod.Name, name, err)
+		return nil
+	}
+	return c.add(path.Join("logs", pod.Namespace, pod.Name, name), logs)
+}
+
+// collectCertificates reports the expiry of the certificates in the TLS secrets of the operator and component
+// namespaces. Only the certificates are read, the private keys are not collected.
+func (c *collector) collectCertificates(ctx context.Context) error {
+	namespaces, err := c.componentNamespaces(ctx)
+	if err != nil {
+		c.recordError("failed to list namespaces: %v", err)
+		return nil
+	}
+
+	var buf bytes.Buffer
+	tw := tabwriter.NewWriter(&buf, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAMESPACE\tSECRET\tSUBJECT\tISSUER\tNOT AFTER\tEXPIRES IN")
+	for _, ns := range namespaces {
+		secrets := &corev1.SecretList{}
+		if err := c.client.List(ctx, secrets, client.InNamespace(ns)); err != nil {
+			c.recordError("failed to list secrets in %s: %v", ns, err)
+			continue
+		}
+		for _, s := range secrets.Items {
+			certPEM, ok := s.Data[corev1.TLSCertKey]
+			if !ok {
+				continue
+			}
+			certs := parseCertificates(certPEM)
+			if len(certs) == 0 {
+				c.recordError("secret %s/%s has no valid certificate", ns, s.Name)
+				continue
+			}
+			for _, cert := range certs {
+				expiresIn := cert.NotAfter.Sub(c.now).Truncate(time.Hour)
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", ns, s.Name, cert.Subject.CommonName, cert.Issuer.CommonName,
+					cert.NotAfter.UTC().Format(time.RFC3339), expiresIn)
+			}
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	return c.add("certificates.txt", buf.Bytes())
+}
+
+// parseCertificates returns the certificates in the given PEM data, skipping any other blocks.
+func parseCertificates(data []byte) []*x509.Certificate {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		certs = append(certs, cert)
+	}
+}
+
+// getDefault gets the default instance of the given custom resource, returning false if it does not exist.
+func (c *collector) getDefault(ctx context.Context, obj client.Object) (bool, error) {
+	if err := c.client.Get(ctx, utils.DefaultInstanceKey, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/dns"
+	"github.com/tigera/operator/pkg/dryrun"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
+)
+
+// collectDiffs renders the objects of the Installation and APIServer offline, and reports where the live objects
+// differ from them. Only the fields that the operator renders are compared, so fields defaulted by the API server
+// are not reported. Certificates are generated for each render, so secrets and anything derived from them are
+// skipped. The offline render cannot know about cluster state such as the autodetected provider, so some of the
+// reported differences may be expected.
+func (c *collector) collectDiffs(ctx context.Context) error {
+	in := &dryrun.Input{Installation: &operatorv1.Installation{}}
+	if found, err := c.getDefault(ctx, in.Installation); err != nil {
+		c.recordError("failed to get the Installation: %v", err)
+		return nil
+	} else if !found {
+		c.recordError("no Installation to render, skipping the rendered object diffs")
+		return nil
+	}
+	apiServer := &operatorv1.APIServer{}
+	if found, err := c.getDefault(ctx, apiServer); err != nil {
+		c.recordError("failed to get the APIServer: %v", err)
+		return nil
+	} else if found {
+		in.APIServer = apiServer
+	}
+
+	objs, err := dryrun.Render(in, dns.DefaultClusterDomain)
+	if err != nil {
+		c.recordError("failed to render the Installation: %v", err)
+		return nil
+	}
+
+	var summary bytes.Buffer
+	for _, obj := range objs {
+		if skipDiff(obj) {
+			continue
+		}
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		id := strings.Join([]string{gvk.Kind, obj.GetNamespace(), obj.GetName()}, "/")
+
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(gvk)
+		if err := c.client.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+			if errors.IsNotFound(err) {
+				fmt.Fprintf(&summary, "%s: missing\n", id)
+			} else {
+				fmt.Fprintf(&summary, "%s: %v\n", id, err)
+			}
+			continue
+		}
+		rendered, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			c.recordError("failed to convert %s: %v", id, err)
+			continue
+		}
+
+		diffs := diffObjects(rendered, live.Object)
+		if len(diffs) == 0 {
+			fmt.Fprintf(&summary, "%s: in sync\n", id)
+			continue
+		}
+		fmt.Fprintf(&summary, "%s: %d differences\n", id, len(diffs))
+		name := strings.Join([]string{gvk.Kind, obj.GetNamespace(), obj.GetName()}, "_") + ".txt"
+		if err := c.add(path.Join("diffs", name), []byte(strings.Join(diffs, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+	return c.add(path.Join("diffs", "summary.txt"), summary.Bytes())
+}
+
+// skipDiff returns true for the rendered objects that hold generated certificate material.
+func skipDiff(obj client.Object) bool {
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		return true
+	case *corev1.ConfigMap:
+		return o.Name == certificatemanagement.TrustedCertConfigMapName
+	}
+	return false
+}
+
+// diffObjects returns the differences between the fields of the rendered object and the live object. Of the metadata,
+// only the labels are compared, and the status is not compared at all.
+func diffObjects(rendered, live map[string]interface{}) []string {
+	var diffs []string
+	for _, k := range sortedKeys(rendered) {
+		switch k {
+		case "apiVersion", "kind", "status":
+			continue
+		case "metadata":
+			labels, _, _ := unstructured.NestedFieldNoCopy(rendered, "metadata", "labels")
+			liveLabels, _, _ := unstructured.NestedFieldNoCopy(live, "metadata", "labels")
+			diffs = append(diffs, diffFields("metadata.labels", labels, liveLabels)...)
+		default:
+			diffs = append(diffs, diffFields(k, rendered[k], live[k])...)
+		}
+	}
+	return diffs
+}
+
+// diffFields returns where the live value differs from the rendered value. Fields of maps that are not rendered are
+// ignored, lists are compared element by element if they have the same length, and as a whole otherwise. Annotations
+// and CA bundles are skipped as they hold hashes of, or are, generated certificates.
+func diffFields(p string, rendered, live interface{}) []string {
+	if strings.HasSuffix(p, ".annotations") || strings.HasSuffix(p, ".caBundle") {
+		return nil
+	}
+	switch r := rendered.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok && live != nil {
+			break
+		}
+		var diffs []string
+		for _, k := range sortedKeys(r) {
+			diffs = append(diffs, diffFields(p+"."+k, r[k], l[k])...)
+		}
+		return diffs
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if (!ok && live != nil) || len(l) != len(r) {
+			break
+		}
+		var diffs []string
+		for i := range r {
+			diffs = append(diffs, diffFields(fmt.Sprintf("%s[%d]", p, i), r[i], l[i])...)
+		}
+		return diffs
+	default:
+		if reflect.DeepEqual(rendered, live) {
+			return nil
+		}
+	}
+	if rendered == nil || (live == nil && reflect.ValueOf(rendered).IsZero()) {
+		// Zero values that are rendered without omitempty are not set on the live object.
+		return nil
+	}
+	return []string{fmt.Sprintf("%s: rendered %s, live %s", p, toJSON(rendered), toJSON(live))}
+}
+
+func toJSON(v interface{}) string {
+	if v == nil {
+		return "<unset>"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/controller/certificatemanager"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/dns"
+	"github.com/tigera/operator/pkg/dryrun"
+	rtest "github.com/tigera/operator/pkg/render/common/test"
+)
+
+// untar returns the files of a gzipped tarball, keyed by name.
+func untar(data []byte) map[string]string {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	Expect(err).NotTo(HaveOccurred())
+	tr := tar.NewReader(gz)
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		Expect(err).NotTo(HaveOccurred())
+		b, err := io.ReadAll(tr)
+		Expect(err).NotTo(HaveOccurred())
+		files[hdr.Name] = string(b)
+	}
+}
+
+var _ = Describe("support bundle collection", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		cli    client.Client
+		bundle *operatorv1.SupportBundle
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme, false)).NotTo(HaveOccurred())
+		Expect(appsv1.SchemeBuilder.AddToScheme(scheme)).NotTo(HaveOccurred())
+		cli = ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+
+		bundle = &operatorv1.SupportBundle{
+			ObjectMeta: metav1.ObjectMeta{Name: "case-1234"},
+			Spec: operatorv1.SupportBundleSpec{
+				Destination: operatorv1.SupportBundleDestination{
+					S3: &operatorv1.SupportBundleS3Destination{
+						Region:                "us-west-2",
+						BucketName:            "support",
+						BucketPath:            "/bundles",
+						CredentialsSecretName: "support-creds",
+					},
+				},
+			},
+		}
+	})
+
+	It("should report where the bundle is written", func() {
+		Expect(Location(bundle)).To(Equal("s3://support/bundles/case-1234.tar.gz"))
+
+		bundle.Spec.Destination = operatorv1.SupportBundleDestination{
+			PersistentVolumeClaim: &operatorv1.SupportBundlePersistentVolumeClaimDestination{ClaimName: "bundles"},
+		}
+		Expect(Location(bundle)).To(Equal("pvc://bundles/case-1234.tar.gz"))
+	})
+
+	It("should collect the TigeraStatuses, pod logs and certificate expiry of the operator and its components", func() {
+		for _, ns := range []string{common.OperatorNamespace(), "calico-system", "kube-system"} {
+			Expect(cli.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}})).NotTo(HaveOccurred())
+		}
+		Expect(cli.Create(ctx, &operatorv1.TigeraStatus{ObjectMeta: metav1.ObjectMeta{Name: "calico"}})).NotTo(HaveOccurred())
+		pods := []*corev1.Pod{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-operator-abc", Namespace: common.OperatorNamespace()},
+				Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "tigera-operator", RestartCount: 1},
+				}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "calico-node-xyz", Namespace: "calico-system"},
+				Status: corev1.PodStatus{
+					InitContainerStatuses: []corev1.ContainerStatus{{Name: "install-cni"}},
+					ContainerStatuses:     []corev1.ContainerStatus{{Name: "calico-node"}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "coredns", Namespace: "kube-system"},
+				Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{Name: "coredns"}}},
+			},
+		}
+		for _, pod := range pods {
+			Expect(cli.Create(ctx, pod)).NotTo(HaveOccurred())
+		}
+		certificateManager, err := certificatemanager.Create(cli, nil, dns.DefaultClusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+		Expect(err).NotTo(HaveOccurred())
+		keyPair, err := certificateManager.GetOrCreateKeyPair(cli, "typha-certs", common.OperatorNamespace(), []string{"typha"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cli.Create(ctx, keyPair.Secret("calico-system"))).NotTo(HaveOccurred())
+
+		var buf bytes.Buffer
+		Expect(Collect(ctx, cli, kfake.NewSimpleClientset(), bundle, &buf)).NotTo(HaveOccurred())
+		files := untar(buf.Bytes())
+
+		Expect(files).To(HaveKey("case-1234/version.txt"))
+		Expect(files).To(HaveKeyWithValue("case-1234/tigerastatus.yaml", ContainSubstring("name: calico")))
+		Expect(files).To(HaveKey("case-1234/logs/tigera-operator/tigera-operator-abc/tigera-operator.log"))
+		Expect(files).To(HaveKey("case-1234/logs/tigera-operator/tigera-operator-abc/tigera-operator.previous.log"))
+		Expect(files).To(HaveKey("case-1234/logs/calico-system/calico-node-xyz/install-cni.log"))
+		Expect(files).To(HaveKey("case-1234/logs/calico-system/calico-node-xyz/calico-node.log"))
+		Expect(files).NotTo(HaveKey(ContainSubstring("coredns")))
+
+		Expect(files).To(HaveKey("case-1234/certificates.txt"))
+		Expect(files["case-1234/certificates.txt"]).To(ContainSubstring("calico-system  typha-certs  typha"))
+		for _, f := range files {
+			Expect(f).NotTo(ContainSubstring("PRIVATE KEY"))
+		}
+
+		// There is no Installation to render.
+		Expect(files).To(HaveKeyWithValue("case-1234/errors.txt", ContainSubstring("no Installation to render")))
+	})
+
+	It("should report where the live objects differ from the rendered objects", func() {
+		installation := &operatorv1.Installation{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		Expect(cli.Create(ctx, installation)).NotTo(HaveOccurred())
+		objs, err := dryrun.Render(&dryrun.Input{Installation: installation}, dns.DefaultClusterDomain)
+		Expect(err).NotTo(HaveOccurred())
+		typha := rtest.GetResource(objs, "calico-typha", "calico-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		typha = typha.DeepCopy()
+		typha.Spec.Template.Spec.Containers[0].Image = "example.com/typha:patched"
+		Expect(cli.Create(ctx, typha)).NotTo(HaveOccurred())
+
+		var buf bytes.Buffer
+		Expect(Collect(ctx, cli, kfake.NewSimpleClientset(), bundle, &buf)).NotTo(HaveOccurred())
+		files := untar(buf.Bytes())
+
+		Expect(files).To(HaveKey("case-1234/diffs/summary.txt"))
+		summary := files["case-1234/diffs/summary.txt"]
+		Expect(summary).To(ContainSubstring("Deployment/calico-system/calico-typha: 1 differences"))
+		Expect(summary).To(ContainSubstring("DaemonSet/calico-system/calico-node: missing"))
+		Expect(summary).NotTo(ContainSubstring("Secret/"))
+		Expect(files).To(HaveKeyWithValue("case-1234/diffs/Deployment_calico-system_calico-typha.txt",
+			And(ContainSubstring("spec.template.spec.containers[0].image: rendered"), ContainSubstring(`live "example.com/typha:patched"`))))
+	})
+
+	It("should only compare the fields that are rendered", func() {
+		rendered := map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "a", "labels": map[string]interface{}{"app": "a"}},
+			"spec": map[string]interface{}{
+				"replicas": int64(2),
+				"paused":   false,
+				"selector": map[string]interface{}{},
+				"ports":    []interface{}{map[string]interface{}{"port": int64(80)}},
+			},
+		}
+		live := map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "a", "uid": "1234", "labels": map[string]interface{}{"app": "b"}},
+			"spec": map[string]interface{}{
+				"replicas":        int64(3),
+				"revisionHistory": int64(10),
+				"ports":           []interface{}{map[string]interface{}{"port": int64(80), "protocol": "TCP"}},
+			},
+			"status": map[string]interface{}{"ready": true},
+		}
+		Expect(diffObjects(rendered, live)).To(ConsistOf(
+			`metadata.labels.app: rendered "a", live "b"`,
+			`spec.replicas: rendered 2, live 3`,
+		))
+	})
+
+	It("should upload the bundle to S3 with a signed request", func() {
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+		}))
+		defer server.Close()
+		defer func(orig func(string, string) string) { s3Endpoint = orig }(s3Endpoint)
+		s3Endpoint = func(region, bucket string) string { return server.URL + "/" + bucket }
+
+		err := write(ctx, cli, bundle, []byte("bundle"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("support-creds"))
+
+		Expect(cli.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "support-creds", Namespace: common.OperatorNamespace()},
+			Data:       map[string][]byte{"key-id": []byte("id"), "key-secret": []byte("secret")},
+		})).NotTo(HaveOccurred())
+		Expect(write(ctx, cli, bundle, []byte("bundle"))).NotTo(HaveOccurred())
+
+		Expect(received.Method).To(Equal(http.MethodPut))
+		Expect(received.URL.Path).To(Equal("/support/bundles/case-1234.tar.gz"))
+		Expect(string(body)).To(Equal("bundle"))
+		Expect(strings.HasPrefix(received.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=id/")).To(BeTrue())
+		Expect(received.Header.Get("Authorization")).To(ContainSubstring("/us-west-2/s3/aws4_request"))
+	})
+})
@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundle
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/render"
+)
+
+// s3Endpoint returns the URL of the given bucket. It is a variable so that tests can upload to a local server.
+var s3Endpoint = func(region, bucket string) string {
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com", bucket, region)
+}
+
+// write writes the collected bundle to the destination of the SupportBundle.
+func write(ctx context.Context, cli client.Client, bundle *operatorv1.SupportBundle, data []byte) error {
+	dest := bundle.Spec.Destination
+	switch {
+	case dest.S3 != nil:
+		return uploadToS3(ctx, cli, dest.S3, s3Key(dest.S3, bundle), data)
+	case dest.PersistentVolumeClaim != nil:
+		// The collection Job mounts the claim.
+		return os.WriteFile(filepath.Join(render.SupportBundleMountPath, FileName(bundle)), data, 0o644)
+	}
+	return fmt.Errorf("SupportBundle %s has no destination", bundle.Name)
+}
+
+// s3Key returns the key of the object that the given SupportBundle is uploaded to.
+func s3Key(s3 *operatorv1.SupportBundleS3Destination, bundle *operatorv1.SupportBundle) string {
+	return strings.TrimPrefix(path.Join(s3.BucketPath, FileName(bundle)), "/")
+}
+
+// uploadToS3 uploads the bundle with a single signed PUT, using the credentials in the secret of the destination.
+func uploadToS3(ctx context.Context, cli client.Client, s3 *operatorv1.SupportBundleS3Destination, key string, data []byte) error {
+	secret := &corev1.Secret{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: s3.CredentialsSecretName, Namespace: common.OperatorNamespace()}, secret); err != nil {
+		return fmt.Errorf("failed to get the S3 credentials secret %s: %w", s3.CredentialsSecretName, err)
+	}
+	keyID, keySecret := string(secret.Data["key-id"]), string(secret.Data["key-secret"])
+	if keyID == "" || keySecret == "" {
+		return fmt.Errorf("the S3 credentials secret %s must have the key-id and key-secret keys", s3.CredentialsSecretName)
+	}
+
+	url := fmt.Sprintf("%s/%s", s3Endpoint(s3.Region, s3.BucketName), key)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/gzip")
+	sum := sha256.Sum256(data)
+	payloadHash := hex.EncodeToString(sum[:])
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	credentials := aws.Credentials{AccessKeyID: keyID, SecretAccessKey: keySecret}
+	if err := v4.NewSigner().SignHTTP(ctx, credentials, req, payloadHash, "s3", s3.Region, time.Now()); err != nil {
+		return fmt.Errorf("failed to sign the S3 upload: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload to S3: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("failed to upload to S3: %s: %s", resp.Status, string(body))
+	}
+	return nil
+}
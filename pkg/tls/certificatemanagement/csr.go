@@ -97,7 +97,7 @@ func CreateCSRInitContainer(
 // specified ImageSet. The init container reuses the combined calico/calico image (or its FIPS variant
 // for OSS) and dispatches into the key-cert-provisioner Cobra subcommand.
 func ResolveCSRInitImage(inst *operatorv1.InstallationSpec, is *operatorv1.ImageSet) (string, error) {
-	return components.GetReference(components.CombinedCalicoImage(inst), inst.Registry, inst.ImagePath, inst.ImagePrefix, is)
+	return components.GetReference(components.CombinedCalicoImage(inst), inst.Registry, inst.ImagePath, inst.ImagePrefix, is, inst.ComponentImages...)
 }
 
 // CSRClusterRole returns a role with the necessary permissions to create certificate signing requests.
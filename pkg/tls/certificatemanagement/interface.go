@@ -17,6 +17,8 @@ package certificatemanagement
 import (
 	corev1 "k8s.io/api/core/v1"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+
 	"github.com/tigera/operator/pkg/render/common/meta"
 )
 
@@ -43,6 +45,10 @@ const (
 	// only in multi-tenant environments as a single namespace requires both a trusted bundle with public CAs as well as one without.
 	TrustedCertConfigMapNamePublic = "tigera" + TrustedCertConfigMapSuffixPublic
 
+	// TrustedBundleAddLabel is set to "true" on ConfigMaps in the operator namespace whose PEM encoded certificates
+	// are added to the trusted certificate bundles.
+	TrustedBundleAddLabel = "trusted-bundle.operator.tigera.io/add"
+
 	// Certificate metadata labels and annotations set on TLS secrets.
 	SignerLabel      = "certificates.operator.tigera.io/signer"
 	IssuerAnnotation = "certificates.operator.tigera.io/issuer"
@@ -55,7 +61,11 @@ const (
 type KeyPairInterface interface {
 	// UseCertificateManagement returns true if this key pair was not user provided and certificate management has been configured.
 	UseCertificateManagement() bool
-	// BYO returns true if this KeyPair was provided by the user. If BYO is true, UseCertificateManagement is false.
+	// UseCertManager returns true if this key pair is issued by cert-manager.
+	UseCertManager() bool
+	// Certificate returns the cert-manager Certificate that requests this key pair. It is only applicable when UseCertManager is true.
+	Certificate(namespace string) *certmanagerv1.Certificate
+	// BYO returns true if this KeyPair was provided by the user. If BYO is true, UseCertificateManagement and UseCertManager are false.
 	BYO() bool
 	InitContainer(namespace string, securityContext *corev1.SecurityContext) corev1.Container
 	VolumeMount(osType meta.OSType) corev1.VolumeMount
@@ -27,6 +27,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+
 	operatorv1 "github.com/tigera/operator/api/v1"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 )
@@ -47,6 +50,9 @@ type KeyPair struct {
 	DNSNames []string
 	Issuer   KeyPairInterface
 
+	// CertManager is set when the key pair is issued by cert-manager, for which a Certificate is rendered.
+	CertManager *operatorv1.CertManager
+
 	// OriginalSecret maintains a copy of the secret that the KeyPair was created from.
 	OriginalSecret *corev1.Secret
 }
@@ -68,9 +74,59 @@ func (k *KeyPair) UseCertificateManagement() bool {
 	return k.CertificateManagement != nil
 }
 
-// BYO returns true if this KeyPair was provided by the user. If BYO is true, UseCertificateManagement is false.
+// UseCertManager is true if the key pair is issued by cert-manager, based on a Certificate that the operator renders.
+func (k *KeyPair) UseCertManager() bool {
+	return k.CertManager != nil
+}
+
+// BYO returns true if this KeyPair was provided by the user. If BYO is true, UseCertificateManagement and UseCertManager are false.
 func (k *KeyPair) BYO() bool {
-	return !k.UseCertificateManagement() && k.Issuer == nil
+	return !k.UseCertificateManagement() && !k.UseCertManager() && k.Issuer == nil
+}
+
+// Certificate returns the cert-manager Certificate that requests this key pair. The issued secret is written to the
+// namespace of the Certificate.
+func (k *KeyPair) Certificate(namespace string) *certmanagerv1.Certificate {
+	var dnsNames, ipAddresses []string
+	for _, name := range k.DNSNames {
+		if net.ParseIP(name) != nil {
+			ipAddresses = append(ipAddresses, name)
+		} else {
+			dnsNames = append(dnsNames, name)
+		}
+	}
+	var commonName string
+	if len(dnsNames) > 0 {
+		commonName = dnsNames[0]
+	}
+	issuerRef := cmmeta.IssuerReference{Name: k.CertManager.IssuerRef.Name, Kind: "Issuer", Group: "cert-manager.io"}
+	if k.CertManager.IssuerRef.Kind != "" {
+		issuerRef.Kind = k.CertManager.IssuerRef.Kind
+	}
+	if k.CertManager.IssuerRef.Group != "" {
+		issuerRef.Group = k.CertManager.IssuerRef.Group
+	}
+	return &certmanagerv1.Certificate{
+		TypeMeta: metav1.TypeMeta{Kind: certmanagerv1.CertificateKind, APIVersion: certmanagerv1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k.GetName(),
+			Namespace: namespace,
+		},
+		Spec: certmanagerv1.CertificateSpec{
+			SecretName:  k.GetName(),
+			CommonName:  commonName,
+			DNSNames:    dnsNames,
+			IPAddresses: ipAddresses,
+			Usages: []certmanagerv1.KeyUsage{
+				certmanagerv1.UsageDigitalSignature,
+				certmanagerv1.UsageKeyEncipherment,
+				certmanagerv1.UsageServerAuth,
+				certmanagerv1.UsageClientAuth,
+			},
+			IssuerRef:  issuerRef,
+			PrivateKey: &certmanagerv1.CertificatePrivateKey{RotationPolicy: certmanagerv1.RotationPolicyAlways},
+		},
+	}
 }
 
 func (k *KeyPair) Secret(namespace string) *corev1.Secret {
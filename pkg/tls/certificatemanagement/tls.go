@@ -21,6 +21,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"fmt"
 	"math/big"
 	"time"
 
@@ -77,6 +78,47 @@ func CreateSelfSignedSecret(secretName, namespace, cn string, altNames []string)
 	}, nil
 }
 
+// CreateClientCertificate creates a client certificate and key for the given common name, signed by the CA in the
+// given TLS secret. It returns the PEM encoded certificate and key.
+func CreateClientCertificate(caSecret *corev1.Secret, cn string) ([]byte, []byte, error) {
+	ca, err := ParseCertificate(caSecret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse the certificate in secret %s: %w", caSecret.Name, err)
+	}
+	block, _ := pem.Decode(caSecret.Data[corev1.TLSPrivateKeyKey])
+	if block == nil {
+		return nil, nil, fmt.Errorf("secret %s does not contain a PEM encoded key", caSecret.Name)
+	}
+	caKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse the key in secret %s: %w", caSecret.Name, err)
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, VoltronKeySizeBits)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now(),
+		NotAfter:     ca.NotAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, ca, &privateKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: blockTypeCert, Bytes: cert})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: blockTypePrivateKey, Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	return certPEM, keyPEM, nil
+}
+
 func template(cn string, altNames []string) *x509.Certificate {
 	return &x509.Certificate{
 		IsCA:                  true,
@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook implements the admission webhook that validates the operator's own custom resources, so that
+// invalid specs are rejected when they are applied rather than reported later as degraded TigeraStatus conditions.
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/common/validation/resources"
+	"github.com/tigera/operator/pkg/render/operatorwebhook"
+)
+
+// Register adds the validating webhooks for the operator's custom resources to the given server.
+func Register(server webhook.Server, scheme *runtime.Scheme) {
+	server.Register(operatorwebhook.InstallationPath, admission.WithValidator(scheme, newValidator(resources.ValidateInstallation)))
+	server.Register(operatorwebhook.APIServerPath, admission.WithValidator(scheme, newValidator(resources.ValidateAPIServer)))
+	server.Register(operatorwebhook.LogCollectorPath, admission.WithValidator(scheme, newValidator(resources.ValidateLogCollector)))
+	server.Register(operatorwebhook.LogSourcePath, admission.WithValidator(scheme, newValidator(resources.ValidateLogSource)))
+	server.Register(operatorwebhook.TenantPath, admission.WithValidator(scheme, newValidator(resources.ValidateTenant)))
+}
+
+// GetCertificate returns a GetCertificate callback that serves the webhook keypair created by the installation
+// controller. The Secret is looked up on each handshake so that the webhook can start before the keypair exists, and
+// so that rotations are picked up without a restart. The reader is expected to be backed by a cache, and the keypair
+// is only parsed again when the Secret changes.
+func GetCertificate(c client.Reader) func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	var (
+		mu              sync.Mutex
+		cert            *tls.Certificate
+		resourceVersion string
+	)
+	return func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		ctx := hello.Context()
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		secret := &corev1.Secret{}
+		key := types.NamespacedName{Name: operatorwebhook.TLSSecretName, Namespace: common.OperatorNamespace()}
+		if err := c.Get(ctx, key, secret); err != nil {
+			return nil, fmt.Errorf("failed to read webhook TLS secret %s: %w", key, err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if cert != nil && secret.ResourceVersion == resourceVersion {
+			return cert, nil
+		}
+		kp, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return nil, fmt.Errorf("failed to load webhook TLS keypair from %s: %w", key, err)
+		}
+		cert, resourceVersion = &kp, secret.ResourceVersion
+		return cert, nil
+	}
+}
+
+// validator adapts a spec validation function to an admission validator.
+type validator[T client.Object] struct {
+	validate func(T) error
+}
+
+func newValidator[T client.Object](validate func(T) error) admission.Validator[T] {
+	return &validator[T]{validate: validate}
+}
+
+func (v *validator[T]) ValidateCreate(_ context.Context, obj T) (admission.Warnings, error) {
+	return nil, v.validate(obj)
+}
+
+func (v *validator[T]) ValidateUpdate(_ context.Context, _, obj T) (admission.Warnings, error) {
+	// Never block updates to a resource that is being deleted, e.g. the removal of its finalizers.
+	if obj.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+	return nil, v.validate(obj)
+}
+
+func (v *validator[T]) ValidateDelete(_ context.Context, _ T) (admission.Warnings, error) {
+	return nil, nil
+}
+
+var (
+	_ admission.Validator[*operatorv1.Installation] = &validator[*operatorv1.Installation]{}
+	_ admission.Validator[*operatorv1.APIServer]    = &validator[*operatorv1.APIServer]{}
+	_ admission.Validator[*operatorv1.LogCollector] = &validator[*operatorv1.LogCollector]{}
+)
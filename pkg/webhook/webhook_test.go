@@ -0,0 +1,687 @@
+// Copyright (c) 2026 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/apis"
+	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/common/validation/resources"
+	"github.com/tigera/operator/pkg/controller/certificatemanager"
+	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/dns"
+	"github.com/tigera/operator/pkg/render/operatorwebhook"
+)
+
+var _ = Describe("Operator webhook", func() {
+	var scheme *runtime.Scheme
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(apis.AddToScheme(scheme, false)).NotTo(HaveOccurred())
+	})
+
+	request := func(op admissionv1.Operation, obj, oldObj client.Object) admission.Request {
+		raw, err := json.Marshal(obj)
+		Expect(err).NotTo(HaveOccurred())
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: op,
+			Object:    runtime.RawExtension{Raw: raw},
+		}}
+		if oldObj != nil {
+			oldRaw, err := json.Marshal(oldObj)
+			Expect(err).NotTo(HaveOccurred())
+			req.OldObject = runtime.RawExtension{Raw: oldRaw}
+		}
+		return req
+	}
+
+	invalidInstallation := func() *operatorv1.Installation {
+		return &operatorv1.Installation{
+			TypeMeta:   metav1.TypeMeta{Kind: "Installation", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       operatorv1.InstallationSpec{ControlPlaneReplicas: ptr.To(int32(0))},
+		}
+	}
+
+	It("should reject invalid Installations", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateInstallation))
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, invalidInstallation(), nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.ControlPlaneReplicas"))
+	})
+
+	It("should accept Installations that still need defaulting", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateInstallation))
+		instance := invalidInstallation()
+		instance.Spec = operatorv1.InstallationSpec{}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should only accept PEM encoded image verification keys", func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateInstallation))
+		instance := invalidInstallation()
+		instance.Spec = operatorv1.InstallationSpec{ImageVerification: &operatorv1.ImageVerification{
+			PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+		}}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+
+		instance.Spec.ImageVerification.PublicKey = "cosign.pub"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.ImageVerification.PublicKey is not PEM encoded"))
+	})
+
+	It("should reject pod security standards that are too restrictive for an operator-managed namespace", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateInstallation))
+		instance := invalidInstallation()
+		instance.Spec = operatorv1.InstallationSpec{NamespacePodSecurityStandards: []operatorv1.NamespacePodSecurityStandard{
+			{Namespace: "tigera-dex", Level: operatorv1.PodSecurityStandardBaseline},
+		}}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+
+		instance.Spec.NamespacePodSecurityStandards = append(instance.Spec.NamespacePodSecurityStandards,
+			operatorv1.NamespacePodSecurityStandard{Namespace: "calico-system", Level: operatorv1.PodSecurityStandardRestricted})
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("namespace calico-system"))
+	})
+
+	It("should not block updates to resources that are being deleted", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateInstallation))
+		instance := invalidInstallation()
+		instance.DeletionTimestamp = ptr.To(metav1.Now())
+		instance.Finalizers = []string{"tigera.io/operator-cleanup"}
+		updated := instance.DeepCopy()
+		updated.Finalizers = nil
+		resp := handler.Handle(context.Background(), request(admissionv1.Update, updated, instance))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject invalid APIServers", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateAPIServer))
+		instance := &operatorv1.APIServer{
+			TypeMeta:   metav1.TypeMeta{Kind: "APIServer", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: operatorv1.APIServerSpec{
+				TLS: &operatorv1.APIServerTLS{ExtraIPAddresses: []string{"not-an-ip"}},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("not-an-ip"))
+	})
+
+	It("should reject an APIServer PodDisruptionBudget with both limits set", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateAPIServer))
+		instance := &operatorv1.APIServer{
+			TypeMeta:   metav1.TypeMeta{Kind: "APIServer", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: operatorv1.APIServerSpec{
+				APIServerDeployment: &operatorv1.APIServerDeployment{
+					Spec: &operatorv1.APIServerDeploymentSpec{
+						PodDisruptionBudget: &operatorv1.PodDisruptionBudgetSpec{
+							MinAvailable:   ptr.To(intstr.FromInt(1)),
+							MaxUnavailable: ptr.To(intstr.FromInt(1)),
+						},
+					},
+				},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("may not both be set"))
+
+		instance.Spec.APIServerDeployment.Spec.PodDisruptionBudget = &operatorv1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromString("150%"))}
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("minAvailable"))
+	})
+
+	It("should reject APIServer extra volume mounts that do not mount an extra volume", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateAPIServer))
+		podSpec := &operatorv1.APIServerDeploymentPodSpec{
+			ExtraVolumes: []corev1.Volume{{Name: "corporate-ca", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}},
+			Containers: []operatorv1.APIServerDeploymentContainer{{
+				Name:              "calico-apiserver",
+				ExtraVolumeMounts: []corev1.VolumeMount{{Name: "corporate-ca", MountPath: "/etc/pki/corporate"}},
+			}},
+		}
+		instance := &operatorv1.APIServer{
+			TypeMeta:   metav1.TypeMeta{Kind: "APIServer", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: operatorv1.APIServerSpec{
+				APIServerDeployment: &operatorv1.APIServerDeployment{
+					Spec: &operatorv1.APIServerDeploymentSpec{
+						Template: &operatorv1.APIServerDeploymentPodTemplateSpec{Spec: podSpec},
+					},
+				},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+
+		podSpec.Containers[0].ExtraVolumeMounts[0].Name = "tigera-ca-bundle"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("does not mount one of the extra volumes"))
+
+		podSpec.Containers[0].ExtraVolumeMounts[0].Name = "corporate-ca"
+		podSpec.ExtraVolumes = append(podSpec.ExtraVolumes, podSpec.ExtraVolumes[0])
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("extra volume corporate-ca is specified more than once"))
+	})
+
+	It("should reject an APIServer query server cache refresh interval that is not positive", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateAPIServer))
+		instance := &operatorv1.APIServer{
+			TypeMeta:   metav1.TypeMeta{Kind: "APIServer", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: operatorv1.APIServerSpec{
+				QueryServer: &operatorv1.APIServerQueryServer{CacheRefreshInterval: &metav1.Duration{}},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.QueryServer.CacheRefreshInterval"))
+
+		instance.Spec.QueryServer.CacheRefreshInterval.Duration = time.Minute
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject an invalid APIServer delegated auth namespace", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateAPIServer))
+		instance := &operatorv1.APIServer{
+			TypeMeta:   metav1.TypeMeta{Kind: "APIServer", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: operatorv1.APIServerSpec{
+				DelegatedAuth: &operatorv1.APIServerDelegatedAuth{Namespace: "Kube_System"},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.DelegatedAuth.Namespace"))
+
+		instance.Spec.DelegatedAuth.Namespace = "host-system"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject an APIServer termination grace period that does not cover the preStop sleep", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateAPIServer))
+		instance := &operatorv1.APIServer{
+			TypeMeta:   metav1.TypeMeta{Kind: "APIServer", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: operatorv1.APIServerSpec{
+				APIServerDeployment: &operatorv1.APIServerDeployment{
+					Spec: &operatorv1.APIServerDeploymentSpec{
+						Template: &operatorv1.APIServerDeploymentPodTemplateSpec{
+							Spec: &operatorv1.APIServerDeploymentPodSpec{
+								PreStopSleepSeconds:           ptr.To[int32](15),
+								TerminationGracePeriodSeconds: ptr.To[int64](30),
+							},
+						},
+					},
+				},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("TerminationGracePeriodSeconds"))
+
+		instance.Spec.APIServerDeployment.Spec.Template.Spec.TerminationGracePeriodSeconds = ptr.To[int64](31)
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject an APIServer Service with an external traffic policy but no load balancer", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateAPIServer))
+		instance := &operatorv1.APIServer{
+			TypeMeta:   metav1.TypeMeta{Kind: "APIServer", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: operatorv1.APIServerSpec{
+				APIServerService: &operatorv1.ServiceOverrides{ExternalTrafficPolicy: ptr.To(corev1.ServiceExternalTrafficPolicyLocal)},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.APIServerService"))
+
+		instance.Spec.APIServerService.Type = ptr.To(corev1.ServiceTypeLoadBalancer)
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should accept valid LogCollectors", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateLogCollector))
+		instance := &operatorv1.LogCollector{
+			TypeMeta:   metav1.TypeMeta{Kind: "LogCollector", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject LogCollectors with an invalid store buffer", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateLogCollector))
+		chunkLimit := resource.MustParse("64Mi")
+		totalLimit := resource.MustParse("8Mi")
+		instance := &operatorv1.LogCollector{
+			TypeMeta:   metav1.TypeMeta{Kind: "LogCollector", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.LogCollectorSpec{
+				AdditionalStores: &operatorv1.AdditionalLogStoreSpec{
+					S3: &operatorv1.S3StoreSpec{
+						Region:     "anyplace",
+						BucketName: "thebucket",
+						BucketPath: "bucketpath",
+						Buffer:     &operatorv1.FluentdBufferSpec{ChunkLimitSize: &chunkLimit, TotalLimitSize: &totalLimit},
+					},
+				},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.AdditionalStores.S3.Buffer"))
+	})
+
+	It("should validate the S3 destinations of LogCollectors", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateLogCollector))
+		instance := &operatorv1.LogCollector{
+			TypeMeta:   metav1.TypeMeta{Kind: "LogCollector", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.LogCollectorSpec{
+				AdditionalStores: &operatorv1.AdditionalLogStoreSpec{
+					S3: &operatorv1.S3StoreSpec{},
+				},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("requires Region and BucketName unless Destinations is set"))
+
+		instance.Spec.AdditionalStores.S3.Destinations = []operatorv1.S3DestinationSpec{
+			{Name: "flows", Region: "us-east-1", BucketName: "flows-archive", LogTypes: []operatorv1.S3LogType{operatorv1.S3LogFlows}},
+			{Name: "audit", Region: "eu-west-1", BucketName: "audit-archive", LogTypes: []operatorv1.S3LogType{operatorv1.S3LogAudit}, CredentialsSecretName: "audit-archive-credentials"},
+		}
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+
+		instance.Spec.AdditionalStores.S3.Destinations[1].Name = "flows"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("contains destination flows more than once"))
+
+		instance.Spec.AdditionalStores.S3.Destinations[1].Name = "Audit_Archive"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("is not valid"))
+	})
+
+	It("should validate the Syslog destinations of LogCollectors", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateLogCollector))
+		instance := &operatorv1.LogCollector{
+			TypeMeta:   metav1.TypeMeta{Kind: "LogCollector", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.LogCollectorSpec{
+				AdditionalStores: &operatorv1.AdditionalLogStoreSpec{
+					Syslog: &operatorv1.SyslogStoreSpec{},
+				},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("requires Endpoint unless Destinations is set"))
+
+		instance.Spec.AdditionalStores.Syslog.Destinations = []operatorv1.SyslogDestinationSpec{
+			{Name: "flows", Endpoint: "udp://1.2.3.4:514", LogTypes: []operatorv1.SyslogLogType{operatorv1.SyslogLogFlows}},
+			{Name: "audit", Endpoint: "tcp://5.6.7.8:6514", Encryption: operatorv1.EncryptionTLS, CAConfigMapName: "audit-syslog-ca"},
+		}
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+
+		instance.Spec.AdditionalStores.Syslog.Destinations[1].Name = "flows"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("contains destination flows more than once"))
+
+		instance.Spec.AdditionalStores.Syslog.Destinations[1].Name = "audit"
+		instance.Spec.AdditionalStores.Syslog.Destinations[1].Endpoint = ""
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("Destinations[audit] requires Endpoint"))
+
+		instance.Spec.AdditionalStores.Syslog.Destinations[1].Endpoint = "tcp://5.6.7.8"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("Destinations[audit] has invalid Endpoint"))
+
+		instance.Spec.AdditionalStores.Syslog.Endpoint = "tcp://9.9.9.9:514"
+		instance.Spec.AdditionalStores.Syslog.Destinations[1].Name = operatorv1.SyslogDefaultDestinationName
+		instance.Spec.AdditionalStores.Syslog.Destinations[1].Endpoint = "tcp://5.6.7.8:6514"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring(`name "default" is reserved`))
+	})
+
+	It("should reject LogCollectors that collect the operator logs with the audit deployment", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateLogCollector))
+		instance := &operatorv1.LogCollector{
+			TypeMeta:   metav1.TypeMeta{Kind: "LogCollector", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.LogCollectorSpec{
+				OperatorLogs:  ptr.To(operatorv1.OperatorLogsEnabled),
+				CollectorType: ptr.To(operatorv1.LogCollectorTypeAuditDeployment),
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.OperatorLogs"))
+
+		instance.Spec.CollectorType = ptr.To(operatorv1.LogCollectorTypeDaemonSet)
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject LogCollectors that disable the audit logs with the audit deployment", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateLogCollector))
+		instance := &operatorv1.LogCollector{
+			TypeMeta:   metav1.TypeMeta{Kind: "LogCollector", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.LogCollectorSpec{
+				Collection:    &operatorv1.LogCollection{Audit: ptr.To(false)},
+				CollectorType: ptr.To(operatorv1.LogCollectorTypeAuditDeployment),
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.Collection.Audit"))
+
+		instance.Spec.Collection.Audit = ptr.To(true)
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject LogCollectors whose fluentd state host path is not absolute", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateLogCollector))
+		instance := &operatorv1.LogCollector{
+			TypeMeta:   metav1.TypeMeta{Kind: "LogCollector", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.LogCollectorSpec{
+				FluentdState: &operatorv1.FluentdState{Type: operatorv1.FluentdStateVolumeHostPath, HostPath: "/var/log/../../etc"},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.FluentdState.HostPath"))
+
+		instance.Spec.FluentdState.HostPath = "var/log/fluentd"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+
+		instance.Spec.FluentdState.HostPath = "/var/lib/fluentd"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject LogCollectors with an invalid dead letter queue PersistentVolumeClaim name", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateLogCollector))
+		instance := &operatorv1.LogCollector{
+			TypeMeta:   metav1.TypeMeta{Kind: "LogCollector", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.LogCollectorSpec{
+				DeadLetterQueue: &operatorv1.FluentdDeadLetterQueue{PersistentVolumeClaimName: "Fluentd_DLQ"},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.DeadLetterQueue.PersistentVolumeClaimName"))
+
+		instance.Spec.DeadLetterQueue.PersistentVolumeClaimName = "fluentd-dlq"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject LogCollectors with a secondary Linseed endpoint that is not an HTTPS URL", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateLogCollector))
+		instance := &operatorv1.LogCollector{
+			TypeMeta:   metav1.TypeMeta{Kind: "LogCollector", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.LogCollectorSpec{
+				LinseedFailover: &operatorv1.LinseedFailover{SecondaryEndpoint: "https://"},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.LinseedFailover.SecondaryEndpoint"))
+
+		instance.Spec.LinseedFailover.SecondaryEndpoint = "https://voltron.standby.example.com:9443"
+		instance.Spec.LinseedFailover.RetryPrimaryInterval = &metav1.Duration{}
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.LinseedFailover.RetryPrimaryInterval"))
+
+		instance.Spec.LinseedFailover.RetryPrimaryInterval = nil
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject LogCollectors with filters that cannot be rendered", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateLogCollector))
+		instance := &operatorv1.LogCollector{
+			TypeMeta:   metav1.TypeMeta{Kind: "LogCollector", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.LogCollectorSpec{
+				Filters: &operatorv1.LogCollectorFilters{
+					ExcludedNamespaces:   []string{"ci"},
+					ExcludedPodSelectors: []metav1.LabelSelector{{}},
+				},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.Filters.ExcludedPodSelectors[0] must not be empty"))
+
+		instance.Spec.Filters.ExcludedPodSelectors = []metav1.LabelSelector{{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"web"}}},
+		}}
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("only In and Exists are supported"))
+
+		instance.Spec.Filters.ExcludedPodSelectors[0].MatchExpressions[0].Operator = metav1.LabelSelectorOpIn
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+
+		instance.Spec.Filters.ExcludedNamespaces = []string{"CI"}
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.Filters.ExcludedNamespaces"))
+	})
+
+	It("should reject LogCollectors that customize a splunk log type twice", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateLogCollector))
+		instance := &operatorv1.LogCollector{
+			TypeMeta:   metav1.TypeMeta{Kind: "LogCollector", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.LogCollectorSpec{
+				AdditionalStores: &operatorv1.AdditionalLogStoreSpec{
+					Splunk: &operatorv1.SplunkStoreSpec{
+						Endpoint: "https://1.2.3.4:8088",
+						LogTypes: []operatorv1.SplunkLogTypeSpec{
+							{Type: operatorv1.SplunkLogFlows, Index: "flows"},
+							{Type: operatorv1.SplunkLogFlows, Index: "more-flows"},
+						},
+					},
+				},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("log type Flows more than once"))
+
+		instance.Spec.AdditionalStores.Splunk.LogTypes = []operatorv1.SplunkLogTypeSpec{
+			{Type: operatorv1.SplunkLogFlows, Fields: map[string]string{"cluster": "a,b"}},
+		}
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("LogTypes[Flows].Fields"))
+	})
+
+	It("should validate the parser of LogSources", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateLogSource))
+		instance := &operatorv1.LogSource{
+			TypeMeta:   metav1.TypeMeta{Kind: "LogSource", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "checkout", Namespace: "shop"},
+			Spec: operatorv1.LogSourceSpec{
+				ContainerName: "checkout",
+				PodNamePrefix: "checkout-",
+				Parser:        operatorv1.LogParserSpec{Type: operatorv1.LogParserTypeRegexp},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.Parser.Expression is required"))
+
+		instance.Spec.Parser.Expression = `^\[(?<level>\w+)\] (?<message>.*)$`
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+
+		instance.Spec.Parser.Expression = "^.*$\n</source>"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("must be a single line"))
+
+		instance.Spec.Parser = operatorv1.LogParserSpec{Type: operatorv1.LogParserTypeJSON, TimeFormat: "%Y-%m-%dT%H:%M:%S"}
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("requires spec.Parser.TimeKey"))
+
+		instance.Spec.Parser.TimeKey = "ts"
+		instance.Spec.ContainerName = "Checkout_Service"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("spec.ContainerName"))
+	})
+
+	It("should reject Tenants with API server deployment overrides that the tenant API server cannot apply", func() {
+		handler := admission.WithValidator(scheme, newValidator(resources.ValidateTenant))
+		podSpec := &operatorv1.APIServerDeploymentPodSpec{
+			Containers: []operatorv1.APIServerDeploymentContainer{{Name: "tigera-queryserver"}},
+		}
+		instance := &operatorv1.Tenant{
+			TypeMeta:   metav1.TypeMeta{Kind: "Tenant", APIVersion: "operator.tigera.io/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "tenant-a"},
+			Spec: operatorv1.TenantSpec{
+				ID: "tenant-a",
+				APIServerDeployment: &operatorv1.APIServerDeployment{
+					Spec: &operatorv1.APIServerDeploymentSpec{
+						Template: &operatorv1.APIServerDeploymentPodTemplateSpec{Spec: podSpec},
+					},
+				},
+			},
+		}
+		resp := handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeTrue())
+
+		podSpec.Containers[0].Name = "calico-apiserver"
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("may only contain tigera-queryserver"))
+
+		podSpec.Containers[0].Name = "tigera-queryserver"
+		podSpec.PreStopSleepSeconds = ptr.To[int32](5)
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("PreStopSleepSeconds is not supported"))
+
+		podSpec.PreStopSleepSeconds = nil
+		podSpec.ExtraVolumes = []corev1.Volume{{Name: "ca"}}
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("ExtraVolumes is not supported"))
+
+		podSpec.ExtraVolumes = nil
+		instance.Spec.APIServerDeployment.Spec.PodDisruptionBudget = &operatorv1.PodDisruptionBudgetSpec{}
+		resp = handler.Handle(context.Background(), request(admissionv1.Create, instance, nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("PodDisruptionBudget is not supported"))
+	})
+
+	It("should serve the webhook keypair from the operator namespace", func() {
+		cli := ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		getCertificate := GetCertificate(cli)
+
+		_, err := getCertificate(&tls.ClientHelloInfo{})
+		Expect(err).To(HaveOccurred())
+
+		certificateManager, err := certificatemanager.Create(cli, nil, dns.DefaultClusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+		Expect(err).NotTo(HaveOccurred())
+		kp, err := certificateManager.GetOrCreateKeyPair(cli, operatorwebhook.TLSSecretName, common.OperatorNamespace(),
+			dns.GetServiceDNSNames(operatorwebhook.ServiceName, common.OperatorNamespace(), dns.DefaultClusterDomain))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cli.Create(context.Background(), kp.Secret(common.OperatorNamespace()))).To(Succeed())
+
+		cert, err := getCertificate(&tls.ClientHelloInfo{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.Leaf.DNSNames).To(ContainElement(operatorwebhook.ServiceName + "." + common.OperatorNamespace() + ".svc"))
+
+		// The keypair is only parsed again once the secret changes.
+		cached, err := getCertificate(&tls.ClientHelloInfo{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cached).To(BeIdenticalTo(cert))
+
+		rotated, err := certificateManager.GetOrCreateKeyPair(cli, operatorwebhook.TLSSecretName, common.OperatorNamespace(), []string{"rotated"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cli.Update(context.Background(), rotated.Secret(common.OperatorNamespace()))).To(Succeed())
+		cert, err = getCertificate(&tls.ClientHelloInfo{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert).NotTo(BeIdenticalTo(cached))
+		Expect(cert.Leaf.DNSNames).To(ConsistOf("rotated"))
+	})
+})